@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/logutil"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.uber.org/zap"
@@ -283,3 +284,60 @@ func HandleAdminLogLevel(w http.ResponseWriter, r *http.Request) {
 
 	api.WriteData(w, struct{}{})
 }
+
+// LiftSinkLogRedactionReq is the request to lift the log redaction of the sink
+// of a changefeed temporarily.
+type LiftSinkLogRedactionReq struct {
+	Namespace    string `json:"namespace"`
+	ChangefeedID string `json:"changefeed_id"`
+	// Duration is how long the redaction is lifted for, e.g. `10m`.
+	Duration string `json:"duration"`
+	// Reason is recorded in the audit record of the lift.
+	Reason string `json:"reason"`
+}
+
+// HandleSinkLogRedaction handles requests to lift the log redaction of the
+// sink of a changefeed for a bounded time window, or to list the audit records
+// of the recent lifts. The lift only takes effect in this capture.
+func HandleSinkLogRedaction(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		api.WriteData(w, pmysql.LogRedactionLifts())
+		return
+	}
+
+	var req LiftSinkLogRedactionReq
+	data, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		api.WriteError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		api.WriteError(w, http.StatusBadRequest,
+			cerror.ErrAPIInvalidParam.GenWithStack("invalid request: %s", err))
+		return
+	}
+	if req.Namespace == "" {
+		req.Namespace = model.DefaultNamespace
+	}
+	changefeedID := model.ChangeFeedID{Namespace: req.Namespace, ID: req.ChangefeedID}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		api.WriteError(w, http.StatusBadRequest,
+			cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed id: %s", changefeedID.ID))
+		return
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil || duration <= 0 || duration > pmysql.MaxLogRedactionLift {
+		api.WriteError(w, http.StatusBadRequest,
+			cerror.ErrAPIInvalidParam.GenWithStack(
+				"duration should be in (0, %s], but got %q", pmysql.MaxLogRedactionLift, req.Duration))
+		return
+	}
+	if req.Reason == "" {
+		api.WriteError(w, http.StatusBadRequest,
+			cerror.ErrAPIInvalidParam.GenWithStack("reason is required to audit the lift"))
+		return
+	}
+
+	api.WriteData(w, pmysql.LiftLogRedaction(changefeedID, duration, r.RemoteAddr, req.Reason))
+}
@@ -15,15 +15,20 @@ package owner
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/httputil"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/etcd/client/v3/concurrency"
 )
@@ -79,3 +84,38 @@ func testRequestNonOwnerFailed(t *testing.T, uri string) {
 	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	require.Equal(t, concurrency.ErrElectionNotLeader.Error(), string(data))
 }
+
+func TestHandleSinkLogRedaction(t *testing.T) {
+	t.Parallel()
+
+	lift := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/debug/sink/log-redaction", strings.NewReader(body))
+		HandleSinkLogRedaction(w, req)
+		return w
+	}
+
+	w := lift(`{"changefeed_id":"test-lift","duration":"2h","reason":"debug"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "duration should be in")
+	w = lift(`{"changefeed_id":"test-lift","duration":"10m"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "reason is required")
+	w = lift(`{"changefeed_id":"test lift","duration":"10m","reason":"debug"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = lift(`{"changefeed_id":"test-lift","duration":"10m","reason":"debug"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	var record pmysql.LogRedactionLift
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &record))
+	require.Equal(t, model.DefaultNamespace, record.Namespace)
+	require.Equal(t, "debug", record.Reason)
+	require.True(t, pmysql.LogRedactionLifted(model.DefaultChangeFeedID("test-lift"), time.Now()))
+
+	w = httptest.NewRecorder()
+	HandleSinkLogRedaction(w, httptest.NewRequest(http.MethodGet, "/debug/sink/log-redaction", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var records []pmysql.LogRedactionLift
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &records))
+	require.NotEmpty(t, records)
+}
@@ -38,6 +38,7 @@ var httpBadRequestError = []*errors.Error{
 	cerror.ErrChangeFeedNotExists, cerror.ErrTargetTsBeforeStartTs, cerror.ErrTableIneligible,
 	cerror.ErrFilterRuleInvalid, cerror.ErrChangefeedUpdateRefused, cerror.ErrMySQLConnectionError,
 	cerror.ErrMySQLInvalidConfig, cerror.ErrCaptureNotExist, cerror.ErrSchedulerRequestFailed,
+	cerror.ErrChangefeedCutoverRefused, cerror.ErrChangefeedCutoverNotFound,
 }
 
 const (
@@ -150,6 +151,26 @@ func HandleOwnerScheduleTable(
 	}
 }
 
+// HandleOwnerSetTargetTs sets the target ts of a running changefeed
+func HandleOwnerSetTargetTs(
+	ctx context.Context, capture capture.Capture,
+	changefeedID model.ChangeFeedID, targetTs model.Ts,
+) error {
+	// Use buffered channel to prevent blocking owner.
+	done := make(chan error, 1)
+	o, err := capture.GetOwner()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	o.SetTargetTs(changefeedID, targetTs, done)
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case err := <-done:
+		return errors.Trace(err)
+	}
+}
+
 // ForwardToOwner forwards an request to the owner
 func ForwardToOwner(c *gin.Context, p capture.Capture) {
 	ctx := c.Request.Context()
@@ -94,6 +94,16 @@ func (p *mockStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	return args.Get(0).(bool), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetChangefeedLagBreakdown(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedLagBreakdown, error) {
+	args := p.Called(ctx)
+	return args.Get(0).(*model.ChangefeedLagBreakdown), args.Error(1)
+}
+
+func (p *mockStatusProvider) GetChangefeedBarriers(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.ChangefeedBarrier, error) {
+	args := p.Called(ctx)
+	return args.Get(0).([]*model.ChangefeedBarrier), args.Error(1)
+}
+
 func newRouter(c capture.Capture, p owner.StatusProvider) *gin.Engine {
 	router := gin.New()
 	RegisterOpenAPIRoutes(router, NewOpenAPI4Test(c, p))
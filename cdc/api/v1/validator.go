@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/version"
 	"github.com/r3labs/diff"
 	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
 )
 
 // verifyCreateChangefeedConfig verifies ChangefeedConfig for create a changefeed
@@ -220,6 +221,17 @@ func VerifyUpdateChangefeedConfig(ctx context.Context,
 	// verify sink_uri
 	if changefeedConfig.SinkURI != "" {
 		newInfo.SinkURI = changefeedConfig.SinkURI
+		warnings, err := sink.AdjustConfigForSinkTypeChange(
+			oldInfo.SinkURI, newInfo.SinkURI, oldInfo.Config, newInfo.Config)
+		if err != nil {
+			return nil, err
+		}
+		if len(warnings) > 0 {
+			log.Warn("sink type of changefeed is changed, some semantics don't carry over",
+				zap.String("namespace", oldInfo.Namespace),
+				zap.String("changefeed", oldInfo.ID),
+				zap.Strings("warnings", warnings))
+		}
 		if err := sink.Validate(ctx, changefeedConfig.SinkURI, newInfo.Config); err != nil {
 			return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
 		}
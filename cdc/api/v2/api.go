@@ -49,12 +49,22 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.POST("", api.createChangefeed)
 	changefeedGroup.PUT("/:changefeed_id", api.updateChangefeed)
 	changefeedGroup.GET("/:changefeed_id/meta_info", api.getChangeFeedMetaInfo)
+	changefeedGroup.GET("/:changefeed_id/ddl-history", api.getChangefeedDDLHistory)
+	changefeedGroup.GET("/:changefeed_id/barriers", api.getChangefeedBarriers)
 	changefeedGroup.POST("/:changefeed_id/resume", api.resumeChangefeed)
+	changefeedGroup.POST("/:changefeed_id/clone", api.cloneChangefeed)
+	changefeedGroup.POST("/:changefeed_id/cutover", api.cutoverChangefeed)
+	changefeedGroup.GET("/:changefeed_id/cutover", api.getChangefeedCutover)
 
 	verifyTableGroup := v2.Group("/verify_table")
 	verifyTableGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
 	verifyTableGroup.POST("", api.verifyTable)
 
+	// owner apis
+	ownerGroup := v2.Group("/owner")
+	ownerGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	ownerGroup.POST("/resign", api.resignOwner)
+
 	// unsafe apis
 	unsafeGroup := v2.Group("/unsafe")
 	unsafeGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
@@ -64,4 +74,6 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 
 	// common APIs
 	v2.POST("/tso", api.QueryTso)
+	v2.GET("/security/certificate", api.getCertificateStatus)
+	v2.GET("/status", api.serverStatus)
 }
@@ -199,8 +199,10 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 		if protocol != "" {
 			replicaCfg.Sink.Protocol = protocol
 		}
+		// The encoders of these protocols omit the old values of the tables
+		// not in `old-value-tables`, so it's not forced on if they are set.
 		for _, fp := range config.ForceEnableOldValueProtocols {
-			if replicaCfg.Sink.Protocol == fp {
+			if replicaCfg.Sink.Protocol == fp && len(replicaCfg.OldValueTables) == 0 {
 				log.Warn(
 					"Attempting to replicate without old value enabled. "+
 						"CDC will enable old value and continue.",
@@ -338,6 +340,19 @@ func (APIV2HelpersImpl) verifyUpdateChangefeedConfig(
 	// verify SinkURI
 	if cfg.SinkURI != "" {
 		newInfo.SinkURI = cfg.SinkURI
+		warnings, err := sink.AdjustConfigForSinkTypeChange(
+			oldInfo.SinkURI, newInfo.SinkURI, oldInfo.Config, newInfo.Config)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(warnings) > 0 {
+			// the checkpoint is kept, the new sink continues from it after resuming.
+			log.Warn("sink type of changefeed is changed, some semantics don't carry over",
+				zap.String("namespace", oldInfo.Namespace),
+				zap.String("changefeed", oldInfo.ID),
+				zap.Uint64("checkpointTs", checkpointTs),
+				zap.Strings("warnings", warnings))
+		}
 		if err := sink.Validate(ctx, newInfo.SinkURI, newInfo.Config); err != nil {
 			return nil, nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
 		}
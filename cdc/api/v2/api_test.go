@@ -64,6 +64,8 @@ type mockStatusProvider struct {
 	owner.StatusProvider
 	changefeedStatus *model.ChangeFeedStatus
 	changefeedInfo   *model.ChangeFeedInfo
+	lagBreakdown     *model.ChangefeedLagBreakdown
+	barriers         []*model.ChangefeedBarrier
 	err              error
 }
 
@@ -80,3 +82,17 @@ func (m *mockStatusProvider) GetChangeFeedInfo(ctx context.Context,
 ) (*model.ChangeFeedInfo, error) {
 	return m.changefeedInfo, m.err
 }
+
+// GetChangefeedLagBreakdown returns a mock changefeeds' lag breakdown.
+func (m *mockStatusProvider) GetChangefeedLagBreakdown(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangefeedLagBreakdown, error) {
+	return m.lagBreakdown, m.err
+}
+
+// GetChangefeedBarriers returns mock changefeeds' blocked barriers.
+func (m *mockStatusProvider) GetChangefeedBarriers(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) ([]*model.ChangefeedBarrier, error) {
+	return m.barriers, m.err
+}
@@ -15,7 +15,9 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,18 +35,27 @@ import (
 	"go.uber.org/zap"
 )
 
-const apiOpVarChangefeedID = "changefeed_id"
+const (
+	apiOpVarChangefeedID = "changefeed_id"
+	apiOpVarSinceTs      = "since_ts"
+)
 
 // createChangefeed handles create changefeed request,
 // it returns the changefeed's changefeedInfo that it just created
 func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
-	ctx := c.Request.Context()
 	cfg := &ChangefeedConfig{ReplicaConfig: GetDefaultReplicaConfig()}
 
 	if err := c.BindJSON(&cfg); err != nil {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	h.doCreateChangefeed(c, cfg)
+}
+
+// doCreateChangefeed verifies the config and creates the changefeed,
+// it's shared by the create and clone changefeed requests.
+func (h *OpenAPIV2) doCreateChangefeed(c *gin.Context, cfg *ChangefeedConfig) {
+	ctx := c.Request.Context()
 	if len(cfg.PDAddrs) == 0 {
 		up, err := getCaptureDefaultUpstream(h.capture)
 		if err != nil {
@@ -201,6 +212,8 @@ func (h *OpenAPIV2) verifyTable(c *gin.Context) {
 // Can only update a changefeed's: TargetTs, SinkURI,
 // ReplicaConfig, PDAddrs, CAPath, CertPath, KeyPath,
 // SyncPointEnabled, SyncPointInterval
+// The sink type can be changed, e.g. from kafka to mysql, the configurations
+// specific to the old sink are reset and the checkpoint is kept.
 func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -312,7 +325,375 @@ func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, toAPIModel(info, false))
+	resp := toAPIModel(info, false)
+	// the lag breakdown is unavailable until the scheduler is initialized.
+	breakdown, err := h.capture.StatusProvider().GetChangefeedLagBreakdown(ctx, changefeedID)
+	if err != nil {
+		log.Warn("failed to get changefeed lag breakdown",
+			zap.String("namespace", changefeedID.Namespace),
+			zap.String("changefeed", changefeedID.ID), zap.Error(err))
+	} else {
+		resp.LagBreakdown = toAPILagBreakdown(breakdown)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// getChangefeedDDLHistory handles get changefeed's emitted DDL history request,
+// only the DDLs whose commit ts is not less than `since_ts` are returned.
+func (h *OpenAPIV2) getChangefeedDDLHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	var sinceTs uint64
+	if s := c.Query(apiOpVarSinceTs); s != "" {
+		ts, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid since_ts: %s", s))
+			return
+		}
+		sinceTs = ts
+	}
+
+	_, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	etcdClient, err := h.capture.GetEtcdClient()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	history, err := etcdClient.GetChangefeedDDLHistory(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	entries := make([]DDLHistoryEntry, 0, len(history.Entries))
+	for _, e := range history.Since(sinceTs) {
+		entries = append(entries, DDLHistoryEntry{
+			Query:      e.Query,
+			CommitTs:   e.CommitTs,
+			FinishedAt: e.FinishedAt,
+			Target:     e.Target,
+		})
+	}
+	c.JSON(http.StatusOK, &ChangefeedDDLHistory{
+		Namespace: changefeedID.Namespace,
+		ID:        changefeedID.ID,
+		Entries:   entries,
+	})
+}
+
+// getChangefeedBarriers handles get the barriers which a changefeed is blocked at
+// request, it helps to find out the tables holding back the checkpoint.
+func (h *OpenAPIV2) getChangefeedBarriers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	barriers, err := h.capture.StatusProvider().GetChangefeedBarriers(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	resp := &ChangefeedBarriers{
+		Namespace: changefeedID.Namespace,
+		ID:        changefeedID.ID,
+		Barriers:  make([]ChangefeedBarrier, 0, len(barriers)),
+	}
+	for _, b := range barriers {
+		barrier := ChangefeedBarrier{
+			Type:      b.Type,
+			BarrierTs: b.BarrierTs,
+			DDL:       b.DDL,
+			WaitingMs: b.Waiting.Milliseconds(),
+		}
+		for _, t := range b.PendingTables {
+			barrier.PendingTables = append(barrier.PendingTables, BarrierPendingTable{
+				TableID:      t.TableID,
+				CaptureID:    t.CaptureID,
+				CheckpointTs: t.CheckpointTs,
+			})
+		}
+		resp.Barriers = append(resp.Barriers, barrier)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// cloneChangefeed handles clone changefeed request, it creates a new changefeed
+// from the checkpoint of the changefeed with its upstream and replica config,
+// but writes to another sink, e.g. the new downstream of a migration.
+func (h *OpenAPIV2) cloneChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	cloneCfg := new(CloneChangefeedConfig)
+	if err := c.BindJSON(cloneCfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if cloneCfg.SinkURI == "" {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("sink_uri is required"))
+		return
+	}
+
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cloneCfg.SinkURI == info.SinkURI {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"the cloned changefeed must write to another sink than changefeed %s",
+			changefeedID.ID))
+		return
+	}
+	status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	etcdClient, err := h.capture.GetEtcdClient()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upInfo, err := etcdClient.GetUpstreamInfo(ctx, info.UpstreamID, changefeedID.Namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	replicaConfig := cloneCfg.ReplicaConfig
+	if replicaConfig == nil {
+		replicaConfig = ToAPIReplicaConfig(info.Config)
+	}
+	cfg := &ChangefeedConfig{
+		Namespace:     changefeedID.Namespace,
+		ID:            cloneCfg.ID,
+		StartTs:       status.CheckpointTs,
+		SinkURI:       cloneCfg.SinkURI,
+		Engine:        info.Engine,
+		ReplicaConfig: replicaConfig,
+		PDConfig: PDConfig{
+			PDAddrs:       strings.Split(upInfo.PDEndpoints, ","),
+			CAPath:        upInfo.CAPath,
+			CertPath:      upInfo.CertPath,
+			KeyPath:       upInfo.KeyPath,
+			CertAllowedCN: upInfo.CertAllowedCN,
+		},
+	}
+	log.Info("Clone changefeed",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("newChangefeed", cloneCfg.ID),
+		zap.Uint64("startTs", cfg.StartTs))
+	h.doCreateChangefeed(c, cfg)
+}
+
+// cutoverChangefeed handles cutover changefeed request, it makes the changefeed
+// finish at the target ts and records the cutover to the new changefeed, which
+// is completed by the owner once the changefeed is finished and the new
+// changefeed passes the target ts.
+func (h *OpenAPIV2) cutoverChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	cfg := new(CutoverChangefeedConfig)
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	newID := model.ChangeFeedID{Namespace: changefeedID.Namespace, ID: cfg.NewChangefeedID}
+	if err := model.ValidateChangefeedID(newID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid new_changefeed_id: %s",
+			newID.ID))
+		return
+	}
+	if newID == changefeedID {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"new_changefeed_id must be different from the changefeed"))
+		return
+	}
+	if cfg.TargetTs == 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("target_ts is required"))
+		return
+	}
+
+	etcdClient, err := h.capture.GetEtcdClient()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	cutover, err := etcdClient.GetChangefeedCutover(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cutover != nil && cutover.State == model.CutoverStatePending {
+		if cutover.To != newID || cutover.TargetTs != cfg.TargetTs {
+			_ = c.Error(cerror.ErrChangefeedCutoverRefused.GenWithStackByArgs(
+				fmt.Sprintf("a cutover to changefeed %s at %d is pending",
+					cutover.To.ID, cutover.TargetTs)))
+			return
+		}
+		// The request is retried, return the pending cutover.
+		h.respondChangefeedCutover(c, cutover)
+		return
+	}
+
+	newInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, newID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if newInfo.State != model.StateNormal {
+		_ = c.Error(cerror.ErrChangefeedCutoverRefused.GenWithStackByArgs(
+			fmt.Sprintf("changefeed %s is %s", newID.ID, newInfo.State)))
+		return
+	}
+	if newInfo.StartTs > cfg.TargetTs {
+		_ = c.Error(cerror.ErrChangefeedCutoverRefused.GenWithStackByArgs(
+			fmt.Sprintf("changefeed %s starts at %d, which is after the target ts %d",
+				newID.ID, newInfo.StartTs, cfg.TargetTs)))
+		return
+	}
+	newCutover := &model.Cutover{
+		From:      changefeedID,
+		To:        newID,
+		TargetTs:  cfg.TargetTs,
+		State:     model.CutoverStatePending,
+		StartedAt: time.Now(),
+	}
+	// The completed cutover is replaced, and the request fails if the cutover
+	// is changed by others since it's read.
+	if cutover != nil {
+		newCutover.ModRevision = cutover.ModRevision
+	}
+	cutover = newCutover
+	// The pending cutover is saved before the target ts is set, so that the
+	// old changefeed never finishes at the target ts without a cutover.
+	if err := etcdClient.SaveChangefeedCutover(ctx, changefeedID, cutover); err != nil {
+		if cerror.ErrChangefeedUpdateFailedTransaction.Equal(err) {
+			err = cerror.ErrChangefeedCutoverRefused.GenWithStackByArgs(
+				"the cutover is changed concurrently")
+		}
+		_ = c.Error(err)
+		return
+	}
+	// The owner refuses to set the target ts if the changefeed isn't normal,
+	// or the target ts is not after its resolved ts.
+	if err := api.HandleOwnerSetTargetTs(ctx, h.capture, changefeedID, cfg.TargetTs); err != nil {
+		if rollbackErr := etcdClient.DeleteChangefeedCutover(
+			ctx, changefeedID, cutover.ModRevision); rollbackErr != nil {
+			// The owner deletes the cutover once the old changefeed is removed.
+			log.Warn("failed to delete the pending cutover",
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID),
+				zap.String("newChangefeed", newID.ID),
+				zap.Error(rollbackErr))
+		}
+		_ = c.Error(err)
+		return
+	}
+	log.Info("Cutover changefeed started",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("newChangefeed", newID.ID),
+		zap.Uint64("targetTs", cfg.TargetTs))
+	h.respondChangefeedCutover(c, cutover)
+}
+
+// getChangefeedCutover handles get the cutover of a changefeed request.
+func (h *OpenAPIV2) getChangefeedCutover(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	etcdClient, err := h.capture.GetEtcdClient()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	cutover, err := etcdClient.GetChangefeedCutover(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cutover == nil {
+		_ = c.Error(cerror.ErrChangefeedCutoverNotFound.GenWithStackByArgs(changefeedID.ID))
+		return
+	}
+	h.respondChangefeedCutover(c, cutover)
+}
+
+// respondChangefeedCutover responds the progress of the cutover.
+func (h *OpenAPIV2) respondChangefeedCutover(c *gin.Context, cutover *model.Cutover) {
+	ctx := c.Request.Context()
+
+	resp := &ChangefeedCutover{
+		Namespace:       cutover.From.Namespace,
+		ID:              cutover.From.ID,
+		NewChangefeedID: cutover.To.ID,
+		TargetTs:        cutover.TargetTs,
+		StartedAt:       cutover.StartedAt,
+	}
+	if cutover.State == model.CutoverStatePending {
+		// The old changefeed may be removed after it's finished.
+		info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, cutover.From)
+		if err != nil && !cerror.ErrChangeFeedNotExists.Equal(err) {
+			_ = c.Error(err)
+			return
+		}
+		if info != nil {
+			resp.OldState = info.State
+		}
+		status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, cutover.From)
+		if err == nil {
+			resp.OldCheckpointTs = status.CheckpointTs
+		}
+		newStatus, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, cutover.To)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		resp.NewCheckpointTs = newStatus.CheckpointTs
+	}
+	resp.State = cutover.State
+	if cutover.State == model.CutoverStateCompleted {
+		completedAt := cutover.CompletedAt
+		resp.CompletedAt = &completedAt
+		if resp.NewCheckpointTs == 0 {
+			resp.NewCheckpointTs = cutover.ToCheckpointTs
+		}
+	}
+	c.JSON(http.StatusOK, resp)
 }
 
 // resumeChangefeed handles update changefeed request.
@@ -415,12 +796,18 @@ func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
 	}
 
 	sinkURI := info.SinkURI
+	replicaConfig := info.Config
 	var err error
 	if maskSinkURI {
 		sinkURI, err = util.MaskSinkURI(sinkURI)
 		if err != nil {
 			log.Error("failed to mask sink URI", zap.Error(err))
 		}
+		if replicaConfig != nil && replicaConfig.Sink != nil &&
+			replicaConfig.Sink.SchemaRegistryConfig != nil {
+			replicaConfig = replicaConfig.Clone()
+			replicaConfig.Sink.SchemaRegistryConfig.MaskSensitiveData()
+		}
 	}
 
 	apiInfoModel := &ChangeFeedInfo{
@@ -433,7 +820,7 @@ func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
 		TargetTs:       info.TargetTs,
 		AdminJobType:   info.AdminJobType,
 		Engine:         info.Engine,
-		Config:         ToAPIReplicaConfig(info.Config),
+		Config:         ToAPIReplicaConfig(replicaConfig),
 		State:          info.State,
 		Error:          runningError,
 		CreatorVersion: info.CreatorVersion,
@@ -441,6 +828,21 @@ func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
 	return apiInfoModel
 }
 
+func toAPILagBreakdown(breakdown *model.ChangefeedLagBreakdown) *CheckpointLagBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+	return &CheckpointLagBreakdown{
+		CurrentTs:        breakdown.CurrentTs,
+		PullerResolvedTs: breakdown.PullerResolvedTs,
+		SorterOutputTs:   breakdown.SorterOutputTs,
+		SinkFlushedTs:    breakdown.SinkFlushedTs,
+		PullerLagMs:      breakdown.PullerLag().Milliseconds(),
+		SorterLagMs:      breakdown.SorterLag().Milliseconds(),
+		SinkLagMs:        breakdown.SinkLag().Milliseconds(),
+	}
+}
+
 func getCaptureDefaultUpstream(cp capture.Capture) (*upstream.Upstream, error) {
 	upManager, err := cp.GetUpstreamManager()
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	tidbkv "github.com/pingcap/tidb/kv"
@@ -35,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
 )
 
@@ -463,6 +465,319 @@ func TestGetChangeFeedMetaInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, resp.ID, validID)
 	require.Nil(t, resp.Error)
+	require.Nil(t, resp.LagBreakdown)
+
+	// success with lag breakdown
+	now := time.Now()
+	statusProvider.lagBreakdown = &model.ChangefeedLagBreakdown{
+		CurrentTs:        oracle.GoTimeToTS(now),
+		PullerResolvedTs: oracle.GoTimeToTS(now.Add(-time.Second)),
+		SinkFlushedTs:    oracle.GoTimeToTS(now.Add(-3 * time.Second)),
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		metaInfo.method, fmt.Sprintf(metaInfo.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ChangeFeedInfo{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, &CheckpointLagBreakdown{
+		CurrentTs:        statusProvider.lagBreakdown.CurrentTs,
+		PullerResolvedTs: statusProvider.lagBreakdown.PullerResolvedTs,
+		SinkFlushedTs:    statusProvider.lagBreakdown.SinkFlushedTs,
+		PullerLagMs:      1000,
+	}, resp.LagBreakdown)
+}
+
+func TestGetChangefeedDDLHistory(t *testing.T) {
+	t.Parallel()
+
+	ddlHistory := testCase{url: "/api/v2/changefeeds/%s/ddl-history", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	cp.EXPECT().GetEtcdClient().Return(etcdClient, nil).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		ddlHistory.method, fmt.Sprintf(ddlHistory.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// invalid since_ts
+	validID := "changefeed-valid-id"
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		ddlHistory.method, fmt.Sprintf(ddlHistory.url, validID)+"?since_ts=abc", nil)
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// changefeed not exists
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		ddlHistory.method, fmt.Sprintf(ddlHistory.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	statusProvider.err = nil
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{ID: validID}
+	etcdClient.EXPECT().GetChangefeedDDLHistory(gomock.Any(), gomock.Any()).
+		Return(&model.DDLHistory{Entries: []model.DDLHistoryEntry{
+			{Query: "CREATE DATABASE test", CommitTs: 10, Target: "`test`"},
+			{Query: "CREATE TABLE t(id int)", CommitTs: 20, Target: "`test`.`t`"},
+		}}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		ddlHistory.method, fmt.Sprintf(ddlHistory.url, validID)+"?since_ts=11", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedDDLHistory{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, validID, resp.ID)
+	require.Len(t, resp.Entries, 1)
+	require.Equal(t, uint64(20), resp.Entries[0].CommitTs)
+	require.Equal(t, "`test`.`t`", resp.Entries[0].Target)
+}
+
+func TestGetChangefeedBarriers(t *testing.T) {
+	t.Parallel()
+
+	barriers := testCase{url: "/api/v2/changefeeds/%s/barriers", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		barriers.method, fmt.Sprintf(barriers.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// changefeed not exists
+	validID := "changefeed-valid-id"
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		barriers.method, fmt.Sprintf(barriers.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	statusProvider.err = nil
+	statusProvider.barriers = []*model.ChangefeedBarrier{{
+		Type:      model.BarrierTypeDDL,
+		BarrierTs: 20,
+		DDL:       "ALTER TABLE t ADD COLUMN c int",
+		Waiting:   2 * time.Minute,
+		PendingTables: []model.BarrierPendingTable{
+			{TableID: 1, CaptureID: "capture-1", CheckpointTs: 10},
+		},
+	}}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		barriers.method, fmt.Sprintf(barriers.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedBarriers{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, validID, resp.ID)
+	require.Equal(t, []ChangefeedBarrier{{
+		Type:      "ddl",
+		BarrierTs: 20,
+		DDL:       "ALTER TABLE t ADD COLUMN c int",
+		WaitingMs: 120000,
+		PendingTables: []BarrierPendingTable{
+			{TableID: 1, CaptureID: "capture-1", CheckpointTs: 10},
+		},
+	}}, resp.Barriers)
+}
+
+func TestCutoverChangefeed(t *testing.T) {
+	t.Parallel()
+
+	cutover := testCase{url: "/api/v2/changefeeds/%s/cutover", method: "POST"}
+	getCutover := testCase{url: "/api/v2/changefeeds/%s/cutover", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	owner := mock_owner.NewMockOwner(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient, nil).AnyTimes()
+	cp.EXPECT().GetOwner().Return(owner, nil).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	oldID := model.DefaultChangeFeedID("changefeed-blue")
+	newID := model.DefaultChangeFeedID("changefeed-green")
+	doCutover := func(cfg *CutoverChangefeedConfig) *httptest.ResponseRecorder {
+		body, err := json.Marshal(cfg)
+		require.Nil(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			cutover.method, fmt.Sprintf(cutover.url, oldID.ID), bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+	requireErrCode := func(w *httptest.ResponseRecorder, code string) {
+		respErr := model.HTTPError{}
+		err := json.NewDecoder(w.Body).Decode(&respErr)
+		require.Nil(t, err)
+		require.Contains(t, respErr.Code, code)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	}
+
+	// cutover to itself
+	w := doCutover(&CutoverChangefeedConfig{NewChangefeedID: oldID.ID, TargetTs: 100})
+	requireErrCode(w, "ErrAPIInvalidParam")
+
+	// another cutover is pending
+	pending := &model.Cutover{
+		From: oldID, To: model.DefaultChangeFeedID("changefeed-red"),
+		TargetTs: 90, State: model.CutoverStatePending,
+	}
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(pending, nil)
+	w = doCutover(&CutoverChangefeedConfig{NewChangefeedID: newID.ID, TargetTs: 100})
+	requireErrCode(w, "ErrChangefeedCutoverRefused")
+
+	// the new changefeed starts after the target ts
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(nil, nil)
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{State: model.StateNormal, StartTs: 120}
+	w = doCutover(&CutoverChangefeedConfig{NewChangefeedID: newID.ID, TargetTs: 100})
+	requireErrCode(w, "ErrChangefeedCutoverRefused")
+
+	// success
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{State: model.StateNormal, StartTs: 50}
+	statusProvider.changefeedStatus = &model.ChangeFeedStatus{CheckpointTs: 60}
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(nil, nil)
+	owner.EXPECT().SetTargetTs(oldID, uint64(100), gomock.Any()).
+		Do(func(cfID model.ChangeFeedID, targetTs model.Ts, done chan<- error) {
+			done <- nil
+		})
+	var saved *model.Cutover
+	etcdClient.EXPECT().SaveChangefeedCutover(gomock.Any(), oldID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id model.ChangeFeedID, c *model.Cutover) error {
+			saved = c
+			return nil
+		})
+	w = doCutover(&CutoverChangefeedConfig{NewChangefeedID: newID.ID, TargetTs: 100})
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedCutover{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, model.CutoverStatePending, resp.State)
+	require.Equal(t, newID.ID, resp.NewChangefeedID)
+	require.Equal(t, uint64(100), resp.TargetTs)
+	require.Equal(t, uint64(60), resp.NewCheckpointTs)
+	require.Nil(t, resp.CompletedAt)
+	require.Equal(t, newID, saved.To)
+
+	// cutover not found
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(nil, nil)
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		getCutover.method, fmt.Sprintf(getCutover.url, oldID.ID), nil)
+	router.ServeHTTP(w, req)
+	requireErrCode(w, "ErrChangefeedCutoverNotFound")
+
+	// the pending cutover is not completed by the request
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{State: model.StateFinished}
+	statusProvider.changefeedStatus = &model.ChangeFeedStatus{CheckpointTs: 101}
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(saved, nil)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		getCutover.method, fmt.Sprintf(getCutover.url, oldID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ChangefeedCutover{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, model.CutoverStatePending, resp.State)
+	require.Equal(t, model.StateFinished, resp.OldState)
+	require.Equal(t, uint64(101), resp.NewCheckpointTs)
+	require.Nil(t, resp.CompletedAt)
+
+	// the cutover is completed by the owner
+	completedAt := time.Now()
+	completed := &model.Cutover{
+		From: oldID, To: newID, TargetTs: 100, State: model.CutoverStateCompleted,
+		CompletedAt: completedAt, ToCheckpointTs: 101, ModRevision: 7,
+	}
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(completed, nil)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		getCutover.method, fmt.Sprintf(getCutover.url, oldID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ChangefeedCutover{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, model.CutoverStateCompleted, resp.State)
+	require.Equal(t, uint64(101), resp.NewCheckpointTs)
+	require.NotNil(t, resp.CompletedAt)
+
+	// a new cutover replaces the completed one only if it's not changed, and
+	// the target ts isn't set if the cutover fails to be saved
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{State: model.StateNormal, StartTs: 50}
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(completed, nil)
+	etcdClient.EXPECT().SaveChangefeedCutover(gomock.Any(), oldID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id model.ChangeFeedID, c *model.Cutover) error {
+			require.Equal(t, int64(7), c.ModRevision)
+			return cerrors.ErrChangefeedUpdateFailedTransaction.GenWithStackByArgs(id)
+		})
+	w = doCutover(&CutoverChangefeedConfig{NewChangefeedID: newID.ID, TargetTs: 200})
+	requireErrCode(w, "ErrChangefeedCutoverRefused")
+
+	// the saved cutover is rolled back if the target ts fails to be set
+	etcdClient.EXPECT().GetChangefeedCutover(gomock.Any(), oldID).Return(completed, nil)
+	etcdClient.EXPECT().SaveChangefeedCutover(gomock.Any(), oldID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id model.ChangeFeedID, c *model.Cutover) error {
+			require.Equal(t, model.CutoverStatePending, c.State)
+			c.ModRevision = 8
+			return nil
+		})
+	owner.EXPECT().SetTargetTs(oldID, uint64(200), gomock.Any()).
+		Do(func(cfID model.ChangeFeedID, targetTs model.Ts, done chan<- error) {
+			done <- cerrors.ErrChangefeedUpdateRefused.GenWithStackByArgs("target ts is too small")
+		})
+	etcdClient.EXPECT().DeleteChangefeedCutover(gomock.Any(), oldID, int64(8)).Return(nil)
+	w = doCutover(&CutoverChangefeedConfig{NewChangefeedID: newID.ID, TargetTs: 200})
+	requireErrCode(w, "ErrChangefeedUpdateRefused")
 }
 
 func TestVerifyTable(t *testing.T) {
@@ -32,6 +32,42 @@ type Tso struct {
 	LogicTime int64 `json:"logic_time"`
 }
 
+// CertificateStatus is the status of the TLS certificate used by the server
+type CertificateStatus struct {
+	TLSEnabled bool `json:"tls_enabled"`
+	// NotAfter is the expiration time of the loaded certificate.
+	NotAfter time.Time `json:"not_after"`
+	// LoadedAt is the time when the certificate is loaded from disk.
+	LoadedAt time.Time `json:"loaded_at"`
+}
+
+// ServerStatus holds some common information of a server
+type ServerStatus struct {
+	Version   string         `json:"version"`
+	GitHash   string         `json:"git_hash"`
+	ID        string         `json:"id"`
+	ClusterID string         `json:"cluster_id"`
+	Pid       int            `json:"pid"`
+	IsOwner   bool           `json:"is_owner"`
+	Liveness  model.Liveness `json:"liveness"`
+	// SortEngine is nil if the sort engine is not created yet.
+	SortEngine *SortEngineHealth `json:"sort_engine,omitempty"`
+}
+
+// SortEngineHealth is the health of the pebble instances used by the sort engine
+type SortEngineHealth struct {
+	// State is one of normal, slowdown and stalled.
+	State          string `json:"state"`
+	L0FileCount    int64  `json:"l0_file_count"`
+	CompactionDebt uint64 `json:"compaction_debt"`
+	// WriteStallCount and WriteStallDuration are counted since the server starts.
+	WriteStallCount    uint64        `json:"write_stall_count"`
+	WriteStallDuration time.Duration `json:"write_stall_duration"`
+	// IngestDelay is the delay of writing an event into the sort engine,
+	// which is used to throttle pullers when the write stall risk is high.
+	IngestDelay time.Duration `json:"ingest_delay"`
+}
+
 // Tables contains IneligibleTables and EligibleTables
 type Tables struct {
 	IneligibleTables []TableName `json:"ineligible_tables,omitempty"`
@@ -89,20 +125,25 @@ type ChangefeedConfig struct {
 
 // ReplicaConfig is a duplicate of  config.ReplicaConfig
 type ReplicaConfig struct {
-	MemoryQuota           uint64            `json:"memory_quota"`
-	CaseSensitive         bool              `json:"case_sensitive"`
-	EnableOldValue        bool              `json:"enable_old_value"`
-	ForceReplicate        bool              `json:"force_replicate"`
-	IgnoreIneligibleTable bool              `json:"ignore_ineligible_table"`
-	CheckGCSafePoint      bool              `json:"check_gc_safe_point"`
-	EnableSyncPoint       bool              `json:"enable_sync_point"`
-	BDRMode               bool              `json:"bdr_mode"`
-	SyncPointInterval     time.Duration     `json:"sync_point_interval"`
-	SyncPointRetention    time.Duration     `json:"sync_point_retention"`
-	Filter                *FilterConfig     `json:"filter"`
-	Mounter               *MounterConfig    `json:"mounter"`
-	Sink                  *SinkConfig       `json:"sink"`
-	Consistent            *ConsistentConfig `json:"consistent"`
+	MemoryQuota                   uint64            `json:"memory_quota"`
+	CaseSensitive                 bool              `json:"case_sensitive"`
+	EnableOldValue                bool              `json:"enable_old_value"`
+	ForceReplicate                bool              `json:"force_replicate"`
+	IgnoreIneligibleTable         bool              `json:"ignore_ineligible_table"`
+	CheckGCSafePoint              bool              `json:"check_gc_safe_point"`
+	EnableSyncPoint               bool              `json:"enable_sync_point"`
+	BDRMode                       bool              `json:"bdr_mode"`
+	SyncPointInterval             time.Duration     `json:"sync_point_interval"`
+	SyncPointRetention            time.Duration     `json:"sync_point_retention"`
+	Filter                        *FilterConfig     `json:"filter"`
+	Mounter                       *MounterConfig    `json:"mounter"`
+	Sink                          *SinkConfig       `json:"sink"`
+	Consistent                    *ConsistentConfig `json:"consistent"`
+	DDLHistory                    *DDLHistoryConfig `json:"ddl_history"`
+	ResolvedTsInterval            time.Duration     `json:"resolved_ts_interval"`
+	EnableFollowerIncrementalScan bool              `json:"enable_follower_incremental_scan"`
+	DDLPacing                     *DDLPacingConfig  `json:"ddl_pacing"`
+	OldValueTables                []string          `json:"old_value_tables,omitempty"`
 }
 
 // ToInternalReplicaConfig coverts *v2.ReplicaConfig into *config.ReplicaConfig
@@ -111,11 +152,14 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 	res.MemoryQuota = c.MemoryQuota
 	res.CaseSensitive = c.CaseSensitive
 	res.EnableOldValue = c.EnableOldValue
+	res.OldValueTables = c.OldValueTables
 	res.ForceReplicate = c.ForceReplicate
 	res.CheckGCSafePoint = c.CheckGCSafePoint
 	res.EnableSyncPoint = c.EnableSyncPoint
 	res.SyncPointInterval = c.SyncPointInterval
 	res.SyncPointRetention = c.SyncPointRetention
+	res.ResolvedTsInterval = c.ResolvedTsInterval
+	res.EnableFollowerIncrementalScan = c.EnableFollowerIncrementalScan
 	res.BDRMode = c.BDRMode
 
 	if c.Filter != nil {
@@ -165,6 +209,19 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 			Storage:           c.Consistent.Storage,
 		}
 	}
+	if c.DDLHistory != nil {
+		res.DDLHistory = &config.DDLHistoryConfig{
+			MaxCount:  c.DDLHistory.MaxCount,
+			Retention: c.DDLHistory.Retention,
+		}
+	}
+	if c.DDLPacing != nil {
+		res.DDLPacing = &config.DDLPacingConfig{
+			MaxDDLPerMinute:       c.DDLPacing.MaxDDLPerMinute,
+			MaxOutstanding:        c.DDLPacing.MaxOutstanding,
+			QueueWarningThreshold: c.DDLPacing.QueueWarningThreshold,
+		}
+	}
 	if c.Sink != nil {
 		var dispatchRules []*config.DispatchRule
 		for _, rule := range c.Sink.DispatchRules {
@@ -191,18 +248,36 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 				IncludeCommitTs: c.Sink.CSVConfig.IncludeCommitTs,
 			}
 		}
+		var schemaRegistryConfig *config.SchemaRegistryConfig
+		if c.Sink.SchemaRegistryConfig != nil {
+			schemaRegistryConfig = &config.SchemaRegistryConfig{
+				Username:            c.Sink.SchemaRegistryConfig.Username,
+				Password:            c.Sink.SchemaRegistryConfig.Password,
+				BearerToken:         c.Sink.SchemaRegistryConfig.BearerToken,
+				CAPath:              c.Sink.SchemaRegistryConfig.CAPath,
+				CertPath:            c.Sink.SchemaRegistryConfig.CertPath,
+				KeyPath:             c.Sink.SchemaRegistryConfig.KeyPath,
+				SubjectNameStrategy: c.Sink.SchemaRegistryConfig.SubjectNameStrategy,
+			}
+		}
 
 		res.Sink = &config.SinkConfig{
-			DispatchRules:            dispatchRules,
-			Protocol:                 c.Sink.Protocol,
-			CSVConfig:                csvConfig,
-			TxnAtomicity:             config.AtomicityLevel(c.Sink.TxnAtomicity),
-			ColumnSelectors:          columnSelectors,
-			SchemaRegistry:           c.Sink.SchemaRegistry,
-			EncoderConcurrency:       c.Sink.EncoderConcurrency,
-			Terminator:               c.Sink.Terminator,
-			DateSeparator:            c.Sink.DateSeparator,
-			EnablePartitionSeparator: c.Sink.EnablePartitionSeparator,
+			DispatchRules:               dispatchRules,
+			Protocol:                    c.Sink.Protocol,
+			CSVConfig:                   csvConfig,
+			TxnAtomicity:                config.AtomicityLevel(c.Sink.TxnAtomicity),
+			ColumnSelectors:             columnSelectors,
+			SchemaRegistry:              c.Sink.SchemaRegistry,
+			SchemaRegistryConfig:        schemaRegistryConfig,
+			EncoderConcurrency:          c.Sink.EncoderConcurrency,
+			SendConcurrency:             c.Sink.SendConcurrency,
+			SplitUpdateToDeleteInsert:   c.Sink.SplitUpdateToDeleteInsert,
+			ColumnValueSizeLimit:        c.Sink.ColumnValueSizeLimit,
+			RedactLog:                   c.Sink.RedactLog,
+			ErrorLogSampleIntervalInSec: c.Sink.ErrorLogSampleIntervalInSec,
+			Terminator:                  c.Sink.Terminator,
+			DateSeparator:               c.Sink.DateSeparator,
+			EnablePartitionSeparator:    c.Sink.EnablePartitionSeparator,
 		}
 	}
 	if c.Mounter != nil {
@@ -217,16 +292,19 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 	cloned := c.Clone()
 	res := &ReplicaConfig{
-		MemoryQuota:           cloned.MemoryQuota,
-		CaseSensitive:         cloned.CaseSensitive,
-		EnableOldValue:        cloned.EnableOldValue,
-		ForceReplicate:        cloned.ForceReplicate,
-		IgnoreIneligibleTable: false,
-		CheckGCSafePoint:      cloned.CheckGCSafePoint,
-		EnableSyncPoint:       cloned.EnableSyncPoint,
-		SyncPointInterval:     cloned.SyncPointInterval,
-		SyncPointRetention:    cloned.SyncPointRetention,
-		BDRMode:               cloned.BDRMode,
+		MemoryQuota:                   cloned.MemoryQuota,
+		CaseSensitive:                 cloned.CaseSensitive,
+		EnableOldValue:                cloned.EnableOldValue,
+		OldValueTables:                cloned.OldValueTables,
+		ForceReplicate:                cloned.ForceReplicate,
+		IgnoreIneligibleTable:         false,
+		CheckGCSafePoint:              cloned.CheckGCSafePoint,
+		EnableSyncPoint:               cloned.EnableSyncPoint,
+		SyncPointInterval:             cloned.SyncPointInterval,
+		SyncPointRetention:            cloned.SyncPointRetention,
+		ResolvedTsInterval:            cloned.ResolvedTsInterval,
+		EnableFollowerIncrementalScan: cloned.EnableFollowerIncrementalScan,
+		BDRMode:                       cloned.BDRMode,
 	}
 
 	if cloned.Filter != nil {
@@ -295,18 +373,36 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				IncludeCommitTs: cloned.Sink.CSVConfig.IncludeCommitTs,
 			}
 		}
+		var schemaRegistryConfig *SchemaRegistryConfig
+		if cloned.Sink.SchemaRegistryConfig != nil {
+			schemaRegistryConfig = &SchemaRegistryConfig{
+				Username:            cloned.Sink.SchemaRegistryConfig.Username,
+				Password:            cloned.Sink.SchemaRegistryConfig.Password,
+				BearerToken:         cloned.Sink.SchemaRegistryConfig.BearerToken,
+				CAPath:              cloned.Sink.SchemaRegistryConfig.CAPath,
+				CertPath:            cloned.Sink.SchemaRegistryConfig.CertPath,
+				KeyPath:             cloned.Sink.SchemaRegistryConfig.KeyPath,
+				SubjectNameStrategy: cloned.Sink.SchemaRegistryConfig.SubjectNameStrategy,
+			}
+		}
 
 		res.Sink = &SinkConfig{
-			Protocol:                 cloned.Sink.Protocol,
-			SchemaRegistry:           cloned.Sink.SchemaRegistry,
-			DispatchRules:            dispatchRules,
-			CSVConfig:                csvConfig,
-			ColumnSelectors:          columnSelectors,
-			TxnAtomicity:             string(cloned.Sink.TxnAtomicity),
-			EncoderConcurrency:       cloned.Sink.EncoderConcurrency,
-			Terminator:               cloned.Sink.Terminator,
-			DateSeparator:            cloned.Sink.DateSeparator,
-			EnablePartitionSeparator: cloned.Sink.EnablePartitionSeparator,
+			Protocol:                    cloned.Sink.Protocol,
+			SchemaRegistry:              cloned.Sink.SchemaRegistry,
+			SchemaRegistryConfig:        schemaRegistryConfig,
+			DispatchRules:               dispatchRules,
+			CSVConfig:                   csvConfig,
+			ColumnSelectors:             columnSelectors,
+			TxnAtomicity:                string(cloned.Sink.TxnAtomicity),
+			EncoderConcurrency:          cloned.Sink.EncoderConcurrency,
+			SendConcurrency:             cloned.Sink.SendConcurrency,
+			SplitUpdateToDeleteInsert:   cloned.Sink.SplitUpdateToDeleteInsert,
+			ColumnValueSizeLimit:        cloned.Sink.ColumnValueSizeLimit,
+			RedactLog:                   cloned.Sink.RedactLog,
+			ErrorLogSampleIntervalInSec: cloned.Sink.ErrorLogSampleIntervalInSec,
+			Terminator:                  cloned.Sink.Terminator,
+			DateSeparator:               cloned.Sink.DateSeparator,
+			EnablePartitionSeparator:    cloned.Sink.EnablePartitionSeparator,
 		}
 	}
 	if cloned.Consistent != nil {
@@ -317,6 +413,19 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			Storage:           cloned.Consistent.Storage,
 		}
 	}
+	if cloned.DDLHistory != nil {
+		res.DDLHistory = &DDLHistoryConfig{
+			MaxCount:  cloned.DDLHistory.MaxCount,
+			Retention: cloned.DDLHistory.Retention,
+		}
+	}
+	if cloned.DDLPacing != nil {
+		res.DDLPacing = &DDLPacingConfig{
+			MaxDDLPerMinute:       cloned.DDLPacing.MaxDDLPerMinute,
+			MaxOutstanding:        cloned.DDLPacing.MaxOutstanding,
+			QueueWarningThreshold: cloned.DDLPacing.QueueWarningThreshold,
+		}
+	}
 	if cloned.Mounter != nil {
 		res.Mounter = &MounterConfig{
 			WorkerNum: cloned.Mounter.WorkerNum,
@@ -344,6 +453,13 @@ func GetDefaultReplicaConfig() *ReplicaConfig {
 			FlushIntervalInMs: 1000,
 			Storage:           "",
 		},
+		DDLHistory: &DDLHistoryConfig{
+			MaxCount:  100,
+			Retention: 7 * 24 * time.Hour,
+		},
+		DDLPacing: &DDLPacingConfig{
+			QueueWarningThreshold: 1000,
+		},
 	}
 }
 
@@ -442,16 +558,22 @@ type Table struct {
 // SinkConfig represents sink config for a changefeed
 // This is a duplicate of config.SinkConfig
 type SinkConfig struct {
-	Protocol                 string            `json:"protocol"`
-	SchemaRegistry           string            `json:"schema_registry"`
-	CSVConfig                *CSVConfig        `json:"csv"`
-	DispatchRules            []*DispatchRule   `json:"dispatchers,omitempty"`
-	ColumnSelectors          []*ColumnSelector `json:"column_selectors"`
-	TxnAtomicity             string            `json:"transaction_atomicity"`
-	EncoderConcurrency       int               `json:"encoder_concurrency"`
-	Terminator               string            `json:"terminator"`
-	DateSeparator            string            `json:"date_separator"`
-	EnablePartitionSeparator bool              `json:"enable_partition_separator"`
+	Protocol                    string                `json:"protocol"`
+	SchemaRegistry              string                `json:"schema_registry"`
+	SchemaRegistryConfig        *SchemaRegistryConfig `json:"schema_registry_config,omitempty"`
+	CSVConfig                   *CSVConfig            `json:"csv"`
+	DispatchRules               []*DispatchRule       `json:"dispatchers,omitempty"`
+	ColumnSelectors             []*ColumnSelector     `json:"column_selectors"`
+	TxnAtomicity                string                `json:"transaction_atomicity"`
+	EncoderConcurrency          int                   `json:"encoder_concurrency"`
+	SendConcurrency             int                   `json:"send_concurrency"`
+	Terminator                  string                `json:"terminator"`
+	DateSeparator               string                `json:"date_separator"`
+	EnablePartitionSeparator    bool                  `json:"enable_partition_separator"`
+	SplitUpdateToDeleteInsert   string                `json:"split_update_to_delete_insert,omitempty"`
+	ColumnValueSizeLimit        int                   `json:"column_value_size_limit,omitempty"`
+	RedactLog                   string                `json:"redact_log,omitempty"`
+	ErrorLogSampleIntervalInSec int                   `json:"error_log_sample_interval_in_sec,omitempty"`
 }
 
 // CSVConfig denotes the csv config
@@ -463,6 +585,18 @@ type CSVConfig struct {
 	IncludeCommitTs bool   `json:"include_commit_ts"`
 }
 
+// SchemaRegistryConfig denotes the schema registry config
+// This is the same as config.SchemaRegistryConfig
+type SchemaRegistryConfig struct {
+	Username            string `json:"username"`
+	Password            string `json:"password"`
+	BearerToken         string `json:"bearer_token"`
+	CAPath              string `json:"ca_path"`
+	CertPath            string `json:"cert_path"`
+	KeyPath             string `json:"key_path"`
+	SubjectNameStrategy string `json:"subject_name_strategy"`
+}
+
 // DispatchRule represents partition rule for a table
 // This is a duplicate of config.DispatchRule
 type DispatchRule struct {
@@ -487,6 +621,21 @@ type ConsistentConfig struct {
 	Storage           string `json:"storage"`
 }
 
+// DDLHistoryConfig represents the retention of the emitted DDL history of a changefeed
+// This is a duplicate of config.DDLHistoryConfig
+type DDLHistoryConfig struct {
+	MaxCount  int           `json:"max_count"`
+	Retention time.Duration `json:"retention"`
+}
+
+// DDLPacingConfig represents the rate limit of executing DDLs of a changefeed
+// This is a duplicate of config.DDLPacingConfig
+type DDLPacingConfig struct {
+	MaxDDLPerMinute       int `json:"max_ddl_per_minute"`
+	MaxOutstanding        int `json:"max_outstanding"`
+	QueueWarningThreshold int `json:"queue_warning_threshold"`
+}
+
 // EtcdData contains key/value pair of etcd data
 type EtcdData struct {
 	Key   string `json:"key,omitempty"`
@@ -518,6 +667,99 @@ type ChangeFeedInfo struct {
 	State          model.FeedState    `json:"state,omitempty"`
 	Error          *RunningError      `json:"error,omitempty"`
 	CreatorVersion string             `json:"creator_version,omitempty"`
+	// LagBreakdown is only returned when getting the changefeed meta info.
+	LagBreakdown *CheckpointLagBreakdown `json:"checkpoint_lag_breakdown,omitempty"`
+}
+
+// CheckpointLagBreakdown is the progress of each stage of a changefeed, and the
+// lag of each stage behind its upstream stage. A stage not reported by any table,
+// e.g. replicated by captures of an older version, is omitted.
+type CheckpointLagBreakdown struct {
+	CurrentTs        uint64 `json:"current_ts"`
+	PullerResolvedTs uint64 `json:"puller_resolved_ts,omitempty"`
+	SorterOutputTs   uint64 `json:"sorter_output_ts,omitempty"`
+	SinkFlushedTs    uint64 `json:"sink_flushed_ts,omitempty"`
+	// PullerLagMs is the lag of the pullers behind the current time.
+	PullerLagMs int64 `json:"puller_lag_ms,omitempty"`
+	// SorterLagMs is the lag of the sorters behind the pullers.
+	SorterLagMs int64 `json:"sorter_lag_ms,omitempty"`
+	// SinkLagMs is the lag of the sinks behind the sorters.
+	SinkLagMs int64 `json:"sink_lag_ms,omitempty"`
+}
+
+// ChangefeedDDLHistory contains the DDLs emitted by a changefeed
+type ChangefeedDDLHistory struct {
+	Namespace string            `json:"namespace"`
+	ID        string            `json:"id"`
+	Entries   []DDLHistoryEntry `json:"entries"`
+}
+
+// DDLHistoryEntry is a DDL emitted to the downstream by a changefeed
+type DDLHistoryEntry struct {
+	Query      string    `json:"query"`
+	CommitTs   uint64    `json:"commit_ts"`
+	FinishedAt time.Time `json:"finished_at"`
+	Target     string    `json:"target"`
+}
+
+// ChangefeedBarriers contains the barriers which a changefeed is blocked at
+type ChangefeedBarriers struct {
+	Namespace string              `json:"namespace"`
+	ID        string              `json:"id"`
+	Barriers  []ChangefeedBarrier `json:"barriers"`
+}
+
+// ChangefeedBarrier is a barrier which a changefeed is blocked at, the changefeed
+// can't proceed until all its tables reach the barrier.
+type ChangefeedBarrier struct {
+	// Type is one of "ddl", "syncpoint" and "finish".
+	Type      string `json:"type"`
+	BarrierTs uint64 `json:"barrier_ts"`
+	// DDL is the query of the DDL job, it's only set for the "ddl" barrier.
+	DDL string `json:"ddl,omitempty"`
+	// WaitingMs is how long the changefeed has been blocked at the barrier.
+	WaitingMs int64 `json:"waiting_ms"`
+	// PendingTables are the tables which don't reach the barrier yet.
+	PendingTables []BarrierPendingTable `json:"pending_tables,omitempty"`
+}
+
+// BarrierPendingTable is a table which doesn't reach a barrier yet
+type BarrierPendingTable struct {
+	TableID      int64  `json:"table_id"`
+	CaptureID    string `json:"capture_id"`
+	CheckpointTs uint64 `json:"checkpoint_ts"`
+}
+
+// CloneChangefeedConfig is used by clone changefeed api
+type CloneChangefeedConfig struct {
+	// ID is the ID of the new changefeed.
+	ID      string `json:"changefeed_id"`
+	SinkURI string `json:"sink_uri"`
+	// ReplicaConfig is the replica config of the new changefeed, the one of
+	// the cloned changefeed is used if it's not set.
+	ReplicaConfig *ReplicaConfig `json:"replica_config,omitempty"`
+}
+
+// CutoverChangefeedConfig is used by cutover changefeed api
+type CutoverChangefeedConfig struct {
+	NewChangefeedID string `json:"new_changefeed_id"`
+	TargetTs        uint64 `json:"target_ts"`
+}
+
+// ChangefeedCutover is the progress of the cutover from a changefeed to
+// a new changefeed at the target ts
+type ChangefeedCutover struct {
+	Namespace       string             `json:"namespace"`
+	ID              string             `json:"id"`
+	NewChangefeedID string             `json:"new_changefeed_id"`
+	TargetTs        uint64             `json:"target_ts"`
+	State           model.CutoverState `json:"state"`
+	StartedAt       time.Time          `json:"started_at"`
+	CompletedAt     *time.Time         `json:"completed_at,omitempty"`
+	// OldState and OldCheckpointTs are only set when the cutover is pending.
+	OldState        model.FeedState `json:"old_state,omitempty"`
+	OldCheckpointTs uint64          `json:"old_checkpoint_ts,omitempty"`
+	NewCheckpointTs uint64          `json:"new_checkpoint_ts"`
 }
 
 // RunningError represents some running error from cdc components, such as processor.
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	filter "github.com/pingcap/tidb/util/table-filter"
@@ -45,7 +46,12 @@ func TestToAPIReplicaConfig(t *testing.T) {
 			},
 		},
 		SchemaRegistry: "bbb",
-		TxnAtomicity:   "aa",
+		SchemaRegistryConfig: &config.SchemaRegistryConfig{
+			Username:            "user",
+			Password:            "pass",
+			SubjectNameStrategy: config.SubjectNameStrategyRecord,
+		},
+		TxnAtomicity: "aa",
 	}
 	cfg.Consistent = &config.ConsistentConfig{
 		Level:             "1",
@@ -81,6 +87,12 @@ func TestToAPIReplicaConfig(t *testing.T) {
 		}},
 	}
 	cfg.Mounter = &config.MounterConfig{WorkerNum: 11}
+	cfg.DDLHistory = &config.DDLHistoryConfig{MaxCount: 20, Retention: time.Hour}
+	cfg.ResolvedTsInterval = 500 * time.Millisecond
+	cfg.EnableFollowerIncrementalScan = true
+	cfg.DDLPacing = &config.DDLPacingConfig{
+		MaxDDLPerMinute: 60, MaxOutstanding: 2, QueueWarningThreshold: 100,
+	}
 	cfg2 := ToAPIReplicaConfig(cfg).ToInternalReplicaConfig()
 	require.Equal(t, "", cfg2.Sink.DispatchRules[0].DispatcherRule)
 	cfg.Sink.DispatchRules[0].DispatcherRule = ""
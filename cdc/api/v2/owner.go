@@ -0,0 +1,82 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	apiOpVarGraceful = "graceful"
+	apiOpVarTimeout  = "timeout"
+
+	// defaultGracefulResignTimeout is the max time the owner waits for the
+	// in-flight DDLs and admin jobs before resigning gracefully.
+	defaultGracefulResignTimeout = 30 * time.Second
+)
+
+// resignOwner makes the current owner resign. If `graceful` is true, the owner
+// waits for the in-flight DDLs and the pending admin jobs of changefeeds to
+// finish, at most `timeout`, and persists handoff markers before resigning,
+// so that the new owner doesn't re-execute the DDLs.
+func (h *OpenAPIV2) resignOwner(c *gin.Context) {
+	graceful := false
+	if s := c.Query(apiOpVarGraceful); s != "" {
+		var err error
+		graceful, err = strconv.ParseBool(s)
+		if err != nil {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid graceful: %s", s))
+			return
+		}
+	}
+	timeout := defaultGracefulResignTimeout
+	if s := c.Query(apiOpVarTimeout); s != "" {
+		var err error
+		timeout, err = time.ParseDuration(s)
+		if err != nil || timeout <= 0 {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid timeout: %s", s))
+			return
+		}
+	}
+
+	o, _ := h.capture.GetOwner()
+	if o == nil {
+		c.Status(http.StatusAccepted)
+		return
+	}
+	if !graceful {
+		o.AsyncStop()
+		c.Status(http.StatusAccepted)
+		return
+	}
+
+	done := make(chan error, 1)
+	o.GracefulResign(timeout, done)
+	select {
+	case <-c.Request.Context().Done():
+		_ = c.Error(c.Request.Context().Err())
+		return
+	case err := <-done:
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+	c.Status(http.StatusAccepted)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResignOwner(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	o := mock_owner.NewMockOwner(ctrl)
+	apiV2 := NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(ctrl))
+	router := newRouter(apiV2)
+
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(o, nil).AnyTimes()
+
+	// case 1: invalid parameters
+	for _, url := range []string{
+		"/api/v2/owner/resign?graceful=abc",
+		"/api/v2/owner/resign?graceful=true&timeout=abc",
+		"/api/v2/owner/resign?graceful=true&timeout=-1s",
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, url, nil)
+		router.ServeHTTP(w, req)
+		respErr := model.HTTPError{}
+		err := json.NewDecoder(w.Body).Decode(&respErr)
+		require.Nil(t, err)
+		require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	}
+
+	// case 2: resign immediately
+	o.EXPECT().AsyncStop().Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/owner/resign", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	// case 3: resign gracefully
+	o.EXPECT().GracefulResign(10*time.Second, gomock.Any()).
+		Do(func(timeout time.Duration, done chan<- error) {
+			close(done)
+		}).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/owner/resign?graceful=true&timeout=10s", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	// case 4: the owner is stopped before resigning gracefully
+	o.EXPECT().GracefulResign(defaultGracefulResignTimeout, gomock.Any()).
+		Do(func(timeout time.Duration, done chan<- error) {
+			done <- cerror.ErrOwnerNotFound.GenWithStackByArgs()
+			close(done)
+		}).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/owner/resign?graceful=true", nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrOwnerNotFound")
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// getCertificateStatus returns the status of the TLS certificate loaded by
+// this server, so that the expiration can be monitored.
+func (h *OpenAPIV2) getCertificateStatus(c *gin.Context) {
+	resp := &CertificateStatus{}
+	reloader := config.GetGlobalServerConfig().Security.CertReloader()
+	if reloader != nil {
+		resp.TLSEnabled = true
+		resp.NotAfter = reloader.NotAfter()
+		resp.LoadedAt = reloader.LoadedAt()
+	}
+	c.IndentedJSON(http.StatusOK, resp)
+}
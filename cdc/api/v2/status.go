@@ -0,0 +1,57 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/pkg/version"
+)
+
+// serverStatus returns the status of this server, including the health of
+// its sort engine, so that write stalls of the sort engine can be monitored.
+func (h *OpenAPIV2) serverStatus(c *gin.Context) {
+	info, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	etcdClient, err := h.capture.GetEtcdClient()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status := &ServerStatus{
+		Version:   version.ReleaseVersion,
+		GitHash:   version.GitHash,
+		Pid:       os.Getpid(),
+		ID:        info.ID,
+		ClusterID: etcdClient.GetClusterID(),
+		IsOwner:   h.capture.IsOwner(),
+		Liveness:  h.capture.Liveness(),
+	}
+	if health, ok := h.capture.GetSortEngineHealth(); ok {
+		status.SortEngine = &SortEngineHealth{
+			State:              string(health.State),
+			L0FileCount:        health.L0FileCount,
+			CompactionDebt:     health.CompactionDebt,
+			WriteStallCount:    health.WriteStallCount,
+			WriteStallDuration: health.WriteStallDuration,
+			IngestDelay:        health.IngestDelay,
+		}
+	}
+	c.IndentedJSON(http.StatusOK, status)
+}
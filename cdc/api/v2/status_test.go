@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerStatus(t *testing.T) {
+	t.Parallel()
+
+	status := testCase{url: "/api/v2/status", method: "GET"}
+
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	etcdClient.EXPECT().GetClusterID().Return("default").AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+	cp.EXPECT().Liveness().Return(model.LivenessCaptureAlive).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient, nil).AnyTimes()
+
+	// case 1: the sort engine is not created.
+	cp.EXPECT().GetSortEngineHealth().Return(factory.Health{}, false).Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), status.method, status.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ServerStatus{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, "capture-1", resp.ID)
+	require.Equal(t, "default", resp.ClusterID)
+	require.True(t, resp.IsOwner)
+	require.Nil(t, resp.SortEngine)
+
+	// case 2: the sort engine is stalled.
+	cp.EXPECT().GetSortEngineHealth().Return(factory.Health{
+		State:              factory.HealthStateStalled,
+		L0FileCount:        400,
+		CompactionDebt:     1 << 30,
+		WriteStallCount:    2,
+		WriteStallDuration: 3 * time.Second,
+		IngestDelay:        100 * time.Millisecond,
+	}, true).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), status.method, status.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ServerStatus{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, &SortEngineHealth{
+		State:              "stalled",
+		L0FileCount:        400,
+		CompactionDebt:     1 << 30,
+		WriteStallCount:    2,
+		WriteStallDuration: 3 * time.Second,
+		IngestDelay:        100 * time.Millisecond,
+	}, resp.SortEngine)
+}
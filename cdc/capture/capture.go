@@ -72,6 +72,9 @@ type Capture interface {
 	// IsReady returns if the cdc server is ready
 	// currently only check if ettcd data migration is done
 	IsReady() bool
+	// GetSortEngineHealth returns the health of the sort engine of the capture,
+	// it returns false if the sort engine is not created.
+	GetSortEngineHealth() (factory.Health, bool)
 }
 
 type captureImpl struct {
@@ -753,3 +756,11 @@ func (c *captureImpl) IsReady() bool {
 	defer c.captureMu.Unlock()
 	return c.initialized && c.migrator.IsMigrateDone()
 }
+
+// GetSortEngineHealth implements Capture interface.
+func (c *captureImpl) GetSortEngineHealth() (factory.Health, bool) {
+	if c.sortEngineFactory == nil {
+		return factory.Health{}, false
+	}
+	return c.sortEngineFactory.Health()
+}
@@ -12,6 +12,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/pingcap/tiflow/cdc/model"
 	owner "github.com/pingcap/tiflow/cdc/owner"
+	factory "github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
 	etcd "github.com/pingcap/tiflow/pkg/etcd"
 	upstream "github.com/pingcap/tiflow/pkg/upstream"
 )
@@ -110,6 +111,21 @@ func (mr *MockCaptureMockRecorder) GetOwnerCaptureInfo(ctx interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerCaptureInfo", reflect.TypeOf((*MockCapture)(nil).GetOwnerCaptureInfo), ctx)
 }
 
+// GetSortEngineHealth mocks base method.
+func (m *MockCapture) GetSortEngineHealth() (factory.Health, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSortEngineHealth")
+	ret0, _ := ret[0].(factory.Health)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSortEngineHealth indicates an expected call of GetSortEngineHealth.
+func (mr *MockCaptureMockRecorder) GetSortEngineHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSortEngineHealth", reflect.TypeOf((*MockCapture)(nil).GetSortEngineHealth))
+}
+
 // GetUpstreamManager mocks base method.
 func (m *MockCapture) GetUpstreamManager() (*upstream.Manager, error) {
 	m.ctrl.T.Helper()
@@ -70,7 +70,7 @@ type Mounter interface {
 type mounter struct {
 	schemaStorage                SchemaStorage
 	tz                           *time.Location
-	enableOldValue               bool
+	oldValue                     *pfilter.OldValueMatcher
 	changefeedID                 model.ChangeFeedID
 	filter                       pfilter.Filter
 	metricTotalRows              prometheus.Gauge
@@ -82,13 +82,13 @@ func NewMounter(schemaStorage SchemaStorage,
 	changefeedID model.ChangeFeedID,
 	tz *time.Location,
 	filter pfilter.Filter,
-	enableOldValue bool,
+	oldValue *pfilter.OldValueMatcher,
 ) Mounter {
 	return &mounter{
-		schemaStorage:  schemaStorage,
-		changefeedID:   changefeedID,
-		enableOldValue: enableOldValue,
-		filter:         filter,
+		schemaStorage: schemaStorage,
+		changefeedID:  changefeedID,
+		oldValue:      oldValue,
+		filter:        filter,
 		metricTotalRows: totalRowsCountGauge.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricIgnoredDMLEventCounter: ignoredDMLEventCounter.
@@ -152,6 +152,12 @@ func (m *mounter) unmarshalAndMountRowChanged(ctx context.Context, raw *model.Ra
 				log.Debug("skip the DML of truncated table", zap.Uint64("ts", raw.CRTs), zap.Int64("tableID", physicalTableID))
 				return nil, nil
 			}
+			if _, dropped := snap.TableDroppedTs(physicalTableID); dropped {
+				// The table span of a dropped table is retiring, its DMLs are
+				// not replicated anymore instead of failing the changefeed.
+				log.Debug("skip the DML of dropped table", zap.Uint64("ts", raw.CRTs), zap.Int64("tableID", physicalTableID))
+				return nil, nil
+			}
 			return nil, cerror.ErrSnapshotTableNotFound.GenWithStackByArgs(physicalTableID)
 		}
 		if bytes.HasPrefix(key, recordPrefix) {
@@ -326,20 +332,25 @@ func (m *mounter) mountRowKVEntry(tableInfo *model.TableInfo, row *rowKVEntry, d
 	var rawRow model.RowChangedDatums
 	// Since we now always use old value internally,
 	// we need to control the output(sink will use the PreColumns field to determine whether to output old value).
-	// Normally old value is output when only enableOldValue is on,
+	// Normally old value is output only when the old value of the table is enabled,
 	// but for the Delete event, when the old value feature is off,
 	// the HandleKey column needs to be included as well. So we need to do the following filtering.
+	enableOldValue := m.oldValue.Enabled(tableInfo.TableName.Schema, tableInfo.TableName.Table)
+	// omitOldValue indicates the update event is delivered without old value,
+	// which happens when only the old values of some other tables are enabled.
+	omitOldValue := !enableOldValue && m.oldValue.IsMixed() && row.PreRowExist && row.RowExist
 	if row.PreRowExist {
 		// FIXME(leoppro): using pre table info to mounter pre column datum
 		// the pre column and current column in one event may using different table info
-		preCols, preRawCols, err = datum2Column(tableInfo, row.PreRow, m.enableOldValue)
+		preCols, preRawCols, err = datum2Column(tableInfo, row.PreRow, enableOldValue)
 		if err != nil {
 			return nil, rawRow, errors.Trace(err)
 		}
 
 		// NOTICE: When the old Value feature is off,
-		// the Delete event only needs to keep the handle key column.
-		if row.Delete && !m.enableOldValue {
+		// the Delete event and the update event without old value
+		// only need to keep the handle key column.
+		if (row.Delete && !enableOldValue) || omitOldValue {
 			for i := range preCols {
 				col := preCols[i]
 				if col != nil && !col.Flag.IsHandleKey() {
@@ -384,6 +395,7 @@ func (m *mounter) mountRowKVEntry(tableInfo *model.TableInfo, row *rowKVEntry, d
 		PreColumns:          preCols,
 		IndexColumns:        tableInfo.IndexColumnsOffset,
 		ApproximateDataSize: dataSize,
+		OmitOldValue:        omitOldValue,
 	}, rawRow, nil
 }
 
@@ -32,11 +32,11 @@ type MounterGroup interface {
 }
 
 type mounterGroup struct {
-	schemaStorage  SchemaStorage
-	inputCh        []chan *model.PolymorphicEvent
-	tz             *time.Location
-	filter         filter.Filter
-	enableOldValue bool
+	schemaStorage SchemaStorage
+	inputCh       []chan *model.PolymorphicEvent
+	tz            *time.Location
+	filter        filter.Filter
+	oldValue      *filter.OldValueMatcher
 
 	workerNum int
 	index     uint64
@@ -54,7 +54,7 @@ const (
 func NewMounterGroup(
 	schemaStorage SchemaStorage,
 	workerNum int,
-	enableOldValue bool,
+	oldValue *filter.OldValueMatcher,
 	filter filter.Filter,
 	tz *time.Location,
 	changefeedID model.ChangeFeedID,
@@ -67,11 +67,11 @@ func NewMounterGroup(
 		inputCh[i] = make(chan *model.PolymorphicEvent, defaultInputChanSize)
 	}
 	return &mounterGroup{
-		schemaStorage:  schemaStorage,
-		inputCh:        inputCh,
-		enableOldValue: enableOldValue,
-		filter:         filter,
-		tz:             tz,
+		schemaStorage: schemaStorage,
+		inputCh:       inputCh,
+		oldValue:      oldValue,
+		filter:        filter,
+		tz:            tz,
 
 		workerNum: workerNum,
 
@@ -94,7 +94,7 @@ func (m *mounterGroup) Run(ctx context.Context) error {
 }
 
 func (m *mounterGroup) runWorker(ctx context.Context, index int) error {
-	mounter := NewMounter(m.schemaStorage, m.changefeedID, m.tz, m.filter, m.enableOldValue)
+	mounter := NewMounter(m.schemaStorage, m.changefeedID, m.tz, m.filter, m.oldValue)
 	rawCh := m.inputCh[index]
 	metrics := mounterGroupInputChanSizeGauge.
 		WithLabelValues(m.changefeedID.Namespace, m.changefeedID.ID, strconv.Itoa(index))
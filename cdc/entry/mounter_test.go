@@ -305,7 +305,7 @@ func testMounterDisableOldValue(t *testing.T, tc struct {
 	require.Nil(t, err)
 	mounter := NewMounter(scheamStorage,
 		model.DefaultChangeFeedID("c1"),
-		time.UTC, filter, false).(*mounter)
+		time.UTC, filter, pfilter.NewOldValueMatcherForAll(false)).(*mounter)
 	mounter.tz = time.Local
 	ctx := context.Background()
 
@@ -1012,7 +1012,7 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 
 	ts := schemaStorage.GetLastSnapshot().CurrentTs()
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
-	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, true).(*mounter)
+	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, pfilter.NewOldValueMatcherForAll(true)).(*mounter)
 
 	type testCase struct {
 		schema  string
@@ -1267,3 +1267,73 @@ func TestNewDMRowChange(t *testing.T) {
 		require.Equal(t, []interface{}{1, 2, 1, 2}, argsGot)
 	}
 }
+
+func TestMountRowKVEntryOldValueTables(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.EnableOldValue = false
+	cfg.OldValueTables = []string{"test.t1"}
+	oldValue, err := pfilter.NewOldValueMatcher(cfg)
+	require.NoError(t, err)
+	m := &mounter{oldValue: oldValue}
+
+	p := parser.New()
+	newTableInfo := func(name string) *model.TableInfo {
+		stmt, err := p.ParseOneStmt(
+			"CREATE TABLE "+name+" (id INT PRIMARY KEY CLUSTERED, a INT)", "", "")
+		require.NoError(t, err)
+		ti, err := ddl.BuildTableInfoFromAST(stmt.(*ast.CreateTableStmt))
+		require.NoError(t, err)
+		return model.WrapTableInfo(1, "test", 0, ti)
+	}
+	newRow := func(tableInfo *model.TableInfo, isDelete bool) *rowKVEntry {
+		idCol, aCol := tableInfo.Columns[0].ID, tableInfo.Columns[1].ID
+		row := &rowKVEntry{
+			PreRow: map[int64]types.Datum{
+				idCol: types.NewIntDatum(1), aCol: types.NewIntDatum(2),
+			},
+			PreRowExist: true,
+			baseKVEntry: baseKVEntry{
+				RecordID: tidbkv.IntHandle(1),
+				Delete:   isDelete,
+			},
+		}
+		if !isDelete {
+			row.Row = map[int64]types.Datum{
+				idCol: types.NewIntDatum(1), aCol: types.NewIntDatum(3),
+			}
+			row.RowExist = true
+		}
+		return row
+	}
+
+	// The old values of t1 are enabled.
+	t1 := newTableInfo("t1")
+	for _, isDelete := range []bool{false, true} {
+		row, _, err := m.mountRowKVEntry(t1, newRow(t1, isDelete), 0)
+		require.NoError(t, err)
+		require.False(t, row.OmitOldValue)
+		require.Len(t, row.PreColumns, 2)
+		require.NotNil(t, row.PreColumns[0])
+		require.NotNil(t, row.PreColumns[1])
+		require.Equal(t, int64(2), row.PreColumns[1].Value)
+	}
+
+	// The old values of t2 are disabled, only the handle key columns are kept.
+	t2 := newTableInfo("t2")
+	row, _, err := m.mountRowKVEntry(t2, newRow(t2, false), 0)
+	require.NoError(t, err)
+	require.True(t, row.OmitOldValue)
+	require.True(t, row.IsUpdate())
+	require.Len(t, row.PreColumns, 2)
+	require.Equal(t, int64(1), row.PreColumns[0].Value)
+	require.Nil(t, row.PreColumns[1])
+	require.Equal(t, int64(3), row.Columns[1].Value)
+
+	row, _, err = m.mountRowKVEntry(t2, newRow(t2, true), 0)
+	require.NoError(t, err)
+	require.False(t, row.OmitOldValue)
+	require.True(t, row.IsDelete())
+	require.Nil(t, row.PreColumns[1])
+}
@@ -391,6 +391,23 @@ func (s *Snapshot) IsTruncateTableID(id int64) bool {
 	return ok && s.inner.truncatedTables.Has(newVersionedID(id, tag))
 }
 
+// TableDroppedTs returns the finished ts of the DDL which drops the physical
+// table, e.g. dropping the table, its partition or its schema, and truncating
+// the table or its partition. The second returned value is false if the table
+// isn't dropped, or it's unknown to the snapshot.
+func (s *Snapshot) TableDroppedTs(id int64) (uint64, bool) {
+	s.rwlock.RLock()
+	defer s.rwlock.RUnlock()
+	if _, ok := s.inner.tableTagByID(id, false); ok {
+		return 0, false
+	}
+	tag, ok := s.inner.tableTagByID(id, true)
+	if !ok {
+		return 0, false
+	}
+	return negative(tag), true
+}
+
 // IsIneligibleTableID returns true if the table is ineligible.
 func (s *Snapshot) IsIneligibleTableID(id int64) bool {
 	s.rwlock.RLock()
@@ -173,8 +173,14 @@ func TestTable(t *testing.T) {
 		_, ok = snap.PhysicalTableByID(11 + 65536)
 		require.False(t, ok)
 		require.True(t, snap.IsTruncateTableID(11))
+		var droppedTs uint64
+		droppedTs, ok = snap.TableDroppedTs(11)
+		require.True(t, ok)
+		require.Equal(t, uint64(180), droppedTs)
 		_, ok = snap.PhysicalTableByID(12)
 		require.True(t, ok)
+		_, ok = snap.TableDroppedTs(12)
+		require.False(t, ok)
 		_, ok = snap.PhysicalTableByID(12 + 65536)
 		require.True(t, ok)
 		_, ok = snap.TableByName("DB_1", "TB_12")
@@ -195,6 +201,15 @@ func TestTable(t *testing.T) {
 		require.False(t, ok)
 		_, ok = snap.TableByName("DB_1", "TB_12")
 		require.False(t, ok)
+		droppedTs, ok = snap.TableDroppedTs(12)
+		require.True(t, ok)
+		require.Equal(t, uint64(200), droppedTs)
+		droppedTs, ok = snap.TableDroppedTs(12 + 65536)
+		require.True(t, ok)
+		require.Equal(t, uint64(200), droppedTs)
+		// unknown tables are not dropped.
+		_, ok = snap.TableDroppedTs(13)
+		require.False(t, ok)
 		if !forceReplicate {
 			require.False(t, snap.IsIneligibleTableID(12))
 			require.False(t, snap.IsIneligibleTableID(12+65536))
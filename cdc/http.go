@@ -55,6 +55,10 @@ func RegisterRoutes(
 	// Log API
 	router.POST("/admin/log", gin.WrapF(owner.HandleAdminLogLevel))
 
+	// Sink log redaction API
+	router.GET("/debug/sink/log-redaction", gin.WrapF(owner.HandleSinkLogRedaction))
+	router.POST("/debug/sink/log-redaction", gin.WrapF(owner.HandleSinkLogRedaction))
+
 	// pprof debug API
 	pprofGroup := router.Group("/debug/pprof/")
 	pprofGroup.GET("", gin.WrapF(pprof.Index))
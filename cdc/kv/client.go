@@ -20,6 +20,7 @@ import (
 	"io"
 	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -208,6 +209,10 @@ type CDCKVClient interface {
 	ResolvedTs() model.Ts
 	// CommitTs returns the current ingress commit ts.
 	CommitTs() model.Ts
+	// Throttle returns whether the event feeds are held back by the upstream
+	// fetch limits, e.g. the region scan limit and the region retry rate
+	// limit, and the reason if they are.
+	Throttle() (throttled bool, reason string)
 }
 
 // NewCDCKVClient is the constructor of CDC KV client
@@ -234,6 +239,11 @@ type CDCClient struct {
 		sync.Mutex
 		counts *list.List
 	}
+	// sessions are the running event feed sessions.
+	sessions struct {
+		sync.Mutex
+		list *list.List
+	}
 	ingressCommitTs   model.Ts
 	ingressResolvedTs model.Ts
 	// filterLoop is used in BDR mode, when it is true, tikv cdc component
@@ -274,6 +284,12 @@ func NewCDCClient(
 		}{
 			counts: list.New(),
 		},
+		sessions: struct {
+			sync.Mutex
+			list *list.List
+		}{
+			list: list.New(),
+		},
 		filterLoop: filterLoop,
 	}
 	return
@@ -336,6 +352,14 @@ func (c *CDCClient) EventFeed(
 	c.regionCounts.Unlock()
 	s := newEventFeedSession(
 		ctx, c, span, lockResolver, ts, eventCh, c.changefeed, c.tableID, c.tableName)
+	c.sessions.Lock()
+	e := c.sessions.list.PushBack(s)
+	c.sessions.Unlock()
+	defer func() {
+		c.sessions.Lock()
+		c.sessions.list.Remove(e)
+		c.sessions.Unlock()
+	}()
 	return s.eventFeed(ctx, ts, &regionCount)
 }
 
@@ -361,6 +385,38 @@ func (c *CDCClient) CommitTs() model.Ts {
 	return atomic.LoadUint64(&c.ingressCommitTs)
 }
 
+// Throttle returns whether the event feeds are held back by the upstream
+// fetch limits, and the reason if they are.
+func (c *CDCClient) Throttle() (throttled bool, reason string) {
+	c.sessions.Lock()
+	defer c.sessions.Unlock()
+
+	rateLimited, waiting := 0, 0
+	for e := c.sessions.list.Front(); e != nil; e = e.Next() {
+		s := e.Value.(*eventFeedSession)
+		rateLimited += int(atomic.LoadInt64(&s.rateLimitedRegions))
+		waiting += s.regionRouter.Buffered()
+	}
+	return throttleReason(rateLimited, waiting, c.config.RegionScanLimit)
+}
+
+func throttleReason(rateLimited, waiting, regionScanLimit int) (bool, string) {
+	var reasons []string
+	if rateLimited > 0 {
+		reasons = append(reasons,
+			fmt.Sprintf("%d regions are rate limited on retrying", rateLimited))
+	}
+	if waiting > 0 {
+		reasons = append(reasons,
+			fmt.Sprintf("%d regions are waiting for the region scan limit %d",
+				waiting, regionScanLimit))
+	}
+	if len(reasons) == 0 {
+		return false, ""
+	}
+	return true, strings.Join(reasons, ", ")
+}
+
 var currentID uint64 = 0
 
 func allocID() uint64 {
@@ -377,6 +433,11 @@ type eventFeedSession struct {
 
 	lockResolver txnutil.LockResolver
 
+	// The start ts of the event feed, regions requested at it need incremental scan.
+	startTs uint64
+	// Whether to send the incremental scan requests to followers, it's only
+	// enabled if all the TiKV stores support it.
+	followerIncrementalScan bool
 	// The whole range that is being subscribed.
 	totalSpan tablepb.Span
 
@@ -394,6 +455,8 @@ type eventFeedSession struct {
 	requestRangeCh chan rangeRequestTask
 	// The queue is used to store region that reaches limit
 	rateLimitQueue []regionErrorInfo
+	// The length of rateLimitQueue, it's read by other goroutines.
+	rateLimitedRegions int64
 
 	rangeLock *regionlock.RegionRangeLock
 
@@ -438,6 +501,7 @@ func newEventFeedSession(
 		changefeed.Namespace+"."+changefeed.ID)
 	return &eventFeedSession{
 		client:            client,
+		startTs:           startTs,
 		totalSpan:         totalSpan,
 		eventCh:           eventCh,
 		regionRouter:      NewSizedRegionRouter(ctx, client.config.RegionScanLimit),
@@ -471,6 +535,20 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64, regionCount
 	eventFeedGauge.Inc()
 	defer eventFeedGauge.Dec()
 
+	if s.client.config.EnableFollowerIncrementalScan {
+		ok, err := version.CheckFollowerIncrementalScan(ctx, s.client.pd)
+		if err != nil || !ok {
+			log.Warn("follower incremental scan is not supported by TiKV, "+
+				"send the incremental scan requests to leaders",
+				zap.String("namespace", s.changefeed.Namespace),
+				zap.String("changefeed", s.changefeed.ID),
+				zap.Int64("tableID", s.tableID),
+				zap.String("tableName", s.tableName),
+				zap.Error(err))
+		}
+		s.followerIncrementalScan = err == nil && ok
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
@@ -542,6 +620,7 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64, regionCount
 				}
 				// rate limit triggers, add the error info to the rate limit queue.
 				s.rateLimitQueue = append(s.rateLimitQueue, errInfo)
+				atomic.StoreInt64(&s.rateLimitedRegions, int64(len(s.rateLimitQueue)))
 			}
 		}
 	})
@@ -876,7 +955,11 @@ func (s *eventFeedSession) dispatchRequest(ctx context.Context) error {
 			return errors.Trace(ctx.Err())
 		}
 
-		rpcCtx, err := s.getRPCContextForRegion(ctx, sri.verID)
+		// Send the incremental scan of a new event feed to a follower to offload
+		// the leader, the region will be handed over to the leader after that.
+		followerRead := s.followerIncrementalScan &&
+			!sri.leaderOnly && sri.resolvedTs == s.startTs
+		rpcCtx, err := s.getRPCContextForRegion(ctx, sri.verID, followerRead)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -895,6 +978,7 @@ func (s *eventFeedSession) dispatchRequest(ctx context.Context) error {
 			continue
 		}
 		sri.rpcCtx = rpcCtx
+		sri.followerRead = followerRead
 		s.regionRouter.AddRegion(sri)
 	}
 }
@@ -1003,6 +1087,7 @@ func (s *eventFeedSession) handleRateLimit(ctx context.Context) {
 	} else {
 		s.rateLimitQueue = append(make([]regionErrorInfo, 0, len(s.rateLimitQueue)-i-1), s.rateLimitQueue[i+1:]...)
 	}
+	atomic.StoreInt64(&s.rateLimitedRegions, int64(len(s.rateLimitQueue)))
 }
 
 // checkRateLimit checks whether a region can be reconnected based on its rate limiter
@@ -1018,6 +1103,12 @@ func (s *eventFeedSession) checkRateLimit(regionID uint64) bool {
 // CAUTION: Note that this should only be invoked in a context that the region is not locked, otherwise use onRegionFail
 // instead.
 func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorInfo) error {
+	if errInfo.followerRead {
+		// The region fails or finishes the incremental scan on a follower,
+		// always request it from the leader later.
+		errInfo.followerRead = false
+		errInfo.leaderOnly = true
+	}
 	err := errInfo.err
 	switch eerr := errors.Cause(err).(type) {
 	case *eventError:
@@ -1066,6 +1157,12 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 		return nil
 	case *connectToStoreErr:
 		metricConnectToStoreErr.Inc()
+	case *followerScanFinishedErr:
+		log.Debug("hand over region to leader after incremental scan on follower",
+			zap.String("namespace", s.changefeed.Namespace),
+			zap.String("changefeed", s.changefeed.ID),
+			zap.Uint64("regionID", errInfo.verID.GetID()),
+			zap.Uint64("resolvedTs", errInfo.resolvedTs))
 	case *sendRequestToStoreErr:
 		metricStoreSendRequestErr.Inc()
 	default:
@@ -1082,10 +1179,16 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 	return nil
 }
 
-func (s *eventFeedSession) getRPCContextForRegion(ctx context.Context, id tikv.RegionVerID) (*tikv.RPCContext, error) {
+func (s *eventFeedSession) getRPCContextForRegion(
+	ctx context.Context, id tikv.RegionVerID, followerRead bool,
+) (*tikv.RPCContext, error) {
 	// todo: add metrics to track rpc cost
 	bo := tikv.NewBackoffer(ctx, tikvRequestMaxBackoff)
-	rpcCtx, err := s.client.regionCache.GetTiKVRPCContext(bo, id, tidbkv.ReplicaReadLeader, 0)
+	replicaRead, followerStoreSeed := tidbkv.ReplicaReadLeader, uint32(0)
+	if followerRead {
+		replicaRead, followerStoreSeed = tidbkv.ReplicaReadFollower, rand.Uint32()
+	}
+	rpcCtx, err := s.client.regionCache.GetTiKVRPCContext(bo, id, replicaRead, followerStoreSeed)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrGetTiKVRPCContext, err)
 	}
@@ -1460,3 +1563,9 @@ func (e *connectToStoreErr) Error() string { return "connect to store error" }
 type sendRequestToStoreErr struct{}
 
 func (e *sendRequestToStoreErr) Error() string { return "send request to store error" }
+
+// followerScanFinishedErr is used to hand over a region to its leader
+// after the incremental scan on a follower is finished.
+type followerScanFinishedErr struct{}
+
+func (e *followerScanFinishedErr) Error() string { return "incremental scan on follower finished" }
@@ -3610,9 +3610,11 @@ func TestHandleRateLimit(t *testing.T) {
 	session.handleRateLimit(ctx)
 	require.Len(t, session.rateLimitQueue, 1)
 	require.Equal(t, 1, cap(session.rateLimitQueue))
+	require.Equal(t, int64(1), atomic.LoadInt64(&session.rateLimitedRegions))
 	session.handleRateLimit(ctx)
 	require.Len(t, session.rateLimitQueue, 0)
 	require.Equal(t, 128, cap(session.rateLimitQueue))
+	require.Equal(t, int64(0), atomic.LoadInt64(&session.rateLimitedRegions))
 }
 
 func TestRegionErrorInfoLogRateLimitedHint(t *testing.T) {
@@ -3630,3 +3632,20 @@ func TestRegionErrorInfoLogRateLimitedHint(t *testing.T) {
 	require.True(t, errInfo.logRateLimitedHint())
 	require.False(t, errInfo.logRateLimitedHint())
 }
+
+func TestThrottleReason(t *testing.T) {
+	t.Parallel()
+
+	throttled, reason := throttleReason(0, 0, 40)
+	require.False(t, throttled)
+	require.Empty(t, reason)
+
+	throttled, reason = throttleReason(2, 0, 40)
+	require.True(t, throttled)
+	require.Equal(t, "2 regions are rate limited on retrying", reason)
+
+	throttled, reason = throttleReason(2, 5, 40)
+	require.True(t, throttled)
+	require.Equal(t, "2 regions are rate limited on retrying, "+
+		"5 regions are waiting for the region scan limit 40", reason)
+}
@@ -102,6 +102,14 @@ var (
 			Help:      "active stream count of each gRPC connection",
 		}, []string{"store"})
 
+	incrementalScanBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "kvclient",
+			Name:      "incremental_scan_bytes",
+			Help:      "bytes of events received during incremental scan",
+		}, []string{"role", "namespace", "changefeed"})
+
 	regionEventsBatchSize = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "ticdc",
@@ -126,6 +134,7 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(batchResolvedEventSize)
 	registry.MustRegister(grpcPoolStreamGauge)
 	registry.MustRegister(regionEventsBatchSize)
+	registry.MustRegister(incrementalScanBytes)
 
 	// Register client metrics to registry.
 	registry.MustRegister(grpcMetrics)
@@ -33,6 +33,10 @@ type singleRegionInfo struct {
 	span       tablepb.Span
 	resolvedTs uint64
 	rpcCtx     *tikv.RPCContext
+	// followerRead is true if the request is sent to a follower for incremental scan.
+	followerRead bool
+	// leaderOnly is true if the region should always be requested from its leader.
+	leaderOnly bool
 }
 
 func newSingleRegionInfo(
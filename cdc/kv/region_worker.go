@@ -73,6 +73,10 @@ type regionWorkerMetrics struct {
 	metricSendEventCommitCounter      prometheus.Counter
 	metricSendEventCommittedCounter   prometheus.Counter
 
+	// incremental scan related metrics
+	metricLeaderScanBytes   prometheus.Counter
+	metricFollowerScanBytes prometheus.Counter
+
 	// TODO: add region runtime related metrics
 }
 
@@ -136,6 +140,10 @@ func newRegionWorker(
 		WithLabelValues("commit", changefeedID.Namespace, changefeedID.ID)
 	metrics.metricSendEventCommittedCounter = sendEventCounter.
 		WithLabelValues("committed", changefeedID.Namespace, changefeedID.ID)
+	metrics.metricLeaderScanBytes = incrementalScanBytes.
+		WithLabelValues("leader", changefeedID.Namespace, changefeedID.ID)
+	metrics.metricFollowerScanBytes = incrementalScanBytes.
+		WithLabelValues("follower", changefeedID.Namespace, changefeedID.ID)
 
 	return &regionWorker{
 		session:       s,
@@ -620,6 +628,13 @@ func (w *regionWorker) handleEventEntry(
 ) error {
 	regionID, regionSpan, startTime, storeAddr := state.getRegionMeta()
 	for _, entry := range x.Entries.GetEntries() {
+		if !state.isInitialized() {
+			if state.sri.followerRead {
+				w.metrics.metricFollowerScanBytes.Add(float64(entry.Size()))
+			} else {
+				w.metrics.metricLeaderScanBytes.Add(float64(entry.Size()))
+			}
+		}
 		// if a region with kv range [a, z), and we only want the get [b, c) from this region,
 		// tikv will return all key events in the region, although specified [b, c) int the request.
 		// we can make tikv only return the events about the keys in the specified range.
@@ -786,6 +801,19 @@ func (w *regionWorker) handleResolvedTs(
 	case <-ctx.Done():
 		return errors.Trace(ctx.Err())
 	}
+
+	// The incremental scan on a follower is finished once the resolved ts
+	// advances, hand over the region to its leader for change data subscription.
+	for _, state := range revents.regions {
+		if state.isStopped() || !state.isInitialized() || !state.sri.followerRead {
+			continue
+		}
+		if state.getLastResolvedTs() > w.session.startTs {
+			if err := w.handleSingleRegionError(&followerScanFinishedErr{}, state); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/kvproto/pkg/cdcpb"
+	"github.com/pingcap/tiflow/cdc/kv/regionlock"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
@@ -300,3 +301,58 @@ func TestRegionWorkerHandleResolvedTs(t *testing.T) {
 	require.Equal(t, 1, len(event.Resolved.Spans))
 	require.Equal(t, uint64(1), event.Resolved.Spans[0].Region)
 }
+
+func TestRegionWorkerHandOverFollowerScan(t *testing.T) {
+	ctx := context.Background()
+	span := spanz.ToSpan([]byte("a"), []byte("b"))
+	s := &eventFeedSession{
+		client:         &CDCClient{changefeed: model.DefaultChangeFeedID("1")},
+		startTs:        5,
+		errCh:          make(chan regionErrorInfo, 1),
+		errChSizeGauge: clientChannelSize.WithLabelValues("err"),
+		rangeLock:      regionlock.NewRegionRangeLock(span.StartKey, span.EndKey, 5, "test"),
+	}
+	outputCh := make(chan model.RegionFeedEvent, 1)
+	w := &regionWorker{
+		parentCtx:     ctx,
+		session:       s,
+		outputCh:      outputCh,
+		rtsUpdateCh:   make(chan *rtsUpdateEvent, 1),
+		statesManager: newRegionStateManager(-1),
+		metrics: &regionWorkerMetrics{metricSendEventResolvedCounter: sendEventCounter.
+			WithLabelValues("native-resolved", "n", "id")},
+	}
+
+	res := s.rangeLock.LockRange(ctx, span.StartKey, span.EndKey, 1, 1)
+	require.Equal(t, regionlock.LockRangeStatusSuccess, res.Status)
+	sri := newSingleRegionInfo(tikv.NewRegionVerID(1, 1, 1), span, 5, &tikv.RPCContext{})
+	sri.followerRead = true
+	s1 := newRegionFeedState(sri, 1)
+	s1.start()
+	s1.initialized.Store(true)
+	s1.lastResolvedTs = 5
+	w.setRegionState(1, s1)
+	// keep another region in the worker, so it doesn't exit.
+	s2 := newRegionFeedState(newSingleRegionInfo(tikv.NewRegionVerID(2, 2, 2),
+		spanz.ToSpan([]byte("b"), []byte("c")), 5, &tikv.RPCContext{}), 2)
+	s2.start()
+	s2.initialized.Store(true)
+	w.setRegionState(2, s2)
+
+	// the resolved ts doesn't advance, the incremental scan may be not finished.
+	err := w.handleResolvedTs(ctx, &resolvedTsEvent{resolvedTs: 5, regions: []*regionFeedState{s1}})
+	require.Nil(t, err)
+	<-outputCh
+	<-w.rtsUpdateCh
+	require.False(t, s1.isStopped())
+
+	// the resolved ts advances, the region is handed over to its leader.
+	err = w.handleResolvedTs(ctx, &resolvedTsEvent{resolvedTs: 10, regions: []*regionFeedState{s1}})
+	require.Nil(t, err)
+	<-outputCh
+	require.True(t, s1.isStopped())
+	errInfo := <-s.errCh
+	require.IsType(t, &followerScanFinishedErr{}, errInfo.err)
+	require.Equal(t, uint64(10), errInfo.resolvedTs)
+	require.True(t, errInfo.followerRead)
+}
@@ -44,6 +44,9 @@ type LimitRegionRouter interface {
 	Release(id string)
 	// Run runs in background and does some logic work
 	Run(ctx context.Context) error
+	// Buffered returns the number of regions waiting for tokens, this
+	// function is thread-safe
+	Buffered() int
 }
 
 // srrMetrics keeps metrics of a Sized Region Router
@@ -135,6 +138,17 @@ func (r *sizedRegionRouter) Release(id string) {
 	r.metrics.tokens[id].Dec()
 }
 
+// Buffered implements LimitRegionRouter.Buffered
+func (r *sizedRegionRouter) Buffered() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	count := 0
+	for _, buf := range r.buffer {
+		count += len(buf)
+	}
+	return count
+}
+
 func (r *sizedRegionRouter) Run(ctx context.Context) error {
 	ticker := time.NewTicker(sizedRegionCheckInterval)
 	defer func() {
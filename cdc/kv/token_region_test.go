@@ -173,3 +173,21 @@ func TestRouterWithMultiStores(t *testing.T) {
 		require.Equal(t, 0, r.tokens[store])
 	}
 }
+
+func TestRouterBuffered(t *testing.T) {
+	t.Parallel()
+
+	store := "store-1"
+	limit := 2
+	r := NewSizedRegionRouter(context.Background(), limit)
+	require.Equal(t, 0, r.Buffered())
+	for i := 0; i < limit; i++ {
+		r.Acquire(store)
+	}
+	// all tokens are used, the regions wait in the buffer.
+	for i := 0; i < 3; i++ {
+		r.AddRegion(singleRegionInfo{resolvedTs: uint64(i), rpcCtx: &tikv.RPCContext{Addr: store}})
+	}
+	require.Equal(t, 3, r.Buffered())
+	require.Len(t, r.Chan(), 0)
+}
@@ -194,6 +194,9 @@ func (info *ChangeFeedInfo) String() (str string) {
 	if err != nil {
 		log.Error("failed to marshal changefeed info", zap.Error(err))
 	}
+	if clone.Config != nil && clone.Config.Sink != nil {
+		clone.Config.Sink.SchemaRegistryConfig.MaskSensitiveData()
+	}
 
 	str, err = clone.Marshal()
 	if err != nil {
@@ -275,6 +278,12 @@ func (info *ChangeFeedInfo) VerifyAndComplete() error {
 	if info.Config.Consistent == nil {
 		info.Config.Consistent = defaultConfig.Consistent
 	}
+	if info.Config.DDLHistory == nil {
+		info.Config.DDLHistory = defaultConfig.DDLHistory
+	}
+	if info.Config.DDLPacing == nil {
+		info.Config.DDLPacing = defaultConfig.DDLPacing
+	}
 
 	return nil
 }
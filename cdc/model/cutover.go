@@ -0,0 +1,115 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// CutoverState is the state of a cutover.
+type CutoverState string
+
+// All the states of a cutover.
+const (
+	// CutoverStatePending means the target ts is set on the old changefeed,
+	// and the cutover is waiting for the old changefeed to finish and the new
+	// changefeed to pass the target ts. The owner checks the pending cutovers
+	// periodically and completes them.
+	CutoverStatePending CutoverState = "pending"
+	// CutoverStateCompleted means the old changefeed finished at the target
+	// ts and the new changefeed passed it.
+	CutoverStateCompleted CutoverState = "completed"
+)
+
+// Cutover records the cutover of the replication from a changefeed to another
+// one at a target ts, e.g. the old changefeed writes to the old downstream and
+// the new changefeed is cloned from it and writes to the new downstream.
+// It's stored in etcd with the ID of the old changefeed, and removed with the
+// old changefeed.
+type Cutover struct {
+	From      ChangeFeedID `json:"from"`
+	To        ChangeFeedID `json:"to"`
+	TargetTs  uint64       `json:"target-ts"`
+	State     CutoverState `json:"state"`
+	StartedAt time.Time    `json:"started-at"`
+	// FromFinishedAt is set when the old changefeed is seen finished at the
+	// target ts, the cutover doesn't need the old changefeed after that, so it
+	// can be completed even if the old changefeed is removed.
+	FromFinishedAt time.Time `json:"from-finished-at"`
+	// CompletedAt and ToCheckpointTs are set when the cutover is completed,
+	// ToCheckpointTs is the checkpoint ts of the new changefeed verified then.
+	CompletedAt    time.Time `json:"completed-at"`
+	ToCheckpointTs uint64    `json:"to-checkpoint-ts"`
+
+	// ModRevision is the revision of the cutover in etcd when it's read, the
+	// cutover is only saved or deleted if it isn't changed since then. It's 0
+	// if the cutover isn't saved yet.
+	ModRevision int64 `json:"-"`
+}
+
+// Marshal using json.Marshal.
+func (c *Cutover) Marshal() ([]byte, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal from binary data.
+func (c *Cutover) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, c)
+	return errors.Annotatef(cerror.WrapError(cerror.ErrUnmarshalFailed, err),
+		"unmarshal data: %v", data)
+}
+
+// IsFromFinished returns true if the old changefeed is seen finished.
+func (c *Cutover) IsFromFinished() bool {
+	return !c.FromFinishedAt.IsZero()
+}
+
+// MarkFromFinished records that the old changefeed is finished if its
+// checkpoint ts reaches the target ts. It returns true if it's recorded by
+// the call.
+func (c *Cutover) MarkFromFinished(fromState FeedState, fromCheckpointTs uint64, now time.Time) bool {
+	if c.State != CutoverStatePending || c.IsFromFinished() {
+		return false
+	}
+	if fromState != StateFinished || fromCheckpointTs < c.TargetTs {
+		return false
+	}
+	c.FromFinishedAt = now
+	return true
+}
+
+// TryComplete completes the pending cutover if the old changefeed is recorded
+// finished and the checkpoint ts of the new changefeed passes the target ts.
+// It returns true if the cutover is completed by the call.
+func (c *Cutover) TryComplete(toCheckpointTs uint64, now time.Time) bool {
+	if c.State != CutoverStatePending || !c.IsFromFinished() {
+		return false
+	}
+	if toCheckpointTs < c.TargetTs {
+		return false
+	}
+	c.State = CutoverStateCompleted
+	c.CompletedAt = now
+	c.ToCheckpointTs = toCheckpointTs
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutoverTryComplete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := &Cutover{
+		From:      DefaultChangeFeedID("blue"),
+		To:        DefaultChangeFeedID("green"),
+		TargetTs:  100,
+		State:     CutoverStatePending,
+		StartedAt: now,
+	}
+	// the old changefeed is not finished yet.
+	require.False(t, c.MarkFromFinished(StateNormal, 90, now))
+	require.False(t, c.MarkFromFinished(StateFinished, 90, now))
+	require.False(t, c.IsFromFinished())
+	require.False(t, c.TryComplete(120, now))
+
+	require.True(t, c.MarkFromFinished(StateFinished, 100, now))
+	require.True(t, c.IsFromFinished())
+	// the finish is recorded only once.
+	require.False(t, c.MarkFromFinished(StateFinished, 100, now.Add(time.Second)))
+	require.Equal(t, now, c.FromFinishedAt)
+
+	// the new changefeed doesn't pass the target ts yet.
+	require.False(t, c.TryComplete(99, now))
+	require.Equal(t, CutoverStatePending, c.State)
+
+	require.True(t, c.TryComplete(100, now.Add(time.Minute)))
+	require.Equal(t, CutoverStateCompleted, c.State)
+	require.Equal(t, now.Add(time.Minute), c.CompletedAt)
+	require.Equal(t, uint64(100), c.ToCheckpointTs)
+	// a completed cutover is not completed again.
+	require.False(t, c.TryComplete(200, now.Add(time.Hour)))
+	require.Equal(t, uint64(100), c.ToCheckpointTs)
+
+	c.ModRevision = 10
+	data, err := c.Marshal()
+	require.Nil(t, err)
+	decoded := &Cutover{}
+	require.Nil(t, decoded.Unmarshal(data))
+	require.Equal(t, c.From, decoded.From)
+	require.Equal(t, c.To, decoded.To)
+	require.Equal(t, c.State, decoded.State)
+	require.True(t, c.FromFinishedAt.Equal(decoded.FromFinishedAt))
+	require.True(t, c.CompletedAt.Equal(decoded.CompletedAt))
+	// the revision is not stored in the value.
+	require.Zero(t, decoded.ModRevision)
+}
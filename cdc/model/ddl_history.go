@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// DDLHistoryEntry is a DDL emitted to the downstream by a changefeed.
+type DDLHistoryEntry struct {
+	Query      string    `json:"query"`
+	CommitTs   uint64    `json:"commit-ts"`
+	FinishedAt time.Time `json:"finished-at"`
+	// Target is the quoted name of the table or schema the DDL applies to.
+	Target string `json:"target"`
+}
+
+// DDLHistory stores the DDLs emitted by a changefeed in etcd, ordered by commit ts.
+type DDLHistory struct {
+	Entries []DDLHistoryEntry `json:"entries"`
+}
+
+// Marshal using json.Marshal.
+func (h *DDLHistory) Marshal() ([]byte, error) {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal from binary data.
+func (h *DDLHistory) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, h)
+	return errors.Annotatef(cerror.WrapError(cerror.ErrUnmarshalFailed, err),
+		"unmarshal data: %v", data)
+}
+
+// Append appends the entry to the history and trims the history to at most
+// maxCount entries that finished within retention before now. A zero
+// retention means no age limit. It returns false if the entry is already
+// recorded, which happens when the owner re-executes a DDL after a restart.
+func (h *DDLHistory) Append(
+	entry DDLHistoryEntry, maxCount int, retention time.Duration, now time.Time,
+) bool {
+	for _, e := range h.Entries {
+		if e.CommitTs == entry.CommitTs && e.Query == entry.Query {
+			return false
+		}
+	}
+	h.Entries = append(h.Entries, entry)
+
+	start := 0
+	if retention > 0 {
+		expired := now.Add(-retention)
+		for start < len(h.Entries) && h.Entries[start].FinishedAt.Before(expired) {
+			start++
+		}
+	}
+	if len(h.Entries)-start > maxCount {
+		start = len(h.Entries) - maxCount
+	}
+	h.Entries = append([]DDLHistoryEntry(nil), h.Entries[start:]...)
+	return true
+}
+
+// Since returns the entries whose commit ts is not less than ts.
+func (h *DDLHistory) Since(ts uint64) []DDLHistoryEntry {
+	entries := make([]DDLHistoryEntry, 0, len(h.Entries))
+	for _, e := range h.Entries {
+		if e.CommitTs >= ts {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
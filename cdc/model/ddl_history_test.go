@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLHistoryAppend(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	h := &DDLHistory{}
+	for i := 1; i <= 5; i++ {
+		require.True(t, h.Append(DDLHistoryEntry{
+			Query:      "CREATE TABLE t(id int)",
+			CommitTs:   uint64(i),
+			FinishedAt: now.Add(time.Duration(i-5) * time.Hour),
+			Target:     "`test`.`t`",
+		}, 10, 0, now))
+	}
+	require.Len(t, h.Entries, 5)
+
+	// the same DDL is not recorded twice
+	require.False(t, h.Append(DDLHistoryEntry{
+		Query: "CREATE TABLE t(id int)", CommitTs: 5, FinishedAt: now,
+	}, 10, 0, now))
+	require.Len(t, h.Entries, 5)
+
+	// trim by count
+	require.True(t, h.Append(DDLHistoryEntry{Query: "DROP TABLE t", CommitTs: 6, FinishedAt: now}, 4, 0, now))
+	require.Len(t, h.Entries, 4)
+	require.Equal(t, uint64(3), h.Entries[0].CommitTs)
+	require.Equal(t, uint64(6), h.Entries[3].CommitTs)
+
+	// trim by age
+	require.True(t, h.Append(DDLHistoryEntry{Query: "DROP TABLE t1", CommitTs: 7, FinishedAt: now}, 4, 30*time.Minute, now))
+	require.Len(t, h.Entries, 3)
+	require.Equal(t, uint64(5), h.Entries[0].CommitTs)
+
+	// recording is disabled
+	require.True(t, h.Append(DDLHistoryEntry{Query: "DROP TABLE t2", CommitTs: 8, FinishedAt: now}, 0, 0, now))
+	require.Len(t, h.Entries, 0)
+}
+
+func TestDDLHistoryMarshalAndSince(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1670000000, 0).UTC()
+	h := &DDLHistory{Entries: []DDLHistoryEntry{
+		{Query: "CREATE DATABASE test", CommitTs: 10, FinishedAt: now, Target: "`test`"},
+		{Query: "CREATE TABLE t(id int)", CommitTs: 20, FinishedAt: now, Target: "`test`.`t`"},
+	}}
+	data, err := h.Marshal()
+	require.Nil(t, err)
+	h2 := &DDLHistory{}
+	require.Nil(t, h2.Unmarshal(data))
+	require.Equal(t, h, h2)
+
+	require.Len(t, h2.Since(0), 2)
+	require.Len(t, h2.Since(20), 1)
+	require.Equal(t, "`test`.`t`", h2.Since(11)[0].Target)
+	require.Len(t, h2.Since(21), 0)
+}
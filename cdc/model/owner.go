@@ -16,11 +16,13 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/errors"
 	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 // AdminJobType represents for admin job type, both used in owner and processor
@@ -277,6 +279,73 @@ func (status *ChangeFeedStatus) Unmarshal(data []byte) error {
 		cerror.WrapError(cerror.ErrUnmarshalFailed, err), "Unmarshal data: %v", data)
 }
 
+// ChangefeedLagBreakdown is the progress of each stage of a changefeed, every ts
+// is the minimum across all the tables of the changefeed. A ts is zero if no table
+// reports it, e.g. the tables are replicated by captures of an older version.
+type ChangefeedLagBreakdown struct {
+	// CurrentTs is the latest PD time reported by the processors.
+	CurrentTs Ts `json:"current-ts"`
+	// PullerResolvedTs is the min resolved ts output by the pullers.
+	PullerResolvedTs Ts `json:"puller-resolved-ts,omitempty"`
+	// SorterOutputTs is the min ts output by the sorters.
+	SorterOutputTs Ts `json:"sorter-output-ts,omitempty"`
+	// SinkFlushedTs is the min ts flushed by the sinks.
+	SinkFlushedTs Ts `json:"sink-flushed-ts,omitempty"`
+}
+
+// PullerLag returns the lag of the pullers behind the current time.
+func (b *ChangefeedLagBreakdown) PullerLag() time.Duration {
+	return stageLag(b.CurrentTs, b.PullerResolvedTs)
+}
+
+// SorterLag returns the lag of the sorters behind the pullers.
+func (b *ChangefeedLagBreakdown) SorterLag() time.Duration {
+	return stageLag(b.PullerResolvedTs, b.SorterOutputTs)
+}
+
+// SinkLag returns the lag of the sinks behind the sorters.
+func (b *ChangefeedLagBreakdown) SinkLag() time.Duration {
+	return stageLag(b.SorterOutputTs, b.SinkFlushedTs)
+}
+
+// stageLag returns the lag of a stage at ts behind its upstream at upstreamTs,
+// it's zero if either is unknown.
+func stageLag(upstreamTs, ts Ts) time.Duration {
+	if upstreamTs == 0 || ts == 0 || ts >= upstreamTs {
+		return 0
+	}
+	return oracle.GetTimeFromTS(upstreamTs).Sub(oracle.GetTimeFromTS(ts))
+}
+
+// Types of ChangefeedBarrier.
+const (
+	BarrierTypeDDL       = "ddl"
+	BarrierTypeSyncPoint = "syncpoint"
+	BarrierTypeFinish    = "finish"
+)
+
+// ChangefeedBarrier is a barrier which the changefeed is blocked at, i.e. all
+// the data before the barrier are received, and the changefeed waits for the
+// tables to reach the barrier before it can proceed, e.g. executing a DDL.
+type ChangefeedBarrier struct {
+	// Type is one of BarrierTypeDDL, BarrierTypeSyncPoint and BarrierTypeFinish.
+	Type      string `json:"type"`
+	BarrierTs Ts     `json:"barrier-ts"`
+	// DDL is the query of the DDL job, it's only set for the DDL barrier.
+	DDL string `json:"ddl,omitempty"`
+	// Waiting is how long the changefeed has been blocked at the barrier.
+	Waiting time.Duration `json:"waiting"`
+	// PendingTables are the tables whose checkpoints are less than the barrier.
+	PendingTables []BarrierPendingTable `json:"pending-tables,omitempty"`
+}
+
+// BarrierPendingTable is a table which doesn't reach the barrier yet.
+type BarrierPendingTable struct {
+	TableID      TableID   `json:"table-id"`
+	CaptureID    CaptureID `json:"capture-id"`
+	CheckpointTs Ts        `json:"checkpoint-ts"`
+}
+
 // ProcInfoSnap holds most important replication information of a processor
 type ProcInfoSnap struct {
 	CfID      ChangeFeedID `json:"changefeed-id"`
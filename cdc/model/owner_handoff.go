@@ -0,0 +1,50 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// OwnerHandoff is persisted for a changefeed by an owner which resigns
+// gracefully. It tells the next owner that no DDL of the changefeed was in
+// flight when the owner resigned, so the DDL at CheckpointTs, if any, has
+// been executed and doesn't need to be pulled again.
+type OwnerHandoff struct {
+	// CaptureID is the capture of the resigned owner.
+	CaptureID    CaptureID `json:"capture-id"`
+	CheckpointTs uint64    `json:"checkpoint-ts"`
+	ResignedAt   time.Time `json:"resigned-at"`
+}
+
+// Marshal using json.Marshal.
+func (h *OwnerHandoff) Marshal() ([]byte, error) {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal from binary data.
+func (h *OwnerHandoff) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, h)
+	return errors.Annotatef(cerror.WrapError(cerror.ErrUnmarshalFailed, err),
+		"unmarshal data: %v", data)
+}
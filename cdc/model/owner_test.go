@@ -15,8 +15,10 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 func TestAdminJobType(t *testing.T) {
@@ -85,6 +87,27 @@ func TestChangeFeedStatusMarshal(t *testing.T) {
 	require.Equal(t, status, newStatus)
 }
 
+func TestChangefeedLagBreakdown(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := &ChangefeedLagBreakdown{
+		CurrentTs:        oracle.GoTimeToTS(now),
+		PullerResolvedTs: oracle.GoTimeToTS(now.Add(-time.Second)),
+		SorterOutputTs:   oracle.GoTimeToTS(now.Add(-3 * time.Second)),
+		SinkFlushedTs:    oracle.GoTimeToTS(now.Add(-6 * time.Second)),
+	}
+	require.Equal(t, time.Second, b.PullerLag())
+	require.Equal(t, 2*time.Second, b.SorterLag())
+	require.Equal(t, 3*time.Second, b.SinkLag())
+
+	// the stages not reported have no lag
+	b.SorterOutputTs = 0
+	require.Equal(t, time.Second, b.PullerLag())
+	require.Equal(t, time.Duration(0), b.SorterLag())
+	require.Equal(t, time.Duration(0), b.SinkLag())
+}
+
 func TestTableOperationState(t *testing.T) {
 	t.Parallel()
 
@@ -271,6 +271,10 @@ type RowChangedEvent struct {
 	SplitTxn bool `json:"-" msg:"-"`
 	// ReplicatingTs is ts when a table starts replicating events to downstream.
 	ReplicatingTs Ts `json:"-" msg:"-"`
+	// OmitOldValue marks the update event is delivered without the old values
+	// of its non-handle-key columns, as the old value of its table is disabled
+	// while the old values of some other tables are enabled.
+	OmitOldValue bool `json:"-" msg:"-"`
 }
 
 // GetCommitTs returns the commit timestamp of this event.
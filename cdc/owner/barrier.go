@@ -15,6 +15,7 @@ package owner
 
 import (
 	"math"
+	"time"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -37,15 +38,36 @@ type barriers struct {
 	inner map[barrierType]model.Ts
 	dirty bool
 	min   barrierType
+	// blocked records since when the changefeed is blocked at the barriers, see markBlocked.
+	blocked map[barrierType]blockedBarrier
+}
+
+type blockedBarrier struct {
+	barrierTs model.Ts
+	since     time.Time
 }
 
 func newBarriers() *barriers {
 	return &barriers{
-		inner: make(map[barrierType]model.Ts),
-		dirty: true,
+		inner:   make(map[barrierType]model.Ts),
+		dirty:   true,
+		blocked: make(map[barrierType]blockedBarrier),
 	}
 }
 
+// String implements fmt.Stringer.
+func (tp barrierType) String() string {
+	switch tp {
+	case ddlJobBarrier:
+		return model.BarrierTypeDDL
+	case syncPointBarrier:
+		return model.BarrierTypeSyncPoint
+	case finishBarrier:
+		return model.BarrierTypeFinish
+	}
+	return "unknown"
+}
+
 func (b *barriers) Update(tp barrierType, barrierTs model.Ts) {
 	// the barriers structure was given the ability to handle a fallback barrierTs by design.
 	// but the barrierTs should never fall back in owner replication model
@@ -81,5 +103,31 @@ func (b *barriers) calcMin() (tp barrierType, barrierTs model.Ts) {
 
 func (b *barriers) Remove(tp barrierType) {
 	delete(b.inner, tp)
+	delete(b.blocked, tp)
 	b.dirty = true
 }
+
+// markBlocked records the barriers which the changefeed is blocked at, i.e. the
+// barriers not greater than the resolved ts of the changefeed. A barrier is
+// considered blocked again from `now` if its barrierTs changes.
+func (b *barriers) markBlocked(resolvedTs model.Ts, now time.Time) {
+	for tp, barrierTs := range b.inner {
+		if barrierTs > resolvedTs {
+			delete(b.blocked, tp)
+			continue
+		}
+		if blocked, ok := b.blocked[tp]; !ok || blocked.barrierTs != barrierTs {
+			b.blocked[tp] = blockedBarrier{barrierTs: barrierTs, since: now}
+		}
+	}
+}
+
+// blockedSince returns since when the changefeed is blocked at the barrier,
+// it returns false if the changefeed is not blocked at it.
+func (b *barriers) blockedSince(tp barrierType) (time.Time, bool) {
+	blocked, ok := b.blocked[tp]
+	if !ok || blocked.barrierTs != b.inner[tp] {
+		return time.Time{}, false
+	}
+	return blocked.since, true
+}
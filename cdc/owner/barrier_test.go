@@ -17,6 +17,7 @@ import (
 	"math"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,40 @@ func TestBarrier(t *testing.T) {
 	require.Equal(t, ts, uint64(1))
 }
 
+func TestBarrierBlocked(t *testing.T) {
+	b := newBarriers()
+	b.Update(ddlJobBarrier, 10)
+	b.Update(syncPointBarrier, 20)
+	now := time.Now()
+
+	b.markBlocked(10, now)
+	since, ok := b.blockedSince(ddlJobBarrier)
+	require.True(t, ok)
+	require.Equal(t, now, since)
+	_, ok = b.blockedSince(syncPointBarrier)
+	require.False(t, ok)
+
+	// still blocked at the same barrier.
+	b.markBlocked(10, now.Add(time.Second))
+	since, _ = b.blockedSince(ddlJobBarrier)
+	require.Equal(t, now, since)
+
+	// the barrier moves forward.
+	b.Update(ddlJobBarrier, 15)
+	_, ok = b.blockedSince(ddlJobBarrier)
+	require.False(t, ok)
+	b.markBlocked(20, now.Add(2*time.Second))
+	since, _ = b.blockedSince(ddlJobBarrier)
+	require.Equal(t, now.Add(2*time.Second), since)
+	since, ok = b.blockedSince(syncPointBarrier)
+	require.True(t, ok)
+	require.Equal(t, now.Add(2*time.Second), since)
+
+	b.Remove(syncPointBarrier)
+	_, ok = b.blockedSince(syncPointBarrier)
+	require.False(t, ok)
+}
+
 func TestBarrierRandom(t *testing.T) {
 	maxBarrierType := 50
 	maxBarrierTs := 1000000
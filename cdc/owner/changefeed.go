@@ -15,6 +15,8 @@ package owner
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -83,6 +85,9 @@ type changefeed struct {
 	// isRemoved is true if the changefeed is removed,
 	// which means it will be removed from memory forever
 	isRemoved bool
+	// isFinished is true if the changefeed reaches its target ts,
+	// which means it will never run again
+	isFinished bool
 	// isReleased is true if the changefeed's resources were released,
 	// but it will still be kept in the memory, and it will be check
 	// in every tick. Such as the changefeed that is stopped or encountered an error.
@@ -257,6 +262,7 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 
 	if !c.feedStateManager.ShouldRunning() {
 		c.isRemoved = c.feedStateManager.ShouldRemoved()
+		c.isFinished = c.feedStateManager.ShouldFinished()
 		c.releaseResources(ctx)
 		return nil
 	}
@@ -478,9 +484,14 @@ LOOP:
 	}
 
 	// if resolvedTs == checkpointTs it means owner can't tell whether the DDL on checkpointTs has
-	// been executed or not. So the DDL puller must start at checkpointTs-1.
+	// been executed or not. So the DDL puller must start at checkpointTs-1, unless the previous
+	// owner resigned gracefully at checkpointTs, which means the DDL has been executed.
+	handedOff, err := c.consumeOwnerHandoff(ctx, checkpointTs)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	var ddlStartTs uint64
-	if resolvedTs > checkpointTs {
+	if resolvedTs > checkpointTs || handedOff {
 		ddlStartTs = checkpointTs
 	} else {
 		ddlStartTs = checkpointTs - 1
@@ -601,6 +612,10 @@ func (c *changefeed) releaseResources(ctx cdcContext.Context) {
 	// the manager can be closed internally.
 	c.cleanupRedoManager(ctx)
 	c.cleanupChangefeedServiceGCSafePoints(ctx)
+	if c.isRemoved {
+		c.cleanupOwnerHandoff(ctx, ctx.GlobalVars().EtcdClient)
+		c.cleanupCutover(ctx, ctx.GlobalVars().EtcdClient)
+	}
 
 	c.cancel()
 	c.cancel = func() {}
@@ -664,6 +679,9 @@ func (c *changefeed) cleanupMetrics() {
 
 	changefeedBarrierTsGauge.DeleteLabelValues(c.id.Namespace, c.id.ID)
 	c.metricsChangefeedBarrierTsGauge = nil
+
+	changefeedDDLQueueLengthGauge.DeleteLabelValues(c.id.Namespace, c.id.ID)
+	changefeedDDLPacingWaitDuration.DeleteLabelValues(c.id.Namespace, c.id.ID)
 }
 
 // redoManagerCleanup cleanups redo logs if changefeed is removed and redo log is enabled
@@ -697,8 +715,10 @@ func (c *changefeed) cleanupRedoManager(ctx context.Context) {
 	}
 }
 
+// cleanupChangefeedServiceGCSafePoints removes the service GC safepoints of
+// the changefeed if it's removed or finished, since it will never run again.
 func (c *changefeed) cleanupChangefeedServiceGCSafePoints(ctx cdcContext.Context) {
-	if !c.isRemoved {
+	if !c.isRemoved && !c.isFinished {
 		return
 	}
 
@@ -755,12 +775,51 @@ func (c *changefeed) preflightCheck(captures map[model.CaptureID]*model.CaptureI
 	return
 }
 
+// setTargetTs sets the target ts of the running changefeed, and moves the
+// finish barrier to it, so that the changefeed is finished at the target ts
+// without being restarted. The target ts must be greater than the resolved ts
+// of the changefeed, since the data before the resolved ts may have been
+// flushed to the downstream.
+func (c *changefeed) setTargetTs(targetTs model.Ts) error {
+	if c.state.Info == nil || c.state.Status == nil {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"the changefeed is not initialized")
+	}
+	if c.state.Info.State != model.StateNormal {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			fmt.Sprintf("can only set target ts when the changefeed is normal, but it's %s",
+				c.state.Info.State))
+	}
+	if resolvedTs := c.state.Status.ResolvedTs; targetTs <= resolvedTs {
+		return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			fmt.Sprintf("target ts %d must be greater than the resolved ts %d",
+				targetTs, resolvedTs))
+	}
+	c.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil {
+			return nil, false, nil
+		}
+		info.TargetTs = targetTs
+		return info, true, nil
+	})
+	if c.barriers != nil {
+		c.barriers.Update(finishBarrier, targetTs)
+	}
+	log.Info("changefeed target ts is set",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.Uint64("targetTs", targetTs))
+	return nil
+}
+
 // handleBarrier calculates the barrierTs of the changefeed.
 // barrierTs is used to control the data that can be flush to downstream.
 func (c *changefeed) handleBarrier(ctx cdcContext.Context) (uint64, error) {
+	c.barriers.markBlocked(c.state.Status.ResolvedTs, time.Now())
 	barrierTp, barrierTs := c.barriers.Min()
 
 	c.metricsChangefeedBarrierTsGauge.Set(float64(oracle.ExtractPhysical(barrierTs)))
+	c.sink.observeDDLQueue(c.ddlPuller.PendingDDLJobCount())
 
 	// It means:
 	//   1. All data before the barrierTs was sent to downstream.
@@ -832,7 +891,16 @@ func (c *changefeed) handleBarrier(ctx cdcContext.Context) (uint64, error) {
 		}
 		c.barriers.Update(syncPointBarrier, nextSyncPointTs)
 	case finishBarrier:
-		if fullyBlocked {
+		if !fullyBlocked {
+			return barrierTs, nil
+		}
+		// All data before the target ts has been flushed, write the final
+		// checkpoint to downstream before marking the changefeed finished.
+		done, err := c.sink.emitFinishedTs(ctx, barrierTs, c.currentTables)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		if done {
 			c.feedStateManager.MarkFinished()
 		}
 		return barrierTs, nil
@@ -931,6 +999,9 @@ func (c *changefeed) asyncExecDDLEvent(ctx cdcContext.Context,
 	if err != nil {
 		return false, err
 	}
+	if done {
+		recordDDLHistory(c.state, c.state.Info.Config.DDLHistory, ddlEvent, time.Now())
+	}
 
 	return done, nil
 }
@@ -992,6 +1063,61 @@ func (c *changefeed) GetInfoProvider() scheduler.InfoProvider {
 	return nil
 }
 
+// getBlockedBarriers returns the barriers which the changefeed is blocked at,
+// with the tables which don't reach them. The DDL barrier is only returned if
+// there is a DDL job at it, otherwise it's the resolved ts of the DDL puller.
+func (c *changefeed) getBlockedBarriers(now time.Time) ([]*model.ChangefeedBarrier, error) {
+	if !c.initialized || c.barriers == nil {
+		return nil, nil
+	}
+	var taskStatuses map[model.CaptureID]*model.TaskStatus
+	if provider := c.GetInfoProvider(); provider != nil {
+		var err error
+		if taskStatuses, err = provider.GetTaskStatuses(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	var res []*model.ChangefeedBarrier
+	for _, tp := range []barrierType{ddlJobBarrier, syncPointBarrier, finishBarrier} {
+		since, ok := c.barriers.blockedSince(tp)
+		if !ok {
+			continue
+		}
+		barrier := &model.ChangefeedBarrier{
+			Type:      tp.String(),
+			BarrierTs: c.barriers.inner[tp],
+			Waiting:   now.Sub(since),
+		}
+		if tp == ddlJobBarrier {
+			ddlResolvedTs, ddlJob := c.ddlPuller.FrontDDL()
+			if ddlJob == nil || ddlResolvedTs != barrier.BarrierTs {
+				continue
+			}
+			barrier.DDL = ddlJob.Query
+		}
+		for captureID, status := range taskStatuses {
+			for tableID, replica := range status.Tables {
+				if replica.StartTs < barrier.BarrierTs {
+					barrier.PendingTables = append(barrier.PendingTables, model.BarrierPendingTable{
+						TableID:      tableID,
+						CaptureID:    captureID,
+						CheckpointTs: replica.StartTs,
+					})
+				}
+			}
+		}
+		sort.Slice(barrier.PendingTables, func(i, j int) bool {
+			return barrier.PendingTables[i].TableID < barrier.PendingTables[j].TableID
+		})
+		res = append(res, barrier)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].BarrierTs < res[j].BarrierTs
+	})
+	return res, nil
+}
+
 // checkUpstream returns skip = true if the upstream is still in initializing phase,
 // and returns an error if the upstream is unavailable.
 func (c *changefeed) checkUpstream() (skip bool, err error) {
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/labstack/gommon/log"
 	"github.com/pingcap/errors"
 	timodel "github.com/pingcap/tidb/parser/model"
@@ -33,7 +34,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/upstream"
@@ -63,6 +66,10 @@ func (m *mockDDLPuller) PopFrontDDL() (uint64, *timodel.Job) {
 	return m.resolvedTs, nil
 }
 
+func (m *mockDDLPuller) PendingDDLJobCount() int {
+	return len(m.ddlQueue)
+}
+
 func (m *mockDDLPuller) Close() {}
 
 func (m *mockDDLPuller) Run(ctx context.Context) error {
@@ -87,6 +94,7 @@ type mockDDLSink struct {
 	}
 	syncPoint    model.Ts
 	syncPointHis []model.Ts
+	finishedTs   model.Ts
 
 	wg sync.WaitGroup
 }
@@ -123,6 +131,13 @@ func (m *mockDDLSink) emitSyncPoint(ctx context.Context, checkpointTs uint64) er
 	return nil
 }
 
+func (m *mockDDLSink) emitFinishedTs(
+	ctx context.Context, ts uint64, tables []*model.TableInfo,
+) (bool, error) {
+	m.finishedTs = ts
+	return true, nil
+}
+
 func (m *mockDDLSink) emitCheckpointTs(ts uint64, tables []*model.TableInfo) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -141,6 +156,8 @@ func (m *mockDDLSink) close(ctx context.Context) error {
 	return nil
 }
 
+func (m *mockDDLSink) observeDDLQueue(length int) {}
+
 func (m *mockDDLSink) isInitialized() bool {
 	return true
 }
@@ -177,6 +194,24 @@ func (m *mockScheduler) DrainCapture(target model.CaptureID) (int, error) {
 // Close closes the scheduler and releases resources.
 func (m *mockScheduler) Close(ctx context.Context) {}
 
+// mockInfoScheduler is a mockScheduler which provides the task statuses.
+type mockInfoScheduler struct {
+	*mockScheduler
+	taskStatuses map[model.CaptureID]*model.TaskStatus
+}
+
+func (m *mockInfoScheduler) IsInitialized() bool {
+	return true
+}
+
+func (m *mockInfoScheduler) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus, error) {
+	return m.taskStatuses, nil
+}
+
+func (m *mockInfoScheduler) GetLagBreakdown() model.ChangefeedLagBreakdown {
+	return model.ChangefeedLagBreakdown{}
+}
+
 func createChangefeed4Test(ctx cdcContext.Context, t *testing.T,
 ) (
 	*changefeed, map[model.CaptureID]*model.CaptureInfo, *orchestrator.ReactorStateTester,
@@ -264,6 +299,100 @@ func TestInitialize(t *testing.T) {
 	require.Equal(t, cf.state.Status.CheckpointTs, ctx.ChangefeedVars().Info.StartTs)
 }
 
+func TestChangefeedHandoffState(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	defer cf.Close(ctx)
+
+	// the checkpoint ts is unknown before the changefeed is initialized
+	ready, clean := cf.handoffState()
+	require.True(t, ready)
+	require.False(t, clean)
+
+	// pre check and initialize
+	cf.Tick(ctx, captures)
+	tester.MustApplyPatches()
+	cf.Tick(ctx, captures)
+	tester.MustApplyPatches()
+	require.True(t, cf.initialized)
+	ready, clean = cf.handoffState()
+	require.True(t, ready)
+	require.True(t, clean)
+
+	// a DDL is being executed
+	cf.ddlEventCache = []*model.DDLEvent{{}}
+	ready, clean = cf.handoffState()
+	require.False(t, ready)
+	require.False(t, clean)
+	cf.ddlEventCache = nil
+
+	// the DDL reached by the checkpoint ts is waiting to be executed
+	mockPuller := cf.ddlPuller.(*mockDDLPuller)
+	mockPuller.ddlQueue = append(mockPuller.ddlQueue, &timodel.Job{
+		BinlogInfo: &timodel.HistoryInfo{FinishedTS: cf.state.Status.CheckpointTs},
+	})
+	ready, clean = cf.handoffState()
+	require.False(t, ready)
+	require.False(t, clean)
+	mockPuller.ddlQueue = nil
+
+	// an admin job is pending
+	cf.feedStateManager.PushAdminJob(&model.AdminJob{
+		CfID: cf.id,
+		Type: model.AdminStop,
+	})
+	ready, clean = cf.handoffState()
+	require.False(t, ready)
+	require.False(t, clean)
+}
+
+func TestConsumeOwnerHandoff(t *testing.T) {
+	t.Parallel()
+
+	id := model.DefaultChangeFeedID("test")
+	cf := &changefeed{id: id}
+	me := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	me.EXPECT().GetEtcdClient().Return(&etcd.Client{}).AnyTimes()
+	ctx := cdcContext.NewContext(context.Background(), &cdcContext.GlobalVars{EtcdClient: me})
+
+	// no marker.
+	me.EXPECT().GetChangefeedOwnerHandoff(gomock.Any(), id).Return(nil, nil).Times(1)
+	handedOff, err := cf.consumeOwnerHandoff(ctx, 10)
+	require.NoError(t, err)
+	require.False(t, handedOff)
+
+	// the marker at the checkpoint ts is consumed.
+	me.EXPECT().GetChangefeedOwnerHandoff(gomock.Any(), id).
+		Return(&model.OwnerHandoff{CheckpointTs: 10}, nil).Times(1)
+	me.EXPECT().DeleteChangefeedOwnerHandoff(gomock.Any(), id).Return(nil).Times(1)
+	handedOff, err = cf.consumeOwnerHandoff(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, handedOff)
+
+	// the marker at another checkpoint ts is removed without being consumed.
+	me.EXPECT().GetChangefeedOwnerHandoff(gomock.Any(), id).
+		Return(&model.OwnerHandoff{CheckpointTs: 5}, nil).Times(1)
+	me.EXPECT().DeleteChangefeedOwnerHandoff(gomock.Any(), id).Return(nil).Times(1)
+	handedOff, err = cf.consumeOwnerHandoff(ctx, 10)
+	require.NoError(t, err)
+	require.False(t, handedOff)
+
+	// the marker which fails to be removed isn't consumed, and the
+	// initialization is retried.
+	me.EXPECT().GetChangefeedOwnerHandoff(gomock.Any(), id).
+		Return(&model.OwnerHandoff{CheckpointTs: 10}, nil).Times(1)
+	me.EXPECT().DeleteChangefeedOwnerHandoff(gomock.Any(), id).
+		Return(errors.New("etcd error")).Times(1)
+	handedOff, err = cf.consumeOwnerHandoff(ctx, 10)
+	require.ErrorContains(t, err, "etcd error")
+	require.False(t, handedOff)
+
+	me.EXPECT().GetChangefeedOwnerHandoff(gomock.Any(), id).
+		Return(nil, errors.New("etcd error")).Times(1)
+	_, err = cf.consumeOwnerHandoff(ctx, 10)
+	require.Error(t, err)
+}
+
 func TestChangefeedHandleError(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	cf, captures, tester := createChangefeed4Test(ctx, t)
@@ -468,6 +597,7 @@ func TestFinished(t *testing.T) {
 
 	mockDDLPuller := cf.ddlPuller.(*mockDDLPuller)
 	mockDDLPuller.resolvedTs += 2000
+	mockDDLSink := cf.sink.(*mockDDLSink)
 	// tick many times to make sure the change feed is stopped
 	for i := 0; i <= 10; i++ {
 		cf.Tick(ctx, captures)
@@ -476,6 +606,8 @@ func TestFinished(t *testing.T) {
 
 	require.Equal(t, cf.state.Status.CheckpointTs, cf.state.Info.TargetTs)
 	require.Equal(t, cf.state.Info.State, model.StateFinished)
+	require.Equal(t, cf.state.Info.TargetTs, mockDDLSink.finishedTs)
+	require.True(t, cf.isFinished)
 }
 
 func TestRemoveChangefeed(t *testing.T) {
@@ -799,3 +931,93 @@ func TestBarrierAdvance(t *testing.T) {
 		require.Equal(t, mockDDLPuller.resolvedTs, barrier)
 	}
 }
+
+func TestGetBlockedBarriers(t *testing.T) {
+	ddlPuller := &mockDDLPuller{resolvedTs: 5}
+	cf := &changefeed{
+		initialized: true,
+		barriers:    newBarriers(),
+		ddlPuller:   ddlPuller,
+		scheduler: &mockInfoScheduler{
+			mockScheduler: &mockScheduler{},
+			taskStatuses: map[model.CaptureID]*model.TaskStatus{
+				"capture-1": {Tables: map[model.TableID]*model.TableReplicaInfo{
+					1: {StartTs: 10}, 2: {StartTs: 8},
+				}},
+				"capture-2": {Tables: map[model.TableID]*model.TableReplicaInfo{
+					3: {StartTs: 6},
+				}},
+			},
+		},
+	}
+	cf.barriers.Update(ddlJobBarrier, 5)
+	cf.barriers.Update(syncPointBarrier, 10)
+	cf.barriers.Update(finishBarrier, math.MaxUint64)
+	now := time.Now()
+
+	// the DDL barrier without DDL job is not reported.
+	cf.barriers.markBlocked(5, now)
+	barriers, err := cf.getBlockedBarriers(now.Add(time.Second))
+	require.NoError(t, err)
+	require.Empty(t, barriers)
+
+	job := &timodel.Job{
+		Query:      "ALTER TABLE t ADD COLUMN c int",
+		BinlogInfo: &timodel.HistoryInfo{FinishedTS: 10},
+	}
+	ddlPuller.ddlQueue = append(ddlPuller.ddlQueue, job)
+	cf.barriers.Update(ddlJobBarrier, 10)
+	cf.barriers.markBlocked(10, now)
+	barriers, err = cf.getBlockedBarriers(now.Add(time.Minute))
+	require.NoError(t, err)
+	pendingTables := []model.BarrierPendingTable{
+		{TableID: 2, CaptureID: "capture-1", CheckpointTs: 8},
+		{TableID: 3, CaptureID: "capture-2", CheckpointTs: 6},
+	}
+	require.Equal(t, []*model.ChangefeedBarrier{
+		{
+			Type:          model.BarrierTypeDDL,
+			BarrierTs:     10,
+			DDL:           job.Query,
+			Waiting:       time.Minute,
+			PendingTables: pendingTables,
+		},
+		{
+			Type:          model.BarrierTypeSyncPoint,
+			BarrierTs:     10,
+			Waiting:       time.Minute,
+			PendingTables: pendingTables,
+		},
+	}, barriers)
+}
+
+func TestSetTargetTs(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	defer cf.Close(ctx)
+	// pre check
+	cf.Tick(ctx, captures)
+	tester.MustApplyPatches()
+
+	// initialize
+	ctx.GlobalVars().EtcdClient = &etcd.CDCEtcdClientImpl{}
+	cf.Tick(ctx, captures)
+	tester.MustApplyPatches()
+	resolvedTs := cf.state.Status.ResolvedTs
+
+	// the data before the resolved ts may have been flushed.
+	require.True(t, cerror.ErrChangefeedUpdateRefused.Equal(cf.setTargetTs(resolvedTs)))
+
+	require.Nil(t, cf.setTargetTs(resolvedTs+10))
+	tester.MustApplyPatches()
+	require.Equal(t, resolvedTs+10, cf.state.Info.TargetTs)
+	require.Equal(t, resolvedTs+10, cf.barriers.inner[finishBarrier])
+
+	cf.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		info.State = model.StateStopped
+		return info, true, nil
+	})
+	tester.MustApplyPatches()
+	require.True(t, cerror.ErrChangefeedUpdateRefused.Equal(cf.setTargetTs(resolvedTs+20)))
+	require.Equal(t, resolvedTs+10, cf.state.Info.TargetTs)
+}
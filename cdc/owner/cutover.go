@@ -0,0 +1,217 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"go.uber.org/zap"
+)
+
+// cutoverCheckInterval is the interval for the owner to check the pending cutovers.
+const cutoverCheckInterval = 5 * time.Second
+
+// cutoverProgress is the progress of a changefeed in the owner tick, which
+// the cutovers from or to the changefeed depend on.
+type cutoverProgress struct {
+	state        model.FeedState
+	checkpointTs model.Ts
+}
+
+// cutoverDriver drives the cutovers of the changefeeds, it marks the old
+// changefeed finished once it reaches the target ts, and completes the cutover
+// once the new changefeed passes the target ts. The etcd requests are sent in
+// the background, so the owner tick is not blocked by them.
+type cutoverDriver struct {
+	lastCheckTime time.Time
+	// running is 1 if the cutovers are being checked in the background.
+	running int32
+	// listed is the StartedAt of the cutovers listed in the previous check,
+	// which are created before the current snapshot of the changefeeds.
+	listed map[model.ChangeFeedID]time.Time
+}
+
+// tick starts to check the cutovers with the progress of the changefeeds in
+// the state, if the previous check is finished and cutoverCheckInterval passes.
+func (d *cutoverDriver) tick(
+	ctx context.Context, etcdClient etcd.CDCEtcdClient, state *orchestrator.GlobalReactorState,
+) {
+	if !etcdAvailable(etcdClient) {
+		return
+	}
+	now := time.Now()
+	if now.Sub(d.lastCheckTime) < cutoverCheckInterval ||
+		!atomic.CompareAndSwapInt32(&d.running, 0, 1) {
+		return
+	}
+	d.lastCheckTime = now
+
+	namespaces := make(map[string]struct{})
+	progresses := make(map[model.ChangeFeedID]cutoverProgress, len(state.Changefeeds))
+	for id, cfState := range state.Changefeeds {
+		namespaces[id.Namespace] = struct{}{}
+		if cfState.Info == nil || cfState.Status == nil {
+			continue
+		}
+		progresses[id] = cutoverProgress{
+			state:        cfState.Info.State,
+			checkpointTs: cfState.Status.CheckpointTs,
+		}
+	}
+	go func() {
+		defer atomic.StoreInt32(&d.running, 0)
+		for namespace := range namespaces {
+			if err := d.check(ctx, etcdClient, namespace, progresses, now); err != nil {
+				log.Warn("failed to check changefeed cutovers",
+					zap.String("namespace", namespace), zap.Error(err))
+			}
+		}
+	}()
+}
+
+// check checks the cutovers from the changefeeds in the namespace. progresses
+// is the snapshot of the changefeeds taken at now, a changefeed not in it is
+// removed.
+func (d *cutoverDriver) check(
+	ctx context.Context,
+	etcdClient etcd.CDCEtcdClient,
+	namespace string,
+	progresses map[model.ChangeFeedID]cutoverProgress,
+	now time.Time,
+) error {
+	cutovers, err := etcdClient.GetChangefeedCutovers(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for id, cutover := range cutovers {
+		if err := d.checkOne(ctx, etcdClient, id, cutover, progresses, now); err != nil {
+			// The cutover is checked again in the next round.
+			log.Warn("failed to update changefeed cutover",
+				zap.String("namespace", id.Namespace),
+				zap.String("changefeed", id.ID),
+				zap.Error(err))
+		}
+	}
+	if d.listed == nil {
+		d.listed = make(map[model.ChangeFeedID]time.Time)
+	}
+	for id := range d.listed {
+		if id.Namespace == namespace {
+			delete(d.listed, id)
+		}
+	}
+	for id, cutover := range cutovers {
+		d.listed[id] = cutover.StartedAt
+	}
+	return nil
+}
+
+func (d *cutoverDriver) checkOne(
+	ctx context.Context,
+	etcdClient etcd.CDCEtcdClient,
+	id model.ChangeFeedID,
+	cutover *model.Cutover,
+	progresses map[model.ChangeFeedID]cutoverProgress,
+	now time.Time,
+) error {
+	from, fromExists := progresses[id]
+	// The cutover which is created after the snapshot may be from a
+	// changefeed not in the snapshot. StartedAt is set by the API server, so
+	// it's only compared with the previous check instead of the owner's clock.
+	if startedAt, ok := d.listed[id]; !fromExists && (!ok || !startedAt.Equal(cutover.StartedAt)) {
+		return nil
+	}
+	if cutover.State != model.CutoverStatePending {
+		if !fromExists {
+			// The cutover is removed with the old changefeed.
+			return d.remove(ctx, etcdClient, id, cutover)
+		}
+		return nil
+	}
+
+	changed := false
+	if fromExists && cutover.MarkFromFinished(from.state, from.checkpointTs, now) {
+		log.Info("old changefeed of cutover finished",
+			zap.String("namespace", id.Namespace),
+			zap.String("changefeed", id.ID),
+			zap.String("newChangefeed", cutover.To.ID),
+			zap.Uint64("targetTs", cutover.TargetTs))
+		changed = true
+	}
+	if !cutover.IsFromFinished() {
+		if !fromExists {
+			// The old changefeed is removed before it finishes, the cutover
+			// can never be completed.
+			return d.remove(ctx, etcdClient, id, cutover)
+		}
+		return nil
+	}
+	// The old changefeed may be removed after it's recorded finished.
+	if to, ok := progresses[cutover.To]; ok && cutover.TryComplete(to.checkpointTs, now) {
+		log.Info("Cutover changefeed completed",
+			zap.String("namespace", id.Namespace),
+			zap.String("changefeed", id.ID),
+			zap.String("newChangefeed", cutover.To.ID),
+			zap.Uint64("targetTs", cutover.TargetTs),
+			zap.Uint64("newCheckpointTs", to.checkpointTs))
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return etcdClient.SaveChangefeedCutover(ctx, id, cutover)
+}
+
+func (d *cutoverDriver) remove(
+	ctx context.Context, etcdClient etcd.CDCEtcdClient, id model.ChangeFeedID, cutover *model.Cutover,
+) error {
+	if err := etcdClient.DeleteChangefeedCutover(ctx, id, cutover.ModRevision); err != nil {
+		return err
+	}
+	log.Info("cutover of removed changefeed is deleted",
+		zap.String("namespace", id.Namespace),
+		zap.String("changefeed", id.ID),
+		zap.String("newChangefeed", cutover.To.ID),
+		zap.String("state", string(cutover.State)))
+	return nil
+}
+
+// cleanupCutover deletes the cutover from the removed changefeed, unless the
+// changefeed is recorded finished and the cutover is waiting for the new
+// changefeed, which is completed and then deleted by the owner.
+func (c *changefeed) cleanupCutover(ctx context.Context, etcdClient etcd.CDCEtcdClient) {
+	if !etcdAvailable(etcdClient) {
+		return
+	}
+	cutover, err := etcdClient.GetChangefeedCutover(ctx, c.id)
+	if err == nil && cutover != nil {
+		if cutover.State == model.CutoverStatePending && cutover.IsFromFinished() {
+			return
+		}
+		err = etcdClient.DeleteChangefeedCutover(ctx, c.id, cutover.ModRevision)
+	}
+	if err != nil {
+		// The owner deletes it later.
+		log.Warn("failed to remove changefeed cutover",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutoverDriver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	blue := model.DefaultChangeFeedID("blue")
+	green := model.DefaultChangeFeedID("green")
+	now := time.Now()
+	cutover := &model.Cutover{
+		From:        blue,
+		To:          green,
+		TargetTs:    100,
+		State:       model.CutoverStatePending,
+		StartedAt:   now.Add(-time.Minute),
+		ModRevision: 1,
+	}
+	me := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	listed := func() {
+		c := *cutover
+		me.EXPECT().GetChangefeedCutovers(gomock.Any(), model.DefaultNamespace).
+			Return(map[model.ChangeFeedID]*model.Cutover{blue: &c}, nil).Times(1)
+	}
+	saved := func() {
+		me.EXPECT().SaveChangefeedCutover(gomock.Any(), blue, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ model.ChangeFeedID, c *model.Cutover) error {
+				c.ModRevision++
+				*cutover = *c
+				return nil
+			}).Times(1)
+	}
+	d := &cutoverDriver{}
+
+	// the old changefeed doesn't reach the target ts yet.
+	listed()
+	progresses := map[model.ChangeFeedID]cutoverProgress{
+		blue:  {state: model.StateNormal, checkpointTs: 90},
+		green: {state: model.StateNormal, checkpointTs: 95},
+	}
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+	require.False(t, cutover.IsFromFinished())
+
+	// the old changefeed finishes, but the new changefeed doesn't pass the target ts.
+	listed()
+	saved()
+	progresses[blue] = cutoverProgress{state: model.StateFinished, checkpointTs: 100}
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+	require.True(t, cutover.IsFromFinished())
+	require.Equal(t, model.CutoverStatePending, cutover.State)
+	require.Equal(t, int64(2), cutover.ModRevision)
+
+	// the old changefeed is removed after it finishes, which doesn't block the
+	// cutover, and the failure to save the cutover is retried.
+	delete(progresses, blue)
+	progresses[green] = cutoverProgress{state: model.StateNormal, checkpointTs: 110}
+	listed()
+	me.EXPECT().SaveChangefeedCutover(gomock.Any(), blue, gomock.Any()).
+		Return(errors.New("etcd error")).Times(1)
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+	require.Equal(t, model.CutoverStatePending, cutover.State)
+	listed()
+	saved()
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+	require.Equal(t, model.CutoverStateCompleted, cutover.State)
+	require.Equal(t, uint64(110), cutover.ToCheckpointTs)
+
+	// the completed cutover of the removed changefeed is deleted.
+	listed()
+	me.EXPECT().DeleteChangefeedCutover(gomock.Any(), blue, int64(3)).Return(nil).Times(1)
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+
+	// the pending cutover of the changefeed removed before it finishes is deleted.
+	*cutover = model.Cutover{
+		From: blue, To: green, TargetTs: 200, State: model.CutoverStatePending,
+		StartedAt: now.Add(-time.Minute), ModRevision: 5,
+	}
+	listed()
+	me.EXPECT().DeleteChangefeedCutover(gomock.Any(), blue, int64(5)).Return(nil).Times(1)
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+
+	// the cutover not listed in the previous check may be started after the
+	// snapshot, it's skipped until the next check.
+	cutover.StartedAt = now.Add(-time.Second)
+	listed()
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+	listed()
+	me.EXPECT().DeleteChangefeedCutover(gomock.Any(), blue, int64(5)).Return(nil).Times(1)
+	require.NoError(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+
+	me.EXPECT().GetChangefeedCutovers(gomock.Any(), model.DefaultNamespace).
+		Return(nil, errors.New("etcd error")).Times(1)
+	require.Error(t, d.check(ctx, me, model.DefaultNamespace, progresses, now))
+}
+
+func TestCleanupCutover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("blue")
+	cf := &changefeed{id: id}
+	me := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	me.EXPECT().GetEtcdClient().Return(&etcd.Client{}).AnyTimes()
+
+	// no cutover.
+	me.EXPECT().GetChangefeedCutover(gomock.Any(), id).Return(nil, nil).Times(1)
+	cf.cleanupCutover(ctx, me)
+
+	// the pending cutover is deleted.
+	me.EXPECT().GetChangefeedCutover(gomock.Any(), id).Return(&model.Cutover{
+		From: id, State: model.CutoverStatePending, ModRevision: 3,
+	}, nil).Times(1)
+	me.EXPECT().DeleteChangefeedCutover(gomock.Any(), id, int64(3)).Return(nil).Times(1)
+	cf.cleanupCutover(ctx, me)
+
+	// the cutover waiting for the new changefeed is kept for the owner to complete.
+	me.EXPECT().GetChangefeedCutover(gomock.Any(), id).Return(&model.Cutover{
+		From: id, State: model.CutoverStatePending, FromFinishedAt: time.Now(), ModRevision: 4,
+	}, nil).Times(1)
+	cf.cleanupCutover(ctx, me)
+
+	// the completed cutover is deleted.
+	me.EXPECT().GetChangefeedCutover(gomock.Any(), id).Return(&model.Cutover{
+		From: id, State: model.CutoverStateCompleted, ModRevision: 5,
+	}, nil).Times(1)
+	me.EXPECT().DeleteChangefeedCutover(gomock.Any(), id, int64(5)).
+		Return(errors.New("etcd error")).Times(1)
+	cf.cleanupCutover(ctx, me)
+}
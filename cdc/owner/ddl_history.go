@@ -0,0 +1,69 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// recordDDLHistory appends the DDL event emitted by the DDL sink to the history
+// of the changefeed in etcd, so that users can inspect which DDLs have been
+// executed downstream. The history is written with the patches of the
+// changefeed state, so the owner tick isn't blocked by etcd and the write is
+// guarded by the revision of the key. The history is bounded by cfg.
+func recordDDLHistory(
+	state *orchestrator.ChangefeedReactorState,
+	cfg *config.DDLHistoryConfig, ddl *model.DDLEvent, now time.Time,
+) {
+	if cfg == nil || cfg.MaxCount == 0 {
+		return
+	}
+	entry := model.DDLHistoryEntry{
+		Query:      ddl.Query,
+		CommitTs:   ddl.CommitTs,
+		FinishedAt: now,
+		Target:     ddlTarget(ddl),
+	}
+	state.PatchDDLHistory(func(history *model.DDLHistory) (*model.DDLHistory, bool, error) {
+		if history == nil {
+			history = &model.DDLHistory{}
+		}
+		// The DDL may be recorded already if the owner re-executes it after a restart.
+		changed := history.Append(entry, cfg.MaxCount, cfg.Retention, now)
+		return history, changed, nil
+	})
+}
+
+// removeDDLHistory removes the emitted DDL history of the changefeed from etcd.
+func removeDDLHistory(state *orchestrator.ChangefeedReactorState) {
+	state.PatchDDLHistory(func(history *model.DDLHistory) (*model.DDLHistory, bool, error) {
+		return nil, history != nil, nil
+	})
+}
+
+// ddlTarget returns the quoted name of the table or schema the DDL applies to.
+func ddlTarget(ddl *model.DDLEvent) string {
+	if ddl.TableInfo == nil {
+		return ""
+	}
+	if ddl.TableInfo.TableName.Table == "" {
+		return quotes.QuoteName(ddl.TableInfo.TableName.Schema)
+	}
+	return ddl.TableInfo.TableName.QuoteString()
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordDDLHistory(t *testing.T) {
+	t.Parallel()
+
+	id := model.DefaultChangeFeedID("test-ddl-history")
+	state := orchestrator.NewChangefeedReactorState(etcd.DefaultCDCClusterID, id)
+	tester := orchestrator.NewReactorStateTester(t, state, nil)
+	key := (&etcd.CDCKey{
+		ClusterID:    etcd.DefaultCDCClusterID,
+		Tp:           etcd.CDCKeyTypeChangefeedDDLHistory,
+		ChangefeedID: id,
+	}).String()
+	loadHistory := func() *model.DDLHistory {
+		value, ok := tester.KVEntries()[key]
+		if !ok {
+			return nil
+		}
+		history := &model.DDLHistory{}
+		require.NoError(t, history.Unmarshal([]byte(value)))
+		return history
+	}
+
+	cfg := &config.DDLHistoryConfig{MaxCount: 2, Retention: time.Hour}
+	now := time.Now()
+	ddl := &model.DDLEvent{
+		Query:    "CREATE TABLE t(id int)",
+		CommitTs: 100,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t"},
+		},
+	}
+	recordDDLHistory(state, cfg, ddl, now)
+	tester.MustApplyPatches()
+	history := loadHistory()
+	require.Len(t, history.Entries, 1)
+	require.Equal(t, "`test`.`t`", history.Entries[0].Target)
+
+	// the same DDL is recorded only once
+	recordDDLHistory(state, cfg, ddl, now)
+	tester.MustApplyPatches()
+	require.Len(t, loadHistory().Entries, 1)
+
+	// the history is bounded by the max count
+	for i, query := range []string{"CREATE DATABASE test2", "CREATE DATABASE test3"} {
+		recordDDLHistory(state, cfg, &model.DDLEvent{
+			Query:     query,
+			CommitTs:  uint64(200 + i),
+			TableInfo: &model.TableInfo{TableName: model.TableName{Schema: "test2"}},
+		}, now)
+	}
+	tester.MustApplyPatches()
+	history = loadHistory()
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, "`test2`", history.Entries[0].Target)
+	require.Equal(t, uint64(201), history.Entries[1].CommitTs)
+
+	// recording is disabled
+	recordDDLHistory(state, &config.DDLHistoryConfig{}, &model.DDLEvent{Query: "DROP TABLE t", CommitTs: 300}, now)
+	require.Empty(t, state.GetPatches()[0])
+
+	removeDDLHistory(state)
+	tester.MustApplyPatches()
+	require.Nil(t, loadHistory())
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// ddlPacer limits the rate of DDLs sent to the downstream by the ddl sink, so
+// that a DDL storm in the upstream doesn't flood the downstream. A DDL held
+// back by the pacer is not executed, so the DMLs after it keep waiting on the
+// ddl barrier as usual.
+//
+// ddlPacer is not thread-safe, it's only used in the owner tick.
+type ddlPacer struct {
+	changefeedID model.ChangeFeedID
+
+	// limiter is nil if the number of DDLs per minute is not limited.
+	limiter               *rate.Limiter
+	maxOutstanding        int
+	queueWarningThreshold int
+
+	// waiting records when the DDLs start to wait for the pacer.
+	waiting map[*model.DDLEvent]time.Time
+	// outstanding are the DDLs allowed by the pacer but not finished yet.
+	outstanding map[*model.DDLEvent]struct{}
+	queueWarned bool
+
+	metricQueueLength  prometheus.Gauge
+	metricWaitDuration prometheus.Observer
+}
+
+func newDDLPacer(changefeedID model.ChangeFeedID, cfg *config.DDLPacingConfig) *ddlPacer {
+	p := &ddlPacer{
+		changefeedID: changefeedID,
+		waiting:      make(map[*model.DDLEvent]time.Time),
+		outstanding:  make(map[*model.DDLEvent]struct{}),
+
+		metricQueueLength: changefeedDDLQueueLengthGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		metricWaitDuration: changefeedDDLPacingWaitDuration.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+	}
+	if cfg == nil {
+		return p
+	}
+	if cfg.MaxDDLPerMinute > 0 {
+		p.limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(cfg.MaxDDLPerMinute)), 1)
+	}
+	p.maxOutstanding = cfg.MaxOutstanding
+	p.queueWarningThreshold = cfg.QueueWarningThreshold
+	return p
+}
+
+// tryAcquire returns true if the DDL can be sent to the downstream at `now`.
+// A DDL that has been allowed is always allowed until it's released.
+func (p *ddlPacer) tryAcquire(ddl *model.DDLEvent, now time.Time) bool {
+	if _, ok := p.outstanding[ddl]; ok {
+		return true
+	}
+	start, ok := p.waiting[ddl]
+	if !ok {
+		start = now
+		p.waiting[ddl] = now
+	}
+	if p.maxOutstanding > 0 && len(p.outstanding) >= p.maxOutstanding {
+		return false
+	}
+	if p.limiter != nil && !p.limiter.AllowN(now, 1) {
+		return false
+	}
+	delete(p.waiting, ddl)
+	p.outstanding[ddl] = struct{}{}
+	p.metricWaitDuration.Observe(now.Sub(start).Seconds())
+	return true
+}
+
+// release is called when the DDL is finished.
+func (p *ddlPacer) release(ddl *model.DDLEvent) {
+	delete(p.waiting, ddl)
+	delete(p.outstanding, ddl)
+}
+
+// observeQueue records the number of DDLs waiting to be executed, and warns
+// once when it exceeds the threshold.
+func (p *ddlPacer) observeQueue(length int) {
+	p.metricQueueLength.Set(float64(length))
+	if p.queueWarningThreshold <= 0 {
+		return
+	}
+	if length <= p.queueWarningThreshold {
+		p.queueWarned = false
+		return
+	}
+	if !p.queueWarned {
+		p.queueWarned = true
+		log.Warn("too many DDLs are waiting to be executed",
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Int("queueLength", length),
+			zap.Int("threshold", p.queueWarningThreshold))
+	}
+}
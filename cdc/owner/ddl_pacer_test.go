@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLPacerUnlimited(t *testing.T) {
+	t.Parallel()
+
+	p := newDDLPacer(model.DefaultChangeFeedID("test"), nil)
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		require.True(t, p.tryAcquire(&model.DDLEvent{CommitTs: uint64(i)}, now))
+	}
+}
+
+func TestDDLPacerMaxDDLPerMinute(t *testing.T) {
+	t.Parallel()
+
+	p := newDDLPacer(model.DefaultChangeFeedID("test"),
+		&config.DDLPacingConfig{MaxDDLPerMinute: 2})
+	now := time.Now()
+	ddl1 := &model.DDLEvent{CommitTs: 1}
+	ddl2 := &model.DDLEvent{CommitTs: 2}
+	require.True(t, p.tryAcquire(ddl1, now))
+	// an allowed ddl is always allowed until it's released.
+	require.True(t, p.tryAcquire(ddl1, now))
+	p.release(ddl1)
+
+	require.False(t, p.tryAcquire(ddl2, now))
+	require.False(t, p.tryAcquire(ddl2, now.Add(10*time.Second)))
+	require.Contains(t, p.waiting, ddl2)
+	require.True(t, p.tryAcquire(ddl2, now.Add(30*time.Second)))
+	require.NotContains(t, p.waiting, ddl2)
+	p.release(ddl2)
+	require.Empty(t, p.outstanding)
+}
+
+func TestDDLPacerMaxOutstanding(t *testing.T) {
+	t.Parallel()
+
+	p := newDDLPacer(model.DefaultChangeFeedID("test"),
+		&config.DDLPacingConfig{MaxOutstanding: 1})
+	now := time.Now()
+	ddl1 := &model.DDLEvent{CommitTs: 1}
+	ddl2 := &model.DDLEvent{CommitTs: 2}
+	require.True(t, p.tryAcquire(ddl1, now))
+	require.False(t, p.tryAcquire(ddl2, now))
+	p.release(ddl1)
+	require.True(t, p.tryAcquire(ddl2, now))
+}
+
+func TestDDLPacerObserveQueue(t *testing.T) {
+	t.Parallel()
+
+	p := newDDLPacer(model.DefaultChangeFeedID("test"),
+		&config.DDLPacingConfig{QueueWarningThreshold: 2})
+	p.observeQueue(1)
+	require.False(t, p.queueWarned)
+	p.observeQueue(3)
+	require.True(t, p.queueWarned)
+	p.observeQueue(4)
+	require.True(t, p.queueWarned)
+	p.observeQueue(2)
+	require.False(t, p.queueWarned)
+}
@@ -56,6 +56,13 @@ type DDLSink interface {
 	// the caller of this function can call again and again until a true returned
 	emitDDLEvent(ctx context.Context, ddl *model.DDLEvent) (bool, error)
 	emitSyncPoint(ctx context.Context, checkpointTs uint64) error
+	// emitFinishedTs emits the final checkpoint Ts to downstream when the changefeed
+	// reaches its target Ts, and return true if the final checkpoint is written.
+	// The caller of this function can call again and again until a true returned,
+	// no more checkpoint Ts will be sent to downstream after that.
+	emitFinishedTs(ctx context.Context, ts uint64, tables []*model.TableInfo) (bool, error)
+	// observeDDLQueue records the number of DDL jobs waiting to be executed.
+	observeDDLQueue(length int)
 	// close the sink, cancel running goroutine.
 	close(ctx context.Context) error
 	isInitialized() bool
@@ -70,13 +77,20 @@ type ddlSinkImpl struct {
 		sync.Mutex
 		checkpointTs  model.Ts
 		currentTables []*model.TableInfo
+		// finished is true if the final checkpoint has been written to downstream.
+		finished bool
 	}
 	// ddlSentTsMap is used to check whether a ddl event in a ddl job has been
 	// sent to `ddlCh` successfully.
 	ddlSentTsMap map[*model.DDLEvent]model.Ts
+	// pacer limits the rate of sending ddl events to `ddlCh`.
+	pacer *ddlPacer
 
 	ddlCh chan *model.DDLEvent
 	errCh chan error
+	// finishedCh is used to send the final checkpoint to the goroutine started by `run`.
+	finishedCh   chan *finishedCheckpoint
+	finishedSent bool
 
 	sinkV1 sinkv1.Sink
 	sinkV2 sinkv2.DDLEventSink
@@ -101,6 +115,7 @@ func newDDLSink(changefeedID model.ChangeFeedID, info *model.ChangeFeedInfo, rep
 	res := &ddlSinkImpl{
 		ddlSentTsMap:    make(map[*model.DDLEvent]uint64),
 		ddlCh:           make(chan *model.DDLEvent, 1),
+		finishedCh:      make(chan *finishedCheckpoint, 1),
 		sinkInitHandler: ddlSinkInitializer,
 		cancel:          func() {},
 
@@ -111,9 +126,20 @@ func newDDLSink(changefeedID model.ChangeFeedID, info *model.ChangeFeedInfo, rep
 		reportErr: reportErr,
 	}
 	res.initialized.Store(false)
+	var pacing *config.DDLPacingConfig
+	if info.Config != nil {
+		pacing = info.Config.DDLPacing
+	}
+	res.pacer = newDDLPacer(changefeedID, pacing)
 	return res
 }
 
+// finishedCheckpoint is the final checkpoint of a changefeed reaching its target Ts.
+type finishedCheckpoint struct {
+	ts     model.Ts
+	tables []*model.TableInfo
+}
+
 type ddlSinkInitHandler func(ctx context.Context, a *ddlSinkImpl) error
 
 func ddlSinkInitializer(ctx context.Context, a *ddlSinkImpl) error {
@@ -226,6 +252,17 @@ func (s *ddlSinkImpl) run(ctx context.Context) {
 					}
 				}
 
+			case finished := <-s.finishedCh:
+				if err := s.writeFinishedTs(ctx, finished); err != nil {
+					s.reportErr(err)
+					return
+				}
+				// checkpoints never exceed the finished ts, so they are skipped from now on.
+				lastCheckpointTs = finished.ts
+				s.mu.Lock()
+				s.mu.finished = true
+				s.mu.Unlock()
+
 			case ddl := <-s.ddlCh:
 				var err error
 				ddl.Query, err = addSpecialComment(ddl.Query)
@@ -318,6 +355,7 @@ func (s *ddlSinkImpl) emitDDLEvent(ctx context.Context, ddl *model.DDLEvent) (bo
 			zap.Any("DDL", ddl))
 		delete(s.ddlSentTsMap, ddl)
 		s.mu.Unlock()
+		s.pacer.release(ddl)
 		return true, nil
 	}
 	s.mu.Unlock()
@@ -331,6 +369,13 @@ func (s *ddlSinkImpl) emitDDLEvent(ctx context.Context, ddl *model.DDLEvent) (bo
 		// the DDL event is executing and not finished yet, return false
 		return false, nil
 	}
+	if !s.pacer.tryAcquire(ddl, time.Now()) {
+		log.Debug("ddl is held back by ddl pacing",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Any("DDL", ddl))
+		return false, nil
+	}
 	select {
 	case <-ctx.Done():
 		return false, errors.Trace(ctx.Err())
@@ -361,6 +406,51 @@ func (s *ddlSinkImpl) emitSyncPoint(ctx context.Context, checkpointTs uint64) er
 	return s.syncPointStore.SinkSyncPoint(ctx, s.changefeedID, checkpointTs)
 }
 
+func (s *ddlSinkImpl) emitFinishedTs(
+	ctx context.Context, ts uint64, tables []*model.TableInfo,
+) (bool, error) {
+	s.mu.Lock()
+	finished := s.mu.finished
+	s.mu.Unlock()
+	if finished || s.finishedSent {
+		return finished, nil
+	}
+	select {
+	case <-ctx.Done():
+		return false, errors.Trace(ctx.Err())
+	case s.finishedCh <- &finishedCheckpoint{ts: ts, tables: tables}:
+		s.finishedSent = true
+		log.Info("finished ts is sent",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Uint64("finishedTs", ts))
+	}
+	return false, nil
+}
+
+// writeFinishedTs writes the final checkpoint to downstream synchronously.
+// The sinks of v1 have no terminal marker, so only the checkpoint is written.
+func (s *ddlSinkImpl) writeFinishedTs(ctx context.Context, finished *finishedCheckpoint) error {
+	var err error
+	if s.sinkV1 != nil {
+		err = s.sinkV1.EmitCheckpointTs(ctx, finished.ts, finished.tables)
+	} else {
+		err = s.sinkV2.WriteFinishedTs(ctx, finished.ts, finished.tables)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("Write finished ts succeeded",
+		zap.String("namespace", s.changefeedID.Namespace),
+		zap.String("changefeed", s.changefeedID.ID),
+		zap.Uint64("finishedTs", finished.ts))
+	return nil
+}
+
+func (s *ddlSinkImpl) observeDDLQueue(length int) {
+	s.pacer.observeQueue(length)
+}
+
 func (s *ddlSinkImpl) close(ctx context.Context) (err error) {
 	s.cancel()
 	// they will both be nil if changefeed return an error in initializing
@@ -97,6 +97,33 @@ func TestCheckpoint(t *testing.T) {
 	require.Nil(t, waitCheckpointGrowingUp(mSink, 10))
 }
 
+func TestFinishedTs(t *testing.T) {
+	ddlSink, mSink := newDDLSink4Test(func(err error) {})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		ddlSink.close(ctx)
+	}()
+	ddlSink.run(ctx)
+
+	ddlSink.emitCheckpointTs(1, nil)
+	require.Eventually(t, func() bool {
+		done, err := ddlSink.emitFinishedTs(ctx, 10, nil)
+		require.Nil(t, err)
+		return done
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(10), atomic.LoadUint64(&mSink.checkpointTs))
+
+	// no more checkpoint is written after the changefeed is finished.
+	ddlSink.emitCheckpointTs(10, nil)
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, uint64(10), atomic.LoadUint64(&mSink.checkpointTs))
+	done, err := ddlSink.emitFinishedTs(ctx, 10, nil)
+	require.Nil(t, err)
+	require.True(t, done)
+}
+
 func TestExecDDLEvents(t *testing.T) {
 	ddlSink, mSink := newDDLSink4Test(func(err error) {})
 
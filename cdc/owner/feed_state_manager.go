@@ -50,6 +50,8 @@ type feedStateManager struct {
 	// shouldBeRemoved = true means the changefeed is removed
 	// shouldBeRemoved = false means the changefeed is paused
 	shouldBeRemoved bool
+	// shouldBeFinished = true means the changefeed reaches its target ts
+	shouldBeFinished bool
 
 	adminJobQueue   []*model.AdminJob
 	stateHistory    [defaultStateWindowSize]model.FeedState
@@ -127,6 +129,7 @@ func (m *feedStateManager) shiftStateWindow(state model.FeedState) {
 func (m *feedStateManager) Tick(state *orchestrator.ChangefeedReactorState) (adminJobPending bool) {
 	m.state = state
 	m.shouldBeRunning = true
+	m.shouldBeFinished = false
 	defer func() {
 		if m.shouldBeRunning {
 			m.patchState(model.StateNormal)
@@ -145,7 +148,11 @@ func (m *feedStateManager) Tick(state *orchestrator.ChangefeedReactorState) (adm
 		m.shouldBeRunning = false
 		m.shouldBeRemoved = true
 		return
-	case model.StateStopped, model.StateFailed, model.StateFinished:
+	case model.StateFinished:
+		m.shouldBeRunning = false
+		m.shouldBeFinished = true
+		return
+	case model.StateStopped, model.StateFailed:
 		m.shouldBeRunning = false
 		return
 	case model.StateError:
@@ -167,6 +174,10 @@ func (m *feedStateManager) ShouldRemoved() bool {
 	return m.shouldBeRemoved
 }
 
+func (m *feedStateManager) ShouldFinished() bool {
+	return m.shouldBeFinished
+}
+
 func (m *feedStateManager) MarkFinished() {
 	if m.state == nil {
 		// when state is nil, it means that Tick has never been called
@@ -238,6 +249,8 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 		m.state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
 			return nil, true, nil
 		})
+		// remove the emitted DDL history
+		removeDDLHistory(m.state)
 		checkpointTs := m.state.Info.GetCheckpointTs(m.state.Status)
 
 		log.Info("the changefeed is removed",
@@ -310,6 +323,7 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 			return
 		}
 		m.shouldBeRunning = false
+		m.shouldBeFinished = true
 		jobsPending = true
 		m.patchState(model.StateFinished)
 	default:
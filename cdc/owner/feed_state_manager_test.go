@@ -200,15 +200,23 @@ func TestMarkFinished(t *testing.T) {
 	manager.Tick(state)
 	tester.MustApplyPatches()
 	require.True(t, manager.ShouldRunning())
+	require.False(t, manager.ShouldFinished())
 
 	manager.MarkFinished()
 	manager.Tick(state)
 	tester.MustApplyPatches()
 
 	require.False(t, manager.ShouldRunning())
+	require.True(t, manager.ShouldFinished())
 	require.Equal(t, state.Info.State, model.StateFinished)
 	require.Equal(t, state.Info.AdminJobType, model.AdminFinish)
 	require.Equal(t, state.Status.AdminJobType, model.AdminFinish)
+
+	// the finished changefeed is still finished in the next tick.
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	require.False(t, manager.ShouldRunning())
+	require.True(t, manager.ShouldFinished())
 }
 
 func TestCleanUpInfos(t *testing.T) {
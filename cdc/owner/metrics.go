@@ -120,6 +120,29 @@ var (
 			Name:      "ignored_ddl_event_count",
 			Help:      "The total count of ddl events that are ignored in changefeed.",
 		}, []string{"namespace", "changefeed"})
+	changefeedDDLQueueLengthGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "owner",
+			Name:      "ddl_queue_length",
+			Help:      "The number of ddl jobs waiting to be executed of changefeeds.",
+		}, []string{"namespace", "changefeed"})
+	changefeedDDLPacingWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "owner",
+			Name:      "ddl_pacing_wait_duration",
+			Help:      "Bucketed histogram of the time ddl events wait for ddl pacing (s).",
+			Buckets:   prometheus.ExponentialBuckets(0.01 /* 10 ms */, 2, 18),
+		}, []string{"namespace", "changefeed"})
+	ownerHandoffDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "owner",
+			Name:      "handoff_duration",
+			Help:      "Bucketed histogram of the time owner waits to resign gracefully (s).",
+			Buckets:   prometheus.ExponentialBuckets(0.01 /* 10 ms */, 2, 18),
+		}, []string{"result"})
 )
 
 const (
@@ -148,6 +171,9 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(changefeedTickDuration)
 	registry.MustRegister(changefeedCloseDuration)
 	registry.MustRegister(changefeedIgnoredDDLEventCounter)
+	registry.MustRegister(changefeedDDLQueueLengthGauge)
+	registry.MustRegister(changefeedDDLPacingWaitDuration)
+	registry.MustRegister(ownerHandoffDuration)
 }
 
 // lagBucket returns the lag buckets for prometheus metric
@@ -8,6 +8,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/pingcap/tiflow/cdc/model"
@@ -75,6 +76,18 @@ func (mr *MockOwnerMockRecorder) EnqueueJob(adminJob, done interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueJob", reflect.TypeOf((*MockOwner)(nil).EnqueueJob), adminJob, done)
 }
 
+// GracefulResign mocks base method.
+func (m *MockOwner) GracefulResign(timeout time.Duration, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GracefulResign", timeout, done)
+}
+
+// GracefulResign indicates an expected call of GracefulResign.
+func (mr *MockOwnerMockRecorder) GracefulResign(timeout, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GracefulResign", reflect.TypeOf((*MockOwner)(nil).GracefulResign), timeout, done)
+}
+
 // Query mocks base method.
 func (m *MockOwner) Query(query *owner.Query, done chan<- error) {
 	m.ctrl.T.Helper()
@@ -99,6 +112,18 @@ func (mr *MockOwnerMockRecorder) RebalanceTables(cfID, done interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceTables", reflect.TypeOf((*MockOwner)(nil).RebalanceTables), cfID, done)
 }
 
+// SetTargetTs mocks base method.
+func (m *MockOwner) SetTargetTs(cfID model.ChangeFeedID, targetTs model.Ts, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTargetTs", cfID, targetTs, done)
+}
+
+// SetTargetTs indicates an expected call of SetTargetTs.
+func (mr *MockOwnerMockRecorder) SetTargetTs(cfID, targetTs, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTargetTs", reflect.TypeOf((*MockOwner)(nil).SetTargetTs), cfID, targetTs, done)
+}
+
 // ScheduleTable mocks base method.
 func (m *MockOwner) ScheduleTable(cfID model.ChangeFeedID, toCapture model.CaptureID, tableID model.TableID, done chan<- error) {
 	m.ctrl.T.Helper()
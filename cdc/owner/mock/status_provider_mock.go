@@ -125,6 +125,36 @@ func (mr *MockStatusProviderMockRecorder) GetChangeFeedStatus(ctx, changefeedID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedStatus", reflect.TypeOf((*MockStatusProvider)(nil).GetChangeFeedStatus), ctx, changefeedID)
 }
 
+// GetChangefeedBarriers mocks base method.
+func (m *MockStatusProvider) GetChangefeedBarriers(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.ChangefeedBarrier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedBarriers", ctx, changefeedID)
+	ret0, _ := ret[0].([]*model.ChangefeedBarrier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedBarriers indicates an expected call of GetChangefeedBarriers.
+func (mr *MockStatusProviderMockRecorder) GetChangefeedBarriers(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedBarriers", reflect.TypeOf((*MockStatusProvider)(nil).GetChangefeedBarriers), ctx, changefeedID)
+}
+
+// GetChangefeedLagBreakdown mocks base method.
+func (m *MockStatusProvider) GetChangefeedLagBreakdown(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedLagBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedLagBreakdown", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.ChangefeedLagBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedLagBreakdown indicates an expected call of GetChangefeedLagBreakdown.
+func (mr *MockStatusProviderMockRecorder) GetChangefeedLagBreakdown(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedLagBreakdown", reflect.TypeOf((*MockStatusProvider)(nil).GetChangefeedLagBreakdown), ctx, changefeedID)
+}
+
 // GetProcessors mocks base method.
 func (m *MockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	m.ctrl.T.Helper()
@@ -47,6 +47,8 @@ const (
 	ownerJobTypeAdminJob
 	ownerJobTypeDebugInfo
 	ownerJobTypeQuery
+	ownerJobTypeGracefulResign
+	ownerJobTypeSetTargetTs
 )
 
 // versionInconsistentLogRate represents the rate of log output when there are
@@ -85,6 +87,12 @@ type ownerJob struct {
 	// for scheduler related jobs
 	scheduleQuery *scheduler.Query
 
+	// for graceful resign only
+	resignTimeout time.Duration
+
+	// for SetTargetTs only
+	targetTs model.Ts
+
 	done chan<- error
 }
 
@@ -99,10 +107,12 @@ type Owner interface {
 		cfID model.ChangeFeedID, toCapture model.CaptureID,
 		tableID model.TableID, done chan<- error,
 	)
+	SetTargetTs(cfID model.ChangeFeedID, targetTs model.Ts, done chan<- error)
 	DrainCapture(query *scheduler.Query, done chan<- error)
 	WriteDebugInfo(w io.Writer, done chan<- error)
 	Query(query *Query, done chan<- error)
 	ValidateChangefeed(info *model.ChangeFeedInfo) error
+	GracefulResign(timeout time.Duration, done chan<- error)
 	AsyncStop()
 }
 
@@ -127,6 +137,12 @@ type ownerImpl struct {
 	// NOTICE: Do not use it in a method other than tick unexpectedly,
 	//         as it is not a thread-safe value.
 	changefeedTicked bool
+	// resigning is not nil if the owner is resigning gracefully.
+	// NOTICE: Do not use it in a method other than tick unexpectedly,
+	//         as it is not a thread-safe value.
+	resigning *gracefulResign
+	// cutovers completes the cutovers between changefeeds in the background.
+	cutovers cutoverDriver
 
 	newChangefeed func(
 		id model.ChangeFeedID,
@@ -196,8 +212,18 @@ func (o *ownerImpl) Tick(stdCtx context.Context, rawState orchestrator.ReactorSt
 		return nil, errors.Trace(err)
 	}
 
-	// Tick all changefeeds.
 	ctx := stdCtx.(cdcContext.Context)
+	// Resign before ticking changefeeds, so that the checkpoints in the
+	// handoff markers are the last ones of the changefeeds.
+	if o.resigning != nil && o.tryResign(ctx) {
+		o.AsyncStop()
+		for _, reactor := range o.changefeeds {
+			reactor.Close(ctx)
+		}
+		return state, cerror.ErrReactorFinished.GenWithStackByArgs()
+	}
+
+	// Tick all changefeeds.
 	for changefeedID, changefeedState := range state.Changefeeds {
 		if changefeedState.Info == nil {
 			o.cleanUpChangefeed(changefeedState)
@@ -233,12 +259,14 @@ func (o *ownerImpl) Tick(stdCtx context.Context, rawState orchestrator.ReactorSt
 			delete(o.changefeeds, changefeedID)
 		}
 	}
+	o.cutovers.tick(ctx, ctx.GlobalVars().EtcdClient, state)
 
 	// Close and cleanup all changefeeds.
 	if atomic.LoadInt32(&o.closed) != 0 {
 		for _, reactor := range o.changefeeds {
 			reactor.Close(ctx)
 		}
+		o.finishGracefulResign(cerror.ErrOwnerNotFound.GenWithStackByArgs())
 		return state, cerror.ErrReactorFinished.GenWithStackByArgs()
 	}
 
@@ -285,6 +313,18 @@ func (o *ownerImpl) ScheduleTable(
 	})
 }
 
+// SetTargetTs sets the target ts of a running changefeed, the changefeed is
+// finished at the target ts without being restarted.
+// `done` must be buffered to prevent blocking owner.
+func (o *ownerImpl) SetTargetTs(cfID model.ChangeFeedID, targetTs model.Ts, done chan<- error) {
+	o.pushOwnerJob(&ownerJob{
+		Tp:           ownerJobTypeSetTargetTs,
+		ChangefeedID: cfID,
+		targetTs:     targetTs,
+		done:         done,
+	})
+}
+
 // DrainCapture removes all tables at the target capture
 // `done` must be buffered to prevent blocking owner.
 func (o *ownerImpl) DrainCapture(query *scheduler.Query, done chan<- error) {
@@ -369,6 +409,7 @@ func (o *ownerImpl) cleanUpChangefeed(state *orchestrator.ChangefeedReactorState
 			return nil, position != nil, nil
 		})
 	}
+	removeDDLHistory(state)
 }
 
 // Bootstrap checks if the state contains incompatible or incorrect information and tries to fix it.
@@ -522,7 +563,8 @@ func (o *ownerImpl) handleJobs(ctx context.Context) {
 	for _, job := range jobs {
 		changefeedID := job.ChangefeedID
 		cfReactor, exist := o.changefeeds[changefeedID]
-		if !exist && (job.Tp != ownerJobTypeQuery && job.Tp != ownerJobTypeDrainCapture) {
+		if !exist && (job.Tp != ownerJobTypeQuery && job.Tp != ownerJobTypeDrainCapture &&
+			job.Tp != ownerJobTypeGracefulResign) {
 			log.Warn("changefeed not found when handle a job", zap.Any("job", job))
 			job.done <- cerror.ErrChangeFeedNotExists.FastGenByArgs(job.ChangefeedID)
 			close(job.done)
@@ -555,8 +597,13 @@ func (o *ownerImpl) handleJobs(ctx context.Context) {
 			if cfReactor.scheduler != nil {
 				cfReactor.scheduler.Rebalance()
 			}
+		case ownerJobTypeSetTargetTs:
+			job.done <- cfReactor.setTargetTs(job.targetTs)
 		case ownerJobTypeQuery:
 			job.done <- o.handleQueries(job.query)
+		case ownerJobTypeGracefulResign:
+			o.startGracefulResign(job.resignTimeout, job.done)
+			continue // the done channel is closed when the owner resigns
 		case ownerJobTypeDebugInfo:
 			// TODO: implement this function
 		}
@@ -665,6 +712,28 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 		query.Data = ret
 	case QueryHealth:
 		query.Data = o.isHealthy()
+	case QueryChangefeedLagBreakdown:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		provider := cfReactor.GetInfoProvider()
+		if provider == nil {
+			// The scheduler has not been initialized yet.
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		breakdown := provider.GetLagBreakdown()
+		query.Data = &breakdown
+	case QueryChangefeedBarriers:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		barriers, err := cfReactor.getBlockedBarriers(time.Now())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = barriers
 	}
 	return nil
 }
@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"go.uber.org/zap"
+)
+
+// gracefulResign is a graceful resignation of the owner in progress.
+type gracefulResign struct {
+	startTime time.Time
+	deadline  time.Time
+	// waiters are notified when the owner resigns.
+	waiters []chan<- error
+}
+
+// GracefulResign lets the owner resign after the in-flight DDLs and the pending
+// admin jobs of all changefeeds are finished, or `timeout` is reached. Before
+// resigning, it persists a handoff marker for each changefeed which has no DDL
+// in flight, so that the next owner doesn't need to pull the DDL at the
+// checkpoint ts again to check whether it's executed.
+// `done` must be buffered to prevent blocking owner.
+func (o *ownerImpl) GracefulResign(timeout time.Duration, done chan<- error) {
+	o.pushOwnerJob(&ownerJob{
+		Tp:            ownerJobTypeGracefulResign,
+		resignTimeout: timeout,
+		done:          done,
+	})
+}
+
+func (o *ownerImpl) startGracefulResign(timeout time.Duration, done chan<- error) {
+	if o.resigning == nil {
+		now := time.Now()
+		o.resigning = &gracefulResign{startTime: now, deadline: now.Add(timeout)}
+		log.Info("owner starts to resign gracefully", zap.Duration("timeout", timeout))
+	}
+	o.resigning.waiters = append(o.resigning.waiters, done)
+}
+
+// tryResign returns true if the owner should resign now, i.e. all changefeeds
+// are ready for the handoff or the graceful resignation times out. The handoff
+// markers are persisted before it returns true.
+func (o *ownerImpl) tryResign(ctx cdcContext.Context) bool {
+	now := time.Now()
+	allReady := true
+	cleanChangefeeds := make([]*changefeed, 0, len(o.changefeeds))
+	for _, cf := range o.changefeeds {
+		ready, clean := cf.handoffState()
+		allReady = allReady && ready
+		if clean {
+			cleanChangefeeds = append(cleanChangefeeds, cf)
+		}
+	}
+	timedOut := now.After(o.resigning.deadline)
+	if !allReady && !timedOut {
+		return false
+	}
+
+	etcdClient := ctx.GlobalVars().EtcdClient
+	if etcdAvailable(etcdClient) {
+		for _, cf := range cleanChangefeeds {
+			handoff := &model.OwnerHandoff{
+				CaptureID:    ctx.GlobalVars().CaptureInfo.ID,
+				CheckpointTs: cf.state.Status.CheckpointTs,
+				ResignedAt:   now,
+			}
+			// The marker only saves the next owner from pulling a DDL again,
+			// so the resignation goes on if it fails to be persisted.
+			if err := etcdClient.SaveChangefeedOwnerHandoff(ctx, cf.id, handoff); err != nil {
+				log.Warn("failed to persist owner handoff marker",
+					zap.String("namespace", cf.id.Namespace),
+					zap.String("changefeed", cf.id.ID),
+					zap.Error(err))
+			}
+		}
+	}
+
+	result := "clean"
+	if !allReady {
+		result = "timeout"
+	}
+	duration := now.Sub(o.resigning.startTime)
+	ownerHandoffDuration.WithLabelValues(result).Observe(duration.Seconds())
+	log.Info("owner resigns gracefully",
+		zap.String("result", result),
+		zap.Int("changefeeds", len(o.changefeeds)),
+		zap.Int("cleanChangefeeds", len(cleanChangefeeds)),
+		zap.Duration("duration", duration))
+	o.finishGracefulResign(nil)
+	return true
+}
+
+// finishGracefulResign notifies the waiters of the graceful resignation.
+func (o *ownerImpl) finishGracefulResign(err error) {
+	if o.resigning == nil {
+		return
+	}
+	for _, done := range o.resigning.waiters {
+		if err != nil {
+			done <- err
+		}
+		close(done)
+	}
+	o.resigning = nil
+}
+
+// handoffState returns whether the changefeed is ready for the owner to
+// resign, i.e. no DDL is being executed and no admin job is pending, and
+// whether the handoff is clean, i.e. the DDL at the checkpoint ts is known to
+// be executed, so the next owner can skip checking it.
+func (c *changefeed) handoffState() (ready, clean bool) {
+	if len(c.feedStateManager.adminJobQueue) > 0 {
+		return false, false
+	}
+	if !c.initialized || c.state.Status == nil {
+		// Nothing is in flight, but the checkpoint ts is unknown.
+		return true, false
+	}
+	if c.ddlEventCache != nil {
+		return false, false
+	}
+	// The DDL reached by the checkpoint ts is waiting to be executed.
+	if ddlTs, job := c.ddlPuller.FrontDDL(); job != nil && ddlTs <= c.state.Status.CheckpointTs {
+		return false, false
+	}
+	return true, true
+}
+
+// consumeOwnerHandoff returns true if the previous owner resigned gracefully
+// at the given checkpoint ts, and removes the handoff marker. The error is
+// returned if the marker can't be got or removed, so that the initialization
+// is retried, otherwise a stale marker may be consumed by a later owner whose
+// checkpoint ts happens to be the same, and the DDL at it may be skipped.
+func (c *changefeed) consumeOwnerHandoff(ctx cdcContext.Context, checkpointTs model.Ts) (bool, error) {
+	etcdClient := ctx.GlobalVars().EtcdClient
+	if !etcdAvailable(etcdClient) {
+		return false, nil
+	}
+	handoff, err := etcdClient.GetChangefeedOwnerHandoff(ctx, c.id)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if handoff == nil {
+		return false, nil
+	}
+	if err := etcdClient.DeleteChangefeedOwnerHandoff(ctx, c.id); err != nil {
+		return false, errors.Trace(err)
+	}
+	if handoff.CheckpointTs != checkpointTs {
+		// The checkpoint ts is changed after the handoff, e.g. by resuming
+		// the changefeed with an overwritten checkpoint ts.
+		return false, nil
+	}
+	log.Info("previous owner resigned gracefully, skip checking the DDL at checkpoint",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.String("previousOwner", handoff.CaptureID),
+		zap.Uint64("checkpointTs", checkpointTs))
+	return true, nil
+}
+
+// cleanupOwnerHandoff removes the owner handoff marker of the changefeed.
+func (c *changefeed) cleanupOwnerHandoff(ctx context.Context, etcdClient etcd.CDCEtcdClient) {
+	if !etcdAvailable(etcdClient) {
+		return
+	}
+	if err := etcdClient.DeleteChangefeedOwnerHandoff(ctx, c.id); err != nil {
+		log.Warn("failed to remove owner handoff marker",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID),
+			zap.Error(err))
+	}
+}
+
+// etcdAvailable returns false if there is no etcd client, which happens in unit tests.
+func etcdAvailable(etcdClient etcd.CDCEtcdClient) bool {
+	return etcdClient != nil && etcdClient.GetEtcdClient() != nil
+}
@@ -729,6 +729,55 @@ func TestAsyncStop(t *testing.T) {
 	}
 }
 
+func TestGracefulResign(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(false)
+	ctx, cancel := cdcContext.WithCancel(ctx)
+	defer cancel()
+
+	owner, state, tester := createOwner4Test(ctx, t)
+
+	changefeedID := model.DefaultChangeFeedID("test-changefeed")
+	changefeedInfo := &model.ChangeFeedInfo{
+		StartTs: oracle.GoTimeToTS(time.Now()),
+		Config:  config.GetDefaultReplicaConfig(),
+	}
+	changefeedStr, err := changefeedInfo.Marshal()
+	require.Nil(t, err)
+	cdcKey := etcd.CDCKey{
+		ClusterID:    state.ClusterID,
+		Tp:           etcd.CDCKeyTypeChangefeedInfo,
+		ChangefeedID: changefeedID,
+	}
+	tester.MustUpdate(cdcKey.String(), []byte(changefeedStr))
+	_, err = owner.Tick(ctx, state)
+	tester.MustApplyPatches()
+	require.Nil(t, err)
+	require.Contains(t, owner.changefeeds, changefeedID)
+
+	// the owner waits for the pending admin job of the changefeed
+	owner.changefeeds[changefeedID].feedStateManager.PushAdminJob(&model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminStop,
+	})
+	done := make(chan error, 1)
+	owner.GracefulResign(time.Hour, done)
+	owner.handleJobs(ctx)
+	require.False(t, owner.tryResign(ctx))
+	require.NotNil(t, owner.resigning)
+	select {
+	case <-done:
+		require.Fail(t, "unexpected")
+	default:
+	}
+
+	// the owner resigns once the graceful resignation times out
+	owner.resigning.deadline = time.Now().Add(-time.Second)
+	_, err = owner.Tick(ctx, state)
+	require.True(t, cerror.ErrReactorFinished.Equal(errors.Cause(err)))
+	require.Nil(t, owner.resigning)
+	require.Nil(t, <-done)
+}
+
 func TestHandleDrainCapturesSchedulerNotReady(t *testing.T) {
 	t.Parallel()
 
@@ -1006,3 +1055,42 @@ func TestValidateChangefeed(t *testing.T) {
 	require.Equal(t, 0, len(o.removedChangefeed))
 	require.Equal(t, 0, len(o.removedSinkURI))
 }
+
+type lagBreakdownScheduler struct {
+	scheduler.Scheduler
+	scheduler.InfoProvider
+	breakdown model.ChangefeedLagBreakdown
+}
+
+func (s *lagBreakdownScheduler) GetLagBreakdown() model.ChangefeedLagBreakdown {
+	return s.breakdown
+}
+
+func TestQueryChangefeedLagBreakdown(t *testing.T) {
+	t.Parallel()
+
+	o := &ownerImpl{changefeeds: make(map[model.ChangeFeedID]*changefeed)}
+	cfID := model.DefaultChangeFeedID("test")
+	query := &Query{Tp: QueryChangefeedLagBreakdown, ChangeFeedID: cfID}
+
+	// changefeed not exists
+	err := o.handleQueries(query)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+
+	// scheduler not initialized
+	cf := &changefeed{state: &orchestrator.ChangefeedReactorState{}}
+	o.changefeeds[cfID] = cf
+	err = o.handleQueries(query)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+
+	breakdown := model.ChangefeedLagBreakdown{
+		CurrentTs:        4,
+		PullerResolvedTs: 3,
+		SorterOutputTs:   2,
+		SinkFlushedTs:    1,
+	}
+	cf.scheduler = &lagBreakdownScheduler{breakdown: breakdown}
+	err = o.handleQueries(query)
+	require.NoError(t, err)
+	require.Equal(t, &breakdown, query.Data.(*model.ChangefeedLagBreakdown))
+}
@@ -47,6 +47,12 @@ type StatusProvider interface {
 
 	// IsHealthy return true if the cluster is healthy
 	IsHealthy(ctx context.Context) (bool, error)
+
+	// GetChangefeedLagBreakdown returns the progress of each stage of a changefeed.
+	GetChangefeedLagBreakdown(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedLagBreakdown, error)
+
+	// GetChangefeedBarriers returns the barriers which a changefeed is blocked at.
+	GetChangefeedBarriers(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.ChangefeedBarrier, error)
 }
 
 // QueryType is the type of different queries.
@@ -65,6 +71,10 @@ const (
 	QueryCaptures
 	// QueryHealth is the type of query cluster health info.
 	QueryHealth
+	// QueryChangefeedLagBreakdown is the type of query changefeed lag breakdown.
+	QueryChangefeedLagBreakdown
+	// QueryChangefeedBarriers is the type of query changefeed blocked barriers.
+	QueryChangefeedBarriers
 )
 
 // Query wraps query command and return results.
@@ -169,6 +179,28 @@ func (p *ownerStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	return query.Data.(bool), nil
 }
 
+func (p *ownerStatusProvider) GetChangefeedLagBreakdown(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedLagBreakdown, error) {
+	query := &Query{
+		Tp:           QueryChangefeedLagBreakdown,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.(*model.ChangefeedLagBreakdown), nil
+}
+
+func (p *ownerStatusProvider) GetChangefeedBarriers(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.ChangefeedBarrier, error) {
+	query := &Query{
+		Tp:           QueryChangefeedBarriers,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.([]*model.ChangefeedBarrier), nil
+}
+
 func (p *ownerStatusProvider) sendQueryToOwner(ctx context.Context, query *Query) error {
 	doneCh := make(chan error, 1)
 	p.owner.Query(query, doneCh)
@@ -0,0 +1,67 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"go.uber.org/zap"
+)
+
+// reportedCheckpoint is the last checkpoint of a table span reported to the
+// scheduler, and the number of times it goes backward.
+type reportedCheckpoint struct {
+	checkpointTs model.Ts
+	rollbacks    int
+}
+
+// GetTableSpanCheckpointRollbacks implements TableExecutor interface.
+func (p *processor) GetTableSpanCheckpointRollbacks(span tablepb.Span) int {
+	reported, _ := p.reportedCheckpoints.Get(span)
+	return reported.rollbacks
+}
+
+// checkCheckpointRollback counts and logs the checkpoints of the replicating
+// table span going backward, and returns the checkpoint reported to record. It
+// only reports the bug, the status is reported as it is. The checkpoint of a
+// replaying table span is rewound on purpose, and it's recorded without being
+// checked. It returns false if the span is absent.
+func (p *processor) checkCheckpointRollback(status tablepb.TableStatus) (reportedCheckpoint, bool) {
+	reported, ok := p.reportedCheckpoints.Get(status.Span)
+	switch status.State {
+	case tablepb.TableStateAbsent:
+		return reportedCheckpoint{}, false
+	case tablepb.TableStateReplicating:
+	default:
+		return reported, ok
+	}
+
+	checkpointTs := status.Checkpoint.CheckpointTs
+	if ok && checkpointTs < reported.checkpointTs && !p.replayingSpans.Has(status.Span) {
+		reported.rollbacks++
+		p.metricCheckpointRollbackCounter.Inc()
+		log.Error("Checkpoint ts of the table span goes backward",
+			zap.String("captureID", p.captureInfo.ID),
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Stringer("span", &status.Span),
+			zap.Uint64("reportedCheckpointTs", reported.checkpointTs),
+			zap.Uint64("checkpointTs", checkpointTs),
+			zap.Int("rollbacks", reported.rollbacks))
+	}
+	// count a rollback only once, even if the checkpoint stays lower.
+	reported.checkpointTs = checkpointTs
+	return reported, true
+}
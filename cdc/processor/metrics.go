@@ -124,6 +124,30 @@ var (
 			Name:      "remain_kv_events",
 			Help:      "processor's kv events that remained in sorter",
 		}, []string{"namespace", "changefeed"})
+
+	tableSpanAlertGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "processor",
+			Name:      "table_span_alerts",
+			Help:      "number of table spans exceeding their alerting thresholds",
+		}, []string{"namespace", "changefeed", "kind"})
+
+	tableSpanNeverAdvancedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "processor",
+			Name:      "table_span_never_advanced",
+			Help:      "number of table spans whose checkpoints never advance since they are added",
+		}, []string{"namespace", "changefeed"})
+
+	tableSpanCheckpointRollbackCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "processor",
+			Name:      "table_span_checkpoint_rollback_total",
+			Help:      "number of times a table span reports a checkpoint lower than the one reported before",
+		}, []string{"namespace", "changefeed"})
 )
 
 // InitMetrics registers all metrics used in processor
@@ -142,6 +166,9 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(tableMemoryHistogram)
 	registry.MustRegister(processorMemoryGauge)
 	registry.MustRegister(remainKVEventsGauge)
+	registry.MustRegister(tableSpanAlertGauge)
+	registry.MustRegister(tableSpanNeverAdvancedGauge)
+	registry.MustRegister(tableSpanCheckpointRollbackCounter)
 	pipeline.InitMetrics(registry)
 	sinkmanager.InitMetrics(registry)
 }
@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"go.uber.org/zap"
+)
+
+// neverAdvancedSpanAlertAge is the age after which a table span whose checkpoint
+// never advances is alerted, it's consistent with the alert rule of changefeed
+// checkpoint lag.
+const neverAdvancedSpanAlertAge = 10 * time.Minute
+
+// addedSpan records when a table span is added and from which ts.
+type addedSpan struct {
+	startTs model.Ts
+	addedAt time.Time
+}
+
+// markSpanAdded records that the table span is added with `startTs`. It's
+// called again when a prepared table span starts replicating, so the time
+// spent in waiting for the commit of the two-phase scheduling isn't counted.
+func (p *processor) markSpanAdded(span tablepb.Span, startTs model.Ts) {
+	p.addedSpans.ReplaceOrInsert(span, addedSpan{startTs: startTs, addedAt: time.Now()})
+}
+
+// GetNeverAdvancedSpans implements TableExecutor interface.
+func (p *processor) GetNeverAdvancedSpans(minAge time.Duration) []tablepb.Span {
+	return p.getNeverAdvancedSpans(minAge, time.Now())
+}
+
+func (p *processor) getNeverAdvancedSpans(minAge time.Duration, now time.Time) []tablepb.Span {
+	spans := make([]tablepb.Span, 0)
+	var removed []tablepb.Span
+	p.addedSpans.Ascend(func(span tablepb.Span, added addedSpan) bool {
+		status := p.getTableSpanStatus(span)
+		switch status.State {
+		case tablepb.TableStateAbsent:
+			removed = append(removed, span)
+		case tablepb.TableStatePreparing, tablepb.TableStatePrepared, tablepb.TableStateReplicating:
+			// the checkpoint of a replaying table span is rewound on purpose.
+			if p.replayingSpans.Has(span) {
+				return true
+			}
+			if now.Sub(added.addedAt) >= minAge && status.Checkpoint.CheckpointTs <= added.startTs {
+				spans = append(spans, span)
+			}
+		}
+		return true
+	})
+	// forget the removed table spans, they are marked again if they are added again.
+	for _, span := range removed {
+		p.addedSpans.Delete(span)
+	}
+	return spans
+}
+
+// handleNeverAdvancedSpans logs the table spans which are found or stop being
+// found never advanced, and updates the number of them.
+func (p *processor) handleNeverAdvancedSpans() {
+	neverAdvancedSpans := spanz.NewMap[struct{}]()
+	for _, span := range p.GetNeverAdvancedSpans(neverAdvancedSpanAlertAge) {
+		neverAdvancedSpans.ReplaceOrInsert(span, struct{}{})
+		if !p.neverAdvancedSpans.Has(span) {
+			added, _ := p.addedSpans.Get(span)
+			log.Warn("table span never advances since it's added",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span),
+				zap.Uint64("startTs", added.startTs),
+				zap.Time("addedAt", added.addedAt))
+		}
+	}
+	p.neverAdvancedSpans.Ascend(func(span tablepb.Span, _ struct{}) bool {
+		if !neverAdvancedSpans.Has(span) {
+			log.Info("table span advances or is removed",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span))
+		}
+		return true
+	})
+	p.neverAdvancedSpans = neverAdvancedSpans
+	p.metricNeverAdvancedSpanGauge.Set(float64(neverAdvancedSpans.Len()))
+}
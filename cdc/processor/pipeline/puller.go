@@ -15,6 +15,7 @@ package pipeline
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/contextutil"
@@ -37,6 +38,9 @@ type pullerNode struct {
 	changefeed model.ChangeFeedID
 	cancel     context.CancelFunc
 	wg         *errgroup.Group
+
+	resolvedTsInterval      time.Duration
+	followerIncrementalScan bool
 }
 
 func newPullerNode(
@@ -44,12 +48,16 @@ func newPullerNode(
 	startTs model.Ts,
 	tableName string,
 	changefeed model.ChangeFeedID,
+	resolvedTsInterval time.Duration,
+	followerIncrementalScan bool,
 ) *pullerNode {
 	return &pullerNode{
-		span:       tableID,
-		startTs:    startTs,
-		tableName:  tableName,
-		changefeed: changefeed,
+		span:                    tableID,
+		startTs:                 startTs,
+		tableName:               tableName,
+		changefeed:              changefeed,
+		resolvedTsInterval:      resolvedTsInterval,
+		followerIncrementalScan: followerIncrementalScan,
 	}
 }
 
@@ -61,7 +69,8 @@ func (n *pullerNode) startWithSorterNode(ctx pipeline.NodeContext,
 	ctxC, cancel := context.WithCancel(ctx)
 	ctxC = contextutil.PutCaptureAddrInCtx(ctxC, ctx.GlobalVars().CaptureInfo.AdvertiseAddr)
 	ctxC = contextutil.PutRoleInCtx(ctxC, util.RoleProcessor)
-	kvCfg := config.GetGlobalServerConfig().KVClient
+	kvCfg := config.GetGlobalServerConfig().KVClient.
+		WithFollowerIncrementalScan(n.followerIncrementalScan)
 	// NOTICE: always pull the old value internally
 	// See also: https://github.com/pingcap/tiflow/issues/2301.
 	n.plr = puller.New(
@@ -78,6 +87,7 @@ func (n *pullerNode) startWithSorterNode(ctx pipeline.NodeContext,
 		n.span.TableID,
 		n.tableName,
 		filterLoop,
+		n.resolvedTsInterval,
 	)
 	n.wg.Go(func() error {
 		ctx.Throw(errors.Trace(n.plr.Run(ctxC)))
@@ -43,7 +43,7 @@ import (
 const (
 	// TODO determine a reasonable default value
 	// This is part of sink performance optimization
-	resolvedTsInterpolateInterval = 200 * time.Millisecond
+	defaultResolvedTsInterpolateInterval = 200 * time.Millisecond
 	// defaultBatchReadSize is the default batch size of read from sorter
 	defaultBatchReadSize = 256
 )
@@ -96,14 +96,21 @@ type sorterNode struct {
 	// remainEvents record the amount of event remain in sorter engine
 	remainEvents int64
 	startTs      model.Ts
+	// resolvedTsInterpolateInterval is the interval of interpolating a resolved
+	// ts if none has been sent to sink for some time.
+	resolvedTsInterpolateInterval time.Duration
 }
 
 func newSorterNode(
 	tableName string, tableID model.TableID, startTs model.Ts,
 	flowController tableFlowController, mg entry.MounterGroup,
 	state *tablepb.TableState, changefeed model.ChangeFeedID, redoLogEnabled bool,
-	pdClient pd.Client,
+	pdClient pd.Client, resolvedTsInterval time.Duration,
 ) *sorterNode {
+	interpolateInterval := defaultResolvedTsInterpolateInterval
+	if resolvedTsInterval > 0 {
+		interpolateInterval = resolvedTsInterval
+	}
 	return &sorterNode{
 		tableName:      tableName,
 		tableID:        tableID,
@@ -117,7 +124,8 @@ func newSorterNode(
 		redoLogEnabled: redoLogEnabled,
 		pdClient:       pdClient,
 
-		changefeed: changefeed,
+		changefeed:                    changefeed,
+		resolvedTsInterpolateInterval: interpolateInterval,
 	}
 }
 
@@ -377,7 +385,7 @@ func (n *sorterNode) start(
 
 				commitTs := e.CRTs
 				// We interpolate a resolved-ts if none has been sent for some time.
-				if time.Since(lastSendResolvedTsTime) > resolvedTsInterpolateInterval {
+				if time.Since(lastSendResolvedTsTime) > n.resolvedTsInterpolateInterval {
 					resolvedTsInterpolateFunc(commitTs)
 				}
 
@@ -66,7 +66,7 @@ func TestSorterResolvedTs(t *testing.T) {
 	t.Parallel()
 	state := tablepb.TableStatePreparing
 	sn := newSorterNode("tableName", 1, 1, nil, nil, &state,
-		model.DefaultChangeFeedID("changefeed-id-test"), false, &mockPD{})
+		model.DefaultChangeFeedID("changefeed-id-test"), false, &mockPD{}, 0)
 	sn.sorter = memory.NewEntrySorter()
 	require.Equal(t, model.Ts(1), sn.ResolvedTs())
 	require.Equal(t, tablepb.TableStatePreparing, sn.State())
@@ -158,7 +158,7 @@ func TestSorterReplicateTs(t *testing.T) {
 	ts := oracle.ComposeTS(1, 1)
 	state := tablepb.TableStatePreparing
 	sn := newSorterNode(t.Name(), 1, 1, &mockFlowController{}, mockMounter{}, &state,
-		model.DefaultChangeFeedID(t.Name()), false, p)
+		model.DefaultChangeFeedID(t.Name()), false, p, 0)
 	sn.sorter = memory.NewEntrySorter()
 
 	require.Equal(t, model.Ts(1), sn.ResolvedTs())
@@ -211,7 +211,7 @@ func TestSorterResolvedTsLessEqualBarrierTs(t *testing.T) {
 	s := &checkSorter{ch: sch}
 	state := tablepb.TableStatePreparing
 	sn := newSorterNode("tableName", 1, 1, nil, nil, &state,
-		model.DefaultChangeFeedID("changefeed-id-test"), false, &mockPD{})
+		model.DefaultChangeFeedID("changefeed-id-test"), false, &mockPD{}, 0)
 	sn.sorter = s
 	require.Equal(t, model.Ts(1), sn.ResolvedTs())
 
@@ -300,7 +300,7 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 	sorterNode := newSorterNode(t.tableName, t.span.TableID,
 		t.replicaInfo.StartTs, flowController,
 		t.mg, &t.state, t.changefeedID, t.redoManager.Enabled(),
-		t.upstream.PDClient,
+		t.upstream.PDClient, t.replicaConfig.ResolvedTsInterval,
 	)
 	t.sortNode = sorterNode
 
@@ -315,7 +315,9 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		return err
 	}
 
-	pullerNode := newPullerNode(t.span, t.replicaInfo.StartTs, t.tableName, t.changefeedVars.ID)
+	pullerNode := newPullerNode(t.span, t.replicaInfo.StartTs, t.tableName,
+		t.changefeedVars.ID, t.replicaConfig.ResolvedTsInterval,
+		t.replicaConfig.EnableFollowerIncrementalScan)
 	pullerActorNodeContext := newContext(sdtTableContext,
 		t.tableName,
 		t.globalVars.TableActorSystem.Router(),
@@ -337,7 +339,7 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		t.tableSinkV1,
 		t.tableSinkV2,
 		t.replicaInfo.StartTs, t.targetTs, flowController, t.redoManager,
-		&t.state, t.changefeedID, t.replicaConfig.EnableOldValue, splitTxn,
+		&t.state, t.changefeedID, t.replicaConfig.HasOldValue(), splitTxn,
 	)
 	t.sinkNode = actorSinkNode
 
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,6 +48,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/retry"
+	psink "github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -100,6 +103,43 @@ type processor struct {
 	agent        scheduler.Agent
 	checkpointTs model.Ts
 	resolvedTs   model.Ts
+	// openTableLimit is the max number of table spans, 0 means no limit.
+	openTableLimit int
+	// globalCheckpointTs is the checkpoint ts of the changefeed set by the
+	// scheduler, 0 means it's not set yet.
+	globalCheckpointTs model.Ts
+	// unflushedAges tracks the age of the oldest unflushed event of table spans.
+	unflushedAges *spanz.Map[*unflushedAgeTracker]
+	// sinkQueueTrends tracks the recent sink queue depths of table spans.
+	sinkQueueTrends *spanz.Map[*sinkQueueTrendTracker]
+	// alertThresholds are the alerting thresholds set for table spans, they
+	// are kept even if the table spans are removed.
+	alertThresholds       *spanz.Map[scheduler.AlertThresholds]
+	globalAlertThresholds scheduler.AlertThresholds
+	// alertingSpans records the kinds of thresholds exceeded by table spans.
+	alertingSpans *spanz.Map[[]string]
+	// replayingSpans tracks the table spans which are being replayed.
+	replayingSpans *spanz.Map[*replayingSpan]
+	// retiringSpans tracks the table spans whose tables are dropped in the
+	// upstream, the values are the finished ts of the drops.
+	retiringSpans *spanz.Map[model.Ts]
+	// checkpointIntervals are the checkpoint persistence intervals set for
+	// table spans, they are kept even if the table spans are removed.
+	// globalCheckpointInterval is 0 by default, i.e. the checkpoints are
+	// reported as they are, and persisted by the owner along with the changefeed.
+	checkpointIntervals      *spanz.Map[time.Duration]
+	globalCheckpointInterval time.Duration
+	// persistedCheckpoints are the last checkpoints of table spans reported
+	// to the owner to be persisted, they're advanced in the processor tick.
+	persistedCheckpoints *spanz.Map[persistedCheckpoint]
+	// addedSpans records when table spans are added, and neverAdvancedSpans
+	// are the ones whose checkpoints never advance since then.
+	addedSpans         *spanz.Map[addedSpan]
+	neverAdvancedSpans *spanz.Map[struct{}]
+	// reportedCheckpoints are the last checkpoints of table spans reported to
+	// the scheduler, they're checked in the processor tick to detect the
+	// checkpoints going backward.
+	reportedCheckpoints *spanz.Map[reportedCheckpoint]
 
 	metricResolvedTsGauge           prometheus.Gauge
 	metricResolvedTsLagGauge        prometheus.Gauge
@@ -115,6 +155,8 @@ type processor struct {
 	metricsTableMemoryHistogram     prometheus.Observer
 	metricsProcessorMemoryGauge     prometheus.Gauge
 	metricRemainKVEventGauge        prometheus.Gauge
+	metricNeverAdvancedSpanGauge    prometheus.Gauge
+	metricCheckpointRollbackCounter prometheus.Counter
 }
 
 // checkReadyForMessages checks whether all necessary Etcd keys have been established.
@@ -182,6 +224,8 @@ func (p *processor) AddTableSpan(
 				} else {
 					p.tableSpans.GetV(span).Start(startTs)
 				}
+				p.markSpanAdded(span, startTs)
+				p.reportedCheckpoints.Delete(span)
 			}
 			return true, nil
 		case tablepb.TableStateReplicating:
@@ -219,6 +263,17 @@ func (p *processor) AddTableSpan(
 			zap.Bool("isPrepare", isPrepare))
 	}
 
+	if count := p.GetTableSpanCount(); p.openTableLimit > 0 && count >= p.openTableLimit {
+		log.Warn("addTable: too many open tables, reject it",
+			zap.String("captureID", p.captureInfo.ID),
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Stringer("span", &span),
+			zap.Int("tableCount", count),
+			zap.Int("limit", p.openTableLimit))
+		return false, cerror.ErrTooManyOpenTables.GenWithStackByArgs(count, p.openTableLimit)
+	}
+
 	if p.pullBasedSinking {
 		p.sinkManager.AddTable(
 			span.TableID, startTs, p.changefeed.Info.TargetTs)
@@ -235,6 +290,8 @@ func (p *processor) AddTableSpan(
 		}
 		p.tableSpans.ReplaceOrInsert(span, table)
 	}
+	p.markSpanAdded(span, startTs)
+	p.reportedCheckpoints.Delete(span)
 
 	return true, nil
 }
@@ -248,6 +305,9 @@ func (p *processor) RemoveTableSpan(span tablepb.Span) bool {
 	if p.pullBasedSinking {
 		_, exist := p.sinkManager.GetTableState(span.TableID)
 		if !exist {
+			if p.isRetiring(span) {
+				return true
+			}
 			log.Warn("Table which will be deleted is not found",
 				zap.String("capture", p.captureInfo.ID),
 				zap.String("namespace", p.changefeedID.Namespace),
@@ -260,6 +320,9 @@ func (p *processor) RemoveTableSpan(span tablepb.Span) bool {
 	}
 	table, ok := p.tableSpans.Get(span)
 	if !ok {
+		if p.isRetiring(span) {
+			return true
+		}
 		log.Warn("table which will be deleted is not found",
 			zap.String("capture", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
@@ -376,6 +439,12 @@ func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool
 		if alreadyExist {
 			stats := p.sinkManager.GetTableStats(span.TableID)
 			tableCheckpointTs = stats.CheckpointTs
+			// The source can't be removed until the table sink replaced by
+			// a replay is stopped.
+			if state == tablepb.TableStateStopped &&
+				!p.sinkManager.IsReplacedTableSinkStopped(span.TableID) {
+				state = tablepb.TableStateStopping
+			}
 		}
 	} else {
 		table, ok := p.tableSpans.Get(span)
@@ -387,6 +456,16 @@ func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool
 	}
 
 	if !alreadyExist {
+		if p.isRetiring(span) {
+			// The retiring table span may have been removed already.
+			log.Info("retiring table span is removed",
+				zap.String("captureID", p.captureInfo.ID),
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span))
+			p.retiringSpans.Delete(span)
+			return 0, true
+		}
 		log.Warn("table should be removing but not found",
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
@@ -418,7 +497,8 @@ func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
 			zap.Stringer("span", &span),
-			zap.Uint64("checkpointTs", stats.CheckpointTs))
+			zap.Uint64("checkpointTs", stats.CheckpointTs),
+			zap.Bool("retiring", p.isRetiring(span)))
 
 		return stats.CheckpointTs, true
 	}
@@ -434,7 +514,8 @@ func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool
 		zap.String("namespace", p.changefeedID.Namespace),
 		zap.String("changefeed", p.changefeedID.ID),
 		zap.Stringer("span", &span),
-		zap.Uint64("checkpointTs", checkpointTs))
+		zap.Uint64("checkpointTs", checkpointTs),
+		zap.Bool("retiring", p.isRetiring(span)))
 	return checkpointTs, true
 }
 
@@ -446,13 +527,427 @@ func (p *processor) GetTableSpanCount() int {
 	return p.tableSpans.Len()
 }
 
+// GetOpenTableLimit implements TableExecutor interface.
+func (p *processor) GetOpenTableLimit() int {
+	return p.openTableLimit
+}
+
+// SetOpenTableLimit implements TableExecutor interface.
+func (p *processor) SetOpenTableLimit(n int) {
+	if n < 0 {
+		n = 0
+	}
+	p.openTableLimit = n
+}
+
+// SetFenced implements TableExecutor interface.
+func (p *processor) SetFenced(fenced bool) error {
+	if !p.pullBasedSinking {
+		// Fencing is rejected by the server config validation unless the
+		// pull-based sink is enabled.
+		return cerror.ErrProcessorFenceNotSupported.GenWithStackByArgs()
+	}
+	p.sinkManager.SetFenced(fenced)
+	return nil
+}
+
+// SetTableSpanEpoch implements TableExecutor interface.
+func (p *processor) SetTableSpanEpoch(span tablepb.Span, epoch uint64) {
+	// The epochs are only used to fence the table sinks of the pull-based sink.
+	if !p.pullBasedSinking {
+		return
+	}
+	p.sinkManager.SetTableEpoch(span.TableID, epoch)
+}
+
+// FenceTableSpan implements TableExecutor interface.
+func (p *processor) FenceTableSpan(span tablepb.Span, epoch uint64) {
+	if !p.pullBasedSinking {
+		return
+	}
+	p.sinkManager.FenceTable(span.TableID, epoch)
+}
+
+// SetGlobalCheckpoint implements TableExecutor interface.
+func (p *processor) SetGlobalCheckpoint(checkpointTs model.Ts) {
+	p.globalCheckpointTs = checkpointTs
+}
+
+// GetTableSpanRelativePosition implements TableExecutor interface.
+func (p *processor) GetTableSpanRelativePosition(span tablepb.Span) time.Duration {
+	if p.globalCheckpointTs == 0 {
+		return 0
+	}
+	status := p.getTableSpanStatus(span)
+	if status.State == tablepb.TableStateAbsent {
+		return 0
+	}
+	return oracle.GetTimeFromTS(status.Checkpoint.CheckpointTs).
+		Sub(oracle.GetTimeFromTS(p.globalCheckpointTs))
+}
+
+// GetTableSpanQuotaUsage implements TableExecutor interface.
+func (p *processor) GetTableSpanQuotaUsage(span tablepb.Span) (used, limit uint64) {
+	if p.pullBasedSinking {
+		used, limit, ok := p.sinkManager.GetTableMemoryUsage(span.TableID)
+		if !ok {
+			return 0, 0
+		}
+		return used, limit
+	}
+	table, ok := p.tableSpans.Get(span)
+	if !ok {
+		return 0, 0
+	}
+	// the memory quota of a table pipeline is the per-table one, see tableActor.
+	return table.MemoryConsumption(), config.GetGlobalServerConfig().PerTableMemoryQuota
+}
+
+// GetSpansAtRiskForSafepoint implements TableExecutor interface.
+func (p *processor) GetSpansAtRiskForSafepoint(proposedSafepoint model.Ts) []tablepb.Span {
+	spans := make([]tablepb.Span, 0)
+	checkSpan := func(span tablepb.Span) {
+		status := p.getTableSpanStatus(span)
+		if status.State == tablepb.TableStateAbsent {
+			return
+		}
+		neededTs := status.Checkpoint.CheckpointTs
+		if r, ok := p.replayingSpans.Get(span); ok && r.fromTs < neededTs {
+			neededTs = r.fromTs
+		}
+		if neededTs < proposedSafepoint {
+			spans = append(spans, span)
+		}
+	}
+	if p.pullBasedSinking {
+		for _, tableID := range p.sinkManager.GetAllCurrentTableIDs() {
+			checkSpan(spanz.TableIDToComparableSpan(tableID))
+		}
+	} else {
+		p.tableSpans.Ascend(func(span tablepb.Span, _ tablepb.TablePipeline) bool {
+			checkSpan(span)
+			return true
+		})
+	}
+	return spans
+}
+
+// GetTableSpanOldestUnflushedAge implements TableExecutor interface.
+func (p *processor) GetTableSpanOldestUnflushedAge(span tablepb.Span) time.Duration {
+	tracker, ok := p.unflushedAges.Get(span)
+	if !ok {
+		return 0
+	}
+	return tracker.age(time.Now())
+}
+
+// IsTableSpanQuickRemovable implements TableExecutor interface.
+func (p *processor) IsTableSpanQuickRemovable(span tablepb.Span) bool {
+	var receivedCommitTs, checkpointTs model.Ts
+	if p.pullBasedSinking {
+		if _, exist := p.sinkManager.GetTableState(span.TableID); !exist {
+			return true
+		}
+		receivedCommitTs = p.sourceManager.GetTableSorterStats(span.TableID).ReceivedMaxCommitTs
+		checkpointTs = p.sinkManager.GetTableStats(span.TableID).CheckpointTs
+	} else {
+		table, ok := p.tableSpans.Get(span)
+		if !ok {
+			return true
+		}
+		receivedCommitTs = table.Stats().StageCheckpoints["sorter-ingress"].CheckpointTs
+		checkpointTs = table.CheckpointTs()
+	}
+	// all events received by the sorter have been flushed by the sink.
+	return receivedCommitTs <= checkpointTs
+}
+
+// GetTableSpanTsSkew implements TableExecutor interface.
+func (p *processor) GetTableSpanTsSkew(span tablepb.Span) (minTs, maxTs model.Ts) {
+	tracker, ok := p.unflushedAges.Get(span)
+	if !ok {
+		return 0, 0
+	}
+	return tracker.tsRange()
+}
+
+// GetTableSpanConflictStats implements TableExecutor interface.
+func (p *processor) GetTableSpanConflictStats(span tablepb.Span) scheduler.ConflictStats {
+	if !p.pullBasedSinking {
+		// conflicts are only detected by the sinks of the sink manager.
+		return scheduler.ConflictStats{}
+	}
+	stats, ok := p.sinkManager.GetTableConflictStats(span.TableID)
+	if !ok {
+		return scheduler.ConflictStats{}
+	}
+	return scheduler.ConflictStats{
+		Detected:    stats.Detected,
+		Overwritten: stats.Overwritten,
+		Skipped:     stats.Skipped,
+	}
+}
+
+// GetTableSpanOrderingViolations implements TableExecutor interface.
+func (p *processor) GetTableSpanOrderingViolations(span tablepb.Span) int {
+	if !p.pullBasedSinking {
+		// the commit ts order is only checked by the sink manager.
+		return 0
+	}
+	violations, _ := p.sinkManager.GetTableOrderingViolations(span.TableID)
+	return violations
+}
+
+// GetTableSpanWriteAmplification implements TableExecutor interface.
+func (p *processor) GetTableSpanWriteAmplification(span tablepb.Span) float64 {
+	if !p.pullBasedSinking {
+		// the downstream writes are only recorded by the sinks of the sink manager.
+		return 1.0
+	}
+	amplification, _ := p.sinkManager.GetTableWriteAmplification(span.TableID)
+	return amplification
+}
+
+// GetTableSpanUpstreamThrottle implements TableExecutor interface.
+func (p *processor) GetTableSpanUpstreamThrottle(span tablepb.Span) (bool, string) {
+	if !p.pullBasedSinking {
+		// the pullers of table pipelines are not exposed.
+		return false, ""
+	}
+	return p.sourceManager.GetTableUpstreamThrottle(span.TableID)
+}
+
+// GetTableSpanTimeBreakdown implements TableExecutor interface.
+func (p *processor) GetTableSpanTimeBreakdown(span tablepb.Span) map[string]time.Duration {
+	breakdown := make(map[string]time.Duration)
+	if !p.pullBasedSinking {
+		// the stages of table pipelines are not instrumented.
+		return breakdown
+	}
+	sort, mount, sink, ok := p.sinkManager.GetTableTimeBreakdown(span.TableID)
+	if !ok {
+		return breakdown
+	}
+	breakdown[scheduler.PipelineStageSort] = sort
+	breakdown[scheduler.PipelineStageMount] = mount
+	breakdown[scheduler.PipelineStageSink] = sink
+	if fetch, ok := p.sourceManager.GetTableFetchDuration(span.TableID); ok {
+		breakdown[scheduler.PipelineStageFetch] = fetch
+	}
+	return breakdown
+}
+
+// GetTableSpanFilterRules implements TableExecutor interface.
+func (p *processor) GetTableSpanFilterRules(span tablepb.Span) []scheduler.FilterRule {
+	rules := make([]scheduler.FilterRule, 0)
+	if p.filter == nil || p.schemaStorage == nil {
+		// the filter is not initialized yet.
+		return rules
+	}
+	if p.getTableSpanStatus(span).State == tablepb.TableStateAbsent {
+		return rules
+	}
+	ti, ok := p.schemaStorage.GetLastSnapshot().PhysicalTableByID(span.TableID)
+	if !ok {
+		return rules
+	}
+	for _, rule := range p.filter.GetTableDMLRules(ti) {
+		rules = append(rules, scheduler.FilterRule{
+			Type:      rule.Type,
+			Matcher:   rule.Matcher,
+			Condition: rule.Condition,
+		})
+	}
+	return rules
+}
+
+// ResetTableSpanStats implements TableExecutor interface.
+func (p *processor) ResetTableSpanStats(span tablepb.Span) {
+	if !p.pullBasedSinking {
+		return
+	}
+	if p.sinkManager.ResetTableConflictStats(span.TableID) {
+		log.Info("Processor reset table span stats",
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Stringer("span", &span))
+	}
+}
+
+// TableSpanSinkCapabilities implements TableExecutor interface.
+func (p *processor) TableSpanSinkCapabilities(span tablepb.Span) scheduler.SinkCapabilities {
+	if p.pullBasedSinking {
+		if _, exist := p.sinkManager.GetTableState(span.TableID); !exist {
+			return scheduler.SinkCapabilities{}
+		}
+	} else if _, ok := p.tableSpans.Get(span); !ok {
+		return scheduler.SinkCapabilities{}
+	}
+	sinkURI, err := url.Parse(p.changefeed.Info.SinkURI)
+	if err != nil {
+		return scheduler.SinkCapabilities{}
+	}
+	// the atomicity is adjusted with the sink uri when the sink is created.
+	splitTxn := p.changefeed.Info.Config.Sink.TxnAtomicity.ShouldSplitTxn()
+	capabilities := psink.GetCapabilities(strings.ToLower(sinkURI.Scheme), splitTxn)
+	return scheduler.SinkCapabilities{
+		Transactional: capabilities.Transactional,
+		ExactlyOnce:   capabilities.ExactlyOnce,
+		Syncpoint:     capabilities.Syncpoint,
+	}
+}
+
+// GetTableSpanTxnGroup implements TableExecutor interface.
+// The transaction atomicity of sinks is at most table level, the downstream
+// commits of table spans are never held together, so it always returns empty.
+func (p *processor) GetTableSpanTxnGroup(span tablepb.Span) (string, []tablepb.Span) {
+	return "", nil
+}
+
+// GetTwoPhaseStageCounts implements TableExecutor interface.
+func (p *processor) GetTwoPhaseStageCounts() map[string]int {
+	counts := scheduler.NewTwoPhaseStageCounts()
+	countSpan := func(span tablepb.Span) {
+		if stage, ok := scheduler.TwoPhaseStageOf(p.getTableSpanStatus(span).State); ok {
+			counts[stage]++
+		}
+	}
+	if p.pullBasedSinking {
+		for _, tableID := range p.sinkManager.GetAllCurrentTableIDs() {
+			countSpan(spanz.TableIDToComparableSpan(tableID))
+		}
+	} else {
+		p.tableSpans.Ascend(func(span tablepb.Span, _ tablepb.TablePipeline) bool {
+			countSpan(span)
+			return true
+		})
+	}
+	return counts
+}
+
+// replayingSpan is a table span which is being replayed.
+type replayingSpan struct {
+	// fromTs is the ts the table span is replayed from.
+	fromTs model.Ts
+	// untilTs is the checkpoint ts of the table span when it's replayed,
+	// the replay is finished after the table span catches up with it.
+	untilTs model.Ts
+	// rewound is true if the source of the table span has been rewound.
+	rewound bool
+}
+
+// ReplayTableSpanFrom implements TableExecutor interface.
+// NOTICE: the events in (fromTs, checkpointTs] have already been written to
+// the downstream, so the sink must handle the duplicates idempotently, and the
+// replay is lost if the table span is moved or the processor is restarted
+// before it catches up.
+func (p *processor) ReplayTableSpanFrom(span tablepb.Span, fromTs model.Ts) error {
+	if !p.pullBasedSinking {
+		return cerror.ErrReplayTableSpanRefused.GenWithStackByArgs(
+			fromTs, "only pull-based sinking is supported")
+	}
+	if p.redoManager.Enabled() {
+		return cerror.ErrReplayTableSpanRefused.GenWithStackByArgs(
+			fromTs, "redo log is enabled")
+	}
+	state, exist := p.sinkManager.GetTableState(span.TableID)
+	if !exist {
+		return cerror.ErrProcessorTableNotFound.GenWithStackByArgs()
+	}
+	if state != tablepb.TableStateReplicating || p.replayingSpans.Has(span) {
+		return cerror.ErrReplayTableSpanRefused.GenWithStackByArgs(
+			fromTs, fmt.Sprintf("table span is in %s state", state))
+	}
+	checkpointTs := p.sinkManager.GetTableStats(span.TableID).CheckpointTs
+	if fromTs >= checkpointTs {
+		return cerror.ErrReplayTableSpanRefused.GenWithStackByArgs(
+			fromTs, fmt.Sprintf("it's not less than the checkpoint ts %d", checkpointTs))
+	}
+	if fromTs < p.lastSchemaTs {
+		return cerror.ErrReplayTableSpanRefused.GenWithStackByArgs(
+			fromTs, fmt.Sprintf("schema before %d has been garbage collected", p.lastSchemaTs))
+	}
+	if err := p.upstream.GCManager.CheckStaleCheckpointTs(
+		context.Background(), p.changefeedID, fromTs); err != nil {
+		return errors.Trace(err)
+	}
+
+	p.sinkManager.ReplayTable(span.TableID, fromTs)
+	p.replayingSpans.ReplaceOrInsert(span, &replayingSpan{
+		fromTs:  fromTs,
+		untilTs: checkpointTs,
+	})
+	log.Info("Processor replay table span",
+		zap.String("captureID", p.captureInfo.ID),
+		zap.String("namespace", p.changefeedID.Namespace),
+		zap.String("changefeed", p.changefeedID.ID),
+		zap.Stringer("span", &span),
+		zap.Uint64("fromTs", fromTs),
+		zap.Uint64("checkpointTs", checkpointTs))
+	return nil
+}
+
+// handleReplayingSpans rewinds the sources of the replaying table spans after
+// their old table sinks are stopped, and forgets the table spans which have
+// caught up with the checkpoint ts when they were replayed.
+func (p *processor) handleReplayingSpans(ctx cdcContext.Context) {
+	if !p.pullBasedSinking {
+		return
+	}
+	var finished []tablepb.Span
+	p.replayingSpans.Ascend(func(span tablepb.Span, r *replayingSpan) bool {
+		state, exist := p.sinkManager.GetTableState(span.TableID)
+		if !exist || state == tablepb.TableStateStopping || state == tablepb.TableStateStopped {
+			// The table span is being removed.
+			finished = append(finished, span)
+			return true
+		}
+		if !r.rewound {
+			if !p.sinkManager.IsReplacedTableSinkStopped(span.TableID) {
+				return true
+			}
+			// It's safe to rewind the source now, because no one fetches
+			// events of the table span from it.
+			p.sourceManager.RemoveTable(span.TableID)
+			p.sourceManager.AddTable(
+				ctx, span.TableID, p.getTableName(ctx, span.TableID), r.fromTs)
+			p.sinkManager.ResumeReplayedTable(span.TableID)
+			r.rewound = true
+			return true
+		}
+		if p.sinkManager.GetTableStats(span.TableID).CheckpointTs >= r.untilTs {
+			log.Info("Processor replay table span finished",
+				zap.String("captureID", p.captureInfo.ID),
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span),
+				zap.Uint64("fromTs", r.fromTs),
+				zap.Uint64("untilTs", r.untilTs))
+			finished = append(finished, span)
+		}
+		return true
+	})
+	for _, span := range finished {
+		p.replayingSpans.Delete(span)
+	}
+}
+
 // GetCheckpoint implements TableExecutor interface.
 func (p *processor) GetCheckpoint() (checkpointTs, resolvedTs model.Ts) {
 	return p.checkpointTs, p.resolvedTs
 }
 
 // GetTableSpanStatus implements TableExecutor interface
+// The checkpoint of a replicating table span is the last persisted one, and
+// its current checkpoint is reported in the stage checkpoints with the key
+// "current". A table span whose table is dropped in the upstream is retiring,
+// and the finished ts of the drop is reported with the key "retiring".
 func (p *processor) GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
+	return p.markRetiring(p.applyPersistedCheckpoint(p.getTableSpanStatus(span)))
+}
+
+// getTableSpanStatus returns the status of the table span with its current checkpoint.
+func (p *processor) getTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
 	if p.pullBasedSinking {
 		state, exist := p.sinkManager.GetTableState(span.TableID)
 		if !exist {
@@ -462,6 +957,11 @@ func (p *processor) GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
 				State:   tablepb.TableStateAbsent,
 			}
 		}
+		if r, ok := p.replayingSpans.Get(span); ok && !r.rewound &&
+			(state == tablepb.TableStatePreparing || state == tablepb.TableStatePrepared) {
+			// The table span is still replicating from the view of the scheduler.
+			state = tablepb.TableStateReplicating
+		}
 		sinkStats := p.sinkManager.GetTableStats(span.TableID)
 		return tablepb.TableStatus{
 			TableID: span.TableID,
@@ -502,6 +1002,7 @@ func (p *processor) getStatsFromSourceManagerAndSinkManager(tableID model.TableI
 		RegionCount: pullerStats.RegionCount,
 		CurrentTs:   oracle.ComposeTS(oracle.GetPhysical(now), 0),
 		BarrierTs:   sinkStats.BarrierTs,
+		Concurrency: uint64(p.sourceManager.GetTableConcurrency(tableID)),
 		StageCheckpoints: map[string]tablepb.Checkpoint{
 			"puller-ingress": {
 				CheckpointTs: pullerStats.CheckpointTsIngress,
@@ -541,14 +1042,19 @@ func newProcessor(
 	cfg *config.SchedulerConfig,
 ) *processor {
 	p := &processor{
-		changefeed:   state,
-		upstream:     up,
-		tableSpans:   spanz.NewMap[tablepb.TablePipeline](),
-		errCh:        make(chan error, 1),
-		changefeedID: changefeedID,
-		captureInfo:  captureInfo,
-		cancel:       func() {},
-		liveness:     liveness,
+		changefeed:      state,
+		upstream:        up,
+		tableSpans:      spanz.NewMap[tablepb.TablePipeline](),
+		unflushedAges:   spanz.NewMap[*unflushedAgeTracker](),
+		sinkQueueTrends: spanz.NewMap[*sinkQueueTrendTracker](),
+		alertingSpans:   spanz.NewMap[[]string](),
+		replayingSpans:  spanz.NewMap[*replayingSpan](),
+		retiringSpans:   spanz.NewMap[model.Ts](),
+		errCh:           make(chan error, 1),
+		changefeedID:    changefeedID,
+		captureInfo:     captureInfo,
+		cancel:          func() {},
+		liveness:        liveness,
 
 		metricResolvedTsGauge: resolvedTsGauge.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
@@ -578,11 +1084,23 @@ func newProcessor(
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricRemainKVEventGauge: remainKVEventsGauge.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		metricNeverAdvancedSpanGauge: tableSpanNeverAdvancedGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		metricCheckpointRollbackCounter: tableSpanCheckpointRollbackCounter.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 	}
 	p.createTablePipeline = p.createTablePipelineImpl
 	p.lazyInit = p.lazyInitImpl
 	p.newAgent = p.newAgentImpl
 	p.cfg = cfg
+	p.SetOpenTableLimit(cfg.OpenTableLimit)
+	p.alertThresholds = spanz.NewMap[scheduler.AlertThresholds]()
+	p.globalAlertThresholds = defaultAlertThresholds
+	p.checkpointIntervals = spanz.NewMap[time.Duration]()
+	p.persistedCheckpoints = spanz.NewMap[persistedCheckpoint]()
+	p.addedSpans = spanz.NewMap[addedSpan]()
+	p.neverAdvancedSpans = spanz.NewMap[struct{}]()
+	p.reportedCheckpoints = spanz.NewMap[reportedCheckpoint]()
 	return p
 }
 
@@ -716,6 +1234,10 @@ func (p *processor) tick(ctx cdcContext.Context) error {
 	pdTime, _ := p.upstream.PDClock.CurrentTime()
 	p.handlePosition(oracle.GetPhysical(pdTime))
 
+	p.handleReplayingSpans(ctx)
+	p.handleRetiringSpans()
+	p.handleNeverAdvancedSpans()
+	p.handleSpanCheckpoints(time.Now())
 	p.doGCSchemaStorage()
 
 	if p.redoManager != nil && p.redoManager.Enabled() {
@@ -806,10 +1328,13 @@ func (p *processor) lazyInitImpl(ctx cdcContext.Context) error {
 	stdCtx := contextutil.PutChangefeedIDInCtx(ctx, p.changefeedID)
 	stdCtx = contextutil.PutRoleInCtx(stdCtx, util.RoleProcessor)
 
+	oldValue, err := filter.NewOldValueMatcher(p.changefeed.Info.Config)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	p.mg = entry.NewMounterGroup(p.schemaStorage,
 		p.changefeed.Info.Config.Mounter.WorkerNum,
-		p.changefeed.Info.Config.EnableOldValue,
-		p.filter, tz, p.changefeedID)
+		oldValue, p.filter, tz, p.changefeedID)
 
 	p.wg.Add(1)
 	go func() {
@@ -847,7 +1372,9 @@ func (p *processor) lazyInitImpl(ctx cdcContext.Context) error {
 				zap.Duration("duration", time.Since(start)))
 			return errors.Trace(err)
 		}
-		p.sourceManager = sourcemanager.New(p.changefeedID, p.upstream, p.mg, sortEngine, p.errCh, p.changefeed.Info.Config.BDRMode)
+		p.sourceManager = sourcemanager.New(p.changefeedID, p.upstream, p.mg, sortEngine, p.errCh,
+			p.changefeed.Info.Config.BDRMode, p.changefeed.Info.Config.ResolvedTsInterval,
+			p.changefeed.Info.Config.EnableFollowerIncrementalScan)
 		p.sinkManager, err = sinkmanager.New(stdCtx, p.changefeedID, p.changefeed.Info, p.upstream, p.redoManager,
 			p.sourceManager, p.errCh, p.metricsTableSinkTotalRows)
 		if err != nil {
@@ -1059,10 +1586,31 @@ func (p *processor) handlePosition(currentTs int64) {
 	}
 	minCheckpointTs := minResolvedTs
 	minCheckpointTableID := int64(0)
+	// rebuild the trackers every time, so trackers of removed spans are dropped.
+	unflushedAges := spanz.NewMap[*unflushedAgeTracker]()
+	sinkQueueTrends := spanz.NewMap[*sinkQueueTrendTracker]()
+	alertingSpans := spanz.NewMap[[]string]()
+	observeSpan := func(span tablepb.Span, receivedCommitTs, checkpointTs, resolvedTs model.Ts) {
+		tracker, ok := p.unflushedAges.Get(span)
+		if !ok {
+			tracker = &unflushedAgeTracker{}
+		}
+		now := time.Now()
+		tracker.observe(receivedCommitTs, checkpointTs, now)
+		unflushedAges.ReplaceOrInsert(span, tracker)
+		sinkQueueTrends.ReplaceOrInsert(span, p.sampleSinkQueue(span, now))
+		kinds := p.checkTableSpanAlert(span, checkpointTs, resolvedTs, tracker.age(now), currentTs)
+		if len(kinds) > 0 {
+			alertingSpans.ReplaceOrInsert(span, kinds)
+		}
+	}
 	if p.pullBasedSinking {
 		tableIDs := p.sinkManager.GetAllCurrentTableIDs()
 		for _, tableID := range tableIDs {
 			stats := p.sinkManager.GetTableStats(tableID)
+			sorterStats := p.sourceManager.GetTableSorterStats(tableID)
+			observeSpan(spanz.TableIDToComparableSpan(tableID),
+				sorterStats.ReceivedMaxCommitTs, stats.CheckpointTs, stats.ResolvedTs)
 			log.Debug("sink manager gets table stats",
 				zap.String("namespace", p.changefeedID.Namespace),
 				zap.String("changefeed", p.changefeedID.ID),
@@ -1089,9 +1637,14 @@ func (p *processor) handlePosition(currentTs int64) {
 				minCheckpointTs = cts
 				minCheckpointTableID = table.ID()
 			}
+			observeSpan(span,
+				table.Stats().StageCheckpoints["sorter-ingress"].CheckpointTs, cts, rts)
 			return true
 		})
 	}
+	p.unflushedAges = unflushedAges
+	p.sinkQueueTrends = sinkQueueTrends
+	p.updateAlertingSpans(alertingSpans)
 
 	resolvedPhyTs := oracle.ExtractPhysical(minResolvedTs)
 	p.metricResolvedTsLagGauge.Set(float64(currentTs-resolvedPhyTs) / 1e3)
@@ -1131,6 +1684,16 @@ func (p *processor) pushResolvedTs2Table() {
 func (p *processor) getTableName(ctx context.Context, tableID model.TableID) string {
 	// FIXME: using GetLastSnapshot here would be confused and get the wrong table name
 	// after `rename table` DDL, since `rename table` keeps the tableID unchanged
+	if droppedTs, ok := p.schemaStorage.GetLastSnapshot().TableDroppedTs(tableID); ok {
+		// The table is dropped, there is no need to retry, use the name
+		// before it's dropped instead.
+		if snap, err := p.schemaStorage.GetSnapshot(ctx, droppedTs-1); err == nil {
+			if x, ok := snap.PhysicalTableByID(tableID); ok {
+				return x.TableName.QuoteString()
+			}
+		}
+		return strconv.Itoa(int(tableID))
+	}
 	var tableName *model.TableName
 	retry.Do(ctx, func() error { //nolint:errcheck
 		if x, ok := p.schemaStorage.GetLastSnapshot().PhysicalTableByID(tableID); ok {
@@ -1240,7 +1803,15 @@ func (p *processor) doGCSchemaStorage() {
 
 	// Please refer to `unmarshalAndMountRowChanged` in cdc/entry/mounter.go
 	// for why we need -1.
-	lastSchemaTs := p.schemaStorage.DoGC(p.changefeed.Status.CheckpointTs - 1)
+	gcTs := p.changefeed.Status.CheckpointTs - 1
+	// Keep the schema of the replaying table spans.
+	p.replayingSpans.Ascend(func(_ tablepb.Span, r *replayingSpan) bool {
+		if r.fromTs < gcTs {
+			gcTs = r.fromTs
+		}
+		return true
+	})
+	lastSchemaTs := p.schemaStorage.DoGC(gcTs)
 	if p.lastSchemaTs == lastSchemaTs {
 		return
 	}
@@ -1423,6 +1994,11 @@ func (p *processor) cleanupMetrics() {
 	processorMemoryGauge.DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
 
 	remainKVEventsGauge.DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
+	for _, kind := range alertKinds {
+		tableSpanAlertGauge.DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID, kind)
+	}
+	tableSpanNeverAdvancedGauge.DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
+	tableSpanCheckpointRollbackCounter.DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
 
 	sinkmetric.TableSinkTotalRowsCountCounter.
 		DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
@@ -20,10 +20,13 @@ import (
 	"math"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/entry/schema"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/redo"
@@ -37,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 // processor needs to implement TableExecutor.
@@ -144,8 +148,12 @@ type mockTablePipeline struct {
 	resolvedTs   model.Ts
 	checkpointTs model.Ts
 	barrierTs    model.Ts
-	state        tablepb.TableState
-	canceled     bool
+	// receivedTs is the max commit ts received by the sorter.
+	receivedTs model.Ts
+	state      tablepb.TableState
+	canceled   bool
+	// memoryConsumption is the memory consumed by the table pipeline in bytes.
+	memoryConsumption uint64
 
 	sinkStartTs model.Ts
 }
@@ -175,7 +183,11 @@ func (m *mockTablePipeline) AsyncStop() bool {
 }
 
 func (m *mockTablePipeline) Stats() tablepb.Stats {
-	return tablepb.Stats{}
+	return tablepb.Stats{
+		StageCheckpoints: map[string]tablepb.Checkpoint{
+			"sorter-ingress": {CheckpointTs: m.receivedTs},
+		},
+	}
 }
 
 func (m *mockTablePipeline) RemainEvents() int64 {
@@ -221,7 +233,7 @@ func (m *mockTablePipeline) Start(ts model.Ts) {
 
 // MemoryConsumption return the memory consumption in bytes
 func (m *mockTablePipeline) MemoryConsumption() uint64 {
-	return 0
+	return m.memoryConsumption
 }
 
 type mockSchemaStorage struct {
@@ -232,6 +244,24 @@ type mockSchemaStorage struct {
 	t          *testing.T
 	lastGcTs   uint64
 	resolvedTs uint64
+	snaps      []*schema.Snapshot
+}
+
+// GetLastSnapshot returns an empty snapshot if no snapshot is set.
+func (s *mockSchemaStorage) GetLastSnapshot() *schema.Snapshot {
+	if len(s.snaps) == 0 {
+		return schema.NewEmptySnapshot(false)
+	}
+	return s.snaps[len(s.snaps)-1]
+}
+
+func (s *mockSchemaStorage) GetSnapshot(_ context.Context, ts uint64) (*schema.Snapshot, error) {
+	for i := len(s.snaps) - 1; i >= 0; i-- {
+		if s.snaps[i].CurrentTs() <= ts {
+			return s.snaps[i], nil
+		}
+	}
+	return schema.NewEmptySnapshot(false), nil
 }
 
 func (s *mockSchemaStorage) ResolvedTs() uint64 {
@@ -355,6 +385,765 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.Nil(t, p.agent)
 }
 
+func TestTableExecutorOpenTableLimit(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	require.Equal(t, 0, p.GetOpenTableLimit())
+	p.SetOpenTableLimit(1)
+	require.Equal(t, 1, p.GetOpenTableLimit())
+
+	done, err := p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(1), 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	// adding an existing table is not limited
+	done, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(1), 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+
+	done, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(2), 20, false)
+	require.True(t, cerror.ErrTooManyOpenTables.Equal(err))
+	require.False(t, done)
+	require.Equal(t, 1, p.GetTableSpanCount())
+
+	// remove the limit
+	p.SetOpenTableLimit(-1)
+	require.Equal(t, 0, p.GetOpenTableLimit())
+	done, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(2), 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	require.Equal(t, 2, p.GetTableSpanCount())
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorTwoPhaseStageCounts(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	require.Equal(t, map[string]int{
+		scheduler.TwoPhaseStagePrepare:         0,
+		scheduler.TwoPhaseStageCommittedAdd:    0,
+		scheduler.TwoPhaseStagePrepareRemove:   0,
+		scheduler.TwoPhaseStageCommittedRemove: 0,
+	}, p.GetTwoPhaseStageCounts())
+
+	for tableID := int64(1); tableID <= 5; tableID++ {
+		done, err := p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(tableID), 20, true)
+		require.Nil(t, err)
+		require.True(t, done)
+	}
+	getTable := func(tableID int64) *mockTablePipeline {
+		return p.tableSpans.GetV(spanz.TableIDToComparableSpan(tableID)).(*mockTablePipeline)
+	}
+	// table 1 is preparing, table 2 is prepared.
+	getTable(2).resolvedTs = 30
+	// table 3 is replicating.
+	getTable(3).sinkStartTs = 10
+	// table 4 is stopping, table 5 is stopped.
+	getTable(4).state = tablepb.TableStateStopping
+	getTable(5).state = tablepb.TableStateStopped
+
+	require.Equal(t, map[string]int{
+		scheduler.TwoPhaseStagePrepare:         2,
+		scheduler.TwoPhaseStageCommittedAdd:    1,
+		scheduler.TwoPhaseStagePrepareRemove:   1,
+		scheduler.TwoPhaseStageCommittedRemove: 1,
+	}, p.GetTwoPhaseStageCounts())
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorOldestUnflushedAge(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	require.Zero(t, p.GetTableSpanOldestUnflushedAge(span))
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	table := p.tableSpans.GetV(span).(*mockTablePipeline)
+
+	// all received events are flushed.
+	table.receivedTs = 20
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	require.Zero(t, p.GetTableSpanOldestUnflushedAge(span))
+
+	// events are received but not flushed.
+	table.receivedTs = 30
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	tracker, ok := p.unflushedAges.Get(span)
+	require.True(t, ok)
+	require.Len(t, tracker.marks, 1)
+	tracker.marks[0].receivedAt = time.Now().Add(-time.Minute)
+	require.GreaterOrEqual(t, p.GetTableSpanOldestUnflushedAge(span), time.Minute)
+
+	// the table is drained.
+	table.checkpointTs = 30
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	require.Zero(t, p.GetTableSpanOldestUnflushedAge(span))
+
+	// trackers of removed tables are dropped.
+	require.True(t, p.RemoveTableSpan(span))
+	table.state = tablepb.TableStateStopped
+	_, done = p.IsRemoveTableSpanFinished(span)
+	require.True(t, done)
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	require.False(t, p.unflushedAges.Has(span))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorQuickRemovable(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	require.True(t, p.IsTableSpanQuickRemovable(span))
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	table := p.tableSpans.GetV(span).(*mockTablePipeline)
+
+	// all received events are flushed.
+	table.receivedTs = 20
+	require.True(t, p.IsTableSpanQuickRemovable(span))
+
+	// events are received but not flushed.
+	table.receivedTs = 30
+	require.False(t, p.IsTableSpanQuickRemovable(span))
+
+	// the table is drained.
+	table.checkpointTs = 30
+	require.True(t, p.IsTableSpanQuickRemovable(span))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorTxnGroup(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	groupID, members := p.GetTableSpanTxnGroup(span)
+	require.Empty(t, groupID)
+	require.Nil(t, members)
+
+	// the table spans are never grouped even if they are replicating.
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	done, err = p.AddTableSpan(ctx, spanz.TableIDToComparableSpan(2), 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	groupID, members = p.GetTableSpanTxnGroup(span)
+	require.Empty(t, groupID)
+	require.Nil(t, members)
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorTsSkew(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	minTs, maxTs := p.GetTableSpanTsSkew(span)
+	require.Zero(t, minTs)
+	require.Zero(t, maxTs)
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	table := p.tableSpans.GetV(span).(*mockTablePipeline)
+
+	// events are received but not flushed.
+	table.receivedTs = 30
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	minTs, maxTs = p.GetTableSpanTsSkew(span)
+	require.Equal(t, model.Ts(21), minTs)
+	require.Equal(t, model.Ts(30), maxTs)
+
+	// more events are received later.
+	tracker, ok := p.unflushedAges.Get(span)
+	require.True(t, ok)
+	tracker.marks[0].receivedAt = time.Now().Add(-time.Minute)
+	table.receivedTs = 40
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	minTs, maxTs = p.GetTableSpanTsSkew(span)
+	require.Equal(t, model.Ts(21), minTs)
+	require.Equal(t, model.Ts(40), maxTs)
+
+	// part of the events are flushed.
+	table.checkpointTs = 30
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	minTs, maxTs = p.GetTableSpanTsSkew(span)
+	require.Equal(t, model.Ts(31), minTs)
+	require.Equal(t, model.Ts(40), maxTs)
+
+	// the table is drained.
+	table.checkpointTs = 40
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	minTs, maxTs = p.GetTableSpanTsSkew(span)
+	require.Zero(t, minTs)
+	require.Zero(t, maxTs)
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorRelativePosition(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	startTs := oracle.ComposeTS(1000, 0)
+	done, err := p.AddTableSpan(ctx, span, startTs, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	table := p.tableSpans.GetV(span).(*mockTablePipeline)
+	table.checkpointTs = oracle.ComposeTS(5000, 0)
+
+	// the global checkpoint is not set yet.
+	require.Zero(t, p.GetTableSpanRelativePosition(span))
+
+	p.SetGlobalCheckpoint(oracle.ComposeTS(3000, 0))
+	require.Equal(t, 2*time.Second, p.GetTableSpanRelativePosition(span))
+	p.SetGlobalCheckpoint(oracle.ComposeTS(8000, 0))
+	require.Equal(t, -3*time.Second, p.GetTableSpanRelativePosition(span))
+	require.Zero(t, p.GetTableSpanRelativePosition(spanz.TableIDToComparableSpan(2)))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorQuotaUsage(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	done, err := p.AddTableSpan(ctx, span, oracle.ComposeTS(1000, 0), false)
+	require.Nil(t, err)
+	require.True(t, done)
+	table := p.tableSpans.GetV(span).(*mockTablePipeline)
+	table.memoryConsumption = 1024
+
+	used, limit := p.GetTableSpanQuotaUsage(span)
+	require.Equal(t, uint64(1024), used)
+	require.Equal(t, config.GetGlobalServerConfig().PerTableMemoryQuota, limit)
+	used, limit = p.GetTableSpanQuotaUsage(spanz.TableIDToComparableSpan(2))
+	require.Zero(t, used)
+	require.Zero(t, limit)
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorSpansAtRiskForSafepoint(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	require.Empty(t, p.GetSpansAtRiskForSafepoint(oracle.ComposeTS(1000, 0)))
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	for _, span := range []tablepb.Span{span1, span2} {
+		done, err := p.AddTableSpan(ctx, span, oracle.ComposeTS(1000, 0), false)
+		require.Nil(t, err)
+		require.True(t, done)
+	}
+	p.tableSpans.GetV(span1).(*mockTablePipeline).checkpointTs = oracle.ComposeTS(2000, 0)
+	p.tableSpans.GetV(span2).(*mockTablePipeline).checkpointTs = oracle.ComposeTS(3000, 0)
+
+	require.Empty(t, p.GetSpansAtRiskForSafepoint(oracle.ComposeTS(2000, 0)))
+	require.Equal(t, []tablepb.Span{span1}, p.GetSpansAtRiskForSafepoint(oracle.ComposeTS(2500, 0)))
+	require.Equal(t, []tablepb.Span{span1, span2}, p.GetSpansAtRiskForSafepoint(oracle.ComposeTS(4000, 0)))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorAlertThresholds(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	for _, span := range []tablepb.Span{span1, span2} {
+		done, err := p.AddTableSpan(ctx, span, 20, false)
+		require.Nil(t, err)
+		require.True(t, done)
+	}
+
+	// both spans lag far behind the global threshold.
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	kinds, ok := p.alertingSpans.Get(span1)
+	require.True(t, ok)
+	require.Equal(t, []string{alertKindLag}, kinds)
+	require.True(t, p.alertingSpans.Has(span2))
+
+	// span1 tolerates the lag, and unset thresholds fall back to the global ones.
+	p.SetTableSpanAlertThresholds(span1, scheduler.AlertThresholds{Lag: math.MaxInt64})
+	require.Equal(t, scheduler.AlertThresholds{
+		Lag:         math.MaxInt64,
+		Backlog:     defaultAlertThresholds.Backlog,
+		SinkLatency: defaultAlertThresholds.SinkLatency,
+	}, p.getTableSpanAlertThresholds(span1))
+	require.Nil(t, p.Tick(ctx))
+	tester.MustApplyPatches()
+	require.False(t, p.alertingSpans.Has(span1))
+	require.True(t, p.alertingSpans.Has(span2))
+
+	// the thresholds are kept after the span is removed.
+	require.True(t, p.RemoveTableSpan(span1))
+	require.Equal(t, time.Duration(math.MaxInt64), p.getTableSpanAlertThresholds(span1).Lag)
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorCheckpointInterval(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	for _, span := range []tablepb.Span{span1, span2} {
+		done, err := p.AddTableSpan(ctx, span, 20, false)
+		require.Nil(t, err)
+		require.True(t, done)
+		p.tableSpans.GetV(span).(*mockTablePipeline).checkpointTs = 30
+	}
+	p.SetTableSpanCheckpointInterval(span1, time.Hour)
+	require.Equal(t, time.Hour, p.getTableSpanCheckpointInterval(span1))
+	require.Zero(t, p.getTableSpanCheckpointInterval(span2))
+
+	p.handleSpanCheckpoints(time.Now())
+	status := p.GetTableSpanStatus(span1)
+	require.Equal(t, tablepb.TableStateReplicating, status.State)
+	require.Equal(t, model.Ts(30), status.Checkpoint.CheckpointTs)
+	require.Equal(t, model.Ts(30), status.Stats.StageCheckpoints[stageCheckpointCurrent].CheckpointTs)
+
+	// span1 keeps the persisted checkpoint within the interval, while span2
+	// follows the changefeed-wide interval.
+	for _, span := range []tablepb.Span{span1, span2} {
+		p.tableSpans.GetV(span).(*mockTablePipeline).checkpointTs = 40
+	}
+	p.handleSpanCheckpoints(time.Now())
+	status = p.GetTableSpanStatus(span1)
+	require.Equal(t, model.Ts(30), status.Checkpoint.CheckpointTs)
+	require.Equal(t, model.Ts(40), status.Stats.StageCheckpoints[stageCheckpointCurrent].CheckpointTs)
+	status = p.GetTableSpanStatus(span2)
+	require.Equal(t, model.Ts(40), status.Checkpoint.CheckpointTs)
+
+	// the persisted checkpoint advances once the interval elapses, and the
+	// status doesn't advance it.
+	p.tableSpans.GetV(span1).(*mockTablePipeline).checkpointTs = 50
+	require.Equal(t, model.Ts(30), p.GetTableSpanStatus(span1).Checkpoint.CheckpointTs)
+	p.handleSpanCheckpoints(time.Now().Add(time.Hour))
+	require.Equal(t, model.Ts(50), p.GetTableSpanStatus(span1).Checkpoint.CheckpointTs)
+
+	// the interval is kept after the span is removed, and non-positive
+	// interval falls back to the changefeed-wide one.
+	require.True(t, p.RemoveTableSpan(span1))
+	require.Equal(t, time.Hour, p.getTableSpanCheckpointInterval(span1))
+	p.SetTableSpanCheckpointInterval(span1, 0)
+	require.Zero(t, p.getTableSpanCheckpointInterval(span1))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorConcurrency(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	// the requested concurrency is bounded to the configured min and max.
+	p.cfg.MinTableSpanConcurrency = 16
+	p.cfg.MaxTableSpanConcurrency = 1024
+	require.Equal(t, 16, p.boundTableSpanConcurrency(1))
+	require.Equal(t, 64, p.boundTableSpanConcurrency(64))
+	require.Equal(t, 1024, p.boundTableSpanConcurrency(4096))
+	require.Equal(t, 0, p.boundTableSpanConcurrency(0))
+
+	// the concurrency can't be adjusted without the pull-based sink.
+	span := spanz.TableIDToComparableSpan(1)
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	p.SetTableSpanConcurrency(span, 64)
+	require.Zero(t, p.GetTableSpanStatus(span).Stats.Concurrency)
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorNeverAdvancedSpans(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	for _, span := range []tablepb.Span{span1, span2} {
+		done, err := p.AddTableSpan(ctx, span, 20, false)
+		require.Nil(t, err)
+		require.True(t, done)
+	}
+	p.tableSpans.GetV(span2).(*mockTablePipeline).checkpointTs = 30
+
+	// the spans are too young to be reported.
+	require.Empty(t, p.GetNeverAdvancedSpans(time.Hour))
+	later := time.Now().Add(time.Hour)
+	require.Equal(t, []tablepb.Span{span1}, p.getNeverAdvancedSpans(time.Hour, later))
+
+	// the span is alerted once it's old enough.
+	p.markSpanAdded(span1, 20)
+	added, _ := p.addedSpans.Get(span1)
+	added.addedAt = added.addedAt.Add(-neverAdvancedSpanAlertAge)
+	p.addedSpans.ReplaceOrInsert(span1, added)
+	p.handleNeverAdvancedSpans()
+	require.True(t, p.neverAdvancedSpans.Has(span1))
+	require.False(t, p.neverAdvancedSpans.Has(span2))
+
+	// the span isn't reported after it advances.
+	p.tableSpans.GetV(span1).(*mockTablePipeline).checkpointTs = 30
+	p.handleNeverAdvancedSpans()
+	require.Zero(t, p.neverAdvancedSpans.Len())
+
+	// the removed spans are forgotten.
+	p.removeTable(p.tableSpans.GetV(span2), span2)
+	require.Empty(t, p.getNeverAdvancedSpans(0, later))
+	require.False(t, p.addedSpans.Has(span2))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorCheckpointRollbacks(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	pipeline := p.tableSpans.GetV(span).(*mockTablePipeline)
+	pipeline.checkpointTs = 30
+	p.handleSpanCheckpoints(time.Now())
+	pipeline.checkpointTs = 40
+	p.handleSpanCheckpoints(time.Now())
+	require.Zero(t, p.GetTableSpanCheckpointRollbacks(span))
+
+	// the rollback is detected in the tick rather than by the status, and
+	// it's counted once, even if the checkpoint stays lower.
+	pipeline.checkpointTs = 35
+	require.Equal(t, model.Ts(35), p.GetTableSpanStatus(span).Checkpoint.CheckpointTs)
+	require.Zero(t, p.GetTableSpanCheckpointRollbacks(span))
+	p.handleSpanCheckpoints(time.Now())
+	p.handleSpanCheckpoints(time.Now())
+	require.Equal(t, 1, p.GetTableSpanCheckpointRollbacks(span))
+	pipeline.checkpointTs = 32
+	p.handleSpanCheckpoints(time.Now())
+	require.Equal(t, 2, p.GetTableSpanCheckpointRollbacks(span))
+
+	// the checkpoint of a replaying span is rewound on purpose.
+	p.replayingSpans.ReplaceOrInsert(span, &replayingSpan{fromTs: 25, untilTs: 32})
+	pipeline.checkpointTs = 25
+	p.handleSpanCheckpoints(time.Now())
+	p.replayingSpans.Delete(span)
+	pipeline.checkpointTs = 33
+	p.handleSpanCheckpoints(time.Now())
+	require.Equal(t, 2, p.GetTableSpanCheckpointRollbacks(span))
+
+	// the number is reset when the span is added again.
+	p.removeTable(pipeline, span)
+	require.Equal(t, 2, p.GetTableSpanCheckpointRollbacks(span))
+	done, err = p.AddTableSpan(ctx, span, 10, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	require.Zero(t, p.GetTableSpanCheckpointRollbacks(span))
+	p.tableSpans.GetV(span).(*mockTablePipeline).checkpointTs = 10
+	p.handleSpanCheckpoints(time.Now())
+	require.Zero(t, p.GetTableSpanCheckpointRollbacks(span))
+
+	// the removed span is forgotten in the next tick.
+	p.removeTable(p.tableSpans.GetV(span), span)
+	p.handleSpanCheckpoints(time.Now())
+	require.False(t, p.persistedCheckpoints.Has(span))
+	require.False(t, p.reportedCheckpoints.Has(span))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorSinkQueueTrend(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span := spanz.TableIDToComparableSpan(1)
+	done, err := p.AddTableSpan(ctx, span, 20, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	p.tableSpans.GetV(span).(*mockTablePipeline).memoryConsumption = 100
+
+	// the trend is unknown until enough samples are taken.
+	p.handlePosition(0)
+	require.Equal(t, scheduler.TrendUnknown, p.GetTableSpanSinkQueueTrend(span))
+	// samples are not taken more often than the interval.
+	p.handlePosition(0)
+	tracker := p.sinkQueueTrends.GetV(span)
+	require.Len(t, tracker.samples, 1)
+
+	now := time.Now()
+	observe := func(depths ...uint64) {
+		for _, depth := range depths {
+			now = now.Add(sinkQueueTrendSampleInterval)
+			require.True(t, tracker.due(now))
+			tracker.observe(depth, now)
+		}
+	}
+	observe(100, 100, 105)
+	require.Equal(t, scheduler.TrendUnknown, p.GetTableSpanSinkQueueTrend(span))
+	observe(108)
+	require.Equal(t, scheduler.TrendStable, p.GetTableSpanSinkQueueTrend(span))
+	observe(200, 300, 400)
+	require.Equal(t, scheduler.TrendGrowing, p.GetTableSpanSinkQueueTrend(span))
+	observe(300, 200, 100, 0, 0)
+	require.Equal(t, scheduler.TrendDraining, p.GetTableSpanSinkQueueTrend(span))
+	observe(0, 0, 0)
+	require.Equal(t, scheduler.TrendStable, p.GetTableSpanSinkQueueTrend(span))
+
+	// the removed spans are forgotten.
+	p.removeTable(p.tableSpans.GetV(span), span)
+	p.handlePosition(0)
+	require.Equal(t, scheduler.TrendUnknown, p.GetTableSpanSinkQueueTrend(span))
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestTableExecutorRetiringSpan(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	// init tick
+	err := p.Tick(ctx)
+	require.Nil(t, err)
+	tester.MustApplyPatches()
+
+	span1 := spanz.TableIDToComparableSpan(1)
+	span2 := spanz.TableIDToComparableSpan(2)
+	for _, span := range []tablepb.Span{span1, span2} {
+		done, err := p.AddTableSpan(ctx, span, 20, false)
+		require.Nil(t, err)
+		require.True(t, done)
+	}
+
+	// table 1 is dropped at 30.
+	newSnapshot := func(dropped bool) *schema.Snapshot {
+		snap := schema.NewEmptySnapshot(false)
+		jobs := []*timodel.Job{{
+			Type:       timodel.ActionCreateSchema,
+			BinlogInfo: &timodel.HistoryInfo{DBInfo: &timodel.DBInfo{ID: 100, Name: timodel.NewCIStr("test")}, FinishedTS: 10},
+		}}
+		for _, tableID := range []int64{1, 2} {
+			jobs = append(jobs, &timodel.Job{
+				Type:     timodel.ActionCreateTable,
+				SchemaID: 100,
+				BinlogInfo: &timodel.HistoryInfo{
+					TableInfo:  &timodel.TableInfo{ID: tableID, Name: timodel.NewCIStr(fmt.Sprintf("t%d", tableID))},
+					FinishedTS: 10 + uint64(tableID),
+				},
+			})
+		}
+		if dropped {
+			jobs = append(jobs, &timodel.Job{
+				Type:       timodel.ActionDropTable,
+				SchemaID:   100,
+				TableID:    1,
+				BinlogInfo: &timodel.HistoryInfo{FinishedTS: 30},
+			})
+		}
+		for _, job := range jobs {
+			require.Nil(t, snap.HandleDDL(job))
+		}
+		return snap
+	}
+	storage := p.schemaStorage.(*mockSchemaStorage)
+	storage.snaps = []*schema.Snapshot{newSnapshot(false), newSnapshot(true)}
+	require.Equal(t, "`test`.`t1`", p.getTableName(ctx, 1))
+
+	p.handleRetiringSpans()
+	require.True(t, p.isRetiring(span1))
+	require.False(t, p.isRetiring(span2))
+	status := p.GetTableSpanStatus(span1)
+	require.Equal(t, tablepb.Checkpoint{CheckpointTs: 30, ResolvedTs: 30},
+		status.Stats.StageCheckpoints[stageCheckpointRetiring])
+	// the stage checkpoints of the table pipeline are not modified.
+	require.NotContains(t, p.tableSpans.GetV(span1).Stats().StageCheckpoints, stageCheckpointRetiring)
+	require.NotContains(t, p.GetTableSpanStatus(span2).Stats.StageCheckpoints, stageCheckpointRetiring)
+
+	// the retiring span flushes its events and is removed.
+	table := p.tableSpans.GetV(span1).(*mockTablePipeline)
+	require.True(t, p.RemoveTableSpan(span1))
+	table.checkpointTs = 30
+	table.state = tablepb.TableStateStopped
+	checkpointTs, done := p.IsRemoveTableSpanFinished(span1)
+	require.True(t, done)
+	require.Equal(t, model.Ts(30), checkpointTs)
+	// removing it again is done without errors.
+	require.True(t, p.RemoveTableSpan(span1))
+	_, done = p.IsRemoveTableSpanFinished(span1)
+	require.True(t, done)
+
+	// the removed span is forgotten.
+	p.handleRetiringSpans()
+	require.False(t, p.isRetiring(span1))
+	require.Equal(t, 0, p.retiringSpans.Len())
+
+	require.Nil(t, p.Close(ctx))
+	tester.MustApplyPatches()
+}
+
+func TestExceededAlertThresholds(t *testing.T) {
+	t.Parallel()
+
+	thresholds := scheduler.AlertThresholds{Lag: 5 * time.Second, SinkLatency: time.Minute}
+	require.Empty(t, exceededAlertThresholds(thresholds, time.Second, time.Hour, time.Second))
+	require.Equal(t, []string{alertKindLag},
+		exceededAlertThresholds(thresholds, 10*time.Second, 0, time.Second))
+	require.Equal(t, []string{alertKindLag, alertKindSinkLatency},
+		exceededAlertThresholds(thresholds, time.Hour, 0, time.Hour))
+	require.Equal(t, []string{alertKindLag, alertKindBacklog, alertKindSinkLatency},
+		exceededAlertThresholds(defaultAlertThresholds, time.Hour, time.Hour, time.Hour))
+}
+
+func TestUnflushedAgeTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := &unflushedAgeTracker{}
+	now := time.Now()
+	require.Zero(t, tracker.age(now))
+
+	tracker.observe(10, 10, now)
+	require.Zero(t, tracker.age(now))
+
+	// events received within the interval share the same mark.
+	tracker.observe(20, 10, now)
+	tracker.observe(25, 10, now.Add(unflushedAgeMarkInterval/2))
+	require.Len(t, tracker.marks, 1)
+	require.Equal(t, model.Ts(25), tracker.marks[0].commitTs)
+	tracker.observe(30, 10, now.Add(2*unflushedAgeMarkInterval))
+	require.Len(t, tracker.marks, 2)
+	require.Equal(t, 5*time.Second, tracker.age(now.Add(5*time.Second)))
+	minTs, maxTs := tracker.tsRange()
+	require.Equal(t, model.Ts(11), minTs)
+	require.Equal(t, model.Ts(30), maxTs)
+
+	// the first mark is flushed, the age is calculated from the second one.
+	tracker.observe(30, 25, now.Add(3*unflushedAgeMarkInterval))
+	require.Len(t, tracker.marks, 1)
+	require.Equal(t, 5*time.Second-2*unflushedAgeMarkInterval, tracker.age(now.Add(5*time.Second)))
+	minTs, maxTs = tracker.tsRange()
+	require.Equal(t, model.Ts(26), minTs)
+	require.Equal(t, model.Ts(30), maxTs)
+
+	tracker.observe(30, 30, now.Add(4*unflushedAgeMarkInterval))
+	require.Zero(t, tracker.age(now.Add(5*time.Second)))
+	minTs, maxTs = tracker.tsRange()
+	require.Zero(t, minTs)
+	require.Zero(t, maxTs)
+}
+
 func TestProcessorError(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	liveness := model.LivenessCaptureAlive
@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"go.uber.org/zap"
+)
+
+// stageCheckpointRetiring is the key of the retiring checkpoint of a table span
+// in its stage checkpoints, it's only present if the table of the span is
+// dropped in the upstream, and its checkpoint ts is the finished ts of the drop.
+const stageCheckpointRetiring = "retiring"
+
+// handleRetiringSpans finds out the table spans whose tables are dropped in
+// the upstream. A retiring table span keeps flushing its remaining events
+// until the owner removes it after executing the DDL dropping the table.
+func (p *processor) handleRetiringSpans() {
+	if p.schemaStorage == nil {
+		return
+	}
+	snap := p.schemaStorage.GetLastSnapshot()
+	retiringSpans := spanz.NewMap[model.Ts]()
+	checkSpan := func(span tablepb.Span) {
+		if droppedTs, ok := p.retiringSpans.Get(span); ok {
+			retiringSpans.ReplaceOrInsert(span, droppedTs)
+			return
+		}
+		droppedTs, ok := snap.TableDroppedTs(span.TableID)
+		if !ok {
+			return
+		}
+		log.Info("table span is retiring, since its table is dropped in the upstream",
+			zap.String("captureID", p.captureInfo.ID),
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Stringer("span", &span),
+			zap.Uint64("droppedTs", droppedTs))
+		retiringSpans.ReplaceOrInsert(span, droppedTs)
+	}
+	if p.pullBasedSinking {
+		for _, tableID := range p.sinkManager.GetAllCurrentTableIDs() {
+			checkSpan(spanz.TableIDToComparableSpan(tableID))
+		}
+	} else {
+		p.tableSpans.Ascend(func(span tablepb.Span, _ tablepb.TablePipeline) bool {
+			checkSpan(span)
+			return true
+		})
+	}
+	// rebuild the map every time, so removed spans are dropped.
+	p.retiringSpans = retiringSpans
+}
+
+// markRetiring records the retiring checkpoint in the stage checkpoints of the
+// status if the table span is retiring.
+func (p *processor) markRetiring(status tablepb.TableStatus) tablepb.TableStatus {
+	droppedTs, ok := p.retiringSpans.Get(status.Span)
+	if !ok || status.State == tablepb.TableStateAbsent {
+		return status
+	}
+	// copy the stage checkpoints, they may be shared with the table pipeline.
+	stageCheckpoints := make(map[string]tablepb.Checkpoint, len(status.Stats.StageCheckpoints)+1)
+	for stage, checkpoint := range status.Stats.StageCheckpoints {
+		stageCheckpoints[stage] = checkpoint
+	}
+	stageCheckpoints[stageCheckpointRetiring] = tablepb.Checkpoint{
+		CheckpointTs: droppedTs,
+		ResolvedTs:   droppedTs,
+	}
+	status.Stats.StageCheckpoints = stageCheckpoints
+	return status
+}
+
+// isRetiring returns true if the table of the span is dropped in the upstream.
+func (p *processor) isRetiring(span tablepb.Span) bool {
+	return p.retiringSpans.Has(span)
+}
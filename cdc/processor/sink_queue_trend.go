@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler"
+)
+
+const (
+	// sinkQueueTrendSampleInterval is the min interval between two samples of
+	// the sink queue depth, so sampling costs little however fast it ticks.
+	sinkQueueTrendSampleInterval = time.Second
+	// sinkQueueTrendSamples is the number of samples to compute the trend.
+	sinkQueueTrendSamples = 5
+	// sinkQueueTrendTolerance is the relative change of the sink queue depth
+	// within which the queue is considered stable.
+	sinkQueueTrendTolerance = 0.1
+)
+
+// sinkQueueTrendTracker keeps the recent samples of the sink queue depth of a
+// table span, i.e. the memory used by its events waiting to be flushed.
+type sinkQueueTrendTracker struct {
+	// samples are in the order of sampling, at most sinkQueueTrendSamples.
+	samples   []uint64
+	sampledAt time.Time
+}
+
+// due returns whether the sink queue depth should be sampled at `now`.
+func (t *sinkQueueTrendTracker) due(now time.Time) bool {
+	return t.sampledAt.IsZero() || now.Sub(t.sampledAt) >= sinkQueueTrendSampleInterval
+}
+
+// observe records the sink queue depth sampled at `now`.
+func (t *sinkQueueTrendTracker) observe(depth uint64, now time.Time) {
+	if len(t.samples) == sinkQueueTrendSamples {
+		t.samples = append(t.samples[:0], t.samples[1:]...)
+	}
+	t.samples = append(t.samples, depth)
+	t.sampledAt = now
+}
+
+// trend classifies the sink queue by the oldest and the newest samples.
+func (t *sinkQueueTrendTracker) trend() scheduler.Trend {
+	if len(t.samples) < sinkQueueTrendSamples {
+		return scheduler.TrendUnknown
+	}
+	oldest, newest := t.samples[0], t.samples[len(t.samples)-1]
+	base := oldest
+	if newest > base {
+		base = newest
+	}
+	tolerance := uint64(float64(base) * sinkQueueTrendTolerance)
+	switch {
+	case newest > oldest+tolerance:
+		return scheduler.TrendGrowing
+	case newest+tolerance < oldest:
+		return scheduler.TrendDraining
+	default:
+		return scheduler.TrendStable
+	}
+}
+
+// GetTableSpanSinkQueueTrend implements TableExecutor interface.
+func (p *processor) GetTableSpanSinkQueueTrend(span tablepb.Span) scheduler.Trend {
+	tracker, ok := p.sinkQueueTrends.Get(span)
+	if !ok {
+		return scheduler.TrendUnknown
+	}
+	return tracker.trend()
+}
+
+// sampleSinkQueue samples the sink queue depth of the table span if it's due,
+// and returns the tracker of the span.
+func (p *processor) sampleSinkQueue(span tablepb.Span, now time.Time) *sinkQueueTrendTracker {
+	tracker, ok := p.sinkQueueTrends.Get(span)
+	if !ok {
+		tracker = &sinkQueueTrendTracker{}
+	}
+	if tracker.due(now) {
+		depth, _ := p.GetTableSpanQuotaUsage(span)
+		tracker.observe(depth, now)
+	}
+	return tracker
+}
@@ -26,7 +26,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/spanz"
@@ -86,6 +88,9 @@ type SinkManager struct {
 	tableSinks sync.Map
 	// lastBarrierTs is the last barrier ts.
 	lastBarrierTs atomic.Uint64
+	// fenced indicates whether emitting events to table sinks is stopped,
+	// see SetFenced.
+	fenced atomic.Bool
 
 	// sinkWorkers used to pull data from source manager.
 	sinkWorkers []*sinkWorker
@@ -99,6 +104,10 @@ type SinkManager struct {
 	redoTaskChan        chan *redoTask
 	redoWorkerAvailable chan struct{}
 
+	// generateTaskInterval is the interval of generating sink and redo tasks,
+	// which decides how frequently resolved events are emitted to table sinks.
+	generateTaskInterval time.Duration
+
 	// wg is used to wait for all workers to exit.
 	wg sync.WaitGroup
 
@@ -143,8 +152,12 @@ func New(
 		sinkTaskChan:        make(chan *sinkTask),
 		sinkWorkerAvailable: make(chan struct{}, 1),
 
+		generateTaskInterval:      defaultGenerateTaskInterval,
 		metricsTableSinkTotalRows: metricsTableSinkTotalRows,
 	}
+	if changefeedInfo.Config.ResolvedTsInterval > 0 {
+		m.generateTaskInterval = changefeedInfo.Config.ResolvedTsInterval
+	}
 
 	if redoManager != nil && redoManager.Enabled() {
 		m.redoManager = redoManager
@@ -156,7 +169,7 @@ func New(
 		m.eventCache = newRedoEventCache(changefeedID, changefeedInfo.Config.MemoryQuota/4*3)
 	}
 
-	m.startWorkers(changefeedInfo.Config.Sink.TxnAtomicity.ShouldSplitTxn(), changefeedInfo.Config.EnableOldValue)
+	m.startWorkers(changefeedInfo.Config.Sink.TxnAtomicity.ShouldSplitTxn(), changefeedInfo.Config.HasOldValue())
 	m.startGenerateTasks()
 	m.backgroundGC()
 
@@ -339,6 +352,11 @@ func (m *SinkManager) generateSinkTasks() error {
 	}
 
 	dispatchTasks := func() error {
+		// Don't emit any events if the sink manager is fenced, progresses are
+		// kept in the heap and will be handled after it's unfenced.
+		if m.fenced.Load() {
+			return nil
+		}
 		tables := make([]*tableSinkWrapper, 0, sinkWorkerNum)
 		progs := make([]*progress, 0, sinkWorkerNum)
 
@@ -363,6 +381,11 @@ func (m *SinkManager) generateSinkTasks() error {
 				continue
 			}
 
+			if tableSink.isStale() {
+				// The table span is owned by another capture, it's never
+				// emitted again, and will be removed by the scheduler.
+				continue
+			}
 			tableState := tableSink.getState()
 			// It means table sink is stopping or stopped.
 			// We should skip it and do not push it back.
@@ -422,7 +445,8 @@ func (m *SinkManager) generateSinkTasks() error {
 					}
 				},
 				isCanceled: func() bool {
-					return tableSink.getState() != tablepb.TableStateReplicating
+					return tableSink.getState() != tablepb.TableStateReplicating ||
+						m.fenced.Load() || tableSink.isStale()
 				},
 			}
 			select {
@@ -452,7 +476,7 @@ func (m *SinkManager) generateSinkTasks() error {
 		return nil
 	}
 
-	taskTicker := time.NewTicker(defaultGenerateTaskInterval)
+	taskTicker := time.NewTicker(m.generateTaskInterval)
 	defer taskTicker.Stop()
 	for {
 		select {
@@ -501,6 +525,11 @@ func (m *SinkManager) generateRedoTasks() error {
 				continue
 			}
 
+			if tableSink.isStale() {
+				// The table span is owned by another capture, it's never
+				// emitted again, and will be removed by the scheduler.
+				continue
+			}
 			tableState := tableSink.getState()
 			// It means table sink is stopping or stopped.
 			// We should skip it and do not push it back.
@@ -589,7 +618,7 @@ func (m *SinkManager) generateRedoTasks() error {
 		return nil
 	}
 
-	taskTicker := time.NewTicker(defaultGenerateTaskInterval)
+	taskTicker := time.NewTicker(m.generateTaskInterval)
 	defer taskTicker.Stop()
 	for {
 		select {
@@ -635,6 +664,63 @@ func (m *SinkManager) UpdateBarrierTs(ts model.Ts) {
 	m.lastBarrierTs.Store(ts)
 }
 
+// SetFenced stops or resumes emitting events to table sinks. Sink tasks in
+// flight are canceled after their current transactions once it's fenced.
+func (m *SinkManager) SetFenced(fenced bool) {
+	if m.fenced.Swap(fenced) == fenced {
+		return
+	}
+	log.Info("Sink manager fenced state changes",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Bool("fenced", fenced))
+	if !fenced {
+		select {
+		case m.sinkWorkerAvailable <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// IsFenced returns whether emitting events to table sinks is stopped.
+func (m *SinkManager) IsFenced() bool {
+	return m.fenced.Load()
+}
+
+// SetTableEpoch sets the epoch of the table span ownership on the capture.
+func (m *SinkManager) SetTableEpoch(tableID model.TableID, epoch uint64) {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Warn("Table sink not found when setting the epoch",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID),
+			zap.Uint64("epoch", epoch))
+		return
+	}
+	tableSink.(*tableSinkWrapper).epoch.Store(epoch)
+}
+
+// FenceTable stops emitting events of the table if `epoch`, i.e. the latest
+// epoch of the table span issued by the owner, is greater than the one of the
+// table sink, which means the table span is owned by another capture. The
+// events in flight are dropped instead of being written to the downstream.
+func (m *SinkManager) FenceTable(tableID model.TableID, epoch uint64) {
+	value, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		return
+	}
+	tableSink := value.(*tableSinkWrapper)
+	if tableSink.fence(epoch) {
+		log.Warn("Table sink is fenced, since the table span is owned by another capture",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID),
+			zap.Uint64("epoch", tableSink.epoch.Load()),
+			zap.Uint64("latestEpoch", epoch))
+	}
+}
+
 // AddTable adds a table(TableSink) to the sink manager.
 func (m *SinkManager) AddTable(tableID model.TableID, startTs model.Ts, targetTs model.Ts) {
 	sinkWrapper := newTableSinkWrapper(
@@ -646,6 +732,7 @@ func (m *SinkManager) AddTable(tableID model.TableID, startTs model.Ts, targetTs
 		startTs,
 		targetTs,
 	)
+	sinkWrapper.checkCommitTsOrder = config.GetGlobalServerConfig().Debug.EnableCommitTsOrderCheck
 	_, loaded := m.tableSinks.LoadOrStore(tableID, sinkWrapper)
 	if loaded {
 		log.Panic("Add an exists table sink",
@@ -772,6 +859,108 @@ func (m *SinkManager) RemoveTable(tableID model.TableID) {
 	}
 }
 
+// ReplayTable replaces the table sink with a new one which replicates the
+// table from fromTs again, the old table sink is closed asynchronously.
+// The new table sink doesn't replicate until ResumeReplayedTable is called,
+// which must be after IsReplacedTableSinkStopped returns true and the source
+// of the table has been rewound to fromTs.
+func (m *SinkManager) ReplayTable(tableID model.TableID, fromTs model.Ts) {
+	value, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Panic("Table sink not found when replaying table",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+	}
+	oldSink := value.(*tableSinkWrapper)
+	sinkWrapper := newTableSinkWrapper(
+		m.changefeedID,
+		tableID,
+		m.sinkFactory.CreateTableSink(
+			m.changefeedID, spanz.TableIDToComparableSpan(tableID), m.metricsTableSinkTotalRows),
+		tablepb.TableStatePreparing,
+		fromTs,
+		oldSink.targetTs,
+	)
+	sinkWrapper.replicateTs = oldSink.replicateTs
+	sinkWrapper.replaced = oldSink
+	sinkWrapper.epoch.Store(oldSink.epoch.Load())
+	sinkWrapper.fencedEpoch.Store(oldSink.fencedEpoch.Load())
+	sinkWrapper.checkCommitTsOrder = oldSink.checkCommitTsOrder
+	// Mark the old table sink as stopping first, so that its progress and
+	// tasks are discarded.
+	oldSink.state.Store(tablepb.TableStateStopping)
+	m.tableSinks.Store(tableID, sinkWrapper)
+	if m.eventCache != nil {
+		m.eventCache.removeTable(tableID)
+	}
+	log.Info("Replay table sink",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Int64("tableID", tableID),
+		zap.Uint64("fromTs", fromTs),
+		zap.Uint64("version", sinkWrapper.version))
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		// Clean up the memory quota before the old table sink is stopped,
+		// so that it won't affect the new table sink after resumed.
+		cleanedBytes := m.memQuota.clean(tableID)
+		m.memQuota.addTable(tableID)
+		oldSink.close(m.ctx)
+		log.Info("Replaced table sink closed asynchronously",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID),
+			zap.Uint64("memory", cleanedBytes))
+	}()
+}
+
+// IsReplacedTableSinkStopped returns whether the table sink replaced by
+// ReplayTable has been stopped. It returns true if the table isn't replayed.
+func (m *SinkManager) IsReplacedTableSinkStopped(tableID model.TableID) bool {
+	value, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		return true
+	}
+	replaced := value.(*tableSinkWrapper).replaced
+	return replaced == nil || replaced.getState() == tablepb.TableStateStopped
+}
+
+// ResumeReplayedTable starts replicating the table replayed by ReplayTable.
+func (m *SinkManager) ResumeReplayedTable(tableID model.TableID) {
+	value, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Panic("Table sink not found when resuming replayed table",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+	}
+	tableSink := value.(*tableSinkWrapper)
+	tableSink.replaced = nil
+	// The resolved ts received from the replaced source is meaningless.
+	tableSink.receivedSorterResolvedTs.Store(tableSink.startTs)
+	tableSink.state.Store(tablepb.TableStateReplicating)
+	m.sinkProgressHeap.push(&progress{
+		tableID:           tableID,
+		nextLowerBoundPos: engine.Position{StartTs: 0, CommitTs: tableSink.startTs + 1},
+		version:           tableSink.version,
+	})
+	if m.redoManager != nil {
+		m.redoProgressHeap.push(&progress{
+			tableID:           tableID,
+			nextLowerBoundPos: engine.Position{StartTs: 0, CommitTs: tableSink.startTs + 1},
+			version:           tableSink.version,
+		})
+	}
+	log.Info("Resume replayed table sink",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Int64("tableID", tableID),
+		zap.Uint64("startTs", tableSink.startTs))
+}
+
 // GetAllCurrentTableIDs returns all the table IDs in the sink manager.
 func (m *SinkManager) GetAllCurrentTableIDs() []model.TableID {
 	var tableIDs []model.TableID
@@ -795,6 +984,95 @@ func (m *SinkManager) GetTableState(tableID model.TableID) (tablepb.TableState,
 	return tableSink.(*tableSinkWrapper).getState(), true
 }
 
+// GetTableConflictStats returns the conflict statistics of the table sink.
+// The statistics are reset when the table is added again.
+func (m *SinkManager) GetTableConflictStats(tableID model.TableID) (eventsink.ConflictStats, bool) {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Debug("Table sink not found when getting table conflict stats",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return eventsink.ConflictStats{}, false
+	}
+	return tableSink.(*tableSinkWrapper).getConflictStats(), true
+}
+
+// GetTableOrderingViolations returns the number of events appended to the table
+// sink whose commit ts decreases. It's always 0 unless the commit ts order check
+// is enabled in the debug config. The number is reset when the table is added again.
+func (m *SinkManager) GetTableOrderingViolations(tableID model.TableID) (int, bool) {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Debug("Table sink not found when getting table ordering violations",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return 0, false
+	}
+	return tableSink.(*tableSinkWrapper).getOrderingViolations(), true
+}
+
+// GetTableWriteAmplification returns the number of downstream writes divided
+// by the number of events of the table sink in the recent window. It's 1.0 if
+// the backend sink doesn't record its writes.
+func (m *SinkManager) GetTableWriteAmplification(tableID model.TableID) (float64, bool) {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Debug("Table sink not found when getting table write amplification",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return 1.0, false
+	}
+	return tableSink.(*tableSinkWrapper).getWriteAmplification(), true
+}
+
+// GetTableTimeBreakdown returns the cumulative time spent on reading events of
+// the table from the sorter, mounting them and writing them to the table sink.
+// It returns false if the table sink is not found.
+func (m *SinkManager) GetTableTimeBreakdown(tableID model.TableID) (sort, mount, sink time.Duration, ok bool) {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Debug("Table sink not found when getting table time breakdown",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return 0, 0, 0, false
+	}
+	sort, mount, sink = tableSink.(*tableSinkWrapper).getStageDurations()
+	return sort, mount, sink, true
+}
+
+// GetTableMemoryUsage returns the memory quota used by the table and the memory
+// quota of the sink manager, which is shared by all tables of the changefeed.
+func (m *SinkManager) GetTableMemoryUsage(tableID model.TableID) (used, limit uint64, ok bool) {
+	if _, ok := m.tableSinks.Load(tableID); !ok {
+		log.Debug("Table sink not found when getting table memory usage",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return 0, 0, false
+	}
+	used, limit = m.memQuota.getTableUsage(tableID)
+	return used, limit, true
+}
+
+// ResetTableConflictStats zeroes the conflict statistics of the table sink.
+// It returns false if the table sink is not found.
+func (m *SinkManager) ResetTableConflictStats(tableID model.TableID) bool {
+	tableSink, ok := m.tableSinks.Load(tableID)
+	if !ok {
+		log.Debug("Table sink not found when resetting table conflict stats",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return false
+	}
+	tableSink.(*tableSinkWrapper).resetConflictStats()
+	return true
+}
+
 // GetTableStats returns the state of the table.
 func (m *SinkManager) GetTableStats(tableID model.TableID) TableStats {
 	value, ok := m.tableSinks.Load(tableID)
@@ -55,7 +55,7 @@ func createManagerWithMemEngine(
 ) (*SinkManager, engine.SortEngine) {
 	sortEngine := memory.New(context.Background())
 	up := upstream.NewUpstream4Test(&mockPD{})
-	sm := sourcemanager.New(changefeedID, up, &entry.MockMountGroup{}, sortEngine, errChan, false)
+	sm := sourcemanager.New(changefeedID, up, &entry.MockMountGroup{}, sortEngine, errChan, false, 0, false)
 	manager, err := New(
 		ctx, changefeedID, changefeedInfo, up,
 		nil, sm,
@@ -161,6 +161,19 @@ func TestAddTable(t *testing.T) {
 	require.Equal(t, tableID, progress.tableID)
 	require.Equal(t, uint64(0), progress.nextLowerBoundPos.StartTs)
 	require.Equal(t, uint64(2), progress.nextLowerBoundPos.CommitTs)
+
+	violations, ok := manager.GetTableOrderingViolations(tableID)
+	require.True(t, ok)
+	require.Equal(t, 0, violations)
+	_, ok = manager.GetTableOrderingViolations(tableID + 1)
+	require.False(t, ok)
+
+	amplification, ok := manager.GetTableWriteAmplification(tableID)
+	require.True(t, ok)
+	require.Equal(t, 1.0, amplification)
+	amplification, ok = manager.GetTableWriteAmplification(tableID + 1)
+	require.False(t, ok)
+	require.Equal(t, 1.0, amplification)
 }
 
 func TestRemoveTable(t *testing.T) {
@@ -205,6 +218,55 @@ func TestRemoveTable(t *testing.T) {
 	require.Equal(t, uint64(0), manager.memQuota.getUsedBytes(), "After remove table, the memory usage should be 0.")
 }
 
+func TestReplayTable(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changefeedInfo := getChangefeedInfo()
+	manager, e := createManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"), changefeedInfo, make(chan error, 1))
+	defer func() {
+		err := manager.Close()
+		require.NoError(t, err)
+	}()
+	tableID := model.TableID(1)
+	manager.AddTable(tableID, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, tableID)
+	manager.UpdateBarrierTs(4)
+	manager.UpdateReceivedSorterResolvedTs(tableID, 5)
+	err := manager.StartTable(tableID, 0)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return manager.GetTableStats(tableID).CheckpointTs == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	value, ok := manager.tableSinks.Load(tableID)
+	require.True(t, ok)
+	oldSink := value.(*tableSinkWrapper)
+
+	manager.ReplayTable(tableID, 2)
+	state, ok := manager.GetTableState(tableID)
+	require.True(t, ok)
+	require.Equal(t, tablepb.TableStatePreparing, state)
+	require.Equal(t, uint64(2), manager.GetTableStats(tableID).CheckpointTs)
+	require.Eventually(t, func() bool {
+		return manager.IsReplacedTableSinkStopped(tableID)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, tablepb.TableStateStopped, oldSink.getState())
+
+	// Rewind the source and resume the replayed table.
+	e.RemoveTable(tableID)
+	addTableAndAddEventsToSortEngine(t, e, tableID)
+	manager.ResumeReplayedTable(tableID)
+	state, ok = manager.GetTableState(tableID)
+	require.True(t, ok)
+	require.Equal(t, tablepb.TableStateReplicating, state)
+	manager.UpdateReceivedSorterResolvedTs(tableID, 5)
+	require.Eventually(t, func() bool {
+		return manager.GetTableStats(tableID).CheckpointTs == 4
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestUpdateBarrierTs(t *testing.T) {
 	t.Parallel()
 
@@ -281,6 +343,43 @@ func TestGenerateTableSinkTaskWithResolvedTs(t *testing.T) {
 	}, 5*time.Second, 10*time.Millisecond)
 }
 
+func TestDoNotGenerateTableSinkTaskWhenFenced(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changefeedInfo := getChangefeedInfo()
+	manager, e := createManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"), changefeedInfo, make(chan error, 1))
+	defer func() {
+		err := manager.Close()
+		require.NoError(t, err)
+	}()
+	manager.SetFenced(true)
+	require.True(t, manager.IsFenced())
+
+	tableID := model.TableID(1)
+	manager.AddTable(tableID, 1, 100)
+	addTableAndAddEventsToSortEngine(t, e, tableID)
+	manager.UpdateBarrierTs(4)
+	manager.UpdateReceivedSorterResolvedTs(tableID, 5)
+	err := manager.StartTable(tableID, 0)
+	require.NoError(t, err)
+
+	// Nothing is emitted while the sink manager is fenced.
+	time.Sleep(5 * manager.generateTaskInterval)
+	tableSink, ok := manager.tableSinks.Load(tableID)
+	require.True(t, ok)
+	require.Less(t, tableSink.(*tableSinkWrapper).getCheckpointTs().ResolvedMark(), uint64(4))
+
+	manager.SetFenced(false)
+	require.False(t, manager.IsFenced())
+	require.Eventually(t, func() bool {
+		checkpointTS := tableSink.(*tableSinkWrapper).getCheckpointTs()
+		return checkpointTS.ResolvedMark() == 4
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestGetTableStatsToReleaseMemQuota(t *testing.T) {
 	t.Parallel()
 
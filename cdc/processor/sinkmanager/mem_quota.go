@@ -240,6 +240,17 @@ func (m *memQuota) getUsedBytes() uint64 {
 	return m.usedBytes
 }
 
+// getTableUsage returns the memory quota recorded for the table and the total
+// memory quota shared by all tables.
+func (m *memQuota) getTableUsage(tableID model.TableID) (used, total uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, record := range m.tableMemory[tableID] {
+		used += record.size
+	}
+	return used, m.totalBytes
+}
+
 // hasAvailable returns true if the memory quota is available, otherwise returns false.
 func (m *memQuota) hasAvailable(nBytes uint64) bool {
 	m.mu.Lock()
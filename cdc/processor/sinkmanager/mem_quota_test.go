@@ -247,3 +247,28 @@ func TestMemQuotaRecordAndClean(t *testing.T) {
 	require.Equal(t, uint64(300), cleanedBytes)
 	require.True(t, m.hasAvailable(100))
 }
+
+func TestMemQuotaGetTableUsage(t *testing.T) {
+	t.Parallel()
+
+	m := newMemQuota(model.DefaultChangeFeedID("1"), 300)
+	defer m.close()
+	m.addTable(1)
+	m.addTable(2)
+	require.True(t, m.tryAcquire(200))
+	m.record(1, model.NewResolvedTs(1), 100)
+	m.record(1, model.NewResolvedTs(2), 50)
+	m.record(2, model.NewResolvedTs(2), 50)
+
+	used, total := m.getTableUsage(1)
+	require.Equal(t, uint64(150), used)
+	require.Equal(t, uint64(300), total)
+	m.release(1, model.NewResolvedTs(1))
+	used, _ = m.getTableUsage(1)
+	require.Equal(t, uint64(50), used)
+	used, _ = m.getTableUsage(2)
+	require.Equal(t, uint64(50), used)
+	used, total = m.getTableUsage(3)
+	require.Zero(t, used)
+	require.Equal(t, uint64(300), total)
+}
@@ -33,8 +33,9 @@ type sinkWorker struct {
 	eventCache    *redoEventCache
 	// splitTxn indicates whether to split the transaction into multiple batches.
 	splitTxn bool
-	// enableOldValue indicates whether to enable the old value feature.
-	// If it is enabled, we need to deal with the compatibility of the data format.
+	// enableOldValue indicates whether the old value feature is enabled for
+	// any table. If it is not, we need to deal with the compatibility of the
+	// data format.
 	enableOldValue bool
 
 	metricRedoEventCacheHit  prometheus.Counter
@@ -241,6 +242,7 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 	defer func() {
 		w.metricRedoEventCacheMiss.Add(float64(allEventSize))
 		task.tableSink.receivedEventCount.Add(int64(allEventCount))
+		task.tableSink.recordFetchDurations(iter.Durations())
 		metrics.OutputEventCount.WithLabelValues(
 			task.tableSink.changefeed.Namespace,
 			task.tableSink.changefeed.ID,
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/pipeline"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	sinkv2 "github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
@@ -65,6 +66,31 @@ type tableSinkWrapper struct {
 	lastCleanTime time.Time
 	// checkpointTs is the checkpoint ts of the table sink.
 	checkpointTs atomic.Uint64
+	// replaced is the table sink replaced by this one when the table is
+	// replayed, it's reset after the replay is resumed.
+	replaced *tableSinkWrapper
+	// epoch is the epoch of the table span ownership on the capture, and
+	// fencedEpoch is the greatest epoch of the table span known to be issued
+	// by the owner. Events are dropped once fencedEpoch is greater, since the
+	// table span is owned by another capture then.
+	epoch       atomic.Uint64
+	fencedEpoch atomic.Uint64
+
+	// checkCommitTsOrder enables checking the commit ts order of appended events.
+	checkCommitTsOrder bool
+	// maxAppendedCommitTs is the max commit ts of appended events, it's only
+	// maintained if checkCommitTsOrder is enabled.
+	maxAppendedCommitTs model.Ts
+	// orderingViolations is the number of appended events whose commit ts is
+	// less than the commit ts of an event appended before.
+	orderingViolations atomic.Int64
+
+	// sortDuration, mountDuration and sinkDuration are the cumulative time
+	// spent on reading events from the sorter, mounting them and writing them
+	// to the table sink, in nanoseconds.
+	sortDuration  atomic.Int64
+	mountDuration atomic.Int64
+	sinkDuration  atomic.Int64
 
 	// rangeEventCounts is for clean the table engine.
 	// If rangeEventCounts[i].events is greater than 0, it means there must be
@@ -143,7 +169,57 @@ func (t *tableSinkWrapper) start(startTs model.Ts, replicateTs model.Ts) {
 }
 
 func (t *tableSinkWrapper) appendRowChangedEvents(events ...*model.RowChangedEvent) {
+	if t.isStale() {
+		return
+	}
+	if t.checkCommitTsOrder {
+		t.checkAppendedCommitTs(events)
+	}
+	start := time.Now()
 	t.tableSink.AppendRowChangedEvents(events...)
+	t.sinkDuration.Add(int64(time.Since(start)))
+}
+
+// checkAppendedCommitTs counts the events whose commit ts decreases, and logs
+// the first one. The events are still appended, it only reports the bug.
+func (t *tableSinkWrapper) checkAppendedCommitTs(events []*model.RowChangedEvent) {
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		if e.CommitTs < t.maxAppendedCommitTs {
+			if t.orderingViolations.Add(1) == 1 {
+				log.Error("Commit ts of the events appended to the table sink decreases",
+					zap.String("namespace", t.changefeed.Namespace),
+					zap.String("changefeed", t.changefeed.ID),
+					zap.Int64("tableID", t.tableID),
+					zap.Uint64("version", t.version),
+					zap.Uint64("maxAppendedCommitTs", t.maxAppendedCommitTs),
+					zap.Uint64("commitTs", e.CommitTs),
+					zap.Uint64("startTs", e.StartTs),
+					zap.Stringer("table", e.Table))
+			}
+			continue
+		}
+		t.maxAppendedCommitTs = e.CommitTs
+	}
+}
+
+func (t *tableSinkWrapper) getOrderingViolations() int {
+	return int(t.orderingViolations.Load())
+}
+
+// recordFetchDurations adds the time spent on reading events from the sorter
+// and mounting them by a sink task.
+func (t *tableSinkWrapper) recordFetchDurations(sort, mount time.Duration) {
+	t.sortDuration.Add(int64(sort))
+	t.mountDuration.Add(int64(mount))
+}
+
+func (t *tableSinkWrapper) getStageDurations() (sort, mount, sink time.Duration) {
+	return time.Duration(t.sortDuration.Load()),
+		time.Duration(t.mountDuration.Load()),
+		time.Duration(t.sinkDuration.Load())
 }
 
 func (t *tableSinkWrapper) updateReceivedSorterResolvedTs(ts model.Ts) {
@@ -168,7 +244,14 @@ func (t *tableSinkWrapper) updateReceivedSorterCommitTs(ts model.Ts) {
 }
 
 func (t *tableSinkWrapper) updateResolvedTs(ts model.ResolvedTs) error {
-	if err := t.tableSink.UpdateResolvedTs(ts); err != nil {
+	// The events appended are never flushed if the table sink is stale.
+	if t.isStale() {
+		return nil
+	}
+	start := time.Now()
+	err := t.tableSink.UpdateResolvedTs(ts)
+	t.sinkDuration.Add(int64(time.Since(start)))
+	if err != nil {
 		return errors.Trace(err)
 	}
 	return nil
@@ -199,6 +282,38 @@ func (t *tableSinkWrapper) getState() tablepb.TableState {
 	return t.state.Load()
 }
 
+// fence records the greatest epoch of the table span issued by the owner, it
+// returns true if the table sink becomes stale.
+func (t *tableSinkWrapper) fence(epoch uint64) bool {
+	for {
+		old := t.fencedEpoch.Load()
+		if epoch <= old {
+			return false
+		}
+		if t.fencedEpoch.CompareAndSwap(old, epoch) {
+			return old <= t.epoch.Load() && t.isStale()
+		}
+	}
+}
+
+// isStale returns true if the table span is owned by another capture with a
+// greater epoch, its events mustn't be written to the downstream then.
+func (t *tableSinkWrapper) isStale() bool {
+	return t.fencedEpoch.Load() > t.epoch.Load()
+}
+
+func (t *tableSinkWrapper) getConflictStats() eventsink.ConflictStats {
+	return t.tableSink.GetConflictStats()
+}
+
+func (t *tableSinkWrapper) resetConflictStats() {
+	t.tableSink.ResetConflictStats()
+}
+
+func (t *tableSinkWrapper) getWriteAmplification() float64 {
+	return t.tableSink.GetWriteAmplification()
+}
+
 func (t *tableSinkWrapper) close(ctx context.Context) {
 	t.state.Store(tablepb.TableStateStopping)
 	// table stopped state must be set after underlying sink is closed
@@ -17,6 +17,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -103,6 +104,93 @@ func TestUpdateReceivedSorterResolvedTs(t *testing.T) {
 	require.Equal(t, tablepb.TableStatePrepared, wrapper.getState())
 }
 
+func TestCheckCommitTsOrder(t *testing.T) {
+	t.Parallel()
+
+	wrapper, sink := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	newEvents := func(commitTs ...model.Ts) []*model.RowChangedEvent {
+		events := make([]*model.RowChangedEvent, 0, len(commitTs))
+		for _, ts := range commitTs {
+			events = append(events, &model.RowChangedEvent{
+				CommitTs: ts,
+				Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+			})
+		}
+		return events
+	}
+	// the check is disabled by default.
+	wrapper.appendRowChangedEvents(newEvents(3, 2)...)
+	require.Equal(t, 0, wrapper.getOrderingViolations())
+
+	wrapper.checkCommitTsOrder = true
+	wrapper.appendRowChangedEvents(newEvents(5, 5, 6)...)
+	wrapper.appendRowChangedEvents(newEvents(6, 7)...)
+	require.Equal(t, 0, wrapper.getOrderingViolations())
+	// every event less than the max appended commit ts is a violation.
+	wrapper.appendRowChangedEvents(newEvents(4, 6, 5, 8)...)
+	require.Equal(t, 3, wrapper.getOrderingViolations())
+	wrapper.appendRowChangedEvents(newEvents(7)...)
+	require.Equal(t, 4, wrapper.getOrderingViolations())
+
+	// the events are still written.
+	require.NoError(t, wrapper.updateResolvedTs(model.NewResolvedTs(10)))
+	require.Len(t, sink.GetEvents(), 12)
+}
+
+func TestTableSinkWrapperDropStaleEvents(t *testing.T) {
+	t.Parallel()
+
+	wrapper, sink := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	newEvent := func(commitTs model.Ts) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs: commitTs,
+			Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+		}
+	}
+	wrapper.epoch.Store(2)
+	require.False(t, wrapper.fence(1))
+	require.False(t, wrapper.fence(2))
+	require.False(t, wrapper.isStale())
+	wrapper.appendRowChangedEvents(newEvent(1))
+	require.NoError(t, wrapper.updateResolvedTs(model.NewResolvedTs(1)))
+	require.Len(t, sink.GetEvents(), 1)
+
+	// The events in flight are dropped once the table span is owned by
+	// another capture with a greater epoch.
+	wrapper.appendRowChangedEvents(newEvent(2))
+	require.True(t, wrapper.fence(3))
+	require.False(t, wrapper.fence(4))
+	require.True(t, wrapper.isStale())
+	wrapper.appendRowChangedEvents(newEvent(3))
+	require.NoError(t, wrapper.updateResolvedTs(model.NewResolvedTs(3)))
+	require.Len(t, sink.GetEvents(), 1)
+}
+
+func TestTableSinkWrapperStageDurations(t *testing.T) {
+	t.Parallel()
+
+	wrapper, _ := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	sort, mount, sink := wrapper.getStageDurations()
+	require.Zero(t, sort)
+	require.Zero(t, mount)
+	require.Zero(t, sink)
+
+	wrapper.recordFetchDurations(time.Second, 2*time.Second)
+	wrapper.recordFetchDurations(time.Second, time.Second)
+	wrapper.appendRowChangedEvents(&model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+	})
+	require.NoError(t, wrapper.updateResolvedTs(model.NewResolvedTs(1)))
+	sort, mount, sink = wrapper.getStageDurations()
+	require.Equal(t, 2*time.Second, sort)
+	require.Equal(t, 3*time.Second, mount)
+	require.Positive(t, sink)
+}
+
 func TestConvertNilRowChangedEvents(t *testing.T) {
 	t.Parallel()
 
@@ -58,9 +58,15 @@ type SortEngineFactory struct {
 	pebbleConfig *config.DBConfig
 	dbs          []*pebble.DB
 	writeStalls  []writeStall
+	// throttle is shared by all engines, it's adjusted by the health checker.
+	throttle *epebble.IngestThrottle
 
 	// dbs is also readed in the background metrics collector.
 	dbInitialized *atomic.Bool
+
+	healthMu      sync.Mutex
+	health        Health
+	healthChecked bool
 }
 
 // Create creates a SortEngine. If an engine with same ID already exists,
@@ -82,7 +88,7 @@ func (f *SortEngineFactory) Create(ID model.ChangeFeedID) (e engine.SortEngine,
 			}
 			f.dbInitialized.Store(true)
 		}
-		e = epebble.New(ID, f.dbs)
+		e = epebble.New(ID, f.dbs).WithIngestThrottle(f.throttle)
 		f.engines[ID] = e
 	default:
 		log.Panic("not implemented")
@@ -129,6 +135,7 @@ func NewForPebble(dir string, memQuotaInBytes uint64, cfg *config.DBConfig) *Sor
 		engines:         make(map[model.ChangeFeedID]engine.SortEngine),
 		closed:          make(chan struct{}),
 		pebbleConfig:    cfg,
+		throttle:        epebble.NewIngestThrottle(),
 		dbInitialized:   atomic.NewBool(false),
 	}
 
@@ -139,15 +146,19 @@ func NewForPebble(dir string, memQuotaInBytes uint64, cfg *config.DBConfig) *Sor
 func (f *SortEngineFactory) startMetricsCollector() {
 	f.wg.Add(1)
 	ticker := time.NewTicker(metricsCollectInterval)
+	healthTicker := time.NewTicker(healthCheckInterval)
 	go func() {
 		defer f.wg.Done()
 		defer ticker.Stop()
+		defer healthTicker.Stop()
 		for {
 			select {
 			case <-f.closed:
 				return
 			case <-ticker.C:
 				f.collectMetrics()
+			case <-healthTicker.C:
+				f.checkHealth()
 			}
 		}
 	}()
@@ -162,6 +173,9 @@ func (f *SortEngineFactory) collectMetrics() {
 			metrics.InMemoryDataSizeGauge.WithLabelValues(id).Set(float64(stats.BlockCache.Size))
 			dbMetrics.IteratorGauge().WithLabelValues(id).Set(float64(stats.TableIters))
 			dbMetrics.WriteDelayCount().WithLabelValues(id).Set(float64(stdatomic.LoadUint64(&f.writeStalls[i].counter)))
+			dbMetrics.WriteDelayDuration().WithLabelValues(id).Set(
+				(time.Duration(stdatomic.LoadInt64(&f.writeStalls[i].durInMs)) * time.Millisecond).Seconds())
+			dbMetrics.CompactionDebt().WithLabelValues(id).Set(float64(stats.Compact.EstimatedDebt))
 
 			metricLevelCount := dbMetrics.LevelCount().MustCurryWith(map[string]string{"id": id})
 			for level, metric := range stats.Levels {
@@ -0,0 +1,138 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factory
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// HealthState is the health state of the pebble instances of a SortEngineFactory.
+type HealthState string
+
+const (
+	// HealthStateNormal means there is no risk of write stalls.
+	HealthStateNormal HealthState = "normal"
+	// HealthStateSlowdown means the number of level-0 files reaches
+	// write-l0-slowdown-trigger, events written by pullers are throttled.
+	HealthStateSlowdown HealthState = "slowdown"
+	// HealthStateStalled means some pebble instances are stalling writes.
+	HealthStateStalled HealthState = "stalled"
+)
+
+const (
+	healthCheckInterval = time.Second
+
+	// minIngestDelay is the delay of adding an event when the throttle starts,
+	// it's doubled every healthCheckInterval until maxIngestDelay.
+	minIngestDelay = time.Millisecond
+	maxIngestDelay = 100 * time.Millisecond
+)
+
+// Health is the health of the pebble instances of a SortEngineFactory.
+type Health struct {
+	State HealthState
+	// L0FileCount is the max number of level-0 files among pebble instances.
+	L0FileCount int64
+	// CompactionDebt is the estimated number of bytes need to be compacted.
+	CompactionDebt uint64
+	// WriteStallCount is the number of write stalls since the factory is created.
+	WriteStallCount uint64
+	// WriteStallDuration is the total duration of write stalls since the
+	// factory is created, including the ongoing ones.
+	WriteStallDuration time.Duration
+	// IngestDelay is the current delay of adding an event into sort engines.
+	IngestDelay time.Duration
+}
+
+// Health returns the health of the pebble instances.
+// It returns false if the pebble instances are not created yet.
+func (f *SortEngineFactory) Health() (Health, bool) {
+	f.healthMu.Lock()
+	defer f.healthMu.Unlock()
+	return f.health, f.healthChecked
+}
+
+func (f *SortEngineFactory) checkHealth() {
+	if f.engineType != pebbleEngine || !f.dbInitialized.Load() {
+		return
+	}
+
+	health := Health{State: HealthStateNormal}
+	stalled := false
+	now := time.Now()
+	for i, db := range f.dbs {
+		stats := db.Metrics()
+		if l0 := stats.Levels[0].NumFiles; l0 > health.L0FileCount {
+			health.L0FileCount = l0
+		}
+		health.CompactionDebt += stats.Compact.EstimatedDebt
+
+		ws := &f.writeStalls[i]
+		health.WriteStallCount += atomic.LoadUint64(&ws.counter)
+		health.WriteStallDuration += time.Duration(atomic.LoadInt64(&ws.durInMs)) * time.Millisecond
+		if startAt := atomic.LoadInt64(&ws.startAt); startAt != 0 {
+			stalled = true
+			health.WriteStallDuration += now.Sub(time.Unix(0, startAt))
+		}
+	}
+	if stalled {
+		health.State = HealthStateStalled
+	} else if health.L0FileCount >= int64(f.pebbleConfig.WriteL0SlowdownTrigger) {
+		health.State = HealthStateSlowdown
+	}
+
+	delay := nextIngestDelay(health.State, f.throttle.Delay())
+	f.throttle.SetDelay(delay)
+	health.IngestDelay = delay
+
+	f.healthMu.Lock()
+	defer f.healthMu.Unlock()
+	if f.health.State != health.State {
+		log.Info("sort engine health state changed",
+			zap.String("from", string(f.health.State)),
+			zap.String("to", string(health.State)),
+			zap.Int64("l0FileCount", health.L0FileCount),
+			zap.Uint64("compactionDebt", health.CompactionDebt),
+			zap.Duration("ingestDelay", delay))
+	}
+	f.health = health
+	f.healthChecked = true
+}
+
+// nextIngestDelay calculates the delay of adding an event according to the
+// health state. The delay grows exponentially while the write stall risk is
+// high, and decreases gradually after the risk is gone to avoid oscillation.
+func nextIngestDelay(state HealthState, current time.Duration) time.Duration {
+	switch state {
+	case HealthStateStalled:
+		return maxIngestDelay
+	case HealthStateSlowdown:
+		if current < minIngestDelay {
+			return minIngestDelay
+		}
+		if current*2 > maxIngestDelay {
+			return maxIngestDelay
+		}
+		return current * 2
+	default:
+		if current/2 < minIngestDelay {
+			return 0
+		}
+		return current / 2
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextIngestDelay(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, time.Duration(0), nextIngestDelay(HealthStateNormal, 0))
+	require.Equal(t, minIngestDelay, nextIngestDelay(HealthStateSlowdown, 0))
+	require.Equal(t, 2*minIngestDelay, nextIngestDelay(HealthStateSlowdown, minIngestDelay))
+	require.Equal(t, maxIngestDelay, nextIngestDelay(HealthStateSlowdown, maxIngestDelay-time.Millisecond))
+	require.Equal(t, maxIngestDelay, nextIngestDelay(HealthStateStalled, 0))
+
+	// the delay decreases gradually after the risk is gone.
+	require.Equal(t, maxIngestDelay/2, nextIngestDelay(HealthStateNormal, maxIngestDelay))
+	require.Equal(t, time.Duration(0), nextIngestDelay(HealthStateNormal, minIngestDelay))
+}
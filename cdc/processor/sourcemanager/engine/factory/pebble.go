@@ -35,7 +35,7 @@ func createPebbleDBs(
 	writeStalls := make([]writeStall, cfg.Count)
 
 	for id := 0; id < cfg.Count; id++ {
-		ws := writeStalls[id]
+		ws := &writeStalls[id]
 		adjust := func(opts *pebble.Options) {
 			opts.EventListener = pebble.MakeLoggingEventListener(&pebbleLogger{id: id})
 
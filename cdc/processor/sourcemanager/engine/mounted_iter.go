@@ -15,6 +15,7 @@ package engine
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -30,6 +31,11 @@ type MountedEventIter struct {
 	nextToMount    int
 	nextToEmit     int
 	savedIterError error
+
+	// sortDuration is the time spent on reading events from the sort engine.
+	sortDuration time.Duration
+	// mountDuration is the time spent on mounting events.
+	mountDuration time.Duration
 }
 
 // NewMountedEventIter creates a MountedEventIter instance.
@@ -49,7 +55,10 @@ func NewMountedEventIter(
 func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEvent, txnFinished Position, err error) {
 	// Check whether there are events in mounting or not.
 	for idx := i.nextToEmit; idx < i.nextToMount; idx++ {
-		if err = i.rawEvents[idx].event.WaitFinished(ctx); err == nil {
+		start := time.Now()
+		err = i.rawEvents[idx].event.WaitFinished(ctx)
+		i.mountDuration += time.Since(start)
+		if err == nil {
 			event = i.rawEvents[idx].event
 			txnFinished = i.rawEvents[idx].txnFinished
 			i.nextToEmit += 1
@@ -69,7 +78,9 @@ func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEv
 		}
 
 		for len(i.rawEvents) < cap(i.rawEvents) {
+			start := time.Now()
 			event, txnFinished, err = i.iter.Next()
+			i.sortDuration += time.Since(start)
 			if err != nil {
 				return
 			}
@@ -80,6 +91,7 @@ func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEv
 			}
 			i.rawEvents = append(i.rawEvents, rawEvent{event, txnFinished})
 		}
+		start := time.Now()
 		for idx := i.nextToMount; idx < len(i.rawEvents); idx++ {
 			i.rawEvents[idx].event.SetUpFinishedCh()
 			if err = i.mg.AddEvent(ctx, i.rawEvents[idx].event); err != nil {
@@ -88,6 +100,7 @@ func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEv
 			}
 			i.nextToMount += 1
 		}
+		i.mountDuration += time.Since(start)
 
 		// More events are fetched and in mounting. So re-call this function to wait them.
 		if i.nextToEmit < i.nextToMount {
@@ -97,6 +110,12 @@ func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEv
 	return
 }
 
+// Durations returns the time spent on reading events from the sort engine and
+// mounting them by the iterator.
+func (i *MountedEventIter) Durations() (sort, mount time.Duration) {
+	return i.sortDuration, i.mountDuration
+}
+
 // Close implements sorter.EventIterator.
 func (i *MountedEventIter) Close() error {
 	if i.savedIterError != nil {
@@ -16,6 +16,7 @@ package engine
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -67,3 +68,32 @@ func TestMountedEventIter(t *testing.T) {
 	require.Equal(t, iter.nextToEmit, 0)
 	require.Nil(t, iter.iter)
 }
+
+func TestMountedEventIterDurations(t *testing.T) {
+	t.Parallel()
+
+	rawIter := &mockIter{
+		repeatItem: func() *model.PolymorphicEvent {
+			time.Sleep(time.Millisecond)
+			return &model.PolymorphicEvent{
+				Row: &model.RowChangedEvent{
+					Table:        &model.TableName{Schema: "schema", Table: "table"},
+					IndexColumns: [][]int{{1}},
+				},
+			}
+		},
+	}
+	iter := NewMountedEventIter(rawIter, &entry.MockMountGroup{}, 3)
+	sort, mount := iter.Durations()
+	require.Zero(t, sort)
+	require.Zero(t, mount)
+
+	for i := 0; i < 3; i++ {
+		event, _, err := iter.Next(context.Background())
+		require.NotNil(t, event)
+		require.Nil(t, err)
+	}
+	sort, mount = iter.Durations()
+	require.GreaterOrEqual(t, sort, 3*time.Millisecond)
+	require.Positive(t, mount)
+}
@@ -45,6 +45,8 @@ type EventSorter struct {
 	dbs          []*pebble.DB
 	channs       []*chann.Chann[eventWithTableID]
 	serde        encoding.MsgPackGenSerde
+	// throttle delays adding events, nil if it's not set.
+	throttle *IngestThrottle
 
 	// To manage background goroutines.
 	wg     sync.WaitGroup
@@ -105,6 +107,13 @@ func New(ID model.ChangeFeedID, dbs []*pebble.DB) *EventSorter {
 	return eventSorter
 }
 
+// WithIngestThrottle sets the throttle used to delay adding events.
+// It must be called before the EventSorter is used.
+func (s *EventSorter) WithIngestThrottle(throttle *IngestThrottle) *EventSorter {
+	s.throttle = throttle
+	return s
+}
+
 // IsTableBased implements engine.SortEngine.
 func (s *EventSorter) IsTableBased() bool {
 	return true
@@ -153,6 +162,7 @@ func (s *EventSorter) Add(tableID model.TableID, events ...*model.PolymorphicEve
 			zap.Int64("tableID", tableID))
 	}
 
+	s.throttle.wait()
 	maxCommitTs := model.Ts(0)
 	maxResolvedTs := model.Ts(0)
 	for _, event := range events {
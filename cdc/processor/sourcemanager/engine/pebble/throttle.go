@@ -0,0 +1,55 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// IngestThrottle delays adding events into EventSorters. It's shared by all
+// EventSorters on the same pebble instances, and is adjusted according to
+// the write stall conditions of the instances, so that pullers are slowed
+// down before pebble blocks the goroutines which write events.
+type IngestThrottle struct {
+	delay atomic.Duration
+}
+
+// NewIngestThrottle creates an IngestThrottle which doesn't delay anything.
+func NewIngestThrottle() *IngestThrottle {
+	return &IngestThrottle{}
+}
+
+// Delay returns the delay of adding events.
+func (t *IngestThrottle) Delay() time.Duration {
+	return t.delay.Load()
+}
+
+// SetDelay sets the delay of adding events, non-positive `d` disables the delay.
+func (t *IngestThrottle) SetDelay(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	t.delay.Store(d)
+}
+
+func (t *IngestThrottle) wait() {
+	if t == nil {
+		return
+	}
+	if d := t.delay.Load(); d > 0 {
+		time.Sleep(d)
+	}
+}
@@ -44,10 +44,17 @@ type SourceManager struct {
 	engine engine.SortEngine
 	// pullers is the puller wrapper map.
 	pullers sync.Map
+	// concurrencies are the numbers of events mounted in parallel when
+	// fetching events of tables, they are kept even if the tables are removed.
+	concurrencies sync.Map
 	// Used to report the error to the processor.
 	errChan chan error
 	// Used to indicate whether the changefeed is in BDR mode.
 	bdrMode bool
+	// resolvedTsInterval is the minimal interval of resolved ts sent by pullers.
+	resolvedTsInterval time.Duration
+	// Used to indicate whether to send the incremental scan requests to followers.
+	followerIncrementalScan bool
 }
 
 // New creates a new source manager.
@@ -58,14 +65,18 @@ func New(
 	engine engine.SortEngine,
 	errChan chan error,
 	bdrMode bool,
+	resolvedTsInterval time.Duration,
+	followerIncrementalScan bool,
 ) *SourceManager {
 	return &SourceManager{
-		changefeedID: changefeedID,
-		up:           up,
-		mg:           mg,
-		engine:       engine,
-		errChan:      errChan,
-		bdrMode:      bdrMode,
+		changefeedID:            changefeedID,
+		up:                      up,
+		mg:                      mg,
+		engine:                  engine,
+		errChan:                 errChan,
+		bdrMode:                 bdrMode,
+		resolvedTsInterval:      resolvedTsInterval,
+		followerIncrementalScan: followerIncrementalScan,
 	}
 }
 
@@ -73,7 +84,8 @@ func New(
 func (m *SourceManager) AddTable(ctx cdccontext.Context, tableID model.TableID, tableName string, startTs model.Ts) {
 	// Add table to the engine first, so that the engine can receive the events from the puller.
 	m.engine.AddTable(tableID)
-	p := pullerwrapper.NewPullerWrapper(m.changefeedID, tableID, tableName, startTs, m.bdrMode)
+	p := pullerwrapper.NewPullerWrapper(m.changefeedID, tableID, tableName, startTs,
+		m.bdrMode, m.resolvedTsInterval, m.followerIncrementalScan)
 	p.Start(ctx, m.up, m.engine, m.errChan)
 	m.pullers.Store(tableID, p)
 }
@@ -95,7 +107,25 @@ func (m *SourceManager) OnResolve(action func(model.TableID, model.Ts)) {
 // FetchByTable just wrap the engine's FetchByTable method.
 func (m *SourceManager) FetchByTable(tableID model.TableID, lowerBound, upperBound engine.Position) *engine.MountedEventIter {
 	iter := m.engine.FetchByTable(tableID, lowerBound, upperBound)
-	return engine.NewMountedEventIter(iter, m.mg, defaultMaxBatchSize)
+	return engine.NewMountedEventIter(iter, m.mg, m.GetTableConcurrency(tableID))
+}
+
+// SetTableConcurrency sets the number of events of the table mounted in parallel,
+// it takes effect on the next fetch. Non-positive `n` resets it to the default one.
+func (m *SourceManager) SetTableConcurrency(tableID model.TableID, n int) {
+	if n <= 0 {
+		m.concurrencies.Delete(tableID)
+		return
+	}
+	m.concurrencies.Store(tableID, n)
+}
+
+// GetTableConcurrency returns the number of events of the table mounted in parallel.
+func (m *SourceManager) GetTableConcurrency(tableID model.TableID) int {
+	if n, ok := m.concurrencies.Load(tableID); ok {
+		return n.(int)
+	}
+	return defaultMaxBatchSize
 }
 
 // CleanByTable just wrap the engine's CleanByTable method.
@@ -120,6 +150,37 @@ func (m *SourceManager) GetTablePullerStats(tableID model.TableID) puller.Stats
 	return p.(*pullerwrapper.Wrapper).GetStats()
 }
 
+// GetTableUpstreamThrottle returns whether the puller of the table is held
+// back by the upstream fetch limits, and the reason if it is. It returns false
+// if the table puller is not found.
+func (m *SourceManager) GetTableUpstreamThrottle(tableID model.TableID) (bool, string) {
+	p, ok := m.pullers.Load(tableID)
+	if !ok {
+		log.Debug("Table puller not found when getting table upstream throttle",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return false, ""
+	}
+	stats := p.(*pullerwrapper.Wrapper).GetStats()
+	return stats.Throttled, stats.ThrottleReason
+}
+
+// GetTableFetchDuration returns the cumulative time spent by the puller of the
+// table on handing the pulled events to the sort engine. It returns false if
+// the table puller is not found.
+func (m *SourceManager) GetTableFetchDuration(tableID model.TableID) (time.Duration, bool) {
+	p, ok := m.pullers.Load(tableID)
+	if !ok {
+		log.Debug("Table puller not found when getting table fetch duration",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Int64("tableID", tableID))
+		return 0, false
+	}
+	return p.(*pullerwrapper.Wrapper).GetFetchDuration(), true
+}
+
 // GetTableSorterStats returns the sorter stats of the table.
 func (m *SourceManager) GetTableSorterStats(tableID model.TableID) engine.TableStats {
 	return m.engine.GetStatsByTable(tableID)
@@ -16,6 +16,8 @@ package puller
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tiflow/cdc/contextutil"
@@ -41,8 +43,15 @@ type Wrapper struct {
 	// cancel is used to cancel the puller when remove or close the table.
 	cancel context.CancelFunc
 	// wg is used to wait the puller to exit.
-	wg      sync.WaitGroup
-	bdrMode bool
+	wg                 sync.WaitGroup
+	bdrMode            bool
+	resolvedTsInterval time.Duration
+	// followerIncrementalScan is true if the incremental scan requests are
+	// sent to followers.
+	followerIncrementalScan bool
+	// fetchDuration is the cumulative time spent on handing the pulled events
+	// to the sort engine, in nanoseconds.
+	fetchDuration atomic.Int64
 }
 
 // NewPullerWrapper creates a new puller wrapper.
@@ -52,13 +61,17 @@ func NewPullerWrapper(
 	tableName string,
 	startTs model.Ts,
 	bdrMode bool,
+	resolvedTsInterval time.Duration,
+	followerIncrementalScan bool,
 ) *Wrapper {
 	return &Wrapper{
-		changefeed: changefeed,
-		tableID:    tableID,
-		tableName:  tableName,
-		startTs:    startTs,
-		bdrMode:    bdrMode,
+		changefeed:              changefeed,
+		tableID:                 tableID,
+		tableName:               tableName,
+		startTs:                 startTs,
+		bdrMode:                 bdrMode,
+		resolvedTsInterval:      resolvedTsInterval,
+		followerIncrementalScan: followerIncrementalScan,
 	}
 }
 
@@ -81,7 +94,8 @@ func (n *Wrapper) Start(
 	ctxC, cancel := context.WithCancel(ctx)
 	ctxC = contextutil.PutCaptureAddrInCtx(ctxC, ctx.GlobalVars().CaptureInfo.AdvertiseAddr)
 	ctxC = contextutil.PutRoleInCtx(ctxC, util.RoleProcessor)
-	kvCfg := config.GetGlobalServerConfig().KVClient
+	kvCfg := config.GetGlobalServerConfig().KVClient.
+		WithFollowerIncrementalScan(n.followerIncrementalScan)
 	// NOTICE: always pull the old value internally
 	// See also: https://github.com/pingcap/tiflow/issues/2301.
 	n.p = puller.New(
@@ -98,6 +112,7 @@ func (n *Wrapper) Start(
 		n.tableID,
 		n.tableName,
 		n.bdrMode,
+		n.resolvedTsInterval,
 	)
 	n.wg.Add(1)
 	go func() {
@@ -118,10 +133,12 @@ func (n *Wrapper) Start(
 				if rawKV == nil {
 					continue
 				}
+				start := time.Now()
 				pEvent := model.NewPolymorphicEvent(rawKV)
 				if err := eventSortEngine.Add(n.tableID, pEvent); err != nil {
 					errChan <- err
 				}
+				n.fetchDuration.Add(int64(time.Since(start)))
 			}
 		}
 	}()
@@ -133,6 +150,12 @@ func (n *Wrapper) GetStats() puller.Stats {
 	return n.p.Stats()
 }
 
+// GetFetchDuration returns the cumulative time spent on handing the pulled
+// events to the sort engine.
+func (n *Wrapper) GetFetchDuration() time.Duration {
+	return time.Duration(n.fetchDuration.Load())
+}
+
 // Close the puller wrapper.
 func (n *Wrapper) Close() {
 	n.cancel()
@@ -0,0 +1,137 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+const (
+	alertKindLag         = "lag"
+	alertKindBacklog     = "backlog"
+	alertKindSinkLatency = "sink-latency"
+)
+
+var alertKinds = []string{alertKindLag, alertKindBacklog, alertKindSinkLatency}
+
+// defaultAlertThresholds are the global alerting thresholds of table spans,
+// they are consistent with the alert rule of changefeed checkpoint lag.
+var defaultAlertThresholds = scheduler.AlertThresholds{
+	Lag:         10 * time.Minute,
+	Backlog:     10 * time.Minute,
+	SinkLatency: 10 * time.Minute,
+}
+
+// exceededAlertThresholds returns the kinds of thresholds which are exceeded.
+func exceededAlertThresholds(
+	thresholds scheduler.AlertThresholds, lag, backlog, sinkLatency time.Duration,
+) []string {
+	var kinds []string
+	if thresholds.Lag > 0 && lag > thresholds.Lag {
+		kinds = append(kinds, alertKindLag)
+	}
+	if thresholds.Backlog > 0 && backlog > thresholds.Backlog {
+		kinds = append(kinds, alertKindBacklog)
+	}
+	if thresholds.SinkLatency > 0 && sinkLatency > thresholds.SinkLatency {
+		kinds = append(kinds, alertKindSinkLatency)
+	}
+	return kinds
+}
+
+// SetTableSpanAlertThresholds implements TableExecutor interface.
+func (p *processor) SetTableSpanAlertThresholds(
+	span tablepb.Span, thresholds scheduler.AlertThresholds,
+) {
+	p.alertThresholds.ReplaceOrInsert(span, thresholds)
+}
+
+// getTableSpanAlertThresholds returns the effective alerting thresholds of the span.
+func (p *processor) getTableSpanAlertThresholds(span tablepb.Span) scheduler.AlertThresholds {
+	thresholds, _ := p.alertThresholds.Get(span)
+	return thresholds.Merge(p.globalAlertThresholds)
+}
+
+// checkTableSpanAlert returns the kinds of thresholds exceeded by the span.
+// `currentTs` is the current physical time in milliseconds.
+func (p *processor) checkTableSpanAlert(
+	span tablepb.Span, checkpointTs, resolvedTs model.Ts, backlog time.Duration, currentTs int64,
+) []string {
+	checkpointPhyTs := oracle.ExtractPhysical(checkpointTs)
+	lag := time.Duration(currentTs-checkpointPhyTs) * time.Millisecond
+	var sinkLatency time.Duration
+	if resolvedTs > checkpointTs {
+		sinkLatency = time.Duration(oracle.ExtractPhysical(resolvedTs)-checkpointPhyTs) * time.Millisecond
+	}
+	return exceededAlertThresholds(p.getTableSpanAlertThresholds(span), lag, backlog, sinkLatency)
+}
+
+// updateAlertingSpans logs the table spans which start or stop exceeding their
+// alerting thresholds, and updates the number of alerting table spans.
+func (p *processor) updateAlertingSpans(alertingSpans *spanz.Map[[]string]) {
+	counts := make(map[string]int, len(alertKinds))
+	alertingSpans.Ascend(func(span tablepb.Span, kinds []string) bool {
+		for _, kind := range kinds {
+			counts[kind]++
+		}
+		if prev, ok := p.alertingSpans.Get(span); !ok || !equalAlertKinds(prev, kinds) {
+			thresholds := p.getTableSpanAlertThresholds(span)
+			log.Warn("table span exceeds its alerting thresholds",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span),
+				zap.Strings("exceeded", kinds),
+				zap.Duration("lagThreshold", thresholds.Lag),
+				zap.Duration("backlogThreshold", thresholds.Backlog),
+				zap.Duration("sinkLatencyThreshold", thresholds.SinkLatency))
+		}
+		return true
+	})
+	p.alertingSpans.Ascend(func(span tablepb.Span, _ []string) bool {
+		if !alertingSpans.Has(span) {
+			log.Info("table span recovers from exceeding its alerting thresholds",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Stringer("span", &span))
+		}
+		return true
+	})
+	p.alertingSpans = alertingSpans
+
+	for _, kind := range alertKinds {
+		tableSpanAlertGauge.
+			WithLabelValues(p.changefeedID.Namespace, p.changefeedID.ID, kind).
+			Set(float64(counts[kind]))
+	}
+}
+
+func equalAlertKinds(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+)
+
+// stageCheckpointCurrent is the key of the current checkpoint of a table span
+// in its stage checkpoints, while the checkpoint in the table status is the
+// last persisted one.
+const stageCheckpointCurrent = "current"
+
+// persistedCheckpoint is the checkpoint of a table span reported to the owner
+// to be persisted, and when it's reported.
+type persistedCheckpoint struct {
+	checkpointTs model.Ts
+	persistedAt  time.Time
+}
+
+// SetTableSpanCheckpointInterval implements TableExecutor interface.
+func (p *processor) SetTableSpanCheckpointInterval(span tablepb.Span, interval time.Duration) {
+	if interval <= 0 {
+		p.checkpointIntervals.Delete(span)
+		return
+	}
+	p.checkpointIntervals.ReplaceOrInsert(span, interval)
+}
+
+// getTableSpanCheckpointInterval returns the effective checkpoint persistence
+// interval of the span.
+func (p *processor) getTableSpanCheckpointInterval(span tablepb.Span) time.Duration {
+	if interval, ok := p.checkpointIntervals.Get(span); ok {
+		return interval
+	}
+	return p.globalCheckpointInterval
+}
+
+// handleSpanCheckpoints advances the persisted checkpoints of the table spans,
+// and checks whether the checkpoints reported to the scheduler go backward.
+// It's called in the processor tick, so that GetTableSpanStatus is read-only
+// and the heartbeats don't affect the checkpoints.
+func (p *processor) handleSpanCheckpoints(now time.Time) {
+	persistedCheckpoints := spanz.NewMap[persistedCheckpoint]()
+	reportedCheckpoints := spanz.NewMap[reportedCheckpoint]()
+	checkSpan := func(span tablepb.Span) {
+		status := p.getTableSpanStatus(span)
+		if persisted, ok := p.persistCheckpoint(status, now); ok {
+			persistedCheckpoints.ReplaceOrInsert(span, persisted)
+		}
+		if reported, ok := p.checkCheckpointRollback(p.applyPersistedCheckpoint(status)); ok {
+			reportedCheckpoints.ReplaceOrInsert(span, reported)
+		}
+	}
+	if p.pullBasedSinking {
+		for _, tableID := range p.sinkManager.GetAllCurrentTableIDs() {
+			checkSpan(spanz.TableIDToComparableSpan(tableID))
+		}
+	} else {
+		p.tableSpans.Ascend(func(span tablepb.Span, _ tablepb.TablePipeline) bool {
+			checkSpan(span)
+			return true
+		})
+	}
+	// rebuild the maps every time, so removed spans are dropped.
+	p.persistedCheckpoints = persistedCheckpoints
+	p.reportedCheckpoints = reportedCheckpoints
+}
+
+// persistCheckpoint returns the last persisted checkpoint of the replicating
+// table span, which advances to the current one at most once per checkpoint
+// interval of the span. It returns false if the span isn't replicating.
+func (p *processor) persistCheckpoint(
+	status tablepb.TableStatus, now time.Time,
+) (persistedCheckpoint, bool) {
+	if status.State != tablepb.TableStateReplicating {
+		return persistedCheckpoint{}, false
+	}
+	current := status.Checkpoint.CheckpointTs
+	persisted, ok := p.persistedCheckpoints.Get(status.Span)
+	// the checkpoint may regress if the table span is added again.
+	if !ok || current < persisted.checkpointTs ||
+		now.Sub(persisted.persistedAt) >= p.getTableSpanCheckpointInterval(status.Span) {
+		persisted = persistedCheckpoint{checkpointTs: current, persistedAt: now}
+	}
+	return persisted, true
+}
+
+// applyPersistedCheckpoint replaces the checkpoint of the replicating table
+// span with the last persisted one, and records the current one in the stage
+// checkpoints. The checkpoint of a span not persisted yet, or behind the
+// persisted one, is reported as it is until the next tick.
+func (p *processor) applyPersistedCheckpoint(status tablepb.TableStatus) tablepb.TableStatus {
+	if status.State != tablepb.TableStateReplicating {
+		return status
+	}
+
+	current := status.Checkpoint
+	// copy the stage checkpoints, they may be shared with the table pipeline.
+	stageCheckpoints := make(map[string]tablepb.Checkpoint, len(status.Stats.StageCheckpoints)+1)
+	for stage, checkpoint := range status.Stats.StageCheckpoints {
+		stageCheckpoints[stage] = checkpoint
+	}
+	stageCheckpoints[stageCheckpointCurrent] = current
+	status.Stats.StageCheckpoints = stageCheckpoints
+
+	if persisted, ok := p.persistedCheckpoints.Get(status.Span); ok &&
+		persisted.checkpointTs <= current.CheckpointTs {
+		status.Checkpoint.CheckpointTs = persisted.checkpointTs
+	}
+	return status
+}
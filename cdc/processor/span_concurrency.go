@@ -0,0 +1,56 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"go.uber.org/zap"
+)
+
+// SetTableSpanConcurrency implements TableExecutor interface.
+func (p *processor) SetTableSpanConcurrency(span tablepb.Span, n int) {
+	if !p.pullBasedSinking || p.sourceManager == nil {
+		log.Warn("table span concurrency can only be adjusted with the pull-based sink, ignore it",
+			zap.String("namespace", p.changefeedID.Namespace),
+			zap.String("changefeed", p.changefeedID.ID),
+			zap.Stringer("span", &span),
+			zap.Int("concurrency", n))
+		return
+	}
+	concurrency := p.boundTableSpanConcurrency(n)
+	p.sourceManager.SetTableConcurrency(span.TableID, concurrency)
+	log.Info("table span concurrency is adjusted",
+		zap.String("namespace", p.changefeedID.Namespace),
+		zap.String("changefeed", p.changefeedID.ID),
+		zap.Stringer("span", &span),
+		zap.Int("requested", n),
+		zap.Int("concurrency", p.sourceManager.GetTableConcurrency(span.TableID)))
+}
+
+// boundTableSpanConcurrency bounds the requested concurrency of a table span
+// to the configured min and max. Non-positive `n` is returned as it is, which
+// resets the concurrency to the default one.
+func (p *processor) boundTableSpanConcurrency(n int) int {
+	if n <= 0 {
+		return n
+	}
+	if n < p.cfg.MinTableSpanConcurrency {
+		return p.cfg.MinTableSpanConcurrency
+	}
+	if n > p.cfg.MaxTableSpanConcurrency {
+		return p.cfg.MaxTableSpanConcurrency
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// unflushedAgeMarkInterval is the granularity of marks kept by unflushedAgeTracker.
+// Events received within the interval share the same mark, so the number of marks
+// is bounded even if the sink is stuck for a long time.
+const unflushedAgeMarkInterval = time.Second
+
+type unflushedAgeMark struct {
+	commitTs   model.Ts
+	receivedAt time.Time
+}
+
+// unflushedAgeTracker tracks the wall-clock time when events of a table span
+// are received by the sorter, so that the age of the oldest event which has
+// not been flushed by the sink can be calculated with the checkpoint of the span.
+type unflushedAgeTracker struct {
+	// marks are in ascending order of both commitTs and receivedAt.
+	marks []unflushedAgeMark
+	// checkpointTs is the checkpoint of the span at the last observation,
+	// events with commit ts not greater than it have been flushed.
+	checkpointTs model.Ts
+}
+
+// observe records the max commit ts received by the sorter at `now`, and
+// drops marks that have been flushed according to `checkpointTs`.
+func (t *unflushedAgeTracker) observe(receivedCommitTs, checkpointTs model.Ts, now time.Time) {
+	i := 0
+	for i < len(t.marks) && t.marks[i].commitTs <= checkpointTs {
+		i++
+	}
+	t.marks = t.marks[i:]
+	if checkpointTs > t.checkpointTs {
+		t.checkpointTs = checkpointTs
+	}
+
+	if receivedCommitTs <= checkpointTs {
+		return
+	}
+	if len(t.marks) == 0 {
+		t.marks = append(t.marks, unflushedAgeMark{commitTs: receivedCommitTs, receivedAt: now})
+		return
+	}
+	last := &t.marks[len(t.marks)-1]
+	if receivedCommitTs <= last.commitTs {
+		return
+	}
+	if now.Sub(last.receivedAt) < unflushedAgeMarkInterval {
+		last.commitTs = receivedCommitTs
+		return
+	}
+	t.marks = append(t.marks, unflushedAgeMark{commitTs: receivedCommitTs, receivedAt: now})
+}
+
+// tsRange returns the commit ts range of the unflushed events, zeros if all
+// received events have been flushed. The min commit ts is the lowest commit ts
+// an unflushed event can have, which is right above the checkpoint, as marks
+// only record the max commit ts received in each interval.
+func (t *unflushedAgeTracker) tsRange() (minTs, maxTs model.Ts) {
+	if len(t.marks) == 0 {
+		return 0, 0
+	}
+	return t.checkpointTs + 1, t.marks[len(t.marks)-1].commitTs
+}
+
+// age returns the age of the oldest unflushed event, zero if all received
+// events have been flushed.
+func (t *unflushedAgeTracker) age(now time.Time) time.Duration {
+	if len(t.marks) == 0 {
+		return 0
+	}
+	age := now.Sub(t.marks[0].receivedAt)
+	if age < 0 {
+		return 0
+	}
+	return age
+}
@@ -491,6 +491,7 @@ func NewDDLJobPuller(
 			changefeed,
 			-1, DDLPullerTableName,
 			ddLPullerFilterLoop,
+			0,
 		),
 		kvStorage: kvStorage,
 		outputCh:  make(chan *model.DDLJobEntry, defaultPullerOutputChanSize),
@@ -507,6 +508,8 @@ type DDLPuller interface {
 	FrontDDL() (uint64, *timodel.Job)
 	// PopFrontDDL returns and pops the first DDL job in the internal queue
 	PopFrontDDL() (uint64, *timodel.Job)
+	// PendingDDLJobCount returns the number of DDL jobs in the internal queue
+	PendingDDLJobCount() int
 	// Close closes the DDLPuller
 	Close()
 }
@@ -674,6 +677,13 @@ func (h *ddlPullerImpl) PopFrontDDL() (uint64, *timodel.Job) {
 	return job.BinlogInfo.FinishedTS, job
 }
 
+// PendingDDLJobCount return the number of pending DDL jobs
+func (h *ddlPullerImpl) PendingDDLJobCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.pendingDDLJobs)
+}
+
 // Close the ddl puller, release all resources.
 func (h *ddlPullerImpl) Close() {
 	log.Info("close the ddl puller",
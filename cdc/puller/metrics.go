@@ -62,6 +62,14 @@ var (
 			Help:      "Puller event channel size",
 			Buckets:   prometheus.ExponentialBuckets(1, 2, 8),
 		}, []string{"namespace", "changefeed"})
+	resolvedTsIntervalHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "puller",
+			Name:      "resolved_ts_interval_seconds",
+			Help:      "The actual interval between two resolved events sent by puller",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14), // 10ms~82s
+		}, []string{"namespace", "changefeed"})
 	discardedDDLCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "ticdc",
@@ -80,4 +88,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(outputChanSizeHistogram)
 	registry.MustRegister(eventChanSizeHistogram)
 	registry.MustRegister(discardedDDLCounter)
+	registry.MustRegister(resolvedTsIntervalHistogram)
 }
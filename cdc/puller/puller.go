@@ -49,6 +49,10 @@ type Stats struct {
 	ResolvedTsIngress   model.Ts
 	CheckpointTsEgress  model.Ts
 	ResolvedTsEgress    model.Ts
+	// Throttled is true if the puller is held back by the upstream fetch
+	// limits, and ThrottleReason tells which limits.
+	Throttled      bool
+	ThrottleReason string
 }
 
 // Puller pull data from tikv and push changes into a buffer.
@@ -73,6 +77,10 @@ type pullerImpl struct {
 	changefeed model.ChangeFeedID
 	tableID    model.TableID
 	tableName  string
+
+	// resolvedTsInterval is the minimal interval between two resolved events
+	// sent by the puller. Zero means sending resolved events once they advance.
+	resolvedTsInterval time.Duration
 }
 
 // New create a new Puller fetch event start from checkpointTs and put into buf.
@@ -89,6 +97,7 @@ func New(ctx context.Context,
 	tableID model.TableID,
 	tableName string,
 	filterLoop bool,
+	resolvedTsInterval time.Duration,
 ) Puller {
 	tikvStorage, ok := kvStorage.(tikv.Storage)
 	if !ok {
@@ -107,16 +116,17 @@ func New(ctx context.Context,
 	kvCli := kv.NewCDCKVClient(
 		ctx, pdCli, grpcPool, regionCache, pdClock, cfg, changefeed, tableID, tableName, filterLoop)
 	p := &pullerImpl{
-		kvCli:        kvCli,
-		kvStorage:    tikvStorage,
-		checkpointTs: checkpointTs,
-		spans:        spans,
-		outputCh:     make(chan *model.RawKVEntry, defaultPullerOutputChanSize),
-		tsTracker:    tsTracker,
-		resolvedTs:   checkpointTs,
-		changefeed:   changefeed,
-		tableID:      tableID,
-		tableName:    tableName,
+		kvCli:              kvCli,
+		kvStorage:          tikvStorage,
+		checkpointTs:       checkpointTs,
+		spans:              spans,
+		outputCh:           make(chan *model.RawKVEntry, defaultPullerOutputChanSize),
+		tsTracker:          tsTracker,
+		resolvedTs:         checkpointTs,
+		changefeed:         changefeed,
+		tableID:            tableID,
+		tableName:          tableName,
+		resolvedTsInterval: resolvedTsInterval,
 	}
 	return p
 }
@@ -148,6 +158,8 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 		WithLabelValues(p.changefeed.Namespace, p.changefeed.ID, "kv")
 	metricTxnCollectCounterResolved := txnCollectCounter.
 		WithLabelValues(p.changefeed.Namespace, p.changefeed.ID, "resolved")
+	metricResolvedTsInterval := resolvedTsIntervalHistogram.
+		WithLabelValues(p.changefeed.Namespace, p.changefeed.ID)
 	defer func() {
 		outputChanSizeHistogram.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID)
 		eventChanSizeHistogram.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID)
@@ -155,12 +167,20 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 		pullerResolvedTsGauge.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID)
 		txnCollectCounter.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID, "kv")
 		txnCollectCounter.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID, "resolved")
+		resolvedTsIntervalHistogram.DeleteLabelValues(p.changefeed.Namespace, p.changefeed.ID)
 	}()
 
 	lastResolvedTs := p.checkpointTs
 	g.Go(func() error {
 		metricsTicker := time.NewTicker(15 * time.Second)
 		defer metricsTicker.Stop()
+		// resolvedTicker sends the resolved ts every resolvedTsInterval if it is set.
+		var resolvedTickerCh <-chan time.Time
+		if p.resolvedTsInterval > 0 {
+			resolvedTicker := time.NewTicker(p.resolvedTsInterval)
+			defer resolvedTicker.Stop()
+			resolvedTickerCh = resolvedTicker.C
+		}
 		output := func(raw *model.RawKVEntry) error {
 			// even after https://github.com/pingcap/tiflow/pull/2038, kv client
 			// could still miss region change notification, which leads to resolved
@@ -190,6 +210,20 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 			return nil
 		}
 
+		lastResolvedAt := time.Now()
+		outputResolvedTs := func(resolvedTs model.Ts, regionID uint64) error {
+			err := output(&model.RawKVEntry{CRTs: resolvedTs, OpType: model.OpTypeResolved, RegionID: regionID})
+			if err != nil {
+				return errors.Trace(err)
+			}
+			now := time.Now()
+			metricResolvedTsInterval.Observe(now.Sub(lastResolvedAt).Seconds())
+			lastResolvedAt = now
+			lastResolvedTs = resolvedTs
+			atomic.StoreUint64(&p.resolvedTs, resolvedTs)
+			return nil
+		}
+
 		start := time.Now()
 		initialized := false
 		for {
@@ -202,6 +236,15 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 				metricOutputChanSize.Observe(float64(len(p.outputCh)))
 				metricPullerResolvedTs.Set(float64(oracle.ExtractPhysical(atomic.LoadUint64(&p.resolvedTs))))
 				continue
+			case <-resolvedTickerCh:
+				resolvedTs := p.tsTracker.Frontier()
+				if !initialized || resolvedTs == lastResolvedTs {
+					continue
+				}
+				if err := outputResolvedTs(resolvedTs, 0); err != nil {
+					return errors.Trace(err)
+				}
+				continue
 			case e = <-eventCh:
 			}
 
@@ -249,12 +292,13 @@ func (p *pullerImpl) Run(ctx context.Context) error {
 				if !initialized || resolvedTs == lastResolvedTs {
 					continue
 				}
-				lastResolvedTs = resolvedTs
-				err := output(&model.RawKVEntry{CRTs: resolvedTs, OpType: model.OpTypeResolved, RegionID: e.RegionID})
-				if err != nil {
+				// The resolved ts is sent by resolvedTicker if the interval is set.
+				if p.resolvedTsInterval > 0 {
+					continue
+				}
+				if err := outputResolvedTs(resolvedTs, e.RegionID); err != nil {
 					return errors.Trace(err)
 				}
-				atomic.StoreUint64(&p.resolvedTs, resolvedTs)
 			}
 		}
 	})
@@ -266,11 +310,14 @@ func (p *pullerImpl) Output() <-chan *model.RawKVEntry {
 }
 
 func (p *pullerImpl) Stats() Stats {
+	throttled, reason := p.kvCli.Throttle()
 	return Stats{
 		RegionCount:         p.kvCli.RegionCount(),
 		ResolvedTsIngress:   p.kvCli.ResolvedTs(),
 		CheckpointTsIngress: p.kvCli.CommitTs(),
 		ResolvedTsEgress:    atomic.LoadUint64(&p.resolvedTs),
 		CheckpointTsEgress:  atomic.LoadUint64(&p.checkpointTs),
+		Throttled:           throttled,
+		ThrottleReason:      reason,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	tidbkv "github.com/pingcap/tidb/kv"
@@ -115,6 +116,7 @@ func newPullerForTest(
 	t *testing.T,
 	spans []tablepb.Span,
 	checkpointTs uint64,
+	resolvedTsInterval time.Duration,
 ) (*mockInjectedPuller, context.CancelFunc, *sync.WaitGroup, tidbkv.Storage) {
 	var wg sync.WaitGroup
 	ctx, cancel := context.WithCancel(context.Background())
@@ -133,7 +135,7 @@ func newPullerForTest(
 	plr := New(
 		ctx, pdCli, grpcPool, regionCache, store, pdutil.NewClock4Test(),
 		checkpointTs, spans, config.GetDefaultServerConfig().KVClient,
-		model.DefaultChangeFeedID("changefeed-id-test"), 0, "table-test", false)
+		model.DefaultChangeFeedID("changefeed-id-test"), 0, "table-test", false, resolvedTsInterval)
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -158,7 +160,7 @@ func TestPullerResolvedForward(t *testing.T) {
 		},
 	}
 	checkpointTs := uint64(996)
-	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs)
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, 0)
 
 	plr.cli.Returns(model.RegionFeedEvent{
 		Resolved: &model.ResolvedSpans{
@@ -199,6 +201,51 @@ func TestPullerResolvedForward(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPullerResolvedTsInterval(t *testing.T) {
+	spans := []tablepb.Span{
+		{
+			StartKey: spanz.ToComparableKey([]byte("t_a")),
+			EndKey:   spanz.ToComparableKey([]byte("t_e")),
+		},
+	}
+	checkpointTs := uint64(996)
+	interval := 100 * time.Millisecond
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, interval)
+
+	for _, ts := range []uint64{1000, 1001, 1002} {
+		plr.cli.Returns(model.RegionFeedEvent{
+			Resolved: &model.ResolvedSpans{
+				Spans: []model.RegionComparableSpan{{
+					Span: spanz.ToSpan([]byte("t_a"), []byte("t_e")),
+				}}, ResolvedTs: ts,
+			},
+		})
+	}
+	// Resolved ts advanced within an interval are sent in one resolved event.
+	var ev *model.RawKVEntry
+	for ev == nil || ev.CRTs != uint64(1002) {
+		ev = <-plr.Output()
+		require.Equal(t, model.OpTypeResolved, ev.OpType)
+	}
+
+	start := time.Now()
+	plr.cli.Returns(model.RegionFeedEvent{
+		Resolved: &model.ResolvedSpans{
+			Spans: []model.RegionComparableSpan{{
+				Span: spanz.ToSpan([]byte("t_a"), []byte("t_e")),
+			}}, ResolvedTs: uint64(1003),
+		},
+	})
+	ev = <-plr.Output()
+	require.Equal(t, model.OpTypeResolved, ev.OpType)
+	require.Equal(t, uint64(1003), ev.CRTs)
+	require.Less(t, time.Since(start), 10*interval)
+
+	store.Close()
+	cancel()
+	wg.Wait()
+}
+
 func TestPullerRawKV(t *testing.T) {
 	spans := []tablepb.Span{
 		{
@@ -207,7 +254,7 @@ func TestPullerRawKV(t *testing.T) {
 		},
 	}
 	checkpointTs := uint64(996)
-	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs)
+	plr, cancel, wg, store := newPullerForTest(t, spans, checkpointTs, 0)
 
 	plr.cli.Returns(model.RegionFeedEvent{
 		Val: &model.RawKVEntry{
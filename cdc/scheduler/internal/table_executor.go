@@ -15,6 +15,7 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -59,4 +60,237 @@ type TableExecutor interface {
 
 	// GetTableSpanStatus return the checkpoint and resolved ts for the given table span.
 	GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus
+
+	// GetTableSpanOldestUnflushedAge returns the age of the oldest unflushed event of the table span.
+	GetTableSpanOldestUnflushedAge(span tablepb.Span) time.Duration
+
+	// IsTableSpanQuickRemovable returns true if the table span has no pending events to flush.
+	IsTableSpanQuickRemovable(span tablepb.Span) bool
+
+	// GetTableSpanTsSkew returns the min and max commit ts of the buffered events of the table span.
+	GetTableSpanTsSkew(span tablepb.Span) (minTs, maxTs model.Ts)
+
+	// SetTableSpanAlertThresholds sets the alerting thresholds of the table span.
+	SetTableSpanAlertThresholds(span tablepb.Span, thresholds AlertThresholds)
+
+	// GetTableSpanConflictStats returns the downstream conflict statistics of the table span.
+	GetTableSpanConflictStats(span tablepb.Span) ConflictStats
+
+	// ResetTableSpanStats zeroes the resettable counters of the table span, i.e. ConflictStats.
+	ResetTableSpanStats(span tablepb.Span)
+
+	// GetTableSpanOrderingViolations returns the number of out-of-order events emitted to the sink.
+	GetTableSpanOrderingViolations(span tablepb.Span) int
+
+	// GetTableSpanCheckpointRollbacks returns the number of checkpoint regressions of the table span.
+	GetTableSpanCheckpointRollbacks(span tablepb.Span) int
+
+	// GetTableSpanWriteAmplification returns the downstream writes per upstream event.
+	GetTableSpanWriteAmplification(span tablepb.Span) float64
+
+	// GetTableSpanUpstreamThrottle returns whether the table span is held back by upstream limits.
+	GetTableSpanUpstreamThrottle(span tablepb.Span) (throttled bool, reason string)
+
+	// TableSpanSinkCapabilities returns the capabilities of the sink of the table span.
+	TableSpanSinkCapabilities(span tablepb.Span) SinkCapabilities
+
+	// ReplayTableSpanFrom re-scans the table span from `fromTs` and re-emits its events to the sink.
+	ReplayTableSpanFrom(span tablepb.Span, fromTs model.Ts) error
+
+	// GetTableSpanTxnGroup returns the table spans whose downstream commits are held together.
+	GetTableSpanTxnGroup(span tablepb.Span) (groupID string, members []tablepb.Span)
+
+	// GetTwoPhaseStageCounts returns the number of table spans in each two-phase scheduling stage.
+	GetTwoPhaseStageCounts() map[string]int
+
+	// SetTableSpanCheckpointInterval sets the interval of persisting the checkpoint of the table span.
+	SetTableSpanCheckpointInterval(span tablepb.Span, interval time.Duration)
+
+	// SetTableSpanConcurrency sets the processing concurrency of the table span at runtime.
+	SetTableSpanConcurrency(span tablepb.Span, n int)
+
+	// GetOpenTableLimit returns the max number of table spans that can be opened, 0 means no limit.
+	GetOpenTableLimit() int
+	// SetOpenTableLimit sets the max number of table spans that can be opened.
+	SetOpenTableLimit(n int)
+
+	// SetFenced stops or resumes emitting events of all the table spans to the downstream.
+	SetFenced(fenced bool) error
+	// SetTableSpanEpoch sets the epoch of the table span ownership on the capture.
+	SetTableSpanEpoch(span tablepb.Span, epoch uint64)
+	// FenceTableSpan drops the events of the table span if `epoch` is greater than its own.
+	FenceTableSpan(span tablepb.Span, epoch uint64)
+
+	// SetGlobalCheckpoint sets the global checkpoint ts of the changefeed.
+	SetGlobalCheckpoint(checkpointTs model.Ts)
+	// GetTableSpanRelativePosition returns the table span's lead over the global checkpoint.
+	GetTableSpanRelativePosition(span tablepb.Span) time.Duration
+
+	// GetTableSpanQuotaUsage returns the memory quota used by the table span and the quota limit.
+	GetTableSpanQuotaUsage(span tablepb.Span) (used, limit uint64)
+
+	// GetTableSpanSinkQueueTrend returns the trend of the sink queue depth of the table span.
+	GetTableSpanSinkQueueTrend(span tablepb.Span) Trend
+
+	// GetTableSpanTimeBreakdown returns the time spent by each pipeline stage on the table span.
+	GetTableSpanTimeBreakdown(span tablepb.Span) map[string]time.Duration
+
+	// GetTableSpanFilterRules returns the filter rules applied to the DML events of the table span.
+	GetTableSpanFilterRules(span tablepb.Span) []FilterRule
+
+	// GetSpansAtRiskForSafepoint returns the table spans with a checkpoint below `proposedSafepoint`.
+	GetSpansAtRiskForSafepoint(proposedSafepoint model.Ts) []tablepb.Span
+
+	// GetNeverAdvancedSpans returns the table spans added over `minAge` ago which never advance.
+	GetNeverAdvancedSpans(minAge time.Duration) []tablepb.Span
+}
+
+// The stages of the two-phase scheduling protocol.
+const (
+	// TwoPhaseStagePrepare means the table span is being prepared or has been
+	// prepared, and it's waiting for the commit of adding.
+	TwoPhaseStagePrepare = "prepare"
+	// TwoPhaseStageCommittedAdd means adding the table span has been committed,
+	// i.e. it's replicating.
+	TwoPhaseStageCommittedAdd = "committed-add"
+	// TwoPhaseStagePrepareRemove means the table span is being stopped.
+	TwoPhaseStagePrepareRemove = "prepare-remove"
+	// TwoPhaseStageCommittedRemove means the table span has been stopped, and
+	// it's waiting for the removal to be confirmed.
+	TwoPhaseStageCommittedRemove = "committed-remove"
+)
+
+// The stages of the pipeline of a table span.
+const (
+	// PipelineStageFetch is handing the events pulled from the upstream to
+	// the sorter.
+	PipelineStageFetch = "fetch"
+	// PipelineStageSort is reading the sorted events from the sorter.
+	PipelineStageSort = "sort"
+	// PipelineStageMount is mounting the sorted events to row changes.
+	PipelineStageMount = "mount"
+	// PipelineStageSink is writing the row changes and resolved ts to the
+	// table sink.
+	PipelineStageSink = "sink"
+)
+
+// NewTwoPhaseStageCounts returns counts with all the two-phase stages set to 0.
+func NewTwoPhaseStageCounts() map[string]int {
+	return map[string]int{
+		TwoPhaseStagePrepare:         0,
+		TwoPhaseStageCommittedAdd:    0,
+		TwoPhaseStagePrepareRemove:   0,
+		TwoPhaseStageCommittedRemove: 0,
+	}
+}
+
+// TwoPhaseStageOf returns the two-phase scheduling stage of a table span in
+// the given state. It returns false if the table span is absent.
+func TwoPhaseStageOf(state tablepb.TableState) (string, bool) {
+	switch state {
+	case tablepb.TableStatePreparing, tablepb.TableStatePrepared:
+		return TwoPhaseStagePrepare, true
+	case tablepb.TableStateReplicating:
+		return TwoPhaseStageCommittedAdd, true
+	case tablepb.TableStateStopping:
+		return TwoPhaseStagePrepareRemove, true
+	case tablepb.TableStateStopped:
+		return TwoPhaseStageCommittedRemove, true
+	default:
+		return "", false
+	}
+}
+
+// AlertThresholds are the alerting thresholds of a table span.
+// A zero field means the threshold is not set.
+type AlertThresholds struct {
+	// Lag is the max lag of the checkpoint ts of the table span.
+	Lag time.Duration
+	// Backlog is the max age of the oldest event of the table span which is
+	// received but not flushed by the sink.
+	Backlog time.Duration
+	// SinkLatency is the max lag of the checkpoint ts behind the resolved ts
+	// of the table span, i.e. the time spent by the sink to flush events.
+	SinkLatency time.Duration
+}
+
+// ConflictStats are the statistics of the conflicts detected when applying
+// the events of a table span to the downstream.
+type ConflictStats struct {
+	// Detected is the number of rows which conflict with the downstream,
+	// i.e. the rows to update or delete are not found in the downstream.
+	Detected uint64
+	// Overwritten is the number of conflicted rows which are overwritten
+	// by the upstream ones.
+	Overwritten uint64
+	// Skipped is the number of conflicted rows which are skipped.
+	Skipped uint64
+}
+
+// FilterRule is a filter rule applied to the DML events of a table span.
+type FilterRule struct {
+	// Type is the name of the config item of the rule, e.g. "ignore-event"
+	// and "ignore-insert-value-expr".
+	Type string
+	// Matcher is the matcher of the event filter which the rule comes from,
+	// it's empty if the rule is applied to all table spans.
+	Matcher []string
+	// Condition is the resolved condition of the rule, i.e. the ignored start
+	// ts, the ignored event types, or the expression.
+	Condition string
+}
+
+// SinkCapabilities are the capabilities of the sink of a table span.
+type SinkCapabilities struct {
+	// Transactional is true if the rows of an upstream transaction are
+	// written to the downstream atomically.
+	Transactional bool
+	// ExactlyOnce is true if the rows replayed after a restart or a table
+	// span movement don't introduce duplicates in the downstream.
+	ExactlyOnce bool
+	// Syncpoint is true if syncpoints can be recorded in the downstream.
+	Syncpoint bool
+}
+
+// Merge returns the thresholds whose unset fields are taken from `global`.
+func (t AlertThresholds) Merge(global AlertThresholds) AlertThresholds {
+	if t.Lag == 0 {
+		t.Lag = global.Lag
+	}
+	if t.Backlog == 0 {
+		t.Backlog = global.Backlog
+	}
+	if t.SinkLatency == 0 {
+		t.SinkLatency = global.SinkLatency
+	}
+	return t
+}
+
+// Trend is the trend of a metric computed from its recent samples.
+type Trend int
+
+// The trends of a metric.
+const (
+	// TrendUnknown means there are not enough samples.
+	TrendUnknown Trend = iota
+	// TrendGrowing means the metric increases.
+	TrendGrowing
+	// TrendStable means the metric changes within the tolerance.
+	TrendStable
+	// TrendDraining means the metric decreases.
+	TrendDraining
+)
+
+// String implements fmt.Stringer.
+func (t Trend) String() string {
+	switch t {
+	case TrendGrowing:
+		return "growing"
+	case TrendStable:
+		return "stable"
+	case TrendDraining:
+		return "draining"
+	default:
+		return "unknown"
+	}
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -51,6 +52,21 @@ type agent struct {
 	// 1. The capture receives a SIGTERM signal.
 	// 2. The agent receives a stopping heartbeat.
 	liveness *model.Liveness
+
+	// The agent fences the table executor, i.e. stops emitting events, if
+	// it doesn't receive heartbeats from the owner within its lease, which
+	// is fenceTimeout minus a safety margin, see fenceLease. The owner only
+	// moves table spans of a removed capture after fenceTimeout since the
+	// last heartbeat response, so that table spans are never written by two
+	// captures at the same time.
+	// Once the lease expires, it's renewed by two heartbeats in a row, see
+	// renewLease, and rejoinTime is the time of the first one.
+	// Fencing is disabled if fenceTimeout is 0.
+	fenceTimeout      time.Duration
+	lastHeartbeatTime time.Time
+	rejoinTime        time.Time
+	fenced            bool
+	clock             clock.Clock
 }
 
 type agentInfo struct {
@@ -91,11 +107,14 @@ func newAgent(
 	cfg *config.SchedulerConfig,
 ) (internal.Agent, error) {
 	result := &agent{
-		agentInfo: newAgentInfo(changeFeedID, captureID),
-		tableM:    newTableSpanManager(changeFeedID, tableExecutor),
-		liveness:  liveness,
-		compat:    compat.New(cfg, map[model.CaptureID]*model.CaptureInfo{}),
+		agentInfo:    newAgentInfo(changeFeedID, captureID),
+		tableM:       newTableSpanManager(changeFeedID, tableExecutor),
+		liveness:     liveness,
+		compat:       compat.New(cfg, map[model.CaptureID]*model.CaptureInfo{}),
+		fenceTimeout: time.Duration(cfg.FenceTimeout),
+		clock:        clock.New(),
 	}
+	result.lastHeartbeatTime = result.clock.Now()
 
 	etcdCliCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -202,6 +221,10 @@ func (a *agent) Tick(ctx context.Context) error {
 
 	outboundMessages := a.handleMessage(inboundMessages)
 
+	if err := a.checkFence(); err != nil {
+		return errors.Trace(err)
+	}
+
 	responses, err := a.tableM.poll(ctx)
 	if err != nil {
 		return errors.Trace(err)
@@ -228,6 +251,73 @@ func (a *agent) handleLivenessUpdate(liveness model.Liveness) {
 	}
 }
 
+// checkFence fences the table executor if the lease of the agent expires,
+// and unfences it once the lease is renewed by a heartbeat.
+func (a *agent) checkFence() error {
+	if a.fenceTimeout == 0 {
+		return nil
+	}
+	expired := a.clock.Since(a.lastHeartbeatTime) >= fenceLease(a.fenceTimeout)
+	if expired == a.fenced {
+		return nil
+	}
+	if err := a.tableM.executor.SetFenced(expired); err != nil {
+		return errors.Trace(err)
+	}
+	if expired {
+		log.Warn("schedulerv3: agent fences itself, "+
+			"since no heartbeat is received from the owner within the lease",
+			zap.String("capture", a.CaptureID),
+			zap.String("namespace", a.ChangeFeedID.Namespace),
+			zap.String("changefeed", a.ChangeFeedID.ID),
+			zap.String("epoch", a.Epoch.Epoch),
+			zap.Time("lastHeartbeatTime", a.lastHeartbeatTime),
+			zap.Duration("fenceTimeout", a.fenceTimeout),
+			zap.Duration("lease", fenceLease(a.fenceTimeout)))
+	} else {
+		log.Info("schedulerv3: agent unfences itself, since the lease is renewed",
+			zap.String("capture", a.CaptureID),
+			zap.String("namespace", a.ChangeFeedID.Namespace),
+			zap.String("changefeed", a.ChangeFeedID.ID),
+			zap.String("epoch", a.Epoch.Epoch))
+	}
+	a.fenced = expired
+	return nil
+}
+
+// renewLease renews the lease of the agent on receiving a heartbeat.
+// An expired lease is renewed by the next heartbeat instead, because table
+// spans of the agent may have been moved to other captures in the meantime.
+// The owner asks the agent to remove such table spans after it handles the
+// response of the first heartbeat, and the requests arrive before the next
+// heartbeat, so the agent never unfences a table span owned by others.
+func (a *agent) renewLease() {
+	now := a.clock.Now()
+	if a.fenced {
+		if a.rejoinTime.IsZero() ||
+			now.Sub(a.rejoinTime) >= fenceLease(a.fenceTimeout) {
+			a.rejoinTime = now
+			return
+		}
+	}
+	a.rejoinTime = time.Time{}
+	a.lastHeartbeatTime = now
+}
+
+// fenceLeaseSafetyMarginRatio is the ratio of fenceTimeout reserved as the
+// safety margin of the agent's lease.
+const fenceLeaseSafetyMarginRatio = 4
+
+// fenceLease returns the lease of the agent for the given fenceTimeout.
+// The agent must give up its lease strictly before the owner moves its table
+// spans, so the lease is shorter than fenceTimeout by a safety margin, which
+// covers the delay of detecting the expiration in Tick and canceling the sink
+// tasks in flight, and the clock drift between the agent and the owner.
+// The margin is at least 250ms since fenceTimeout is at least 1s.
+func fenceLease(fenceTimeout time.Duration) time.Duration {
+	return fenceTimeout - fenceTimeout/fenceLeaseSafetyMarginRatio
+}
+
 func (a *agent) handleMessage(msg []*schedulepb.Message) []*schedulepb.Message {
 	result := make([]*schedulepb.Message, 0)
 	for _, message := range msg {
@@ -243,6 +333,11 @@ func (a *agent) handleMessage(msg []*schedulepb.Message) []*schedulepb.Message {
 
 		switch message.GetMsgType() {
 		case schedulepb.MsgHeartbeat:
+			if a.fenceTimeout != 0 {
+				// Renew the lease before responding, the owner renews its
+				// view of the lease when it receives the response.
+				a.renewLease()
+			}
 			response := a.handleMessageHeartbeat(message.GetHeartbeat())
 			result = append(result, response)
 		case schedulepb.MsgDispatchTableRequest:
@@ -279,6 +374,9 @@ func (a *agent) handleMessageHeartbeat(request *schedulepb.Heartbeat) *schedulep
 	if request.IsStopping {
 		a.handleLivenessUpdate(model.LivenessCaptureStopping)
 	}
+	if request.CheckpointTs != 0 {
+		a.tableM.executor.SetGlobalCheckpoint(request.CheckpointTs)
+	}
 	response := &schedulepb.HeartbeatResponse{
 		Tables:   result,
 		Liveness: a.liveness.Load(),
@@ -347,6 +445,19 @@ func (a *agent) handleMessageDispatchTableRequest(
 			status:    dispatchTableTaskReceived,
 		}
 		table = a.tableM.addTableSpan(span)
+		spanEpoch := req.AddTable.GetEpoch()
+		if spanEpoch < table.epoch {
+			log.Info("schedulerv3: agent ignore add table request, "+
+				"since the epoch of the table span is stale",
+				zap.String("capture", a.CaptureID),
+				zap.String("namespace", a.ChangeFeedID.Namespace),
+				zap.String("changefeed", a.ChangeFeedID.ID),
+				zap.Stringer("span", &span),
+				zap.Uint64("spanEpoch", spanEpoch),
+				zap.Uint64("expected", table.epoch))
+			return
+		}
+		table.epoch = spanEpoch
 	case *schedulepb.DispatchTableRequest_RemoveTable:
 		span := req.RemoveTable.GetSpan()
 		table, ok = a.tableM.getTableSpan(span)
@@ -360,6 +471,12 @@ func (a *agent) handleMessageDispatchTableRequest(
 				zap.Any("request", request))
 			return
 		}
+		// The table span may be owned by another capture with a greater
+		// epoch, e.g. it's moved while the capture is partitioned, so the
+		// events of the table span in flight must be dropped.
+		if spanEpoch := req.RemoveTable.GetEpoch(); spanEpoch != 0 {
+			a.tableM.executor.FenceTableSpan(span, spanEpoch)
+		}
 		task = &dispatchTableTask{
 			Span:     span,
 			IsRemove: true,
@@ -17,7 +17,9 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -299,6 +301,45 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.False(t, a.tableM.tables.Has(spanz.TableIDToComparableSpan(1)))
 }
 
+func TestAgentHandleSpanEpoch(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	processorEpoch := schedulepb.ProcessorEpoch{Epoch: "agent-epoch-1"}
+	span := spanz.TableIDToComparableSpan(1)
+	addTableRequest := func(epoch uint64) *schedulepb.DispatchTableRequest {
+		return &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_AddTable{
+				AddTable: &schedulepb.AddTableRequest{
+					Span:        span,
+					IsSecondary: true,
+					Epoch:       epoch,
+				},
+			},
+		}
+	}
+
+	a.handleMessageDispatchTableRequest(addTableRequest(2), processorEpoch)
+	table, ok := a.tableM.getTableSpan(span)
+	require.True(t, ok)
+	require.NotNil(t, table.task)
+	require.EqualValues(t, 2, table.epoch)
+	// The epoch is reported in the table status.
+	require.EqualValues(t, 2, table.getTableSpanStatus().Epoch)
+
+	// The request with a stale epoch is ignored.
+	table.task = nil
+	a.handleMessageDispatchTableRequest(addTableRequest(1), processorEpoch)
+	require.Nil(t, table.task)
+	require.EqualValues(t, 2, table.epoch)
+
+	a.handleMessageDispatchTableRequest(addTableRequest(3), processorEpoch)
+	require.NotNil(t, table.task)
+	require.EqualValues(t, 3, table.epoch)
+}
+
 func TestAgentHandleMessageHeartbeat(t *testing.T) {
 	t.Parallel()
 
@@ -377,6 +418,12 @@ func TestAgentHandleMessageHeartbeat(t *testing.T) {
 	})
 	require.Equal(t, tablepb.TableStateStopping, result[1].State)
 
+	// the global checkpoint is passed to the executor.
+	require.Zero(t, mockTableExecutor.globalCheckpointTs)
+	heartbeat.Heartbeat.CheckpointTs = 42
+	a.handleMessage([]*schedulepb.Message{heartbeat})
+	require.Equal(t, model.Ts(42), mockTableExecutor.globalCheckpointTs)
+
 	a.handleLivenessUpdate(model.LivenessCaptureStopping)
 	response = a.handleMessage([]*schedulepb.Message{heartbeat})
 	require.Len(t, response, 1)
@@ -769,6 +816,217 @@ func TestAgentHandleLivenessUpdate(t *testing.T) {
 	require.Equal(t, model.LivenessCaptureStopping, a.liveness.Load())
 }
 
+func TestAgentFenceWithoutHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	a := newAgent4Test()
+	mockTableExecutor := newMockTableExecutor()
+	a.tableM = newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+	trans := transport.NewMockTrans()
+	a.trans = trans
+	mockClock := clock.NewMock()
+	a.clock = mockClock
+	a.fenceTimeout = 10 * time.Second
+	a.lastHeartbeatTime = mockClock.Now()
+
+	heartbeat := func(revision int64) *schedulepb.Message {
+		return &schedulepb.Message{
+			Header: &schedulepb.Message_Header{
+				Version:        "owner-version-1",
+				OwnerRevision:  schedulepb.OwnerRevision{Revision: revision},
+				ProcessorEpoch: schedulepb.ProcessorEpoch{Epoch: "agent-epoch-1"},
+			},
+			To:        "agent-1",
+			From:      "owner-1",
+			MsgType:   schedulepb.MsgHeartbeat,
+			Heartbeat: &schedulepb.Heartbeat{},
+		}
+	}
+
+	// The lease is renewed by the heartbeat.
+	mockClock.Add(5 * time.Second)
+	trans.RecvBuffer = []*schedulepb.Message{heartbeat(1)}
+	require.Nil(t, a.Tick(context.Background()))
+	require.Len(t, trans.SendBuffer, 1)
+	require.False(t, mockTableExecutor.fenced)
+
+	// The owner is partitioned away, the lease is not expired yet.
+	mockClock.Add(7 * time.Second)
+	require.Nil(t, a.Tick(context.Background()))
+	require.False(t, mockTableExecutor.fenced)
+
+	// The lease expires strictly before the owner's, i.e. 10s, the agent
+	// fences itself.
+	mockClock.Add(500 * time.Millisecond)
+	require.Nil(t, a.Tick(context.Background()))
+	require.True(t, a.fenced)
+	require.True(t, mockTableExecutor.fenced)
+
+	// Heartbeats from a staled owner can't renew the lease.
+	trans.RecvBuffer = []*schedulepb.Message{heartbeat(0)}
+	require.Nil(t, a.Tick(context.Background()))
+	require.True(t, mockTableExecutor.fenced)
+
+	// The owner is back, the agent keeps fenced until the next heartbeat,
+	// since its table spans may have been moved to other captures.
+	trans.RecvBuffer = []*schedulepb.Message{heartbeat(1)}
+	require.Nil(t, a.Tick(context.Background()))
+	require.True(t, a.fenced)
+	require.True(t, mockTableExecutor.fenced)
+
+	// The agent unfences itself on the next heartbeat.
+	mockClock.Add(time.Second)
+	trans.RecvBuffer = []*schedulepb.Message{heartbeat(1)}
+	require.Nil(t, a.Tick(context.Background()))
+	require.False(t, a.fenced)
+	require.False(t, mockTableExecutor.fenced)
+}
+
+func TestAgentPartitionNeverDoubleWrite(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	span := spanz.TableIDToComparableSpan(1)
+	mockClock := clock.NewMock()
+	fenceTimeout := 10 * time.Second
+
+	type capture struct {
+		a     *agent
+		e     *MockTableExecutor
+		trans *transport.MockTrans
+	}
+	newCapture := func(captureID string) *capture {
+		a := newAgent4Test()
+		a.CaptureID = captureID
+		a.Epoch = schedulepb.ProcessorEpoch{Epoch: captureID + "-epoch"}
+		e := newMockTableExecutor()
+		e.On("AddTableSpan", mock.Anything, span, mock.Anything, mock.Anything).
+			Return(true, nil)
+		e.On("IsAddTableSpanFinished", span, mock.Anything).Return(true)
+		e.On("RemoveTableSpan", span).Return(true)
+		e.On("IsRemoveTableSpanFinished", span).Return(0, true)
+		a.tableM = newTableSpanManager(model.ChangeFeedID{}, e)
+		trans := transport.NewMockTrans()
+		a.trans = trans
+		a.clock = mockClock
+		a.fenceTimeout = fenceTimeout
+		a.lastHeartbeatTime = mockClock.Now()
+		return &capture{a: a, e: e, trans: trans}
+	}
+	captureA, captureB := newCapture("agent-a"), newCapture("agent-b")
+
+	// The owner follows the rules of the coordinator, i.e. it moves the
+	// table span of a removed capture only after fenceTimeout since the last
+	// heartbeat response, and every AddTableRequest carries a new epoch.
+	newMsg := func(c *capture) *schedulepb.Message {
+		return &schedulepb.Message{
+			Header: &schedulepb.Message_Header{
+				Version:        "owner-version-1",
+				OwnerRevision:  schedulepb.OwnerRevision{Revision: 1},
+				ProcessorEpoch: c.a.Epoch,
+			},
+			To:   c.a.CaptureID,
+			From: "owner-1",
+		}
+	}
+	heartbeat := func(c *capture) *schedulepb.Message {
+		msg := newMsg(c)
+		msg.MsgType = schedulepb.MsgHeartbeat
+		msg.Heartbeat = &schedulepb.Heartbeat{}
+		return msg
+	}
+	addTable := func(c *capture, epoch uint64, isSecondary bool) *schedulepb.Message {
+		msg := newMsg(c)
+		msg.MsgType = schedulepb.MsgDispatchTableRequest
+		msg.DispatchTableRequest = &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_AddTable{
+				AddTable: &schedulepb.AddTableRequest{
+					Span:        span,
+					IsSecondary: isSecondary,
+					Epoch:       epoch,
+				},
+			},
+		}
+		return msg
+	}
+	removeTable := func(c *capture, epoch uint64) *schedulepb.Message {
+		msg := newMsg(c)
+		msg.MsgType = schedulepb.MsgDispatchTableRequest
+		msg.DispatchTableRequest = &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableRequest{
+					Span:  span,
+					Epoch: epoch,
+				},
+			},
+		}
+		return msg
+	}
+
+	// tick ticks the agent of the capture, and checks that the table span is
+	// never written by two captures at the same time.
+	tick := func(c *capture, msgs ...*schedulepb.Message) []*schedulepb.Message {
+		c.trans.RecvBuffer = append(c.trans.RecvBuffer, msgs...)
+		require.Nil(t, c.a.Tick(ctx))
+		sent := c.trans.SendBuffer
+		c.trans.SendBuffer = nil
+
+		writers := 0
+		for _, c := range []*capture{captureA, captureB} {
+			if c.e.isWriting(span) {
+				writers++
+			}
+		}
+		require.LessOrEqual(t, writers, 1)
+		return sent
+	}
+
+	// The table span is replicated by capture A.
+	tick(captureA, heartbeat(captureA), addTable(captureA, 1, false))
+	tick(captureB, heartbeat(captureB))
+	require.True(t, captureA.e.isWriting(span))
+
+	// Capture A is partitioned away. The owner moves the table span to
+	// capture B after fenceTimeout.
+	step := 500 * time.Millisecond
+	for elapsed := step; elapsed <= fenceTimeout; elapsed += step {
+		mockClock.Add(step)
+		tick(captureA)
+		tick(captureB, heartbeat(captureB))
+		if elapsed >= fenceLease(fenceTimeout) {
+			require.True(t, captureA.a.fenced)
+		}
+	}
+	tick(captureB, addTable(captureB, 2, true))
+	tick(captureB, addTable(captureB, 3, false))
+	require.True(t, captureB.e.isWriting(span))
+	require.False(t, captureA.e.isWriting(span))
+
+	// The partition heals. Capture A reports the stale table span, and keeps
+	// fenced until the next heartbeat.
+	mockClock.Add(step)
+	sent := tick(captureA, heartbeat(captureA))
+	require.Len(t, sent, 1)
+	require.Equal(t, schedulepb.MsgHeartbeatResponse, sent[0].MsgType)
+	tables := sent[0].GetHeartbeatResponse().GetTables()
+	require.Len(t, tables, 1)
+	require.Equal(t, tablepb.TableStateReplicating, tables[0].State)
+	require.EqualValues(t, 1, tables[0].Epoch)
+	require.True(t, captureA.a.fenced)
+
+	// The owner asks capture A to remove the stale table span with the latest
+	// epoch before the next heartbeat.
+	tick(captureA, removeTable(captureA, 3), heartbeat(captureA))
+	require.False(t, captureA.a.fenced)
+	for i := 0; i < 3; i++ {
+		mockClock.Add(step)
+		tick(captureA, heartbeat(captureA))
+		tick(captureB, heartbeat(captureB))
+	}
+	require.Equal(t, tablepb.TableStateAbsent, captureA.e.GetTableSpanStatus(span).State)
+	require.True(t, captureB.e.isWriting(span))
+}
+
 func TestAgentCommitAddTableDuringStopping(t *testing.T) {
 	t.Parallel()
 
@@ -959,6 +1217,14 @@ type MockTableExecutor struct {
 
 	// it's preferred to use `pipeline.MockPipeline` here to make the test more vivid.
 	tables *spanz.Map[tablepb.TableState]
+
+	openTableLimit     int
+	fenced             bool
+	globalCheckpointTs model.Ts
+	// epochs and fencedEpochs are the epochs of table span ownership set by
+	// SetTableSpanEpoch and FenceTableSpan respectively.
+	epochs       *spanz.Map[uint64]
+	fencedEpochs *spanz.Map[uint64]
 }
 
 var _ internal.TableExecutor = (*MockTableExecutor)(nil)
@@ -966,7 +1232,9 @@ var _ internal.TableExecutor = (*MockTableExecutor)(nil)
 // newMockTableExecutor creates a new mock table executor.
 func newMockTableExecutor() *MockTableExecutor {
 	return &MockTableExecutor{
-		tables: spanz.NewMap[tablepb.TableState](),
+		tables:       spanz.NewMap[tablepb.TableState](),
+		epochs:       spanz.NewMap[uint64](),
+		fencedEpochs: spanz.NewMap[uint64](),
 	}
 }
 
@@ -1101,3 +1369,173 @@ func (e *MockTableExecutor) GetTableSpanStatus(span tablepb.Span) tablepb.TableS
 		State: state,
 	}
 }
+
+// GetTableSpanOldestUnflushedAge implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanOldestUnflushedAge(span tablepb.Span) time.Duration {
+	return 0
+}
+
+// IsTableSpanQuickRemovable implements TableExecutor interface
+func (e *MockTableExecutor) IsTableSpanQuickRemovable(span tablepb.Span) bool {
+	return true
+}
+
+// GetTableSpanTsSkew implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanTsSkew(span tablepb.Span) (model.Ts, model.Ts) {
+	return 0, 0
+}
+
+// SetTableSpanAlertThresholds implements TableExecutor interface
+func (e *MockTableExecutor) SetTableSpanAlertThresholds(
+	span tablepb.Span, thresholds internal.AlertThresholds,
+) {
+}
+
+// GetTableSpanConflictStats implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanConflictStats(
+	span tablepb.Span,
+) internal.ConflictStats {
+	return internal.ConflictStats{}
+}
+
+// ResetTableSpanStats implements TableExecutor interface
+func (e *MockTableExecutor) ResetTableSpanStats(span tablepb.Span) {}
+
+// GetTableSpanOrderingViolations implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanOrderingViolations(span tablepb.Span) int {
+	return 0
+}
+
+// GetTableSpanCheckpointRollbacks implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanCheckpointRollbacks(span tablepb.Span) int {
+	return 0
+}
+
+// GetTableSpanWriteAmplification implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanWriteAmplification(span tablepb.Span) float64 {
+	return 1.0
+}
+
+// GetTableSpanUpstreamThrottle implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanUpstreamThrottle(span tablepb.Span) (bool, string) {
+	return false, ""
+}
+
+// TableSpanSinkCapabilities implements TableExecutor interface
+func (e *MockTableExecutor) TableSpanSinkCapabilities(
+	span tablepb.Span,
+) internal.SinkCapabilities {
+	return internal.SinkCapabilities{}
+}
+
+// ReplayTableSpanFrom implements TableExecutor interface
+func (e *MockTableExecutor) ReplayTableSpanFrom(
+	span tablepb.Span, fromTs model.Ts,
+) error {
+	return nil
+}
+
+// GetTableSpanTxnGroup implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanTxnGroup(
+	span tablepb.Span,
+) (string, []tablepb.Span) {
+	return "", nil
+}
+
+// GetTwoPhaseStageCounts implements TableExecutor interface
+func (e *MockTableExecutor) GetTwoPhaseStageCounts() map[string]int {
+	counts := internal.NewTwoPhaseStageCounts()
+	e.tables.Ascend(func(span tablepb.Span, state tablepb.TableState) bool {
+		if stage, ok := internal.TwoPhaseStageOf(state); ok {
+			counts[stage]++
+		}
+		return true
+	})
+	return counts
+}
+
+// SetTableSpanCheckpointInterval implements TableExecutor interface
+func (e *MockTableExecutor) SetTableSpanCheckpointInterval(
+	span tablepb.Span, interval time.Duration,
+) {
+}
+
+// SetTableSpanConcurrency implements TableExecutor interface
+func (e *MockTableExecutor) SetTableSpanConcurrency(span tablepb.Span, n int) {
+}
+
+// GetTableSpanSinkQueueTrend implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanSinkQueueTrend(span tablepb.Span) internal.Trend {
+	return internal.TrendUnknown
+}
+
+// GetTableSpanTimeBreakdown implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanTimeBreakdown(span tablepb.Span) map[string]time.Duration {
+	return map[string]time.Duration{}
+}
+
+// GetTableSpanFilterRules implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanFilterRules(span tablepb.Span) []internal.FilterRule {
+	return []internal.FilterRule{}
+}
+
+// GetNeverAdvancedSpans implements TableExecutor interface
+func (e *MockTableExecutor) GetNeverAdvancedSpans(minAge time.Duration) []tablepb.Span {
+	return nil
+}
+
+// GetOpenTableLimit implements TableExecutor interface
+func (e *MockTableExecutor) GetOpenTableLimit() int {
+	return e.openTableLimit
+}
+
+// SetOpenTableLimit implements TableExecutor interface
+func (e *MockTableExecutor) SetOpenTableLimit(n int) {
+	e.openTableLimit = n
+}
+
+// SetFenced implements TableExecutor interface
+func (e *MockTableExecutor) SetFenced(fenced bool) error {
+	e.fenced = fenced
+	return nil
+}
+
+// SetTableSpanEpoch implements TableExecutor interface
+func (e *MockTableExecutor) SetTableSpanEpoch(span tablepb.Span, epoch uint64) {
+	e.epochs.ReplaceOrInsert(span, epoch)
+}
+
+// FenceTableSpan implements TableExecutor interface
+func (e *MockTableExecutor) FenceTableSpan(span tablepb.Span, epoch uint64) {
+	if fenced, _ := e.fencedEpochs.Get(span); epoch > fenced {
+		e.fencedEpochs.ReplaceOrInsert(span, epoch)
+	}
+}
+
+// isWriting returns true if the executor emits events of the table span.
+func (e *MockTableExecutor) isWriting(span tablepb.Span) bool {
+	state, _ := e.tables.Get(span)
+	epoch, _ := e.epochs.Get(span)
+	fenced, _ := e.fencedEpochs.Get(span)
+	return state == tablepb.TableStateReplicating && !e.fenced && fenced <= epoch
+}
+
+// SetGlobalCheckpoint implements TableExecutor interface
+func (e *MockTableExecutor) SetGlobalCheckpoint(checkpointTs model.Ts) {
+	e.globalCheckpointTs = checkpointTs
+}
+
+// GetTableSpanRelativePosition implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanRelativePosition(span tablepb.Span) time.Duration {
+	return 0
+}
+
+// GetTableSpanQuotaUsage implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanQuotaUsage(span tablepb.Span) (uint64, uint64) {
+	return 0, 0
+}
+
+// GetSpansAtRiskForSafepoint implements TableExecutor interface
+func (e *MockTableExecutor) GetSpansAtRiskForSafepoint(proposedSafepoint model.Ts) []tablepb.Span {
+	return []tablepb.Span{}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"go.uber.org/zap"
 )
@@ -34,6 +35,9 @@ type tableSpan struct {
 
 	state    tablepb.TableState
 	executor internal.TableExecutor
+	// epoch is the epoch of the table span ownership issued by the owner in
+	// the latest AddTableRequest, see schedulepb.AddTableRequest.
+	epoch uint64
 
 	task *dispatchTableTask
 }
@@ -70,7 +74,9 @@ func (t *tableSpan) getAndUpdateTableSpanState() (tablepb.TableState, bool) {
 }
 
 func (t *tableSpan) getTableSpanStatus() tablepb.TableStatus {
-	return t.executor.GetTableSpanStatus(t.span)
+	status := t.executor.GetTableSpanStatus(t.span)
+	status.Epoch = t.epoch
+	return status
 }
 
 func newAddTableResponseMessage(status tablepb.TableStatus) *schedulepb.Message {
@@ -160,6 +166,19 @@ func (t *tableSpan) handleAddTableTask(
 		switch state {
 		case tablepb.TableStateAbsent:
 			done, err := t.executor.AddTableSpan(ctx, t.task.Span, t.task.StartTs, t.task.IsPrepare)
+			if cerror.ErrTooManyOpenTables.Equal(err) {
+				// reject the table explicitly, so that the coordinator
+				// schedules the table to another capture.
+				log.Warn("schedulerv3: agent reject add table, too many open tables",
+					zap.String("namespace", t.changefeedID.Namespace),
+					zap.String("changefeed", t.changefeedID.ID),
+					zap.Int64("tableID", t.span.TableID), zap.Any("task", t.task),
+					zap.Error(err))
+				t.task = nil
+				message := newAddTableResponseMessage(t.getTableSpanStatus())
+				message.DispatchTableResponse.GetAddTable().Rejected = true
+				return message, nil
+			}
 			if err != nil || !done {
 				log.Warn("schedulerv3: agent add table failed",
 					zap.String("namespace", t.changefeedID.Namespace),
@@ -169,12 +188,14 @@ func (t *tableSpan) handleAddTableTask(
 				status := t.getTableSpanStatus()
 				return newAddTableResponseMessage(status), errors.Trace(err)
 			}
+			t.executor.SetTableSpanEpoch(t.task.Span, t.epoch)
 			state, changed = t.getAndUpdateTableSpanState()
 		case tablepb.TableStateReplicating:
 			log.Info("schedulerv3: table is replicating",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.span.TableID), zap.Stringer("state", state))
+				zap.Int64("tableID", t.span.TableID), zap.Stringer("state", state),
+				zap.Uint64("epoch", t.epoch))
 			t.task = nil
 			status := t.getTableSpanStatus()
 			return newAddTableResponseMessage(status), nil
@@ -200,6 +221,7 @@ func (t *tableSpan) handleAddTableTask(
 					status := t.getTableSpanStatus()
 					return newAddTableResponseMessage(status), errors.Trace(err)
 				}
+				t.executor.SetTableSpanEpoch(t.task.Span, t.epoch)
 				t.task.status = dispatchTableTaskProcessed
 			}
 
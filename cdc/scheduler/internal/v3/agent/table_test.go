@@ -14,11 +14,14 @@
 package agent
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,3 +40,30 @@ func TestTableManager(t *testing.T) {
 	tableM.dropTableSpan(span1)
 	require.False(t, tableM.tables.Has(span1))
 }
+
+func TestTableSpanRejectTooManyOpenTables(t *testing.T) {
+	t.Parallel()
+
+	mockTableExecutor := newMockTableExecutor()
+	tableM := newTableSpanManager(model.ChangeFeedID{}, mockTableExecutor)
+
+	span := spanz.TableIDToComparableSpan(1)
+	table := tableM.addTableSpan(span)
+	table.injectDispatchTableTask(&dispatchTableTask{
+		Span:      span,
+		StartTs:   1,
+		IsPrepare: true,
+		status:    dispatchTableTaskReceived,
+	})
+	mockTableExecutor.On("AddTableSpan", mock.Anything, span, mock.Anything, true).
+		Return(false, cerror.ErrTooManyOpenTables.GenWithStackByArgs(1, 1))
+
+	msgs, err := tableM.poll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, tablepb.TableStateAbsent,
+		msgs[0].DispatchTableResponse.GetAddTable().Status.State)
+	require.True(t, msgs[0].DispatchTableResponse.GetAddTable().Rejected)
+	// the rejected table is dropped, so it can be dispatched again.
+	require.False(t, tableM.tables.Has(span))
+}
@@ -61,6 +61,7 @@ type coordinator struct {
 	reconciler   *keyspan.Reconciler
 	compat       *compat.Compat
 	pdClock      pdutil.Clock
+	spanEpochs   *spanEpochs
 
 	lastCollectTime time.Time
 	changefeedID    model.ChangeFeedID
@@ -105,10 +106,12 @@ func newCoordinator(
 		replicationM: replication.NewReplicationManager(
 			cfg.MaxTaskConcurrency, changefeedID),
 		captureM: member.NewCaptureManager(
-			captureID, changefeedID, revision, cfg.HeartbeatTick),
+			captureID, changefeedID, revision, cfg.HeartbeatTick,
+			time.Duration(cfg.FenceTimeout)),
 		schedulerM:   scheduler.NewSchedulerManager(changefeedID, cfg),
 		changefeedID: changefeedID,
 		compat:       compat.New(cfg, map[model.CaptureID]*model.CaptureInfo{}),
+		spanEpochs:   newSpanEpochs(),
 	}
 }
 
@@ -269,7 +272,8 @@ func (c *coordinator) poll(
 
 	var msgBuf []*schedulepb.Message
 	c.captureM.HandleMessage(recvMsgs)
-	msgs := c.captureM.Tick(c.replicationM.ReplicationSets(), c.schedulerM.DrainingTarget())
+	msgs := c.captureM.Tick(
+		c.replicationM.ReplicationSets(), c.schedulerM.DrainingTarget(), checkpointTs)
 	msgBuf = append(msgBuf, msgs...)
 	msgs = c.captureM.HandleAliveCaptureUpdate(aliveCaptures)
 	msgBuf = append(msgBuf, msgs...)
@@ -307,6 +311,13 @@ func (c *coordinator) poll(
 		msgBuf = append(msgBuf, msgs...)
 	}
 
+	if c.captureM.HasFencingCaptures() {
+		// Skip generating schedule tasks for replication manager, as removed
+		// captures may still emit events of their tables until they are fenced.
+		newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(currentTables, pdTime)
+		return newCheckpointTs, newResolvedTs, c.sendMsgs(ctx, msgBuf)
+	}
+
 	// Generate schedule tasks based on the current status.
 	replications := c.replicationM.ReplicationSets()
 	runningTasks := c.replicationM.RunningTasks()
@@ -347,6 +358,7 @@ func (c *coordinator) recvMsgs(ctx context.Context) ([]*schedulepb.Message, erro
 		}
 	}
 	c.compat.AfterTransportReceive(recvMsgs[:n])
+	c.spanEpochs.observe(recvMsgs[:n])
 	return recvMsgs[:n], nil
 }
 
@@ -372,6 +384,7 @@ func (c *coordinator) sendMsgs(ctx context.Context, msgs []*schedulepb.Message)
 		}
 		m.From = c.captureID
 	}
+	c.spanEpochs.issue(msgs)
 	c.compat.BeforeTransportSend(msgs)
 	return c.trans.Send(ctx, msgs)
 }
@@ -74,7 +74,8 @@ func BenchmarkCoordinatorInit(b *testing.B) {
 			replicationM: replication.NewReplicationManager(10, model.ChangeFeedID{}),
 			// Disable heartbeat.
 			captureM: member.NewCaptureManager(
-				"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, math.MaxInt),
+				"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, math.MaxInt, 0),
+			spanEpochs: newSpanEpochs(),
 		}
 		name = fmt.Sprintf("InitTable %d", total)
 		return name, coord, currentTables, captures
@@ -92,7 +93,7 @@ func BenchmarkCoordinatorHeartbeat(b *testing.B) {
 		captures = map[model.CaptureID]*model.CaptureInfo{}
 		// Always heartbeat.
 		captureM := member.NewCaptureManager(
-			"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, 0)
+			"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, 0, 0)
 		captureM.SetInitializedForTests(true)
 		for i := 0; i < captureCount; i++ {
 			captures[fmt.Sprint(i)] = &model.CaptureInfo{}
@@ -108,6 +109,7 @@ func BenchmarkCoordinatorHeartbeat(b *testing.B) {
 			trans:        transport.NewMockTrans(),
 			replicationM: replication.NewReplicationManager(10, model.ChangeFeedID{}),
 			captureM:     captureM,
+			spanEpochs:   newSpanEpochs(),
 		}
 		name = fmt.Sprintf("Heartbeat %d", total)
 		return name, coord, currentTables, captures
@@ -125,7 +127,7 @@ func BenchmarkCoordinatorHeartbeatResponse(b *testing.B) {
 		captures = map[model.CaptureID]*model.CaptureInfo{}
 		// Disable heartbeat.
 		captureM := member.NewCaptureManager(
-			"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, math.MaxInt)
+			"", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, math.MaxInt, 0)
 		captureM.SetInitializedForTests(true)
 		for i := 0; i < captureCount; i++ {
 			captures[fmt.Sprint(i)] = &model.CaptureInfo{}
@@ -180,6 +182,7 @@ func BenchmarkCoordinatorHeartbeatResponse(b *testing.B) {
 			trans:        trans,
 			replicationM: replicationM,
 			captureM:     captureM,
+			spanEpochs:   newSpanEpochs(),
 		}
 		name = fmt.Sprintf("HeartbeatResponse %d", total)
 		return name, coord, currentTables, captures
@@ -17,7 +17,9 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
@@ -46,7 +48,7 @@ func TestCoordinatorSendMsgs(t *testing.T) {
 	coord.version = "6.2.0"
 	coord.revision = schedulepb.OwnerRevision{Revision: 3}
 	coord.captureID = "0"
-	coord.captureM = member.NewCaptureManager("", model.ChangeFeedID{}, coord.revision, 0)
+	coord.captureM = member.NewCaptureManager("", model.ChangeFeedID{}, coord.revision, 0, 0)
 	coord.sendMsgs(
 		ctx, []*schedulepb.Message{{To: "1", MsgType: schedulepb.MsgDispatchTableRequest}})
 
@@ -146,6 +148,7 @@ func TestCoordinatorTransportCompat(t *testing.T) {
 				AddTable: &schedulepb.AddTableRequest{
 					TableID: 1,
 					Span:    spanz.TableIDToComparableSpan(1),
+					Epoch:   1,
 				},
 			},
 		},
@@ -197,6 +200,78 @@ func newTestCoordinator(cfg *config.SchedulerConfig) (*coordinator, *transport.M
 	return coord, trans
 }
 
+func TestCoordinatorSpanEpoch(t *testing.T) {
+	t.Parallel()
+
+	coord, trans := newTestCoordinator(&config.SchedulerConfig{
+		RegionPerSpan: 10000, // Enable span replication.
+	})
+	ctx := context.Background()
+	addTable := func(to model.CaptureID, tableID model.TableID) *schedulepb.Message {
+		return &schedulepb.Message{
+			To:      to,
+			MsgType: schedulepb.MsgDispatchTableRequest,
+			DispatchTableRequest: &schedulepb.DispatchTableRequest{
+				Request: &schedulepb.DispatchTableRequest_AddTable{
+					AddTable: &schedulepb.AddTableRequest{
+						Span: spanz.TableIDToComparableSpan(tableID),
+					},
+				},
+			},
+		}
+	}
+
+	// Every AddTableRequest of a table span carries a new epoch.
+	require.Nil(t, coord.sendMsgs(ctx, []*schedulepb.Message{addTable("b", 1), addTable("c", 2)}))
+	require.Nil(t, coord.sendMsgs(ctx, []*schedulepb.Message{addTable("c", 1)}))
+	require.Len(t, trans.SendBuffer, 3)
+	require.EqualValues(t, 1, trans.SendBuffer[0].DispatchTableRequest.GetAddTable().Epoch)
+	require.EqualValues(t, 1, trans.SendBuffer[1].DispatchTableRequest.GetAddTable().Epoch)
+	require.EqualValues(t, 2, trans.SendBuffer[2].DispatchTableRequest.GetAddTable().Epoch)
+
+	// The epochs reported by captures are learnt, e.g., the ones issued by
+	// the previous owner.
+	trans.RecvBuffer = append(trans.RecvBuffer, &schedulepb.Message{
+		Header: &schedulepb.Message_Header{
+			OwnerRevision: coord.revision,
+		},
+		From: "b", To: coord.captureID, MsgType: schedulepb.MsgHeartbeatResponse,
+		HeartbeatResponse: &schedulepb.HeartbeatResponse{
+			Tables: []tablepb.TableStatus{{
+				Span:  spanz.TableIDToComparableSpan(2),
+				State: tablepb.TableStateReplicating,
+				Epoch: 5,
+			}, {
+				Span:  spanz.TableIDToComparableSpan(1),
+				State: tablepb.TableStateReplicating,
+				Epoch: 1,
+			}},
+		},
+	})
+	_, err := coord.recvMsgs(ctx)
+	require.Nil(t, err)
+	trans.SendBuffer = nil
+	require.Nil(t, coord.sendMsgs(ctx, []*schedulepb.Message{addTable("b", 1), addTable("b", 2)}))
+	require.EqualValues(t, 3, trans.SendBuffer[0].DispatchTableRequest.GetAddTable().Epoch)
+	require.EqualValues(t, 6, trans.SendBuffer[1].DispatchTableRequest.GetAddTable().Epoch)
+
+	// RemoveTableRequest carries the latest epoch without issuing a new one.
+	trans.SendBuffer = nil
+	require.Nil(t, coord.sendMsgs(ctx, []*schedulepb.Message{{
+		To:      "c",
+		MsgType: schedulepb.MsgDispatchTableRequest,
+		DispatchTableRequest: &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableRequest{
+					Span: spanz.TableIDToComparableSpan(1),
+				},
+			},
+		},
+	}, addTable("b", 1)}))
+	require.EqualValues(t, 3, trans.SendBuffer[0].DispatchTableRequest.GetRemoveTable().Epoch)
+	require.EqualValues(t, 4, trans.SendBuffer[1].DispatchTableRequest.GetAddTable().Epoch)
+}
+
 func TestCoordinatorHeartbeat(t *testing.T) {
 	t.Parallel()
 
@@ -346,6 +421,142 @@ func TestCoordinatorRemoveCapture(t *testing.T) {
 	require.EqualValues(t, 3, msgs[0].DispatchTableRequest.GetAddTable().Span.TableID)
 }
 
+func TestCoordinatorRemoveCaptureAfterFenced(t *testing.T) {
+	t.Parallel()
+
+	coord, trans := newTestCoordinator(&config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 1,
+		AddTableBatchSize:  50,
+		FenceTimeout:       config.TomlDuration(10 * time.Second),
+	})
+	mockClock := clock.NewMock()
+	coord.captureM.SetClockForTests(mockClock)
+
+	// Two captures "a" "b", table 1 is replicated by "a" and table 2 by "b".
+	ctx := context.Background()
+	currentTables := []model.TableID{1, 2}
+	aliveCaptures := map[model.CaptureID]*model.CaptureInfo{"a": {}, "b": {}}
+	_, _, err := coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	for id, span := range map[model.CaptureID]tablepb.Span{
+		"a": spanz.TableIDToComparableSpan(1),
+		"b": spanz.TableIDToComparableSpan(2),
+	} {
+		trans.RecvBuffer = append(trans.RecvBuffer, &schedulepb.Message{
+			Header: &schedulepb.Message_Header{
+				OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+			},
+			To:      "a",
+			From:    id,
+			MsgType: schedulepb.MsgHeartbeatResponse,
+			HeartbeatResponse: &schedulepb.HeartbeatResponse{
+				Tables: []tablepb.TableStatus{
+					{Span: span, State: tablepb.TableStateReplicating},
+				},
+			},
+		})
+	}
+	_, _, err = coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	require.True(t, coord.captureM.CheckAllCaptureInitialized())
+	require.Equal(t, 2, coord.replicationM.GetReplicationSetForTests().Len())
+
+	// Capture "b" is partitioned away and removed from the cluster, it keeps
+	// emitting events of table 2 until it fences itself, i.e. until 10s after
+	// its last heartbeat. So table 2 must not be added to another capture.
+	delete(aliveCaptures, "b")
+	for i := 0; i < 10; i++ {
+		mockClock.Add(time.Second - time.Millisecond)
+		trans.SendBuffer = []*schedulepb.Message{}
+		_, _, err = coord.poll(ctx, 0, currentTables, aliveCaptures)
+		require.Nil(t, err)
+		require.Empty(t, trans.SendBuffer)
+		require.True(t, coord.captureM.HasFencingCaptures())
+	}
+
+	// The lease of "b" expires, add table 2 to capture "a".
+	mockClock.Add(10 * time.Millisecond)
+	_, _, err = coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	require.False(t, coord.captureM.HasFencingCaptures())
+	msgs := trans.SendBuffer
+	require.Len(t, msgs, 1)
+	require.Equal(t, "a", msgs[0].To)
+	require.NotNil(t, msgs[0].DispatchTableRequest.GetAddTable(), msgs[0])
+	require.EqualValues(t, 2, msgs[0].DispatchTableRequest.GetAddTable().Span.TableID)
+}
+
+func TestCoordinatorAddTableRejected(t *testing.T) {
+	t.Parallel()
+
+	coord, trans := newTestCoordinator(&config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 1,
+		AddTableBatchSize:  50,
+	})
+
+	// Two captures "a" "b" without any table.
+	ctx := context.Background()
+	currentTables := []model.TableID{1}
+	aliveCaptures := map[model.CaptureID]*model.CaptureInfo{"a": {}, "b": {}}
+	_, _, err := coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	for _, id := range []model.CaptureID{"a", "b"} {
+		trans.RecvBuffer = append(trans.RecvBuffer, &schedulepb.Message{
+			Header: &schedulepb.Message_Header{
+				OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+			},
+			To:                "a",
+			From:              id,
+			MsgType:           schedulepb.MsgHeartbeatResponse,
+			HeartbeatResponse: &schedulepb.HeartbeatResponse{},
+		})
+	}
+	trans.SendBuffer = []*schedulepb.Message{}
+	_, _, err = coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	require.True(t, coord.captureM.CheckAllCaptureInitialized())
+	msgs := trans.SendBuffer
+	require.Len(t, msgs, 1)
+	require.NotNil(t, msgs[0].DispatchTableRequest.GetAddTable(), msgs[0])
+	rejectedBy := msgs[0].To
+
+	// The capture rejects to add table 1, e.g., it has too many open tables,
+	// table 1 must be added to the other capture instead.
+	span := spanz.TableIDToComparableSpan(1)
+	rejected := &schedulepb.Message{
+		Header: &schedulepb.Message_Header{
+			OwnerRevision: schedulepb.OwnerRevision{Revision: 1},
+		},
+		To:      "a",
+		From:    rejectedBy,
+		MsgType: schedulepb.MsgDispatchTableResponse,
+		DispatchTableResponse: &schedulepb.DispatchTableResponse{
+			Response: &schedulepb.DispatchTableResponse_AddTable{
+				AddTable: &schedulepb.AddTableResponse{
+					Status: &tablepb.TableStatus{
+						Span:  span,
+						State: tablepb.TableStateAbsent,
+					},
+					Rejected: true,
+				},
+			},
+		},
+	}
+	trans.RecvBuffer = append(trans.RecvBuffer, rejected)
+	trans.SendBuffer = []*schedulepb.Message{}
+	_, _, err = coord.poll(ctx, 0, currentTables, aliveCaptures)
+	require.Nil(t, err)
+	msgs = trans.SendBuffer
+	require.Len(t, msgs, 1)
+	require.NotNil(t, msgs[0].DispatchTableRequest.GetAddTable(), msgs[0])
+	require.NotEqual(t, rejectedBy, msgs[0].To)
+	rep := coord.replicationM.GetReplicationSetForTests().GetV(span)
+	require.True(t, rep.IsRejectedBy(rejectedBy))
+	require.NotContains(t, rep.Captures, rejectedBy)
+}
+
 func TestCoordinatorDrainCapture(t *testing.T) {
 	t.Parallel()
 
@@ -354,7 +565,7 @@ func TestCoordinatorDrainCapture(t *testing.T) {
 		revision:  schedulepb.OwnerRevision{Revision: 3},
 		captureID: "a",
 	}
-	coord.captureM = member.NewCaptureManager("", model.ChangeFeedID{}, coord.revision, 0)
+	coord.captureM = member.NewCaptureManager("", model.ChangeFeedID{}, coord.revision, 0, 0)
 
 	coord.captureM.SetInitializedForTests(true)
 	coord.captureM.Captures["a"] = &member.CaptureStatus{State: member.CaptureStateUninitialized}
@@ -48,3 +48,11 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 	}
 	return tasks, nil
 }
+
+// GetLagBreakdown returns the progress of each stage of the changefeed.
+func (c *coordinator) GetLagBreakdown() model.ChangefeedLagBreakdown {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.replicationM.LagBreakdown()
+}
@@ -58,6 +58,7 @@ func TestInfoProvider(t *testing.T) {
 		}},
 		"b": {Tables: map[model.TableID]*model.TableReplicaInfo{}},
 	}, tasks)
+	require.Equal(t, model.ChangefeedLagBreakdown{}, ip.GetLagBreakdown())
 }
 
 func TestInfoProviderIsInitialized(t *testing.T) {
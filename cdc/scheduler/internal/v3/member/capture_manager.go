@@ -14,6 +14,9 @@
 package member
 
 import (
+	"time"
+
+	"github.com/benbjohnson/clock"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -63,6 +66,10 @@ type CaptureStatus struct {
 	ID       model.CaptureID
 	Addr     string
 	IsOwner  bool
+
+	// lastHeartbeatRespTime is the time of the last accepted heartbeat
+	// response, the lease of the capture starts from it.
+	lastHeartbeatRespTime time.Time
 }
 
 func newCaptureStatus(
@@ -78,7 +85,7 @@ func newCaptureStatus(
 }
 
 func (c *CaptureStatus) handleHeartbeatResponse(
-	resp *schedulepb.HeartbeatResponse, epoch schedulepb.ProcessorEpoch,
+	resp *schedulepb.HeartbeatResponse, epoch schedulepb.ProcessorEpoch, now time.Time,
 ) {
 	// Check epoch for initialized captures.
 	if c.State != CaptureStateUninitialized && c.Epoch.Epoch != epoch.Epoch {
@@ -105,6 +112,7 @@ func (c *CaptureStatus) handleHeartbeatResponse(
 			zap.String("captureAddr", c.Addr))
 	}
 	c.Tables = resp.Tables
+	c.lastHeartbeatRespTime = now
 }
 
 // CaptureChanges wraps changes of captures.
@@ -125,6 +133,13 @@ type CaptureManager struct {
 	tickCounter   int
 	heartbeatTick int
 
+	// fencing holds removed captures whose lease is not expired yet, their
+	// tables are only moved to other captures after the lease expires, i.e.
+	// after they are fenced by themselves. See SchedulerConfig.FenceTimeout.
+	fencing      map[model.CaptureID]*CaptureStatus
+	fenceTimeout time.Duration
+	clock        clock.Clock
+
 	changefeedID model.ChangeFeedID
 	ownerID      model.CaptureID
 }
@@ -132,12 +147,15 @@ type CaptureManager struct {
 // NewCaptureManager returns a new capture manager.
 func NewCaptureManager(
 	ownerID model.CaptureID, changefeedID model.ChangeFeedID,
-	rev schedulepb.OwnerRevision, heartbeatTick int,
+	rev schedulepb.OwnerRevision, heartbeatTick int, fenceTimeout time.Duration,
 ) *CaptureManager {
 	return &CaptureManager{
 		OwnerRev:      rev,
 		Captures:      make(map[model.CaptureID]*CaptureStatus),
 		heartbeatTick: heartbeatTick,
+		fencing:       make(map[model.CaptureID]*CaptureStatus),
+		fenceTimeout:  fenceTimeout,
+		clock:         clock.New(),
 
 		changefeedID: changefeedID,
 		ownerID:      ownerID,
@@ -162,9 +180,10 @@ func (c *CaptureManager) checkAllCaptureInitialized() bool {
 }
 
 // Tick advances the logical lock of capture manager and produce heartbeat when
-// necessary.
+// necessary. The heartbeat carries the global checkpoint ts of the changefeed.
 func (c *CaptureManager) Tick(
 	reps *spanz.Map[*replication.ReplicationSet], drainingCapture model.CaptureID,
+	checkpointTs model.Ts,
 ) []*schedulepb.Message {
 	c.tickCounter++
 	if c.tickCounter < c.heartbeatTick {
@@ -187,7 +206,8 @@ func (c *CaptureManager) Tick(
 				Spans: tables[to],
 				// IsStopping let the receiver capture know that it should be stopping now.
 				// At the moment, this is triggered by `DrainCapture` scheduler.
-				IsStopping: drainingCapture == to,
+				IsStopping:   drainingCapture == to,
+				CheckpointTs: checkpointTs,
 			},
 		})
 	}
@@ -207,7 +227,7 @@ func (c *CaptureManager) HandleMessage(
 				continue
 			}
 			captureStatus.handleHeartbeatResponse(
-				msg.GetHeartbeatResponse(), msg.Header.ProcessorEpoch)
+				msg.GetHeartbeatResponse(), msg.Header.ProcessorEpoch, c.clock.Now())
 		}
 	}
 }
@@ -245,17 +265,31 @@ func (c *CaptureManager) HandleAliveCaptureUpdate(
 			if !c.initialized {
 				continue
 			}
-			if c.changes == nil {
-				c.changes = &CaptureChanges{}
-			}
-			if c.changes.Removed == nil {
-				c.changes.Removed = make(map[string][]tablepb.TableStatus)
+			if c.fenceTimeout != 0 {
+				log.Info("schedulerv3: wait for the lease of the removed capture expiring",
+					zap.String("captureAddr", capture.Addr),
+					zap.String("capture", id),
+					zap.String("epoch", capture.Epoch.Epoch),
+					zap.Time("lastHeartbeatRespTime", capture.lastHeartbeatRespTime))
+				c.fencing[id] = capture
+				continue
 			}
-			c.changes.Removed[id] = capture.Tables
+			c.removeCapture(id, capture)
+		}
+	}
 
-			cf := c.changefeedID
-			captureTableGauge.DeleteLabelValues(cf.Namespace, cf.ID, capture.Addr)
+	// Find removed captures whose lease expires. They have stopped emitting
+	// events, so their tables can be moved to other captures safely.
+	for id, capture := range c.fencing {
+		if c.clock.Since(capture.lastHeartbeatRespTime) < c.fenceTimeout {
+			continue
 		}
+		log.Info("schedulerv3: the lease of the removed capture expires",
+			zap.String("captureAddr", capture.Addr),
+			zap.String("capture", id),
+			zap.String("epoch", capture.Epoch.Epoch))
+		delete(c.fencing, id)
+		c.removeCapture(id, capture)
 	}
 
 	// Check if this is the first time all captures are initialized.
@@ -272,6 +306,26 @@ func (c *CaptureManager) HandleAliveCaptureUpdate(
 	return msgs
 }
 
+func (c *CaptureManager) removeCapture(id model.CaptureID, capture *CaptureStatus) {
+	if c.changes == nil {
+		c.changes = &CaptureChanges{}
+	}
+	if c.changes.Removed == nil {
+		c.changes.Removed = make(map[string][]tablepb.TableStatus)
+	}
+	c.changes.Removed[id] = capture.Tables
+
+	cf := c.changefeedID
+	captureTableGauge.DeleteLabelValues(cf.Namespace, cf.ID, capture.Addr)
+}
+
+// HasFencingCaptures returns true if there are removed captures whose lease
+// is not expired yet. Tables must not be scheduled in the meantime, because
+// the tables of such captures may still be emitting events.
+func (c *CaptureManager) HasFencingCaptures() bool {
+	return len(c.fencing) != 0
+}
+
 // TakeChanges takes the changes of captures that it sees so far.
 func (c *CaptureManager) TakeChanges() *CaptureChanges {
 	// Only return changes when it's initialized.
@@ -299,9 +353,17 @@ func (c *CaptureManager) CleanMetrics() {
 	for _, capture := range c.Captures {
 		captureTableGauge.DeleteLabelValues(cf.Namespace, cf.ID, capture.Addr)
 	}
+	for _, capture := range c.fencing {
+		captureTableGauge.DeleteLabelValues(cf.Namespace, cf.ID, capture.Addr)
+	}
 }
 
 // SetInitializedForTests is only used in tests.
 func (c *CaptureManager) SetInitializedForTests(init bool) {
 	c.initialized = init
 }
+
+// SetClockForTests is only used in tests.
+func (c *CaptureManager) SetClockForTests(clk clock.Clock) {
+	c.clock = clk
+}
@@ -15,6 +15,7 @@ package member
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -38,19 +39,19 @@ func TestCaptureStatusHandleHeartbeatResponse(t *testing.T) {
 	require.True(t, c.IsOwner)
 
 	// Uninitialized -> Initialized
-	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{}, epoch)
+	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{}, epoch, time.Now())
 	require.Equal(t, CaptureStateInitialized, c.State)
 	require.Equal(t, epoch, c.Epoch)
 
 	// Processor epoch mismatch
 	c.handleHeartbeatResponse(&schedulepb.HeartbeatResponse{
 		Liveness: model.LivenessCaptureStopping,
-	}, schedulepb.ProcessorEpoch{Epoch: "unknown"})
+	}, schedulepb.ProcessorEpoch{Epoch: "unknown"}, time.Now())
 	require.Equal(t, CaptureStateInitialized, c.State)
 
 	// Initialized -> Stopping
 	c.handleHeartbeatResponse(
-		&schedulepb.HeartbeatResponse{Liveness: model.LivenessCaptureStopping}, epoch)
+		&schedulepb.HeartbeatResponse{Liveness: model.LivenessCaptureStopping}, epoch, time.Now())
 	require.Equal(t, CaptureStateStopping, c.State)
 	require.Equal(t, epoch, c.Epoch)
 }
@@ -59,7 +60,7 @@ func TestCaptureManagerHandleAliveCaptureUpdate(t *testing.T) {
 	t.Parallel()
 
 	rev := schedulepb.OwnerRevision{}
-	cm := NewCaptureManager("1", model.ChangeFeedID{}, rev, 2)
+	cm := NewCaptureManager("1", model.ChangeFeedID{}, rev, 2, 0)
 	ms := map[model.CaptureID]*model.CaptureInfo{
 		"1": {}, "2": {}, "3": {},
 	}
@@ -134,7 +135,7 @@ func TestCaptureManagerHandleMessages(t *testing.T) {
 		"1": {},
 		"2": {},
 	}
-	cm := NewCaptureManager("", model.ChangeFeedID{}, rev, 2)
+	cm := NewCaptureManager("", model.ChangeFeedID{}, rev, 2, 0)
 	require.False(t, cm.CheckAllCaptureInitialized())
 
 	// Initial handle alive captures.
@@ -182,12 +183,12 @@ func TestCaptureManagerTick(t *testing.T) {
 	t.Parallel()
 
 	rev := schedulepb.OwnerRevision{}
-	cm := NewCaptureManager("", model.ChangeFeedID{}, rev, 2)
+	cm := NewCaptureManager("", model.ChangeFeedID{}, rev, 2, 0)
 
 	// No heartbeat if there is no capture.
-	msgs := cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+	msgs := cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 	require.Empty(t, msgs)
-	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 	require.Empty(t, msgs)
 
 	ms := map[model.CaptureID]*model.CaptureInfo{
@@ -197,9 +198,9 @@ func TestCaptureManagerTick(t *testing.T) {
 	cm.HandleAliveCaptureUpdate(ms)
 
 	// Heartbeat even if capture is uninitialized.
-	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 	require.Empty(t, msgs)
-	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 	require.ElementsMatch(t, []*schedulepb.Message{
 		{To: "1", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
 		{To: "2", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
@@ -209,9 +210,9 @@ func TestCaptureManagerTick(t *testing.T) {
 	for _, s := range []CaptureState{CaptureStateInitialized, CaptureStateStopping} {
 		cm.Captures["1"].State = s
 		cm.Captures["2"].State = s
-		msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+		msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 		require.Empty(t, msgs)
-		msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+		msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 		require.ElementsMatch(t, []*schedulepb.Message{
 			{To: "1", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
 			{To: "2", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
@@ -219,7 +220,7 @@ func TestCaptureManagerTick(t *testing.T) {
 	}
 
 	// TableID in heartbeat.
-	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining)
+	msgs = cm.Tick(spanz.NewMap[*replication.ReplicationSet](), captureIDNotDraining, 0)
 	require.Empty(t, msgs)
 
 	tables := spanz.NewMap[*replication.ReplicationSet]()
@@ -240,8 +241,11 @@ func TestCaptureManagerTick(t *testing.T) {
 		}})
 	tables.ReplaceOrInsert(tablepb.Span{TableID: 4}, &replication.ReplicationSet{})
 
-	msgs = cm.Tick(tables, captureIDNotDraining)
+	// The global checkpoint in heartbeat.
+	msgs = cm.Tick(tables, captureIDNotDraining, 42)
 	require.Len(t, msgs, 2)
+	require.Equal(t, model.Ts(42), msgs[0].Heartbeat.CheckpointTs)
+	require.Equal(t, model.Ts(42), msgs[1].Heartbeat.CheckpointTs)
 	if msgs[0].To == "1" {
 		require.ElementsMatch(t,
 			[]tablepb.Span{{TableID: 1}, {TableID: 2}}, msgs[0].Heartbeat.Spans)
@@ -125,6 +125,21 @@ var (
 			Name:      "slow_table_region_count",
 			Help:      "The number of regions captured by the slowest table",
 		}, []string{"namespace", "changefeed"})
+
+	changefeedStageTsGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "scheduler",
+			Name:      "changefeed_stage_ts",
+			Help:      "The min ts of each stage across all tables of the changefeed",
+		}, []string{"namespace", "changefeed", "stage"})
+	changefeedStageLagGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "scheduler",
+			Name:      "changefeed_stage_lag",
+			Help:      "The lag of each stage behind its upstream stage of the changefeed",
+		}, []string{"namespace", "changefeed", "stage"})
 )
 
 // InitMetrics registers all metrics used in scheduler
@@ -144,4 +159,6 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(slowestTableStageCheckpointTsLagHistogramVec)
 	registry.MustRegister(slowestTableStageResolvedTsLagHistogramVec)
 	registry.MustRegister(slowestTableRegionGaugeVec)
+	registry.MustRegister(changefeedStageTsGaugeVec)
+	registry.MustRegister(changefeedStageLagGaugeVec)
 }
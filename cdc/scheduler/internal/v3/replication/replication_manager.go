@@ -250,6 +250,9 @@ func (r *Manager) handleMessageDispatchTableResponse(
 	var status *tablepb.TableStatus
 	switch resp := msg.Response.(type) {
 	case *schedulepb.DispatchTableResponse_AddTable:
+		if resp.AddTable.Rejected {
+			return nil, r.handleAddTableRejected(from, resp.AddTable.Status.Span)
+		}
 		status = resp.AddTable.Status
 	case *schedulepb.DispatchTableResponse_RemoveTable:
 		status = resp.RemoveTable.Status
@@ -283,6 +286,21 @@ func (r *Manager) handleMessageDispatchTableResponse(
 	return msgs, nil
 }
 
+func (r *Manager) handleAddTableRejected(
+	from model.CaptureID, span tablepb.Span,
+) error {
+	table, ok := r.spans.Get(span)
+	if !ok {
+		log.Info("schedulerv3: ignore add table rejection no table found",
+			zap.String("namespace", r.changefeedID.Namespace),
+			zap.String("changefeed", r.changefeedID.ID),
+			zap.String("captureID", from),
+			zap.Stringer("span", &span))
+		return nil
+	}
+	return errors.Trace(table.handleAddTableRejected(from))
+}
+
 // HandleTasks handles schedule tasks.
 func (r *Manager) HandleTasks(
 	tasks []*ScheduleTask,
@@ -291,9 +309,10 @@ func (r *Manager) HandleTasks(
 	toBeDeleted := []tablepb.Span{}
 	r.runningTasks.Ascend(func(span tablepb.Span, task *ScheduleTask) bool {
 		if table, ok := r.spans.Get(span); ok {
-			// If table is back to Replicating or Removed,
+			// If table is back to Replicating, Absent or Removed,
 			// the running task is finished.
-			if table.State == ReplicationSetStateReplicating || table.hasRemoved() {
+			if table.State == ReplicationSetStateReplicating ||
+				table.State == ReplicationSetStateAbsent || table.hasRemoved() {
 				toBeDeleted = append(toBeDeleted, span)
 			}
 		} else {
@@ -593,6 +612,41 @@ func (r *Manager) logSlowTableInfo(currentPDTime time.Time) {
 	}
 }
 
+// Stages of a changefeed in the lag breakdown, they are the keys of the stage
+// checkpoints reported by processors.
+const (
+	stagePullerEgress = "puller-egress"
+	stageSorterEgress = "sorter-egress"
+	stageSink         = "sink"
+)
+
+// LagBreakdown returns the min ts of each stage across all replicating tables.
+// Tables that don't report a stage, e.g. replicated by captures of an older
+// version, are skipped for that stage.
+func (r *Manager) LagBreakdown() model.ChangefeedLagBreakdown {
+	var breakdown model.ChangefeedLagBreakdown
+	minTs := func(ts *model.Ts, t model.Ts) {
+		if t != 0 && (*ts == 0 || t < *ts) {
+			*ts = t
+		}
+	}
+	r.spans.Ascend(func(span tablepb.Span, table *ReplicationSet) bool {
+		if table.State != ReplicationSetStateReplicating {
+			return true
+		}
+		if table.Stats.CurrentTs > breakdown.CurrentTs {
+			breakdown.CurrentTs = table.Stats.CurrentTs
+		}
+		// a missing stage is a zero checkpoint, which is skipped.
+		stages := table.Stats.StageCheckpoints
+		minTs(&breakdown.PullerResolvedTs, stages[stagePullerEgress].ResolvedTs)
+		minTs(&breakdown.SorterOutputTs, stages[stageSorterEgress].ResolvedTs)
+		minTs(&breakdown.SinkFlushedTs, stages[stageSink].CheckpointTs)
+		return true
+	})
+	return breakdown
+}
+
 // CollectMetrics collects metrics.
 func (r *Manager) CollectMetrics() {
 	cf := r.changefeedID
@@ -659,6 +713,27 @@ func (r *Manager) CollectMetrics() {
 	r.acceptBurstBalanceTask = 0
 	runningScheduleTaskGauge.
 		WithLabelValues(cf.Namespace, cf.ID).Set(float64(r.runningTasks.Len()))
+	breakdown := r.LagBreakdown()
+	for _, stage := range []struct {
+		name string
+		ts   model.Ts
+		lag  time.Duration
+	}{
+		{name: "puller", ts: breakdown.PullerResolvedTs, lag: breakdown.PullerLag()},
+		{name: "sorter", ts: breakdown.SorterOutputTs, lag: breakdown.SorterLag()},
+		{name: "sink", ts: breakdown.SinkFlushedTs, lag: breakdown.SinkLag()},
+	} {
+		if stage.ts == 0 {
+			// not reported by any table.
+			changefeedStageTsGaugeVec.DeleteLabelValues(cf.Namespace, cf.ID, stage.name)
+			changefeedStageLagGaugeVec.DeleteLabelValues(cf.Namespace, cf.ID, stage.name)
+			continue
+		}
+		changefeedStageTsGaugeVec.WithLabelValues(cf.Namespace, cf.ID, stage.name).
+			Set(float64(oracle.ExtractPhysical(stage.ts)))
+		changefeedStageLagGaugeVec.WithLabelValues(cf.Namespace, cf.ID, stage.name).
+			Set(stage.lag.Seconds())
+	}
 	var stateCounters [6]int
 	r.spans.Ascend(func(span tablepb.Span, table *ReplicationSet) bool {
 		switch table.State {
@@ -712,6 +787,10 @@ func (r *Manager) CleanMetrics() {
 	slowestTableStageCheckpointTsLagHistogramVec.Reset()
 	slowestTableStageResolvedTsLagHistogramVec.Reset()
 	slowestTableRegionGaugeVec.Reset()
+	for _, stage := range []string{"puller", "sorter", "sink"} {
+		changefeedStageTsGaugeVec.DeleteLabelValues(cf.Namespace, cf.ID, stage)
+		changefeedStageLagGaugeVec.DeleteLabelValues(cf.Namespace, cf.ID, stage)
+	}
 }
 
 // SetReplicationSetForTests is only used in tests.
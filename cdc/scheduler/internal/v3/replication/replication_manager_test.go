@@ -851,3 +851,55 @@ func TestLogSlowTableInfo(t *testing.T) {
 	// make sure the slowTableHeap's capacity will not extend
 	require.Equal(t, cap(r.slowTableHeap), 8)
 }
+
+func TestReplicationManagerLagBreakdown(t *testing.T) {
+	t.Parallel()
+	r := NewReplicationManager(1, model.ChangeFeedID{})
+	require.Equal(t, model.ChangefeedLagBreakdown{}, r.LagBreakdown())
+
+	r.spans.ReplaceOrInsert(spanz.TableIDToComparableSpan(1), &ReplicationSet{
+		Span:  spanz.TableIDToComparableSpan(1),
+		State: ReplicationSetStateReplicating,
+		Stats: tablepb.Stats{
+			CurrentTs: 100,
+			StageCheckpoints: map[string]tablepb.Checkpoint{
+				"puller-egress": {ResolvedTs: 90},
+				"sorter-egress": {ResolvedTs: 80},
+				"sink":          {CheckpointTs: 70, ResolvedTs: 75},
+			},
+		},
+	})
+	r.spans.ReplaceOrInsert(spanz.TableIDToComparableSpan(2), &ReplicationSet{
+		Span:  spanz.TableIDToComparableSpan(2),
+		State: ReplicationSetStateReplicating,
+		Stats: tablepb.Stats{
+			CurrentTs: 101,
+			StageCheckpoints: map[string]tablepb.Checkpoint{
+				"puller-egress": {ResolvedTs: 85},
+				"sorter-egress": {ResolvedTs: 83},
+				"sink":          {CheckpointTs: 72, ResolvedTs: 80},
+			},
+		},
+	})
+	// the table reported by an older capture without stage checkpoints.
+	r.spans.ReplaceOrInsert(spanz.TableIDToComparableSpan(3), &ReplicationSet{
+		Span:  spanz.TableIDToComparableSpan(3),
+		State: ReplicationSetStateReplicating,
+	})
+	// the table not replicating yet.
+	r.spans.ReplaceOrInsert(spanz.TableIDToComparableSpan(4), &ReplicationSet{
+		Span:  spanz.TableIDToComparableSpan(4),
+		State: ReplicationSetStatePrepare,
+		Stats: tablepb.Stats{
+			StageCheckpoints: map[string]tablepb.Checkpoint{
+				"puller-egress": {ResolvedTs: 1},
+			},
+		},
+	})
+	require.Equal(t, model.ChangefeedLagBreakdown{
+		CurrentTs:        101,
+		PullerResolvedTs: 85,
+		SorterOutputTs:   80,
+		SinkFlushedTs:    70,
+	}, r.LagBreakdown())
+}
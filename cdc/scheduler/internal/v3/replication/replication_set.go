@@ -137,6 +137,10 @@ type ReplicationSet struct { //nolint:revive
 	Captures   map[model.CaptureID]Role
 	Checkpoint tablepb.Checkpoint
 	Stats      tablepb.Stats
+	// RejectedCaptures is a set of captures that reject to add the table,
+	// e.g., they have too many open tables. It's reset once the table is
+	// replicated.
+	RejectedCaptures map[model.CaptureID]struct{}
 }
 
 // NewReplicationSet returns a new replication set.
@@ -305,9 +309,16 @@ func (r *ReplicationSet) promoteSecondary(captureID model.CaptureID) error {
 	}
 	r.Primary = captureID
 	r.Captures[r.Primary] = RolePrimary
+	r.RejectedCaptures = nil
 	return nil
 }
 
+// IsRejectedBy returns true if the capture rejects to add the table.
+func (r *ReplicationSet) IsRejectedBy(captureID model.CaptureID) bool {
+	_, ok := r.RejectedCaptures[captureID]
+	return ok
+}
+
 func (r *ReplicationSet) clearPrimary() {
 	delete(r.Captures, r.Primary)
 	r.Primary = ""
@@ -794,9 +805,76 @@ func (r *ReplicationSet) pollOnRemoving(
 func (r *ReplicationSet) handleTableStatus(
 	from model.CaptureID, status *tablepb.TableStatus,
 ) ([]*schedulepb.Message, error) {
+	if _, ok := r.Captures[from]; !ok {
+		return r.handleStrayTableStatus(from, status), nil
+	}
 	return r.poll(status, from)
 }
 
+// handleStrayTableStatus asks a capture which is not in the replication set to
+// remove the table, e.g., the capture is back after its table is moved to
+// other captures. The RemoveTableRequest carries the latest epoch of the
+// table, so that the capture stops emitting events of the table at once.
+func (r *ReplicationSet) handleStrayTableStatus(
+	from model.CaptureID, status *tablepb.TableStatus,
+) []*schedulepb.Message {
+	switch status.State {
+	case tablepb.TableStatePreparing, tablepb.TableStatePrepared,
+		tablepb.TableStateReplicating:
+	default:
+		return nil
+	}
+	log.Warn("schedulerv3: remove table from the capture not in replication set",
+		zap.Stringer("tableState", status),
+		zap.String("captureID", from),
+		zap.Any("replicationSet", r))
+	return []*schedulepb.Message{{
+		To:      from,
+		MsgType: schedulepb.MsgDispatchTableRequest,
+		DispatchTableRequest: &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableRequest{Span: r.Span},
+			},
+		},
+	}}
+}
+
+// handleAddTableRejected handles the rejection of adding the table from the
+// secondary capture. The capture is removed from the replication set, so that
+// the table can be added to another capture.
+func (r *ReplicationSet) handleAddTableRejected(
+	captureID model.CaptureID,
+) error {
+	if r.State != ReplicationSetStatePrepare ||
+		!r.isInRole(captureID, RoleSecondary) {
+		log.Warn("schedulerv3: ignore add table rejection, "+
+			"the capture is not the secondary in Prepare",
+			zap.String("captureID", captureID),
+			zap.Any("replicationSet", r))
+		return nil
+	}
+	if err := r.clearCapture(captureID, RoleSecondary); err != nil {
+		return errors.Trace(err)
+	}
+	if r.RejectedCaptures == nil {
+		r.RejectedCaptures = make(map[model.CaptureID]struct{})
+	}
+	r.RejectedCaptures[captureID] = struct{}{}
+	oldState := r.State
+	if r.Primary != "" {
+		r.State = ReplicationSetStateReplicating
+	} else {
+		r.State = ReplicationSetStateAbsent
+	}
+	log.Info("schedulerv3: replication state transition, add table rejected",
+		zap.String("captureID", captureID),
+		zap.Stringer("old", oldState),
+		zap.Stringer("new", r.State),
+		zap.String("namespace", r.Changefeed.Namespace),
+		zap.String("changefeed", r.Changefeed.ID))
+	return nil
+}
+
 func (r *ReplicationSet) handleAddTable(
 	captureID model.CaptureID,
 ) ([]*schedulepb.Message, error) {
@@ -322,6 +322,47 @@ func TestReplicationSetPollUnknownCapture(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestReplicationSetRemoveStrayTable(t *testing.T) {
+	t.Parallel()
+
+	span := tablepb.Span{TableID: 1}
+	r, err := NewReplicationSet(span, 0, map[model.CaptureID]*tablepb.TableStatus{
+		"1": {
+			Span:  span,
+			State: tablepb.TableStateReplicating,
+		},
+	}, model.ChangeFeedID{})
+	require.Nil(t, err)
+
+	// The capture is back after the table is moved to other captures.
+	msgs, err := r.handleTableStatus("2", &tablepb.TableStatus{
+		Span:  span,
+		State: tablepb.TableStateReplicating,
+	})
+	require.Nil(t, err)
+	require.Len(t, msgs, 1)
+	require.EqualValues(t, &schedulepb.Message{
+		To:      "2",
+		MsgType: schedulepb.MsgDispatchTableRequest,
+		DispatchTableRequest: &schedulepb.DispatchTableRequest{
+			Request: &schedulepb.DispatchTableRequest_RemoveTable{
+				RemoveTable: &schedulepb.RemoveTableRequest{Span: span},
+			},
+		},
+	}, msgs[0])
+	require.EqualValues(t, map[string]Role{"1": RolePrimary}, r.Captures)
+	require.Equal(t, ReplicationSetStateReplicating, r.State)
+
+	// Ignore the capture if it does not have the table.
+	for _, state := range []tablepb.TableState{
+		tablepb.TableStateAbsent, tablepb.TableStateStopping, tablepb.TableStateStopped,
+	} {
+		msgs, err = r.handleTableStatus("2", &tablepb.TableStatus{Span: span, State: state})
+		require.Nil(t, err)
+		require.Len(t, msgs, 0)
+	}
+}
+
 func TestReplicationSetAddTable(t *testing.T) {
 	t.Parallel()
 
@@ -467,6 +508,55 @@ func TestReplicationSetAddTable(t *testing.T) {
 	}, r.Checkpoint)
 }
 
+func TestReplicationSetAddTableRejected(t *testing.T) {
+	t.Parallel()
+
+	span := tablepb.Span{TableID: 1}
+	r, err := NewReplicationSet(span, 0, nil, model.ChangeFeedID{})
+	require.Nil(t, err)
+	_, err = r.handleAddTable("1")
+	require.Nil(t, err)
+	require.Equal(t, ReplicationSetStatePrepare, r.State)
+
+	// The secondary rejects to add the table, Prepare -> Absent.
+	require.Nil(t, r.handleAddTableRejected("1"))
+	require.Equal(t, ReplicationSetStateAbsent, r.State)
+	require.Empty(t, r.Captures)
+	require.True(t, r.IsRejectedBy("1"))
+
+	// Add the table to another capture.
+	_, err = r.handleAddTable("2")
+	require.Nil(t, err)
+	require.Equal(t, ReplicationSetStatePrepare, r.State)
+	require.True(t, r.isInRole("2", RoleSecondary))
+	// Ignore the rejection from a capture which is not the secondary.
+	require.Nil(t, r.handleAddTableRejected("1"))
+	require.Equal(t, ReplicationSetStatePrepare, r.State)
+
+	// The rejections are reset once the table is replicated.
+	_, err = r.handleTableStatus("2", &tablepb.TableStatus{
+		Span:  span,
+		State: tablepb.TableStatePrepared,
+	})
+	require.Nil(t, err)
+	_, err = r.handleTableStatus("2", &tablepb.TableStatus{
+		Span:  span,
+		State: tablepb.TableStateReplicating,
+	})
+	require.Nil(t, err)
+	require.Equal(t, ReplicationSetStateReplicating, r.State)
+	require.False(t, r.IsRejectedBy("1"))
+
+	// Move the table, the destination rejects, Prepare -> Replicating.
+	_, err = r.handleMoveTable("3")
+	require.Nil(t, err)
+	require.Equal(t, ReplicationSetStatePrepare, r.State)
+	require.Nil(t, r.handleAddTableRejected("3"))
+	require.Equal(t, ReplicationSetStateReplicating, r.State)
+	require.Equal(t, "2", r.Primary)
+	require.EqualValues(t, map[string]Role{"2": RolePrimary}, r.Captures)
+}
+
 func TestReplicationSetRemoveTable(t *testing.T) {
 	t.Parallel()
 
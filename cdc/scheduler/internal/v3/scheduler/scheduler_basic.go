@@ -112,7 +112,7 @@ func (b *basicScheduler) Schedule(
 			zap.Strings("captureIDs", captureIDs),
 			zap.Int("tableCount", len(newSpans)))
 		tasks = append(
-			tasks, newBurstAddTables(checkpointTs, newSpans, captureIDs))
+			tasks, newBurstAddTables(checkpointTs, newSpans, captureIDs, replications))
 	}
 
 	// Build remove table tasks.
@@ -152,18 +152,30 @@ func (b *basicScheduler) Schedule(
 }
 
 // newBurstAddTables add each new table to captures in a round-robin way.
+// Captures that reject to add a table are skipped, unless all captures reject.
 func newBurstAddTables(
 	checkpointTs model.Ts, newSpans []tablepb.Span, captureIDs []model.CaptureID,
+	replications *spanz.Map[*replication.ReplicationSet],
 ) *replication.ScheduleTask {
 	idx := 0
 	tables := make([]replication.AddTable, 0, len(newSpans))
 	for _, span := range newSpans {
+		target := idx
+		if rep, ok := replications.Get(span); ok {
+			for i := 0; i < len(captureIDs); i++ {
+				candidate := (idx + i) % len(captureIDs)
+				if !rep.IsRejectedBy(captureIDs[candidate]) {
+					target = candidate
+					break
+				}
+			}
+		}
 		tables = append(tables, replication.AddTable{
 			Span:         span,
-			CaptureID:    captureIDs[idx],
+			CaptureID:    captureIDs[target],
 			CheckpointTs: checkpointTs,
 		})
-		idx++
+		idx = target + 1
 		if idx >= len(captureIDs) {
 			idx = 0
 		}
@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+)
+
+// spanEpochs issues the epochs of table span ownership. Every AddTableRequest
+// carries a new epoch of the table span, which is greater than all the epochs
+// issued or reported before, so that captures can tell a stale ownership.
+// The epochs reported by captures are learnt from their table statuses, so a
+// new owner keeps the epochs increasing after it collects the heartbeat
+// responses from all captures.
+//
+// A capture which replicates a table span with an epoch less than the latest
+// one is not necessarily stale, e.g., the primary keeps replicating the table
+// span until the secondary, which is added with a greater epoch, is prepared.
+type spanEpochs struct {
+	latest *spanz.Map[uint64]
+}
+
+func newSpanEpochs() *spanEpochs {
+	return &spanEpochs{latest: spanz.NewMap[uint64]()}
+}
+
+// observe learns the epochs of table spans from the received messages.
+func (e *spanEpochs) observe(msgs []*schedulepb.Message) {
+	for _, msg := range msgs {
+		switch msg.MsgType {
+		case schedulepb.MsgHeartbeatResponse:
+			tables := msg.GetHeartbeatResponse().GetTables()
+			for i := range tables {
+				e.observeStatus(&tables[i])
+			}
+		case schedulepb.MsgDispatchTableResponse:
+			resp := msg.GetDispatchTableResponse()
+			if status := resp.GetAddTable().GetStatus(); status != nil {
+				e.observeStatus(status)
+			}
+			if status := resp.GetRemoveTable().GetStatus(); status != nil {
+				e.observeStatus(status)
+			}
+		}
+	}
+}
+
+func (e *spanEpochs) observeStatus(status *tablepb.TableStatus) {
+	if latest, _ := e.latest.Get(status.Span); status.Epoch > latest {
+		e.latest.ReplaceOrInsert(status.Span, status.Epoch)
+	}
+}
+
+// issue sets a new epoch to every AddTableRequest in the messages, and the
+// latest epoch to every RemoveTableRequest, so that a capture whose table span
+// has been added to another capture stops emitting its events at once.
+func (e *spanEpochs) issue(msgs []*schedulepb.Message) {
+	for _, msg := range msgs {
+		req := msg.GetDispatchTableRequest()
+		if add := req.GetAddTable(); add != nil {
+			latest, _ := e.latest.Get(add.Span)
+			add.Epoch = latest + 1
+			e.latest.ReplaceOrInsert(add.Span, add.Epoch)
+		} else if remove := req.GetRemoveTable(); remove != nil {
+			remove.Epoch, _ = e.latest.Get(remove.Span)
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	v3 "github.com/pingcap/tiflow/cdc/scheduler/internal/v3"
 	v3agent "github.com/pingcap/tiflow/cdc/scheduler/internal/v3/agent"
@@ -35,6 +36,49 @@ import (
 // TODO find a way to make the semantics easier to understand.
 type TableExecutor internal.TableExecutor
 
+// AlertThresholds are the alerting thresholds of a table span.
+type AlertThresholds = internal.AlertThresholds
+
+// ConflictStats are the statistics of the conflicts detected when applying
+// the events of a table span to the downstream.
+type ConflictStats = internal.ConflictStats
+
+// FilterRule is a filter rule applied to the DML events of a table span.
+type FilterRule = internal.FilterRule
+
+// SinkCapabilities are the capabilities of the sink of a table span.
+type SinkCapabilities = internal.SinkCapabilities
+
+// Trend is the trend of a metric computed from its recent samples.
+type Trend = internal.Trend
+
+// The trends of a metric.
+const (
+	TrendUnknown  = internal.TrendUnknown
+	TrendGrowing  = internal.TrendGrowing
+	TrendStable   = internal.TrendStable
+	TrendDraining = internal.TrendDraining
+)
+
+// The stages of the pipeline of a table span.
+const (
+	PipelineStageFetch = internal.PipelineStageFetch
+	PipelineStageSort  = internal.PipelineStageSort
+	PipelineStageMount = internal.PipelineStageMount
+	PipelineStageSink  = internal.PipelineStageSink
+)
+
+// NewTwoPhaseStageCounts returns counts with all the two-phase stages set to 0.
+func NewTwoPhaseStageCounts() map[string]int {
+	return internal.NewTwoPhaseStageCounts()
+}
+
+// TwoPhaseStageOf returns the two-phase scheduling stage of a table span in
+// the given state. It returns false if the table span is absent.
+func TwoPhaseStageOf(state tablepb.TableState) (string, bool) {
+	return internal.TwoPhaseStageOf(state)
+}
+
 // Scheduler is an interface for scheduling tables.
 // Since in our design, we do not record checkpoints per table,
 // how we calculate the global watermarks (checkpoint-ts and resolved-ts)
@@ -64,6 +64,9 @@ type AddTableRequest struct {
 	Span        tablepb.Span                                `protobuf:"bytes,4,opt,name=span,proto3" json:"span"`
 	IsSecondary bool                                        `protobuf:"varint,2,opt,name=is_secondary,json=isSecondary,proto3" json:"is_secondary,omitempty"`
 	Checkpoint  tablepb.Checkpoint                          `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
+	// The epoch of the table span ownership, it's issued by the owner and
+	// increases every time the table span is added to a capture.
+	Epoch uint64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
 func (m *AddTableRequest) Reset()         { *m = AddTableRequest{} }
@@ -127,9 +130,19 @@ func (m *AddTableRequest) GetCheckpoint() tablepb.Checkpoint {
 	return tablepb.Checkpoint{}
 }
 
+func (m *AddTableRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 type RemoveTableRequest struct {
 	TableID github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_id,omitempty"`
 	Span    tablepb.Span                                `protobuf:"bytes,2,opt,name=span,proto3" json:"span"`
+	// The latest epoch of the table span ownership issued by the owner, the
+	// capture stops emitting events of the table span if its epoch is less.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
 func (m *RemoveTableRequest) Reset()         { *m = RemoveTableRequest{} }
@@ -179,6 +192,13 @@ func (m *RemoveTableRequest) GetSpan() tablepb.Span {
 	return tablepb.Span{}
 }
 
+func (m *RemoveTableRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 type DispatchTableRequest struct {
 	// Types that are valid to be assigned to Request:
 	//	*DispatchTableRequest_AddTable
@@ -267,6 +287,9 @@ func (*DispatchTableRequest) XXX_OneofWrappers() []interface{} {
 type AddTableResponse struct {
 	Status     *tablepb.TableStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Checkpoint tablepb.Checkpoint   `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint"`
+	// The capture rejects to add the table span, e.g., it has too many open
+	// table spans, so the table span should be added to another capture.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
 }
 
 func (m *AddTableResponse) Reset()         { *m = AddTableResponse{} }
@@ -316,6 +339,13 @@ func (m *AddTableResponse) GetCheckpoint() tablepb.Checkpoint {
 	return tablepb.Checkpoint{}
 }
 
+func (m *AddTableResponse) GetRejected() bool {
+	if m != nil {
+		return m.Rejected
+	}
+	return false
+}
+
 type RemoveTableResponse struct {
 	Status     *tablepb.TableStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Checkpoint tablepb.Checkpoint   `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint"`
@@ -455,9 +485,10 @@ func (*DispatchTableResponse) XXX_OneofWrappers() []interface{} {
 }
 
 type Heartbeat struct {
-	TableIDs   []github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,rep,packed,name=table_ids,json=tableIds,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_ids,omitempty"`
-	IsStopping bool                                          `protobuf:"varint,2,opt,name=is_stopping,json=isStopping,proto3" json:"is_stopping,omitempty"`
-	Spans      []tablepb.Span                                `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans"`
+	TableIDs     []github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,rep,packed,name=table_ids,json=tableIds,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_ids,omitempty"`
+	IsStopping   bool                                          `protobuf:"varint,2,opt,name=is_stopping,json=isStopping,proto3" json:"is_stopping,omitempty"`
+	Spans        []tablepb.Span                                `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans"`
+	CheckpointTs github_com_pingcap_tiflow_cdc_model.Ts        `protobuf:"varint,4,opt,name=checkpoint_ts,json=checkpointTs,proto3,casttype=github.com/pingcap/tiflow/cdc/model.Ts" json:"checkpoint_ts,omitempty"`
 }
 
 func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
@@ -514,6 +545,13 @@ func (m *Heartbeat) GetSpans() []tablepb.Span {
 	return nil
 }
 
+func (m *Heartbeat) GetCheckpointTs() github_com_pingcap_tiflow_cdc_model.Ts {
+	if m != nil {
+		return m.CheckpointTs
+	}
+	return 0
+}
+
 type HeartbeatResponse struct {
 	Tables   []tablepb.TableStatus                        `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables"`
 	Liveness github_com_pingcap_tiflow_cdc_model.Liveness `protobuf:"varint,2,opt,name=liveness,proto3,casttype=github.com/pingcap/tiflow/cdc/model.Liveness" json:"liveness,omitempty"`
@@ -836,71 +874,74 @@ func init() {
 }
 
 var fileDescriptor_86eeacbf6ca5b996 = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6e, 0x1b, 0x45,
-	0x18, 0xf7, 0xda, 0x89, 0xff, 0x7c, 0x4e, 0x5d, 0x77, 0x70, 0xe9, 0xca, 0x80, 0x6d, 0xf6, 0x00,
-	0xa1, 0x85, 0x75, 0x6b, 0x10, 0x94, 0x14, 0x90, 0xea, 0xa6, 0x28, 0x91, 0x1a, 0x25, 0xda, 0xb4,
-	0x80, 0xb8, 0x98, 0xf5, 0xee, 0x64, 0xbd, 0xaa, 0xbd, 0xb3, 0xec, 0xac, 0x1d, 0xe5, 0x15, 0x7c,
-	0xe2, 0x05, 0xfc, 0x00, 0x1c, 0x41, 0x42, 0xe2, 0x11, 0x7a, 0x42, 0xe1, 0xc6, 0x01, 0x59, 0xc5,
-	0x79, 0x00, 0xee, 0xe1, 0x82, 0x76, 0x66, 0x76, 0x9d, 0x4d, 0x5c, 0xb4, 0x31, 0x08, 0xa9, 0xb7,
-	0x99, 0x6f, 0xf6, 0xfb, 0x7d, 0x7f, 0xe6, 0xf7, 0xfb, 0x46, 0x0b, 0xef, 0x50, 0xa3, 0x87, 0xcd,
-	0x61, 0x1f, 0x7b, 0xcd, 0x70, 0xe5, 0x76, 0x9b, 0xbe, 0xde, 0xed, 0xe3, 0x4e, 0x68, 0x50, 0x5d,
-	0x8f, 0xf8, 0x04, 0xbd, 0xed, 0xda, 0x8e, 0x65, 0xe8, 0xae, 0xea, 0xdb, 0x07, 0x7d, 0x72, 0xa8,
-	0x1a, 0xa6, 0xa1, 0x46, 0xde, 0xea, 0xdc, 0xbb, 0x5a, 0xb1, 0x88, 0x45, 0x98, 0x4f, 0x33, 0x58,
-	0x71, 0xf7, 0xea, 0x1b, 0xae, 0x47, 0x0c, 0x4c, 0x29, 0xf1, 0x38, 0x7c, 0x18, 0x86, 0x1f, 0x2b,
-	0xdf, 0xa7, 0xe1, 0xea, 0x7d, 0xd3, 0x7c, 0x1c, 0x98, 0x34, 0xfc, 0xed, 0x10, 0x53, 0x1f, 0x3d,
-	0x81, 0x3c, 0xcf, 0xc4, 0x36, 0x65, 0xa9, 0x21, 0xad, 0x67, 0xda, 0x1b, 0xb3, 0x69, 0x3d, 0xc7,
-	0xbe, 0xd9, 0xde, 0x3c, 0x9d, 0xd6, 0x6f, 0x59, 0xb6, 0xdf, 0x1b, 0x76, 0x55, 0x83, 0x0c, 0x9a,
-	0x22, 0xbb, 0x26, 0xcf, 0xae, 0x69, 0x98, 0x46, 0x73, 0x40, 0x4c, 0xdc, 0x57, 0xc5, 0xe7, 0x5a,
-	0x8e, 0x61, 0x6d, 0x9b, 0x68, 0x13, 0x56, 0xa8, 0xab, 0x3b, 0xf2, 0x4a, 0x43, 0x5a, 0x2f, 0xb6,
-	0x6e, 0xaa, 0x0b, 0xea, 0x8a, 0x72, 0x55, 0x45, 0xae, 0xea, 0xbe, 0xab, 0x3b, 0xed, 0x95, 0x67,
-	0xd3, 0x7a, 0x4a, 0x63, 0xde, 0xe8, 0x4d, 0x58, 0xb3, 0x69, 0x87, 0x62, 0x83, 0x38, 0xa6, 0xee,
-	0x1d, 0xc9, 0xe9, 0x86, 0xb4, 0x9e, 0xd7, 0x8a, 0x36, 0xdd, 0x0f, 0x4d, 0xe8, 0x0b, 0x00, 0xa3,
-	0x87, 0x8d, 0xa7, 0x2e, 0xb1, 0x1d, 0x5f, 0xce, 0xb0, 0x70, 0xb7, 0x93, 0x85, 0x7b, 0x10, 0xf9,
-	0x89, 0xa0, 0x67, 0x90, 0x94, 0x1f, 0x24, 0x40, 0x1a, 0x1e, 0x90, 0x11, 0xfe, 0x3f, 0xdb, 0x95,
-	0xfe, 0x37, 0xed, 0x52, 0x7e, 0x97, 0xa0, 0xb2, 0x69, 0x53, 0x57, 0xf7, 0x8d, 0x5e, 0x2c, 0xeb,
-	0x2f, 0xa1, 0xa0, 0x9b, 0x66, 0x87, 0x39, 0xb2, 0xb4, 0x8b, 0xad, 0xbb, 0x6a, 0x42, 0xaa, 0xa9,
-	0xe7, 0x18, 0xb3, 0x95, 0xd2, 0xf2, 0xba, 0x30, 0xa1, 0x6f, 0x60, 0xcd, 0x63, 0x4d, 0x12, 0xd8,
-	0x3c, 0xff, 0x7b, 0x89, 0xb1, 0x2f, 0x76, 0x78, 0x2b, 0xa5, 0x15, 0xbd, 0xb9, 0xb5, 0x5d, 0x80,
-	0x9c, 0xc7, 0x4f, 0x94, 0x9f, 0x24, 0x28, 0xcf, 0x93, 0xa1, 0x2e, 0x71, 0x28, 0x46, 0xdb, 0x90,
-	0xa5, 0xbe, 0xee, 0x0f, 0xa9, 0xa8, 0xeb, 0x4e, 0xb2, 0xde, 0x31, 0x90, 0x7d, 0xe6, 0xa8, 0x09,
-	0x80, 0x73, 0x54, 0x4a, 0xff, 0x67, 0x54, 0xfa, 0x59, 0x82, 0x57, 0x62, 0x85, 0xbe, 0x3c, 0xa9,
-	0x3f, 0x97, 0xe0, 0xfa, 0x39, 0x46, 0x89, 0xe4, 0xbf, 0xba, 0x48, 0xa9, 0x8f, 0x97, 0xa0, 0x14,
-	0x47, 0x8b, 0x71, 0x4a, 0x5f, 0xc8, 0xa9, 0x4f, 0x96, 0xe3, 0x54, 0x84, 0x1f, 0x23, 0x15, 0x40,
-	0xde, 0x13, 0x47, 0xca, 0x2f, 0x12, 0x14, 0xb6, 0xb0, 0xee, 0xf9, 0x5d, 0xac, 0xfb, 0x41, 0x59,
-	0xa1, 0xbe, 0x83, 0x6b, 0xc9, 0xac, 0x67, 0xda, 0xf7, 0x66, 0xd3, 0x7a, 0x5e, 0x28, 0x96, 0x5e,
-	0x56, 0xe1, 0x79, 0xa1, 0x70, 0x8a, 0xea, 0x50, 0x0c, 0x66, 0x99, 0x4f, 0xdc, 0xc0, 0x49, 0x8c,
-	0x32, 0xb0, 0xe9, 0xbe, 0xb0, 0xa0, 0xcf, 0x61, 0x35, 0x50, 0x31, 0x95, 0x33, 0x8d, 0xcc, 0x52,
-	0x43, 0x80, 0xbb, 0x2b, 0x3f, 0x4a, 0x70, 0x2d, 0x2a, 0x28, 0xba, 0xaf, 0x5d, 0xc8, 0x32, 0x17,
-	0x5e, 0xd5, 0x32, 0x64, 0x13, 0x51, 0x04, 0x0c, 0x7a, 0x04, 0xf9, 0xbe, 0x3d, 0xc2, 0x0e, 0xa6,
-	0x94, 0x15, 0xb3, 0xda, 0xbe, 0x7d, 0x3a, 0xad, 0xbf, 0x9b, 0xa4, 0x39, 0x8f, 0x84, 0x9f, 0x16,
-	0x21, 0x28, 0xb7, 0xe0, 0xca, 0xee, 0xa1, 0x83, 0x3d, 0x0d, 0x8f, 0x6c, 0x6a, 0x13, 0x07, 0x55,
-	0x83, 0x2b, 0xe2, 0x6b, 0x3e, 0x68, 0xb5, 0x68, 0xaf, 0xbc, 0x05, 0xa5, 0xbd, 0x30, 0xd3, 0x87,
-	0x2e, 0x31, 0x7a, 0xa8, 0x02, 0xab, 0x38, 0x58, 0xb0, 0x4f, 0x0b, 0x1a, 0xdf, 0x28, 0xbf, 0xe6,
-	0x20, 0xb7, 0x83, 0x29, 0xd5, 0x2d, 0x56, 0x7f, 0x0f, 0xeb, 0x26, 0xf6, 0x04, 0x59, 0x3f, 0x4a,
-	0xcc, 0x27, 0x81, 0xa0, 0x6e, 0x31, 0x77, 0x4d, 0xc0, 0xa0, 0x5d, 0xc8, 0x0f, 0xa8, 0xd5, 0xf1,
-	0x8f, 0x5c, 0x4e, 0xd1, 0x52, 0xeb, 0x83, 0xcb, 0x42, 0x3e, 0x3e, 0x72, 0xb1, 0x96, 0x1b, 0x50,
-	0x2b, 0x58, 0xa0, 0x87, 0xb0, 0x72, 0xe0, 0x91, 0x01, 0x7b, 0xc3, 0x0a, 0xed, 0x3b, 0xa7, 0xd3,
-	0xfa, 0x7b, 0x49, 0x9a, 0xf9, 0x40, 0x77, 0xfd, 0xa1, 0x17, 0x70, 0x8d, 0xb9, 0xa3, 0xfb, 0x90,
-	0xf6, 0x09, 0x7b, 0x77, 0x97, 0x02, 0x49, 0xfb, 0x04, 0x51, 0x78, 0xd5, 0x14, 0xa2, 0xe7, 0x1a,
-	0xec, 0x88, 0x11, 0x2c, 0xaf, 0xb2, 0xde, 0x7d, 0x9a, 0xb8, 0xd0, 0x45, 0xaf, 0x91, 0x56, 0x31,
-	0x17, 0xbd, 0x51, 0x23, 0xb8, 0x71, 0x21, 0x28, 0xe7, 0xae, 0x9c, 0x65, 0x51, 0x3f, 0x5b, 0x36,
-	0x2a, 0x47, 0xd1, 0xae, 0x9b, 0x0b, 0x07, 0xd9, 0x1e, 0x14, 0x7a, 0xa1, 0x5a, 0xe4, 0x1c, 0x8b,
-	0xd4, 0x4a, 0x1c, 0x69, 0xae, 0xb3, 0x39, 0x08, 0xb2, 0x01, 0x45, 0x9b, 0x79, 0x11, 0x79, 0x06,
-	0xbd, 0xb1, 0x04, 0x74, 0x58, 0xc0, 0xb5, 0xde, 0x79, 0x53, 0xf5, 0x4f, 0x09, 0xb2, 0x9c, 0x97,
-	0x48, 0x86, 0xdc, 0x08, 0x7b, 0x91, 0x5e, 0x0a, 0x5a, 0xb8, 0x45, 0x06, 0x94, 0x48, 0xa0, 0xad,
-	0x4e, 0x24, 0x28, 0x3e, 0x52, 0x3f, 0x4c, 0x9c, 0x4b, 0x4c, 0x9a, 0x62, 0x0e, 0x5c, 0x21, 0x31,
-	0xbd, 0x1e, 0xc0, 0xd5, 0x68, 0x7a, 0x74, 0xb8, 0x16, 0x33, 0x97, 0x14, 0x5a, 0x5c, 0xd3, 0x22,
-	0x4c, 0xc9, 0x8d, 0x59, 0x6f, 0xfe, 0x25, 0x41, 0xf1, 0x8c, 0x7c, 0x50, 0x0d, 0x60, 0x87, 0x5a,
-	0x4f, 0x9c, 0xa7, 0x0e, 0x39, 0x74, 0xca, 0xa9, 0x6a, 0x69, 0x3c, 0x69, 0x9c, 0xb1, 0xa0, 0xbb,
-	0x70, 0x63, 0x87, 0x5a, 0x8b, 0x78, 0x58, 0x96, 0xaa, 0xaf, 0x8d, 0x27, 0x8d, 0x17, 0x1d, 0xa3,
-	0x0d, 0x90, 0x2f, 0x1e, 0xf1, 0xbe, 0x97, 0xd3, 0xd5, 0xd7, 0xc7, 0x93, 0xc6, 0x0b, 0xcf, 0x91,
-	0x02, 0x6b, 0x3b, 0xd4, 0x8a, 0xae, 0xb0, 0x9c, 0xa9, 0x96, 0xc7, 0x93, 0x46, 0xcc, 0x86, 0x5a,
-	0x50, 0x39, 0xbb, 0x8f, 0xb0, 0x57, 0xaa, 0xf2, 0x78, 0xd2, 0x58, 0x78, 0xd6, 0xde, 0x3b, 0xfe,
-	0xa3, 0x96, 0x7a, 0x36, 0xab, 0x49, 0xc7, 0xb3, 0x9a, 0xf4, 0x7c, 0x56, 0x93, 0xbe, 0x3b, 0xa9,
-	0xa5, 0x8e, 0x4f, 0x6a, 0xa9, 0xdf, 0x4e, 0x6a, 0xa9, 0xaf, 0x5b, 0xff, 0x2c, 0xf5, 0x45, 0xbf,
-	0x21, 0xdd, 0x2c, 0xfb, 0x35, 0x78, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0xa2, 0xb6,
-	0xc9, 0xa5, 0x0c, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x6c, 0x27, 0x96, 0x9f, 0x93, 0xd4, 0x5d, 0x52, 0xaa, 0x31, 0x60, 0x0b, 0x1d, 0x4a,
+	0x48, 0x41, 0x6e, 0x0d, 0x03, 0x25, 0x05, 0x66, 0xea, 0xa6, 0x4c, 0x32, 0xd3, 0x4c, 0x32, 0x4a,
+	0x0a, 0x0c, 0x17, 0xa3, 0x48, 0x1b, 0x59, 0x34, 0xd6, 0x0a, 0xad, 0x92, 0x4c, 0xbe, 0x42, 0x4e,
+	0x7c, 0x01, 0x0f, 0xdf, 0x81, 0x13, 0x37, 0x8e, 0xf4, 0x98, 0xde, 0x38, 0x30, 0x9e, 0xe2, 0x7c,
+	0x00, 0xee, 0xe1, 0xc2, 0x68, 0x77, 0x25, 0xd9, 0x89, 0xcb, 0x28, 0x86, 0x61, 0xa6, 0x37, 0xed,
+	0x7b, 0x7a, 0xbf, 0xf7, 0x67, 0x7f, 0xef, 0x67, 0x0b, 0xde, 0xa5, 0x56, 0x17, 0xdb, 0x07, 0xfb,
+	0x38, 0x68, 0xc6, 0x4f, 0xfe, 0x6e, 0x33, 0x34, 0x77, 0xf7, 0x71, 0x27, 0x36, 0xe8, 0x7e, 0x40,
+	0x42, 0x82, 0xde, 0xf1, 0x5d, 0xcf, 0xb1, 0x4c, 0x5f, 0x0f, 0xdd, 0xbd, 0x7d, 0x72, 0xa4, 0x5b,
+	0xb6, 0xa5, 0x27, 0xd1, 0x7a, 0x1a, 0x5d, 0x5b, 0x74, 0x88, 0x43, 0x58, 0x4c, 0x33, 0x7a, 0xe2,
+	0xe1, 0xb5, 0xb7, 0xfc, 0x80, 0x58, 0x98, 0x52, 0x12, 0x70, 0xf8, 0x38, 0x0d, 0x77, 0x6b, 0xbf,
+	0xe4, 0xe1, 0xda, 0x03, 0xdb, 0xde, 0x89, 0x4c, 0x06, 0xfe, 0xfe, 0x00, 0xd3, 0x10, 0x3d, 0x01,
+	0x99, 0x57, 0xe2, 0xda, 0x8a, 0xa4, 0x4a, 0x4b, 0x85, 0xf6, 0xca, 0x70, 0xd0, 0x28, 0xb1, 0x77,
+	0xd6, 0x57, 0xcf, 0x07, 0x8d, 0xdb, 0x8e, 0x1b, 0x76, 0x0f, 0x76, 0x75, 0x8b, 0xf4, 0x9a, 0xa2,
+	0xba, 0x26, 0xaf, 0xae, 0x69, 0xd9, 0x56, 0xb3, 0x47, 0x6c, 0xbc, 0xaf, 0x8b, 0xd7, 0x8d, 0x12,
+	0xc3, 0x5a, 0xb7, 0xd1, 0x2a, 0x14, 0xa9, 0x6f, 0x7a, 0x4a, 0x51, 0x95, 0x96, 0x2a, 0xad, 0x65,
+	0x7d, 0x42, 0x5f, 0x49, 0xad, 0xba, 0xa8, 0x55, 0xdf, 0xf6, 0x4d, 0xaf, 0x5d, 0x7c, 0x36, 0x68,
+	0xe4, 0x0c, 0x16, 0x8d, 0xde, 0x86, 0x39, 0x97, 0x76, 0x28, 0xb6, 0x88, 0x67, 0x9b, 0xc1, 0xb1,
+	0x92, 0x57, 0xa5, 0x25, 0xd9, 0xa8, 0xb8, 0x74, 0x3b, 0x36, 0xa1, 0x2f, 0x01, 0xac, 0x2e, 0xb6,
+	0x9e, 0xfa, 0xc4, 0xf5, 0x42, 0xa5, 0xc0, 0xd2, 0xdd, 0xc9, 0x96, 0xee, 0x61, 0x12, 0x27, 0x92,
+	0x8e, 0x20, 0xa1, 0x45, 0x98, 0xc1, 0x3e, 0xb1, 0xba, 0xca, 0x8c, 0x2a, 0x2d, 0x15, 0x0d, 0x7e,
+	0xd0, 0x7e, 0x95, 0x00, 0x19, 0xb8, 0x47, 0x0e, 0xf1, 0xff, 0x39, 0xc4, 0xfc, 0xbf, 0x1a, 0x62,
+	0xd2, 0x49, 0x61, 0xb4, 0x93, 0xdf, 0x25, 0x58, 0x5c, 0x75, 0xa9, 0x6f, 0x86, 0x56, 0x77, 0xac,
+	0x97, 0xaf, 0xa0, 0x6c, 0xda, 0x76, 0x87, 0xc1, 0xb1, 0x66, 0x2a, 0xad, 0x7b, 0x7a, 0x46, 0x5a,
+	0xea, 0x17, 0xd8, 0xb5, 0x96, 0x33, 0x64, 0x53, 0x98, 0xd0, 0xb7, 0x30, 0x17, 0xb0, 0xd1, 0x09,
+	0x6c, 0xde, 0xd5, 0xfd, 0xcc, 0xd8, 0x97, 0xe7, 0xbe, 0x96, 0x33, 0x2a, 0x41, 0x6a, 0x6d, 0x97,
+	0xa1, 0x14, 0x70, 0x8f, 0xf6, 0x5c, 0x82, 0x6a, 0x5a, 0x0c, 0xf5, 0x89, 0x47, 0x31, 0x5a, 0x87,
+	0x59, 0x1a, 0x9a, 0xe1, 0x01, 0x15, 0x7d, 0xdd, 0xcd, 0x36, 0x51, 0x06, 0xb2, 0xcd, 0x02, 0x0d,
+	0x01, 0x70, 0x81, 0x76, 0xf9, 0xff, 0x8c, 0x76, 0x35, 0x90, 0x03, 0xfc, 0x1d, 0xb6, 0x42, 0x6c,
+	0xb3, 0xfb, 0x92, 0x8d, 0xe4, 0xac, 0xfd, 0x2c, 0xc1, 0x6b, 0x63, 0x43, 0x78, 0x65, 0xda, 0xd2,
+	0x5e, 0x48, 0x70, 0xe3, 0x02, 0xdb, 0x44, 0xf1, 0x5f, 0x5f, 0xa6, 0xdb, 0x27, 0x53, 0xd0, 0x8d,
+	0xa3, 0x8d, 0xf1, 0xcd, 0x9c, 0xc8, 0xb7, 0x4f, 0xa7, 0xe3, 0x5b, 0x82, 0x3f, 0x46, 0x38, 0x88,
+	0x6e, 0x8b, 0xbb, 0xb4, 0x1f, 0xf3, 0x50, 0x5e, 0xc3, 0x66, 0x10, 0xee, 0x62, 0x33, 0x8c, 0xda,
+	0x8a, 0x15, 0x21, 0xba, 0x96, 0xc2, 0x52, 0xa1, 0x7d, 0x7f, 0x38, 0x68, 0xc8, 0x62, 0xc7, 0xe9,
+	0x55, 0x35, 0x41, 0x16, 0x9a, 0x40, 0x51, 0x03, 0x2a, 0x91, 0x26, 0x86, 0xc4, 0x8f, 0x82, 0x84,
+	0x24, 0x82, 0x4b, 0xb7, 0x85, 0x05, 0x7d, 0x01, 0x33, 0xd1, 0xde, 0x53, 0xa5, 0xa0, 0x16, 0xa6,
+	0x92, 0x0d, 0x1e, 0x8e, 0x36, 0x61, 0x3e, 0xbd, 0xc1, 0x4e, 0x48, 0x99, 0x96, 0x17, 0xdb, 0xcb,
+	0xe7, 0x83, 0xc6, 0xad, 0x4c, 0xa5, 0x53, 0x63, 0x2e, 0x05, 0xd8, 0xa1, 0xda, 0x4f, 0x12, 0x5c,
+	0x4f, 0x26, 0x94, 0x10, 0x60, 0x13, 0x66, 0x59, 0x0d, 0x7c, 0x4c, 0xd3, 0xb0, 0x57, 0x94, 0x2d,
+	0x60, 0xd0, 0x63, 0x90, 0xf7, 0xdd, 0x43, 0xec, 0x61, 0x4a, 0xd9, 0x74, 0x66, 0xda, 0x77, 0xce,
+	0x07, 0x8d, 0xf7, 0xb2, 0x94, 0xfc, 0x58, 0xc4, 0x19, 0x09, 0x82, 0x76, 0x1b, 0xe6, 0x37, 0x8f,
+	0x3c, 0x1c, 0x18, 0xf8, 0xd0, 0xa5, 0x2e, 0xf1, 0xf8, 0x86, 0xf2, 0x67, 0xae, 0xf5, 0x46, 0x72,
+	0xd6, 0x6e, 0xc1, 0xc2, 0x56, 0x5c, 0xe9, 0xa3, 0x48, 0x66, 0x53, 0xf1, 0x8d, 0x5e, 0x2d, 0xc7,
+	0xe2, 0xfb, 0xbc, 0x04, 0xa5, 0x0d, 0x4c, 0xa9, 0xe9, 0xb0, 0xfe, 0xbb, 0xd8, 0xb4, 0x71, 0x20,
+	0xd8, 0xff, 0x71, 0x66, 0x82, 0x0a, 0x04, 0x7d, 0x8d, 0x85, 0x1b, 0x02, 0x06, 0x6d, 0x82, 0xdc,
+	0xa3, 0x4e, 0x27, 0x3c, 0xf6, 0x39, 0xe7, 0x17, 0x5a, 0x1f, 0x5e, 0x15, 0x72, 0xe7, 0xd8, 0xc7,
+	0x46, 0xa9, 0x47, 0x9d, 0xe8, 0x01, 0x3d, 0x82, 0xe2, 0x5e, 0x40, 0x7a, 0x4c, 0x8f, 0xca, 0xed,
+	0xbb, 0xe7, 0x83, 0xc6, 0xfb, 0x59, 0x86, 0xf9, 0xd0, 0xf4, 0xc3, 0x83, 0x20, 0x22, 0x2f, 0x0b,
+	0x47, 0x0f, 0x20, 0x1f, 0x12, 0xa5, 0x38, 0x2d, 0x48, 0x3e, 0x24, 0x88, 0xc2, 0xeb, 0xb6, 0x50,
+	0x11, 0xbe, 0xd4, 0x1d, 0xa1, 0xf7, 0xec, 0x57, 0xba, 0xd2, 0xfa, 0x2c, 0x73, 0xa3, 0x93, 0x7e,
+	0xfa, 0x8c, 0x45, 0x7b, 0x82, 0x15, 0x1d, 0xc2, 0xcd, 0x4b, 0x49, 0x39, 0x77, 0x95, 0x59, 0x96,
+	0xf5, 0xf3, 0x69, 0xb3, 0x72, 0x14, 0xe3, 0x86, 0x3d, 0xc9, 0x8c, 0xb6, 0xa0, 0xdc, 0x8d, 0xb7,
+	0x45, 0x29, 0xb1, 0x4c, 0xad, 0xcc, 0x99, 0xd2, 0x3d, 0x4b, 0x41, 0x90, 0x0b, 0x28, 0x39, 0xa4,
+	0x4d, 0xc8, 0x0c, 0x7a, 0x65, 0x0a, 0xe8, 0xb8, 0x81, 0xeb, 0xdd, 0x8b, 0xa6, 0xda, 0x9f, 0x12,
+	0xcc, 0x72, 0x5e, 0x22, 0x05, 0x4a, 0x87, 0x38, 0x48, 0xf6, 0xa5, 0x6c, 0xc4, 0x47, 0x64, 0xc1,
+	0x02, 0x89, 0x76, 0xab, 0x93, 0x2c, 0x14, 0xd7, 0xe8, 0x8f, 0x32, 0xd7, 0x32, 0xb6, 0x9a, 0x42,
+	0x07, 0xe6, 0xc9, 0xd8, 0xbe, 0xee, 0xc1, 0xb5, 0x44, 0x3d, 0x3a, 0xe9, 0x1f, 0xa1, 0xab, 0x2c,
+	0xda, 0xf8, 0x4e, 0x8b, 0x34, 0x0b, 0xfe, 0x98, 0x75, 0xf9, 0x2f, 0x09, 0x2a, 0x23, 0xeb, 0x83,
+	0xea, 0x00, 0x1b, 0xd4, 0x79, 0xe2, 0x3d, 0xf5, 0xc8, 0x91, 0x57, 0xcd, 0xd5, 0x16, 0x4e, 0xfa,
+	0xea, 0x88, 0x05, 0xdd, 0x83, 0x9b, 0x1b, 0xd4, 0x99, 0xc4, 0xc3, 0xaa, 0x54, 0x7b, 0xe3, 0xa4,
+	0xaf, 0xbe, 0xcc, 0x8d, 0x56, 0x40, 0xb9, 0xec, 0xe2, 0x73, 0xaf, 0xe6, 0x6b, 0x6f, 0x9e, 0xf4,
+	0xd5, 0x97, 0xfa, 0x91, 0x06, 0x73, 0x1b, 0xd4, 0x49, 0xae, 0xb0, 0x5a, 0xa8, 0x55, 0x4f, 0xfa,
+	0xea, 0x98, 0x0d, 0xb5, 0x60, 0x71, 0xf4, 0x9c, 0x60, 0x17, 0x6b, 0xca, 0x49, 0x5f, 0x9d, 0xe8,
+	0x6b, 0x6f, 0x9d, 0xfe, 0x51, 0xcf, 0x3d, 0x1b, 0xd6, 0xa5, 0xd3, 0x61, 0x5d, 0x7a, 0x31, 0xac,
+	0x4b, 0x3f, 0x9c, 0xd5, 0x73, 0xa7, 0x67, 0xf5, 0xdc, 0x6f, 0x67, 0xf5, 0xdc, 0x37, 0xad, 0x7f,
+	0x5e, 0xf5, 0x49, 0xdf, 0x47, 0xbb, 0xb3, 0xec, 0x9b, 0xe5, 0x83, 0xbf, 0x07, 0x00, 0xa5, 0x9c,
+	0x61, 0xee, 0x3e, 0x0d, 0x00, 0x00,
 }
 
 func (m *AddTableRequest) Marshal() (dAtA []byte, err error) {
@@ -923,6 +964,11 @@ func (m *AddTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintTableSchedule(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -981,6 +1027,11 @@ func (m *RemoveTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintTableSchedule(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1093,6 +1144,16 @@ func (m *AddTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rejected {
+		i--
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1257,6 +1318,11 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointTs != 0 {
+		i = encodeVarintTableSchedule(dAtA, i, uint64(m.CheckpointTs))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Spans) > 0 {
 		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1582,6 +1648,9 @@ func (m *AddTableRequest) Size() (n int) {
 	n += 1 + l + sovTableSchedule(uint64(l))
 	l = m.Span.Size()
 	n += 1 + l + sovTableSchedule(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovTableSchedule(uint64(m.Epoch))
+	}
 	return n
 }
 
@@ -1596,6 +1665,9 @@ func (m *RemoveTableRequest) Size() (n int) {
 	}
 	l = m.Span.Size()
 	n += 1 + l + sovTableSchedule(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovTableSchedule(uint64(m.Epoch))
+	}
 	return n
 }
 
@@ -1647,6 +1719,9 @@ func (m *AddTableResponse) Size() (n int) {
 	}
 	l = m.Checkpoint.Size()
 	n += 1 + l + sovTableSchedule(uint64(l))
+	if m.Rejected {
+		n += 2
+	}
 	return n
 }
 
@@ -1723,6 +1798,9 @@ func (m *Heartbeat) Size() (n int) {
 			n += 1 + l + sovTableSchedule(uint64(l))
 		}
 	}
+	if m.CheckpointTs != 0 {
+		n += 1 + sovTableSchedule(uint64(m.CheckpointTs))
+	}
 	return n
 }
 
@@ -1966,6 +2044,25 @@ func (m *AddTableRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -2068,6 +2165,25 @@ func (m *RemoveTableRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -2307,6 +2423,26 @@ func (m *AddTableResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rejected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -2726,6 +2862,25 @@ func (m *Heartbeat) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			}
+			m.CheckpointTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointTs |= github_com_pingcap_tiflow_cdc_model.Ts(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/fsutil"
 	"github.com/pingcap/tiflow/pkg/p2p"
 	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/tcpserver"
 	p2pProto "github.com/pingcap/tiflow/proto/p2p"
 	pd "github.com/tikv/pd/client"
@@ -84,6 +85,8 @@ type server struct {
 	statusServer *http.Server
 	etcdClient   etcd.CDCEtcdClient
 	pdEndpoints  []string
+	// certReloader reloads the TLS certificates, it's nil if TLS is disabled.
+	certReloader *security.CertReloader
 
 	tableActorSystem *system.System
 
@@ -106,6 +109,17 @@ func New(pdEndpoints []string) (*server, error) {
 		}
 	}
 
+	// The certificates can be rotated without restarting, new connections
+	// use the reloaded ones.
+	var certReloader *security.CertReloader
+	if conf.Security.IsTLSEnabled() {
+		var err error
+		certReloader, err = conf.Security.EnableCertReload()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	// tcpServer is the unified frontend of the CDC server that serves
 	// both RESTful APIs and gRPC APIs.
 	// Note that we pass the TLS config to the tcpServer, so there is no need to
@@ -124,6 +138,8 @@ func New(pdEndpoints []string) (*server, error) {
 		grpcService: p2p.NewServerWrapper(),
 		tcpServer:   tcpServer,
 
+		certReloader: certReloader,
+
 		useEventSortEngine: useEventSortEngine,
 	}
 
@@ -370,6 +386,12 @@ func (s *server) run(ctx context.Context) (err error) {
 
 	conf := config.GetGlobalServerConfig()
 
+	if s.certReloader != nil {
+		wg.Go(func() error {
+			return s.certReloader.Run(cctx, time.Duration(conf.CertReloadInterval))
+		})
+	}
+
 	if !conf.Debug.EnableDBSorter {
 		wg.Go(func() error {
 			return unified.RunWorkerPool(cctx)
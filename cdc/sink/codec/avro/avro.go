@@ -194,6 +194,7 @@ func (a *BatchEncoder) avroEncode(
 	avroCodec, registryID, err := schemaManager.GetCachedOrRegister(
 		ctx,
 		topic,
+		namespace+"."+sanitizeName(e.Table.Table),
 		e.TableInfo.Version,
 		schemaGen,
 	)
@@ -795,7 +796,7 @@ const (
 func NewBatchEncoderBuilder(ctx context.Context, config *common.Config) (codec.EncoderBuilder, error) {
 	keySchemaManager, err := NewAvroSchemaManager(
 		ctx,
-		nil,
+		config.AvroSchemaRegistryConfig,
 		config.AvroSchemaRegistry,
 		keySchemaSuffix,
 	)
@@ -805,7 +806,7 @@ func NewBatchEncoderBuilder(ctx context.Context, config *common.Config) (codec.E
 
 	valueSchemaManager, err := NewAvroSchemaManager(
 		ctx,
-		nil,
+		config.AvroSchemaRegistryConfig,
 		config.AvroSchemaRegistry,
 		valueSchemaSuffix,
 	)
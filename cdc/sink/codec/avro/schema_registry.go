@@ -20,6 +20,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/linkedin/goavro/v2"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/httputil"
 	"github.com/pingcap/tiflow/pkg/security"
@@ -40,8 +42,12 @@ import (
 type schemaManager struct {
 	registryURL   string
 	subjectSuffix string
+	// subjectNameStrategy is only applied to the value schemas, the key
+	// schemas always use the topic name strategy.
+	subjectNameStrategy string
 
-	credential *security.Credential // placeholder, currently always nil
+	credential    *security.Credential
+	authorization string
 
 	cacheRWLock sync.RWMutex
 	cache       map[string]*schemaCacheEntry
@@ -69,16 +75,45 @@ type lookupResponse struct {
 	Schema     string `json:"schema"`
 }
 
-// NewAvroSchemaManager creates a new schemaManager and test connectivity to the schema registry
+// errorResponse is the error body returned by the Confluent compatible registries.
+type errorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// NewAvroSchemaManager creates a new schemaManager and test connectivity to the schema registry.
+// A nil registryConfig means anonymous access and the topic name strategy.
 func NewAvroSchemaManager(
-	ctx context.Context, credential *security.Credential, registryURL string, subjectSuffix string,
+	ctx context.Context,
+	registryConfig *config.SchemaRegistryConfig,
+	registryURL string,
+	subjectSuffix string,
 ) (*schemaManager, error) {
 	registryURL = strings.TrimRight(registryURL, "/")
+	credential := &security.Credential{}
+	authorization := ""
+	subjectNameStrategy := config.SubjectNameStrategyTopic
+	if registryConfig != nil {
+		credential.CAPath = registryConfig.CAPath
+		credential.CertPath = registryConfig.CertPath
+		credential.KeyPath = registryConfig.KeyPath
+		authorization = getAuthorization(registryConfig)
+		if registryConfig.SubjectNameStrategy != "" {
+			subjectNameStrategy = registryConfig.SubjectNameStrategy
+		}
+	}
 	httpCli, err := httputil.NewClient(credential)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	resp, err := httpCli.Get(ctx, registryURL)
+	req, err := http.NewRequestWithContext(ctx, "GET", registryURL, nil)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := httpCli.Do(req)
 	if err != nil {
 		log.Error("Test connection to Schema Registry failed", zap.Error(err))
 		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
@@ -91,6 +126,11 @@ func NewAvroSchemaManager(
 		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, cerror.ErrAvroSchemaRegistryUnauthorized.GenWithStackByArgs(
+			resp.StatusCode, parseErrorMessage(text))
+	}
+
 	if string(text[:]) != "{}" {
 		return nil, cerror.ErrAvroSchemaAPIError.GenWithStack(
 			"Unexpected response from Schema Registry",
@@ -103,16 +143,33 @@ func NewAvroSchemaManager(
 	)
 
 	return &schemaManager{
-		registryURL:   registryURL,
-		cache:         make(map[string]*schemaCacheEntry, 1),
-		subjectSuffix: subjectSuffix,
+		registryURL:         registryURL,
+		cache:               make(map[string]*schemaCacheEntry, 1),
+		subjectSuffix:       subjectSuffix,
+		subjectNameStrategy: subjectNameStrategy,
+		credential:          credential,
+		authorization:       authorization,
 	}, nil
 }
 
-// Register a schema in schema registry, no cache
+func getAuthorization(registryConfig *config.SchemaRegistryConfig) string {
+	if registryConfig.BearerToken != "" {
+		return "Bearer " + registryConfig.BearerToken
+	}
+	if registryConfig.Username != "" {
+		req := &http.Request{Header: make(http.Header)}
+		req.SetBasicAuth(registryConfig.Username, registryConfig.Password)
+		return req.Header.Get("Authorization")
+	}
+	return ""
+}
+
+// Register a schema in schema registry, no cache.
+// recordName is the fully-qualified name of the record in the schema.
 func (m *schemaManager) Register(
 	ctx context.Context,
 	topicName string,
+	recordName string,
 	codec *goavro.Codec,
 ) (int, error) {
 	// The Schema Registry expects the JSON to be without newline characters
@@ -130,9 +187,8 @@ func (m *schemaManager) Register(
 		log.Error("Could not marshal request to the Registry", zap.Error(err))
 		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
-	uri := m.registryURL + "/subjects/" + url.QueryEscape(
-		m.topicNameToSchemaSubject(topicName),
-	) + "/versions"
+	subject := m.schemaSubject(topicName, recordName)
+	uri := m.registryURL + "/subjects/" + url.QueryEscape(subject) + "/versions"
 	log.Info("Registering schema", zap.String("uri", uri), zap.ByteString("payload", payload))
 
 	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewReader(payload))
@@ -146,7 +202,7 @@ func (m *schemaManager) Register(
 			"application/json",
 	)
 	req.Header.Add("Content-Type", "application/vnd.schemaregistry.v1+json")
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := m.httpRetry(ctx, req)
 	if err != nil {
 		return 0, err
 	}
@@ -169,6 +225,15 @@ func (m *schemaManager) Register(
 			zap.ByteString("requestBody", payload),
 			zap.ByteString("responseBody", body),
 		)
+		switch resp.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return 0, cerror.ErrAvroSchemaRegistryUnauthorized.GenWithStackByArgs(
+				resp.StatusCode, parseErrorMessage(body))
+		case http.StatusConflict:
+			msg := parseErrorMessage(body)
+			return 0, cerror.ErrAvroSchemaIncompatible.GenWithStackByArgs(
+				subject, parseIncompatibleFields(msg), msg)
+		}
 		return 0, cerror.ErrAvroSchemaAPIError.GenWithStackByArgs()
 	}
 
@@ -204,9 +269,10 @@ func (m *schemaManager) Register(
 func (m *schemaManager) Lookup(
 	ctx context.Context,
 	topicName string,
+	recordName string,
 	tiSchemaID uint64,
 ) (*goavro.Codec, int, error) {
-	key := m.topicNameToSchemaSubject(topicName)
+	key := m.schemaSubject(topicName, recordName)
 	m.cacheRWLock.RLock()
 	if entry, exists := m.cache[key]; exists && entry.tiSchemaID == tiSchemaID {
 		log.Info("Avro schema lookup cache hit",
@@ -236,7 +302,7 @@ func (m *schemaManager) Lookup(
 			"application/json",
 	)
 
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := m.httpRetry(ctx, req)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -253,6 +319,10 @@ func (m *schemaManager) Lookup(
 			zap.Int("status", resp.StatusCode),
 			zap.String("uri", uri),
 			zap.ByteString("responseBody", body))
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return nil, 0, cerror.ErrAvroSchemaRegistryUnauthorized.GenWithStackByArgs(
+				resp.StatusCode, parseErrorMessage(body))
+		}
 		return nil, 0, cerror.ErrAvroSchemaAPIError.GenWithStack(
 			"Failed to query schema from the Registry, HTTP error",
 		)
@@ -306,10 +376,11 @@ type SchemaGenerator func() (string, error)
 func (m *schemaManager) GetCachedOrRegister(
 	ctx context.Context,
 	topicName string,
+	recordName string,
 	tiSchemaID uint64,
 	schemaGen SchemaGenerator,
 ) (*goavro.Codec, int, error) {
-	key := m.topicNameToSchemaSubject(topicName)
+	key := m.schemaSubject(topicName, recordName)
 	m.cacheRWLock.RLock()
 	if entry, exists := m.cache[key]; exists && entry.tiSchemaID == tiSchemaID {
 		log.Debug("Avro schema GetCachedOrRegister cache hit",
@@ -336,7 +407,7 @@ func (m *schemaManager) GetCachedOrRegister(
 		return nil, 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
-	id, err := m.Register(ctx, topicName, codec)
+	id, err := m.Register(ctx, topicName, recordName, codec)
 	if err != nil {
 		log.Error("GetCachedOrRegister: Could not register schema", zap.Error(err))
 		return nil, 0, errors.Trace(err)
@@ -362,9 +433,11 @@ func (m *schemaManager) GetCachedOrRegister(
 // ClearRegistry clears the Registry subject for the given table. Should be idempotent.
 // Exported for testing.
 // NOT USED for now, reserved for future use.
-func (m *schemaManager) ClearRegistry(ctx context.Context, topicName string) error {
+func (m *schemaManager) ClearRegistry(
+	ctx context.Context, topicName string, recordName string,
+) error {
 	uri := m.registryURL + "/subjects/" + url.QueryEscape(
-		m.topicNameToSchemaSubject(topicName),
+		m.schemaSubject(topicName, recordName),
 	)
 	req, err := http.NewRequestWithContext(ctx, "DELETE", uri, nil)
 	if err != nil {
@@ -376,7 +449,7 @@ func (m *schemaManager) ClearRegistry(ctx context.Context, topicName string) err
 		"application/vnd.schemaregistry.v1+json, application/vnd.schemaregistry+json, "+
 			"application/json",
 	)
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := m.httpRetry(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -402,6 +475,16 @@ func (m *schemaManager) ClearRegistry(ctx context.Context, topicName string) err
 	)
 }
 
+func (m *schemaManager) httpRetry(
+	ctx context.Context,
+	r *http.Request,
+) (*http.Response, error) {
+	if m.authorization != "" {
+		r.Header.Set("Authorization", m.authorization)
+	}
+	return httpRetry(ctx, m.credential, r)
+}
+
 func httpRetry(
 	ctx context.Context,
 	credential *security.Credential,
@@ -459,7 +542,53 @@ func httpRetry(
 	return resp, nil
 }
 
-// TopicNameStrategy, ksqlDB only supports this
-func (m *schemaManager) topicNameToSchemaSubject(topicName string) string {
-	return topicName + m.subjectSuffix
+// schemaSubject returns the subject of the schema according to the subject
+// name strategy. TopicNameStrategy is the default one, ksqlDB only supports it.
+func (m *schemaManager) schemaSubject(topicName string, recordName string) string {
+	switch m.subjectNameStrategy {
+	case config.SubjectNameStrategyRecord:
+		return recordName
+	case config.SubjectNameStrategyTopicRecord:
+		return topicName + "-" + recordName
+	default:
+		return topicName + m.subjectSuffix
+	}
+}
+
+// parseErrorMessage extracts the message from the error response of the
+// registry, the raw body is returned if it's not a Confluent style error.
+func parseErrorMessage(body []byte) string {
+	var resp errorResponse
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Message == "" {
+		return string(body)
+	}
+	return resp.Message
+}
+
+// incompatibleFieldRegexps match the field names in the incompatibility
+// messages of Confluent Schema Registry, such as
+// `... {errorType:'READER_FIELD_MISSING_DEFAULT_VALUE', description:'The field 'a' at path '/fields/1' ...', additionalInfo:'a'} ...`.
+var incompatibleFieldRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`additionalInfo:'([^']+)'`),
+	regexp.MustCompile(`[Tt]he field '([^']+)'`),
+}
+
+// parseIncompatibleFields returns the names of the incompatible fields in
+// the message, nil if none can be found.
+func parseIncompatibleFields(msg string) []string {
+	var fields []string
+	seen := make(map[string]struct{})
+	for _, re := range incompatibleFieldRegexps {
+		for _, match := range re.FindAllStringSubmatch(msg, -1) {
+			if _, ok := seen[match[1]]; ok {
+				continue
+			}
+			seen[match[1]] = struct{}{}
+			fields = append(fields, match[1])
+		}
+		if len(fields) > 0 {
+			return fields
+		}
+	}
+	return fields
 }
@@ -25,6 +25,8 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -173,10 +175,10 @@ func TestSchemaRegistry(t *testing.T) {
 
 	topic := "cdctest"
 
-	err = manager.ClearRegistry(getTestingContext(), topic)
+	err = manager.ClearRegistry(getTestingContext(), topic, "test")
 	require.NoError(t, err)
 
-	_, _, err = manager.Lookup(getTestingContext(), topic, 1)
+	_, _, err = manager.Lookup(getTestingContext(), topic, "test", 1)
 	require.Regexp(t, `.*not\sfound.*`, err)
 
 	codec, err := goavro.NewCodec(`{
@@ -192,12 +194,12 @@ func TestSchemaRegistry(t *testing.T) {
      }`)
 	require.NoError(t, err)
 
-	_, err = manager.Register(getTestingContext(), topic, codec)
+	_, err = manager.Register(getTestingContext(), topic, "test", codec)
 	require.NoError(t, err)
 
 	var id int
 	for i := 0; i < 2; i++ {
-		_, id, err = manager.Lookup(getTestingContext(), topic, 1)
+		_, id, err = manager.Lookup(getTestingContext(), topic, "test", 1)
 		require.NoError(t, err)
 		require.Greater(t, id, 0)
 	}
@@ -222,10 +224,10 @@ func TestSchemaRegistry(t *testing.T) {
           ]
      }`)
 	require.NoError(t, err)
-	_, err = manager.Register(getTestingContext(), topic, codec)
+	_, err = manager.Register(getTestingContext(), topic, "test", codec)
 	require.NoError(t, err)
 
-	codec2, id2, err := manager.Lookup(getTestingContext(), topic, 999)
+	codec2, id2, err := manager.Lookup(getTestingContext(), topic, "test", 999)
 	require.NoError(t, err)
 	require.NotEqual(t, id, id2)
 	require.Equal(t, codec.CanonicalSchema(), codec2.CanonicalSchema())
@@ -257,7 +259,7 @@ func TestSchemaRegistryIdempotent(t *testing.T) {
 	topic := "cdctest"
 
 	for i := 0; i < 20; i++ {
-		err = manager.ClearRegistry(getTestingContext(), topic)
+		err = manager.ClearRegistry(getTestingContext(), topic, "test")
 		require.NoError(t, err)
 	}
 
@@ -284,7 +286,7 @@ func TestSchemaRegistryIdempotent(t *testing.T) {
 
 	id := 0
 	for i := 0; i < 20; i++ {
-		id1, err := manager.Register(getTestingContext(), topic, codec)
+		id1, err := manager.Register(getTestingContext(), topic, "test", codec)
 		require.NoError(t, err)
 		require.True(t, id == 0 || id == id1)
 		id = id1
@@ -330,18 +332,18 @@ func TestGetCachedOrRegister(t *testing.T) {
 	}
 	topic := "cdctest"
 
-	codec, id, err := manager.GetCachedOrRegister(getTestingContext(), topic, 1, schemaGen)
+	codec, id, err := manager.GetCachedOrRegister(getTestingContext(), topic, "test1", 1, schemaGen)
 	require.NoError(t, err)
 	require.Greater(t, id, 0)
 	require.NotNil(t, codec)
 	require.Equal(t, 1, called)
 
-	codec1, _, err := manager.GetCachedOrRegister(getTestingContext(), topic, 1, schemaGen)
+	codec1, _, err := manager.GetCachedOrRegister(getTestingContext(), topic, "test1", 1, schemaGen)
 	require.NoError(t, err)
 	require.True(t, codec == codec1) // check identity
 	require.Equal(t, 1, called)
 
-	codec2, _, err := manager.GetCachedOrRegister(getTestingContext(), topic, 2, schemaGen)
+	codec2, _, err := manager.GetCachedOrRegister(getTestingContext(), topic, "test1", 2, schemaGen)
 	require.NoError(t, err)
 	require.NotEqual(t, codec, codec2)
 	require.Equal(t, 2, called)
@@ -378,6 +380,7 @@ func TestGetCachedOrRegister(t *testing.T) {
 				codec, id, err := manager.GetCachedOrRegister(
 					getTestingContext(),
 					topic,
+					"test1",
 					uint64(finalI),
 					schemaGen,
 				)
@@ -407,3 +410,170 @@ func TestHTTPRetry(t *testing.T) {
 	require.Equal(t, 200, resp.StatusCode)
 	_ = resp.Body.Close()
 }
+
+const testingAuthRegistryURL = "http://127.0.0.1:8082"
+
+// startHTTPInterceptForTestingAuthRegistry mocks a registry which requires
+// the given Authorization header, and records the subjects registered.
+func startHTTPInterceptForTestingAuthRegistry(authorization string, subjects *[]string) {
+	httpmock.Activate()
+
+	checkAuth := func(req *http.Request) *http.Response {
+		if req.Header.Get("Authorization") == authorization {
+			return nil
+		}
+		resp, _ := httpmock.NewJsonResponse(401, &errorResponse{
+			ErrorCode: 401,
+			Message:   "Unauthorized",
+		})
+		return resp
+	}
+
+	httpmock.RegisterResponder("GET", testingAuthRegistryURL,
+		func(req *http.Request) (*http.Response, error) {
+			if resp := checkAuth(req); resp != nil {
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	httpmock.RegisterResponder("POST", `=~^`+testingAuthRegistryURL+`/subjects/(.+)/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			if resp := checkAuth(req); resp != nil {
+				return resp, nil
+			}
+			subject, err := httpmock.GetSubmatch(req, 1)
+			if err != nil {
+				return nil, err
+			}
+			*subjects = append(*subjects, subject)
+			return httpmock.NewJsonResponse(200, &registerResponse{ID: len(*subjects)})
+		})
+}
+
+func getTestingCodec(t *testing.T) *goavro.Codec {
+	codec, err := goavro.NewCodec(`{
+       "type": "record",
+       "name": "test",
+       "namespace": "default.test_db",
+       "fields":
+         [
+           {
+             "type": "string",
+             "name": "field1"
+           }
+          ]
+     }`)
+	require.NoError(t, err)
+	return codec
+}
+
+func TestSchemaRegistryAuthentication(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        *config.SchemaRegistryConfig
+		authorization string
+	}{
+		{
+			name:          "basic",
+			config:        &config.SchemaRegistryConfig{Username: "user", Password: "pass"},
+			authorization: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name:          "bearer",
+			config:        &config.SchemaRegistryConfig{BearerToken: "token"},
+			authorization: "Bearer token",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var subjects []string
+			startHTTPInterceptForTestingAuthRegistry(tc.authorization, &subjects)
+			defer stopHTTPInterceptForTestingRegistry()
+
+			// anonymous access is rejected
+			_, err := NewAvroSchemaManager(getTestingContext(), nil, testingAuthRegistryURL, "-value")
+			require.True(t, cerror.ErrAvroSchemaRegistryUnauthorized.Equal(err))
+			require.ErrorContains(t, err, "Unauthorized")
+
+			manager, err := NewAvroSchemaManager(
+				getTestingContext(), tc.config, testingAuthRegistryURL, "-value")
+			require.NoError(t, err)
+			id, err := manager.Register(
+				getTestingContext(), "cdctest", "default.test_db.test", getTestingCodec(t))
+			require.NoError(t, err)
+			require.Equal(t, 1, id)
+			require.Equal(t, []string{"cdctest-value"}, subjects)
+
+			// the credentials are revoked after the manager is created
+			manager.authorization = "Bearer revoked"
+			_, err = manager.Register(
+				getTestingContext(), "cdctest", "default.test_db.test", getTestingCodec(t))
+			require.True(t, cerror.ErrAvroSchemaRegistryUnauthorized.Equal(err))
+		})
+	}
+}
+
+func TestSchemaRegistrySubjectNameStrategy(t *testing.T) {
+	testCases := []struct {
+		strategy     string
+		valueSubject string
+	}{
+		{config.SubjectNameStrategyTopic, "cdctest-value"},
+		{config.SubjectNameStrategyRecord, "default.test_db.test"},
+		{config.SubjectNameStrategyTopicRecord, "cdctest-default.test_db.test"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.strategy, func(t *testing.T) {
+			var subjects []string
+			startHTTPInterceptForTestingAuthRegistry("", &subjects)
+			defer stopHTTPInterceptForTestingRegistry()
+
+			registryConfig := &config.SchemaRegistryConfig{SubjectNameStrategy: tc.strategy}
+			manager, err := NewAvroSchemaManager(
+				getTestingContext(), registryConfig, testingAuthRegistryURL, "-value")
+			require.NoError(t, err)
+			_, err = manager.Register(
+				getTestingContext(), "cdctest", "default.test_db.test", getTestingCodec(t))
+			require.NoError(t, err)
+			require.Equal(t, []string{tc.valueSubject}, subjects)
+		})
+	}
+}
+
+func TestSchemaRegistryIncompatible(t *testing.T) {
+	httpmock.Activate()
+	defer stopHTTPInterceptForTestingRegistry()
+
+	httpmock.RegisterResponder("GET", testingAuthRegistryURL,
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterResponder("POST", `=~^`+testingAuthRegistryURL+`/subjects/(.+)/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(409, &errorResponse{
+				ErrorCode: 409,
+				Message: "Schema being registered is incompatible with an earlier schema " +
+					"for subject \"cdctest-value\", details: " +
+					"[{errorType:'READER_FIELD_MISSING_DEFAULT_VALUE', " +
+					"description:'The field 'field2' at path '/fields/1' in the new schema " +
+					"has no default value and is missing in the old schema', " +
+					"additionalInfo:'field2'}]",
+			})
+		})
+
+	manager, err := NewAvroSchemaManager(
+		getTestingContext(), nil, testingAuthRegistryURL, "-value")
+	require.NoError(t, err)
+	_, _, err = manager.GetCachedOrRegister(
+		getTestingContext(), "cdctest", "default.test_db.test", 1,
+		func() (string, error) { return getTestingCodec(t).Schema(), nil })
+	require.True(t, cerror.ErrAvroSchemaIncompatible.Equal(err))
+	require.ErrorContains(t, err, "subject cdctest-value")
+	require.ErrorContains(t, err, "incompatible fields: [field2]")
+
+	require.Empty(t, parseIncompatibleFields("Schema being registered is incompatible"))
+	require.Equal(t, []string{"a", "b"}, parseIncompatibleFields(
+		"{description:'The field 'a' at path '/fields/0'}, "+
+			"{description:'The field 'b' at path '/fields/1'}"))
+}
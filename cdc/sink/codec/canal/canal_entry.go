@@ -158,7 +158,8 @@ func (b *canalEntryBuilder) buildRowData(e *model.RowChangedEvent) (*canal.RowDa
 	}
 	var preColumns []*canal.Column
 	for _, column := range e.PreColumns {
-		if column == nil {
+		// The before columns are omitted if the old value of the table is disabled.
+		if column == nil || e.OmitOldValue {
 			continue
 		}
 		c, err := b.buildColumn(column, column.Name, !e.IsDelete())
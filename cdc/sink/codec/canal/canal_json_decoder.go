@@ -114,6 +114,16 @@ func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
 	return result, nil
 }
 
+// IsFinishedEvent implements the FinishedEventDecoder interface
+// `HasNext` should be called before this.
+func (b *batchDecoder) IsFinishedEvent() bool {
+	if b.msg == nil || b.msg.messageType() != model.MessageTypeResolved {
+		return false
+	}
+	withExtensionEvent, ok := b.msg.(*canalJSONMessageWithTiDBExtension)
+	return ok && withExtensionEvent.Extensions.Finished
+}
+
 // NextResolvedEvent implements the EventBatchDecoder interface
 // `HasNext` should be called before this.
 func (b *batchDecoder) NextResolvedEvent() (uint64, error) {
@@ -221,9 +221,12 @@ func (c *JSONBatchEncoder) newJSONMessageForDML(e *model.RowChangedEvent) ([]byt
 			return nil, err
 		}
 	} else if e.IsUpdate() {
-		out.RawString(",\"old\":")
-		if err := filling(e.PreColumns, out); err != nil {
-			return nil, err
+		// The `old` field is omitted if the old value of the table is disabled.
+		if !e.OmitOldValue {
+			out.RawString(",\"old\":")
+			if err := filling(e.PreColumns, out); err != nil {
+				return nil, err
+			}
 		}
 		out.RawString(",\"data\":")
 		if err := filling(e.Columns, out); err != nil {
@@ -293,11 +296,21 @@ func (c *JSONBatchEncoder) newJSONMessage4CheckpointEvent(ts uint64) *canalJSONM
 
 // EncodeCheckpointEvent implements the EventBatchEncoder interface
 func (c *JSONBatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return c.encodeWatermark(ts, false)
+}
+
+// EncodeFinishedEvent implements the FinishedEventEncoder interface
+func (c *JSONBatchEncoder) EncodeFinishedEvent(ts uint64) (*common.Message, error) {
+	return c.encodeWatermark(ts, true)
+}
+
+func (c *JSONBatchEncoder) encodeWatermark(ts uint64, finished bool) (*common.Message, error) {
 	if !c.enableTiDBExtension {
 		return nil, nil
 	}
 
 	msg := c.newJSONMessage4CheckpointEvent(ts)
+	msg.Extensions.Finished = finished
 	value, err := json.Marshal(msg)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrCanalEncodeFailed, err)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
@@ -133,6 +134,29 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	require.Equal(t, testCaseUpdate.CommitTs, withExtension.Extensions.CommitTs)
 }
 
+func TestNewCanalJSONMessage4DMLOmitOldValue(t *testing.T) {
+	t.Parallel()
+	e := newJSONBatchEncoder(&common.Config{
+		EnableTiDBExtension: false,
+		Terminator:          "",
+	})
+	encoder, ok := e.(*JSONBatchEncoder)
+	require.True(t, ok)
+
+	updateCase := *testCaseUpdate
+	updateCase.OmitOldValue = true
+	data, err := encoder.newJSONMessageForDML(&updateCase)
+	require.Nil(t, err)
+	require.NotContains(t, string(data), `"old"`)
+
+	jsonMsg := &JSONMessage{}
+	err = json.Unmarshal(data, jsonMsg)
+	require.Nil(t, err)
+	require.NotNil(t, jsonMsg.Data)
+	require.Nil(t, jsonMsg.Old)
+	require.Equal(t, "UPDATE", jsonMsg.EventType)
+}
+
 func TestNewCanalJSONMessageFromDDL(t *testing.T) {
 	t.Parallel()
 	encoder := &JSONBatchEncoder{builder: newCanalEntryBuilder()}
@@ -235,6 +259,41 @@ func TestEncodeCheckpointEvent(t *testing.T) {
 	}
 }
 
+func TestEncodeFinishedEvent(t *testing.T) {
+	t.Parallel()
+	var watermark uint64 = 2333
+	encoder := &JSONBatchEncoder{builder: newCanalEntryBuilder(), enableTiDBExtension: true}
+	for _, finished := range []bool{false, true} {
+		var (
+			msg *common.Message
+			err error
+		)
+		if finished {
+			msg, err = encoder.EncodeFinishedEvent(watermark)
+		} else {
+			msg, err = encoder.EncodeCheckpointEvent(watermark)
+		}
+		require.Nil(t, err)
+		require.NotNil(t, msg)
+
+		decoder := NewBatchDecoder(msg.Value, true, "")
+		ty, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeResolved, ty)
+		require.Equal(t, finished, decoder.(codec.FinishedEventDecoder).IsFinishedEvent())
+		consumed, err := decoder.NextResolvedEvent()
+		require.Nil(t, err)
+		require.Equal(t, watermark, consumed)
+	}
+
+	// the finished event is not sent without the tidb extension.
+	encoder = &JSONBatchEncoder{builder: newCanalEntryBuilder(), enableTiDBExtension: false}
+	msg, err := encoder.EncodeFinishedEvent(watermark)
+	require.Nil(t, err)
+	require.Nil(t, msg)
+}
+
 func TestCheckpointEventValueMarshal(t *testing.T) {
 	t.Parallel()
 	var watermark uint64 = 1024
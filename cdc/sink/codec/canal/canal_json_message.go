@@ -133,6 +133,8 @@ func (c *JSONMessage) pkNameSet() map[string]struct{} {
 type tidbExtension struct {
 	CommitTs    uint64 `json:"commitTs,omitempty"`
 	WatermarkTs uint64 `json:"watermarkTs,omitempty"`
+	// Finished marks the watermark as the last one of a finished changefeed.
+	Finished bool `json:"finished,omitempty"`
 }
 
 type canalJSONMessageWithTiDBExtension struct {
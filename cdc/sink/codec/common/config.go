@@ -38,6 +38,7 @@ type Config struct {
 
 	// avro only
 	AvroSchemaRegistry             string
+	AvroSchemaRegistryConfig       *config.SchemaRegistryConfig
 	AvroDecimalHandlingMode        string
 	AvroBigintUnsignedHandlingMode string
 
@@ -123,6 +124,10 @@ func (c *Config) Apply(sinkURI *url.URL, config *config.ReplicaConfig) error {
 		c.AvroSchemaRegistry = config.Sink.SchemaRegistry
 	}
 
+	if config.Sink != nil && config.Sink.SchemaRegistryConfig != nil {
+		c.AvroSchemaRegistryConfig = config.Sink.SchemaRegistryConfig
+	}
+
 	if config.Sink != nil {
 		c.Terminator = config.Sink.Terminator
 		if config.Sink.CSVConfig != nil {
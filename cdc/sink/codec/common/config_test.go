@@ -105,9 +105,18 @@ func TestConfigApplyValidate(t *testing.T) {
 	err = c.Apply(sinkURI, replicaConfig)
 	require.NoError(t, err)
 	require.Equal(t, "this-is-a-uri", c.AvroSchemaRegistry)
+	require.Nil(t, c.AvroSchemaRegistryConfig)
 	err = c.Validate()
 	require.NoError(t, err)
 
+	replicaConfig.Sink.SchemaRegistryConfig = &config.SchemaRegistryConfig{
+		BearerToken:         "token",
+		SubjectNameStrategy: config.SubjectNameStrategyRecord,
+	}
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	require.Equal(t, replicaConfig.Sink.SchemaRegistryConfig, c.AvroSchemaRegistryConfig)
+
 	// avro-decimal-handling-mode
 	c = NewConfig(config.ProtocolAvro)
 	require.Equal(t, "precise", c.AvroDecimalHandlingMode)
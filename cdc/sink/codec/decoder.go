@@ -30,3 +30,11 @@ type EventBatchDecoder interface {
 	// NextDDLEvent returns the next DDL event if exists
 	NextDDLEvent() (*model.DDLEvent, error)
 }
+
+// FinishedEventDecoder is implemented by the decoders of the protocols which
+// can tell the finished event from other resolved events.
+type FinishedEventDecoder interface {
+	// IsFinishedEvent returns whether the next event is the resolved event
+	// which marks the changefeed as finished. `HasNext` should be called before this.
+	IsFinishedEvent() bool
+}
@@ -40,6 +40,14 @@ type EventBatchEncoder interface {
 	Build() []*common.Message
 }
 
+// FinishedEventEncoder is implemented by the encoders of the protocols which
+// can tell the finished event from other checkpoint events.
+type FinishedEventEncoder interface {
+	// EncodeFinishedEvent encodes a checkpoint event which is marked as the
+	// final one of a changefeed finished at `ts`.
+	EncodeFinishedEvent(ts uint64) (*common.Message, error)
+}
+
 // EncoderBuilder builds encoder with context.
 type EncoderBuilder interface {
 	Build() EventBatchEncoder
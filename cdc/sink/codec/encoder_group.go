@@ -15,6 +15,7 @@ package codec
 
 import (
 	"context"
+	"hash/fnv"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -30,6 +31,7 @@ import (
 
 const (
 	defaultEncoderGroupSize = 16
+	defaultOutputCount      = 4
 	defaultInputChanSize    = 256
 	defaultMetricInterval   = 15 * time.Second
 )
@@ -39,10 +41,13 @@ type EncoderGroup interface {
 	// Run start the group
 	Run(ctx context.Context) error
 	// AddEvents add events into the group, handled by one of the encoders
-	// all input events should belong to the same topic and partition, this should be guaranteed by the caller
+	// all input events should belong to the same topic and partition, this should be guaranteed by the caller.
+	// The events are encoded by the encoders in turn, and the futures of the same
+	// topic and partition are always produced to the same output channel in order.
 	AddEvents(ctx context.Context, topic string, partition int32, events ...*eventsink.RowChangeCallbackableEvent) error
-	// Output returns a channel produce futures
-	Output() <-chan *future
+	// Outputs returns the channels produce futures, each of them should be
+	// consumed by a dedicated goroutine to keep the order of a partition.
+	Outputs() []<-chan *Future
 }
 
 type encoderGroup struct {
@@ -50,21 +55,36 @@ type encoderGroup struct {
 
 	builder EncoderBuilder
 	count   int
-	inputCh []chan *future
+	inputCh []chan *Future
 	index   uint64
 
-	outputCh chan *future
+	outputCh []chan *Future
 }
 
-// NewEncoderGroup creates a new EncoderGroup instance
-func NewEncoderGroup(builder EncoderBuilder, count int, changefeedID model.ChangeFeedID) *encoderGroup {
+// NewEncoderGroup creates a new EncoderGroup instance, `count` is the number
+// of encoders and `outputCount` is the number of output channels.
+func NewEncoderGroup(
+	builder EncoderBuilder, count int, outputCount int, changefeedID model.ChangeFeedID,
+) *encoderGroup {
 	if count <= 0 {
 		count = defaultEncoderGroupSize
 	}
+	if outputCount <= 0 {
+		outputCount = defaultOutputCount
+	}
 
-	inputCh := make([]chan *future, count)
+	inputCh := make([]chan *Future, count)
 	for i := 0; i < count; i++ {
-		inputCh[i] = make(chan *future, defaultInputChanSize)
+		inputCh[i] = make(chan *Future, defaultInputChanSize)
+	}
+	// the total capacity of the output channels is the same as the input ones.
+	outputChanSize := defaultInputChanSize * count / outputCount
+	if outputChanSize < defaultInputChanSize {
+		outputChanSize = defaultInputChanSize
+	}
+	outputCh := make([]chan *Future, outputCount)
+	for i := 0; i < outputCount; i++ {
+		outputCh[i] = make(chan *Future, outputChanSize)
 	}
 
 	return &encoderGroup{
@@ -73,15 +93,16 @@ func NewEncoderGroup(builder EncoderBuilder, count int, changefeedID model.Chang
 		builder:  builder,
 		count:    count,
 		inputCh:  inputCh,
-		index:    0,
-		outputCh: make(chan *future, defaultInputChanSize*count),
+		outputCh: outputCh,
 	}
 }
 
 func (g *encoderGroup) Run(ctx context.Context) error {
 	defer func() {
 		encoderGroupInputChanSizeGauge.DeleteLabelValues(g.changefeedID.Namespace, g.changefeedID.ID)
-		close(g.outputCh)
+		for _, ch := range g.outputCh {
+			close(ch)
+		}
 		log.Info("encoder group exited",
 			zap.String("namespace", g.changefeedID.Namespace),
 			zap.String("changefeed", g.changefeedID.ID))
@@ -129,27 +150,40 @@ func (g *encoderGroup) AddEvents(
 	events ...*eventsink.RowChangeCallbackableEvent,
 ) error {
 	future := newFuture(topic, partition, events...)
-	index := atomic.AddUint64(&g.index, 1) % uint64(g.count)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case g.inputCh[index] <- future:
+	case g.inputCh[atomic.AddUint64(&g.index, 1)%uint64(g.count)] <- future:
 	}
 
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case g.outputCh <- future:
+	case g.outputCh[shardIndex(topic, partition, len(g.outputCh))] <- future:
 	}
 
 	return nil
 }
 
-func (g *encoderGroup) Output() <-chan *future {
-	return g.outputCh
+func (g *encoderGroup) Outputs() []<-chan *Future {
+	outputs := make([]<-chan *Future, 0, len(g.outputCh))
+	for _, ch := range g.outputCh {
+		outputs = append(outputs, ch)
+	}
+	return outputs
+}
+
+// shardIndex returns the index of the output channel which the given topic
+// partition belongs to, partitions of a topic are spread evenly over them.
+func shardIndex(topic string, partition int32, count int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(topic))
+	return int((h.Sum32() + uint32(partition)) % uint32(count))
 }
 
-type future struct {
+// Future is the encoding result of a batch of events, the messages are only
+// available after it is ready.
+type Future struct {
 	Topic     string
 	Partition int32
 	events    []*eventsink.RowChangeCallbackableEvent
@@ -158,8 +192,8 @@ type future struct {
 	done chan struct{}
 }
 
-func newFuture(topic string, partition int32, events ...*eventsink.RowChangeCallbackableEvent) *future {
-	return &future{
+func newFuture(topic string, partition int32, events ...*eventsink.RowChangeCallbackableEvent) *Future {
+	return &Future{
 		Topic:     topic,
 		Partition: partition,
 		events:    events,
@@ -169,7 +203,7 @@ func newFuture(topic string, partition int32, events ...*eventsink.RowChangeCall
 }
 
 // Ready waits until the response is ready, should be called before consuming the future.
-func (p *future) Ready(ctx context.Context) error {
+func (p *Future) Ready(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -0,0 +1,73 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardIndex(t *testing.T) {
+	t.Parallel()
+
+	count := 4
+	shards := make(map[int]struct{})
+	for partition := int32(0); partition < int32(count); partition++ {
+		idx := shardIndex("test", partition, count)
+		require.Equal(t, idx, shardIndex("test", partition, count))
+		require.True(t, idx >= 0 && idx < count)
+		shards[idx] = struct{}{}
+	}
+	// partitions of a topic are spread evenly over the shards.
+	require.Len(t, shards, count)
+}
+
+func TestEncoderGroupOutputsInPartitionOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	g := NewEncoderGroup(nil, 4, 2, model.DefaultChangeFeedID("test"))
+	require.Len(t, g.Outputs(), 2)
+
+	for i := 0; i < 8; i++ {
+		event := &eventsink.RowChangeCallbackableEvent{
+			Event: &model.RowChangedEvent{CommitTs: uint64(i)},
+		}
+		require.NoError(t, g.AddEvents(ctx, "test", int32(i%4), event))
+	}
+	// the events are encoded by the encoders in turn.
+	for _, input := range g.inputCh {
+		require.Len(t, input, 2)
+	}
+
+	total := 0
+	for idx, output := range g.Outputs() {
+		lastCommitTs := make(map[int32]uint64)
+		for len(output) > 0 {
+			future := <-output
+			require.Equal(t, idx, shardIndex(future.Topic, future.Partition, 2))
+			commitTs := future.events[0].Event.CommitTs
+			if last, ok := lastCommitTs[future.Partition]; ok {
+				require.Less(t, last, commitTs)
+			}
+			lastCommitTs[future.Partition] = commitTs
+			total++
+		}
+	}
+	require.Equal(t, 8, total)
+}
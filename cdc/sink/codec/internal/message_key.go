@@ -28,6 +28,8 @@ type MessageKey struct {
 	RowID     int64             `json:"rid,omitempty"`
 	Partition *int64            `json:"ptn,omitempty"`
 	Type      model.MessageType `json:"t"`
+	// Finished marks the resolved event as the last one of a finished changefeed.
+	Finished bool `json:"f,omitempty"`
 }
 
 // Encode encodes the message key to a byte slice.
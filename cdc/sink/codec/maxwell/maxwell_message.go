@@ -68,6 +68,10 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 	if e.IsDelete() {
 		value.Type = "delete"
 		for _, v := range e.PreColumns {
+			// Only the handle key columns are kept if the old value is disabled.
+			if v == nil {
+				continue
+			}
 			switch v.Type {
 			case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 				if v.Value == nil {
@@ -100,6 +104,10 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 			value.Type = "insert"
 		} else {
 			value.Type = "update"
+			// The `old` field is omitted if the old value of the table is disabled.
+			if e.OmitOldValue {
+				return key, value
+			}
 			for _, v := range e.PreColumns {
 				switch v.Type {
 				case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
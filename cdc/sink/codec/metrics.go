@@ -30,7 +30,7 @@ var (
 			Subsystem: "sink",
 			Name:      "encoder_group_output_chan_size",
 			Help:      "The size of output channel of encoder group",
-		}, []string{"namespace", "changefeed"})
+		}, []string{"namespace", "changefeed", "index"})
 )
 
 // InitMetrics registers all metrics in this file
@@ -41,6 +41,11 @@ func (b *BatchMixedDecoder) HasNext() (model.MessageType, bool, error) {
 	return b.nextKey.Type, true, nil
 }
 
+// IsFinishedEvent implements the FinishedEventDecoder interface
+func (b *BatchMixedDecoder) IsFinishedEvent() bool {
+	return b.nextKey != nil && b.nextKey.Type == model.MessageTypeResolved && b.nextKey.Finished
+}
+
 // NextResolvedEvent implements the EventBatchDecoder interface
 func (b *BatchMixedDecoder) NextResolvedEvent() (uint64, error) {
 	if b.nextKey == nil {
@@ -142,6 +147,11 @@ func (b *BatchDecoder) HasNext() (model.MessageType, bool, error) {
 	return b.nextKey.Type, true, nil
 }
 
+// IsFinishedEvent implements the FinishedEventDecoder interface
+func (b *BatchDecoder) IsFinishedEvent() bool {
+	return b.nextKey != nil && b.nextKey.Type == model.MessageTypeResolved && b.nextKey.Finished
+}
+
 // NextResolvedEvent implements the EventBatchDecoder interface
 func (b *BatchDecoder) NextResolvedEvent() (uint64, error) {
 	if b.nextKey == nil {
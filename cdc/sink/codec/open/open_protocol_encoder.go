@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/cdc/sink/codec/internal"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"go.uber.org/zap"
@@ -134,7 +135,15 @@ func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error
 
 // EncodeCheckpointEvent implements the EventBatchEncoder interface
 func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
-	keyMsg := newResolvedMessage(ts)
+	return d.encodeResolvedEvent(newResolvedMessage(ts))
+}
+
+// EncodeFinishedEvent implements the FinishedEventEncoder interface
+func (d *BatchEncoder) EncodeFinishedEvent(ts uint64) (*common.Message, error) {
+	return d.encodeResolvedEvent(newFinishedMessage(ts))
+}
+
+func (d *BatchEncoder) encodeResolvedEvent(keyMsg *internal.MessageKey) (*common.Message, error) {
 	key, err := keyMsg.Encode()
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -155,7 +164,7 @@ func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error)
 	valueBuf := new(bytes.Buffer)
 	valueBuf.Write(valueLenByte[:])
 
-	ret := common.NewResolvedMsg(config.ProtocolOpen, keyBuf.Bytes(), valueBuf.Bytes(), ts)
+	ret := common.NewResolvedMsg(config.ProtocolOpen, keyBuf.Bytes(), valueBuf.Bytes(), keyMsg.Ts)
 	return ret, nil
 }
 
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/cdc/sink/codec/internal"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -208,3 +209,36 @@ func TestOpenProtocolBatchCodec(t *testing.T) {
 	tester := internal.NewDefaultBatchTester()
 	tester.TestBatchCodec(t, NewBatchEncoderBuilder(config), NewBatchDecoder)
 }
+
+func TestOpenProtocolFinishedEvent(t *testing.T) {
+	t.Parallel()
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolOpen)).Build()
+
+	for _, finished := range []bool{false, true} {
+		var (
+			msg *common.Message
+			err error
+		)
+		if finished {
+			msg, err = encoder.(codec.FinishedEventEncoder).EncodeFinishedEvent(2333)
+		} else {
+			msg, err = encoder.EncodeCheckpointEvent(2333)
+		}
+		require.Nil(t, err)
+		require.Equal(t, model.MessageTypeResolved, msg.Type)
+
+		decoder, err := NewBatchDecoder(msg.Key, msg.Value)
+		require.Nil(t, err)
+		ty, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeResolved, ty)
+		require.Equal(t, finished, decoder.(codec.FinishedEventDecoder).IsFinishedEvent())
+		ts, err := decoder.NextResolvedEvent()
+		require.Nil(t, err)
+		require.Equal(t, uint64(2333), ts)
+		_, hasNext, err = decoder.HasNext()
+		require.Nil(t, err)
+		require.False(t, hasNext)
+	}
+}
@@ -76,6 +76,12 @@ func newResolvedMessage(ts uint64) *internal.MessageKey {
 	}
 }
 
+func newFinishedMessage(ts uint64) *internal.MessageKey {
+	key := newResolvedMessage(ts)
+	key.Finished = true
+	return key
+}
+
 func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow) {
 	var partition *int64
 	if e.Table.IsPartition {
@@ -94,7 +100,10 @@ func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow
 		value.Delete = rowChangeColumns2CodecColumns(e.PreColumns)
 	} else {
 		value.Update = rowChangeColumns2CodecColumns(e.Columns)
-		value.PreColumns = rowChangeColumns2CodecColumns(e.PreColumns)
+		// The old values are omitted if the old value of the table is disabled.
+		if !e.OmitOldValue {
+			value.PreColumns = rowChangeColumns2CodecColumns(e.PreColumns)
+		}
 	}
 	return key, value
 }
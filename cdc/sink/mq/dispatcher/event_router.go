@@ -102,7 +102,7 @@ func NewEventRouter(cfg *config.ReplicaConfig, defaultTopic string) (*EventRoute
 			f = filter.CaseInsensitive(f)
 		}
 
-		d := getPartitionDispatcher(ruleConfig, cfg.EnableOldValue)
+		d := getPartitionDispatcher(ruleConfig, cfg.HasOldValue())
 		t, err := getTopicDispatcher(ruleConfig, defaultTopic, cfg.Sink.Protocol)
 		if err != nil {
 			return nil, err
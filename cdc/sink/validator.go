@@ -15,11 +15,13 @@ package sink
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"strings"
 
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -152,3 +154,85 @@ func IsSinkCompatibleWithSpanReplication(sinkURI string) bool {
 	return err == nil &&
 		(strings.Contains(u.Scheme, "kafka") || strings.Contains(u.Scheme, "blackhole"))
 }
+
+// sinkTypeOf returns the type of the sink with the given scheme, schemes of
+// the same type share the sink specific configurations.
+func sinkTypeOf(scheme string) string {
+	switch {
+	case sink.IsMySQLCompatibleScheme(scheme):
+		return "mysql"
+	case sink.IsMQScheme(scheme):
+		return "mq"
+	case sink.IsStorageScheme(scheme):
+		return "storage"
+	default:
+		return scheme
+	}
+}
+
+// AdjustConfigForSinkTypeChange checks whether a stopped changefeed can be
+// switched from the old sink to the new sink of a different type, e.g. from
+// kafka to mysql, and resets the configurations of `newCfg` which are
+// specific to the old sink type. It returns the warnings about the semantics
+// which don't carry over to the new sink. It's a no-op if the sink type is
+// not changed.
+func AdjustConfigForSinkTypeChange(
+	oldSinkURI, newSinkURI string, oldCfg, newCfg *config.ReplicaConfig,
+) ([]string, error) {
+	oldURI, err := url.Parse(oldSinkURI)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	newURI, err := url.Parse(newSinkURI)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	oldScheme := strings.ToLower(oldURI.Scheme)
+	newScheme := strings.ToLower(newURI.Scheme)
+	if sinkTypeOf(oldScheme) == sinkTypeOf(newScheme) {
+		return nil, nil
+	}
+
+	// the redo logs are written for the old sink, applying them to the new
+	// sink after a failure is unexpected.
+	if newCfg.Consistent != nil && redo.IsConsistentEnabled(newCfg.Consistent.Level) &&
+		(oldCfg.Consistent == nil || oldCfg.Consistent.Storage == newCfg.Consistent.Storage) {
+		return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can not change the sink type while redo log is enabled, " +
+				"please update the consistent storage or disable redo log at the same time")
+	}
+
+	var warnings []string
+	if newCfg.Sink != nil {
+		if !sink.IsMQScheme(newScheme) {
+			newCfg.Sink.DispatchRules = nil
+			newCfg.Sink.ColumnSelectors = nil
+			newCfg.Sink.SchemaRegistry = ""
+		}
+		if !sink.IsMQScheme(newScheme) && !sink.IsStorageScheme(newScheme) {
+			newCfg.Sink.Protocol = ""
+		}
+	}
+
+	splitTxn := func(u *url.URL) bool {
+		level := config.AtomicityLevel(u.Query().Get("transaction-atomicity"))
+		return level.ShouldSplitTxn()
+	}
+	oldCapabilities := sink.GetCapabilities(oldScheme, splitTxn(oldURI))
+	newCapabilities := sink.GetCapabilities(newScheme, splitTxn(newURI))
+	if !newCapabilities.Syncpoint && newCfg.EnableSyncPoint {
+		newCfg.EnableSyncPoint = false
+		warnings = append(warnings, fmt.Sprintf(
+			"syncpoint is disabled since it's not supported by the %s sink", newScheme))
+	}
+	if oldCapabilities.Transactional && !newCapabilities.Transactional {
+		warnings = append(warnings, fmt.Sprintf(
+			"upstream transactions are not written atomically by the %s sink", newScheme))
+	}
+	if oldCapabilities.ExactlyOnce && !newCapabilities.ExactlyOnce {
+		warnings = append(warnings, fmt.Sprintf(
+			"the %s sink delivers changes at least once, "+
+				"duplicated changes may be received after restarts", newScheme))
+	}
+	return warnings, nil
+}
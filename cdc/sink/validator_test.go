@@ -16,6 +16,8 @@ package sink
 import (
 	"testing"
 
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -148,3 +150,53 @@ func TestIsSinkCompatibleWithSpanReplication(t *testing.T) {
 		require.Equal(t, compatible, tt.compatible, tt.name)
 	}
 }
+
+func TestAdjustConfigForSinkTypeChange(t *testing.T) {
+	t.Parallel()
+
+	oldCfg := config.GetDefaultReplicaConfig()
+	oldCfg.Sink.Protocol = "open-protocol"
+	oldCfg.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"test.*"}, PartitionRule: "ts"},
+	}
+
+	// the sink type is not changed.
+	newCfg := oldCfg.Clone()
+	warnings, err := AdjustConfigForSinkTypeChange(
+		"kafka://127.0.0.1:9092/topic", "kafka+ssl://127.0.0.1:9093/topic", oldCfg, newCfg)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Equal(t, oldCfg, newCfg)
+
+	// switch from kafka to mysql.
+	warnings, err = AdjustConfigForSinkTypeChange(
+		"kafka://127.0.0.1:9092/topic", "mysql://127.0.0.1:3306/", oldCfg, newCfg)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Empty(t, newCfg.Sink.Protocol)
+	require.Empty(t, newCfg.Sink.DispatchRules)
+
+	// switch from mysql to kafka, syncpoint is disabled.
+	oldCfg = config.GetDefaultReplicaConfig()
+	oldCfg.EnableSyncPoint = true
+	newCfg = oldCfg.Clone()
+	newCfg.Sink.Protocol = "canal-json"
+	warnings, err = AdjustConfigForSinkTypeChange(
+		"mysql://127.0.0.1:3306/", "kafka://127.0.0.1:9092/topic", oldCfg, newCfg)
+	require.NoError(t, err)
+	require.Len(t, warnings, 3)
+	require.False(t, newCfg.EnableSyncPoint)
+	require.Equal(t, "canal-json", newCfg.Sink.Protocol)
+
+	// redo log is enabled without updating the storage.
+	oldCfg.Consistent.Level = "eventual"
+	oldCfg.Consistent.Storage = "s3://redo/old"
+	newCfg = oldCfg.Clone()
+	_, err = AdjustConfigForSinkTypeChange(
+		"mysql://127.0.0.1:3306/", "kafka://127.0.0.1:9092/topic", oldCfg, newCfg)
+	require.True(t, cerror.ErrChangefeedUpdateRefused.Equal(err))
+	newCfg.Consistent.Storage = "s3://redo/new"
+	_, err = AdjustConfigForSinkTypeChange(
+		"mysql://127.0.0.1:3306/", "kafka://127.0.0.1:9092/topic", oldCfg, newCfg)
+	require.NoError(t, err)
+}
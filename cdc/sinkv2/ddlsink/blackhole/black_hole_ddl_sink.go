@@ -46,6 +46,13 @@ func (d *ddlSink) WriteCheckpointTs(ctx context.Context,
 	return nil
 }
 
+func (d *ddlSink) WriteFinishedTs(ctx context.Context,
+	ts uint64, tables []*model.TableInfo,
+) error {
+	log.Debug("BlackHoleSink: Finished Ts Event", zap.Uint64("ts", ts), zap.Any("tables", tables))
+	return nil
+}
+
 // Close do nothing.
 func (d *ddlSink) Close() error {
 	return nil
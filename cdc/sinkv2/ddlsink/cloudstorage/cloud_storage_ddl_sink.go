@@ -33,6 +33,9 @@ import (
 // Assert DDLEventSink implementation
 var _ ddlsink.DDLEventSink = (*ddlSink)(nil)
 
+// metadataFile is the file recording the checkpoint of the changefeed.
+const metadataFile = "metadata"
+
 type ddlSink struct {
 	// id indicates which changefeed this sink belongs to.
 	id model.ChangeFeedID
@@ -98,17 +101,37 @@ func (d *ddlSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error
 	return errors.Trace(err)
 }
 
-func (d *ddlSink) WriteCheckpointTs(ctx context.Context,
-	ts uint64, tables []*model.TableInfo,
-) error {
-	ckpt, err := json.Marshal(map[string]uint64{"checkpoint-ts": ts})
+// metadata is the content of the metadata file in the root of the storage.
+type metadata struct {
+	CheckpointTs uint64 `json:"checkpoint-ts"`
+	// Finished is true if the changefeed is finished at its target ts, and
+	// no more data will be written to the storage.
+	Finished bool `json:"finished,omitempty"`
+}
+
+func (d *ddlSink) writeMetadata(ctx context.Context, meta metadata) error {
+	data, err := json.Marshal(meta)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	err = d.storage.WriteFile(ctx, "metadata", ckpt)
+	err = d.storage.WriteFile(ctx, metadataFile, data)
 	return errors.Trace(err)
 }
 
+func (d *ddlSink) WriteCheckpointTs(ctx context.Context,
+	ts uint64, tables []*model.TableInfo,
+) error {
+	return d.writeMetadata(ctx, metadata{CheckpointTs: ts})
+}
+
+// WriteFinishedTs writes the final manifest to the metadata file, consumers
+// can stop consuming the storage once the finished flag is set.
+func (d *ddlSink) WriteFinishedTs(ctx context.Context,
+	ts uint64, tables []*model.TableInfo,
+) error {
+	return d.writeMetadata(ctx, metadata{CheckpointTs: ts, Finished: true})
+}
+
 func (d *ddlSink) Close() error {
 	if d.statistics != nil {
 		d.statistics.Close()
@@ -132,3 +132,22 @@ func TestWriteCheckpointTs(t *testing.T) {
 	require.Nil(t, err)
 	require.JSONEq(t, `{"checkpoint-ts":100}`, string(metadata))
 }
+
+func TestWriteFinishedTs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	parentDir := t.TempDir()
+	uri := fmt.Sprintf("file:///%s", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	sink, err := NewCloudStorageDDLSink(ctx, sinkURI)
+	require.Nil(t, err)
+
+	err = sink.WriteCheckpointTs(ctx, 100, nil)
+	require.Nil(t, err)
+	err = sink.WriteFinishedTs(ctx, 200, nil)
+	require.Nil(t, err)
+	metadata, err := os.ReadFile(path.Join(parentDir, "metadata"))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"checkpoint-ts":200,"finished":true}`, string(metadata))
+}
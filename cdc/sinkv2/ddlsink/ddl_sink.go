@@ -28,6 +28,11 @@ type DDLEventSink interface {
 	// Note: This is a synchronous and thread-safe method.
 	// This only for MQSink for now.
 	WriteCheckpointTs(ctx context.Context, ts uint64, tables []*model.TableInfo) error
+	// WriteFinishedTs writes the final checkpoint timestamp to the sink when
+	// the changefeed finishes at its target ts, all the data before ts has
+	// been flushed to the sink at that time.
+	// Note: This is a synchronous and thread-safe method.
+	WriteFinishedTs(ctx context.Context, ts uint64, tables []*model.TableInfo) error
 	// Close closes the sink.
 	Close() error
 }
@@ -136,6 +136,12 @@ func (k *ddlSink) WriteCheckpointTs(ctx context.Context,
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return k.broadcastCheckpoint(ctx, msg, ts, tables)
+}
+
+func (k *ddlSink) broadcastCheckpoint(ctx context.Context,
+	msg *common.Message, ts uint64, tables []*model.TableInfo,
+) error {
 	if msg == nil {
 		return nil
 	}
@@ -171,6 +177,31 @@ func (k *ddlSink) WriteCheckpointTs(ctx context.Context,
 	return nil
 }
 
+// WriteFinishedTs broadcasts the finished event to the partitions which
+// receive checkpoint events, which is the terminal marker of the changefeed
+// for consumers. Protocols which can not tell the finished event from other
+// checkpoint events fall back to a plain checkpoint event.
+func (k *ddlSink) WriteFinishedTs(ctx context.Context,
+	ts uint64, tables []*model.TableInfo,
+) error {
+	log.Info("Emit finished ts as the final checkpoint",
+		zap.String("namespace", k.id.Namespace),
+		zap.String("changefeed", k.id.ID),
+		zap.Uint64("finishedTs", ts))
+	encoder := k.encoderBuilder.Build()
+	var msg *common.Message
+	var err error
+	if finishedEncoder, ok := encoder.(codec.FinishedEventEncoder); ok {
+		msg, err = finishedEncoder.EncodeFinishedEvent(ts)
+	} else {
+		msg, err = encoder.EncodeCheckpointEvent(ts)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return k.broadcastCheckpoint(ctx, msg, ts, tables)
+}
+
 func (k *ddlSink) Close() error {
 	k.producer.Close()
 	return nil
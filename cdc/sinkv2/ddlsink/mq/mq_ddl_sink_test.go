@@ -22,6 +22,8 @@ import (
 	"github.com/Shopify/sarama"
 	mm "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/canal"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mq/ddlproducer"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -338,3 +340,74 @@ func TestWriteCheckpointTsWhenCanalJsonTiDBExtensionIsDisable(t *testing.T) {
 	require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetAllEvents(),
 		0, "No topic and partition should be broadcast")
 }
+
+func TestWriteFinishedTs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, topic := initBroker(t, kafka.DefaultMockPartitionNum)
+	defer leader.Close()
+	// Notice: auto create topic is true. Auto created topic will have 1 partition.
+	uriTemplate := "kafka://%s/%s?kafka-version=0.9.0.0&max-batch-size=1" +
+		"&max-message-bytes=1048576&partition-num=1" +
+		"&kafka-client-id=unit-test&auto-create-topic=true&compression=gzip" +
+		"&protocol=canal-json&enable-tidb-extension=true"
+	uri := fmt.Sprintf(uriTemplate, leader.Addr(), topic)
+
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{
+			Matcher:   []string{"*.*"},
+			TopicRule: "{schema}_{table}",
+		},
+	}
+
+	s, err := NewKafkaDDLSink(ctx, sinkURI, replicaConfig,
+		kafka.NewMockAdminClient, ddlproducer.NewMockDDLProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	finishedTs := uint64(417318403368288260)
+	tables := []*model.TableInfo{
+		{
+			TableName: model.TableName{
+				Schema: "cdc",
+				Table:  "person",
+			},
+		},
+	}
+
+	err = s.WriteFinishedTs(ctx, finishedTs, tables)
+	require.Nil(t, err)
+
+	// the finished ts is broadcast to the default topic as well as the table topics.
+	require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetAllEvents(),
+		4, "All topics and partitions should be broadcast")
+	for i := 0; i < kafka.DefaultMockPartitionNum; i++ {
+		require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetEvents(mqv1.TopicPartitionKey{
+			Topic:     "mock_topic",
+			Partition: int32(i),
+		}), 1)
+	}
+	events := s.producer.(*ddlproducer.MockDDLProducer).GetEvents(mqv1.TopicPartitionKey{
+		Topic:     "cdc_person",
+		Partition: 0,
+	})
+	require.Len(t, events, 1)
+
+	// consumers can tell the finished event from other checkpoint events.
+	decoder := canal.NewBatchDecoder(events[0].Value, true, "")
+	ty, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeResolved, ty)
+	require.True(t, decoder.(codec.FinishedEventDecoder).IsFinishedEvent())
+	ts, err := decoder.NextResolvedEvent()
+	require.Nil(t, err)
+	require.Equal(t, finishedTs, ts)
+}
@@ -206,6 +206,12 @@ func (m *mysqlDDLSink) WriteCheckpointTs(_ context.Context, _ uint64, _ []*model
 	return nil
 }
 
+// WriteFinishedTs does nothing, since the downstream database is consistent
+// at the finished ts once all the data before it is flushed.
+func (m *mysqlDDLSink) WriteFinishedTs(_ context.Context, _ uint64, _ []*model.TableInfo) error {
+	return nil
+}
+
 // Close closes the database connection.
 func (m *mysqlDDLSink) Close() error {
 	if err := m.db.Close(); err != nil {
@@ -0,0 +1,91 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// writeAmplificationWindow is the recent window the write amplification
+	// is computed over.
+	writeAmplificationWindow = time.Minute
+	// writeAmplificationBuckets is the number of buckets the window is split
+	// into, the oldest bucket is expired as a whole.
+	writeAmplificationBuckets = 6
+)
+
+type writeAmplificationBucket struct {
+	// start is the start time of the bucket truncated to the bucket size.
+	start  time.Time
+	events uint64
+	writes uint64
+}
+
+// WriteAmplificationRecorder records the number of upstream events of a table
+// and the number of downstream writes they are expanded into, e.g. an update
+// is written as a DELETE and a REPLACE if the old value is disabled. It's
+// thread-safe. All methods of a nil WriteAmplificationRecorder are no-ops.
+type WriteAmplificationRecorder struct {
+	mu      sync.Mutex
+	buckets [writeAmplificationBuckets]writeAmplificationBucket
+}
+
+// Record records that `events` upstream events are written to the downstream
+// by `writes` writes.
+func (r *WriteAmplificationRecorder) Record(events, writes int) {
+	if r != nil {
+		r.record(time.Now(), events, writes)
+	}
+}
+
+func (r *WriteAmplificationRecorder) record(now time.Time, events, writes int) {
+	size := writeAmplificationWindow / writeAmplificationBuckets
+	start := now.Truncate(size)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bucket := &r.buckets[start.UnixNano()/int64(size)%writeAmplificationBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = writeAmplificationBucket{start: start}
+	}
+	bucket.events += uint64(events)
+	bucket.writes += uint64(writes)
+}
+
+// Amplification returns the number of downstream writes divided by the number
+// of upstream events in the recent window. It returns 1.0 if no event is
+// recorded in the window.
+func (r *WriteAmplificationRecorder) Amplification() float64 {
+	if r == nil {
+		return 1.0
+	}
+	return r.amplification(time.Now())
+}
+
+func (r *WriteAmplificationRecorder) amplification(now time.Time) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events, writes uint64
+	for _, bucket := range r.buckets {
+		if now.Sub(bucket.start) < writeAmplificationWindow {
+			events += bucket.events
+			writes += bucket.writes
+		}
+	}
+	if events == 0 {
+		return 1.0
+	}
+	return float64(writes) / float64(events)
+}
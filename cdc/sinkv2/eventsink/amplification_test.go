@@ -0,0 +1,47 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAmplificationRecorder(t *testing.T) {
+	t.Parallel()
+
+	r := &WriteAmplificationRecorder{}
+	now := time.Unix(1700000000, 0)
+	require.Equal(t, 1.0, r.amplification(now))
+
+	r.record(now, 2, 4)
+	r.record(now.Add(15*time.Second), 2, 2)
+	require.Equal(t, 1.5, r.amplification(now.Add(20*time.Second)))
+
+	// the events out of the window are not counted.
+	r.record(now.Add(50*time.Second), 4, 12)
+	require.Equal(t, 14.0/6, r.amplification(now.Add(65*time.Second)))
+	require.Equal(t, 1.0, r.amplification(now.Add(10*time.Minute)))
+
+	// a bucket is reused after the window passes.
+	r.record(now.Add(2*time.Minute), 1, 3)
+	require.Equal(t, 3.0, r.amplification(now.Add(2*time.Minute)))
+
+	// all methods of a nil recorder are no-ops.
+	var nilRecorder *WriteAmplificationRecorder
+	nilRecorder.Record(1, 2)
+	require.Equal(t, 1.0, nilRecorder.Amplification())
+}
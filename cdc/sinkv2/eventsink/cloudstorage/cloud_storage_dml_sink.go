@@ -77,6 +77,12 @@ type dmlSink struct {
 	// sends encoded messages to individual dmlWorkers.
 	writer     *dmlWriter
 	statistics *metrics.Statistics
+	// splitUpdate decides which update events are split into a delete event
+	// and an insert event, see `config.SinkConfig.SplitUpdateToDeleteInsert`.
+	splitUpdate string
+	// truncator truncates the column values exceeding the limit, see
+	// `config.SinkConfig.ColumnValueSizeLimit`.
+	truncator *eventsink.ColumnValueTruncator
 	// last sequence number
 	lastSeqNum uint64
 }
@@ -87,7 +93,7 @@ func NewCloudStorageSink(ctx context.Context,
 	replicaConfig *config.ReplicaConfig,
 	errCh chan error,
 ) (*dmlSink, error) {
-	s := &dmlSink{}
+	s := &dmlSink{splitUpdate: replicaConfig.Sink.SplitUpdateToDeleteInsert}
 	// create cloud storage config and then apply the params of sinkURI to it.
 	cfg := cloudstorage.NewConfig()
 	err := cfg.Apply(ctx, sinkURI, replicaConfig)
@@ -130,6 +136,8 @@ func NewCloudStorageSink(ctx context.Context,
 	}
 
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	s.truncator = eventsink.NewColumnValueTruncator(
+		changefeedID, replicaConfig.Sink.ColumnValueSizeLimit)
 	s.msgCh = make(chan eventFragment, defaultChannelSize)
 	s.defragmenter = newDefragmenter(ctx)
 	orderedCh := s.defragmenter.orderedOut()
@@ -158,6 +166,12 @@ func (s *dmlSink) WriteEvents(txns ...*eventsink.CallbackableEvent[*model.Single
 			txn.Callback()
 			continue
 		}
+		// The delete event of a split update event is placed right before
+		// its insert event, so they are written to the same file in order.
+		for _, row := range txn.Event.Rows {
+			s.truncator.Truncate(row)
+		}
+		txn.Event.Rows = eventsink.SplitUpdateRows(txn.Event.Rows, s.splitUpdate)
 
 		tbl = versionedTable{
 			TableName: txn.Event.TableInfo.TableName,
@@ -185,5 +199,6 @@ func (s *dmlSink) Close() error {
 	if s.statistics != nil {
 		s.statistics.Close()
 	}
+	s.truncator.Close()
 	return nil
 }
@@ -59,6 +59,18 @@ type dmlWorker struct {
 	bufferPool       sync.Pool
 	metricWriteBytes prometheus.Gauge
 	metricFileCount  prometheus.Gauge
+	// manifest records the data files written by the worker, it's nil if
+	// manifests are disabled.
+	manifest *manifestWriter
+	// pendingSince maintains a mapping of <table, arrival time of the oldest
+	// data which isn't flushed>.
+	pendingSince map[versionedTable]time.Time
+	// flushScheduler is shared by all the workers of the sink.
+	flushScheduler *flushScheduler
+
+	metricDataWriteRequests   prometheus.Counter
+	metricSchemaWriteRequests prometheus.Counter
+	metricFileSize            prometheus.Observer
 }
 
 type tableEventsMap struct {
@@ -94,21 +106,24 @@ func newDMLWorker(
 	config *cloudstorage.Config,
 	extension string,
 	statistics *metrics.Statistics,
+	flushScheduler *flushScheduler,
 	errCh chan<- error,
 ) *dmlWorker {
 	d := &dmlWorker{
-		id:            id,
-		changeFeedID:  changefeedID,
-		storage:       storage,
-		config:        config,
-		tableEvents:   newTableEventsMap(),
-		flushNotifyCh: make(chan flushTask, 1),
-		fileIndex:     make(map[versionedTable]*indexWithDate),
-		fileSize:      make(map[versionedTable]uint64),
-		extension:     extension,
-		errCh:         errCh,
-		statistics:    statistics,
-		clock:         clock.New(),
+		id:             id,
+		changeFeedID:   changefeedID,
+		storage:        storage,
+		config:         config,
+		tableEvents:    newTableEventsMap(),
+		flushNotifyCh:  make(chan flushTask, 1),
+		fileIndex:      make(map[versionedTable]*indexWithDate),
+		fileSize:       make(map[versionedTable]uint64),
+		pendingSince:   make(map[versionedTable]time.Time),
+		flushScheduler: flushScheduler,
+		extension:      extension,
+		errCh:          errCh,
+		statistics:     statistics,
+		clock:          clock.New(),
 		bufferPool: sync.Pool{
 			New: func() interface{} {
 				return new(bytes.Buffer)
@@ -116,6 +131,12 @@ func newDMLWorker(
 		},
 		metricWriteBytes: mcloudstorage.CloudStorageWriteBytesGauge.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricFileCount:  mcloudstorage.CloudStorageFileCountGauge.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		metricDataWriteRequests: mcloudstorage.CloudStorageWriteRequestCounter.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID, "data"),
+		metricSchemaWriteRequests: mcloudstorage.CloudStorageWriteRequestCounter.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID, "schema"),
+		metricFileSize: mcloudstorage.CloudStorageFileSizeHistogram.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 	}
 
 	return d
@@ -163,8 +184,12 @@ func (d *dmlWorker) backgroundFlushMsgs(ctx context.Context) {
 						return
 					}
 
+					if err = d.flushScheduler.acquire(ctx); err != nil {
+						return
+					}
 					path := d.generateDataFilePath(table)
 					err = d.writeDataFile(ctx, path, events)
+					d.flushScheduler.release()
 					if err != nil {
 						d.errCh <- err
 						return
@@ -210,6 +235,7 @@ func (d *dmlWorker) writeSchemaFile(
 			return err
 		}
 
+		d.metricSchemaWriteRequests.Inc()
 		err = d.storage.WriteFile(ctx, path, encodedDetail)
 		if err != nil {
 			return err
@@ -223,11 +249,15 @@ func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eve
 	var callbacks []func()
 
 	rowsCnt := 0
+	var maxCommitTs uint64
 	buf := d.bufferPool.Get().(*bytes.Buffer)
 	defer d.bufferPool.Put(buf)
 	buf.Reset()
 
 	for _, frag := range events {
+		if frag.event.Event.CommitTs > maxCommitTs {
+			maxCommitTs = frag.event.Event.CommitTs
+		}
 		msgs := frag.encodedMsgs
 		d.statistics.ObserveRows(frag.event.Event.Rows...)
 		for _, msg := range msgs {
@@ -238,6 +268,7 @@ func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eve
 		}
 	}
 	if err := d.statistics.RecordBatchExecution(func() (int, error) {
+		d.metricDataWriteRequests.Inc()
 		err := d.storage.WriteFile(ctx, path, buf.Bytes())
 		if err != nil {
 			return 0, err
@@ -247,6 +278,8 @@ func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eve
 		return err
 	}
 	d.metricFileCount.Add(1)
+	d.metricFileSize.Observe(float64(buf.Len()))
+	d.manifest.addFile(cloudstorage.NewManifestFile(path, buf.Bytes(), maxCommitTs))
 
 	for _, cb := range callbacks {
 		if cb != nil {
@@ -258,7 +291,8 @@ func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eve
 }
 
 // backgroundDispatchTasks dispatches flush tasks in two conditions:
-// 1. the flush interval exceeds the upper limit.
+// 1. the flush interval exceeds the upper limit, the tables whose flushes are
+// coalesced by the flushScheduler are skipped.
 // 2. the file size exceeds the upper limit.
 func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann[eventFragment]) {
 	tableSet := make(map[wrappedTable]struct{})
@@ -278,9 +312,16 @@ func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann
 				if atomic.LoadUint64(&d.isClosed) == 1 {
 					return
 				}
+				now := d.clock.Now()
 				var readyTables []wrappedTable
 				for tbl := range tableSet {
-					readyTables = append(readyTables, tbl)
+					table := versionedTable{
+						TableName: tbl.tableName,
+						version:   tbl.tableInfo.Version,
+					}
+					if d.flushScheduler.shouldFlush(d.fileSize[table], d.pendingSince[table], now) {
+						readyTables = append(readyTables, tbl)
+					}
 				}
 				if len(readyTables) == 0 {
 					continue
@@ -294,7 +335,7 @@ func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann
 				case d.flushNotifyCh <- task:
 					log.Debug("flush task is emitted successfully when flush interval exceeds",
 						zap.Any("tables", task.targetTables))
-					for elem := range tableSet {
+					for _, elem := range readyTables {
 						// we should get TableName using elem.tableName instead of
 						// elem.tableInfo.TableName because the former one contains
 						// the physical table id (useful for partition table)
@@ -305,8 +346,9 @@ func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann
 							version:   elem.tableInfo.Version,
 						}
 						d.fileSize[tbl] = 0
+						delete(d.pendingSince, tbl)
+						delete(tableSet, elem)
 					}
-					tableSet = make(map[wrappedTable]struct{})
 				default:
 				}
 			case frag, ok := <-ch.Out():
@@ -324,6 +366,9 @@ func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann
 				}
 
 				tableSet[key] = struct{}{}
+				if _, ok := d.pendingSince[table]; !ok {
+					d.pendingSince[table] = d.clock.Now()
+				}
 				for _, msg := range frag.encodedMsgs {
 					if msg.Value != nil {
 						d.fileSize[table] += uint64(len(msg.Value))
@@ -342,6 +387,7 @@ func (d *dmlWorker) backgroundDispatchTasks(ctx context.Context, ch *chann.Chann
 						log.Debug("flush task is emitted successfully when file size exceeds",
 							zap.Any("tables", table))
 						d.fileSize[table] = 0
+						delete(d.pendingSince, table)
 					default:
 					}
 				}
@@ -52,7 +52,7 @@ func testDMLWorker(ctx context.Context, t *testing.T, dir string) *dmlWorker {
 
 	statistics := metrics.NewStatistics(ctx, sink.TxnSink)
 	d := newDMLWorker(1, model.DefaultChangeFeedID("dml-worker-test"), storage,
-		cfg, ".json", statistics, errCh)
+		cfg, ".json", statistics, newFlushScheduler(cfg), errCh)
 	return d
 }
 
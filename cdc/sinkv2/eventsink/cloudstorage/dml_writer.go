@@ -36,6 +36,9 @@ type dmlWriter struct {
 	wg             sync.WaitGroup
 	inputCh        <-chan eventFragment
 	errCh          chan<- error
+
+	// manifest is nil if manifests are disabled.
+	manifest *manifestWriter
 }
 
 func newDMLWriter(ctx context.Context,
@@ -63,8 +66,19 @@ func newDMLWriter(ctx context.Context,
 		w.dispatchFragToDMLWorker(ctx)
 	}()
 
+	if config.EnableManifest {
+		w.manifest = newManifestWriter(changefeedID, storage, config.FlushInterval)
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.manifest.run(ctx, errCh)
+		}()
+	}
+
+	flushScheduler := newFlushScheduler(config)
 	for i := 0; i < config.WorkerCount; i++ {
-		d := newDMLWorker(i, changefeedID, storage, w.config, extension, statistics, errCh)
+		d := newDMLWorker(i, changefeedID, storage, w.config, extension, statistics, flushScheduler, errCh)
+		d.manifest = w.manifest
 		w.workerChannels[i] = chann.New[eventFragment]()
 		d.run(ctx, w.workerChannels[i])
 		w.workers = append(w.workers, d)
@@ -96,6 +110,9 @@ func (d *dmlWriter) close() {
 	for _, w := range d.workers {
 		w.close()
 	}
+	if d.manifest != nil {
+		d.manifest.close()
+	}
 	for _, ch := range d.workerChannels {
 		ch.Close()
 		for range ch.Out() {
@@ -0,0 +1,69 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cloudstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"golang.org/x/sync/semaphore"
+)
+
+// flushScheduler is shared by the dmlWorkers of a sink to reduce the requests
+// sent to cloud storage when there are lots of small tables. It caps the
+// number of data files written concurrently, and coalesces the flushes of
+// small tables so that they're flushed less frequently.
+//
+// The events of a table are still flushed in order by the same dmlWorker, and
+// the events are only acknowledged after they're written, so a delayed flush
+// only holds back the checkpoint of the table, i.e. the written files are
+// always complete up to the resolved ts.
+type flushScheduler struct {
+	// sem is nil if the concurrency isn't limited.
+	sem     *semaphore.Weighted
+	minSize uint64
+	minAge  time.Duration
+}
+
+func newFlushScheduler(config *cloudstorage.Config) *flushScheduler {
+	s := &flushScheduler{
+		minSize: uint64(config.FlushMinSize),
+		minAge:  config.FlushMinAge,
+	}
+	if config.FlushConcurrency > 0 {
+		s.sem = semaphore.NewWeighted(int64(config.FlushConcurrency))
+	}
+	return s
+}
+
+// acquire blocks until a data file can be written.
+func (s *flushScheduler) acquire(ctx context.Context) error {
+	if s.sem == nil {
+		return nil
+	}
+	return s.sem.Acquire(ctx, 1)
+}
+
+// release must be called after a data file acquired by acquire is written.
+func (s *flushScheduler) release() {
+	if s.sem != nil {
+		s.sem.Release(1)
+	}
+}
+
+// shouldFlush returns whether a table should be flushed at the flush interval,
+// given the size of its pending data and when its oldest pending data arrives.
+func (s *flushScheduler) shouldFlush(size uint64, pendingSince, now time.Time) bool {
+	return size >= s.minSize || now.Sub(pendingSince) >= s.minAge
+}
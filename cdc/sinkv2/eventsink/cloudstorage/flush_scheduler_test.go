@@ -0,0 +1,60 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cloudstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushSchedulerShouldFlush(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	// all the tables are flushed at the flush interval by default.
+	s := newFlushScheduler(cloudstorage.NewConfig())
+	require.True(t, s.shouldFlush(1, now, now))
+
+	cfg := cloudstorage.NewConfig()
+	cfg.FlushMinSize = 1024
+	cfg.FlushMinAge = time.Minute
+	s = newFlushScheduler(cfg)
+	require.False(t, s.shouldFlush(1, now.Add(-time.Second), now))
+	require.True(t, s.shouldFlush(1024, now.Add(-time.Second), now))
+	require.True(t, s.shouldFlush(1, now.Add(-time.Minute), now))
+}
+
+func TestFlushSchedulerConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// the concurrency isn't limited by default.
+	s := newFlushScheduler(cloudstorage.NewConfig())
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.acquire(ctx))
+	}
+
+	cfg := cloudstorage.NewConfig()
+	cfg.FlushConcurrency = 1
+	s = newFlushScheduler(cfg)
+	require.NoError(t, s.acquire(ctx))
+	ctx1, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.acquire(ctx1), context.DeadlineExceeded)
+	s.release()
+	require.NoError(t, s.acquire(ctx))
+}
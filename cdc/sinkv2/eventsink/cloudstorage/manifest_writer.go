@@ -0,0 +1,162 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cloudstorage
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"go.uber.org/zap"
+)
+
+// manifestCloseTimeout is the timeout to write the last manifest when the sink is closed.
+const manifestCloseTimeout = 10 * time.Second
+
+// manifestWriter collects the data files finalized by dmlWorkers, and writes
+// them into a changefeed-level manifest periodically. A manifest is written
+// after all the files listed in it, so downstream can ingest them atomically.
+type manifestWriter struct {
+	changefeedID model.ChangeFeedID
+	storage      storage.ExternalStorage
+	interval     time.Duration
+
+	mu      sync.Mutex
+	pending []cloudstorage.ManifestFile
+	// nextSeq is the sequence number of the next manifest, it's only
+	// accessed by the goroutine writing manifests.
+	nextSeq uint64
+}
+
+func newManifestWriter(
+	changefeedID model.ChangeFeedID,
+	storage storage.ExternalStorage,
+	interval time.Duration,
+) *manifestWriter {
+	return &manifestWriter{
+		changefeedID: changefeedID,
+		storage:      storage,
+		interval:     interval,
+	}
+}
+
+// addFile records a data file which has been written to the storage.
+// It's safe to call on a nil manifestWriter.
+func (m *manifestWriter) addFile(file cloudstorage.ManifestFile) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending = append(m.pending, file)
+}
+
+// init continues the sequence numbers of the manifests written before.
+func (m *manifestWriter) init(ctx context.Context) error {
+	seqs, err := cloudstorage.ListManifestSeqs(ctx, m.storage)
+	if err != nil {
+		return err
+	}
+	m.nextSeq = 1
+	if len(seqs) > 0 {
+		m.nextSeq = seqs[len(seqs)-1] + 1
+	}
+	return nil
+}
+
+func (m *manifestWriter) run(ctx context.Context, errCh chan<- error) {
+	if err := m.init(ctx); err != nil {
+		errCh <- err
+		return
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.flush(ctx); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}
+}
+
+// flush writes the pending files into a new manifest if there are any.
+func (m *manifestWriter) flush(ctx context.Context) error {
+	m.mu.Lock()
+	files := make([]cloudstorage.ManifestFile, len(m.pending))
+	copy(files, m.pending)
+	m.mu.Unlock()
+	if len(files) == 0 {
+		return nil
+	}
+
+	manifest := &cloudstorage.Manifest{
+		Seq:   m.nextSeq,
+		Files: files,
+	}
+	for _, f := range files {
+		if f.MaxCommitTs > manifest.MaxCommitTs {
+			manifest.MaxCommitTs = f.MaxCommitTs
+		}
+	}
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	path := cloudstorage.ManifestPath(manifest.Seq)
+	if err := m.storage.WriteFile(ctx, path, content); err != nil {
+		return errors.Trace(err)
+	}
+	m.nextSeq++
+
+	// files added during writing the manifest are kept for the next one.
+	m.mu.Lock()
+	m.pending = m.pending[len(files):]
+	m.mu.Unlock()
+
+	log.Debug("write manifest to storage success",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.String("path", path),
+		zap.Int("fileCount", len(files)),
+		zap.Uint64("maxCommitTs", manifest.MaxCommitTs))
+	return nil
+}
+
+// close writes the files finalized since the last manifest, so that they
+// are not left out of any manifest.
+func (m *manifestWriter) close() {
+	if m.nextSeq == 0 {
+		// the writer is not initialized.
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), manifestCloseTimeout)
+	defer cancel()
+	if err := m.flush(ctx); err != nil {
+		log.Warn("failed to write the last manifest",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Error(err))
+	}
+}
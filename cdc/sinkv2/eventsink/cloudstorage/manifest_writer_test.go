@@ -0,0 +1,71 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cloudstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestWriter(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.Nil(t, err)
+
+	changefeedID := model.DefaultChangeFeedID("manifest-writer-test")
+	m := newManifestWriter(changefeedID, s, time.Second)
+	require.Nil(t, m.init(ctx))
+	reader := cloudstorage.NewManifestReader(s, 0)
+
+	// nothing to flush
+	require.Nil(t, m.flush(ctx))
+	manifests, err := reader.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 0)
+
+	m.addFile(cloudstorage.NewManifestFile("test/table1/5/CDC000001.json", []byte("a"), 100))
+	m.addFile(cloudstorage.NewManifestFile("test/table2/5/CDC000001.json", []byte("bc"), 120))
+	require.Nil(t, m.flush(ctx))
+	m.addFile(cloudstorage.NewManifestFile("test/table1/5/CDC000002.json", []byte("d"), 110))
+	require.Nil(t, m.flush(ctx))
+
+	manifests, err = reader.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 2)
+	require.Equal(t, uint64(1), manifests[0].Seq)
+	require.Equal(t, uint64(120), manifests[0].MaxCommitTs)
+	require.Len(t, manifests[0].Files, 2)
+	require.Equal(t, int64(2), manifests[0].Files[1].Size)
+	require.Equal(t, uint64(2), manifests[1].Seq)
+	require.Equal(t, "test/table1/5/CDC000002.json", manifests[1].Files[0].Path)
+
+	// a restarted writer continues the sequence numbers.
+	m = newManifestWriter(changefeedID, s, time.Second)
+	require.Nil(t, m.init(ctx))
+	m.addFile(cloudstorage.NewManifestFile("test/table1/5/CDC000003.json", []byte("e"), 130))
+	m.close()
+	manifests, err = reader.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 1)
+	require.Equal(t, uint64(3), manifests[0].Seq)
+
+	// nil writer ignores files
+	var nilWriter *manifestWriter
+	nilWriter.addFile(cloudstorage.ManifestFile{})
+}
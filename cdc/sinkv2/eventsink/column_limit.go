@@ -0,0 +1,193 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+)
+
+const (
+	// TruncatedValueMarkerKey is the only key of the JSON object replacing a
+	// truncated column value, consumers can detect truncated values by it.
+	TruncatedValueMarkerKey = "ticdc-truncated-value"
+	// truncatedPrefixSize is the max size of the prefix kept in the marker.
+	truncatedPrefixSize = 64
+)
+
+// TruncatedValueMarker describes a column value which exceeds the
+// column-value-size-limit. It's encoded as
+// `{"ticdc-truncated-value":{"length":...,"sha256":"...","prefix":"..."}}`.
+type TruncatedValueMarker struct {
+	// Length is the length of the original value in bytes.
+	Length int `json:"length"`
+	// SHA256 is the hex encoded SHA-256 of the original value.
+	SHA256 string `json:"sha256"`
+	// Prefix is the beginning of the original value, it's cut at a UTF-8
+	// character boundary. Invalid UTF-8 bytes of binary values are replaced
+	// by U+FFFD.
+	Prefix string `json:"prefix"`
+}
+
+// ColumnValueTruncator replaces the string and binary column values larger
+// than the limit with truncation markers, so that a huge value, e.g. a BLOB or
+// a JSON, doesn't exceed the limits of encoders and brokers. The values of the
+// handle key and unique key columns are never truncated, since they identify
+// the rows for consumers. It's thread-safe.
+// All methods of a nil ColumnValueTruncator are no-ops.
+type ColumnValueTruncator struct {
+	changefeedID model.ChangeFeedID
+	limit        int
+
+	mu sync.Mutex
+	// tables are the tables having truncated values, they are used to clean
+	// up the metrics.
+	tables map[model.TableName]struct{}
+}
+
+// NewColumnValueTruncator creates a ColumnValueTruncator, it returns nil if
+// the limit is not positive, which means column values are never truncated.
+func NewColumnValueTruncator(changefeedID model.ChangeFeedID, limit int) *ColumnValueTruncator {
+	if limit <= 0 {
+		return nil
+	}
+	return &ColumnValueTruncator{
+		changefeedID: changefeedID,
+		limit:        limit,
+		tables:       make(map[model.TableName]struct{}),
+	}
+}
+
+// Truncate truncates the column values of the row. The truncated columns are
+// copied instead of being modified in place, since they may be shared by
+// other events.
+func (t *ColumnValueTruncator) Truncate(row *model.RowChangedEvent) {
+	if t == nil || row == nil {
+		return
+	}
+	var count int
+	var n int
+	row.Columns, n = t.truncateColumns(row.Columns)
+	count += n
+	row.PreColumns, n = t.truncateColumns(row.PreColumns)
+	count += n
+	if count == 0 {
+		return
+	}
+
+	var table model.TableName
+	if row.Table != nil {
+		// the partitions of a table share the counter.
+		table = model.TableName{Schema: row.Table.Schema, Table: row.Table.Table}
+	}
+	t.mu.Lock()
+	t.tables[table] = struct{}{}
+	t.mu.Unlock()
+	metrics.TruncatedColumnValueCounter.
+		WithLabelValues(t.changefeedID.Namespace, t.changefeedID.ID, table.Schema, table.Table).
+		Add(float64(count))
+}
+
+// Close cleans up the metrics of the truncator.
+func (t *ColumnValueTruncator) Close() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for table := range t.tables {
+		metrics.TruncatedColumnValueCounter.DeleteLabelValues(
+			t.changefeedID.Namespace, t.changefeedID.ID, table.Schema, table.Table)
+	}
+	t.tables = make(map[model.TableName]struct{})
+}
+
+func (t *ColumnValueTruncator) truncateColumns(cols []*model.Column) ([]*model.Column, int) {
+	var res []*model.Column
+	count := 0
+	for i, col := range cols {
+		if col == nil || isKeyColumn(col) {
+			continue
+		}
+		value, ok := t.truncateValue(col.Value)
+		if !ok {
+			continue
+		}
+		if res == nil {
+			res = make([]*model.Column, len(cols))
+			copy(res, cols)
+		}
+		truncated := *col
+		truncated.Value = value
+		res[i] = &truncated
+		count++
+	}
+	if res == nil {
+		return cols, 0
+	}
+	return res, count
+}
+
+func isKeyColumn(col *model.Column) bool {
+	return col.Flag.IsHandleKey() || col.Flag.IsPrimaryKey() || col.Flag.IsUniqueKey()
+}
+
+// truncateValue returns the marker of the value if it exceeds the limit, the
+// marker has the same type as the value.
+func (t *ColumnValueTruncator) truncateValue(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case []byte:
+		if len(v) <= t.limit {
+			return nil, false
+		}
+		return []byte(t.marker(v)), true
+	case string:
+		if len(v) <= t.limit {
+			return nil, false
+		}
+		return t.marker([]byte(v)), true
+	default:
+		return nil, false
+	}
+}
+
+func (t *ColumnValueTruncator) marker(data []byte) string {
+	n := truncatedPrefixSize
+	if n > t.limit {
+		n = t.limit
+	}
+	if n > len(data) {
+		n = len(data)
+	}
+	// don't cut a UTF-8 character in the middle.
+	for i := 0; i < utf8.UTFMax-1 && n > 0 && n < len(data) && !utf8.RuneStart(data[n]); i++ {
+		n--
+	}
+	sum := sha256.Sum256(data)
+	// json.Marshal never fails on the marker.
+	b, _ := json.Marshal(map[string]TruncatedValueMarker{
+		TruncatedValueMarkerKey: {
+			Length: len(data),
+			SHA256: hex.EncodeToString(sum[:]),
+			Prefix: string(data[:n]),
+		},
+	})
+	return string(b)
+}
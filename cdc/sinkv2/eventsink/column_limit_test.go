@@ -0,0 +1,126 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnValueTruncator(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, NewColumnValueTruncator(model.DefaultChangeFeedID("test"), 0))
+
+	truncator := NewColumnValueTruncator(model.DefaultChangeFeedID("test"), 8)
+	defer truncator.Close()
+
+	blob := []byte(strings.Repeat("b", 100))
+	preColumns := []*model.Column{
+		{Name: "id", Value: int64(1)},
+		{Name: "doc", Value: `{"k":1}`},
+		{Name: "data", Value: []byte("small")},
+	}
+	columns := []*model.Column{
+		{Name: "id", Value: int64(1)},
+		{Name: "doc", Value: "中文中文中文"},
+		{Name: "data", Value: blob},
+	}
+	row := &model.RowChangedEvent{
+		Table:      &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: preColumns,
+		Columns:    columns,
+	}
+	truncator.Truncate(row)
+
+	// the pre columns are not truncated, the slice is kept as is.
+	require.Equal(t, `{"k":1}`, row.PreColumns[1].Value)
+	require.Same(t, preColumns[0], row.PreColumns[0])
+	// the truncated columns are copied.
+	require.Equal(t, blob, columns[2].Value)
+	require.Same(t, columns[0], row.Columns[0])
+	require.NotSame(t, columns[2], row.Columns[2])
+
+	var marker map[string]TruncatedValueMarker
+	require.IsType(t, []byte{}, row.Columns[2].Value)
+	require.NoError(t, json.Unmarshal(row.Columns[2].Value.([]byte), &marker))
+	sum := sha256.Sum256(blob)
+	require.Equal(t, TruncatedValueMarker{
+		Length: 100,
+		SHA256: hex.EncodeToString(sum[:]),
+		Prefix: "bbbbbbbb",
+	}, marker[TruncatedValueMarkerKey])
+
+	// the prefix is cut at a character boundary.
+	require.IsType(t, "", row.Columns[1].Value)
+	require.NoError(t, json.Unmarshal([]byte(row.Columns[1].Value.(string)), &marker))
+	require.Equal(t, len("中文中文中文"), marker[TruncatedValueMarkerKey].Length)
+	require.Equal(t, "中文", marker[TruncatedValueMarkerKey].Prefix)
+
+	// all methods of a nil truncator are no-ops.
+	var nilTruncator *ColumnValueTruncator
+	row = &model.RowChangedEvent{Columns: []*model.Column{{Value: blob}}}
+	nilTruncator.Truncate(row)
+	nilTruncator.Close()
+	require.Equal(t, blob, row.Columns[0].Value)
+}
+
+func TestColumnValueTruncatorKeyColumns(t *testing.T) {
+	t.Parallel()
+
+	truncator := NewColumnValueTruncator(model.DefaultChangeFeedID("test"), 8)
+	defer truncator.Close()
+
+	value := strings.Repeat("k", 100)
+	columns := []*model.Column{
+		{Name: "pk", Value: value, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "uk", Value: value, Flag: model.UniqueKeyFlag},
+		{Name: "v", Value: value},
+	}
+	row := &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t"},
+		Columns: columns,
+	}
+	truncator.Truncate(row)
+
+	// the key columns identify the row, they are never truncated.
+	require.Same(t, columns[0], row.Columns[0])
+	require.Same(t, columns[1], row.Columns[1])
+	require.NotEqual(t, value, row.Columns[2].Value)
+}
+
+func TestColumnValueTruncatorMarkerSize(t *testing.T) {
+	t.Parallel()
+
+	truncator := NewColumnValueTruncator(model.DefaultChangeFeedID("test"),
+		config.MinColumnValueSizeLimit)
+	defer truncator.Close()
+
+	// every byte of the prefix is escaped by the JSON encoding.
+	value := strings.Repeat("\x00", 10*config.MinColumnValueSizeLimit)
+	row := &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{{Name: "v", Value: value}},
+	}
+	truncator.Truncate(row)
+	require.NotEqual(t, value, row.Columns[0].Value)
+	require.LessOrEqual(t, len(row.Columns[0].Value.(string)), config.MinColumnValueSizeLimit)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import "go.uber.org/atomic"
+
+// ConflictStats are the statistics of the conflicts detected when applying
+// the events of a table to the downstream, e.g. the row to update or delete
+// has been modified or deleted on the other side in bidirectional replication.
+type ConflictStats struct {
+	// Detected is the number of detected conflicts.
+	Detected uint64
+	// Overwritten is the number of conflicts resolved by overwriting the
+	// downstream row with the replicated one.
+	Overwritten uint64
+	// Skipped is the number of conflicts resolved by skipping the replicated
+	// change, which has no effect on the downstream.
+	Skipped uint64
+}
+
+// ConflictRecorder records the conflicts of a table, it's thread-safe.
+// All methods of a nil ConflictRecorder are no-ops.
+type ConflictRecorder struct {
+	overwritten atomic.Uint64
+	skipped     atomic.Uint64
+}
+
+// RecordOverwritten records a conflict resolved by overwriting the downstream row.
+func (r *ConflictRecorder) RecordOverwritten() {
+	if r != nil {
+		r.overwritten.Inc()
+	}
+}
+
+// RecordSkipped records a conflict resolved by skipping the replicated change.
+func (r *ConflictRecorder) RecordSkipped() {
+	if r != nil {
+		r.skipped.Inc()
+	}
+}
+
+// Reset zeroes the recorded conflicts.
+func (r *ConflictRecorder) Reset() {
+	if r != nil {
+		r.overwritten.Store(0)
+		r.skipped.Store(0)
+	}
+}
+
+// Stats returns the statistics of the recorded conflicts.
+func (r *ConflictRecorder) Stats() ConflictStats {
+	if r == nil {
+		return ConflictStats{}
+	}
+	overwritten, skipped := r.overwritten.Load(), r.skipped.Load()
+	return ConflictStats{
+		Detected:    overwritten + skipped,
+		Overwritten: overwritten,
+		Skipped:     skipped,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictRecorder(t *testing.T) {
+	t.Parallel()
+
+	r := &ConflictRecorder{}
+	require.Equal(t, ConflictStats{}, r.Stats())
+
+	r.RecordOverwritten()
+	r.RecordSkipped()
+	r.RecordSkipped()
+	require.Equal(t, ConflictStats{Detected: 3, Overwritten: 1, Skipped: 2}, r.Stats())
+
+	r.Reset()
+	require.Equal(t, ConflictStats{}, r.Stats())
+	r.RecordSkipped()
+	require.Equal(t, ConflictStats{Detected: 1, Skipped: 1}, r.Stats())
+
+	// all methods of a nil recorder are no-ops.
+	var nilRecorder *ConflictRecorder
+	nilRecorder.RecordOverwritten()
+	nilRecorder.RecordSkipped()
+	nilRecorder.Reset()
+	require.Equal(t, ConflictStats{}, nilRecorder.Stats())
+}
@@ -33,6 +33,12 @@ type CallbackableEvent[E TableEvent] struct {
 	Event     E
 	Callback  CallbackFunc
 	SinkState *state.TableSinkState
+	// Conflicts records the conflicts detected when applying the event to
+	// the downstream, it can be nil.
+	Conflicts *ConflictRecorder
+	// Writes records the number of downstream writes the event is expanded
+	// into, it can be nil.
+	Writes *WriteAmplificationRecorder
 }
 
 // GetTableSinkState returns the table sink state.
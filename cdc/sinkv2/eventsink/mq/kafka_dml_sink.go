@@ -118,7 +118,9 @@ func NewKafkaDMLSink(
 	}
 
 	s, err := newSink(ctx, p, topicManager, eventRouter, encoderConfig,
-		replicaConfig.Sink.EncoderConcurrency, errCh)
+		replicaConfig.Sink.EncoderConcurrency, replicaConfig.Sink.SendConcurrency,
+		replicaConfig.Sink.SplitUpdateToDeleteInsert,
+		replicaConfig.Sink.ColumnValueSizeLimit, errCh)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
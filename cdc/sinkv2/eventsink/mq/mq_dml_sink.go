@@ -52,6 +52,12 @@ type dmlSink struct {
 	// topicManager used to manage topics.
 	// It is also responsible for creating topics.
 	topicManager manager.TopicManager
+	// splitUpdate decides which update events are split into a delete event
+	// and an insert event, see `config.SinkConfig.SplitUpdateToDeleteInsert`.
+	splitUpdate string
+	// truncator truncates the column values exceeding the limit, see
+	// `config.SinkConfig.ColumnValueSizeLimit`.
+	truncator *eventsink.ColumnValueTruncator
 }
 
 func newSink(ctx context.Context,
@@ -60,6 +66,9 @@ func newSink(ctx context.Context,
 	eventRouter *dispatcher.EventRouter,
 	encoderConfig *common.Config,
 	encoderConcurrency int,
+	sendConcurrency int,
+	splitUpdate string,
+	columnValueSizeLimit int,
 	errCh chan error,
 ) (*dmlSink, error) {
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
@@ -71,13 +80,15 @@ func newSink(ctx context.Context,
 
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
 	worker := newWorker(changefeedID, encoderConfig.Protocol,
-		encoderBuilder, encoderConcurrency, producer, statistics)
+		encoderBuilder, encoderConcurrency, sendConcurrency, producer, statistics)
 	s := &dmlSink{
 		id:           changefeedID,
 		protocol:     encoderConfig.Protocol,
 		worker:       worker,
 		eventRouter:  eventRouter,
 		topicManager: topicManager,
+		splitUpdate:  splitUpdate,
+		truncator:    eventsink.NewColumnValueTruncator(changefeedID, columnValueSizeLimit),
 	}
 
 	// Spawn a goroutine to send messages by the worker.
@@ -109,26 +120,48 @@ func (s *dmlSink) WriteEvents(rows ...*eventsink.RowChangeCallbackableEvent) err
 			row.Callback()
 			continue
 		}
-		topic := s.eventRouter.GetTopicForRowChange(row.Event)
-		partitionNum, err := s.topicManager.GetPartitionNum(topic)
-		if err != nil {
-			return errors.Trace(err)
+		s.truncator.Truncate(row.Event)
+		if eventsink.ShouldSplitUpdateRow(row.Event, s.splitUpdate) {
+			// The delete event is sent before the insert event, they are in
+			// order if they are dispatched to the same partition. Otherwise,
+			// there is no ordering guarantee between the two partitions.
+			deleteRow, insertRow := eventsink.SplitUpdateEvent(row)
+			if err := s.writeEvent(deleteRow); err != nil {
+				return errors.Trace(err)
+			}
+			if err := s.writeEvent(insertRow); err != nil {
+				return errors.Trace(err)
+			}
+			continue
 		}
-		partition := s.eventRouter.GetPartitionForRowChange(row.Event, partitionNum)
-		// This never be blocked because this is an unbounded channel.
-		s.worker.msgChan.In() <- mqEvent{
-			key: mqv1.TopicPartitionKey{
-				Topic: topic, Partition: partition,
-			},
-			rowEvent: row,
+		if err := s.writeEvent(row); err != nil {
+			return errors.Trace(err)
 		}
 	}
 
 	return nil
 }
 
+func (s *dmlSink) writeEvent(row *eventsink.RowChangeCallbackableEvent) error {
+	topic := s.eventRouter.GetTopicForRowChange(row.Event)
+	partitionNum, err := s.topicManager.GetPartitionNum(topic)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	partition := s.eventRouter.GetPartitionForRowChange(row.Event, partitionNum)
+	// This never be blocked because this is an unbounded channel.
+	s.worker.msgChan.In() <- mqEvent{
+		key: mqv1.TopicPartitionKey{
+			Topic: topic, Partition: partition,
+		},
+		rowEvent: row,
+	}
+	return nil
+}
+
 // Close closes the sink.
 func (s *dmlSink) Close() error {
 	s.worker.close()
+	s.truncator.Close()
 	return nil
 }
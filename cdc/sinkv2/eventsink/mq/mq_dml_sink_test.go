@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -124,3 +125,68 @@ func TestWriteEvents(t *testing.T) {
 	err = s.Close()
 	require.Nil(t, err)
 }
+
+func TestWriteEventsSplitUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, topic := initBroker(t, kafka.DefaultMockPartitionNum)
+	defer leader.Close()
+	uriTemplate := "kafka://%s/%s?kafka-version=0.9.0.0&max-batch-size=1" +
+		"&max-message-bytes=1048576&partition-num=1" +
+		"&kafka-client-id=unit-test&auto-create-topic=false&compression=gzip&protocol=open-protocol"
+	uri := fmt.Sprintf(uriTemplate, leader.Addr(), topic)
+
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.SplitUpdateToDeleteInsert = config.SplitUpdatePKChangeOnly
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	s, err := NewKafkaDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	tableStatus := state.TableSinkSinking
+	newRow := func(pre, cur string) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs: 1,
+			Table:    &model.TableName{Schema: "a", Table: "b"},
+			PreColumns: []*model.Column{{
+				Name: "col1", Type: 1, Flag: model.HandleKeyFlag, Value: pre,
+			}},
+			Columns: []*model.Column{{
+				Name: "col1", Type: 1, Flag: model.HandleKeyFlag, Value: cur,
+			}},
+		}
+	}
+
+	var called int64
+	events := []*eventsink.RowChangeCallbackableEvent{
+		{
+			Event:     newRow("aa", "bb"),
+			Callback:  func() { atomic.AddInt64(&called, 1) },
+			SinkState: &tableStatus,
+		},
+		{
+			Event:     newRow("cc", "cc"),
+			Callback:  func() { atomic.AddInt64(&called, 1) },
+			SinkState: &tableStatus,
+		},
+	}
+
+	err = s.WriteEvents(events...)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&called) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+	// The update changing the handle key is sent as a delete and an insert.
+	require.Len(t, s.worker.producer.(*dmlproducer.MockDMLProducer).GetAllEvents(), 3)
+	err = s.Close()
+	require.Nil(t, err)
+}
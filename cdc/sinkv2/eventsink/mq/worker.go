@@ -15,6 +15,7 @@ package mq
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -61,6 +62,8 @@ type worker struct {
 	// ticker used to force flush the messages when the interval is reached.
 	ticker *time.Ticker
 
+	// encoderGroup encodes the messages concurrently, the encoded messages
+	// of a partition are always sent by the same goroutine in order.
 	encoderGroup codec.EncoderGroup
 
 	// producer is used to send the messages to the Kafka broker.
@@ -82,6 +85,7 @@ func newWorker(
 	protocol config.Protocol,
 	builder codec.EncoderBuilder,
 	encoderConcurrency int,
+	sendConcurrency int,
 	producer dmlproducer.DMLProducer,
 	statistics *metrics.Statistics,
 ) *worker {
@@ -90,7 +94,7 @@ func newWorker(
 		protocol:                          protocol,
 		msgChan:                           chann.New[mqEvent](),
 		ticker:                            time.NewTicker(flushInterval),
-		encoderGroup:                      codec.NewEncoderGroup(builder, encoderConcurrency, id),
+		encoderGroup:                      codec.NewEncoderGroup(builder, encoderConcurrency, sendConcurrency, id),
 		producer:                          producer,
 		metricMQWorkerSendMessageDuration: mq.WorkerSendMessageDuration.WithLabelValues(id.Namespace, id.ID),
 		metricMQWorkerBatchSize:           mq.WorkerBatchSize.WithLabelValues(id.Namespace, id.ID),
@@ -123,9 +127,12 @@ func (w *worker) run(ctx context.Context) (retErr error) {
 		}
 		return w.nonBatchEncodeRun(ctx)
 	})
-	g.Go(func() error {
-		return w.sendMessages(ctx)
-	})
+	for idx, inputCh := range w.encoderGroup.Outputs() {
+		idx, inputCh := idx, inputCh
+		g.Go(func() error {
+			return w.sendMessages(ctx, idx, inputCh)
+		})
+	}
 	return g.Wait()
 }
 
@@ -261,15 +268,16 @@ func (w *worker) group(
 	return partitionedRows
 }
 
-func (w *worker) sendMessages(ctx context.Context) error {
-	inputCh := w.encoderGroup.Output()
+// sendMessages sends the encoded messages of an output channel of the encoder
+// group to the DML producer, so network writes don't block the encoders.
+func (w *worker) sendMessages(ctx context.Context, idx int, inputCh <-chan *codec.Future) error {
 	ticker := time.NewTicker(15 * time.Second)
 	metric := codec.EncoderGroupOutputChanSizeGauge.
-		WithLabelValues(w.changeFeedID.Namespace, w.changeFeedID.ID)
+		WithLabelValues(w.changeFeedID.Namespace, w.changeFeedID.ID, strconv.Itoa(idx))
 	defer func() {
 		ticker.Stop()
 		codec.EncoderGroupOutputChanSizeGauge.
-			DeleteLabelValues(w.changeFeedID.Namespace, w.changeFeedID.ID)
+			DeleteLabelValues(w.changeFeedID.Namespace, w.changeFeedID.ID, strconv.Itoa(idx))
 	}()
 	for {
 		select {
@@ -15,10 +15,13 @@ package mq
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
@@ -41,8 +44,9 @@ func newBatchEncodeWorker(ctx context.Context, t *testing.T) (*worker, dmlproduc
 	require.Nil(t, err)
 	id := model.DefaultChangeFeedID("test")
 	encoderConcurrency := 4
+	sendConcurrency := 2
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
-	return newWorker(id, config.ProtocolOpen, builder, encoderConcurrency, p, statistics), p
+	return newWorker(id, config.ProtocolOpen, builder, encoderConcurrency, sendConcurrency, p, statistics), p
 }
 
 func newNonBatchEncodeWorker(ctx context.Context, t *testing.T) (*worker, dmlproducer.DMLProducer) {
@@ -54,8 +58,9 @@ func newNonBatchEncodeWorker(ctx context.Context, t *testing.T) (*worker, dmlpro
 	require.Nil(t, err)
 	id := model.DefaultChangeFeedID("test")
 	encoderConcurrency := 4
+	sendConcurrency := 2
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
-	return newWorker(id, config.ProtocolCanalJSON, builder, encoderConcurrency, p, statistics), p
+	return newWorker(id, config.ProtocolCanalJSON, builder, encoderConcurrency, sendConcurrency, p, statistics), p
 }
 
 func TestNonBatchEncode_SendMessages(t *testing.T) {
@@ -551,3 +556,138 @@ func TestNonBatchEncodeWorker_Abort(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestNonBatchEncode_SendMessagesInPartitionOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	worker, p := newNonBatchEncodeWorker(ctx, t)
+	defer worker.close()
+
+	const partitionNum, eventNum = 8, 64
+	var mu sync.Mutex
+	flushed := make(map[mqv1.TopicPartitionKey][]uint64)
+	tableStatus := state.TableSinkSinking
+	for i := 0; i < eventNum; i++ {
+		key := mqv1.TopicPartitionKey{Topic: "test", Partition: int32(i % partitionNum)}
+		commitTs := uint64(i + 1)
+		worker.msgChan.In() <- mqEvent{
+			rowEvent: &eventsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs: commitTs,
+					Table:    &model.TableName{Schema: "a", Table: "b"},
+					Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+				},
+				Callback: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					flushed[key] = append(flushed[key], commitTs)
+				},
+				SinkState: &tableStatus,
+			},
+			key: key,
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = worker.run(ctx)
+	}()
+	mp := p.(*dmlproducer.MockDMLProducer)
+	require.Eventually(t, func() bool {
+		return len(mp.GetAllEvents()) == eventNum
+	}, 3*time.Second, 100*time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	// messages of a partition are sent in order.
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, flushed, partitionNum)
+	for key, commitTs := range flushed {
+		require.Len(t, commitTs, eventNum/partitionNum, key)
+		require.IsIncreasing(t, commitTs, key)
+	}
+}
+
+// discardDMLProducer drops the messages without any latency, so the benchmark
+// measures the encoding instead of the network writes.
+type discardDMLProducer struct{}
+
+func (p *discardDMLProducer) AsyncSendMessage(
+	_ context.Context, _ string, _ int32, message *common.Message,
+) error {
+	message.Callback()
+	return nil
+}
+
+func (p *discardDMLProducer) Close() {}
+
+func BenchmarkNonBatchEncodeWorker(b *testing.B) {
+	for _, encoderConcurrency := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("encoder-concurrency-%d", encoderConcurrency), func(b *testing.B) {
+			benchmarkNonBatchEncodeWorker(b, encoderConcurrency)
+		})
+	}
+}
+
+func benchmarkNonBatchEncodeWorker(b *testing.B, encoderConcurrency int) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	encoderConfig := common.NewConfig(config.ProtocolCanalJSON).WithMaxMessageBytes(config.DefaultMaxMessageBytes)
+	builder, err := builder.NewEventBatchEncoderBuilder(ctx, encoderConfig)
+	require.Nil(b, err)
+	statistics := metrics.NewStatistics(ctx, sink.RowSink)
+	worker := newWorker(model.DefaultChangeFeedID("bench"), config.ProtocolCanalJSON,
+		builder, encoderConcurrency, 4, &discardDMLProducer{}, statistics)
+	defer worker.close()
+
+	// wide rows make the encoding the bottleneck of the worker.
+	columns := make([]*model.Column, 0, 64)
+	for i := 0; i < cap(columns); i++ {
+		columns = append(columns, &model.Column{
+			Name:  fmt.Sprintf("col%d", i),
+			Type:  mysql.TypeVarchar,
+			Value: []byte(strings.Repeat("a", 64)),
+		})
+	}
+	var flushed sync.WaitGroup
+	flushed.Add(b.N)
+	tableStatus := state.TableSinkSinking
+	events := make([]mqEvent, 0, b.N)
+	for i := 0; i < b.N; i++ {
+		events = append(events, mqEvent{
+			rowEvent: &eventsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs: uint64(i + 1),
+					Table:    &model.TableName{Schema: "a", Table: "b"},
+					Columns:  columns,
+				},
+				Callback:  flushed.Done,
+				SinkState: &tableStatus,
+			},
+			key: mqv1.TopicPartitionKey{Topic: "test", Partition: int32(i % 32)},
+		})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = worker.run(ctx)
+	}()
+
+	b.ResetTimer()
+	for _, event := range events {
+		worker.msgChan.In() <- event
+	}
+	flushed.Wait()
+	b.StopTimer()
+
+	cancel()
+	wg.Wait()
+}
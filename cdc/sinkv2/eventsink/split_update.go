@@ -0,0 +1,123 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"sync/atomic"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// ShouldSplitUpdateRow returns whether the row is an update event which should
+// be split into a delete event and an insert event by the strategy, which is
+// one of the `config.SplitUpdateXXX`. An empty strategy means never.
+func ShouldSplitUpdateRow(row *model.RowChangedEvent, strategy string) bool {
+	if row == nil || !row.IsUpdate() {
+		return false
+	}
+	switch strategy {
+	case config.SplitUpdateAlways:
+		return true
+	case config.SplitUpdatePKChangeOnly:
+		for i := range row.Columns {
+			if i >= len(row.PreColumns) {
+				break
+			}
+			col, preCol := row.Columns[i], row.PreColumns[i]
+			if col != nil && col.Flag.IsHandleKey() &&
+				preCol != nil && preCol.Flag.IsHandleKey() &&
+				model.ColumnValueString(col.Value) != model.ColumnValueString(preCol.Value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// SplitUpdateRow splits an update event into a delete event carrying the old
+// values and an insert event carrying the new values. The columns are shared
+// with the update event instead of being copied.
+func SplitUpdateRow(row *model.RowChangedEvent) (
+	deleteRow *model.RowChangedEvent, insertRow *model.RowChangedEvent,
+) {
+	deleteEvent := *row
+	deleteEvent.Columns = nil
+
+	insertEvent := *row
+	insertEvent.PreColumns = nil
+	// The delete event is the first event of the txn if the update event is.
+	insertEvent.SplitTxn = false
+
+	return &deleteEvent, &insertEvent
+}
+
+// SplitUpdateRows splits the update events in rows by the strategy, the delete
+// event of an update event is placed right before its insert event. The rows
+// are returned as is if no event is split.
+func SplitUpdateRows(rows []*model.RowChangedEvent, strategy string) []*model.RowChangedEvent {
+	var res []*model.RowChangedEvent
+	for i, row := range rows {
+		if !ShouldSplitUpdateRow(row, strategy) {
+			if res != nil {
+				res = append(res, row)
+			}
+			continue
+		}
+		if res == nil {
+			res = make([]*model.RowChangedEvent, 0, len(rows)+1)
+			res = append(res, rows[:i]...)
+		}
+		deleteRow, insertRow := SplitUpdateRow(row)
+		res = append(res, deleteRow, insertRow)
+	}
+	if res == nil {
+		return rows
+	}
+	return res
+}
+
+// SplitUpdateEvent splits a callbackable update event into a delete event and
+// an insert event, the callback of the update event is called once both of
+// them are called back.
+func SplitUpdateEvent(event *RowChangeCallbackableEvent) (
+	deleteEvent *RowChangeCallbackableEvent, insertEvent *RowChangeCallbackableEvent,
+) {
+	deleteRow, insertRow := SplitUpdateRow(event.Event)
+
+	var pending int32 = 2
+	callback := func() {
+		if atomic.AddInt32(&pending, -1) == 0 && event.Callback != nil {
+			event.Callback()
+		}
+	}
+
+	deleteEvent = &RowChangeCallbackableEvent{
+		Event:     deleteRow,
+		Callback:  callback,
+		SinkState: event.SinkState,
+		Conflicts: event.Conflicts,
+		Writes:    event.Writes,
+	}
+	insertEvent = &RowChangeCallbackableEvent{
+		Event:     insertRow,
+		Callback:  callback,
+		SinkState: event.SinkState,
+		Conflicts: event.Conflicts,
+		Writes:    event.Writes,
+	}
+	return deleteEvent, insertEvent
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsink
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitUpdateRows(t *testing.T) {
+	t.Parallel()
+
+	newRow := func(pre, cur string) *model.RowChangedEvent {
+		row := &model.RowChangedEvent{CommitTs: 1, SplitTxn: true}
+		if pre != "" {
+			row.PreColumns = []*model.Column{
+				{Name: "id", Flag: model.HandleKeyFlag, Value: pre},
+				{Name: "v", Value: 1},
+			}
+		}
+		if cur != "" {
+			row.Columns = []*model.Column{
+				{Name: "id", Flag: model.HandleKeyFlag, Value: cur},
+				{Name: "v", Value: 2},
+			}
+		}
+		return row
+	}
+	insert, update, pkUpdate := newRow("", "a"), newRow("a", "a"), newRow("a", "b")
+
+	require.False(t, ShouldSplitUpdateRow(insert, config.SplitUpdateAlways))
+	require.True(t, ShouldSplitUpdateRow(update, config.SplitUpdateAlways))
+	require.False(t, ShouldSplitUpdateRow(update, config.SplitUpdatePKChangeOnly))
+	require.True(t, ShouldSplitUpdateRow(pkUpdate, config.SplitUpdatePKChangeOnly))
+	require.False(t, ShouldSplitUpdateRow(pkUpdate, config.SplitUpdateNever))
+	require.False(t, ShouldSplitUpdateRow(pkUpdate, ""))
+
+	rows := []*model.RowChangedEvent{insert, update}
+	require.Equal(t, rows, SplitUpdateRows(rows, config.SplitUpdatePKChangeOnly))
+
+	rows = []*model.RowChangedEvent{insert, pkUpdate, update}
+	split := SplitUpdateRows(rows, config.SplitUpdatePKChangeOnly)
+	require.Len(t, split, 4)
+	require.Same(t, insert, split[0])
+	require.True(t, split[1].IsDelete())
+	require.Equal(t, pkUpdate.PreColumns, split[1].PreColumns)
+	require.True(t, split[1].SplitTxn)
+	require.True(t, split[2].IsInsert())
+	require.Equal(t, pkUpdate.Columns, split[2].Columns)
+	require.False(t, split[2].SplitTxn)
+	require.Same(t, update, split[3])
+	// The original event is not changed.
+	require.True(t, pkUpdate.IsUpdate())
+
+	called := 0
+	deleteEvent, insertEvent := SplitUpdateEvent(&RowChangeCallbackableEvent{
+		Event:    pkUpdate,
+		Callback: func() { called++ },
+	})
+	require.True(t, deleteEvent.Event.IsDelete())
+	require.True(t, insertEvent.Event.IsInsert())
+	insertEvent.Callback()
+	require.Equal(t, 0, called)
+	deleteEvent.Callback()
+	require.Equal(t, 1, called)
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"go.uber.org/zap"
+)
+
+// maxSampledErrors is the max number of distinct errors tracked for sampling.
+const maxSampledErrors = 1024
+
+// sampledError is the sampling state of an identical error.
+type sampledError struct {
+	loggedAt   time.Time
+	suppressed int
+}
+
+// dmlErrorLogger logs the errors of executing DMLs of a changefeed. The values
+// in the logs are redacted by the redaction policy of the changefeed unless
+// the redaction is lifted, and an identical error is logged at most once per
+// sample interval. It's shared by the backends of the changefeed.
+type dmlErrorLogger struct {
+	changefeedID model.ChangeFeedID
+	redactLog    string
+	interval     time.Duration
+
+	mu      sync.Mutex
+	sampled map[string]*sampledError
+}
+
+func newDMLErrorLogger(changefeedID model.ChangeFeedID, cfg *pmysql.Config) *dmlErrorLogger {
+	return &dmlErrorLogger{
+		changefeedID: changefeedID,
+		redactLog:    cfg.RedactLog,
+		interval:     cfg.ErrorLogSampleInterval,
+		sampled:      make(map[string]*sampledError),
+	}
+}
+
+// logDMLTxnErr logs the error of executing the query, and returns the error.
+func (l *dmlErrorLogger) logDMLTxnErr(
+	err error, start time.Time, query string, count int, startTs []model.Ts,
+) error {
+	retryable := isRetryableDMLError(err)
+	now := time.Now()
+	ok, suppressed := l.sample(fmt.Sprintf("%t:%s:%s", retryable, query, err), now)
+	if !ok {
+		return err
+	}
+
+	errField := l.errorField(err, now)
+	if !pmysql.LogRedactionLifted(l.changefeedID, now) {
+		query = pmysql.RedactSQL(l.redactLog, query)
+	}
+	changefeed := fmt.Sprintf("%s.%s", l.changefeedID.Namespace, l.changefeedID.ID)
+	if retryable {
+		log.Warn("execute DMLs with error, retry later",
+			errField, zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.Uint64s("startTs", startTs),
+			zap.String("changefeed", changefeed),
+			zap.Int("suppressed", suppressed))
+	} else {
+		log.Error("execute DMLs with error, can not retry",
+			errField, zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.String("changefeed", changefeed),
+			zap.Int("suppressed", suppressed))
+	}
+	return err
+}
+
+// errorField returns the log field of the error, the values in the error
+// message are redacted unless the redaction is lifted at `now`.
+func (l *dmlErrorLogger) errorField(err error, now time.Time) zap.Field {
+	if l.redactLog == config.RedactLogNone || pmysql.LogRedactionLifted(l.changefeedID, now) {
+		return zap.Error(err)
+	}
+	return zap.String("error", pmysql.RedactErrorMessage(l.redactLog, err.Error()))
+}
+
+// sample returns true if the error identified by the key should be logged at
+// `now`, and the number of the identical errors suppressed since it's logged
+// last time.
+func (l *dmlErrorLogger) sample(key string, now time.Time) (bool, int) {
+	if l.interval <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s, ok := l.sampled[key]; ok {
+		if now.Sub(s.loggedAt) < l.interval {
+			s.suppressed++
+			return false, 0
+		}
+		suppressed := s.suppressed
+		s.loggedAt, s.suppressed = now, 0
+		return true, suppressed
+	}
+
+	if len(l.sampled) >= maxSampledErrors {
+		for k, s := range l.sampled {
+			if now.Sub(s.loggedAt) >= l.interval {
+				delete(l.sampled, k)
+			}
+		}
+		// too many distinct errors within an interval, start over.
+		if len(l.sampled) >= maxSampledErrors {
+			l.sampled = make(map[string]*sampledError)
+		}
+	}
+	l.sampled[key] = &sampledError{loggedAt: now}
+	return true, 0
+}
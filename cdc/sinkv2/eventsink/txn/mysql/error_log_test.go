@@ -0,0 +1,95 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+	"time"
+
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDMLErrorLoggerSample(t *testing.T) {
+	t.Parallel()
+
+	cfg := pmysql.NewConfig()
+	cfg.ErrorLogSampleInterval = time.Minute
+	l := newDMLErrorLogger(model.DefaultChangeFeedID("test"), cfg)
+
+	now := time.Now()
+	ok, suppressed := l.sample("a", now)
+	require.True(t, ok)
+	require.Zero(t, suppressed)
+	for i := 0; i < 3; i++ {
+		ok, _ = l.sample("a", now.Add(time.Second))
+		require.False(t, ok)
+	}
+	// a different error is logged.
+	ok, _ = l.sample("b", now.Add(time.Second))
+	require.True(t, ok)
+
+	// the suppressed ones are reported with the next logged one.
+	ok, suppressed = l.sample("a", now.Add(time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 3, suppressed)
+
+	// every error is logged without the interval.
+	l = newDMLErrorLogger(model.DefaultChangeFeedID("test"), pmysql.NewConfig())
+	for i := 0; i < 3; i++ {
+		ok, _ = l.sample("a", now)
+		require.True(t, ok)
+	}
+}
+
+func TestDMLErrorLoggerRedact(t *testing.T) {
+	zapcore, logs := observer.New(zap.WarnLevel)
+	conf := &log.Config{Level: "warn", File: log.FileLogConfig{}}
+	_, r, _ := log.InitLogger(conf)
+	restoreFn := log.ReplaceGlobals(zap.New(zapcore), r)
+	defer restoreFn()
+
+	changefeedID := model.DefaultChangeFeedID("test-redact-log")
+	cfg := pmysql.NewConfig()
+	cfg.RedactLog = config.RedactLogElide
+	l := newDMLErrorLogger(changefeedID, cfg)
+	err := &dmysql.MySQLError{
+		Number:  1062,
+		Message: "Duplicate entry 'alice@example.com' for key 'email'",
+	}
+	query := "INSERT INTO `t` (`id`,`email`) VALUES (1,'alice@example.com')"
+
+	require.Equal(t, error(err), l.logDMLTxnErr(err, time.Now(), query, 1, nil))
+	entries := logs.TakeAll()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	require.Equal(t, "INSERT INTO `t` (`id`,`email`) VALUES (?,?)", fields["query"])
+	require.NotContains(t, fields["error"], "alice@example.com")
+	require.Contains(t, fields["error"], "1062")
+
+	// the values are logged as they are after the redaction is lifted.
+	pmysql.LiftLogRedaction(changefeedID, time.Minute, "127.0.0.1", "debug")
+	l.logDMLTxnErr(err, time.Now(), query, 1, nil)
+	entries = logs.FilterMessageSnippet("execute DMLs with error").TakeAll()
+	require.Len(t, entries, 1)
+	fields = entries[0].ContextMap()
+	require.Equal(t, query, fields["query"])
+	require.Contains(t, fields["error"], "alice@example.com")
+}
@@ -19,6 +19,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	dmysql "github.com/go-sql-driver/mysql"
@@ -58,6 +59,9 @@ type mysqlBackend struct {
 	db          *sql.DB
 	cfg         *pmysql.Config
 	dmlMaxRetry uint64
+	// schemaChecker is nil if the downstream schema check is disabled.
+	schemaChecker *schemaChecker
+	errLogger     *dmlErrorLogger
 
 	events []*eventsink.TxnCallbackableEvent
 	rows   int
@@ -65,6 +69,9 @@ type mysqlBackend struct {
 	statistics                    *metrics.Statistics
 	metricTxnSinkDMLBatchCommit   prometheus.Observer
 	metricTxnSinkDMLBatchCallback prometheus.Observer
+	// metricTxnSinkDMLMultiStmtFallback counts how many times a failed
+	// multi-statement is re-executed statement by statement.
+	metricTxnSinkDMLMultiStmtFallback prometheus.Counter
 }
 
 // NewMySQLBackends creates a new MySQL sink using schema storage
@@ -102,18 +109,28 @@ func NewMySQLBackends(
 	db.SetMaxIdleConns(cfg.WorkerCount)
 	db.SetMaxOpenConns(cfg.WorkerCount)
 
+	var checker *schemaChecker
+	if cfg.SchemaDriftPolicy != pmysql.SchemaDriftPolicyNone {
+		checker = newSchemaChecker(db, changefeed, cfg)
+	}
+	errLogger := newDMLErrorLogger(changefeedID, cfg)
+
 	backends := make([]*mysqlBackend, 0, cfg.WorkerCount)
 	for i := 0; i < cfg.WorkerCount; i++ {
 		backends = append(backends, &mysqlBackend{
-			workerID:    i,
-			changefeed:  changefeed,
-			db:          db,
-			cfg:         cfg,
-			dmlMaxRetry: defaultDMLMaxRetry,
-			statistics:  statistics,
+			workerID:      i,
+			changefeed:    changefeed,
+			db:            db,
+			cfg:           cfg,
+			dmlMaxRetry:   defaultDMLMaxRetry,
+			schemaChecker: checker,
+			errLogger:     errLogger,
+			statistics:    statistics,
 
 			metricTxnSinkDMLBatchCommit:   txn.SinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 			metricTxnSinkDMLBatchCallback: txn.SinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+			metricTxnSinkDMLMultiStmtFallback: txn.SinkDMLMultiStmtFallback.
+				WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		})
 	}
 
@@ -121,7 +138,10 @@ func NewMySQLBackends(
 		zap.String("changefeed", changefeed),
 		zap.Int("workerCount", cfg.WorkerCount),
 		zap.Bool("forceReplicate", cfg.ForceReplicate),
-		zap.Bool("enableOldValue", cfg.EnableOldValue))
+		zap.Bool("enableOldValue", cfg.EnableOldValue),
+		zap.String("schemaDriftPolicy", cfg.SchemaDriftPolicy),
+		zap.String("redactLog", cfg.RedactLog),
+		zap.Duration("errorLogSampleInterval", cfg.ErrorLogSampleInterval))
 	return backends, nil
 }
 
@@ -146,6 +166,14 @@ func (s *mysqlBackend) Flush(ctx context.Context) (err error) {
 		failpoint.Return(errors.Trace(dmysql.ErrInvalidConn))
 	})
 
+	// Check the downstream tables before generating the DMLs, so a drifted
+	// table is reported with the drifted columns.
+	if s.schemaChecker != nil {
+		if err := s.schemaChecker.check(ctx, s.events); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	for _, event := range s.events {
 		s.statistics.ObserveRows(event.Event.Rows...)
 	}
@@ -157,7 +185,7 @@ func (s *mysqlBackend) Flush(ctx context.Context) (err error) {
 	start := time.Now()
 	if err := s.execDMLWithMaxRetries(ctx, dmls); err != nil {
 		if errors.Cause(err) != context.Canceled {
-			log.Error("execute DMLs failed", zap.Error(err))
+			log.Error("execute DMLs failed", s.errLogger.errorField(err, time.Now()))
 		}
 		return errors.Trace(err)
 	}
@@ -200,6 +228,25 @@ type preparedDMLs struct {
 	values    [][]interface{}
 	callbacks []eventsink.CallbackFunc
 	rowCount  int
+	// conflictChecks are the checks of the statements which conflict with
+	// the downstream if they affect no rows, keyed by the statement index.
+	conflictChecks map[int]conflictCheck
+}
+
+// conflictCheck records how a conflicted statement is resolved.
+type conflictCheck struct {
+	recorder *eventsink.ConflictRecorder
+	// overwrite is true if the conflicted statement is followed by a statement
+	// which overwrites the downstream row.
+	overwrite bool
+}
+
+func (c conflictCheck) record() {
+	if c.overwrite {
+		c.recorder.RecordOverwritten()
+	} else {
+		c.recorder.RecordSkipped()
+	}
 }
 
 // convert2RowChanges is a helper function that convert the row change representation
@@ -311,8 +358,9 @@ func batchSingleTxnDmls(
 	event *eventsink.TxnCallbackableEvent,
 	tableInfo *timodel.TableInfo,
 	translateToInsert bool,
-) (sqls []string, values [][]interface{}) {
+) (sqls []string, values [][]interface{}, writes int) {
 	insertRows, updateRows, deleteRows := groupRowsByType(event, tableInfo, !translateToInsert)
+	writes = len(insertRows) + len(updateRows) + len(deleteRows)
 
 	if len(deleteRows) > 0 {
 		sql, value := sqlmodel.GenDeleteSQL(deleteRows...)
@@ -364,6 +412,18 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 	values := make([][]interface{}, 0, s.rows)
 	callbacks := make([]eventsink.CallbackFunc, 0, len(s.events))
 	replaces := make(map[string][][]interface{})
+	var conflictChecks map[int]conflictCheck
+	// addConflictCheck checks the next statement for conflicts if the event
+	// records them.
+	addConflictCheck := func(recorder *eventsink.ConflictRecorder, overwrite bool) {
+		if recorder == nil {
+			return
+		}
+		if conflictChecks == nil {
+			conflictChecks = make(map[int]conflictCheck)
+		}
+		conflictChecks[len(sqls)] = conflictCheck{recorder: recorder, overwrite: overwrite}
+	}
 
 	// flushes the cached batch replace or insert DMLs,
 	// to keep the sequence of DMLs
@@ -417,14 +477,17 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 			if hasHandleKey(tableColumns) {
 				// TODO(dongmen): find a better way to get table info.
 				tableInfo := model.BuildTiDBTableInfo(tableColumns, firstRow.IndexColumns)
-				sql, value := batchSingleTxnDmls(event, tableInfo, translateToInsert)
+				sql, value, writes := batchSingleTxnDmls(event, tableInfo, translateToInsert)
 				sqls = append(sqls, sql...)
 				values = append(values, value...)
+				event.Writes.Record(len(event.Event.Rows), writes)
 				continue
 			}
 		}
 
 		quoteTable := firstRow.Table.QuoteString()
+		// writes is the number of rows written to the downstream for the event.
+		writes := 0
 		for _, row := range event.Event.Rows {
 			var query string
 			var args []interface{}
@@ -434,8 +497,11 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 				flushCacheDMLs()
 				query, args = prepareUpdate(quoteTable, row.PreColumns, row.Columns, s.cfg.ForceReplicate)
 				if query != "" {
+					// the row to update has been modified or deleted on the other side.
+					addConflictCheck(event.Conflicts, false)
 					sqls = append(sqls, query)
 					values = append(values, args)
+					writes++
 				}
 				continue
 			}
@@ -450,8 +516,12 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 				flushCacheDMLs()
 				query, args = prepareDelete(quoteTable, row.PreColumns, s.cfg.ForceReplicate)
 				if query != "" {
+					// the row to delete has been modified or deleted on the other side,
+					// for an update event the new row is written by the following REPLACE.
+					addConflictCheck(event.Conflicts, len(row.Columns) != 0)
 					sqls = append(sqls, query)
 					values = append(values, args)
+					writes++
 				}
 			}
 
@@ -471,16 +541,19 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 							replaces[query] = make([][]interface{}, 0)
 						}
 						replaces[query] = append(replaces[query], args)
+						writes++
 					}
 				} else {
 					query, args = prepareReplace(quoteTable, row.Columns, true /* appendPlaceHolder */, translateToInsert)
 					if query != "" {
 						sqls = append(sqls, query)
 						values = append(values, args)
+						writes++
 					}
 				}
 			}
 		}
+		event.Writes.Record(len(event.Event.Rows), writes)
 	}
 	flushCacheDMLs()
 
@@ -489,11 +562,12 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 	}
 
 	return &preparedDMLs{
-		startTs:   startTs,
-		sqls:      sqls,
-		values:    values,
-		callbacks: callbacks,
-		rowCount:  rowCount,
+		startTs:        startTs,
+		sqls:           sqls,
+		values:         values,
+		callbacks:      callbacks,
+		rowCount:       rowCount,
+		conflictChecks: conflictChecks,
 	}
 }
 
@@ -513,7 +587,7 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 
 		failpoint.Inject("MySQLSinkTxnRandomError", func() {
 			fmt.Printf("start to random error")
-			err := logDMLTxnErr(errors.Trace(driver.ErrBadConn), start, s.changefeed, "failpoint", 0, nil)
+			err := s.errLogger.logDMLTxnErr(errors.Trace(driver.ErrBadConn), start, "failpoint", 0, nil)
 			failpoint.Return(err)
 		})
 		failpoint.Inject("MySQLSinkHangLongTime", func() {
@@ -529,52 +603,26 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 		})
 
 		err := s.statistics.RecordBatchExecution(func() (int, error) {
-			tx, err := s.db.BeginTx(ctx, nil)
-			if err != nil {
-				return 0, logDMLTxnErr(
-					cerror.WrapError(cerror.ErrMySQLTxnError, err),
-					start, s.changefeed, "BEGIN", dmls.rowCount, dmls.startTs)
-			}
-
-			for i, query := range dmls.sqls {
-				args := dmls.values[i]
-				log.Debug("exec row", zap.Int("workerID", s.workerID),
-					zap.String("sql", query), zap.Any("args", args))
-				if _, err := tx.ExecContext(ctx, query, args...); err != nil {
-					err := logDMLTxnErr(
-						cerror.WrapError(cerror.ErrMySQLTxnError, err),
-						start, s.changefeed, query, dmls.rowCount, dmls.startTs)
-					if rbErr := tx.Rollback(); rbErr != nil {
-						if errors.Cause(rbErr) != context.Canceled {
-							log.Warn("failed to rollback txn", zap.Error(rbErr))
-						}
-					}
+			if s.cfg.MultiStmtEnable && len(dmls.sqls) > 1 {
+				err := s.multiStmtExecute(ctx, dmls, start)
+				if err == nil {
+					return dmls.rowCount, nil
+				}
+				if errors.Cause(err) == context.Canceled {
 					return 0, err
 				}
+				// A failed multi-statement doesn't tell which statement fails,
+				// so re-run the statements one by one to pinpoint the failed one.
+				s.metricTxnSinkDMLMultiStmtFallback.Inc()
+				log.Warn("execute multi-statement DMLs failed, fallback to execute them one by one",
+					zap.Int("workerID", s.workerID),
+					zap.String("changefeed", s.changefeed),
+					zap.Int("numOfStatements", len(dmls.sqls)),
+					s.errLogger.errorField(err, time.Now()))
 			}
-
-			// we set write source for each txn,
-			// so we can use it to trace the data source
-			if err = s.setWriteSource(ctx, tx); err != nil {
-				err := logDMLTxnErr(
-					cerror.WrapError(cerror.ErrMySQLTxnError, err),
-					start, s.changefeed,
-					fmt.Sprintf("SET SESSION %s = %d", "tidb_cdc_write_source",
-						s.cfg.SourceID),
-					dmls.rowCount, dmls.startTs)
-				if rbErr := tx.Rollback(); rbErr != nil {
-					if errors.Cause(rbErr) != context.Canceled {
-						log.Warn("failed to rollback txn", zap.Error(rbErr))
-					}
-				}
+			if err := s.sequenceExecute(ctx, dmls, start); err != nil {
 				return 0, err
 			}
-
-			if err = tx.Commit(); err != nil {
-				return 0, logDMLTxnErr(
-					cerror.WrapError(cerror.ErrMySQLTxnError, err),
-					start, s.changefeed, "COMMIT", dmls.rowCount, dmls.startTs)
-			}
 			return dmls.rowCount, nil
 		})
 		if err != nil {
@@ -591,23 +639,112 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 		retry.WithIsRetryableErr(isRetryableDMLError))
 }
 
-func logDMLTxnErr(
-	err error, start time.Time, changefeed string,
-	query string, count int, startTs []model.Ts,
-) error {
-	if isRetryableDMLError(err) {
-		log.Warn("execute DMLs with error, retry later",
-			zap.Error(err), zap.Duration("duration", time.Since(start)),
-			zap.String("query", query), zap.Int("count", count),
-			zap.Uint64s("startTs", startTs),
-			zap.String("changefeed", changefeed))
-	} else {
-		log.Error("execute DMLs with error, can not retry",
-			zap.Error(err), zap.Duration("duration", time.Since(start)),
-			zap.String("query", query), zap.Int("count", count),
-			zap.String("changefeed", changefeed))
+// sequenceExecute executes the DMLs statement by statement in a transaction.
+// Conflicts with the downstream are only detected in this way, because the
+// affected rows of each statement is unknown in a multi-statement.
+func (s *mysqlBackend) sequenceExecute(ctx context.Context, dmls *preparedDMLs, start time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return s.errLogger.logDMLTxnErr(
+			cerror.WrapError(cerror.ErrMySQLTxnError, err),
+			start, "BEGIN", dmls.rowCount, dmls.startTs)
+	}
+
+	var conflicts []conflictCheck
+	for i, query := range dmls.sqls {
+		args := dmls.values[i]
+		log.Debug("exec row", zap.Int("workerID", s.workerID),
+			zap.String("sql", query), zap.Any("args", args))
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			err := s.errLogger.logDMLTxnErr(
+				cerror.WrapError(cerror.ErrMySQLTxnError,
+					errors.Annotatef(err, "statement %d of %d", i, len(dmls.sqls))),
+				start, query, dmls.rowCount, dmls.startTs)
+			s.rollback(tx)
+			return err
+		}
+		if check, ok := dmls.conflictChecks[i]; ok {
+			if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+				conflicts = append(conflicts, check)
+			}
+		}
+	}
+
+	if err := s.commit(ctx, tx, dmls, start); err != nil {
+		return err
+	}
+	// only record the conflicts of the committed transaction, so they are
+	// not counted again when the transaction is retried.
+	for _, check := range conflicts {
+		check.record()
+	}
+	if len(conflicts) > 0 {
+		log.Debug("detect conflicts with the downstream",
+			zap.Int("workerID", s.workerID),
+			zap.String("changefeed", s.changefeed),
+			zap.Int("numOfConflicts", len(conflicts)))
+	}
+	return nil
+}
+
+// multiStmtExecute executes the DMLs as one multi-statement in a transaction.
+func (s *mysqlBackend) multiStmtExecute(ctx context.Context, dmls *preparedDMLs, start time.Time) error {
+	var args []interface{}
+	for _, value := range dmls.values {
+		args = append(args, value...)
+	}
+	multiStmtSQL := strings.Join(dmls.sqls, ";")
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return s.errLogger.logDMLTxnErr(
+			cerror.WrapError(cerror.ErrMySQLTxnError, err),
+			start, "BEGIN", dmls.rowCount, dmls.startTs)
+	}
+
+	log.Debug("exec multi-statement rows", zap.Int("workerID", s.workerID),
+		zap.String("sql", multiStmtSQL), zap.Any("args", args))
+	if _, err := tx.ExecContext(ctx, multiStmtSQL, args...); err != nil {
+		err := s.errLogger.logDMLTxnErr(
+			cerror.WrapError(cerror.ErrMySQLTxnError, err),
+			start, multiStmtSQL, dmls.rowCount, dmls.startTs)
+		s.rollback(tx)
+		return err
+	}
+
+	return s.commit(ctx, tx, dmls, start)
+}
+
+// commit sets the write source and commits the transaction.
+func (s *mysqlBackend) commit(ctx context.Context, tx *sql.Tx, dmls *preparedDMLs, start time.Time) error {
+	// we set write source for each txn,
+	// so we can use it to trace the data source
+	if err := s.setWriteSource(ctx, tx); err != nil {
+		err := s.errLogger.logDMLTxnErr(
+			cerror.WrapError(cerror.ErrMySQLTxnError, err),
+			start,
+			fmt.Sprintf("SET SESSION %s = %d", "tidb_cdc_write_source",
+				s.cfg.SourceID),
+			dmls.rowCount, dmls.startTs)
+		s.rollback(tx)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return s.errLogger.logDMLTxnErr(
+			cerror.WrapError(cerror.ErrMySQLTxnError, err),
+			start, "COMMIT", dmls.rowCount, dmls.startTs)
+	}
+	return nil
+}
+
+func (s *mysqlBackend) rollback(tx *sql.Tx) {
+	if rbErr := tx.Rollback(); rbErr != nil {
+		if errors.Cause(rbErr) != context.Canceled {
+			log.Warn("failed to rollback txn", zap.Error(rbErr))
+		}
 	}
-	return err
 }
 
 func isRetryableDMLError(err error) bool {
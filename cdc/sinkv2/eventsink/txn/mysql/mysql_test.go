@@ -50,6 +50,7 @@ func newMySQLBackendWithoutDB(ctx context.Context) *mysqlBackend {
 	return &mysqlBackend{
 		statistics: metrics.NewStatistics(ctx, sink.TxnSink),
 		cfg:        cfg,
+		errLogger:  newDMLErrorLogger(model.ChangeFeedID{}, cfg),
 	}
 }
 
@@ -628,6 +629,184 @@ func TestMysqlSinkNotRetryErrDupEntry(t *testing.T) {
 	require.Nil(t, sink.Close())
 }
 
+func TestMultiStmtFallbackToSequenceExecute(t *testing.T) {
+	errDup := mysql.NewErr(mysql.ErrDupEntry)
+	rows := []*model.RowChangedEvent{
+		{
+			StartTs:       2,
+			CommitTs:      3,
+			ReplicatingTs: 1,
+			Table:         &model.TableName{Schema: "s1", Table: "t1", TableID: 1},
+			Columns: []*model.Column{
+				{
+					Name:  "a",
+					Type:  mysql.TypeLong,
+					Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+					Value: 1,
+				},
+			},
+		},
+		{
+			StartTs:       2,
+			CommitTs:      3,
+			ReplicatingTs: 1,
+			Table:         &model.TableName{Schema: "s1", Table: "t1", TableID: 1},
+			Columns: []*model.Column{
+				{
+					Name:  "a",
+					Type:  mysql.TypeLong,
+					Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+					Value: 2,
+				},
+			},
+		},
+	}
+
+	dbIndex := 0
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() { dbIndex++ }()
+
+		if dbIndex == 0 {
+			// test db
+			db, err := pmysql.MockTestDB(true)
+			require.Nil(t, err)
+			return db, nil
+		}
+
+		// normal db
+		db, mock := newTestMockDB(t)
+		// the first batch succeeds in multi-statement
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?);INSERT INTO `s1`.`t1`(`a`) VALUES (?)").
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectCommit()
+		// the second batch fails in multi-statement, and then the
+		// statements are executed one by one.
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?);INSERT INTO `s1`.`t1`(`a`) VALUES (?)").
+			WithArgs(1, 2).
+			WillReturnError(errDup)
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?)").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?)").
+			WithArgs(2).
+			WillReturnError(errDup)
+		mock.ExpectRollback()
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changefeed := "test-changefeed"
+	contextutil.PutChangefeedIDInCtx(ctx, model.DefaultChangeFeedID(changefeed))
+	sinkURI, err := url.Parse("mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1" +
+		"&safe-mode=false&batch-replace-enable=false&multi-stmt-enable=true")
+	require.Nil(t, err)
+	sink, err := newMySQLBackend(ctx, sinkURI,
+		config.GetDefaultReplicaConfig(), mockGetDBConn)
+	require.Nil(t, err)
+	sink.setDMLMaxRetry(1)
+
+	_ = sink.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: rows},
+	})
+	require.Nil(t, sink.Flush(context.Background()))
+
+	_ = sink.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: rows},
+	})
+	err = sink.Flush(context.Background())
+	require.Equal(t, errDup, errors.Cause(err))
+	require.Contains(t, err.Error(), "statement 1 of 2")
+
+	require.Nil(t, sink.Close())
+}
+
+func TestMySQLBackendRecordConflicts(t *testing.T) {
+	newRow := func(value int) []*model.Column {
+		return []*model.Column{{
+			Name:  "a",
+			Type:  mysql.TypeLong,
+			Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+			Value: value,
+		}}
+	}
+	table := &model.TableName{Schema: "s1", Table: "t1", TableID: 1}
+	rows := []*model.RowChangedEvent{
+		// update, the old row is not found in the downstream.
+		{StartTs: 2, CommitTs: 3, Table: table, PreColumns: newRow(1), Columns: newRow(1)},
+		// delete, the row is not found in the downstream.
+		{StartTs: 2, CommitTs: 3, Table: table, PreColumns: newRow(2)},
+		// delete, the row is found in the downstream.
+		{StartTs: 2, CommitTs: 3, Table: table, PreColumns: newRow(3)},
+	}
+
+	dbIndex := 0
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() { dbIndex++ }()
+
+		if dbIndex == 0 {
+			// test db
+			db, err := pmysql.MockTestDB(true)
+			require.Nil(t, err)
+			return db, nil
+		}
+
+		// normal db
+		db, mock := newTestMockDB(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `s1`.`t1` WHERE `a` = ? LIMIT 1;").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("REPLACE INTO `s1`.`t1`(`a`) VALUES (?);").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM `s1`.`t1` WHERE `a` = ? LIMIT 1;").
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM `s1`.`t1` WHERE `a` = ? LIMIT 1;").
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changefeed := "test-changefeed"
+	contextutil.PutChangefeedIDInCtx(ctx, model.DefaultChangeFeedID(changefeed))
+	sinkURI, err := url.Parse("mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1" +
+		"&safe-mode=true&batch-replace-enable=false")
+	require.Nil(t, err)
+	sink, err := newMySQLBackend(ctx, sinkURI,
+		config.GetDefaultReplicaConfig(), mockGetDBConn)
+	require.Nil(t, err)
+
+	conflicts := &eventsink.ConflictRecorder{}
+	writes := &eventsink.WriteAmplificationRecorder{}
+	_ = sink.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+		Event:     &model.SingleTableTxn{Rows: rows},
+		Conflicts: conflicts,
+		Writes:    writes,
+	})
+	require.Nil(t, sink.Flush(context.Background()))
+	require.Equal(t, eventsink.ConflictStats{
+		Detected:    2,
+		Overwritten: 1,
+		Skipped:     1,
+	}, conflicts.Stats())
+	// the update is written as a DELETE and a REPLACE in safe mode.
+	require.Equal(t, 4.0/3, writes.Amplification())
+
+	require.Nil(t, sink.Close())
+}
+
 func TestNewMySQLBackendExecDDL(t *testing.T) {
 	// TODO: fill it.
 }
@@ -0,0 +1,239 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"go.uber.org/zap"
+)
+
+const queryDownstreamColumns = "SELECT COLUMN_NAME FROM information_schema.COLUMNS " +
+	"WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION"
+
+// schemaChecker checks whether the columns of the downstream tables drift
+// from the upstream schema before DMLs are applied to them, so that a table
+// altered manually in the downstream is reported with the drifted columns
+// instead of a vague error of the DMLs. It is shared by all backends, the
+// downstream is queried without holding the lock, so a slow query of a table
+// doesn't block the backends writing other tables.
+type schemaChecker struct {
+	db         *sql.DB
+	changefeed string
+	policy     string
+	interval   time.Duration
+
+	// mu only protects tables.
+	mu     sync.Mutex
+	tables map[model.TableID]*tableSchemaState
+}
+
+// tableSchemaState is the result of the last check of a table.
+type tableSchemaState struct {
+	// version is the version of the upstream table info which is checked,
+	// a different version means a DDL of the table has been executed.
+	version   uint64
+	checkedAt time.Time
+	// missing are the upstream columns not found in the downstream table,
+	// they are omitted from the DMLs.
+	missing map[string]struct{}
+}
+
+func newSchemaChecker(db *sql.DB, changefeed string, cfg *pmysql.Config) *schemaChecker {
+	return &schemaChecker{
+		db:         db,
+		changefeed: changefeed,
+		policy:     cfg.SchemaDriftPolicy,
+		interval:   cfg.SchemaCheckInterval,
+		tables:     make(map[model.TableID]*tableSchemaState),
+	}
+}
+
+// check checks the downstream tables of the events. The columns missing in
+// the downstream are omitted from the rows if the policy is adapt, otherwise
+// an error naming the drifted columns is returned.
+func (c *schemaChecker) check(ctx context.Context, events []*eventsink.TxnCallbackableEvent) error {
+	for _, event := range events {
+		if len(event.Event.Rows) == 0 {
+			continue
+		}
+		missing, err := c.checkTable(ctx, event.Event.Rows[0])
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		for _, row := range event.Event.Rows {
+			omitColumns(row.Columns, missing)
+			omitColumns(row.PreColumns, missing)
+		}
+	}
+	return nil
+}
+
+func (c *schemaChecker) checkTable(
+	ctx context.Context, row *model.RowChangedEvent,
+) (map[string]struct{}, error) {
+	var version uint64
+	if row.TableInfo != nil {
+		version = row.TableInfo.Version
+	}
+	tableID := row.Table.TableID
+	c.mu.Lock()
+	state, ok := c.tables[tableID]
+	c.mu.Unlock()
+	if ok && state.version == version && time.Since(state.checkedAt) < c.interval {
+		return state.missing, nil
+	}
+
+	downstream, err := c.queryColumns(ctx, row.Table)
+	if err != nil {
+		return nil, err
+	}
+	state = &tableSchemaState{version: version, checkedAt: time.Now()}
+	missing, err := c.diffColumns(row, downstream, state)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		delete(c.tables, tableID)
+		return nil, err
+	}
+	c.tables[tableID] = state
+	return missing, nil
+}
+
+// diffColumns compares the columns of the row with the downstream columns,
+// and records the omitted columns in the state if the drift is tolerated.
+func (c *schemaChecker) diffColumns(
+	row *model.RowChangedEvent, downstream downstreamColumns, state *tableSchemaState,
+) (map[string]struct{}, error) {
+	// The downstream table doesn't exist, leave it to the DMLs to report.
+	if len(downstream.names) == 0 {
+		return nil, nil
+	}
+
+	columns := row.Columns
+	if len(columns) == 0 {
+		columns = row.PreColumns
+	}
+	expected := make(map[string]struct{}, len(columns))
+	var missing []string
+	handleKeyMissing := false
+	for _, col := range columns {
+		if col == nil || col.Flag.IsGeneratedColumn() {
+			continue
+		}
+		name := strings.ToLower(col.Name)
+		expected[name] = struct{}{}
+		if _, ok := downstream.set[name]; !ok {
+			missing = append(missing, col.Name)
+			handleKeyMissing = handleKeyMissing || col.Flag.IsHandleKey()
+		}
+	}
+	var extra []string
+	for _, name := range downstream.names {
+		if _, ok := expected[strings.ToLower(name)]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return nil, nil
+	}
+
+	table := row.Table.QuoteString()
+	// Missing handle key columns can't be omitted, because they are used to
+	// locate the rows in the downstream.
+	if len(missing) > 0 && (c.policy == pmysql.SchemaDriftPolicyFail || handleKeyMissing) {
+		err := cerror.ErrMySQLSchemaDrift.GenWithStackByArgs(table, missing, extra)
+		log.Error("downstream table drifts from the upstream schema",
+			zap.String("changefeed", c.changefeed),
+			zap.String("table", table),
+			zap.Strings("missingColumns", missing),
+			zap.Strings("extraColumns", extra),
+			zap.String("policy", c.policy),
+			zap.Bool("handleKeyMissing", handleKeyMissing),
+			zap.Error(err))
+		return nil, err
+	}
+	// Extra downstream columns are not written by the DMLs, so they are fine
+	// as long as they can be filled with default values.
+	log.Warn("downstream table drifts from the upstream schema",
+		zap.String("changefeed", c.changefeed),
+		zap.String("table", table),
+		zap.Strings("missingColumns", missing),
+		zap.Strings("extraColumns", extra),
+		zap.String("policy", c.policy))
+	if len(missing) > 0 {
+		state.missing = make(map[string]struct{}, len(missing))
+		for _, name := range missing {
+			state.missing[strings.ToLower(name)] = struct{}{}
+		}
+	}
+	return state.missing, nil
+}
+
+// downstreamColumns are the columns of a downstream table.
+type downstreamColumns struct {
+	names []string
+	// set is keyed by the lower case column names.
+	set map[string]struct{}
+}
+
+func (c *schemaChecker) queryColumns(
+	ctx context.Context, table *model.TableName,
+) (downstreamColumns, error) {
+	var res downstreamColumns
+	rows, err := c.db.QueryContext(ctx, queryDownstreamColumns, table.Schema, table.Table)
+	if err != nil {
+		return res, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+	res.set = make(map[string]struct{})
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return res, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		res.names = append(res.names, name)
+		res.set[strings.ToLower(name)] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return res, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return res, nil
+}
+
+// omitColumns sets the missing columns to nil, which are skipped when
+// building the DMLs.
+func omitColumns(cols []*model.Column, missing map[string]struct{}) {
+	for i, col := range cols {
+		if col == nil {
+			continue
+		}
+		if _, ok := missing[strings.ToLower(col.Name)]; ok {
+			cols[i] = nil
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func newSchemaDriftRows(version uint64) []*model.RowChangedEvent {
+	return []*model.RowChangedEvent{{
+		StartTs:       2,
+		CommitTs:      3,
+		ReplicatingTs: 1,
+		Table:         &model.TableName{Schema: "s1", Table: "t1", TableID: 1},
+		TableInfo:     &model.TableInfo{Version: version},
+		Columns: []*model.Column{
+			{
+				Name:  "a",
+				Type:  mysql.TypeLong,
+				Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+				Value: 1,
+			},
+			{
+				Name:  "b",
+				Type:  mysql.TypeLong,
+				Value: 2,
+			},
+		},
+	}}
+}
+
+func newSchemaDriftBackend(
+	t *testing.T, policy string, expect func(mock sqlmock.Sqlmock),
+) *mysqlBackend {
+	dbIndex := 0
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() { dbIndex++ }()
+
+		if dbIndex == 0 {
+			// test db
+			db, err := pmysql.MockTestDB(true)
+			require.Nil(t, err)
+			return db, nil
+		}
+
+		// normal db
+		db, mock := newTestMockDB(t)
+		expect(mock)
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	ctx := context.Background()
+	contextutil.PutChangefeedIDInCtx(ctx, model.DefaultChangeFeedID("test-changefeed"))
+	sinkURI, err := url.Parse("mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1" +
+		"&safe-mode=false&batch-replace-enable=false&schema-drift-policy=" + policy)
+	require.Nil(t, err)
+	backend, err := newMySQLBackend(ctx, sinkURI, config.GetDefaultReplicaConfig(), mockGetDBConn)
+	require.Nil(t, err)
+	backend.setDMLMaxRetry(1)
+	return backend
+}
+
+func TestSchemaDriftPolicyFail(t *testing.T) {
+	backend := newSchemaDriftBackend(t, pmysql.SchemaDriftPolicyFail, func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(queryDownstreamColumns).WithArgs("s1", "t1").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("a").AddRow("c"))
+	})
+
+	_ = backend.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: newSchemaDriftRows(1)},
+	})
+	err := backend.Flush(context.Background())
+	require.True(t, cerror.ErrMySQLSchemaDrift.Equal(err))
+	require.Contains(t, err.Error(), "missing columns: [b], extra columns: [c]")
+
+	require.Nil(t, backend.Close())
+}
+
+func TestSchemaDriftPolicyAdapt(t *testing.T) {
+	backend := newSchemaDriftBackend(t, pmysql.SchemaDriftPolicyAdapt, func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(queryDownstreamColumns).WithArgs("s1", "t1").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("A").AddRow("c"))
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?);").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		// the check result is cached for the same table info version.
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`) VALUES (?);").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		// the downstream table is checked again after a DDL.
+		mock.ExpectQuery(queryDownstreamColumns).WithArgs("s1", "t1").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("a").AddRow("b"))
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1`(`a`,`b`) VALUES (?,?);").
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+	})
+
+	for _, version := range []uint64{1, 1, 2} {
+		_ = backend.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{Rows: newSchemaDriftRows(version)},
+		})
+		require.Nil(t, backend.Flush(context.Background()))
+	}
+
+	require.Nil(t, backend.Close())
+}
+
+func TestSchemaDriftHandleKeyMissing(t *testing.T) {
+	backend := newSchemaDriftBackend(t, pmysql.SchemaDriftPolicyAdapt, func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(queryDownstreamColumns).WithArgs("s1", "t1").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("b"))
+	})
+
+	_ = backend.OnTxnEvent(&eventsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: newSchemaDriftRows(1)},
+	})
+	err := backend.Flush(context.Background())
+	require.True(t, cerror.ErrMySQLSchemaDrift.Equal(err))
+
+	require.Nil(t, backend.Close())
+}
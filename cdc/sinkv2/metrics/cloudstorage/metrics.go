@@ -36,10 +36,30 @@ var (
 		Name:      "cloud_storage_file_count",
 		Help:      "Total number of files managed by a cloud storage sink",
 	}, []string{"namespace", "changefeed"})
+
+	// CloudStorageWriteRequestCounter records the number of write requests sent
+	// to cloud storage by the type of the written files.
+	CloudStorageWriteRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "cloud_storage_write_request_count",
+		Help:      "Total number of write requests sent to cloud storage",
+	}, []string{"namespace", "changefeed", "type"})
+
+	// CloudStorageFileSizeHistogram records the size of data files written to cloud storage.
+	CloudStorageFileSizeHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "cloud_storage_file_size",
+		Help:      "Size of data files written to cloud storage",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 11), // 1KB~1GB
+	}, []string{"namespace", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file.
 func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(CloudStorageWriteBytesGauge)
 	registry.MustRegister(CloudStorageFileCountGauge)
+	registry.MustRegister(CloudStorageWriteRequestCounter)
+	registry.MustRegister(CloudStorageFileSizeHistogram)
 }
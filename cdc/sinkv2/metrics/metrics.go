@@ -63,6 +63,16 @@ var (
 			Name:      "execution_error",
 			Help:      "Total count of execution errors.",
 		}, []string{"namespace", "changefeed", "type"}) // type is for `sinkType`
+
+	// TruncatedColumnValueCounter is the counter of column values truncated
+	// by the column-value-size-limit.
+	TruncatedColumnValueCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sinkv2",
+			Name:      "truncated_column_value",
+			Help:      "Total count of column values truncated for exceeding the size limit.",
+		}, []string{"namespace", "changefeed", "schema", "table"})
 )
 
 // InitMetrics registers all metrics in this file.
@@ -71,6 +81,7 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(ExecDDLHistogram)
 	registry.MustRegister(LargeRowSizeHistogram)
 	registry.MustRegister(ExecutionErrorCounter)
+	registry.MustRegister(TruncatedColumnValueCounter)
 
 	txn.InitMetrics(registry)
 	mq.InitMetrics(registry)
@@ -69,6 +69,14 @@ var (
 			Help:      "Duration of execuing a batch of callbacks",
 			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 18), // 10ms~1300s
 		}, []string{"namespace", "changefeed"})
+
+	SinkDMLMultiStmtFallback = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sinkv2",
+			Name:      "txn_sink_dml_multi_stmt_fallback_count",
+			Help:      "The number of failed multi-statement DML batches re-executed statement by statement",
+		}, []string{"namespace", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file.
@@ -79,4 +87,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(WorkerHandledRows)
 	registry.MustRegister(SinkDMLBatchCommit)
 	registry.MustRegister(SinkDMLBatchCallback)
+	registry.MustRegister(SinkDMLMultiStmtFallback)
 }
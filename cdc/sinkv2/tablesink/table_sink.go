@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 )
 
 // TableSink is the interface for table sink.
@@ -34,6 +35,19 @@ type TableSink interface {
 	// For example, calculating the current progress from the statistics of the table sink.
 	// This is a thread-safe method.
 	GetCheckpointTs() model.ResolvedTs
+	// GetConflictStats returns the statistics of the conflicts detected when
+	// applying the events to the downstream. It returns zeros if the backend
+	// sink doesn't detect conflicts.
+	// This is a thread-safe method.
+	GetConflictStats() eventsink.ConflictStats
+	// ResetConflictStats zeroes the statistics of the detected conflicts.
+	// This is a thread-safe method.
+	ResetConflictStats()
+	// GetWriteAmplification returns the number of downstream writes divided by
+	// the number of events in the recent window. It returns 1.0 if the backend
+	// sink doesn't record its writes.
+	// This is a thread-safe method.
+	GetWriteAmplification() float64
 	// Close closes the table sink.
 	// We should make sure this method is cancellable.
 	Close(ctx context.Context)
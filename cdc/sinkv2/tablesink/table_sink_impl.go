@@ -46,6 +46,8 @@ type EventTableSink[E eventsink.TableEvent] struct {
 	// NOTICE: It is ordered by commitTs.
 	eventBuffer []E
 	state       state.TableSinkState
+	conflicts   eventsink.ConflictRecorder
+	writes      eventsink.WriteAmplificationRecorder
 
 	// For dataflow metrics.
 	metricsTableSinkTotalRows prometheus.Counter
@@ -109,6 +111,8 @@ func (e *EventTableSink[E]) UpdateResolvedTs(resolvedTs model.ResolvedTs) error
 			Event:     ev,
 			Callback:  e.progressTracker.addEvent(),
 			SinkState: &e.state,
+			Conflicts: &e.conflicts,
+			Writes:    &e.writes,
 		}
 		resolvedCallbackableEvents = append(resolvedCallbackableEvents, ce)
 	}
@@ -122,6 +126,21 @@ func (e *EventTableSink[E]) GetCheckpointTs() model.ResolvedTs {
 	return e.progressTracker.advance()
 }
 
+// GetConflictStats returns the statistics of the conflicts detected by the backend sink.
+func (e *EventTableSink[E]) GetConflictStats() eventsink.ConflictStats {
+	return e.conflicts.Stats()
+}
+
+// GetWriteAmplification returns the write amplification recorded by the backend sink.
+func (e *EventTableSink[E]) GetWriteAmplification() float64 {
+	return e.writes.Amplification()
+}
+
+// ResetConflictStats zeroes the statistics of the conflicts detected by the backend sink.
+func (e *EventTableSink[E]) ResetConflictStats() {
+	e.conflicts.Reset()
+}
+
 // Close the table sink and wait for all callbacks be called.
 // Notice: It will be blocked until all callbacks be called.
 func (e *EventTableSink[E]) Close(ctx context.Context) {
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/master"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -58,6 +59,11 @@ func main() {
 
 	utils.LogHTTPProxies(true)
 
+	if err = secret.InitGlobalResolver(cfg.SecretProviders); err != nil {
+		log.L().Error("fail to init secret providers", zap.Error(err))
+		os.Exit(2)
+	}
+
 	// 3. print process version information
 	version.LogVersionInfo("dm-master")
 	log.L().Info("", zap.Stringer("dm-master config", cfg))
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/worker"
@@ -55,6 +56,11 @@ func main() {
 
 	utils.LogHTTPProxies(true)
 
+	if err = secret.InitGlobalResolver(cfg.SecretProviders); err != nil {
+		log.L().Error("fail to init secret providers", zap.Error(err))
+		os.Exit(2)
+	}
+
 	version.LogVersionInfo("dm-worker")
 	log.L().Info("", zap.Stringer("dm-worker config", cfg))
 
@@ -0,0 +1,331 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+const (
+	checkpointSchemaName = "tidb_cdc"
+	checkpointTableName  = "kafka_consumer_checkpoint"
+)
+
+// verificationCheckpoint is the state of a verification run, which is saved
+// periodically so that the consumer can resume from it after restarts.
+//
+// All rows whose commit ts are not greater than ResolvedTs have been applied
+// to the downstream, and the consumer skips them after resuming.
+type verificationCheckpoint struct {
+	Topic string `json:"topic"`
+	// ResolvedTs is the last verified resolved ts, i.e. the resolved ts
+	// which has been applied to the downstream.
+	ResolvedTs uint64 `json:"resolved-ts"`
+	// Partitions are the positions to resume consuming from.
+	Partitions map[int32]partitionCheckpoint `json:"partitions"`
+	// Rows is the number of rows applied to the downstream per table.
+	Rows       map[string]uint64 `json:"rows"`
+	UpdateTime time.Time         `json:"update-time"`
+}
+
+// partitionCheckpoint is the offset of a resolved ts message of a partition,
+// all row messages before it are applied to the downstream.
+type partitionCheckpoint struct {
+	Offset     int64  `json:"offset"`
+	ResolvedTs uint64 `json:"resolved-ts"`
+}
+
+func newVerificationCheckpoint(topic string) *verificationCheckpoint {
+	return &verificationCheckpoint{
+		Topic:      topic,
+		Partitions: make(map[int32]partitionCheckpoint),
+		Rows:       make(map[string]uint64),
+	}
+}
+
+func (cp *verificationCheckpoint) totalRows() uint64 {
+	var total uint64
+	for _, n := range cp.Rows {
+		total += n
+	}
+	return total
+}
+
+// logSummary prints the verification state.
+func (cp *verificationCheckpoint) logSummary() {
+	tables := make([]string, 0, len(cp.Rows))
+	for table := range cp.Rows {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	fields := make([]zap.Field, 0, len(tables)+3)
+	fields = append(fields,
+		zap.Uint64("lastVerifiedResolvedTs", cp.ResolvedTs),
+		zap.Uint64("totalRows", cp.totalRows()),
+		zap.Int("tableCount", len(tables)))
+	for _, table := range tables {
+		fields = append(fields, zap.Uint64(table, cp.Rows[table]))
+	}
+	log.Info("verification state summary", fields...)
+}
+
+// checkpointStorage is used to save and load the verification checkpoint.
+type checkpointStorage interface {
+	// load returns nil if there is no checkpoint.
+	load(ctx context.Context) (*verificationCheckpoint, error)
+	save(ctx context.Context, cp *verificationCheckpoint) error
+	close() error
+}
+
+// newCheckpointStorage creates a checkpointStorage by the uri, which is either
+// a local file path like `file:///tmp/checkpoint.json` or a MySQL address like
+// `mysql://root@127.0.0.1:3306/`.
+func newCheckpointStorage(uriStr string, topic string) (checkpointStorage, error) {
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch strings.ToLower(uri.Scheme) {
+	case "", "file":
+		return &fileCheckpointStorage{path: uri.Path}, nil
+	case "mysql", "tidb":
+		return newMySQLCheckpointStorage(uri, topic)
+	default:
+		return nil, errors.Errorf("unsupported checkpoint-uri scheme %s", uri.Scheme)
+	}
+}
+
+type fileCheckpointStorage struct {
+	path string
+}
+
+func (s *fileCheckpointStorage) load(_ context.Context) (*verificationCheckpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Trace(err)
+	}
+	cp := &verificationCheckpoint{}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cp, nil
+}
+
+func (s *fileCheckpointStorage) save(_ context.Context, cp *verificationCheckpoint) error {
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// write to a temporary file and rename it, so that a crash never leaves
+	// a broken checkpoint behind.
+	tmpPath := filepath.Join(filepath.Dir(s.path), "."+filepath.Base(s.path)+".tmp")
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(tmpPath, s.path))
+}
+
+func (s *fileCheckpointStorage) close() error {
+	return nil
+}
+
+type mysqlCheckpointStorage struct {
+	db          *sql.DB
+	topic       string
+	initialized bool
+}
+
+func newMySQLCheckpointStorage(uri *url.URL, topic string) (*mysqlCheckpointStorage, error) {
+	username := uri.User.Username()
+	if username == "" {
+		username = "root"
+	}
+	password, _ := uri.User.Password()
+	port := uri.Port()
+	if port == "" {
+		port = "4000"
+	}
+	dsn := gmysql.NewConfig()
+	dsn.User = username
+	dsn.Passwd = password
+	dsn.Net = "tcp"
+	// This will handle the IPv6 address format.
+	dsn.Addr = net.JoinHostPort(uri.Hostname(), port)
+
+	db, err := sql.Open("mysql", dsn.FormatDSN())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &mysqlCheckpointStorage{db: db, topic: topic}, nil
+}
+
+func (s *mysqlCheckpointStorage) tableName() string {
+	return quotes.QuoteSchema(checkpointSchemaName, checkpointTableName)
+}
+
+func (s *mysqlCheckpointStorage) init(ctx context.Context) error {
+	if s.initialized {
+		return nil
+	}
+	if _, err := s.db.ExecContext(ctx,
+		"CREATE DATABASE IF NOT EXISTS "+quotes.QuoteName(checkpointSchemaName)); err != nil {
+		return errors.Trace(err)
+	}
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	topic VARCHAR(255) NOT NULL PRIMARY KEY,
+	checkpoint LONGTEXT NOT NULL,
+	update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+)`, s.tableName()))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	s.initialized = true
+	return nil
+}
+
+func (s *mysqlCheckpointStorage) load(ctx context.Context) (*verificationCheckpoint, error) {
+	if err := s.init(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var data string
+	err := s.db.QueryRowContext(ctx,
+		"SELECT checkpoint FROM "+s.tableName()+" WHERE topic = ?", s.topic).Scan(&data)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Trace(err)
+	}
+	cp := &verificationCheckpoint{}
+	if err := json.Unmarshal([]byte(data), cp); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cp, nil
+}
+
+func (s *mysqlCheckpointStorage) save(ctx context.Context, cp *verificationCheckpoint) error {
+	if err := s.init(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = s.db.ExecContext(ctx,
+		"REPLACE INTO "+s.tableName()+" (topic, checkpoint) VALUES (?, ?)", s.topic, string(data))
+	return errors.Trace(err)
+}
+
+func (s *mysqlCheckpointStorage) close() error {
+	return s.db.Close()
+}
+
+type resolvedMark struct {
+	ts     uint64
+	offset int64
+}
+
+type rowsMark struct {
+	commitTs uint64
+	table    string
+	count    uint64
+}
+
+// partitionProgress tracks the consuming progress of a partition which is not
+// checkpointed yet. All methods of a nil partitionProgress are no-ops.
+type partitionProgress struct {
+	mu sync.Mutex
+	// resolved ts messages consumed, in the order of offsets.
+	marks []resolvedMark
+	// rows emitted to the sink.
+	rows []rowsMark
+}
+
+func (p *partitionProgress) addResolved(ts uint64, offset int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.marks = append(p.marks, resolvedMark{ts: ts, offset: offset})
+}
+
+// addRows records the rows emitted to the sink.
+func (p *partitionProgress) addRows(events []*model.RowChangedEvent) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range events {
+		table := quotes.QuoteSchema(e.Table.Schema, e.Table.Table)
+		if n := len(p.rows); n > 0 && p.rows[n-1].commitTs == e.CommitTs && p.rows[n-1].table == table {
+			p.rows[n-1].count++
+			continue
+		}
+		p.rows = append(p.rows, rowsMark{commitTs: e.CommitTs, table: table, count: 1})
+	}
+}
+
+// advance moves the progress which is not greater than `resolvedTs` to the
+// checkpoint, since it has been applied to the downstream.
+func (p *partitionProgress) advance(resolvedTs uint64, partition int32, cp *verificationCheckpoint) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := 0
+	for ; i < len(p.marks) && p.marks[i].ts <= resolvedTs; i++ {
+		cp.Partitions[partition] = partitionCheckpoint{
+			Offset:     p.marks[i].offset,
+			ResolvedTs: p.marks[i].ts,
+		}
+	}
+	p.marks = p.marks[i:]
+
+	// rows of different tables are emitted separately, so they are not
+	// ordered by commit ts across tables.
+	remained := p.rows[:0]
+	for _, r := range p.rows {
+		if r.commitTs <= resolvedTs {
+			cp.Rows[r.table] += r.count
+			continue
+		}
+		remained = append(remained, r)
+	}
+	p.rows = remained
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCheckpoint(topic string) *verificationCheckpoint {
+	cp := newVerificationCheckpoint(topic)
+	cp.ResolvedTs = 100
+	cp.Partitions[0] = partitionCheckpoint{Offset: 10, ResolvedTs: 100}
+	cp.Partitions[1] = partitionCheckpoint{Offset: 20, ResolvedTs: 110}
+	cp.Rows["`test`.`t1`"] = 3
+	cp.Rows["`test`.`t2`"] = 5
+	cp.UpdateTime = time.Unix(1672531200, 0).UTC()
+	return cp
+}
+
+func TestFileCheckpointStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := newCheckpointStorage("file://"+filepath.Join(dir, "checkpoint.json"), "test")
+	require.NoError(t, err)
+	defer storage.close()
+
+	// no checkpoint is saved yet.
+	cp, err := storage.load(ctx)
+	require.NoError(t, err)
+	require.Nil(t, cp)
+
+	expected := newTestCheckpoint("test")
+	require.NoError(t, storage.save(ctx, expected))
+	cp, err = storage.load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, cp)
+	require.Equal(t, uint64(8), cp.totalRows())
+
+	// the checkpoint is overwritten, and the temporary file is renamed.
+	expected.ResolvedTs = 200
+	require.NoError(t, storage.save(ctx, expected))
+	cp, err = storage.load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), cp.ResolvedTs)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	// a broken checkpoint fails to load.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "checkpoint.json"), []byte("{"), 0o644))
+	_, err = storage.load(ctx)
+	require.Error(t, err)
+
+	_, err = newCheckpointStorage("s3://bucket/checkpoint", "test")
+	require.ErrorContains(t, err, "unsupported checkpoint-uri scheme")
+}
+
+func TestMySQLCheckpointStorage(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	storage := &mysqlCheckpointStorage{db: db, topic: "test"}
+
+	// the table is created at the first access.
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS `tidb_cdc`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `tidb_cdc`.`kafka_consumer_checkpoint`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT checkpoint FROM `tidb_cdc`.`kafka_consumer_checkpoint` WHERE topic = ?")).
+		WithArgs("test").
+		WillReturnRows(sqlmock.NewRows([]string{"checkpoint"}))
+	cp, err := storage.load(ctx)
+	require.NoError(t, err)
+	require.Nil(t, cp)
+
+	expected := newTestCheckpoint("test")
+	data, err := json.Marshal(expected)
+	require.NoError(t, err)
+	mock.ExpectExec(regexp.QuoteMeta("REPLACE INTO `tidb_cdc`.`kafka_consumer_checkpoint` (topic, checkpoint) VALUES (?, ?)")).
+		WithArgs("test", string(data)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, storage.save(ctx, expected))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT checkpoint FROM `tidb_cdc`.`kafka_consumer_checkpoint` WHERE topic = ?")).
+		WithArgs("test").
+		WillReturnRows(sqlmock.NewRows([]string{"checkpoint"}).AddRow(string(data)))
+	cp, err = storage.load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, cp)
+
+	mock.ExpectClose()
+	require.NoError(t, storage.close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPartitionProgress(t *testing.T) {
+	newRow := func(table string, commitTs uint64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs: commitTs,
+			Table:    &model.TableName{Schema: "test", Table: table},
+		}
+	}
+	cp := newVerificationCheckpoint("test")
+
+	// all methods of a nil progress are no-ops.
+	var p *partitionProgress
+	p.addResolved(100, 1)
+	p.addRows([]*model.RowChangedEvent{newRow("t1", 100)})
+	p.advance(100, 0, cp)
+	require.Empty(t, cp.Partitions)
+	require.Empty(t, cp.Rows)
+
+	p = &partitionProgress{}
+	p.addRows([]*model.RowChangedEvent{newRow("t1", 90), newRow("t1", 90), newRow("t2", 95)})
+	p.addResolved(100, 3)
+	p.addRows([]*model.RowChangedEvent{newRow("t2", 105), newRow("t1", 110)})
+	p.addResolved(110, 6)
+
+	// only the progress not greater than the resolved ts is checkpointed.
+	p.advance(105, 1, cp)
+	require.Equal(t, map[int32]partitionCheckpoint{1: {Offset: 3, ResolvedTs: 100}}, cp.Partitions)
+	require.Equal(t, map[string]uint64{"`test`.`t1`": 2, "`test`.`t2`": 2}, cp.Rows)
+
+	p.advance(110, 1, cp)
+	require.Equal(t, map[int32]partitionCheckpoint{1: {Offset: 6, ResolvedTs: 110}}, cp.Partitions)
+	require.Equal(t, map[string]uint64{"`test`.`t1`": 3, "`test`.`t2`": 2}, cp.Rows)
+	require.Empty(t, p.marks)
+	require.Empty(t, p.rows)
+}
+
+func TestResumeFromCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	oldURI, oldTopic, oldResume, oldInterval := checkpointURI, kafkaTopic, resume, checkpointInterval
+	defer func() {
+		checkpointURI, kafkaTopic, resume, checkpointInterval = oldURI, oldTopic, oldResume, oldInterval
+	}()
+	checkpointURI, kafkaTopic, checkpointInterval = "file://"+path, "test", 0
+
+	// the first run saves the progress applied to the downstream.
+	resume = false
+	c := &Consumer{sinks: []*partitionSink{{}, {}}}
+	require.NoError(t, c.initCheckpoint(ctx))
+	for i, sink := range c.sinks {
+		require.NotNil(t, sink.progress)
+		sink.progress.addResolved(100, int64(i+10))
+		sink.progress.addRows([]*model.RowChangedEvent{{
+			CommitTs: 90,
+			Table:    &model.TableName{Schema: "test", Table: "t"},
+		}})
+		sink.progress.addResolved(120, int64(i+20))
+	}
+	c.globalResolvedTs = 110
+	require.NoError(t, c.saveCheckpoint(ctx))
+
+	// the second run resumes from the saved progress.
+	resume = true
+	c = &Consumer{sinks: []*partitionSink{{}, {}}}
+	require.NoError(t, c.initCheckpoint(ctx))
+	require.Equal(t, uint64(110), c.resumedTs)
+	require.Equal(t, map[int32]partitionCheckpoint{
+		0: {Offset: 10, ResolvedTs: 100},
+		1: {Offset: 11, ResolvedTs: 100},
+	}, c.resumedOffsets)
+	for _, sink := range c.sinks {
+		require.Equal(t, uint64(100), sink.resolvedTs)
+	}
+	require.Equal(t, map[string]uint64{"`test`.`t`": 2}, c.checkpoint.Rows)
+
+	// the checkpoint of another topic can't be resumed from.
+	kafkaTopic = "another"
+	c = &Consumer{sinks: []*partitionSink{{}, {}}}
+	require.ErrorContains(t, c.initCheckpoint(ctx), "the checkpoint is saved for topic test")
+
+	// it consumes from the beginning if there is no checkpoint.
+	checkpointURI = "file://" + filepath.Join(t.TempDir(), "checkpoint.json")
+	c = &Consumer{sinks: []*partitionSink{{}, {}}}
+	require.NoError(t, c.initCheckpoint(ctx))
+	require.Zero(t, c.resumedTs)
+	require.Nil(t, c.resumedOffsets)
+}
@@ -73,9 +73,18 @@ var (
 	logLevel      string
 	timezone      string
 	ca, cert, key string
+
+	// checkpointURI is where to save the verification checkpoint, it's
+	// disabled if empty.
+	checkpointURI      string
+	resume             bool
+	checkpointInterval time.Duration
+	summaryInterval    time.Duration
 )
 
-func init() {
+// parseFlags parses the flags and initializes the options, it's not done in
+// init so that the package can be tested.
+func parseFlags() {
 	var (
 		upstreamURIStr string
 		configFile     string
@@ -90,6 +99,11 @@ func init() {
 	flag.StringVar(&ca, "ca", "", "CA certificate path for Kafka SSL connection")
 	flag.StringVar(&cert, "cert", "", "Certificate path for Kafka SSL connection")
 	flag.StringVar(&key, "key", "", "Private key path for Kafka SSL connection")
+	flag.StringVar(&checkpointURI, "checkpoint-uri", "",
+		"where to save the verification checkpoint, a local file like `file:///tmp/checkpoint.json` or a MySQL address like `mysql://root@127.0.0.1:3306/`")
+	flag.BoolVar(&resume, "resume", false, "resume consuming from the checkpoint saved in checkpoint-uri")
+	flag.DurationVar(&checkpointInterval, "checkpoint-interval", 10*time.Second, "interval to save the verification checkpoint")
+	flag.DurationVar(&summaryInterval, "summary-interval", time.Minute, "interval to print the verification state summary")
 	flag.Parse()
 
 	err := logutil.InitLogger(&logutil.Config{
@@ -200,6 +214,10 @@ func init() {
 			log.Panic("verify rule failed", zap.Error(err))
 		}
 	}
+
+	if resume && checkpointURI == "" {
+		log.Panic("resume requires checkpoint-uri")
+	}
 }
 
 func getPartitionNum(address []string, topic string, cfg *sarama.Config) (int32, error) {
@@ -278,6 +296,8 @@ func newSaramaConfig() (*sarama.Config, error) {
 }
 
 func main() {
+	parseFlags()
+
 	/**
 	 * Construct a new Sarama configuration.
 	 * The Kafka cluster version has to be defined before the consumer/producer is initialized.
@@ -353,6 +373,8 @@ type partitionSink struct {
 	resolvedTs  uint64
 	partitionNo int
 	tablesMap   sync.Map
+	// progress is nil if the checkpoint is disabled.
+	progress *partitionProgress
 }
 
 // Consumer represents a Sarama consumer group consumer
@@ -377,6 +399,16 @@ type Consumer struct {
 	enableTiDBExtension bool
 
 	eventRouter *dispatcher.EventRouter
+
+	// checkpoint is nil if checkpointStorage is nil.
+	checkpointStorage  checkpointStorage
+	checkpoint         *verificationCheckpoint
+	lastCheckpointTime time.Time
+	// resumedTs is the resolved ts of the checkpoint resumed from, all events
+	// whose commit ts are not greater than it have been applied.
+	resumedTs uint64
+	// resumedOffsets are reset to the consumer group at the first session.
+	resumedOffsets map[int32]partitionCheckpoint
 }
 
 // NewConsumer creates a new cdc kafka consumer
@@ -426,6 +458,12 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 		}
 		c.sinks[i] = &partitionSink{Sink: s, partitionNo: i}
 	}
+	if checkpointURI != "" {
+		if err := c.initCheckpoint(ctx); err != nil {
+			cancel()
+			return nil, errors.Trace(err)
+		}
+	}
 	sink, err := sink.New(ctx,
 		model.DefaultChangeFeedID("kafka-consumer"),
 		downstreamURIStr, config.GetDefaultReplicaConfig(), errCh)
@@ -447,8 +485,65 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	return c, nil
 }
 
+func (c *Consumer) initCheckpoint(ctx context.Context) error {
+	storage, err := newCheckpointStorage(checkpointURI, kafkaTopic)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.checkpointStorage = storage
+	c.checkpoint = newVerificationCheckpoint(kafkaTopic)
+	for _, s := range c.sinks {
+		s.progress = &partitionProgress{}
+	}
+	if !resume {
+		return nil
+	}
+
+	cp, err := storage.load(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cp == nil {
+		log.Warn("no checkpoint found, consume from the beginning",
+			zap.String("checkpointURI", checkpointURI))
+		return nil
+	}
+	if cp.Topic != kafkaTopic {
+		return errors.Errorf("the checkpoint is saved for topic %s, but the topic to consume is %s",
+			cp.Topic, kafkaTopic)
+	}
+	if cp.Partitions == nil {
+		cp.Partitions = make(map[int32]partitionCheckpoint)
+	}
+	if cp.Rows == nil {
+		cp.Rows = make(map[string]uint64)
+	}
+	c.checkpoint = cp
+	c.resumedTs = cp.ResolvedTs
+	c.resumedOffsets = cp.Partitions
+	for partition, pc := range cp.Partitions {
+		if int(partition) < len(c.sinks) {
+			atomic.StoreUint64(&c.sinks[partition].resolvedTs, pc.ResolvedTs)
+		}
+	}
+	log.Info("resume from the checkpoint", zap.String("checkpointURI", checkpointURI))
+	cp.logSummary()
+	return nil
+}
+
 // Setup is run at the beginning of a new session, before ConsumeClaim
-func (c *Consumer) Setup(sarama.ConsumerGroupSession) error {
+func (c *Consumer) Setup(session sarama.ConsumerGroupSession) error {
+	if c.resumedOffsets != nil {
+		for topic, partitions := range session.Claims() {
+			for _, partition := range partitions {
+				if pc, ok := c.resumedOffsets[partition]; ok {
+					// consume from the message next to the checkpointed one.
+					session.ResetOffset(topic, partition, pc.Offset+1, "")
+				}
+			}
+		}
+		c.resumedOffsets = nil
+	}
 	// Mark the c as ready
 	close(c.ready)
 	return nil
@@ -545,7 +640,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 				if err != nil {
 					log.Panic("decode message value failed", zap.ByteString("value", message.Value))
 				}
-				if partition == 0 {
+				if partition == 0 && ddl.CommitTs > c.resumedTs {
 					c.appendDDL(ddl)
 				}
 			case model.MessageTypeRow:
@@ -566,12 +661,19 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 					}
 				}
 
+				if row.CommitTs <= c.resumedTs {
+					// the row has been applied before resuming.
+					session.MarkMessage(message, "")
+					continue
+				}
+
 				globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
-				if row.CommitTs <= globalResolvedTs || row.CommitTs <= sink.resolvedTs {
+				sinkResolvedTs := atomic.LoadUint64(&sink.resolvedTs)
+				if row.CommitTs <= globalResolvedTs || row.CommitTs <= sinkResolvedTs {
 					log.Warn("RowChangedEvent fallback row, ignore it",
 						zap.Uint64("commitTs", row.CommitTs),
 						zap.Uint64("globalResolvedTs", globalResolvedTs),
-						zap.Uint64("sinkResolvedTs", sink.resolvedTs),
+						zap.Uint64("sinkResolvedTs", sinkResolvedTs),
 						zap.Int32("partition", partition),
 						zap.Any("row", row))
 				}
@@ -593,10 +695,19 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 				}
 				group.Append(row)
 			case model.MessageTypeResolved:
+				finished := false
+				if finishedDecoder, ok := decoder.(codec.FinishedEventDecoder); ok {
+					finished = finishedDecoder.IsFinishedEvent()
+				}
 				ts, err := decoder.NextResolvedEvent()
 				if err != nil {
 					log.Panic("decode message value failed", zap.ByteString("value", message.Value))
 				}
+				if finished {
+					log.Info("the changefeed is finished",
+						zap.Uint64("finishedTs", ts),
+						zap.Int32("partition", partition))
+				}
 				resolvedTs := atomic.LoadUint64(&sink.resolvedTs)
 				// `resolvedTs` should be monotonically increasing, it's allowed to receive redundant one.
 				if ts < resolvedTs {
@@ -617,6 +728,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 								zap.Error(err),
 								zap.Int32("partition", partition))
 						}
+						sink.progress.addRows(events)
 						commitTs := events[len(events)-1].CommitTs
 						lastCommitTs, ok := sink.tablesMap.Load(tableID)
 						if !ok || lastCommitTs.(uint64) < commitTs {
@@ -627,6 +739,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 						zap.Uint64("ts", ts),
 						zap.Int32("partition", partition))
 					atomic.StoreUint64(&sink.resolvedTs, ts)
+					sink.progress.addResolved(ts, message.Offset)
 				} else {
 					log.Info("redundant sink resolved ts", zap.Uint64("ts", ts), zap.Int32("partition", partition))
 				}
@@ -713,14 +826,45 @@ func (c *Consumer) getMinPartitionResolvedTs() (result uint64, err error) {
 	return result, err
 }
 
+// saveCheckpoint saves the progress which has been applied to the downstream,
+// i.e. not greater than the global resolved ts, to the checkpoint.
+func (c *Consumer) saveCheckpoint(ctx context.Context) error {
+	if c.checkpointStorage == nil || time.Since(c.lastCheckpointTime) < checkpointInterval {
+		return nil
+	}
+	resolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
+	for i, sink := range c.sinks {
+		sink.progress.advance(resolvedTs, int32(i), c.checkpoint)
+	}
+	if resolvedTs > c.checkpoint.ResolvedTs {
+		c.checkpoint.ResolvedTs = resolvedTs
+	}
+	c.checkpoint.UpdateTime = time.Now()
+	if err := c.checkpointStorage.save(ctx, c.checkpoint); err != nil {
+		return errors.Trace(err)
+	}
+	c.lastCheckpointTime = time.Now()
+	return nil
+}
+
 // Run the Consumer
 func (c *Consumer) Run(ctx context.Context) error {
 	ticker := time.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
+	summaryTicker := time.NewTicker(summaryInterval)
+	defer summaryTicker.Stop()
+	if c.checkpointStorage != nil {
+		defer c.checkpointStorage.close()
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-summaryTicker.C:
+			if c.checkpoint != nil {
+				c.checkpoint.logSummary()
+			}
+			continue
 		case <-ticker.C:
 		}
 
@@ -754,23 +898,28 @@ func (c *Consumer) Run(ctx context.Context) error {
 		}
 
 		// update global resolved ts
-		if c.globalResolvedTs > minPartitionResolvedTs {
+		globalResolvedTs := atomic.LoadUint64(&c.globalResolvedTs)
+		if globalResolvedTs > minPartitionResolvedTs {
 			log.Panic("global ResolvedTs fallback",
-				zap.Uint64("globalResolvedTs", c.globalResolvedTs),
+				zap.Uint64("globalResolvedTs", globalResolvedTs),
 				zap.Uint64("minPartitionResolvedTs", minPartitionResolvedTs))
 		}
 
-		if c.globalResolvedTs == minPartitionResolvedTs {
+		if globalResolvedTs == minPartitionResolvedTs {
 			continue
 		}
 
-		c.globalResolvedTs = minPartitionResolvedTs
+		atomic.StoreUint64(&c.globalResolvedTs, minPartitionResolvedTs)
 
 		if err := c.forEachSink(func(sink *partitionSink) error {
-			return syncFlushRowChangedEvents(ctx, sink, c.globalResolvedTs)
+			return syncFlushRowChangedEvents(ctx, sink, minPartitionResolvedTs)
 		}); err != nil {
 			return errors.Trace(err)
 		}
+
+		if err := c.saveCheckpoint(ctx); err != nil {
+			return errors.Trace(err)
+		}
 	}
 }
 
@@ -336,7 +336,7 @@ func (c *consumer) getNewFiles(ctx context.Context) (map[dmlPathKey]fileIndexRan
 		var dmlkey dmlPathKey
 		var schemaKey schemaPathKey
 
-		if strings.HasSuffix(path, "metadata") {
+		if strings.HasSuffix(path, "metadata") || cloudstorage.IsManifestPath(path) {
 			return nil
 		}
 
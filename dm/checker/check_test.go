@@ -52,6 +52,7 @@ func ignoreExcept(itemMap map[string]struct{}) []string {
 		config.OnlineDDLChecking,
 		config.BinlogDBChecking,
 		config.TargetDBPrivilegeChecking,
+		config.LoadOrderChecking,
 		config.LightningFreeSpaceChecking,
 		config.LightningDownstreamVersionChecking,
 		config.LightningRegionDistributionChecking,
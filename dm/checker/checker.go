@@ -352,7 +352,8 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 			if _, ok := c.checkingItems[config.BinlogFormatChecking]; ok {
 				c.checkList = append(c.checkList, checker.NewMySQLBinlogFormatChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 			}
-			if _, ok := c.checkingItems[config.BinlogRowImageChecking]; ok {
+			// ignore-update-when-only-columns compares the whole old and new row, so the full row image is always required
+			if _, ok := c.checkingItems[config.BinlogRowImageChecking]; ok || needFullRowImage(instance.cfg) {
 				c.checkList = append(c.checkList, checker.NewMySQLBinlogRowImageChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 			}
 			if _, ok := c.checkingItems[config.ReplicationPrivilegeChecking]; ok {
@@ -379,6 +380,19 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 	}
 
 	instance := c.instances[0]
+	// the loader restores tables in random order if load-order-logical is not set,
+	// which may fail when the target tables have foreign keys.
+	if _, ok := c.checkingItems[config.LoadOrderChecking]; ok &&
+		instance.cfg.Mode != config.ModeIncrement &&
+		instance.cfg.LoaderConfig.ImportMode == config.LoadModeLoader &&
+		len(instance.cfg.LoaderConfig.LoadOrderLogical) == 0 {
+		c.checkList = append(c.checkList, checker.NewLoadOrderChecker(
+			instance.targetDB.DB,
+			instance.targetDBInfo,
+			info.db2TargetTables,
+		))
+	}
+
 	// Not check the sharding tables’ schema when the mode is increment.
 	// Because the table schema obtained from `show create table` is not the schema at the point of binlog.
 	_, checkingShardID := c.checkingItems[config.ShardAutoIncrementIDChecking]
@@ -872,3 +886,12 @@ func (l *lightningPrecheckAdaptor) ReadFirstNRowsByFileMeta(ctx context.Context,
 func (l *lightningPrecheckAdaptor) EstimateSourceDataSize(ctx context.Context, opts ...opts.GetPreInfoOption) (*restore.EstimateSourceDataSizeResult, error) {
 	return &l.sourceDataResult, nil
 }
+
+func needFullRowImage(cfg *config.SubTaskConfig) bool {
+	for _, f := range cfg.ExprFilter {
+		if f.IgnoreUpdateByColumns() {
+			return true
+		}
+	}
+	return false
+}
@@ -109,13 +109,22 @@ var (
 	// config because the command line arguments may be expected to take effect only once when failover.
 	// kv: Encode(task-name, source-id) -> TaskCliArgs.
 	TaskCliArgsKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-cli-args/")
+	// SoftDeletedTaskMetaKeyAdapter is used to store the meta data of tasks stopped with `--keep-meta-days`, the
+	// downstream checkpoints of these tasks are kept until they are expired or the task is started again.
+	// k/v: Encode(task-name) -> SoftDeletedTaskMeta.
+	SoftDeletedTaskMetaKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/soft-deleted-task-meta/")
+	// MasterVersionKeyAdapter is used to store the version information of DM-masters, the version information
+	// of DM-workers is stored with the register info.
+	// k/v: Encode(master-name) -> MemberVersion.
+	MasterVersionKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/master-version/")
 )
 
 func keyAdapterKeysLen(s KeyAdapter) int {
 	switch s {
 	case WorkerRegisterKeyAdapter, UpstreamConfigKeyAdapter, UpstreamBoundWorkerKeyAdapter,
 		WorkerKeepAliveKeyAdapter, StageRelayKeyAdapter,
-		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter:
+		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter,
+		SoftDeletedTaskMetaKeyAdapter, MasterVersionKeyAdapter:
 		return 1
 	case UpstreamSubTaskKeyAdapter, StageSubTaskKeyAdapter, StageValidatorKeyAdapter,
 		ShardDDLPessimismInfoKeyAdapter, ShardDDLPessimismOperationKeyAdapter,
@@ -20,12 +20,13 @@ import (
 
 // Backoff related constants.
 var (
-	DefaultCheckInterval           = 5 * time.Second
-	DefaultBackoffRollback         = 5 * time.Minute
-	DefaultBackoffMin              = 1 * time.Second
-	DefaultBackoffMax              = 5 * time.Minute
-	DefaultBackoffJitter           = true
-	DefaultBackoffFactor   float64 = 2
+	DefaultCheckInterval            = 5 * time.Second
+	DefaultBackoffRollback          = 5 * time.Minute
+	DefaultBackoffMin               = 1 * time.Second
+	DefaultBackoffMax               = 5 * time.Minute
+	DefaultBackoffJitter            = true
+	DefaultBackoffFactor    float64 = 2
+	DefaultBreakerThreshold         = 10
 )
 
 // Duration is used to hold a time.Duration field.
@@ -61,6 +62,9 @@ type CheckerConfig struct {
 	CheckEnable     bool     `yaml:"check-enable" toml:"check-enable" json:"check-enable"`
 	BackoffRollback Duration `yaml:"backoff-rollback" toml:"backoff-rollback" json:"backoff-rollback"`
 	BackoffMax      Duration `yaml:"backoff-max" toml:"backoff-max" json:"backoff-max"`
+	// BreakerThreshold is the number of consecutive pauses by the identical error after which
+	// auto resume stops until the task is resumed manually, 0 means auto resume never stops.
+	BreakerThreshold int `yaml:"breaker-threshold" toml:"breaker-threshold" json:"breaker-threshold"`
 	// unexpose config
 	CheckInterval Duration `yaml:"check-interval" toml:"check-interval" json:"-"`
 	BackoffMin    Duration `yaml:"backoff-min" toml:"backoff-min" json:"-"`
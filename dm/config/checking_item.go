@@ -37,6 +37,7 @@ const (
 	BinlogDBChecking             = "binlog_db"
 	ConnNumberChecking           = "conn_number"
 	TargetDBPrivilegeChecking    = "target_privilege"
+	LoadOrderChecking            = "load_order"
 	// lighting prechecks.
 	LightningEmptyRegionChecking        = "empty_region"
 	LightningRegionDistributionChecking = "region_distribution"
@@ -62,6 +63,7 @@ var AllCheckingItems = map[string]string{
 	BinlogDBChecking:             "binlog db checking item",
 	ConnNumberChecking:           "connection number checking item",
 	TargetDBPrivilegeChecking:    "privileges of target DB checking item",
+	LoadOrderChecking:            "foreign keys of target tables without load order checking item",
 	// lightning prechecks
 	LightningEmptyRegionChecking:        "physical import mode empty region checking item",
 	LightningRegionDistributionChecking: "physical import mode region distribution checking item",
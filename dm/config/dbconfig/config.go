@@ -80,6 +80,9 @@ type DBConfig struct {
 	// deprecated, mysql driver could automatically fetch this value
 	MaxAllowedPacket *int              `toml:"max-allowed-packet" json:"max-allowed-packet" yaml:"max-allowed-packet"`
 	Session          map[string]string `toml:"session" json:"session" yaml:"session"`
+	// MaxConnections only takes effect for the upstream. It's the max number of connections opened to the
+	// upstream by the user in a DM process, which are shared by all units, binlog streamers and prechecks. 0 means no limit.
+	MaxConnections int `toml:"max-connections,omitempty" json:"max-connections,omitempty" yaml:"max-connections,omitempty"`
 
 	// security config
 	Security *security.Security `toml:"security" json:"security" yaml:"security"`
@@ -0,0 +1,104 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// DDLHook on-error policies.
+const (
+	DDLHookOnErrorWarn  = "warn"
+	DDLHookOnErrorPause = "pause"
+)
+
+// DDL hook placeholders, which will be replaced by the quoted downstream schema and table of the matched DDL.
+const (
+	DDLHookSchemaPlaceholder = "${schema}"
+	DDLHookTablePlaceholder  = "${table}"
+)
+
+var ddlHookSupportedTypes = map[bf.EventType]struct{}{
+	bf.AllDDL:         {},
+	bf.CreateDatabase: {},
+	bf.DropDatabase:   {},
+	bf.AlterDatabase:  {},
+	bf.CreateTable:    {},
+	bf.DropTable:      {},
+	bf.TruncateTable:  {},
+	bf.RenameTable:    {},
+	bf.CreateIndex:    {},
+	bf.DropIndex:      {},
+	bf.AlterTable:     {},
+	bf.CreateView:     {},
+	bf.DropView:       {},
+}
+
+// DDLHook represents SQLs that will be executed in downstream before or after the matched DDLs.
+// schema-pattern and table-pattern are matched against the downstream table of the DDL, and wildcards are supported.
+// If ddl-types is empty, all DDLs of the matched tables will trigger the hook.
+type DDLHook struct {
+	// Name is filled from the key of ddl-hook in task config.
+	Name          string         `yaml:"-" toml:"name" json:"name"`
+	SchemaPattern string         `yaml:"schema-pattern" toml:"schema-pattern" json:"schema-pattern"`
+	TablePattern  string         `yaml:"table-pattern" toml:"table-pattern" json:"table-pattern"`
+	DDLTypes      []bf.EventType `yaml:"ddl-types" toml:"ddl-types" json:"ddl-types"`
+	BeforeSQL     []string       `yaml:"before-sql" toml:"before-sql" json:"before-sql"`
+	AfterSQL      []string       `yaml:"after-sql" toml:"after-sql" json:"after-sql"`
+	OnError       string         `yaml:"on-error" toml:"on-error" json:"on-error"`
+}
+
+// adjust validates the DDLHook and fills default values.
+func (h *DDLHook) adjust(name string) error {
+	h.Name = name
+	if h.SchemaPattern == "" {
+		return terror.ErrConfigInvalidDDLHook.Generate(name, "schema-pattern can't be empty")
+	}
+	if len(h.BeforeSQL) == 0 && len(h.AfterSQL) == 0 {
+		return terror.ErrConfigInvalidDDLHook.Generate(name, "at least one of before-sql and after-sql should be specified")
+	}
+	for i, tp := range h.DDLTypes {
+		tp = bf.EventType(strings.ToLower(string(tp)))
+		if _, ok := ddlHookSupportedTypes[tp]; !ok {
+			return terror.ErrConfigInvalidDDLHook.Generate(name, "unsupported ddl-type "+string(h.DDLTypes[i]))
+		}
+		h.DDLTypes[i] = tp
+	}
+	switch strings.ToLower(h.OnError) {
+	case "":
+		h.OnError = DDLHookOnErrorPause
+	case DDLHookOnErrorWarn, DDLHookOnErrorPause:
+		h.OnError = strings.ToLower(h.OnError)
+	default:
+		return terror.ErrConfigInvalidDDLHook.Generate(name, "on-error should be one of [warn, pause]")
+	}
+	return nil
+}
+
+// MatchDDLType returns whether the DDL type triggers the hook.
+func (h *DDLHook) MatchDDLType(tp bf.EventType) bool {
+	if len(h.DDLTypes) == 0 {
+		return true
+	}
+	for _, t := range h.DDLTypes {
+		if t == tp || t == bf.AllDDL {
+			return true
+		}
+	}
+	return false
+}
@@ -18,6 +18,8 @@ package config
 // ExpressionFilter represents a filter that will be applied on row changes.
 // one ExpressionFilter can only have one of (insert, update, delete) expressions.
 // there are two update expressions, which form an AND logic. If user omits one expression, DM will use "TRUE" for it.
+// IgnoreUpdateWhenOnlyColumns is another kind of update filter, an UPDATE event is skipped when all of its changed
+// columns are in the list. It compares the old and new values, so it requires the full binlog row image.
 type ExpressionFilter struct {
 	Schema             string `yaml:"schema" toml:"schema" json:"schema"`
 	Table              string `yaml:"table" toml:"table" json:"table"`
@@ -25,4 +27,11 @@ type ExpressionFilter struct {
 	UpdateOldValueExpr string `yaml:"update-old-value-expr" toml:"update-old-value-expr" json:"update-old-value-expr"`
 	UpdateNewValueExpr string `yaml:"update-new-value-expr" toml:"update-new-value-expr" json:"update-new-value-expr"`
 	DeleteValueExpr    string `yaml:"delete-value-expr" toml:"delete-value-expr" json:"delete-value-expr"`
+
+	IgnoreUpdateWhenOnlyColumns []string `yaml:"ignore-update-when-only-columns" toml:"ignore-update-when-only-columns" json:"ignore-update-when-only-columns"`
+}
+
+// IgnoreUpdateByColumns returns true if the filter skips UPDATE events by the changed columns.
+func (f *ExpressionFilter) IgnoreUpdateByColumns() bool {
+	return len(f.IgnoreUpdateWhenOnlyColumns) > 0
 }
@@ -0,0 +1,191 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// loaderThrottleClockLayout is the layout of the start and end of a throttle window.
+const loaderThrottleClockLayout = "15:04"
+
+// defaultLoaderBackpressurePollInterval is the default interval of polling the overload signal of the downstream.
+const defaultLoaderBackpressurePollInterval = 5 * time.Second
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// LoaderThrottleWindow is a time window in which the rate limit of the loader is scaled.
+type LoaderThrottleWindow struct {
+	// Weekdays are the days of week when the window starts, e.g. "mon-fri" or "sat,sun".
+	// Empty means every day.
+	Weekdays string `yaml:"weekdays" toml:"weekdays" json:"weekdays"`
+	// Start and End are the local time of day in "HH:MM" format,
+	// the window crosses midnight if End is before Start.
+	Start string `yaml:"start" toml:"start" json:"start"`
+	End   string `yaml:"end" toml:"end" json:"end"`
+	// RateMultiplier scales the rate limit of the loader when the window is active.
+	RateMultiplier float64 `yaml:"rate-multiplier" toml:"rate-multiplier" json:"rate-multiplier"`
+}
+
+func (w *LoaderThrottleWindow) adjust() error {
+	w.Weekdays = strings.ToLower(strings.ReplaceAll(w.Weekdays, " ", ""))
+	if _, err := parseWeekdays(w.Weekdays); err != nil {
+		return err
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return err
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return terror.ErrConfigInvalidLoaderThrottle.Generate("start and end of throttle window must be different")
+	}
+	if w.RateMultiplier <= 0 {
+		return terror.ErrConfigInvalidLoaderThrottle.Generate(fmt.Sprintf("rate-multiplier %v must be positive", w.RateMultiplier))
+	}
+	return nil
+}
+
+// Contains returns whether the time `t` is in the window, the window should be adjusted before.
+func (w LoaderThrottleWindow) Contains(t time.Time) bool {
+	days, err := parseWeekdays(w.Weekdays)
+	if err != nil {
+		return false
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return false
+	}
+
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	day := t.Weekday()
+	if start < end {
+		return days[day] && clock >= start && clock < end
+	}
+	// the window crosses midnight, it may be started yesterday.
+	yesterday := (day + 6) % 7
+	return (days[day] && clock >= start) || (days[yesterday] && clock < end)
+}
+
+// parseClock parses a time of day in "HH:MM" format to the duration since midnight.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse(loaderThrottleClockLayout, s)
+	if err != nil {
+		return 0, terror.ErrConfigInvalidLoaderThrottle.Generate(fmt.Sprintf("invalid time of day %q, should be in HH:MM format", s))
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseWeekdays parses the comma separated days or day ranges, e.g. "mon-fri,sun".
+func parseWeekdays(s string) ([7]bool, error) {
+	var days [7]bool
+	if s == "" {
+		for i := range days {
+			days[i] = true
+		}
+		return days, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(item, "-")
+		first, ok := weekdayNames[from]
+		if !ok {
+			return days, terror.ErrConfigInvalidLoaderThrottle.Generate(fmt.Sprintf("invalid weekday %q", from))
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdayNames[to]; !ok {
+				return days, terror.ErrConfigInvalidLoaderThrottle.Generate(fmt.Sprintf("invalid weekday %q", to))
+			}
+		}
+		// the range may wrap around the week, e.g. "fri-mon".
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// loaderStatusVariableRegexp matches the names of status variables.
+var loaderStatusVariableRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// LoaderBackpressure is the overload signal of the downstream polled by the loader, the rate
+// limit of the loader is lowered to ThrottledRate while the downstream is overloaded.
+type LoaderBackpressure struct {
+	// StatusVariable is a global status variable of the downstream used as the signal, e.g. "Threads_running".
+	StatusVariable string `yaml:"status-variable" toml:"status-variable" json:"status-variable"`
+	// Query is a query of the downstream used as the signal instead of StatusVariable,
+	// the signal is the last column of the first row of its result.
+	Query string `yaml:"query" toml:"query" json:"query"`
+	// Threshold is the value of the signal from which the downstream is overloaded.
+	Threshold float64 `yaml:"threshold" toml:"threshold" json:"threshold"`
+	// ThrottledRate is the max number of transactions executed per second while the downstream is overloaded.
+	ThrottledRate int `yaml:"throttled-rate" toml:"throttled-rate" json:"throttled-rate"`
+	// PollInterval is the interval of polling the signal.
+	PollInterval Duration `yaml:"poll-interval" toml:"poll-interval" json:"poll-interval"`
+}
+
+// Enabled returns whether the signal is polled.
+func (b *LoaderBackpressure) Enabled() bool {
+	return b.StatusVariable != "" || b.Query != ""
+}
+
+// SignalQuery returns the query of the signal.
+func (b *LoaderBackpressure) SignalQuery() string {
+	if b.Query != "" {
+		return b.Query
+	}
+	return fmt.Sprintf("SHOW GLOBAL STATUS LIKE '%s'", b.StatusVariable)
+}
+
+func (b *LoaderBackpressure) adjust() error {
+	if b.StatusVariable != "" && b.Query != "" {
+		return terror.ErrConfigInvalidLoaderBackpressure.Generate("only one of status-variable and query can be set")
+	}
+	if b.StatusVariable != "" && !loaderStatusVariableRegexp.MatchString(b.StatusVariable) {
+		return terror.ErrConfigInvalidLoaderBackpressure.Generate(fmt.Sprintf("invalid status-variable %q", b.StatusVariable))
+	}
+	if b.ThrottledRate <= 0 {
+		return terror.ErrConfigInvalidLoaderBackpressure.Generate("throttled-rate must be positive")
+	}
+	if b.PollInterval.Duration < 0 {
+		return terror.ErrConfigInvalidLoaderBackpressure.Generate("poll-interval must not be negative")
+	}
+	if b.PollInterval.Duration == 0 {
+		b.PollInterval.Duration = defaultLoaderBackpressurePollInterval
+	}
+	return nil
+}
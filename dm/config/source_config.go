@@ -22,6 +22,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
@@ -121,9 +123,10 @@ func newSourceConfig() *SourceConfig {
 			RemainSpace: 15,
 		},
 		Checker: CheckerConfig{
-			CheckEnable:     true,
-			BackoffRollback: Duration{DefaultBackoffRollback},
-			BackoffMax:      Duration{DefaultBackoffMax},
+			CheckEnable:      true,
+			BackoffRollback:  Duration{DefaultBackoffRollback},
+			BackoffMax:       Duration{DefaultBackoffMax},
+			BreakerThreshold: DefaultBreakerThreshold,
 		},
 	}
 	return c
@@ -174,7 +177,7 @@ func (c *SourceConfig) Parse(content string) error {
 func ParseYaml(content string) (*SourceConfig, error) {
 	c := newSourceConfig()
 	if err := yaml.UnmarshalStrict([]byte(content), c); err != nil {
-		return nil, terror.ErrConfigYamlTransform.Delegate(err, "decode source config")
+		return nil, terror.ErrConfigYamlTransform.Delegate(hintYAMLError([]byte(content), err), "decode source config")
 	}
 	c.adjust()
 	return c, nil
@@ -252,6 +255,10 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	if c.From.MaxConnections < 0 || (c.From.MaxConnections > 0 && c.From.MaxConnections < conn.MinUpstreamMaxConnections) {
+		return terror.ErrConfigInvalidMaxConnections.Generate(c.From.MaxConnections, conn.MinUpstreamMaxConnections)
+	}
+
 	return nil
 }
 
@@ -275,6 +282,59 @@ func (c *SourceConfig) GenerateDBConfig() *dbconfig.DBConfig {
 	return from
 }
 
+// hotUpdatableFromItems are the items of `from` which can be updated online, the bound worker rebuilds
+// the upstream connections of relay and sync units with them.
+var hotUpdatableFromItems = map[string]struct{}{
+	"user":     {},
+	"password": {},
+	"session":  {},
+	"security": {},
+}
+
+// CheckSourceCfgHotUpdate checks whether the source config can be updated to newCfg online, which only
+// changes the items in hotUpdatableFromItems. Other items, e.g. host, port and server-id, may change the
+// upstream the checkpoints are saved for, so they can only be updated when the tasks and relay are stopped.
+func CheckSourceCfgHotUpdate(oldCfg, newCfg *SourceConfig) error {
+	var changed []string
+	for _, item := range changedConfigItems(reflect.ValueOf(*oldCfg), reflect.ValueOf(*newCfg)) {
+		if item != "from" {
+			changed = append(changed, item)
+		}
+	}
+	for _, item := range changedConfigItems(reflect.ValueOf(oldCfg.From), reflect.ValueOf(newCfg.From)) {
+		if _, ok := hotUpdatableFromItems[item]; !ok {
+			changed = append(changed, "from."+item)
+		}
+	}
+	if len(changed) > 0 {
+		return terror.ErrConfigSourceCfgHotUpdate.Generate(newCfg.SourceID, strings.Join(changed, ", "))
+	}
+	return nil
+}
+
+// changedConfigItems returns the yaml names of the different fields of two structs with the same type.
+// The fields not marshaled to yaml are ignored, and an empty slice or map equals a nil one.
+func changedConfigItems(oldV, newV reflect.Value) []string {
+	var changed []string
+	for i := 0; i < oldV.NumField(); i++ {
+		name := strings.Split(oldV.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		oldF, newF := oldV.Field(i), newV.Field(i)
+		switch oldF.Kind() {
+		case reflect.Slice, reflect.Map:
+			if oldF.Len() == 0 && newF.Len() == 0 {
+				continue
+			}
+		}
+		if !reflect.DeepEqual(oldF.Interface(), newF.Interface()) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
 // Adjust flavor and server-id of SourceConfig.
 func (c *SourceConfig) Adjust(ctx context.Context, db *conn.BaseDB) (err error) {
 	c.From.Adjust()
@@ -403,12 +463,14 @@ func (c *SourceConfig) check(metaData *toml.MetaData, err error) error {
 func (c *SourceConfig) YamlForDowngrade() (string, error) {
 	s := NewSourceConfigForDowngrade(c)
 
-	// encrypt password
-	cipher, err := utils.Encrypt(utils.DecryptOrPlaintext(c.From.Password))
-	if err != nil {
-		return "", err
+	// encrypt password, a reference to a secret is kept as is.
+	if !secret.IsReference(c.From.Password) {
+		cipher, err := utils.Encrypt(utils.DecryptOrPlaintext(c.From.Password))
+		if err != nil {
+			return "", err
+		}
+		s.From.Password = cipher
 	}
-	s.From.Password = cipher
 	s.omitDefaultVals()
 	return s.Yaml()
 }
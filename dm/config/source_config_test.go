@@ -27,8 +27,10 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-mysql-org/go-mysql/mysql"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tiflow/dm/config/security"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/stretchr/testify/require"
 )
 
@@ -220,6 +222,30 @@ func TestConfigVerify(t *testing.T) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.MaxConnections = 10
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.MaxConnections = 1
+				return cfg
+			},
+			".*invalid max-connections 1 of the upstream.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.MaxConnections = -1
+				return cfg
+			},
+			".*invalid max-connections -1 of the upstream.*",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -356,3 +382,28 @@ func TestEmbedSampleFile(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, SampleSourceConfig, string(data))
 }
+
+func TestCheckSourceCfgHotUpdate(t *testing.T) {
+	oldCfg, err := ParseYaml(SampleSourceConfig)
+	require.NoError(t, err)
+	require.NoError(t, CheckSourceCfgHotUpdate(oldCfg, oldCfg.Clone()))
+
+	// the connection parameters can be updated online
+	newCfg := oldCfg.Clone()
+	newCfg.From.User = "new_user"
+	newCfg.From.Password = "new_password"
+	newCfg.From.Session = map[string]string{"time_zone": "+08:00"}
+	newCfg.From.Security = &security.Security{SSLCA: "ca.pem"}
+	require.NoError(t, CheckSourceCfgHotUpdate(oldCfg, newCfg))
+	// an empty slice equals a nil one
+	newCfg.Filters = []*bf.BinlogEventRule{}
+	require.NoError(t, CheckSourceCfgHotUpdate(oldCfg, newCfg))
+
+	// other items can't be updated online
+	newCfg.From.Host = "127.0.0.2"
+	newCfg.ServerID++
+	newCfg.EnableRelay = !oldCfg.EnableRelay
+	err = CheckSourceCfgHotUpdate(oldCfg, newCfg)
+	require.True(t, terror.ErrConfigSourceCfgHotUpdate.Equal(err))
+	require.Contains(t, err.Error(), "enable-relay, server-id, from.host")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/config/security"
 	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 )
 
 // SourceCfgToOpenAPISource converter SourceConfig to openapi.Source.
@@ -50,6 +51,11 @@ func SourceCfgToOpenAPISource(cfg *SourceConfig) openapi.Source {
 		certAllowedCn = append(certAllowedCn, cfg.From.Security.CertAllowedCN...)
 		source.Security = &openapi.Security{CertAllowedCn: &certAllowedCn}
 	}
+	// a reference to a secret is not sensitive and helps to locate the secret.
+	if secret.IsReference(cfg.From.Password) {
+		password := cfg.From.Password
+		source.Password = &password
+	}
 	return source
 }
 
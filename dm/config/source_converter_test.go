@@ -49,3 +49,13 @@ func (t *testConfig) TestConverterWithSourceAndOpenAPISource(c *check.C) {
 	openapiSource2.Password = openapiSource1.Password // we set passwd to "******" for privacy
 	c.Assert(openapiSource1, check.DeepEquals, openapiSource2)
 }
+
+func (t *testConfig) TestConverterWithSecretReference(c *check.C) {
+	sourceCfg, err := ParseYaml(SampleSourceConfig)
+	c.Assert(err, check.IsNil)
+	c.Assert(*SourceCfgToOpenAPISource(sourceCfg).Password, check.Equals, ObfuscatedPasswordForFeedback)
+
+	// a reference to a secret is shown as is.
+	sourceCfg.From.Password = "vault://secret/data/dm/mysql-01#password"
+	c.Assert(*SourceCfgToOpenAPISource(sourceCfg).Password, check.Equals, sourceCfg.From.Password)
+}
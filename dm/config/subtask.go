@@ -134,6 +134,7 @@ type SubTaskConfig struct {
 	FilterRules        []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
 	ColumnMappingRules []*column.Rule        `toml:"mapping-rule" json:"mapping-rule"`
 	ExprFilter         []*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	DDLHook            []*DDLHook            `yaml:"ddl-hook" toml:"ddl-hook" json:"ddl-hook"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList *filter.Rules `toml:"black-white-list" json:"black-white-list"`
@@ -144,7 +145,8 @@ type SubTaskConfig struct {
 	SyncerConfig   // Syncer configuration
 	ValidatorCfg   ValidatorConfig
 
-	// compatible with standalone dm unit
+	// compatible with standalone dm unit, `log-level` and `log-file` are also
+	// used to route the logs of the subtask to a separate file.
 	LogLevel  string `toml:"log-level" json:"log-level"`
 	LogFile   string `toml:"log-file" json:"log-file"`
 	LogFormat string `toml:"log-format" json:"log-format"`
@@ -362,6 +364,9 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	} else if c.SyncerConfig.SafeMode && duration == 0 {
 		return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 	}
+	if err := c.SyncerConfig.adjust(); err != nil {
+		return err
+	}
 
 	c.From.AdjustWithTimeZone(c.Timezone)
 	c.To.AdjustWithTimeZone(c.Timezone)
@@ -149,6 +149,47 @@ func TestSubTaskAdjustFail(t *testing.T) {
 			},
 			"Message: online scheme rtc not supported",
 		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.TableTunings = []*TableTuning{{SchemaPattern: "db", TablePattern: "tbl", Batch: MaxTableTuningBatch + 1}}
+				return cfg
+			},
+			"Message: table-tuning db.tbl is invalid: batch should be in [1, 10000]",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.TableTunings = []*TableTuning{{SchemaPattern: "db", MaxDMLSize: "1g"}}
+				return cfg
+			},
+			"Message: table-tuning db. is invalid: max-dml-size should be in [1KiB, 256MiB]",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.WorkerCount = 16
+				cfg.SyncerConfig.TableTunings = []*TableTuning{{SchemaPattern: "db", TablePattern: "tbl", WorkerCount: 32}}
+				return cfg
+			},
+			"Message: table-tuning db.tbl is invalid: worker-count should be in [1, 16] which is the worker-count of syncer",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.TableTunings = []*TableTuning{{SchemaPattern: "db", TablePattern: "tbl"}}
+				return cfg
+			},
+			"Message: table-tuning db.tbl is invalid: at least one of batch, max-dml-size and worker-count should be specified",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SyncerConfig.StrictAllowList = "pause"
+				return cfg
+			},
+			"Message: invalid strict-allow-list pause",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,85 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/docker/go-units"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// bounds of the table tuning overrides.
+const (
+	MaxTableTuningBatch      = 10000
+	MinTableTuningMaxDMLSize = units.KiB
+	MaxTableTuningMaxDMLSize = 256 * units.MiB
+)
+
+// TableTuning overrides the syncer tuning for the DMLs of the matched tables, it's used to
+// tame pathological tables like the ones with very large rows or very hot keys.
+// schema-pattern and table-pattern are matched against the upstream table, and wildcards are supported.
+// A zero value of an override means using the value of the syncer.
+type TableTuning struct {
+	SchemaPattern string `yaml:"schema-pattern" toml:"schema-pattern" json:"schema-pattern"`
+	TablePattern  string `yaml:"table-pattern" toml:"table-pattern" json:"table-pattern"`
+	// Batch is the max number of the DMLs executed in one transaction.
+	Batch int `yaml:"batch" toml:"batch" json:"batch"`
+	// MaxDMLSize is the max total size of the DMLs executed in one transaction, like "4MiB".
+	MaxDMLSize string `yaml:"max-dml-size" toml:"max-dml-size" json:"max-dml-size"`
+	// WorkerCount is the number of DML queues the DMLs are distributed to.
+	WorkerCount int `yaml:"worker-count" toml:"worker-count" json:"worker-count"`
+}
+
+// String implements fmt.Stringer.
+func (t *TableTuning) String() string {
+	return fmt.Sprintf("%s.%s", t.SchemaPattern, t.TablePattern)
+}
+
+// MaxDMLSizeInBytes returns the max-dml-size in bytes, 0 means no limit.
+// It should be called after adjust.
+func (t *TableTuning) MaxDMLSizeInBytes() int64 {
+	if t.MaxDMLSize == "" {
+		return 0
+	}
+	size, _ := units.RAMInBytes(t.MaxDMLSize)
+	return size
+}
+
+// adjust validates the TableTuning, the overrides are capped to sane ranges.
+func (t *TableTuning) adjust(workerCount int) error {
+	if t.SchemaPattern == "" {
+		return terror.ErrConfigInvalidTableTuning.Generate(t, "schema-pattern can't be empty")
+	}
+	if t.Batch < 0 || t.Batch > MaxTableTuningBatch {
+		return terror.ErrConfigInvalidTableTuning.Generate(t, fmt.Sprintf("batch should be in [1, %d]", MaxTableTuningBatch))
+	}
+	if t.MaxDMLSize != "" {
+		size, err := units.RAMInBytes(t.MaxDMLSize)
+		if err != nil {
+			return terror.ErrConfigInvalidTableTuning.Generate(t, "invalid max-dml-size "+t.MaxDMLSize)
+		}
+		if size < MinTableTuningMaxDMLSize || size > MaxTableTuningMaxDMLSize {
+			return terror.ErrConfigInvalidTableTuning.Generate(t, "max-dml-size should be in [1KiB, 256MiB]")
+		}
+	}
+	if t.WorkerCount < 0 || (workerCount > 0 && t.WorkerCount > workerCount) {
+		return terror.ErrConfigInvalidTableTuning.Generate(t, fmt.Sprintf("worker-count should be in [1, %d] which is the worker-count of syncer", workerCount))
+	}
+	if t.Batch == 0 && t.MaxDMLSize == "" && t.WorkerCount == 0 {
+		return terror.ErrConfigInvalidTableTuning.Generate(t, "at least one of batch, max-dml-size and worker-count should be specified")
+	}
+	return nil
+}
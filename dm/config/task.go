@@ -32,13 +32,17 @@ import (
 	"github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/filter"
+	tablefilter "github.com/pingcap/tidb/util/table-filter"
 	router "github.com/pingcap/tidb/util/table-router"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/yaml.v2"
 )
 
@@ -98,6 +102,19 @@ var (
 	// LoaderConfig.
 	defaultPoolSize = 16
 	defaultDir      = "./dumped_data"
+	// default row count of a chunk when verifying the checksum after load.
+	defaultChecksumChunkSizeLogical = 50000
+	defaultReadPoolSizeLogical      = 1
+	// the bloom filter of 1 million keys with 1% false positive rate takes about 1.2 MiB.
+	defaultDedupFalsePositiveRateLogical  = 0.01
+	defaultDedupMaxKeysLogical            = 1000000
+	defaultSlowQueryPlanIntervalLogical   = time.Minute
+	defaultAdaptiveRetryMinCountLogical   = 3
+	defaultAdaptiveRetryMaxCountLogical   = 20
+	defaultAdaptiveRetryMinBackoffLogical = 500 * time.Millisecond
+	defaultAdaptiveRetryMaxBackoffLogical = 30 * time.Second
+	defaultWriteConflictRetryCountLogical = 10
+	defaultClockSkewThreshold             = 30 * time.Second
 	// SyncerConfig.
 	defaultWorkerCount             = 16
 	defaultBatch                   = 100
@@ -143,6 +160,7 @@ type MySQLInstance struct {
 	ColumnMappingRules []string `yaml:"column-mapping-rules"`
 	RouteRules         []string `yaml:"route-rules"`
 	ExpressionFilters  []string `yaml:"expression-filters"`
+	DDLHooks           []string `yaml:"ddl-hooks"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWListName string `yaml:"black-white-list"`
@@ -283,6 +301,61 @@ const (
 	ChecksumOff      = "off"
 )
 
+// LoaderCheckpointStorage defines where the loader saves its checkpoints.
+type LoaderCheckpointStorage string
+
+const (
+	// LoaderCheckpointRemote saves checkpoints in the downstream database, this is the default.
+	LoaderCheckpointRemote LoaderCheckpointStorage = "remote"
+	// LoaderCheckpointLocal saves checkpoints in a local bolt file of the DM-worker. The load
+	// progress can't be resumed by another DM-worker after the source is bound to it.
+	LoaderCheckpointLocal LoaderCheckpointStorage = "local"
+)
+
+const (
+	// LoaderSQLModeAuto uses the global sql_mode of the downstream.
+	LoaderSQLModeAuto = "auto"
+	// LoaderSQLModeAutoRelax uses the global sql_mode of the downstream without the strict modes during loading.
+	LoaderSQLModeAutoRelax = "auto-relax"
+)
+
+// LoaderSlowQueryPlan is how the loader captures the execution plan of a slow query.
+type LoaderSlowQueryPlan string
+
+const (
+	// LoaderSlowQueryPlanNone doesn't capture the execution plans.
+	LoaderSlowQueryPlanNone LoaderSlowQueryPlan = ""
+	// LoaderSlowQueryPlanExplain captures the estimated execution plan by EXPLAIN.
+	LoaderSlowQueryPlanExplain LoaderSlowQueryPlan = "explain"
+	// LoaderSlowQueryPlanExplainAnalyze captures the actual execution plan by EXPLAIN ANALYZE,
+	// which runs the query again.
+	LoaderSlowQueryPlanExplainAnalyze LoaderSlowQueryPlan = "explain-analyze"
+)
+
+// LoaderIdempotencyKey is how the loader generates the idempotency key of a transaction.
+type LoaderIdempotencyKey string
+
+const (
+	// LoaderIdempotencyKeyNone doesn't write idempotency keys.
+	LoaderIdempotencyKeyNone LoaderIdempotencyKey = ""
+	// LoaderIdempotencyKeyPosition generates the key by the data file and the end offset of the transaction.
+	LoaderIdempotencyKeyPosition LoaderIdempotencyKey = "position"
+	// LoaderIdempotencyKeyContent generates the key by the hash of the statements of the transaction.
+	LoaderIdempotencyKeyContent LoaderIdempotencyKey = "content"
+)
+
+// LoaderClockSkewCheck is how the loader handles the clock skew of the downstream.
+type LoaderClockSkewCheck string
+
+const (
+	// LoaderClockSkewCheckNone doesn't check the clock skew.
+	LoaderClockSkewCheckNone LoaderClockSkewCheck = ""
+	// LoaderClockSkewCheckWarn logs a warning if the clock skew exceeds the threshold.
+	LoaderClockSkewCheckWarn LoaderClockSkewCheck = "warn"
+	// LoaderClockSkewCheckFail fails the unit if the clock skew exceeds the threshold.
+	LoaderClockSkewCheckFail LoaderClockSkewCheck = "fail"
+)
+
 // LoaderConfig represents loader process unit's specific config.
 type LoaderConfig struct {
 	PoolSize           int      `yaml:"pool-size" toml:"pool-size" json:"pool-size"`
@@ -296,6 +369,116 @@ type LoaderConfig struct {
 	OnDuplicatePhysical PhysicalDuplicateResolveType `yaml:"on-duplicate-physical" toml:"on-duplicate-physical" json:"on-duplicate-physical"`
 	DiskQuotaPhysical   config.ByteSize              `yaml:"disk-quota-physical" toml:"disk-quota-physical" json:"disk-quota-physical"`
 	ChecksumPhysical    PhysicalChecksumType         `yaml:"checksum-physical" toml:"checksum-physical" json:"checksum-physical"`
+	// CheckpointStorage, CheckpointSchema and CheckpointTable only take effect when ImportMode is "loader".
+	// When CheckpointTable is set, the loader uses this pre-created table as its checkpoint table
+	// and only validates its columns, which is useful when the downstream user can't create tables.
+	// The table may be shared by tasks, so its `id` column is keyed by "<task-name>:<source-id>".
+	CheckpointStorage LoaderCheckpointStorage `yaml:"checkpoint-storage" toml:"checkpoint-storage" json:"checkpoint-storage"`
+	CheckpointSchema  string                  `yaml:"checkpoint-schema" toml:"checkpoint-schema" json:"checkpoint-schema"`
+	CheckpointTable   string                  `yaml:"checkpoint-table" toml:"checkpoint-table" json:"checkpoint-table"`
+	// CheckpointBatchIntervalLogical only takes effect when ImportMode is "loader" and CheckpointStorage is "remote".
+	// When it's set, the checkpoints of data files are not written in the transactions of their data, but batched
+	// and written in one transaction at the interval, which reduces the small writes of loading many small tables.
+	// The data loaded in the last interval is loaded again after a crash, so on-duplicate-logical can't be "error"
+	// unless dedup-logical is enabled. It's 0 to write the checkpoint with the data.
+	CheckpointBatchIntervalLogical Duration `yaml:"checkpoint-batch-interval-logical" toml:"checkpoint-batch-interval-logical" json:"checkpoint-batch-interval-logical"`
+	// ChecksumLogical and ChecksumChunkSizeLogical only take effect when ImportMode is "loader".
+	// When ChecksumLogical is true, the loaded tables are verified chunk by chunk against the source after load,
+	// and the mismatched chunks are reported. ChecksumChunkSizeLogical is the number of rows in a chunk.
+	ChecksumLogical          bool `yaml:"checksum-logical" toml:"checksum-logical" json:"checksum-logical"`
+	ChecksumChunkSizeLogical int  `yaml:"checksum-chunk-size-logical" toml:"checksum-chunk-size-logical" json:"checksum-chunk-size-logical"`
+	// RateLimitLogical and ThrottleWindowsLogical only take effect when ImportMode is "loader".
+	// RateLimitLogical is the max number of transactions executed per second by the loader, 0 means no limit.
+	// During the ThrottleWindowsLogical, the rate limit is scaled by the rate-multiplier of the first active window.
+	RateLimitLogical       int                    `yaml:"rate-limit-logical" toml:"rate-limit-logical" json:"rate-limit-logical"`
+	ThrottleWindowsLogical []LoaderThrottleWindow `yaml:"throttle-windows-logical" toml:"throttle-windows-logical" json:"throttle-windows-logical"`
+	// BackpressureLogical only takes effect when ImportMode is "loader". When its status-variable or query is set,
+	// the loader polls the overload signal of the downstream, and lowers the rate limit to the throttled-rate while
+	// the signal reaches the threshold, until it clears. It works with or without RateLimitLogical.
+	BackpressureLogical LoaderBackpressure `yaml:"backpressure-logical" toml:"backpressure-logical" json:"backpressure-logical"`
+	// ReadPoolSizeLogical only takes effect when ChecksumLogical is true. It's the size of the downstream
+	// connection pool used by verification reads, which is separate from the PoolSize connections of writes.
+	ReadPoolSizeLogical int `yaml:"read-pool-size-logical" toml:"read-pool-size-logical" json:"read-pool-size-logical"`
+	// LoadOrderLogical only takes effect when ImportMode is "loader". It lists the table patterns of upstream
+	// tables in priority tiers, the loader restores all data files of a tier before the next tier starts.
+	// Tables not matched by any tier are restored in an implicit last tier.
+	LoadOrderLogical [][]string `yaml:"load-order-logical" toml:"load-order-logical" json:"load-order-logical"`
+	// DedupLogical, DedupFalsePositiveRateLogical and DedupMaxKeysLogical only take effect when ImportMode is "loader".
+	// When DedupLogical is true, the loader keeps a bloom filter of the primary keys loaded into each table, seeded
+	// from the downstream table, and skips the rows which are already in the downstream. The filter is probabilistic:
+	// a miss means the row is certainly not loaded, while a hit is confirmed by querying the downstream, so a false
+	// positive only costs a query and a row is never skipped unless it's found in the downstream. A skipped row keeps
+	// the downstream value, as if on-duplicate-logical is "ignore".
+	// DedupFalsePositiveRateLogical is the expected false positive rate of a filter. DedupMaxKeysLogical is the max
+	// number of keys of a filter, which bounds its memory, the dedup is disabled for a table when it's exceeded.
+	DedupLogical                  bool    `yaml:"dedup-logical" toml:"dedup-logical" json:"dedup-logical"`
+	DedupFalsePositiveRateLogical float64 `yaml:"dedup-false-positive-rate-logical" toml:"dedup-false-positive-rate-logical" json:"dedup-false-positive-rate-logical"`
+	DedupMaxKeysLogical           int     `yaml:"dedup-max-keys-logical" toml:"dedup-max-keys-logical" json:"dedup-max-keys-logical"`
+	// SlowQueryPlanLogical and SlowQueryPlanIntervalLogical only take effect when ImportMode is "loader".
+	// SlowQueryPlanLogical is how the execution plan of a slow query is captured on a side connection and logged,
+	// it's empty to not capture. SlowQueryPlanIntervalLogical is the min interval between two captures, so that
+	// capturing doesn't amplify the load of the downstream during a slowdown.
+	SlowQueryPlanLogical         LoaderSlowQueryPlan `yaml:"slow-query-plan-logical" toml:"slow-query-plan-logical" json:"slow-query-plan-logical"`
+	SlowQueryPlanIntervalLogical Duration            `yaml:"slow-query-plan-interval-logical" toml:"slow-query-plan-interval-logical" json:"slow-query-plan-interval-logical"`
+	// AdaptiveRetryLogical and the bounds of it only take effect when ImportMode is "loader".
+	// When AdaptiveRetryLogical is true, the retry params of the downstream statements are tuned by the recent
+	// errors: the backoff is lengthened if connection errors dominate, jitter is added if deadlocks dominate,
+	// and the retries are tightened if errors are rare. The retry count is kept in
+	// [AdaptiveRetryMinCountLogical, AdaptiveRetryMaxCountLogical] and the first retry backoff is kept in
+	// [AdaptiveRetryMinBackoffLogical, AdaptiveRetryMaxBackoffLogical].
+	AdaptiveRetryLogical           bool     `yaml:"adaptive-retry-logical" toml:"adaptive-retry-logical" json:"adaptive-retry-logical"`
+	AdaptiveRetryMinCountLogical   int      `yaml:"adaptive-retry-min-count-logical" toml:"adaptive-retry-min-count-logical" json:"adaptive-retry-min-count-logical"`
+	AdaptiveRetryMaxCountLogical   int      `yaml:"adaptive-retry-max-count-logical" toml:"adaptive-retry-max-count-logical" json:"adaptive-retry-max-count-logical"`
+	AdaptiveRetryMinBackoffLogical Duration `yaml:"adaptive-retry-min-backoff-logical" toml:"adaptive-retry-min-backoff-logical" json:"adaptive-retry-min-backoff-logical"`
+	AdaptiveRetryMaxBackoffLogical Duration `yaml:"adaptive-retry-max-backoff-logical" toml:"adaptive-retry-max-backoff-logical" json:"adaptive-retry-max-backoff-logical"`
+	// WriteConflictRetryCountLogical only takes effect when ImportMode is "loader". It's the max number of times a
+	// transaction is retried on its connection with a short backoff when it meets write conflicts of the optimistic
+	// transactions of TiDB, which don't consume the retries of the other errors. It's 10 by default.
+	WriteConflictRetryCountLogical int `yaml:"write-conflict-retry-count-logical" toml:"write-conflict-retry-count-logical" json:"write-conflict-retry-count-logical"`
+	// ParsePoolSizeLogical only takes effect when ImportMode is "loader". It's the number of goroutines parsing and
+	// rewriting the dumped statements, which are separated from the PoolSize workers executing the statements, so
+	// that the parsing doesn't stall the downstream IO. It's 0 to parse the statements by the readers of data files.
+	ParsePoolSizeLogical int `yaml:"parse-pool-size-logical" toml:"parse-pool-size-logical" json:"parse-pool-size-logical"`
+	// WorkStealingLogical only takes effect when ImportMode is "loader". When it's true, the statements read from the
+	// data files are put into a shared queue and executed by any idle worker, so that the workers which finish their
+	// small files help the workers reading large files. The checkpoint of a data file only advances over its
+	// statements which are all loaded. The statements of a file are still executed one by one when the loaded data
+	// can't be loaded again, i.e. the checkpoint is written with the data or on-duplicate-logical is "error" without
+	// dedup-logical.
+	WorkStealingLogical bool `yaml:"work-stealing-logical" toml:"work-stealing-logical" json:"work-stealing-logical"`
+	// CursorBatchSizeLogical only takes effect when ImportMode is "loader". When it's positive, the loader scans the
+	// downstream tables, e.g. to seed the filters of dedup-logical, by server-side cursors which fetch this number of
+	// rows per batch, instead of reading the whole result of a query on the connection. The cursors are opened by
+	// HANDLER statements, the loader falls back to the queries if the downstream doesn't support them, e.g. TiDB.
+	// It's 0 to not use cursors.
+	CursorBatchSizeLogical int `yaml:"cursor-batch-size-logical" toml:"cursor-batch-size-logical" json:"cursor-batch-size-logical"`
+	// SQLModeLogical only takes effect when ImportMode is "loader". It's the session sql_mode of the downstream
+	// connections, which is applied on connect and again after a connection is reset. It's empty to use the
+	// sql_mode of the upstream adjusted for compatibility, "auto" to use the global sql_mode of the downstream,
+	// and "auto-relax" to use the global sql_mode of the downstream without the strict modes during loading and
+	// restore it after loading finishes. Relaxing the strict modes trades integrity for availability: invalid or
+	// out-of-range values are adjusted with warnings instead of failing the load, e.g. truncated strings, clipped
+	// numbers and zero dates, so the loaded data may differ from the upstream silently.
+	SQLModeLogical string `yaml:"sql-mode-logical" toml:"sql-mode-logical" json:"sql-mode-logical"`
+	// IdempotencyKeyLogical and IdempotencyTableLogical only take effect when ImportMode is "loader".
+	// When IdempotencyKeyLogical is set, each transaction of data carries a key which is written to the idempotency
+	// table in the same transaction. When the transaction is retried after an ambiguous failure, i.e. the connection
+	// is broken so it's unknown whether the transaction is committed, the key is checked first and the transaction
+	// is skipped if the key exists, so the data is loaded exactly once. It's "position" to generate the key by the
+	// data file and the offset, "content" by the hash of the statements, and empty to not write keys.
+	// IdempotencyTableLogical is the name of the idempotency table in the meta schema, it's
+	// "<task>_loader_idempotency" by default. The table is created if it doesn't exist, a pre-created table must have
+	// the columns `task_name`, `source_name` and `idempotency_key` as its primary key. The keys of a source are
+	// deleted after all data files of the source are loaded, and the rest are deleted with the meta data of the task.
+	IdempotencyKeyLogical   LoaderIdempotencyKey `yaml:"idempotency-key-logical" toml:"idempotency-key-logical" json:"idempotency-key-logical"`
+	IdempotencyTableLogical string               `yaml:"idempotency-table-logical" toml:"idempotency-table-logical" json:"idempotency-table-logical"`
+	// ClockSkewCheck and ClockSkewThreshold take effect in all import modes. When ClockSkewCheck is set, the clock of
+	// the downstream is compared with the local clock when the connections are created, because the timestamps
+	// generated by the downstream, e.g. CURRENT_TIMESTAMP and the create_time of the meta tables, are wrong if its
+	// clock is skewed. It's "warn" to log a warning and "fail" to fail the unit if the skew exceeds
+	// ClockSkewThreshold, and empty to not check. The measured skew is also exposed as a metric.
+	ClockSkewCheck     LoaderClockSkewCheck `yaml:"clock-skew-check" toml:"clock-skew-check" json:"clock-skew-check"`
+	ClockSkewThreshold Duration             `yaml:"clock-skew-threshold" toml:"clock-skew-threshold" json:"clock-skew-threshold"`
 }
 
 // DefaultLoaderConfig return default loader config for task.
@@ -305,6 +488,7 @@ func DefaultLoaderConfig() LoaderConfig {
 		Dir:                defaultDir,
 		ImportMode:         LoadModeLogical,
 		OnDuplicateLogical: OnDuplicateReplace,
+		CheckpointStorage:  LoaderCheckpointRemote,
 	}
 }
 
@@ -372,9 +556,244 @@ func (m *LoaderConfig) adjust() error {
 		return terror.ErrConfigInvalidPhysicalChecksum.Generate(m.ChecksumPhysical)
 	}
 
+	if m.CheckpointStorage == "" {
+		m.CheckpointStorage = LoaderCheckpointRemote
+	}
+	m.CheckpointStorage = LoaderCheckpointStorage(strings.ToLower(string(m.CheckpointStorage)))
+	switch m.CheckpointStorage {
+	case LoaderCheckpointRemote, LoaderCheckpointLocal:
+	default:
+		return terror.ErrConfigInvalidLoaderCheckpointStorage.Generate(m.CheckpointStorage)
+	}
+	if m.CheckpointStorage == LoaderCheckpointLocal && m.CheckpointTable != "" {
+		return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-table can't be used with local checkpoint-storage")
+	}
+	if m.CheckpointSchema != "" && m.CheckpointTable == "" {
+		return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-schema must be used with checkpoint-table")
+	}
+	if (m.CheckpointStorage == LoaderCheckpointLocal || m.CheckpointTable != "") && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-storage and checkpoint-table are only supported when import-mode is loader")
+	}
+	if m.CheckpointBatchIntervalLogical.Duration < 0 {
+		return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-batch-interval-logical must not be negative")
+	}
+	if m.CheckpointBatchIntervalLogical.Duration > 0 {
+		if m.ImportMode != LoadModeLoader || m.CheckpointStorage != LoaderCheckpointRemote {
+			return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-batch-interval-logical is only supported when import-mode is loader and checkpoint-storage is remote")
+		}
+		if m.OnDuplicateLogical == OnDuplicateError && !m.DedupLogical {
+			return terror.ErrConfigInvalidLoaderCheckpoint.Generate("checkpoint-batch-interval-logical can't be used with on-duplicate-logical error unless dedup-logical is enabled")
+		}
+	}
+
+	if m.ChecksumLogical {
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderChecksum.Generate("checksum-logical is only supported when import-mode is loader")
+		}
+		if m.ChecksumChunkSizeLogical < 0 {
+			return terror.ErrConfigInvalidLoaderChecksum.Generate("checksum-chunk-size-logical must not be negative")
+		}
+		if m.ChecksumChunkSizeLogical == 0 {
+			m.ChecksumChunkSizeLogical = defaultChecksumChunkSizeLogical
+		}
+		if m.ReadPoolSizeLogical < 0 {
+			return terror.ErrConfigInvalidLoaderChecksum.Generate("read-pool-size-logical must not be negative")
+		}
+		if m.ReadPoolSizeLogical == 0 {
+			m.ReadPoolSizeLogical = defaultReadPoolSizeLogical
+		}
+	}
+
+	if m.RateLimitLogical < 0 {
+		return terror.ErrConfigInvalidLoaderThrottle.Generate("rate-limit-logical must not be negative")
+	}
+	if m.RateLimitLogical > 0 && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderThrottle.Generate("rate-limit-logical is only supported when import-mode is loader")
+	}
+	if len(m.ThrottleWindowsLogical) > 0 && m.RateLimitLogical == 0 {
+		return terror.ErrConfigInvalidLoaderThrottle.Generate("throttle-windows-logical must be used with rate-limit-logical")
+	}
+	for i := range m.ThrottleWindowsLogical {
+		if err := m.ThrottleWindowsLogical[i].adjust(); err != nil {
+			return err
+		}
+	}
+	if m.BackpressureLogical.Enabled() {
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderBackpressure.Generate("backpressure-logical is only supported when import-mode is loader")
+		}
+		if err := m.BackpressureLogical.adjust(); err != nil {
+			return err
+		}
+	}
+
+	if len(m.LoadOrderLogical) > 0 && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderLoadOrder.Generate("load-order-logical is only supported when import-mode is loader")
+	}
+	for i, tier := range m.LoadOrderLogical {
+		if len(tier) == 0 {
+			return terror.ErrConfigInvalidLoaderLoadOrder.Generate(fmt.Sprintf("tier %d of load-order-logical is empty", i+1))
+		}
+		if _, err := tablefilter.Parse(tier); err != nil {
+			return terror.ErrConfigInvalidLoaderLoadOrder.Delegate(err, fmt.Sprintf("tier %d of load-order-logical", i+1))
+		}
+	}
+
+	if m.DedupLogical {
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderDedup.Generate("dedup-logical is only supported when import-mode is loader")
+		}
+		if m.DedupFalsePositiveRateLogical == 0 {
+			m.DedupFalsePositiveRateLogical = defaultDedupFalsePositiveRateLogical
+		}
+		if m.DedupFalsePositiveRateLogical < 0 || m.DedupFalsePositiveRateLogical >= 1 {
+			return terror.ErrConfigInvalidLoaderDedup.Generate("dedup-false-positive-rate-logical must be in (0, 1)")
+		}
+		if m.DedupMaxKeysLogical < 0 {
+			return terror.ErrConfigInvalidLoaderDedup.Generate("dedup-max-keys-logical must not be negative")
+		}
+		if m.DedupMaxKeysLogical == 0 {
+			m.DedupMaxKeysLogical = defaultDedupMaxKeysLogical
+		}
+	}
+
+	switch m.SlowQueryPlanLogical {
+	case LoaderSlowQueryPlanNone:
+	case LoaderSlowQueryPlanExplain, LoaderSlowQueryPlanExplainAnalyze:
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderSlowQueryPlan.Generate("slow-query-plan-logical is only supported when import-mode is loader")
+		}
+		if m.SlowQueryPlanIntervalLogical.Duration < 0 {
+			return terror.ErrConfigInvalidLoaderSlowQueryPlan.Generate("slow-query-plan-interval-logical must not be negative")
+		}
+		if m.SlowQueryPlanIntervalLogical.Duration == 0 {
+			m.SlowQueryPlanIntervalLogical.Duration = defaultSlowQueryPlanIntervalLogical
+		}
+	default:
+		return terror.ErrConfigInvalidLoaderSlowQueryPlan.Generate(fmt.Sprintf(
+			"slow-query-plan-logical must be one of %q, %q and %q", LoaderSlowQueryPlanNone,
+			LoaderSlowQueryPlanExplain, LoaderSlowQueryPlanExplainAnalyze))
+	}
+
+	if m.AdaptiveRetryLogical {
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderAdaptiveRetry.Generate("adaptive-retry-logical is only supported when import-mode is loader")
+		}
+		if m.AdaptiveRetryMinCountLogical < 0 || m.AdaptiveRetryMaxCountLogical < 0 {
+			return terror.ErrConfigInvalidLoaderAdaptiveRetry.Generate("retry count bounds must not be negative")
+		}
+		if m.AdaptiveRetryMinBackoffLogical.Duration < 0 || m.AdaptiveRetryMaxBackoffLogical.Duration < 0 {
+			return terror.ErrConfigInvalidLoaderAdaptiveRetry.Generate("retry backoff bounds must not be negative")
+		}
+		if m.AdaptiveRetryMinCountLogical == 0 {
+			m.AdaptiveRetryMinCountLogical = defaultAdaptiveRetryMinCountLogical
+		}
+		if m.AdaptiveRetryMaxCountLogical == 0 {
+			m.AdaptiveRetryMaxCountLogical = defaultAdaptiveRetryMaxCountLogical
+		}
+		if m.AdaptiveRetryMinBackoffLogical.Duration == 0 {
+			m.AdaptiveRetryMinBackoffLogical.Duration = defaultAdaptiveRetryMinBackoffLogical
+		}
+		if m.AdaptiveRetryMaxBackoffLogical.Duration == 0 {
+			m.AdaptiveRetryMaxBackoffLogical.Duration = defaultAdaptiveRetryMaxBackoffLogical
+		}
+		if m.AdaptiveRetryMinCountLogical > m.AdaptiveRetryMaxCountLogical {
+			return terror.ErrConfigInvalidLoaderAdaptiveRetry.Generate(
+				"adaptive-retry-min-count-logical must not be greater than adaptive-retry-max-count-logical")
+		}
+		if m.AdaptiveRetryMinBackoffLogical.Duration > m.AdaptiveRetryMaxBackoffLogical.Duration {
+			return terror.ErrConfigInvalidLoaderAdaptiveRetry.Generate(
+				"adaptive-retry-min-backoff-logical must not be greater than adaptive-retry-max-backoff-logical")
+		}
+	}
+
+	if m.WriteConflictRetryCountLogical < 0 {
+		return terror.ErrConfigInvalidLoaderWriteConflictRetry.Generate("write-conflict-retry-count-logical must not be negative")
+	}
+	if m.WriteConflictRetryCountLogical > 0 && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderWriteConflictRetry.Generate("write-conflict-retry-count-logical is only supported when import-mode is loader")
+	}
+	if m.ImportMode == LoadModeLoader && m.WriteConflictRetryCountLogical == 0 {
+		m.WriteConflictRetryCountLogical = defaultWriteConflictRetryCountLogical
+	}
+
+	if m.ParsePoolSizeLogical < 0 {
+		return terror.ErrConfigInvalidLoaderParsePool.Generate("parse-pool-size-logical must not be negative")
+	}
+	if m.ParsePoolSizeLogical > 0 && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderParsePool.Generate("parse-pool-size-logical is only supported when import-mode is loader")
+	}
+	if m.WorkStealingLogical && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderWorkStealing.Generate("work-stealing-logical is only supported when import-mode is loader")
+	}
+	if m.CursorBatchSizeLogical < 0 {
+		return terror.ErrConfigInvalidLoaderCursor.Generate("cursor-batch-size-logical must not be negative")
+	}
+	if m.CursorBatchSizeLogical > 0 && m.ImportMode != LoadModeLoader {
+		return terror.ErrConfigInvalidLoaderCursor.Generate("cursor-batch-size-logical is only supported when import-mode is loader")
+	}
+
+	if m.SQLModeLogical != "" {
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderSQLMode.Generate("sql-mode-logical is only supported when import-mode is loader")
+		}
+		switch strings.ToLower(m.SQLModeLogical) {
+		case LoaderSQLModeAuto, LoaderSQLModeAutoRelax:
+			m.SQLModeLogical = strings.ToLower(m.SQLModeLogical)
+		default:
+			if _, err := mysql.GetSQLMode(m.SQLModeLogical); err != nil {
+				return terror.ErrConfigInvalidLoaderSQLMode.Delegate(err, "sql-mode-logical")
+			}
+		}
+	}
+
+	m.IdempotencyKeyLogical = LoaderIdempotencyKey(strings.ToLower(string(m.IdempotencyKeyLogical)))
+	switch m.IdempotencyKeyLogical {
+	case LoaderIdempotencyKeyNone:
+		if m.IdempotencyTableLogical != "" {
+			return terror.ErrConfigInvalidLoaderIdempotency.Generate("idempotency-table-logical must be used with idempotency-key-logical")
+		}
+	case LoaderIdempotencyKeyPosition, LoaderIdempotencyKeyContent:
+		if m.ImportMode != LoadModeLoader {
+			return terror.ErrConfigInvalidLoaderIdempotency.Generate("idempotency-key-logical is only supported when import-mode is loader")
+		}
+	default:
+		return terror.ErrConfigInvalidLoaderIdempotency.Generate(fmt.Sprintf(
+			"idempotency-key-logical must be one of %q, %q and %q", LoaderIdempotencyKeyNone,
+			LoaderIdempotencyKeyPosition, LoaderIdempotencyKeyContent))
+	}
+
+	m.ClockSkewCheck = LoaderClockSkewCheck(strings.ToLower(string(m.ClockSkewCheck)))
+	switch m.ClockSkewCheck {
+	case LoaderClockSkewCheckNone:
+	case LoaderClockSkewCheckWarn, LoaderClockSkewCheckFail:
+		if m.ClockSkewThreshold.Duration < 0 {
+			return terror.ErrConfigInvalidLoaderClockSkew.Generate("clock-skew-threshold must not be negative")
+		}
+		if m.ClockSkewThreshold.Duration == 0 {
+			m.ClockSkewThreshold.Duration = defaultClockSkewThreshold
+		}
+	default:
+		return terror.ErrConfigInvalidLoaderClockSkew.Generate(fmt.Sprintf(
+			"clock-skew-check must be one of %q, %q and %q", LoaderClockSkewCheckNone,
+			LoaderClockSkewCheckWarn, LoaderClockSkewCheckFail))
+	}
+
 	return nil
 }
 
+// StrictAllowListMode is how the syncer handles the binlog events of the tables not matched by block-allow-list.
+type StrictAllowListMode string
+
+const (
+	// StrictAllowListNone skips the events silently.
+	StrictAllowListNone StrictAllowListMode = ""
+	// StrictAllowListWarn skips the events, and records a warning for every table.
+	StrictAllowListWarn StrictAllowListMode = "warn"
+	// StrictAllowListError pauses the task at the first event.
+	StrictAllowListError StrictAllowListMode = "error"
+)
+
 // SyncerConfig represents syncer process unit's specific config.
 type SyncerConfig struct {
 	MetaFile    string `yaml:"meta-file" toml:"meta-file" json:"meta-file"` // meta filename, used only when load SubConfig directly
@@ -399,6 +818,12 @@ type SyncerConfig struct {
 	SafeModeDuration string `yaml:"safe-mode-duration" toml:"safe-mode-duration" json:"safe-mode-duration"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
+
+	// TableTunings overrides the tuning for the DMLs of some tables, the first matched one takes effect.
+	TableTunings []*TableTuning `yaml:"table-tunings" toml:"table-tunings" json:"table-tunings"`
+	// StrictAllowList is how the binlog events of the tables not matched by block-allow-list are handled,
+	// they are skipped silently if it's empty.
+	StrictAllowList StrictAllowListMode `yaml:"strict-allow-list" toml:"strict-allow-list" json:"strict-allow-list"`
 }
 
 // DefaultSyncerConfig return default syncer config for task.
@@ -412,6 +837,22 @@ func DefaultSyncerConfig() SyncerConfig {
 	}
 }
 
+// adjust validates the SyncerConfig.
+func (m *SyncerConfig) adjust() error {
+	for _, tuning := range m.TableTunings {
+		if err := tuning.adjust(m.WorkerCount); err != nil {
+			return err
+		}
+	}
+	m.StrictAllowList = StrictAllowListMode(strings.ToLower(string(m.StrictAllowList)))
+	switch m.StrictAllowList {
+	case StrictAllowListNone, StrictAllowListWarn, StrictAllowListError:
+	default:
+		return terror.ErrConfigInvalidStrictAllowList.Generate(m.StrictAllowList)
+	}
+	return nil
+}
+
 // alias to avoid infinite recursion for UnmarshalYAML.
 type rawSyncerConfig SyncerConfig
 
@@ -528,6 +969,7 @@ type TaskConfig struct {
 	Filters        map[string]*bf.BinlogEventRule `yaml:"filters" toml:"filters" json:"filters"`
 	ColumnMappings map[string]*column.Rule        `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
 	ExprFilter     map[string]*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	DDLHook        map[string]*DDLHook            `yaml:"ddl-hook" toml:"ddl-hook" json:"ddl-hook"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList map[string]*filter.Rules `yaml:"black-white-list" toml:"black-white-list" json:"black-white-list"`
@@ -545,6 +987,13 @@ type TaskConfig struct {
 	// deprecated, replaced by `start-task --remove-meta`
 	RemoveMeta bool `yaml:"remove-meta"`
 
+	// the logs of the subtasks are written to separate files with an independent
+	// level if `log-level` or `log-file` is set, otherwise to the DM-worker log.
+	// The source ID is added to the file name, e.g. `logs/task.log` becomes
+	// `logs/task-mysql-replica-01.log`, default is `logs/<task-name>.log`.
+	LogLevel string `yaml:"log-level" toml:"log-level" json:"log-level"`
+	LogFile  string `yaml:"log-file" toml:"log-file" json:"log-file"`
+
 	// task experimental configs
 	Experimental struct {
 		AsyncCheckpointFlush bool `yaml:"async-checkpoint-flush" toml:"async-checkpoint-flush" json:"async-checkpoint-flush"`
@@ -565,6 +1014,7 @@ func NewTaskConfig() *TaskConfig {
 		Filters:                 make(map[string]*bf.BinlogEventRule),
 		ColumnMappings:          make(map[string]*column.Rule),
 		ExprFilter:              make(map[string]*ExpressionFilter),
+		DDLHook:                 make(map[string]*DDLHook),
 		BWList:                  make(map[string]*filter.Rules),
 		BAList:                  make(map[string]*filter.Rules),
 		Mydumpers:               make(map[string]*MydumperConfig),
@@ -607,7 +1057,7 @@ func (c *TaskConfig) DecodeFile(fpath string) error {
 
 	err = yaml.UnmarshalStrict(bs, c)
 	if err != nil {
-		return terror.ErrConfigYamlTransform.Delegate(err)
+		return terror.ErrConfigYamlTransform.Delegate(hintYAMLError(bs, err))
 	}
 
 	return c.adjust()
@@ -617,7 +1067,7 @@ func (c *TaskConfig) DecodeFile(fpath string) error {
 func (c *TaskConfig) Decode(data string) error {
 	err := yaml.UnmarshalStrict([]byte(data), c)
 	if err != nil {
-		return terror.ErrConfigYamlTransform.Delegate(err, "decode task config failed")
+		return terror.ErrConfigYamlTransform.Delegate(hintYAMLError([]byte(data), err), "decode task config failed")
 	}
 
 	return c.adjust()
@@ -625,11 +1075,12 @@ func (c *TaskConfig) Decode(data string) error {
 
 // RawDecode loads config from file data.
 func (c *TaskConfig) RawDecode(data string) error {
-	return terror.ErrConfigYamlTransform.Delegate(yaml.UnmarshalStrict([]byte(data), c), "decode task config failed")
+	err := yaml.UnmarshalStrict([]byte(data), c)
+	return terror.ErrConfigYamlTransform.Delegate(hintYAMLError([]byte(data), err), "decode task config failed")
 }
 
 // find unused items in config.
-var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter", "Validator"}
+var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter", "Validator", "DDLHook"}
 
 const (
 	routeRulesIdx = iota
@@ -640,6 +1091,7 @@ const (
 	syncerIdx
 	exprFilterIdx
 	validatorIdx
+	ddlHookIdx
 )
 
 // Adjust adjusts and verifies config.
@@ -721,6 +1173,14 @@ func (c *TaskConfig) adjust() error {
 			}
 			setFields = append(setFields, "update (old value): ["+exprFilter.UpdateOldValueExpr+"] update (new value): ["+exprFilter.UpdateNewValueExpr+"]")
 		}
+		if exprFilter.IgnoreUpdateByColumns() {
+			for _, col := range exprFilter.IgnoreUpdateWhenOnlyColumns {
+				if col == "" {
+					return terror.ErrConfigExprFilterEmptyName.Generate(name, "column in ignore-update-when-only-columns")
+				}
+			}
+			setFields = append(setFields, "update (ignore when only columns): ["+strings.Join(exprFilter.IgnoreUpdateWhenOnlyColumns, ", ")+"]")
+		}
 		if exprFilter.DeleteValueExpr != "" {
 			if err := checkValidExpr(exprFilter.DeleteValueExpr); err != nil {
 				return terror.ErrConfigExprFilterWrongGrammar.Generate(name, exprFilter.DeleteValueExpr, err)
@@ -732,6 +1192,12 @@ func (c *TaskConfig) adjust() error {
 		}
 	}
 
+	for name, hook := range c.DDLHook {
+		if err := hook.adjust(name); err != nil {
+			return err
+		}
+	}
+
 	for _, validatorCfg := range c.Validators {
 		if err := validatorCfg.Adjust(); err != nil {
 			return err
@@ -908,6 +1374,13 @@ func (c *TaskConfig) adjust() error {
 			globalConfigReferCount[configRefPrefixes[exprFilterIdx]+name]++
 		}
 
+		for _, name := range inst.DDLHooks {
+			if _, ok := c.DDLHook[name]; !ok {
+				return terror.ErrConfigDDLHookNotFound.Generate(i, name)
+			}
+			globalConfigReferCount[configRefPrefixes[ddlHookIdx]+name]++
+		}
+
 		if dupeRules := checkDuplicateString(inst.RouteRules); len(dupeRules) > 0 {
 			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s route-rules: %s", i, strings.Join(dupeRules, ", ")))
 		}
@@ -920,6 +1393,9 @@ func (c *TaskConfig) adjust() error {
 		if dupeRules := checkDuplicateString(inst.ExpressionFilters); len(dupeRules) > 0 {
 			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s expression-filters: %s", i, strings.Join(dupeRules, ", ")))
 		}
+		if dupeRules := checkDuplicateString(inst.DDLHooks); len(dupeRules) > 0 {
+			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s ddl-hooks: %s", i, strings.Join(dupeRules, ", ")))
+		}
 	}
 	if len(duplicateErrorStrings) > 0 {
 		return terror.ErrConfigDuplicateCfgItem.Generate(strings.Join(duplicateErrorStrings, "\n"))
@@ -972,6 +1448,11 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, key)
 		}
 	}
+	for ddlHook := range c.DDLHook {
+		if globalConfigReferCount[configRefPrefixes[ddlHookIdx]+ddlHook] == 0 {
+			unusedConfigs = append(unusedConfigs, ddlHook)
+		}
+	}
 
 	if len(unusedConfigs) != 0 {
 		sort.Strings(unusedConfigs)
@@ -984,6 +1465,12 @@ func (c *TaskConfig) adjust() error {
 			return err
 		}
 	}
+	if c.LogLevel != "" {
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+			return terror.ErrConfigInvalidTaskLog.Generate(fmt.Sprintf("invalid log-level %s", c.LogLevel))
+		}
+	}
 	if c.RemoveMeta {
 		log.L().Warn("`remove-meta` in task config is deprecated, please use `start-task ... --remove-meta` instead")
 	}
@@ -1064,12 +1551,14 @@ func checkValidExpr(expr string) error {
 func (c *TaskConfig) YamlForDowngrade() (string, error) {
 	t := NewTaskConfigForDowngrade(c)
 
-	// encrypt password
-	cipher, err := utils.Encrypt(utils.DecryptOrPlaintext(t.TargetDB.Password))
-	if err != nil {
-		return "", err
+	// encrypt password, a reference to a secret is kept as is.
+	if !secret.IsReference(t.TargetDB.Password) {
+		cipher, err := utils.Encrypt(utils.DecryptOrPlaintext(t.TargetDB.Password))
+		if err != nil {
+			return "", err
+		}
+		t.TargetDB.Password = cipher
 	}
-	t.TargetDB.Password = cipher
 
 	// omit default values, so we can ignore them for later marshal
 	t.omitDefaultVals()
@@ -1097,6 +1586,7 @@ type MySQLInstanceForDowngrade struct {
 	SyncerThread       int             `yaml:"syncer-thread"`
 	// new config item
 	ExpressionFilters []string `yaml:"expression-filters,omitempty"`
+	DDLHooks          []string `yaml:"ddl-hooks,omitempty"`
 }
 
 // NewMySQLInstancesForDowngrade creates []* MySQLInstanceForDowngrade.
@@ -1121,6 +1611,7 @@ func NewMySQLInstancesForDowngrade(mysqlInstances []*MySQLInstance) []*MySQLInst
 			Syncer:             m.Syncer,
 			SyncerThread:       m.SyncerThread,
 			ExpressionFilters:  m.ExpressionFilters,
+			DDLHooks:           m.DDLHooks,
 		}
 		mysqlInstancesForDowngrade = append(mysqlInstancesForDowngrade, newMySQLInstance)
 	}
@@ -1230,6 +1721,7 @@ type TaskConfigForDowngrade struct {
 	// new config item
 	MySQLInstances   []*MySQLInstanceForDowngrade `yaml:"mysql-instances"`
 	ExprFilter       map[string]*ExpressionFilter `yaml:"expression-filter,omitempty"`
+	DDLHook          map[string]*DDLHook          `yaml:"ddl-hook,omitempty"`
 	OnlineDDL        bool                         `yaml:"online-ddl,omitempty"`
 	ShadowTableRules []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules  []string                     `yaml:"trash-table-rules,omitempty"`
@@ -1264,6 +1756,7 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		RemoveMeta:              taskConfig.RemoveMeta,
 		MySQLInstances:          NewMySQLInstancesForDowngrade(taskConfig.MySQLInstances),
 		ExprFilter:              taskConfig.ExprFilter,
+		DDLHook:                 taskConfig.DDLHook,
 		OnlineDDL:               taskConfig.OnlineDDL,
 		ShadowTableRules:        taskConfig.ShadowTableRules,
 		TrashTableRules:         taskConfig.TrashTableRules,
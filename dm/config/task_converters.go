@@ -54,6 +54,8 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		cfg.HeartbeatUpdateInterval = c.HeartbeatUpdateInterval
 		cfg.HeartbeatReportInterval = c.HeartbeatReportInterval
 		cfg.Timezone = c.Timezone
+		cfg.LogLevel = c.LogLevel
+		cfg.LogFile = c.LogFile
 		cfg.Meta = inst.Meta
 		cfg.CollationCompatible = c.CollationCompatible
 		cfg.Experimental = c.Experimental
@@ -91,6 +93,11 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 			cfg.ExprFilter[j] = c.ExprFilter[name]
 		}
 
+		cfg.DDLHook = make([]*DDLHook, len(inst.DDLHooks))
+		for j, name := range inst.DDLHooks {
+			cfg.DDLHook[j] = c.DDLHook[name]
+		}
+
 		cfg.BAList = c.BAList[inst.BAListName]
 
 		cfg.MydumperConfig = *inst.Mydumper
@@ -320,6 +327,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.HeartbeatUpdateInterval = stCfg0.HeartbeatUpdateInterval
 	c.HeartbeatReportInterval = stCfg0.HeartbeatReportInterval
 	c.Timezone = stCfg0.Timezone
+	c.LogLevel = stCfg0.LogLevel
+	c.LogFile = stCfg0.LogFile
 	c.CaseSensitive = stCfg0.CaseSensitive
 	c.TargetDB = &stCfg0.To // just ref
 	c.OnlineDDL = stCfg0.OnlineDDL
@@ -335,6 +344,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Loaders = make(map[string]*LoaderConfig)
 	c.Syncers = make(map[string]*SyncerConfig)
 	c.ExprFilter = make(map[string]*ExpressionFilter)
+	c.DDLHook = make(map[string]*DDLHook)
 	c.Experimental = stCfg0.Experimental
 	c.Validators = make(map[string]*ValidatorConfig)
 
@@ -404,6 +414,12 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 			c.ExprFilter[efName] = f
 		}
 
+		ddlHookNames := make([]string, 0, len(stCfg.DDLHook))
+		for _, h := range stCfg.DDLHook {
+			ddlHookNames = append(ddlHookNames, h.Name)
+			c.DDLHook[h.Name] = h
+		}
+
 		validateName, validateIdx = getGenerateName(stCfg.ValidatorCfg, validateIdx, "validator", validatorMap)
 		c.Validators[validateName] = &stCfg.ValidatorCfg
 
@@ -425,6 +441,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 			LoaderConfigName:              loadName,
 			SyncerConfigName:              syncName,
 			ExpressionFilters:             exprFilterNames,
+			DDLHooks:                      ddlHookNames,
 			ContinuousValidatorConfigName: validateName,
 		})
 	}
@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -253,6 +254,32 @@ mysql-instances:
 	require.ErrorContains(t, err, "The configurations as following [column-mapping-rule-2 expr-1 filter-rule-2 route-rule-2] are set in global configuration")
 }
 
+func TestTaskLogConfig(t *testing.T) {
+	t.Parallel()
+
+	taskConfig := NewTaskConfig()
+	require.NoError(t, taskConfig.Decode(correctTaskConfig))
+	require.Empty(t, taskConfig.LogLevel)
+	require.Empty(t, taskConfig.LogFile)
+
+	taskConfig = NewTaskConfig()
+	require.NoError(t, taskConfig.Decode(correctTaskConfig+"log-level: debug\nlog-file: logs/test.log\n"))
+	require.Equal(t, "debug", taskConfig.LogLevel)
+	require.Equal(t, "logs/test.log", taskConfig.LogFile)
+	subTaskConfigs, err := TaskConfigToSubTaskConfigs(taskConfig, map[string]dbconfig.DBConfig{
+		"mysql-replica-01": {}, "mysql-replica-02": {},
+	})
+	require.NoError(t, err)
+	for _, subTaskConfig := range subTaskConfigs {
+		require.Equal(t, "debug", subTaskConfig.LogLevel)
+		require.Equal(t, "logs/test.log", subTaskConfig.LogFile)
+	}
+
+	taskConfig = NewTaskConfig()
+	err = taskConfig.Decode(correctTaskConfig + "log-level: verbose\n")
+	require.True(t, terror.ErrConfigInvalidTaskLog.Equal(err))
+}
+
 func TestName(t *testing.T) {
 	t.Parallel()
 
@@ -704,6 +731,7 @@ func TestGenAndFromSubTaskConfigs(t *testing.T) {
 				OnDuplicateLogical:  OnDuplicateReplace,
 				OnDuplicatePhysical: OnDuplicateNone,
 				ChecksumPhysical:    ChecksumRequired,
+				CheckpointStorage:   LoaderCheckpointRemote,
 			},
 			SyncerConfig: SyncerConfig{
 				WorkerCount:             32,
@@ -1052,6 +1080,75 @@ func TestExclusiveAndWrongExprFilterFields(t *testing.T) {
 	cfg.MySQLInstances[0].ExpressionFilters[length-1] = "wrong"
 	err = cfg.adjust()
 	require.True(t, terror.ErrConfigExprFilterWrongGrammar.Equal(err))
+
+	delete(cfg.ExprFilter, "wrong")
+	cfg.ExprFilter["ignore-columns"] = &ExpressionFilter{
+		Schema:                      "db",
+		Table:                       "tbl",
+		IgnoreUpdateWhenOnlyColumns: []string{"updated_at", "version"},
+	}
+	cfg.MySQLInstances[0].ExpressionFilters[length-1] = "ignore-columns"
+	require.NoError(t, cfg.adjust())
+
+	cfg.ExprFilter["ignore-columns"].UpdateNewValueExpr = "a > 1"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigExprFilterManyExpr.Equal(err))
+
+	cfg.ExprFilter["ignore-columns"].UpdateNewValueExpr = ""
+	cfg.ExprFilter["ignore-columns"].IgnoreUpdateWhenOnlyColumns = []string{"updated_at", ""}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigExprFilterEmptyName.Equal(err))
+}
+
+func TestDDLHookConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = "all"
+	cfg.TargetDB = &dbconfig.DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+	cfg.DDLHook["analyze"] = &DDLHook{
+		SchemaPattern: "db*",
+		TablePattern:  "tbl",
+		DDLTypes:      []bf.EventType{"ALTER TABLE", bf.CreateIndex},
+		AfterSQL:      []string{"ANALYZE TABLE ${schema}.${table}"},
+	}
+	err := cfg.adjust()
+	require.True(t, terror.ErrConfigGlobalConfigsUnused.Equal(err))
+
+	cfg.MySQLInstances[0].DDLHooks = []string{"analyze", "not-exist"}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigDDLHookNotFound.Equal(err))
+
+	cfg.MySQLInstances[0].DDLHooks = []string{"analyze"}
+	require.NoError(t, cfg.adjust())
+	hook := cfg.DDLHook["analyze"]
+	require.Equal(t, "analyze", hook.Name)
+	require.Equal(t, DDLHookOnErrorPause, hook.OnError)
+	require.Equal(t, []bf.EventType{bf.AlterTable, bf.CreateIndex}, hook.DDLTypes)
+	require.True(t, hook.MatchDDLType(bf.AlterTable))
+	require.False(t, hook.MatchDDLType(bf.DropTable))
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Equal(t, []*DDLHook{hook}, stCfgs[0].DDLHook)
+
+	hook.OnError = "ignore"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidDDLHook.Equal(err))
+	hook.OnError = "WARN"
+	hook.DDLTypes = []bf.EventType{bf.InsertEvent}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidDDLHook.Equal(err))
+	hook.DDLTypes = nil
+	hook.AfterSQL = nil
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidDDLHook.Equal(err))
+	hook.BeforeSQL = []string{"SELECT 1"}
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, DDLHookOnErrorWarn, hook.OnError)
+	require.True(t, hook.MatchDDLType(bf.DropTable))
 }
 
 func TestTaskConfigForDowngrade(t *testing.T) {
@@ -1161,6 +1258,7 @@ func TestLoadConfigAdjust(t *testing.T) {
 		OnDuplicateLogical:  "replace",
 		OnDuplicatePhysical: "none",
 		ChecksumPhysical:    "required",
+		CheckpointStorage:   "remote",
 	}, cfg)
 
 	// test deprecated OnDuplicate will write to OnDuplicateLogical
@@ -1173,4 +1271,373 @@ func TestLoadConfigAdjust(t *testing.T) {
 	cfg.OnDuplicatePhysical = "wrong"
 	err := cfg.adjust()
 	require.True(t, terror.ErrConfigInvalidPhysicalDuplicateResolution.Equal(err))
+	cfg.OnDuplicatePhysical = OnDuplicateNone
+
+	// test checkpoint options
+	cfg.CheckpointStorage = "wrong"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpointStorage.Equal(err))
+
+	cfg.CheckpointStorage = "LOCAL"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, LoaderCheckpointLocal, cfg.CheckpointStorage)
+
+	cfg.CheckpointTable = "cp"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+
+	cfg.CheckpointStorage = LoaderCheckpointRemote
+	cfg.CheckpointSchema = "meta"
+	require.NoError(t, cfg.adjust())
+
+	cfg.CheckpointTable = ""
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+
+	// test checksum options
+	cfg = &LoaderConfig{ChecksumLogical: true}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderChecksum.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultChecksumChunkSizeLogical, cfg.ChecksumChunkSizeLogical)
+
+	require.Equal(t, defaultReadPoolSizeLogical, cfg.ReadPoolSizeLogical)
+
+	cfg.ReadPoolSizeLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderChecksum.Equal(err))
+	cfg.ReadPoolSizeLogical = 4
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, 4, cfg.ReadPoolSizeLogical)
+
+	cfg.ChecksumChunkSizeLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderChecksum.Equal(err))
+
+	// test throttle options
+	cfg = &LoaderConfig{RateLimitLogical: 100}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderThrottle.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg = &LoaderConfig{
+		ImportMode:             LoadModeLoader,
+		ThrottleWindowsLogical: []LoaderThrottleWindow{{Start: "09:00", End: "17:00", RateMultiplier: 0.2}},
+	}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderThrottle.Equal(err))
+	require.Contains(t, err.Error(), "must be used with rate-limit-logical")
+
+	cfg.RateLimitLogical = 100
+	require.NoError(t, cfg.adjust())
+
+	for _, w := range []LoaderThrottleWindow{
+		{Start: "9am", End: "17:00", RateMultiplier: 0.2},
+		{Start: "09:00", End: "09:00", RateMultiplier: 0.2},
+		{Start: "09:00", End: "17:00", RateMultiplier: 0},
+		{Weekdays: "mon-fry", Start: "09:00", End: "17:00", RateMultiplier: 0.2},
+	} {
+		cfg.ThrottleWindowsLogical = []LoaderThrottleWindow{w}
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidLoaderThrottle.Equal(err), w)
+	}
+
+	// test backpressure options
+	cfg = &LoaderConfig{BackpressureLogical: LoaderBackpressure{StatusVariable: "Threads_running", ThrottledRate: 10}}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderBackpressure.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultLoaderBackpressurePollInterval, cfg.BackpressureLogical.PollInterval.Duration)
+	require.Equal(t, "SHOW GLOBAL STATUS LIKE 'Threads_running'", cfg.BackpressureLogical.SignalQuery())
+
+	for _, b := range []LoaderBackpressure{
+		{StatusVariable: "Threads_running", Query: "SELECT 1", ThrottledRate: 10},
+		{StatusVariable: "Threads_running' OR '1", ThrottledRate: 10},
+		{Query: "SELECT 1", ThrottledRate: 0},
+		{Query: "SELECT 1", ThrottledRate: 10, PollInterval: Duration{Duration: -time.Second}},
+	} {
+		cfg.BackpressureLogical = b
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidLoaderBackpressure.Equal(err), b)
+	}
+
+	// test load order options
+	cfg = &LoaderConfig{LoadOrderLogical: [][]string{{"db.parent"}}}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderLoadOrder.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.LoadOrderLogical = [][]string{{"db.parent"}, {}}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderLoadOrder.Equal(err))
+	require.Contains(t, err.Error(), "tier 2 of load-order-logical is empty")
+
+	cfg.LoadOrderLogical = [][]string{{"db.parent["}}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderLoadOrder.Equal(err))
+
+	// test dedup options
+	cfg = &LoaderConfig{DedupLogical: true}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderDedup.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultDedupFalsePositiveRateLogical, cfg.DedupFalsePositiveRateLogical)
+	require.Equal(t, defaultDedupMaxKeysLogical, cfg.DedupMaxKeysLogical)
+
+	cfg.DedupFalsePositiveRateLogical = 1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderDedup.Equal(err))
+	cfg.DedupFalsePositiveRateLogical = 0.001
+	cfg.DedupMaxKeysLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderDedup.Equal(err))
+
+	// test slow query plan options
+	cfg = &LoaderConfig{SlowQueryPlanLogical: LoaderSlowQueryPlanExplain}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderSlowQueryPlan.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultSlowQueryPlanIntervalLogical, cfg.SlowQueryPlanIntervalLogical.Duration)
+
+	cfg.SlowQueryPlanIntervalLogical.Duration = -time.Second
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderSlowQueryPlan.Equal(err))
+
+	cfg.SlowQueryPlanLogical = "trace"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderSlowQueryPlan.Equal(err))
+	require.Contains(t, err.Error(), "must be one of")
+
+	// test adaptive retry options
+	cfg = &LoaderConfig{AdaptiveRetryLogical: true}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderAdaptiveRetry.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultAdaptiveRetryMinCountLogical, cfg.AdaptiveRetryMinCountLogical)
+	require.Equal(t, defaultAdaptiveRetryMaxCountLogical, cfg.AdaptiveRetryMaxCountLogical)
+	require.Equal(t, defaultAdaptiveRetryMinBackoffLogical, cfg.AdaptiveRetryMinBackoffLogical.Duration)
+	require.Equal(t, defaultAdaptiveRetryMaxBackoffLogical, cfg.AdaptiveRetryMaxBackoffLogical.Duration)
+
+	cfg.AdaptiveRetryMinCountLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderAdaptiveRetry.Equal(err))
+
+	cfg.AdaptiveRetryMinCountLogical = 30
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderAdaptiveRetry.Equal(err))
+	require.Contains(t, err.Error(), "adaptive-retry-min-count-logical must not be greater than")
+
+	cfg.AdaptiveRetryMinCountLogical = 3
+	cfg.AdaptiveRetryMinBackoffLogical.Duration = time.Minute
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderAdaptiveRetry.Equal(err))
+	require.Contains(t, err.Error(), "adaptive-retry-min-backoff-logical must not be greater than")
+
+	// test write conflict retry options
+	cfg = &LoaderConfig{WriteConflictRetryCountLogical: 5}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderWriteConflictRetry.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, 5, cfg.WriteConflictRetryCountLogical)
+
+	cfg.WriteConflictRetryCountLogical = 0
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, defaultWriteConflictRetryCountLogical, cfg.WriteConflictRetryCountLogical)
+
+	cfg.WriteConflictRetryCountLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderWriteConflictRetry.Equal(err))
+	require.Contains(t, err.Error(), "must not be negative")
+
+	// test parse pool options
+	cfg = &LoaderConfig{ParsePoolSizeLogical: 4}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderParsePool.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.ParsePoolSizeLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderParsePool.Equal(err))
+	require.Contains(t, err.Error(), "must not be negative")
+
+	// test work stealing options
+	cfg = &LoaderConfig{WorkStealingLogical: true}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderWorkStealing.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	// test cursor options
+	cfg = &LoaderConfig{CursorBatchSizeLogical: 1000}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCursor.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.CursorBatchSizeLogical = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCursor.Equal(err))
+	require.Contains(t, err.Error(), "must not be negative")
+
+	// test sql mode options
+	cfg = &LoaderConfig{SQLModeLogical: LoaderSQLModeAuto}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderSQLMode.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.SQLModeLogical = "AUTO-RELAX"
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, LoaderSQLModeAutoRelax, cfg.SQLModeLogical)
+
+	cfg.SQLModeLogical = "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION"
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION", cfg.SQLModeLogical)
+
+	cfg.SQLModeLogical = "NOT_A_MODE"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderSQLMode.Equal(err))
+
+	// test checkpoint batch options
+	cfg = &LoaderConfig{CheckpointBatchIntervalLogical: Duration{Duration: time.Second}}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader and checkpoint-storage is remote")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.CheckpointStorage = LoaderCheckpointLocal
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+
+	cfg.CheckpointStorage = LoaderCheckpointRemote
+	cfg.OnDuplicateLogical = OnDuplicateError
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+	require.Contains(t, err.Error(), "unless dedup-logical is enabled")
+
+	cfg.DedupLogical = true
+	require.NoError(t, cfg.adjust())
+
+	cfg.CheckpointBatchIntervalLogical.Duration = -time.Second
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderCheckpoint.Equal(err))
+	require.Contains(t, err.Error(), "must not be negative")
+
+	// test idempotency options
+	cfg = &LoaderConfig{IdempotencyKeyLogical: LoaderIdempotencyKeyPosition}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderIdempotency.Equal(err))
+	require.Contains(t, err.Error(), "only supported when import-mode is loader")
+
+	cfg.ImportMode = LoadModeLoader
+	require.NoError(t, cfg.adjust())
+
+	cfg.IdempotencyKeyLogical = "CONTENT"
+	cfg.IdempotencyTableLogical = "keys"
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, LoaderIdempotencyKeyContent, cfg.IdempotencyKeyLogical)
+
+	cfg.IdempotencyKeyLogical = "unknown"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderIdempotency.Equal(err))
+
+	cfg.IdempotencyKeyLogical = LoaderIdempotencyKeyNone
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderIdempotency.Equal(err))
+	require.Contains(t, err.Error(), "must be used with idempotency-key-logical")
+
+	// test clock skew options
+	cfg = &LoaderConfig{}
+	require.NoError(t, cfg.adjust())
+	require.Zero(t, cfg.ClockSkewThreshold.Duration)
+
+	cfg.ClockSkewCheck = "WARN"
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, LoaderClockSkewCheckWarn, cfg.ClockSkewCheck)
+	require.Equal(t, defaultClockSkewThreshold, cfg.ClockSkewThreshold.Duration)
+
+	cfg.ClockSkewCheck = LoaderClockSkewCheckFail
+	cfg.ClockSkewThreshold.Duration = -time.Second
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderClockSkew.Equal(err))
+	require.Contains(t, err.Error(), "must not be negative")
+
+	cfg.ClockSkewCheck = "error"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoaderClockSkew.Equal(err))
+	require.Contains(t, err.Error(), "must be one of")
+}
+
+func TestLoaderThrottleWindowContains(t *testing.T) {
+	t.Parallel()
+
+	// 2023-01-02 is Monday.
+	at := func(day int, clock string) time.Time {
+		c, err := time.Parse("15:04", clock)
+		require.NoError(t, err)
+		return time.Date(2023, 1, day, c.Hour(), c.Minute(), 0, 0, time.Local)
+	}
+
+	w := LoaderThrottleWindow{Weekdays: "Mon-Fri", Start: "09:00", End: "17:00", RateMultiplier: 0.2}
+	require.NoError(t, w.adjust())
+	require.Equal(t, "mon-fri", w.Weekdays)
+	require.True(t, w.Contains(at(2, "09:00")))
+	require.True(t, w.Contains(at(6, "16:59")))
+	require.False(t, w.Contains(at(2, "17:00")))
+	require.False(t, w.Contains(at(2, "08:59")))
+	require.False(t, w.Contains(at(7, "12:00")))
+
+	// the window crosses midnight and the week.
+	w = LoaderThrottleWindow{Weekdays: "sat,sun", Start: "22:00", End: "06:00", RateMultiplier: 0.5}
+	require.NoError(t, w.adjust())
+	require.True(t, w.Contains(at(7, "23:00")))
+	require.True(t, w.Contains(at(8, "05:00")))
+	require.True(t, w.Contains(at(9, "05:59")))
+	require.False(t, w.Contains(at(9, "22:00")))
+	require.False(t, w.Contains(at(6, "23:00")))
+	require.False(t, w.Contains(at(7, "05:00")))
+
+	// empty weekdays means every day.
+	w = LoaderThrottleWindow{Start: "00:00", End: "01:00", RateMultiplier: 2}
+	require.NoError(t, w.adjust())
+	for day := 1; day <= 7; day++ {
+		require.True(t, w.Contains(at(day, "00:30")))
+	}
 }
@@ -0,0 +1,248 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// UnknownYAMLField is a field which is not known by the config struct, found
+// when decoding a config strictly.
+type UnknownYAMLField struct {
+	// Line and Column are 1-based, Column is 0 if the field is not found in the line.
+	Line   int
+	Column int
+	Field  string
+	// Type is the name of the config struct, e.g. config.LoaderConfig.
+	Type string
+	// Suggestion is the nearest known field of the config struct, it's empty if no field is near enough.
+	Suggestion string
+}
+
+func (f UnknownYAMLField) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "line %d", f.Line)
+	if f.Column > 0 {
+		fmt.Fprintf(&b, ", column %d", f.Column)
+	}
+	fmt.Fprintf(&b, ": unknown field %q in %s", f.Field, f.Type)
+	if f.Suggestion != "" {
+		fmt.Fprintf(&b, ", did you mean %q?", f.Suggestion)
+	}
+	return b.String()
+}
+
+// unknownFieldRegexp matches the error of yaml.UnmarshalStrict on an unknown field.
+var unknownFieldRegexp = regexp.MustCompile(`^line (\d+): field (\S+) not found in type (\S+)`)
+
+// FindUnknownYAMLFields returns the unknown fields reported by the error of decoding
+// content strictly, e.g. by TaskConfig.Decode or ParseYaml.
+func FindUnknownYAMLFields(content []byte, err error) []UnknownYAMLField {
+	typeErr, ok := errors.Cause(err).(*yaml.TypeError)
+	if !ok {
+		return nil
+	}
+	lines := strings.Split(string(content), "\n")
+	fields := make([]UnknownYAMLField, 0, len(typeErr.Errors))
+	for _, msg := range typeErr.Errors {
+		if f, ok := parseUnknownField(lines, msg); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func parseUnknownField(lines []string, msg string) (UnknownYAMLField, bool) {
+	matches := unknownFieldRegexp.FindStringSubmatch(msg)
+	if matches == nil {
+		return UnknownYAMLField{}, false
+	}
+	line, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return UnknownYAMLField{}, false
+	}
+	typeName := configTypeName(matches[3])
+	f := UnknownYAMLField{
+		Line:       line,
+		Field:      matches[2],
+		Type:       typeName,
+		Suggestion: nearestField(matches[2], knownYAMLFields(typeName)),
+	}
+	if line >= 1 && line <= len(lines) {
+		if idx := strings.Index(lines[line-1], f.Field); idx >= 0 {
+			f.Column = utf8.RuneCountInString(lines[line-1][:idx]) + 1
+		}
+	}
+	return f, true
+}
+
+// hintYAMLError adds the column and the nearest known field to the unknown fields
+// reported by the error of yaml.UnmarshalStrict, other errors are returned as is.
+func hintYAMLError(content []byte, err error) error {
+	// the error of a config implementing yaml.Unmarshaler is wrapped by it.
+	typeErr, ok := errors.Cause(err).(*yaml.TypeError)
+	if !ok {
+		return err
+	}
+	lines := strings.Split(string(content), "\n")
+	hinted := &yaml.TypeError{Errors: make([]string, 0, len(typeErr.Errors))}
+	for _, msg := range typeErr.Errors {
+		f, ok := parseUnknownField(lines, msg)
+		if !ok || (f.Column == 0 && f.Suggestion == "") {
+			hinted.Errors = append(hinted.Errors, msg)
+			continue
+		}
+		// keep the original message, the hints are appended to it.
+		hints := make([]string, 0, 2)
+		if f.Column > 0 {
+			hints = append(hints, fmt.Sprintf("column %d", f.Column))
+		}
+		if f.Suggestion != "" {
+			hints = append(hints, fmt.Sprintf("did you mean %q?", f.Suggestion))
+		}
+		hinted.Errors = append(hinted.Errors, fmt.Sprintf("%s (%s)", msg, strings.Join(hints, ", ")))
+	}
+	return hinted
+}
+
+// yamlFieldRegistry maps the name of a config struct, as reported by the yaml
+// decoder, to the yaml keys of its fields. It's derived from the structs
+// reachable from TaskConfig and SourceConfig.
+var (
+	yamlFieldRegistryOnce sync.Once
+	yamlFieldRegistry     map[string][]string
+)
+
+// knownYAMLFields returns the yaml keys of the fields of the config struct.
+func knownYAMLFields(typeName string) []string {
+	yamlFieldRegistryOnce.Do(func() {
+		yamlFieldRegistry = make(map[string][]string)
+		registerYAMLFields(reflect.TypeOf(TaskConfig{}))
+		registerYAMLFields(reflect.TypeOf(SourceConfig{}))
+	})
+	return yamlFieldRegistry[typeName]
+}
+
+// configTypeName returns the name of the config struct of a type reported by
+// the yaml decoder. The configs implementing yaml.Unmarshaler are decoded by
+// their raw aliases, e.g. config.rawLoaderConfig is config.LoaderConfig.
+func configTypeName(typeName string) string {
+	if pkg, name, ok := strings.Cut(typeName, ".raw"); ok {
+		return pkg + "." + name
+	}
+	return typeName
+}
+
+func registerYAMLFields(t reflect.Type) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	name := t.String()
+	if _, ok := yamlFieldRegistry[name]; ok {
+		return
+	}
+	// register the struct before its fields to stop at recursive types.
+	yamlFieldRegistry[name] = nil
+	yamlFieldRegistry[name] = collectYAMLFields(t)
+}
+
+// collectYAMLFields returns the yaml keys of the fields of the struct by the
+// rules of yaml.v2, and registers the structs of the fields.
+func collectYAMLFields(t reflect.Type) []string {
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		key, opts, _ := strings.Cut(tag, ",")
+		if strings.Contains(opts, "inline") {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, collectYAMLFields(ft)...)
+				continue
+			}
+		}
+		if key == "" {
+			key = strings.ToLower(f.Name)
+		}
+		fields = append(fields, key)
+		registerYAMLFields(f.Type)
+	}
+	return fields
+}
+
+// nearestField returns the candidate nearest to field by edit distance, or
+// returns "" if none of them is near enough to be a typo.
+func nearestField(field string, candidates []string) string {
+	var (
+		nearest string
+		minDist = -1
+	)
+	for _, c := range candidates {
+		if d := editDistance(field, c); minDist < 0 || d < minDist {
+			nearest, minDist = c, d
+		}
+	}
+	if minDist < 0 || (minDist > 2 && minDist*3 > len(field)) {
+		return ""
+	}
+	return nearest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if prev[j]+1 < cur[j] {
+				cur[j] = prev[j] + 1
+			}
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
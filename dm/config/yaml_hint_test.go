@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownYAMLFields(t *testing.T) {
+	t.Parallel()
+
+	fields := knownYAMLFields("config.TaskConfig")
+	require.Contains(t, fields, "name")
+	require.Contains(t, fields, "mysql-instances")
+	require.Contains(t, fields, "loaders")
+	require.Contains(t, knownYAMLFields("config.LoaderConfig"), "pool-size")
+	// the raw aliases are resolved to the config structs.
+	require.Equal(t, "config.LoaderConfig", configTypeName("config.rawLoaderConfig"))
+	require.Equal(t, "config.TaskConfig", configTypeName("config.TaskConfig"))
+	require.Contains(t, knownYAMLFields("config.MySQLInstance"), "source-id")
+	require.Contains(t, knownYAMLFields("config.SourceConfig"), "enable-gtid")
+	require.Empty(t, knownYAMLFields("config.Unknown"))
+}
+
+func TestFindUnknownYAMLFields(t *testing.T) {
+	t.Parallel()
+
+	content := `---
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    block-allow-list: "instance"
+loaders:
+  global:
+    pool-sise: 16
+    xyz: 1
+`
+	err := NewTaskConfig().Decode(content)
+	require.ErrorContains(t, err, `line 14: field pool-sise not found in type config.rawLoaderConfig (column 5, did you mean "pool-size"?)`)
+	require.ErrorContains(t, err, "line 15: field xyz not found in type config.rawLoaderConfig (column 5)")
+
+	fields := FindUnknownYAMLFields([]byte(content), err)
+	require.Equal(t, []UnknownYAMLField{
+		{Line: 14, Column: 5, Field: "pool-sise", Type: "config.LoaderConfig", Suggestion: "pool-size"},
+		{Line: 15, Column: 5, Field: "xyz", Type: "config.LoaderConfig"},
+	}, fields)
+	require.Equal(t, `line 14, column 5: unknown field "pool-sise" in config.LoaderConfig, did you mean "pool-size"?`, fields[0].String())
+
+	_, err = ParseYaml("source-ids: mysql-replica-01\n")
+	fields = FindUnknownYAMLFields([]byte("source-ids: mysql-replica-01\n"), err)
+	require.Len(t, fields, 1)
+	require.Equal(t, "source-id", fields[0].Suggestion)
+
+	// syntax errors are not unknown fields.
+	err = NewTaskConfig().Decode("name: [")
+	require.Error(t, err)
+	require.Empty(t, FindUnknownYAMLFields([]byte("name: ["), err))
+}
+
+func TestNearestField(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"pool-size", "dir", "import-mode"}
+	require.Equal(t, "pool-size", nearestField("pool_size", candidates))
+	require.Equal(t, "import-mode", nearestField("importmode", candidates))
+	require.Equal(t, "dir", nearestField("dri", candidates))
+	require.Equal(t, "", nearestField("on-duplicate", candidates))
+	require.Equal(t, "", nearestField("dir", nil))
+
+	require.Equal(t, 0, editDistance("abc", "abc"))
+	require.Equal(t, 3, editDistance("", "abc"))
+	require.Equal(t, 2, editDistance("kitten", "sitten1"))
+}
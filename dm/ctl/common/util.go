@@ -183,6 +183,12 @@ func InitClient(addr string, securityCfg security.Security) error {
 	return GlobalCtlClient.updateMasterClient()
 }
 
+// GetWorkerJSON gets the JSON result from the HTTP API of DM-worker at addr,
+// with the same TLS config as connecting to DM-master.
+func GetWorkerJSON(addr, path string, v interface{}) error {
+	return errors.Trace(GlobalCtlClient.tls.WithHost(addr).GetJSON(path, v))
+}
+
 // GlobalConfig returns global dmctl config.
 func GlobalConfig() *Config {
 	return globalConfig
@@ -0,0 +1,170 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/spf13/cobra"
+)
+
+const completionCmdName = "completion"
+
+// taskNameCmds are the commands whose first argument is a task name.
+var taskNameCmds = []string{"stop-task", "pause-task", "resume-task", "query-status", "show-ddl-locks"}
+
+// sourceIDCmds are the commands whose first argument is a source ID.
+var sourceIDCmds = []string{"transfer-source"}
+
+func newCompletionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   completionCmdName + " <bash|zsh>",
+		Short: "Generates the completion script for bash or zsh",
+		Long: `Generates the completion script for bash or zsh, the task names and source IDs are
+completed by fetching them from the dm-master of --master-addr or DM_MASTER_ADDR.
+
+To load completions in the current bash session:
+  source <(dmctl completion bash)
+
+To load completions in the current zsh session:
+  source <(dmctl completion zsh); compdef _dmctl dmctl`,
+		ValidArgs: []string{"bash", "zsh"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			switch args[0] {
+			case "bash":
+				return cmd.Root().GenBashCompletionV2(os.Stdout, true)
+			case "zsh":
+				return cmd.Root().GenZshCompletion(os.Stdout)
+			default:
+				return errors.Errorf("shell %s is not supported, only bash and zsh are supported", args[0])
+			}
+		},
+	}
+}
+
+// registerCompletions registers the dynamic completions of task names and source IDs.
+func registerCompletions(rootCmd *cobra.Command) {
+	for _, c := range rootCmd.Commands() {
+		for _, name := range taskNameCmds {
+			if c.Name() == name {
+				c.ValidArgsFunction = completeTaskNames
+			}
+		}
+		for _, name := range sourceIDCmds {
+			if c.Name() == name {
+				c.ValidArgsFunction = completeSourceIDs
+			}
+		}
+	}
+	_ = rootCmd.RegisterFlagCompletionFunc("source", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeSourceIDs(cmd, nil, toComplete)
+	})
+}
+
+// isOfflineCmd returns whether the command doesn't interact with the dm-master,
+// so that the config of dmctl is not required.
+func isOfflineCmd(cmd *cobra.Command) bool {
+	switch cmd.Name() {
+	case common.DecryptCmdName, common.EncryptCmdName, validateCmdName, completionCmdName,
+		cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	return false
+}
+
+// initForCompletion initializes dmctl by the flags parsed for completion, the
+// persistent pre-run is skipped by the completion requests.
+func initForCompletion(cmd *cobra.Command) error {
+	cfg := common.NewConfig(cmd.Flags())
+	if err := cfg.Adjust(); err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	return Init(cfg)
+}
+
+// completeTaskNames completes the names of the tasks fetched from the dm-master.
+func completeTaskNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if err := initForCompletion(cmd); err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.QueryStatusListResponse{}
+	if err := common.SendRequest(ctx, "QueryStatus", &pb.QueryStatusListRequest{}, &resp); err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0)
+	for _, source := range resp.Sources {
+		for _, subTask := range source.SubTaskStatus {
+			names = append(names, subTask.Name)
+		}
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeSourceIDs completes the IDs of the sources fetched from the dm-master.
+func completeSourceIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if err := initForCompletion(cmd); err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.OperateSourceResponse{}
+	err := common.SendRequest(ctx, "OperateSource", &pb.OperateSourceRequest{Op: pb.SourceOp_ShowSource}, &resp)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ids := make([]string, 0, len(resp.Sources))
+	for _, source := range resp.Sources {
+		ids = append(ids, source.Source)
+	}
+	return filterCompletions(ids, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filterCompletions returns the sorted and deduplicated candidates with the prefix.
+func filterCompletions(candidates []string, prefix string) []string {
+	sort.Strings(candidates)
+	ret := make([]string, 0, len(candidates))
+	for i, c := range candidates {
+		if c == "" || !strings.HasPrefix(c, prefix) || (i > 0 && c == candidates[i-1]) {
+			continue
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
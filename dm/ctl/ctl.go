@@ -84,8 +84,13 @@ func NewRootCmd() *cobra.Command {
 		master.NewSourceTableSchemaCmd(),
 		master.NewConfigCmd(),
 		master.NewValidationCmd(),
+		master.NewRelayCmd(),
+		master.NewVerifyTaskCmd(),
+		master.NewImportReplicationCmd(),
 		newDecryptCmd(),
 		newEncryptCmd(),
+		newValidateCmd(),
+		newCompletionCmd(),
 	)
 	// copied from (*cobra.Command).InitDefaultHelpCmd
 	helpCmd := &cobra.Command{
@@ -213,7 +218,7 @@ func MainStart(args []string) {
 			os.Exit(0)
 		}
 
-		if cmd.Name() == common.DecryptCmdName || cmd.Name() == common.EncryptCmdName {
+		if isOfflineCmd(cmd) {
 			return nil
 		}
 
@@ -231,6 +236,7 @@ func MainStart(args []string) {
 		return Init(cfg)
 	}
 	common.DefineConfigFlagSet(rootCmd.PersistentFlags())
+	registerCompletions(rootCmd)
 	rootCmd.SetArgs(args)
 	if c, err := rootCmd.ExecuteC(); err != nil {
 		rootCmd.Println("Error:", err)
@@ -0,0 +1,619 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+// the steps of import-replication, in the order they are run.
+const (
+	importStepStopSQLThread    = "stop-sql-thread"
+	importStepReadPosition     = "read-position"
+	importStepVerifyDownstream = "verify-downstream"
+	importStepGenerateTask     = "generate-task"
+	importStepStartTask        = "start-task"
+	importStepConfirmRunning   = "confirm-running"
+	importStepStopReplica      = "stop-replica"
+)
+
+const importConfirmInterval = 2 * time.Second
+
+// replicaStatements are the statements to show the status of the replica and
+// to stop its replication.
+type replicaStatements struct {
+	showStatus    string
+	stopSQLThread string
+	stop          string
+}
+
+var (
+	// replicaStmts are used by MySQL 8.0.22 and later.
+	replicaStmts = replicaStatements{
+		showStatus:    "SHOW REPLICA STATUS",
+		stopSQLThread: "STOP REPLICA SQL_THREAD",
+		stop:          "STOP REPLICA",
+	}
+	// legacyReplicaStmts are used by the versions before MySQL 8.0.22.
+	legacyReplicaStmts = replicaStatements{
+		showStatus:    "SHOW SLAVE STATUS",
+		stopSQLThread: "STOP SLAVE SQL_THREAD",
+		stop:          "STOP SLAVE",
+	}
+)
+
+// replicaPosition is the position in the binlog of the primary that the
+// replica has executed to.
+type replicaPosition struct {
+	SourceHost string `json:"source-host"`
+	SourcePort int    `json:"source-port"`
+	BinlogName string `json:"binlog-name"`
+	BinlogPos  uint32 `json:"binlog-pos"`
+	GTIDSet    string `json:"gtid-set,omitempty"`
+}
+
+// importReplicationState is the progress of import-replication, it's saved in
+// the state file after each step, so that a failed import can be resumed by
+// running the command again.
+type importReplicationState struct {
+	Task     string           `json:"task"`
+	Source   string           `json:"source"`
+	Position *replicaPosition `json:"position,omitempty"`
+	TaskFile string           `json:"task-file,omitempty"`
+	Done     []string         `json:"done"`
+}
+
+// NewImportReplicationCmd creates an ImportReplication command.
+func NewImportReplicationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-replication <-s source> <--task-template file> [--replica host:port] [--stop-replica] [--dry-run]",
+		Short: "Takes over the replication of an existing MySQL replica with an incremental task",
+		Long: `Takes over the replication of an existing MySQL replica with an incremental task.
+
+The steps are:
+  1. stop-sql-thread:   stop the SQL thread of the replica, so its position doesn't move.
+  2. read-position:     read the executed position and GTID set from SHOW REPLICA STATUS.
+  3. verify-downstream: check whether the replica is the target database of the task,
+                        a warning is printed if it's not, since the data of the target
+                        database must match the replica at the position.
+  4. generate-task:     generate an incremental task from the template, with the meta of
+                        the source seeded from the position.
+  5. start-task:        start the generated task.
+  6. confirm-running:   wait until the subtask of the source is running in the sync unit.
+  7. stop-replica:      stop the replication of the replica, only if --stop-replica is set.
+
+The progress is saved in the state file after each step, a failed import is resumed
+from the failed step by running the command again with the same state file. With
+--dry-run, the replica and the task are not changed, the position and the generated
+task are printed instead. The replica is connected with the user and password of the
+target database of the template if they are not specified.`,
+		RunE: importReplicationFunc,
+	}
+	cmd.Flags().String("task-template", "", "task config file used as the template of the generated task")
+	cmd.Flags().String("replica", "", "host:port of the replica, default to the target database of the template")
+	cmd.Flags().String("replica-user", "", "user to connect to the replica")
+	cmd.Flags().String("replica-password", "", "password to connect to the replica")
+	cmd.Flags().StringP("output", "o", "", "file to write the generated task to, default to <task-name>-import-replication.yaml")
+	cmd.Flags().String("state-file", "", "file to save the progress to, default to <task-name>-import-replication-state.json")
+	cmd.Flags().Bool("stop-replica", false, "stop the replication of the replica once the task is confirmed running")
+	cmd.Flags().Bool("dry-run", false, "print the position and the generated task without changing the replica and the task")
+	cmd.Flags().Duration("wait", time.Minute, "max time to wait for the task to be running")
+	return cmd
+}
+
+// importReplicationOptions are the options of import-replication.
+type importReplicationOptions struct {
+	source          string
+	replica         dbconfig.DBConfig
+	output          string
+	stateFile       string
+	stopReplica     bool
+	dryRun          bool
+	wait            time.Duration
+	task            *config.TaskConfig
+	enableGTID      bool
+	primaryHostPort string
+}
+
+func importReplicationFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) > 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	opts, err := parseImportReplicationOptions(cmd)
+	if err != nil {
+		return err
+	}
+	state, err := loadImportReplicationState(opts.stateFile, opts.task.Name, opts.source)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	replicaDB, err := conn.GetUpstreamDB(&opts.replica)
+	if err != nil {
+		common.PrintLinesf("can not connect to the replica %s:%d", opts.replica.Host, opts.replica.Port)
+		return err
+	}
+	defer replicaDB.Close()
+	stmts, status, err := showReplicaStatus(ctx, replicaDB.DB)
+	if err != nil {
+		return err
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{importStepStopSQLThread, func() error {
+			return execReplicaStatement(ctx, replicaDB.DB, stmts.stopSQLThread, opts.dryRun)
+		}},
+		{importStepReadPosition, func() error {
+			if !slices.Contains(state.Done, importStepStopSQLThread) {
+				common.PrintLinesf("the SQL thread of the replica is running, the position may still move")
+			} else if _, status, err = showReplicaStatus(ctx, replicaDB.DB); err != nil {
+				return err
+			}
+			state.Position, err = parseReplicaPosition(status)
+			if err != nil {
+				return err
+			}
+			common.PrettyPrintInterface(state.Position)
+			return nil
+		}},
+		{importStepVerifyDownstream, func() error {
+			return verifyImportDownstream(ctx, opts, state.Position, replicaDB.DB)
+		}},
+		{importStepGenerateTask, func() error {
+			if err := seedImportTask(opts.task, opts.source, state.Position, opts.enableGTID); err != nil {
+				return err
+			}
+			if opts.dryRun {
+				fmt.Println(opts.task.String())
+				return nil
+			}
+			if err := os.WriteFile(opts.output, []byte(opts.task.String()), 0o600); err != nil {
+				common.PrintLinesf("can not write task to file %s", opts.output)
+				return err
+			}
+			state.TaskFile = opts.output
+			common.PrintLinesf("write task to file %s succeed", opts.output)
+			return nil
+		}},
+		{importStepStartTask, func() error {
+			if opts.dryRun {
+				common.PrintLinesf("[dry-run] start task %s", opts.task.Name)
+				return nil
+			}
+			return startImportTask(ctx, state.TaskFile, opts.source)
+		}},
+		{importStepConfirmRunning, func() error {
+			if opts.dryRun {
+				return nil
+			}
+			return waitImportTaskRunning(ctx, opts.task.Name, opts.source, opts.wait)
+		}},
+		{importStepStopReplica, func() error {
+			if !opts.stopReplica {
+				common.PrintLinesf("the replication of the replica is kept, stop it with `%s` after checking the task", stmts.stop)
+				return nil
+			}
+			return execReplicaStatement(ctx, replicaDB.DB, stmts.stop, opts.dryRun)
+		}},
+	}
+	for _, step := range steps {
+		if slices.Contains(state.Done, step.name) {
+			common.PrintLinesf("step %s is done, skip it", step.name)
+			continue
+		}
+		common.PrintLinesf("run step %s", step.name)
+		if err := step.run(); err != nil {
+			common.PrintLinesf("step %s failed, run the command again to resume from it", step.name)
+			return err
+		}
+		if opts.dryRun {
+			continue
+		}
+		state.Done = append(state.Done, step.name)
+		if err := saveImportReplicationState(opts.stateFile, state); err != nil {
+			return err
+		}
+	}
+	if opts.dryRun {
+		common.PrintLinesf("[dry-run] import replication of source %s is checked", opts.source)
+	} else {
+		common.PrintLinesf("import replication of source %s succeed", opts.source)
+	}
+	return nil
+}
+
+// parseImportReplicationOptions parses the flags, and gets the config of the
+// source from DM-master.
+func parseImportReplicationOptions(cmd *cobra.Command) (*importReplicationOptions, error) {
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) != 1 {
+		common.PrintLinesf("must specify one source (`-s` / `--source`)")
+		return nil, errors.New("please check output to see error")
+	}
+	opts := &importReplicationOptions{source: sources[0]}
+
+	templateFile, err := cmd.Flags().GetString("task-template")
+	if err != nil {
+		return nil, err
+	}
+	if templateFile == "" {
+		common.PrintLinesf("must specify the task template (`--task-template`)")
+		return nil, errors.New("please check output to see error")
+	}
+	content, err := common.GetFileContent(templateFile)
+	if err != nil {
+		return nil, err
+	}
+	opts.task = config.NewTaskConfig()
+	if err = opts.task.RawDecode(string(content)); err != nil {
+		return nil, err
+	}
+	if opts.task.TargetDB == nil {
+		return nil, errors.New("target-database of the task template is not set")
+	}
+
+	replicaAddr, err := cmd.Flags().GetString("replica")
+	if err != nil {
+		return nil, err
+	}
+	opts.replica = dbconfig.DBConfig{
+		Host:     opts.task.TargetDB.Host,
+		Port:     opts.task.TargetDB.Port,
+		User:     opts.task.TargetDB.User,
+		Password: utils.DecryptOrPlaintext(opts.task.TargetDB.Password),
+	}
+	if replicaAddr != "" {
+		host, port, err2 := net.SplitHostPort(replicaAddr)
+		if err2 != nil {
+			common.PrintLinesf("invalid replica address %s, should be host:port", replicaAddr)
+			return nil, err2
+		}
+		opts.replica.Host = host
+		if opts.replica.Port, err2 = strconv.Atoi(port); err2 != nil {
+			common.PrintLinesf("invalid replica address %s, should be host:port", replicaAddr)
+			return nil, err2
+		}
+	}
+	if user, _ := cmd.Flags().GetString("replica-user"); user != "" {
+		opts.replica.User = user
+	}
+	if password, _ := cmd.Flags().GetString("replica-password"); password != "" {
+		opts.replica.Password = password
+	}
+
+	if opts.output, err = cmd.Flags().GetString("output"); err != nil {
+		return nil, err
+	}
+	if opts.output == "" {
+		opts.output = opts.task.Name + "-import-replication.yaml"
+	}
+	if opts.stateFile, err = cmd.Flags().GetString("state-file"); err != nil {
+		return nil, err
+	}
+	if opts.stateFile == "" {
+		opts.stateFile = opts.task.Name + "-import-replication-state.json"
+	}
+	if opts.stopReplica, err = cmd.Flags().GetBool("stop-replica"); err != nil {
+		return nil, err
+	}
+	if opts.dryRun, err = cmd.Flags().GetBool("dry-run"); err != nil {
+		return nil, err
+	}
+	if opts.wait, err = cmd.Flags().GetDuration("wait"); err != nil {
+		return nil, err
+	}
+
+	sourceCfg, err := getImportSourceCfg(opts.source)
+	if err != nil {
+		return nil, err
+	}
+	opts.enableGTID = sourceCfg.EnableGTID
+	opts.primaryHostPort = net.JoinHostPort(sourceCfg.From.Host, strconv.Itoa(sourceCfg.From.Port))
+	return opts, nil
+}
+
+// getImportSourceCfg gets the config of the source from DM-master.
+func getImportSourceCfg(source string) (*config.SourceConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.GetCfgResponse{}
+	err := common.SendRequest(ctx, "GetCfg", &pb.GetCfgRequest{Type: pb.CfgType_SourceType, Name: source}, &resp)
+	if err != nil {
+		common.PrintLinesf("can not get source config of %s", source)
+		return nil, err
+	}
+	if !resp.Result {
+		common.PrettyPrintResponse(resp)
+		return nil, errors.New("please check output to see error")
+	}
+	return config.ParseYaml(resp.Cfg)
+}
+
+// loadImportReplicationState loads the state from the state file, a new state
+// is returned if the file doesn't exist.
+func loadImportReplicationState(file, task, source string) (*importReplicationState, error) {
+	content, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return &importReplicationState{Task: task, Source: source}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &importReplicationState{}
+	if err = json.Unmarshal(content, state); err != nil {
+		common.PrintLinesf("can not decode state file %s", file)
+		return nil, err
+	}
+	if state.Task != task || state.Source != source {
+		return nil, fmt.Errorf("state file %s belongs to task %s of source %s, please specify another state file",
+			file, state.Task, state.Source)
+	}
+	return state, nil
+}
+
+func saveImportReplicationState(file string, state *importReplicationState) error {
+	content, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(file, content, 0o600); err != nil {
+		common.PrintLinesf("can not write state to file %s", file)
+		return err
+	}
+	return nil
+}
+
+// showReplicaStatus returns the statements of the version of the replica and
+// the result of SHOW REPLICA STATUS.
+func showReplicaStatus(ctx context.Context, db *sql.DB) (replicaStatements, map[string]string, error) {
+	stmts := replicaStmts
+	status, err := queryReplicaStatus(ctx, db, stmts.showStatus)
+	if conn.IsMySQLError(err, tmysql.ErrParse) {
+		stmts = legacyReplicaStmts
+		status, err = queryReplicaStatus(ctx, db, stmts.showStatus)
+	}
+	if err != nil {
+		return stmts, nil, terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+	}
+	if len(status) == 0 {
+		return stmts, nil, errors.New("the instance is not a replica, replication is not configured")
+	}
+	return stmts, status, nil
+}
+
+func queryReplicaStatus(ctx context.Context, db *sql.DB, query string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	status := make(map[string]string, len(columns))
+	for i, column := range columns {
+		status[column] = values[i].String
+	}
+	return status, rows.Err()
+}
+
+// parseReplicaPosition parses the executed position from the result of SHOW
+// REPLICA STATUS, the columns of both the new and the legacy names are read.
+func parseReplicaPosition(status map[string]string) (*replicaPosition, error) {
+	get := func(names ...string) string {
+		for _, name := range names {
+			if v, ok := status[name]; ok {
+				return v
+			}
+		}
+		return ""
+	}
+	if errno := get("Last_SQL_Errno"); errno != "" && errno != "0" {
+		return nil, fmt.Errorf("the SQL thread of the replica failed with error %s: %s", errno, get("Last_SQL_Error"))
+	}
+	pos := &replicaPosition{
+		SourceHost: get("Source_Host", "Master_Host"),
+		BinlogName: get("Relay_Source_Log_File", "Relay_Master_Log_File"),
+		// the GTID set is split into lines by the server.
+		GTIDSet: strings.ReplaceAll(get("Executed_Gtid_Set"), "\n", ""),
+	}
+	if pos.BinlogName == "" {
+		return nil, errors.New("the executed binlog file of the replica is empty")
+	}
+	port, err := strconv.Atoi(get("Source_Port", "Master_Port"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid source port of the replica: %w", err)
+	}
+	pos.SourcePort = port
+	binlogPos, err := strconv.ParseUint(get("Exec_Source_Log_Pos", "Exec_Master_Log_Pos"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid executed binlog position of the replica: %w", err)
+	}
+	pos.BinlogPos = uint32(binlogPos)
+	return pos, nil
+}
+
+// verifyImportDownstream warns if the replica is not replicating from the
+// source, or it's not the target database of the task.
+func verifyImportDownstream(ctx context.Context, opts *importReplicationOptions, pos *replicaPosition, replicaDB *sql.DB) error {
+	replicaPrimary := net.JoinHostPort(pos.SourceHost, strconv.Itoa(pos.SourcePort))
+	if replicaPrimary != opts.primaryHostPort {
+		common.PrintLinesf("WARNING: the replica replicates from %s, but source %s connects to %s, make sure they are the same instance",
+			replicaPrimary, opts.source, opts.primaryHostPort)
+	}
+	if opts.enableGTID && pos.GTIDSet == "" {
+		return fmt.Errorf("GTID is enabled in source %s, but the executed GTID set of the replica is empty", opts.source)
+	}
+
+	target := *opts.task.TargetDB
+	target.Password = utils.DecryptOrPlaintext(target.Password)
+	targetDB, err := conn.GetDownstreamDB(&target)
+	if err != nil {
+		common.PrintLinesf("can not connect to the target database %s:%d", target.Host, target.Port)
+		return err
+	}
+	defer targetDB.Close()
+
+	replicaUUID, err := queryServerUUID(ctx, replicaDB)
+	if err != nil {
+		return terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+	}
+	targetUUID, err := queryServerUUID(ctx, targetDB.DB)
+	if err != nil || targetUUID != replicaUUID {
+		common.PrintLinesf("WARNING: the replica is not the target database of the task, "+
+			"make sure the data of the target database matches the replica at binlog position (%s, %d)",
+			pos.BinlogName, pos.BinlogPos)
+		return nil
+	}
+	common.PrintLinesf("the replica is the target database of the task")
+	return nil
+}
+
+func queryServerUUID(ctx context.Context, db *sql.DB) (string, error) {
+	var uuid string
+	err := db.QueryRowContext(ctx, "SELECT @@server_uuid").Scan(&uuid)
+	return uuid, err
+}
+
+// seedImportTask turns the task into an incremental task, which starts from
+// the position of the replica for the source.
+func seedImportTask(task *config.TaskConfig, source string, pos *replicaPosition, enableGTID bool) error {
+	var instance *config.MySQLInstance
+	for _, inst := range task.MySQLInstances {
+		if inst.SourceID == source {
+			instance = inst
+			break
+		}
+	}
+	if instance == nil {
+		return fmt.Errorf("source %s is not found in mysql-instances of the task template", source)
+	}
+	task.TaskMode = config.ModeIncrement
+	instance.Meta = &config.Meta{
+		BinLogName: pos.BinlogName,
+		BinLogPos:  pos.BinlogPos,
+	}
+	if enableGTID {
+		instance.Meta.BinLogGTID = pos.GTIDSet
+	}
+	return nil
+}
+
+func execReplicaStatement(ctx context.Context, db *sql.DB, stmt string, dryRun bool) error {
+	if dryRun {
+		common.PrintLinesf("[dry-run] execute `%s` in the replica", stmt)
+		return nil
+	}
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return terror.DBErrorAdapt(err, terror.ScopeDownstream, terror.ErrDBDriverError)
+	}
+	common.PrintLinesf("execute `%s` in the replica succeed", stmt)
+	return nil
+}
+
+func startImportTask(ctx context.Context, taskFile, source string) error {
+	content, err := common.GetFileContent(taskFile)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.StartTaskResponse{}
+	err = common.SendRequest(ctx, "StartTask", &pb.StartTaskRequest{Task: string(content), Sources: []string{source}}, &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		common.PrettyPrintResponse(resp)
+		return errors.New("please check output to see error")
+	}
+	return nil
+}
+
+// waitImportTaskRunning waits until the subtask of the source is running in
+// the sync unit.
+func waitImportTaskRunning(ctx context.Context, task, source string, wait time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	ticker := time.NewTicker(importConfirmInterval)
+	defer ticker.Stop()
+	for {
+		resp := &pb.QueryStatusListResponse{}
+		err := common.SendRequest(ctx, "QueryStatus", &pb.QueryStatusListRequest{Name: task, Sources: []string{source}}, &resp)
+		if err == nil && resp.Result {
+			for _, s := range resp.Sources {
+				for _, subTask := range s.SubTaskStatus {
+					if subTask.Stage == pb.Stage_Running && subTask.Unit == pb.UnitType_Sync {
+						common.PrintLinesf("task %s is running for source %s", task, source)
+						return nil
+					}
+					if subTask.Stage == pb.Stage_Paused || subTask.Stage == pb.Stage_Stopped {
+						common.PrettyPrintResponse(resp)
+						return fmt.Errorf("task %s is %s for source %s", task, subTask.Stage, source)
+					}
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("task %s is not running for source %s in %s", task, source, wait)
+		case <-ticker.C:
+		}
+	}
+}
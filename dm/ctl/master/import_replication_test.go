@@ -0,0 +1,107 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"path/filepath"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/config"
+)
+
+func (t *testCtlMaster) TestParseReplicaPosition(c *check.C) {
+	// MySQL 8.0.22+
+	pos, err := parseReplicaPosition(map[string]string{
+		"Source_Host":           "127.0.0.1",
+		"Source_Port":           "3306",
+		"Relay_Source_Log_File": "mysql-bin.000003",
+		"Exec_Source_Log_Pos":   "1234",
+		"Executed_Gtid_Set":     "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14,\n406a3f61-690d-11e7-87c5-6c92bf46f384:1-94321383",
+		"Last_SQL_Errno":        "0",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(pos, check.DeepEquals, &replicaPosition{
+		SourceHost: "127.0.0.1",
+		SourcePort: 3306,
+		BinlogName: "mysql-bin.000003",
+		BinlogPos:  1234,
+		GTIDSet:    "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14,406a3f61-690d-11e7-87c5-6c92bf46f384:1-94321383",
+	})
+
+	// legacy names
+	legacy := map[string]string{
+		"Master_Host":           "mysql-primary",
+		"Master_Port":           "3307",
+		"Relay_Master_Log_File": "mysql-bin.000010",
+		"Exec_Master_Log_Pos":   "4",
+		"Executed_Gtid_Set":     "",
+	}
+	pos, err = parseReplicaPosition(legacy)
+	c.Assert(err, check.IsNil)
+	c.Assert(pos, check.DeepEquals, &replicaPosition{
+		SourceHost: "mysql-primary",
+		SourcePort: 3307,
+		BinlogName: "mysql-bin.000010",
+		BinlogPos:  4,
+	})
+
+	legacy["Last_SQL_Errno"] = "1062"
+	legacy["Last_SQL_Error"] = "Duplicate entry"
+	_, err = parseReplicaPosition(legacy)
+	c.Assert(err, check.ErrorMatches, ".*failed with error 1062: Duplicate entry")
+
+	_, err = parseReplicaPosition(map[string]string{"Master_Port": "3306", "Exec_Master_Log_Pos": "4"})
+	c.Assert(err, check.ErrorMatches, ".*binlog file of the replica is empty")
+}
+
+func (t *testCtlMaster) TestSeedImportTask(c *check.C) {
+	pos := &replicaPosition{
+		BinlogName: "mysql-bin.000003",
+		BinlogPos:  1234,
+		GTIDSet:    "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+	}
+	task := config.NewTaskConfig()
+	task.TaskMode = config.ModeAll
+	task.MySQLInstances = []*config.MySQLInstance{{SourceID: "mysql-replica-01"}, {SourceID: "mysql-replica-02"}}
+
+	c.Assert(seedImportTask(task, "mysql-replica-03", pos, false), check.ErrorMatches, ".*is not found.*")
+
+	c.Assert(seedImportTask(task, "mysql-replica-02", pos, false), check.IsNil)
+	c.Assert(task.TaskMode, check.Equals, config.ModeIncrement)
+	c.Assert(task.MySQLInstances[0].Meta, check.IsNil)
+	c.Assert(task.MySQLInstances[1].Meta, check.DeepEquals, &config.Meta{BinLogName: "mysql-bin.000003", BinLogPos: 1234})
+
+	c.Assert(seedImportTask(task, "mysql-replica-02", pos, true), check.IsNil)
+	c.Assert(task.MySQLInstances[1].Meta.BinLogGTID, check.Equals, pos.GTIDSet)
+}
+
+func (t *testCtlMaster) TestImportReplicationState(c *check.C) {
+	file := filepath.Join(c.MkDir(), "state.json")
+
+	state, err := loadImportReplicationState(file, "task", "source")
+	c.Assert(err, check.IsNil)
+	c.Assert(state, check.DeepEquals, &importReplicationState{Task: "task", Source: "source"})
+
+	state.Position = &replicaPosition{SourceHost: "127.0.0.1", SourcePort: 3306, BinlogName: "mysql-bin.000001", BinlogPos: 4}
+	state.TaskFile = "task-import-replication.yaml"
+	state.Done = append(state.Done, importStepStopSQLThread, importStepReadPosition)
+	c.Assert(saveImportReplicationState(file, state), check.IsNil)
+
+	loaded, err := loadImportReplicationState(file, "task", "source")
+	c.Assert(err, check.IsNil)
+	c.Assert(loaded, check.DeepEquals, state)
+
+	_, err = loadImportReplicationState(file, "task", "another-source")
+	c.Assert(err, check.ErrorMatches, ".*belongs to task task of source source.*")
+}
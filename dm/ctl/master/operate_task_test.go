@@ -14,6 +14,8 @@
 package master
 
 import (
+	"time"
+
 	"github.com/pingcap/check"
 	"github.com/spf13/cobra"
 )
@@ -67,3 +69,19 @@ func prepareTestCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceVarP(&[]string{}, "source", "s", []string{}, "MySQL Source ID.")
 	return cmd
 }
+
+func (t *testCtlMaster) TestParsePauseAtTime(c *check.C) {
+	expected := time.Date(2023, 1, 2, 3, 4, 5, 0, time.Local)
+	for _, s := range []string{"2023-01-02 03:04:05", "2023-01-02T03:04:05"} {
+		pauseAt, err := parsePauseAtTime(s)
+		c.Assert(err, check.IsNil)
+		c.Assert(pauseAt.Equal(expected), check.IsTrue)
+	}
+
+	pauseAt, err := parsePauseAtTime("2023-01-02T03:04:05+08:00")
+	c.Assert(err, check.IsNil)
+	c.Assert(pauseAt.Unix(), check.Equals, int64(1672599845))
+
+	_, err = parsePauseAtTime("2023-01-02")
+	c.Assert(err, check.ErrorMatches, ".*RFC3339 format")
+}
@@ -14,22 +14,102 @@
 package master
 
 import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
+const (
+	atTimeFlag        = "at-time"
+	atTimeTimeoutFlag = "at-time-timeout"
+)
+
 // NewPauseTaskCmd creates a PauseTask command.
 func NewPauseTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   `pause-task [-s source ...] [task-name | task-file]`,
+		Use:   `pause-task [-s source ...] [--at-time "2006-01-02 15:04:05" [--at-time-timeout 10m]] [task-name | task-file]`,
 		Short: "Pauses a specified running task or all (sub)tasks bound to a source",
 		RunE:  pauseTaskFunc,
 	}
 	addOperateSourceTaskFlags(cmd)
+	cmd.Flags().String(atTimeFlag, "", "pause the sync units after applying all the binlog events not later than this time, in local time zone or RFC3339 format")
+	cmd.Flags().String(atTimeTimeoutFlag, "", "pause the sync units anyway if they can't reach `--at-time` in this duration, default to 10m")
 	return cmd
 }
 
 // pauseTaskFunc does pause task request.
 func pauseTaskFunc(cmd *cobra.Command, _ []string) (err error) {
-	return operateTaskFunc(pb.TaskOp_Pause, cmd)
+	atTime, err := cmd.Flags().GetString(atTimeFlag)
+	if err != nil {
+		common.PrintLinesf("error in parse `--" + atTimeFlag + "`")
+		return err
+	}
+	if atTime == "" {
+		return operateTaskFunc(pb.TaskOp_Pause, cmd)
+	}
+	atTimeTimeout, err := cmd.Flags().GetString(atTimeTimeoutFlag)
+	if err != nil {
+		common.PrintLinesf("error in parse `--" + atTimeTimeoutFlag + "`")
+		return err
+	}
+
+	if len(cmd.Flags().Args()) != 1 {
+		// all the sources of a task are paused at the same time
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	pauseAt, err := parsePauseAtTime(atTime)
+	if err != nil {
+		common.PrintLinesf("error in parse `--" + atTimeFlag + "`")
+		return err
+	}
+	name := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateTaskResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateTask",
+		&pb.OperateTaskRequest{
+			Op:             pb.TaskOp_Pause,
+			Name:           name,
+			Sources:        sources,
+			PauseAtTime:    pauseAt.Format(time.RFC3339),
+			PauseAtTimeout: atTimeTimeout,
+		},
+		&resp,
+	)
+	if err != nil {
+		common.PrintLinesf("can not pause task %s at %s", name, atTime)
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
+
+// parsePauseAtTime parses the time of `--at-time`, which is in local time zone if not specified.
+func parsePauseAtTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{utils.StartTimeFormat, utils.StartTimeFormat2} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("the time should be like \"2006-01-02 15:04:05\" or in RFC3339 format")
 }
@@ -41,11 +41,12 @@ type taskInfo struct {
 // NewQueryStatusCmd creates a QueryStatus command.
 func NewQueryStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "query-status [-s source ...] [task-name | task-file] [--more]",
+		Use:   "query-status [-s source ...] [task-name | task-file] [--more] [--show-tuning]",
 		Short: "Queries task status",
 		RunE:  queryStatusFunc,
 	}
 	cmd.Flags().BoolP("more", "", false, "whether to print the detailed task information")
+	cmd.Flags().BoolP("show-tuning", "", false, "whether to print the effective per-table tuning overrides of syncer, implies --more")
 	return cmd
 }
 
@@ -87,6 +88,16 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		common.PrintLinesf("error in parse `--more`")
 		return err
 	}
+	showTuning, err := cmd.Flags().GetBool("show-tuning")
+	if err != nil {
+		common.PrintLinesf("error in parse `--show-tuning`")
+		return err
+	}
+	if showTuning {
+		more = true
+	} else {
+		hideTableTunings(resp)
+	}
 
 	if resp.Result && taskName == "" && len(sources) == 0 && !more {
 		result, hasFalseResult := wrapTaskResult(resp)
@@ -99,6 +110,18 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// hideTableTunings removes the per-table tuning overrides of syncer from the response, they're
+// only printed with `--show-tuning` since they may be lengthy.
+func hideTableTunings(resp *pb.QueryStatusListResponse) {
+	for _, source := range resp.Sources {
+		for _, subTask := range source.SubTaskStatus {
+			if sync := subTask.GetSync(); sync != nil {
+				sync.TableTunings = nil
+			}
+		}
+	}
+}
+
 // errorOccurred checks ProcessResult and return true if some error occurred.
 func errorOccurred(result *pb.ProcessResult) bool {
 	return result != nil && len(result.Errors) > 0
@@ -169,3 +169,24 @@ func (t *testCtlMaster) TestWrapTaskResult(c *check.C) {
 	_, hasFalseResult = wrapTaskResult(resp)
 	c.Assert(hasFalseResult, check.IsFalse)
 }
+
+func (t *testCtlMaster) TestHideTableTunings(c *check.C) {
+	syncStatus := &pb.SyncStatus{
+		SyncerBinlog: "(mysql-bin.000001, 4)",
+		TableTunings: []string{"`db`.`tbl`: batch=1000, max-dml-size=0, worker-count=1"},
+	}
+	resp := &pb.QueryStatusListResponse{
+		Result: true,
+		Sources: []*pb.QueryStatusResponse{{
+			Result:       true,
+			SourceStatus: &pb.SourceStatus{Source: "mysql-replica-01"},
+			SubTaskStatus: []*pb.SubTaskStatus{
+				{Name: "test", Stage: pb.Stage_Running, Status: &pb.SubTaskStatus_Sync{Sync: syncStatus}},
+				{Name: "test2", Stage: pb.Stage_Running},
+			},
+		}},
+	}
+	hideTableTunings(resp)
+	c.Assert(syncStatus.TableTunings, check.IsNil)
+	c.Assert(syncStatus.SyncerBinlog, check.Equals, "(mysql-bin.000001, 4)")
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/relay"
+	"github.com/spf13/cobra"
+)
+
+// relayStatsResult is the statistics of relayed binlog events got from a DM-worker.
+type relayStatsResult struct {
+	Worker string                    `json:"worker"`
+	Msg    string                    `json:"msg,omitempty"`
+	Stats  *relay.EventStatsSnapshot `json:"stats,omitempty"`
+}
+
+// NewRelayCmd creates a relay command.
+func NewRelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay <command>",
+		Short: "show relay information",
+	}
+	cmd.AddCommand(
+		newRelayStatsCmd(),
+	)
+	return cmd
+}
+
+func newRelayStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats <-s source>",
+		Short: "show the statistics of relayed binlog events of the source by type and table in rolling windows",
+		RunE:  relayStatsFunc,
+	}
+	return cmd
+}
+
+// relayStatsFunc gets the statistics from the DM-worker bound to the source.
+func relayStatsFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) > 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	if len(sources) != 1 {
+		fmt.Println("must specify one source (`-s` / `--source`)")
+		return errors.New("please check output to see error")
+	}
+	source := sources[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.ListMemberResponse{}
+	err = common.SendRequest(ctx,
+		"ListMember",
+		&pb.ListMemberRequest{Worker: true},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		common.PrettyPrintResponse(resp)
+		return nil
+	}
+
+	results := make([]relayStatsResult, 0, 1)
+	for _, member := range resp.Members {
+		worker := member.GetWorker()
+		if worker == nil {
+			continue
+		}
+		for _, info := range worker.Workers {
+			if info.Source != source {
+				continue
+			}
+			result := relayStatsResult{Worker: info.Name}
+			stats := &relay.EventStatsSnapshot{}
+			if err = common.GetWorkerJSON(info.Addr, "/relay/stats?source="+url.QueryEscape(source), stats); err != nil {
+				result.Msg = err.Error()
+			} else {
+				result.Stats = stats
+			}
+			results = append(results, result)
+		}
+	}
+	if len(results) == 0 {
+		common.PrintLinesf("source %s is not bound to any DM-worker", source)
+		return errors.New("please check output to see error")
+	}
+	common.PrettyPrintInterface(results)
+	return nil
+}
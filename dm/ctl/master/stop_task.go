@@ -14,22 +14,71 @@
 package master
 
 import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/spf13/cobra"
 )
 
+const keepMetaDaysFlag = "keep-meta-days"
+
 // NewStopTaskCmd creates a StopTask command.
 func NewStopTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "stop-task [-s source ...] [task-name | task-file]",
+		Use:   "stop-task [-s source ...] [--keep-meta-days N] [task-name | task-file]",
 		Short: "Stops a specified task or all (sub)tasks bound to a source",
 		RunE:  stopTaskFunc,
 	}
 	addOperateSourceTaskFlags(cmd)
+	cmd.Flags().Int32(keepMetaDaysFlag, 0, "keep the meta data of the task for N days, the task can resume from it if started again before expired")
 	return cmd
 }
 
 // stopTaskFunc does stop task request.
 func stopTaskFunc(cmd *cobra.Command, _ []string) (err error) {
-	return operateTaskFunc(pb.TaskOp_Delete, cmd)
+	keepMetaDays, err := cmd.Flags().GetInt32(keepMetaDaysFlag)
+	if err != nil {
+		common.PrintLinesf("error in parse `--" + keepMetaDaysFlag + "`")
+		return err
+	}
+	if keepMetaDays == 0 {
+		return operateTaskFunc(pb.TaskOp_Delete, cmd)
+	}
+
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	if keepMetaDays < 0 || len(cmd.Flags().Args()) != 1 || len(sources) > 0 {
+		// the meta data can only be kept for a whole task
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	name := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateTaskResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateTask",
+		&pb.OperateTaskRequest{
+			Op:           pb.TaskOp_Delete,
+			Name:         name,
+			KeepMetaDays: keepMetaDays,
+		},
+		&resp,
+	)
+	if err != nil {
+		common.PrintLinesf("can not stop task %s", name)
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
 }
@@ -0,0 +1,367 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/tidb/util/filter"
+	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
+	tablefilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/checksum"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultVerifyChunkSize = 10000
+	// the progress of a large table is printed every verifyProgressChunks chunks.
+	verifyProgressChunks = 100
+)
+
+// NewVerifyTaskCmd creates a VerifyTask command.
+func NewVerifyTaskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-task <task-name> [-s source ...] [--tables pattern ...] [--concurrency N] [--chunk-size N] [--file filename]",
+		Short: "Compares the data of the downstream tables with the upstream tables of a task",
+		Long: `Compares the data of the downstream tables with the upstream tables of a task.
+
+The tables to compare are generated from the block-allow-list and the routes of
+the task, the upstream tables routed to the same downstream table are merged.
+Each downstream table is split into chunks by the first column of its primary
+key, and the row count and the checksum of each chunk are compared. The
+mismatched chunks are reported with the SQL to inspect their rows.
+
+The comparison is not a snapshot read. If the replication is still flowing, the
+rows changed after being read in the upstream and before being read in the
+downstream cause false mismatches, so run it after the task catches up, against
+a quiesced or low-traffic system, and re-verify the mismatched chunks before
+repairing them. The binlog event filters, expression filters and column
+mappings of the task are not applied, the tables using them are reported as
+mismatched if their rows are changed by them. dmctl connects to the upstream
+and downstream databases directly.`,
+		RunE: verifyTaskFunc,
+	}
+	cmd.Flags().StringSlice("tables", nil, "table filter patterns of the downstream tables to compare, e.g. 'db.tbl*', all tables of the task are compared if not specified")
+	cmd.Flags().Int("concurrency", 4, "number of tables compared concurrently")
+	cmd.Flags().Int("chunk-size", defaultVerifyChunkSize, "number of rows of each compared chunk")
+	cmd.Flags().StringP("file", "f", "", "write the report to file")
+	return cmd
+}
+
+// VerifyTaskReport is the report of verify-task.
+type VerifyTaskReport struct {
+	Task             string                  `json:"task"`
+	Tables           int                     `json:"tables"`
+	MismatchedTables int                     `json:"mismatched_tables"`
+	Reports          []*checksum.TableReport `json:"reports"`
+}
+
+// verifyTable is a downstream table and the upstream tables merged into it.
+type verifyTable struct {
+	target  *checksum.Table
+	sources []*checksum.Table
+}
+
+func verifyTaskFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	patterns, err := cmd.Flags().GetStringSlice("tables")
+	if err != nil {
+		return err
+	}
+	concurrency, err := cmd.Flags().GetInt("concurrency")
+	if err != nil {
+		return err
+	}
+	chunkSize, err := cmd.Flags().GetInt("chunk-size")
+	if err != nil {
+		return err
+	}
+	output, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+	if concurrency <= 0 || chunkSize <= 0 {
+		return errors.New("concurrency and chunk-size should be positive")
+	}
+	var tableFilter tablefilter.Filter
+	if len(patterns) > 0 {
+		tableFilter, err = tablefilter.Parse(patterns)
+		if err != nil {
+			common.PrintLinesf("invalid table filter patterns %v", patterns)
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	warnIfNotSynced(ctx, taskName, sources)
+
+	cfgs, err := getVerifySubTaskCfgs(taskName, sources)
+	if err != nil {
+		return err
+	}
+	tables, closeDBs, err := genVerifyTables(ctx, cfgs, tableFilter)
+	defer closeDBs()
+	if err != nil {
+		return err
+	}
+	if len(tables) == 0 {
+		common.PrintLinesf("no table of task %s needs to be verified", taskName)
+		return nil
+	}
+
+	report, err := compareVerifyTables(ctx, taskName, tables, concurrency, chunkSize)
+	if err != nil {
+		return err
+	}
+	if len(output) != 0 {
+		content, err2 := json.MarshalIndent(report, "", "    ")
+		if err2 != nil {
+			return err2
+		}
+		if err2 = os.WriteFile(output, content, 0o600); err2 != nil {
+			common.PrintLinesf("can not write report to file %s", output)
+			return err2
+		}
+		common.PrintLinesf("write report to file %s succeed", output)
+	} else {
+		common.PrettyPrintInterface(report)
+	}
+	if report.MismatchedTables > 0 {
+		return fmt.Errorf("data of %d/%d tables mismatch, please check the report", report.MismatchedTables, report.Tables)
+	}
+	return nil
+}
+
+// warnIfNotSynced warns if some subtasks of the task haven't caught up, the
+// comparison is meaningless if the downstream is far behind.
+func warnIfNotSynced(ctx context.Context, taskName string, sources []string) {
+	ctx, cancel := context.WithTimeout(ctx, common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.QueryStatusListResponse{}
+	err := common.SendRequest(ctx, "QueryStatus", &pb.QueryStatusListRequest{Name: taskName, Sources: sources}, &resp)
+	if err != nil || !resp.Result {
+		common.PrintLinesf("can not query the status of task %s, make sure it has caught up before verifying", taskName)
+		return
+	}
+	for _, source := range resp.Sources {
+		for _, subTask := range source.SubTaskStatus {
+			if subTask.GetSync().GetSynced() && subTask.Stage == pb.Stage_Running {
+				continue
+			}
+			common.PrintLinesf("WARNING: subtask of source %s is %s and not synced, the verification may report false mismatches",
+				source.SourceStatus.GetSource(), subTask.Stage)
+		}
+	}
+}
+
+// getVerifySubTaskCfgs gets the subtask configs of the task from etcd, the
+// passwords in them are decrypted.
+func getVerifySubTaskCfgs(taskName string, sources []string) ([]*config.SubTaskConfig, error) {
+	cli := common.GlobalCtlClient.EtcdClient
+	if cli == nil {
+		return nil, errors.New("etcd client is not initialized")
+	}
+	subTaskCfgsMap, _, err := ha.GetAllSubTaskCfg(cli)
+	if err != nil {
+		common.PrintLinesf("can not get subtask configs from etcd")
+		return nil, err
+	}
+	sourceSet := make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		sourceSet[source] = struct{}{}
+	}
+
+	cfgs := make([]*config.SubTaskConfig, 0, len(subTaskCfgsMap))
+	for source, taskCfgs := range subTaskCfgsMap {
+		if _, ok := sourceSet[source]; len(sourceSet) > 0 && !ok {
+			continue
+		}
+		cfg, ok := taskCfgs[taskName]
+		if !ok {
+			continue
+		}
+		decrypted, err := cfg.DecryptPassword()
+		if err != nil {
+			return nil, err
+		}
+		cfgs = append(cfgs, decrypted)
+	}
+	if len(cfgs) == 0 {
+		return nil, fmt.Errorf("task %s is not found in sources %v", taskName, sources)
+	}
+	sort.Slice(cfgs, func(i, j int) bool {
+		return cfgs[i].SourceID < cfgs[j].SourceID
+	})
+	return cfgs, nil
+}
+
+// genVerifyTables generates the downstream tables to compare from the
+// block-allow-list and the routes of the subtasks. The returned function
+// closes the opened databases, it should be called even if an error is returned.
+func genVerifyTables(ctx context.Context, cfgs []*config.SubTaskConfig, tableFilter tablefilter.Filter) ([]*verifyTable, func(), error) {
+	var dbs []*conn.BaseDB
+	closeDBs := func() {
+		for _, db := range dbs {
+			_ = db.Close()
+		}
+	}
+
+	// all subtasks of a task share the same downstream.
+	toDB, err := conn.GetDownstreamDB(&cfgs[0].To)
+	if err != nil {
+		return nil, closeDBs, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	dbs = append(dbs, toDB)
+	toQuery := checksum.DBQueryFunc(toDB.DB)
+
+	tables := make(map[filter.Table]*verifyTable)
+	for _, cfg := range cfgs {
+		bw, err := filter.New(cfg.CaseSensitive, cfg.BAList)
+		if err != nil {
+			return nil, closeDBs, terror.ErrTaskCheckGenBAList.Delegate(err)
+		}
+		router, err := regexprrouter.NewRegExprRouter(cfg.CaseSensitive, cfg.RouteRules)
+		if err != nil {
+			return nil, closeDBs, terror.ErrTaskCheckGenTableRouter.Delegate(err)
+		}
+		fromDB, err := conn.GetUpstreamDB(&cfg.From)
+		if err != nil {
+			return nil, closeDBs, terror.WithScope(err, terror.ScopeUpstream)
+		}
+		dbs = append(dbs, fromDB)
+		fromQuery := checksum.DBQueryFunc(fromDB.DB)
+
+		tableMapper, _, err := conn.FetchTargetDoTables(ctx, cfg.SourceID, fromDB, bw, router)
+		if err != nil {
+			return nil, closeDBs, err
+		}
+		for target, sourceTables := range tableMapper {
+			if tableFilter != nil && !tableFilter.MatchTable(target.Schema, target.Name) {
+				continue
+			}
+			table, ok := tables[target]
+			if !ok {
+				table = &verifyTable{target: &checksum.Table{Schema: target.Schema, Name: target.Name, Query: toQuery}}
+				tables[target] = table
+			}
+			for _, source := range sourceTables {
+				table.sources = append(table.sources, &checksum.Table{
+					SourceID: cfg.SourceID,
+					Schema:   source.Schema,
+					Name:     source.Name,
+					Query:    fromQuery,
+				})
+			}
+		}
+	}
+
+	res := make([]*verifyTable, 0, len(tables))
+	for _, table := range tables {
+		sort.Slice(table.sources, func(i, j int) bool {
+			a, b := table.sources[i], table.sources[j]
+			if a.SourceID != b.SourceID {
+				return a.SourceID < b.SourceID
+			}
+			return a.QuotedName() < b.QuotedName()
+		})
+		res = append(res, table)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].target.QuotedName() < res[j].target.QuotedName()
+	})
+	return res, closeDBs, nil
+}
+
+// compareVerifyTables compares the tables concurrently and prints the progress.
+func compareVerifyTables(ctx context.Context, taskName string, tables []*verifyTable, concurrency, chunkSize int) (*VerifyTaskReport, error) {
+	report := &VerifyTaskReport{
+		Task:    taskName,
+		Tables:  len(tables),
+		Reports: make([]*checksum.TableReport, len(tables)),
+	}
+
+	var (
+		mu       sync.Mutex
+		finished int
+	)
+	tableCh := make(chan int, len(tables))
+	for i := range tables {
+		tableCh <- i
+	}
+	close(tableCh)
+	eg, egCtx := errgroup.WithContext(ctx)
+	for w := 0; w < concurrency && w < len(tables); w++ {
+		eg.Go(func() error {
+			tctx := tcontext.NewContext(egCtx, log.L())
+			for i := range tableCh {
+				if egCtx.Err() != nil {
+					return egCtx.Err()
+				}
+				table := tables[i]
+				tableReport, err := checksum.CompareTable(tctx, table.target, table.sources, chunkSize, func(r *checksum.TableReport) {
+					if r.Chunks%verifyProgressChunks == 0 {
+						common.PrintLinesf("%s: %d chunks compared, %d mismatched", r.TargetTable, r.Chunks, len(r.Mismatches))
+					}
+				})
+				if err != nil {
+					common.PrintLinesf("fail to compare table %s", table.target.QuotedName())
+					return err
+				}
+				report.Reports[i] = tableReport
+
+				mu.Lock()
+				finished++
+				common.PrintLinesf("[%d/%d] %s: %d chunks compared, %d mismatched",
+					finished, len(tables), tableReport.TargetTable, tableReport.Chunks, len(tableReport.Mismatches))
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	for _, tableReport := range report.Reports {
+		if len(tableReport.Mismatches) > 0 {
+			report.MismatchedTables++
+		}
+	}
+	return report, nil
+}
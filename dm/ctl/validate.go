@@ -0,0 +1,112 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/spf13/cobra"
+)
+
+const validateCmdName = "validate"
+
+func newValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [--task-file file ...] [--source-file file ...]",
+		Short: "Validates the configuration files of tasks and sources offline",
+		RunE:  validateFunc,
+	}
+	cmd.Flags().StringSlice("task-file", nil, "the configuration file of a task")
+	cmd.Flags().StringSlice("source-file", nil, "the configuration file of a source")
+	_ = cmd.MarkFlagFilename("task-file", "yaml", "yml")
+	_ = cmd.MarkFlagFilename("source-file", "yaml", "yml")
+	return cmd
+}
+
+// validateFunc checks the files against the config schema without the cluster.
+func validateFunc(cmd *cobra.Command, _ []string) error {
+	taskFiles, err := cmd.Flags().GetStringSlice("task-file")
+	if err != nil {
+		common.PrintLinesf("error in parse `--task-file`")
+		return err
+	}
+	sourceFiles, err := cmd.Flags().GetStringSlice("source-file")
+	if err != nil {
+		common.PrintLinesf("error in parse `--source-file`")
+		return err
+	}
+	if len(cmd.Flags().Args()) > 0 || len(taskFiles)+len(sourceFiles) == 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	valid := true
+	for _, f := range taskFiles {
+		valid = validateFile(f, func(content string) error {
+			return config.NewTaskConfig().Decode(content)
+		}) && valid
+	}
+	for _, f := range sourceFiles {
+		valid = validateFile(f, func(content string) error {
+			_, err2 := config.ParseYamlAndVerify(content)
+			return err2
+		}) && valid
+	}
+	if !valid {
+		return errors.New("please check output to see error")
+	}
+	return nil
+}
+
+// validateFile decodes the file by decode and prints the result. The unknown
+// fields are printed with their locations and the fixes of the typos.
+func validateFile(file string, decode func(content string) error) bool {
+	content, err := common.GetFileContent(file)
+	if err != nil {
+		common.PrintLinesf("%s: %v", file, err)
+		return false
+	}
+	err = decode(string(content))
+	if err == nil {
+		common.PrintLinesf("%s: ok", file)
+		return true
+	}
+
+	unknownFields := config.FindUnknownYAMLFields(content, err)
+	if len(unknownFields) == 0 {
+		common.PrintLinesf("%s: %s", file, terror.Message(err))
+		return false
+	}
+	lines := strings.Split(string(content), "\n")
+	for _, f := range unknownFields {
+		common.PrintLinesf("%s: %s", file, f)
+		if f.Line < 1 || f.Line > len(lines) {
+			continue
+		}
+		line := lines[f.Line-1]
+		if f.Suggestion == "" {
+			common.PrintLinesf("    %s", line)
+			continue
+		}
+		common.PrintLinesf("  - %s", line)
+		common.PrintLinesf("  + %s", strings.Replace(line, f.Field, f.Suggestion, 1))
+	}
+	return false
+}
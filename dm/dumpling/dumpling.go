@@ -32,6 +32,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	dutils "github.com/pingcap/tiflow/dm/pkg/dumpling"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -50,14 +51,17 @@ type Dumpling struct {
 	logger log.Logger
 
 	dumpConfig *export.Config
-	closed     atomic.Bool
-	core       *export.Dumper
-	mu         sync.RWMutex
+	// threads is the configured threads of dumpling, the threads in dumpConfig may be decreased
+	// to respect max-connections of the upstream.
+	threads int
+	closed  atomic.Bool
+	core    *export.Dumper
+	mu      sync.RWMutex
 }
 
 // NewDumpling creates a new Dumpling.
 func NewDumpling(cfg *config.SubTaskConfig) *Dumpling {
-	logger := log.L()
+	logger := log.ForTask(cfg.Name, cfg.SourceID)
 	if cfg.FrameworkLogger != nil {
 		logger = log.Logger{Logger: cfg.FrameworkLogger}
 	}
@@ -74,6 +78,7 @@ func (m *Dumpling) Init(ctx context.Context) error {
 	if m.dumpConfig, err = m.constructArgs(ctx); err != nil {
 		return err
 	}
+	m.threads = m.dumpConfig.Threads
 	if m.cfg.MetricsFactory != nil {
 		// this branch means dataflow engine has set a Factory, the Factory itself
 		// will register and deregister metrics, so we must use NoopRegistry
@@ -172,10 +177,13 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 
 	newCtx, cancel := context.WithCancel(ctx)
 	var (
-		dumpling *export.Dumper
-		err      error
+		dumpling    *export.Dumper
+		reservation *conn.UpstreamReservation
+		err         error
 	)
-	if dumpling, err = export.NewDumper(newCtx, m.dumpConfig); err == nil {
+	if reservation, err = m.reserveUpstreamConns(newCtx); err != nil {
+		m.logger.Warn("error occurred during reserving upstream connections", zap.Error(err))
+	} else if dumpling, err = export.NewDumper(newCtx, m.dumpConfig); err == nil {
 		m.mu.Lock()
 		m.core = dumpling
 		m.mu.Unlock()
@@ -189,6 +197,7 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	} else {
 		m.logger.Warn("error occurred during NewDumper", zap.Error(err))
 	}
+	reservation.Release()
 	cancel()
 
 	if err != nil {
@@ -318,6 +327,23 @@ func (m *Dumpling) IsFreshTask(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// reserveUpstreamConns reserves the connections used by dumpling from the upstream if max-connections
+// is set, because dumpling connects the upstream by itself. The threads of dumpling are decreased
+// if there are not enough connections, a connection is reserved for the consistency control.
+func (m *Dumpling) reserveUpstreamConns(ctx context.Context) (*conn.UpstreamReservation, error) {
+	m.dumpConfig.Threads = m.threads
+	reservation, err := conn.ReserveUpstreamConnections(ctx, &m.cfg.From, conn.MinUpstreamMaxConnections, m.threads+1)
+	if err != nil || reservation == nil {
+		return nil, err
+	}
+	if threads := reservation.Count - 1; threads < m.threads {
+		m.logger.Warn("decrease the threads of dumpling to respect max-connections of the upstream",
+			zap.Int("threads", m.threads), zap.Int("decreased threads", threads), zap.Int("max-connections", m.cfg.From.MaxConnections))
+		m.dumpConfig.Threads = threads
+	}
+	return reservation, nil
+}
+
 // constructArgs constructs arguments for exec.Command.
 func (m *Dumpling) constructArgs(ctx context.Context) (*export.Config, error) {
 	cfg := m.cfg
@@ -331,7 +357,11 @@ func (m *Dumpling) constructArgs(ctx context.Context) (*export.Config, error) {
 	dumpConfig.Host = db.Host
 	dumpConfig.Port = db.Port
 	dumpConfig.User = db.User
-	dumpConfig.Password = db.Password
+	password, err := secret.Resolve(ctx, db.Password)
+	if err != nil {
+		return nil, err
+	}
+	dumpConfig.Password = password
 	dumpConfig.OutputDirPath = cfg.Dir // use LoaderConfig.Dir as output dir
 	dumpConfig.CollationCompatible = cfg.CollationCompatible
 	tableFilter, err := filter.ParseMySQLReplicationRules(cfg.BAList)
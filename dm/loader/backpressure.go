@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// downstreamBackpressure polls the overload signal of the downstream.
+type downstreamBackpressure struct {
+	query         string
+	threshold     float64
+	throttledRate float64
+	interval      time.Duration
+	db            *conn.BaseDB
+	gauge         prometheus.Gauge
+	logger        log.Logger
+}
+
+// newDownstreamBackpressure creates a downstreamBackpressure polling the signal on
+// the connections of db, it returns nil if backpressure-logical is not set.
+func newDownstreamBackpressure(cfg *config.SubTaskConfig, db *conn.BaseDB, logger log.Logger) *downstreamBackpressure {
+	if !cfg.BackpressureLogical.Enabled() {
+		return nil
+	}
+	gauge := backpressureThrottledGauge.WithLabelValues(cfg.Name, cfg.SourceID)
+	gauge.Set(0)
+	return &downstreamBackpressure{
+		query:         cfg.BackpressureLogical.SignalQuery(),
+		threshold:     cfg.BackpressureLogical.Threshold,
+		throttledRate: float64(cfg.BackpressureLogical.ThrottledRate),
+		interval:      cfg.BackpressureLogical.PollInterval.Duration,
+		db:            db,
+		gauge:         gauge,
+		logger:        logger,
+	}
+}
+
+// overloaded polls the signal and returns whether it reaches the threshold.
+func (b *downstreamBackpressure) overloaded(ctx context.Context) (bool, error) {
+	// the signal must not block longer than a polling interval.
+	ctx, cancel := context.WithTimeout(ctx, b.interval)
+	defer cancel()
+	rows, err := b.db.QueryContext(tcontext.NewContext(ctx, b.logger), b.query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	signal, err := readSignal(rows)
+	if err != nil {
+		return false, err
+	}
+	return signal >= b.threshold, nil
+}
+
+// readSignal reads the last column of the first row as the signal.
+func readSignal(rows *sql.Rows) (float64, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("no row is returned by the overload signal query")
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	last := values[len(values)-1]
+	if !last.Valid {
+		return 0, errors.New("the overload signal is NULL")
+	}
+	signal, err := strconv.ParseFloat(last.String, 64)
+	if err != nil {
+		return 0, errors.Annotatef(err, "the overload signal %q is not a number", last.String)
+	}
+	return signal, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestLoadThrottleBackpressure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	cfg := &config.SubTaskConfig{Name: "test-backpressure", SourceID: "source"}
+	cfg.BackpressureLogical = config.LoaderBackpressure{
+		StatusVariable: "Threads_running",
+		Threshold:      64,
+		ThrottledRate:  10,
+		PollInterval:   config.Duration{Duration: time.Second},
+	}
+	throttle := newLoadThrottle(cfg, conn.NewBaseDBForTest(db), log.L())
+	require.NotNil(t, throttle)
+	defer throttleMultiplierGauge.DeletePartialMatch(map[string]string{"task": cfg.Name})
+	defer backpressureThrottledGauge.DeletePartialMatch(map[string]string{"task": cfg.Name})
+	throttled := func() float64 {
+		m := &dto.Metric{}
+		require.NoError(t, throttle.backpressure.gauge.Write(m))
+		return m.GetGauge().GetValue()
+	}
+	// the rate is not limited without rate-limit-logical until the downstream is overloaded.
+	require.Equal(t, rate.Inf, throttle.limiter.Limit())
+	require.Equal(t, float64(0), throttled())
+
+	query := regexp.QuoteMeta("SHOW GLOBAL STATUS LIKE 'Threads_running'")
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("Threads_running", "80"))
+	overloaded, err := throttle.backpressure.overloaded(context.Background())
+	require.NoError(t, err)
+	require.True(t, overloaded)
+	throttle.setOverloaded(time.Now(), overloaded)
+	require.Equal(t, rate.Limit(10), throttle.limiter.Limit())
+	require.Equal(t, float64(1), throttled())
+
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("Threads_running", "3"))
+	overloaded, err = throttle.backpressure.overloaded(context.Background())
+	require.NoError(t, err)
+	require.False(t, overloaded)
+	throttle.setOverloaded(time.Now(), overloaded)
+	require.Equal(t, rate.Inf, throttle.limiter.Limit())
+	require.Equal(t, float64(0), throttled())
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}))
+	_, err = throttle.backpressure.overloaded(context.Background())
+	require.ErrorContains(t, err, "no row is returned")
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("Threads_running", "busy"))
+	_, err = throttle.backpressure.overloaded(context.Background())
+	require.ErrorContains(t, err, "is not a number")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the throttled rate doesn't raise a lower rate limit.
+	cfg.RateLimitLogical = 100
+	cfg.ThrottleWindowsLogical = []config.LoaderThrottleWindow{
+		{Start: "00:00", End: "23:59", RateMultiplier: 0.05},
+	}
+	throttle = newLoadThrottle(cfg, conn.NewBaseDBForTest(db), log.L())
+	throttle.update(time.Date(2023, 1, 2, 12, 0, 0, 0, time.Local))
+	require.Equal(t, rate.Limit(5), throttle.limiter.Limit())
+	throttle.setOverloaded(time.Now(), true)
+	require.Equal(t, rate.Limit(5), throttle.limiter.Limit())
+	throttle.windows = nil
+	throttle.update(time.Now())
+	require.Equal(t, rate.Limit(10), throttle.limiter.Limit())
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -76,10 +77,21 @@ type CheckPoint interface {
 	// should be called after update checkpoint in DB
 	UpdateOffset(filename string, offset int64) error
 
+	// FlushOffsets writes the offsets of several data files to DB in one transaction,
+	// it's used when the checkpoint updates are batched rather than written with the data.
+	FlushOffsets(tctx *tcontext.Context, offsets map[string]int64) error
+
 	// AllFinished returns `true` when all restoring job are finished
 	AllFinished() bool
+
+	// Compact merges the checkpoints of contiguous finished data files of a table into one record,
+	// to keep the checkpoint small for the loads of many data files. It replaces records atomically.
+	Compact(tctx *tcontext.Context, allFiles map[string]Tables2DataFiles) error
 }
 
+// checkpointRequiredColumns are the columns of checkpoint table which are read or written by loader.
+var checkpointRequiredColumns = []string{"id", "filename", "cp_schema", "cp_table", "offset", "end_pos"}
+
 // RemoteCheckPoint implements CheckPoint by saving status in remote database system, mostly in TiDB.
 // it's not thread-safe.
 type RemoteCheckPoint struct {
@@ -87,19 +99,67 @@ type RemoteCheckPoint struct {
 	// if more operations need to be protected, add another mutex or rename this one.
 	connMutex sync.Mutex
 
-	db             *conn.BaseDB
-	conn           *DBConn
-	id             string
-	schema         string
-	tableName      string // tableName contains schema name
+	db         *conn.BaseDB
+	conn       *DBConn
+	id         string
+	schema     string
+	tableName  string // tableName contains schema name
+	rawSchema  string
+	rawTable   string
+	preCreated bool // the checkpoint table is created by user, we only check it
+	restoringState
+}
+
+// restoringState holds the checkpoints in memory, it's shared by all CheckPoint implementations.
+type restoringState struct {
 	restoringFiles struct {
 		sync.RWMutex
 		pos map[string]map[string]FilePosSet // schema -> table -> FilePosSet(filename -> [cur, end])
+		// compacted holds the loaded records which cover several finished data files, they are
+		// expanded into pos by CalcProgress. schema -> table -> record filename -> size
+		compacted map[string]map[string]map[string]int64
+		// compactedFiles maps a data file to the compacted record which covers it
+		compactedFiles map[string]string
 	}
 	finishedTables map[string]struct{}
 	logger         log.Logger
 }
 
+// resetRestoringFiles resets the checkpoints in memory, caller should hold the lock of restoringFiles.
+func (cp *restoringState) resetRestoringFiles() {
+	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet)
+	cp.restoringFiles.compacted = make(map[string]map[string]map[string]int64)
+	cp.restoringFiles.compactedFiles = make(map[string]string)
+}
+
+// addRestoringFile adds the checkpoint of a data file to memory, caller should hold the lock of restoringFiles.
+func (cp *restoringState) addRestoringFile(schema, table, filename string, offset, endPos int64) {
+	if _, ok := cp.restoringFiles.pos[schema]; !ok {
+		cp.restoringFiles.pos[schema] = make(map[string]FilePosSet)
+	}
+	tables := cp.restoringFiles.pos[schema]
+	if _, ok := tables[table]; !ok {
+		tables[table] = make(map[string][]int64)
+	}
+	tables[table][filename] = []int64{offset, endPos}
+}
+
+// loadRecord adds a loaded checkpoint record to memory, caller should hold the lock of restoringFiles.
+func (cp *restoringState) loadRecord(schema, table, filename string, offset, endPos int64) {
+	if !isCompactedFilename(filename) {
+		cp.addRestoringFile(schema, table, filename, offset, endPos)
+		return
+	}
+	if _, ok := cp.restoringFiles.compacted[schema]; !ok {
+		cp.restoringFiles.compacted[schema] = make(map[string]map[string]int64)
+	}
+	tables := cp.restoringFiles.compacted[schema]
+	if _, ok := tables[table]; !ok {
+		tables[table] = make(map[string]int64)
+	}
+	tables[table][filename] = endPos
+}
+
 func newRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id string) (CheckPoint, error) {
 	var err error
 	var db *conn.BaseDB
@@ -112,21 +172,30 @@ func newRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id s
 		}
 	}()
 
-	db, dbConns, err = createConns(tctx, cfg, cfg.Name, cfg.SourceID, 1)
+	db, dbConns, _, _, err = createConns(tctx, cfg, cfg.Name, cfg.SourceID, 1, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	cp := &RemoteCheckPoint{
-		db:             db,
-		conn:           dbConns[0],
-		id:             id,
-		finishedTables: make(map[string]struct{}),
-		schema:         dbutil.ColumnName(cfg.MetaSchema),
-		tableName:      dbutil.TableName(cfg.MetaSchema, cputil.LoaderCheckpoint(cfg.Name)),
-		logger:         tctx.L().WithFields(zap.String("component", "remote checkpoint")),
+	schema, table, preCreated := checkpointTableName(cfg.Name, cfg.MetaSchema, &cfg.LoaderConfig)
+	if preCreated {
+		id = PreCreatedCheckpointID(cfg.Name, id)
 	}
-	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet)
+	cp := &RemoteCheckPoint{
+		db:         db,
+		conn:       dbConns[0],
+		id:         id,
+		schema:     dbutil.ColumnName(schema),
+		tableName:  dbutil.TableName(schema, table),
+		rawSchema:  schema,
+		rawTable:   table,
+		preCreated: preCreated,
+		restoringState: restoringState{
+			finishedTables: make(map[string]struct{}),
+			logger:         tctx.L().WithFields(zap.String("component", "remote checkpoint")),
+		},
+	}
+	cp.resetRestoringFiles()
 	rollbackHolder.Add(fr.FuncRollback{Name: "CloseRemoteCheckPoint", Fn: cp.Close})
 
 	err = cp.prepare(tctx)
@@ -137,7 +206,40 @@ func newRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id s
 	return cp, nil
 }
 
+// checkpointTableName returns the schema and table name of the loader checkpoint table, and whether
+// the table is pre-created by user.
+func checkpointTableName(taskName, metaSchema string, cfg *config.LoaderConfig) (string, string, bool) {
+	if cfg.CheckpointTable == "" {
+		return metaSchema, cputil.LoaderCheckpoint(taskName), false
+	}
+	schema := cfg.CheckpointSchema
+	if schema == "" {
+		schema = metaSchema
+	}
+	return schema, cfg.CheckpointTable, true
+}
+
+// PreCreatedCheckpointTable returns the quoted name of the pre-created checkpoint table of loader,
+// or returns "" if loader creates the checkpoint table itself.
+func PreCreatedCheckpointTable(taskName, metaSchema string, cfg *config.LoaderConfig) string {
+	schema, table, preCreated := checkpointTableName(taskName, metaSchema, cfg)
+	if !preCreated {
+		return ""
+	}
+	return dbutil.TableName(schema, table)
+}
+
+// PreCreatedCheckpointID returns the `id` of the checkpoints of a task and a source in the pre-created
+// checkpoint table, which may be shared by tasks.
+func PreCreatedCheckpointID(taskName, sourceID string) string {
+	return taskName + ":" + sourceID
+}
+
 func (cp *RemoteCheckPoint) prepare(tctx *tcontext.Context) error {
+	if cp.preCreated {
+		// the downstream user may have no privilege to create schema or table
+		return cp.checkTable(tctx)
+	}
 	// create schema
 	if err := cp.createSchema(tctx); err != nil {
 		return err
@@ -174,6 +276,42 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 	return terror.WithScope(err, terror.ScopeDownstream)
 }
 
+// checkTable checks the pre-created checkpoint table has all columns used by loader.
+func (cp *RemoteCheckPoint) checkTable(tctx *tcontext.Context) error {
+	query := "SELECT COLUMN_NAME FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?"
+	cp.connMutex.Lock()
+	rows, err := cp.conn.querySQL(tctx, query, cp.rawSchema, cp.rawTable)
+	cp.connMutex.Unlock()
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	columns := make(map[string]struct{})
+	var column string
+	for rows.Next() {
+		if err = rows.Scan(&column); err != nil {
+			return terror.DBErrorAdapt(err, cp.conn.Scope(), terror.ErrDBDriverError)
+		}
+		columns[strings.ToLower(column)] = struct{}{}
+	}
+	if err = rows.Err(); err != nil {
+		return terror.DBErrorAdapt(err, cp.conn.Scope(), terror.ErrDBDriverError)
+	}
+
+	missing := make([]string, 0)
+	for _, col := range checkpointRequiredColumns {
+		if _, ok := columns[col]; !ok {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) > 0 {
+		return terror.ErrLoadCheckpointTableInvalid.Generate(cp.tableName, missing)
+	}
+	cp.logger.Info("use pre-created checkpoint table", zap.String("table", cp.tableName))
+	return nil
+}
+
 // Load implements CheckPoint.Load.
 func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	begin := time.Now()
@@ -200,29 +338,20 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 
 	cp.restoringFiles.Lock()
 	defer cp.restoringFiles.Unlock()
-	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet) // reset to empty
+	cp.resetRestoringFiles() // reset to empty
 	for rows.Next() {
 		err := rows.Scan(&filename, &schema, &table, &offset, &endPos)
 		if err != nil {
 			return terror.DBErrorAdapt(err, cp.conn.Scope(), terror.ErrDBDriverError)
 		}
-
-		if _, ok := cp.restoringFiles.pos[schema]; !ok {
-			cp.restoringFiles.pos[schema] = make(map[string]FilePosSet)
-		}
-		tables := cp.restoringFiles.pos[schema]
-		if _, ok := tables[table]; !ok {
-			tables[table] = make(map[string][]int64)
-		}
-		restoringFiles := tables[table]
-		restoringFiles[filename] = []int64{offset, endPos}
+		cp.loadRecord(schema, table, filename, offset, endPos)
 	}
 
 	return terror.DBErrorAdapt(rows.Err(), cp.conn.Scope(), terror.ErrDBDriverError)
 }
 
 // GetRestoringFileInfo implements CheckPoint.GetRestoringFileInfo.
-func (cp *RemoteCheckPoint) GetRestoringFileInfo(db, table string) map[string][]int64 {
+func (cp *restoringState) GetRestoringFileInfo(db, table string) map[string][]int64 {
 	cp.restoringFiles.RLock()
 	defer cp.restoringFiles.RUnlock()
 	results := make(map[string][]int64)
@@ -240,7 +369,7 @@ func (cp *RemoteCheckPoint) GetRestoringFileInfo(db, table string) map[string][]
 }
 
 // GetAllRestoringFileInfo implements CheckPoint.GetAllRestoringFileInfo.
-func (cp *RemoteCheckPoint) GetAllRestoringFileInfo() map[string][]int64 {
+func (cp *restoringState) GetAllRestoringFileInfo() map[string][]int64 {
 	cp.restoringFiles.RLock()
 	defer cp.restoringFiles.RUnlock()
 	results := make(map[string][]int64)
@@ -256,7 +385,7 @@ func (cp *RemoteCheckPoint) GetAllRestoringFileInfo() map[string][]int64 {
 }
 
 // IsTableCreated implements CheckPoint.IsTableCreated.
-func (cp *RemoteCheckPoint) IsTableCreated(db, table string) bool {
+func (cp *restoringState) IsTableCreated(db, table string) bool {
 	cp.restoringFiles.RLock()
 	defer cp.restoringFiles.RUnlock()
 	tables, ok := cp.restoringFiles.pos[db]
@@ -271,7 +400,7 @@ func (cp *RemoteCheckPoint) IsTableCreated(db, table string) bool {
 }
 
 // IsTableFinished implements CheckPoint.IsTableFinished.
-func (cp *RemoteCheckPoint) IsTableFinished(db, table string) bool {
+func (cp *restoringState) IsTableFinished(db, table string) bool {
 	key := strings.Join([]string{db, table}, ".")
 	if _, ok := cp.finishedTables[key]; ok {
 		return true
@@ -280,9 +409,10 @@ func (cp *RemoteCheckPoint) IsTableFinished(db, table string) bool {
 }
 
 // CalcProgress implements CheckPoint.CalcProgress.
-func (cp *RemoteCheckPoint) CalcProgress(allFiles map[string]Tables2DataFiles) error {
-	cp.restoringFiles.RLock()
-	defer cp.restoringFiles.RUnlock()
+func (cp *restoringState) CalcProgress(allFiles map[string]Tables2DataFiles) error {
+	cp.restoringFiles.Lock()
+	defer cp.restoringFiles.Unlock()
+	cp.expandCompacted(allFiles)
 	cp.finishedTables = make(map[string]struct{}) // reset to empty
 	for db, tables := range cp.restoringFiles.pos {
 		dbTables, ok := allFiles[db]
@@ -315,7 +445,7 @@ func (cp *RemoteCheckPoint) CalcProgress(allFiles map[string]Tables2DataFiles) e
 	return nil
 }
 
-func (cp *RemoteCheckPoint) allFilesFinished(files map[string][]int64) bool {
+func (cp *restoringState) allFilesFinished(files map[string][]int64) bool {
 	for file, pos := range files {
 		if len(pos) != 2 {
 			cp.logger.Error("unexpected checkpoint record", zap.String("data file", file), zap.Int64s("position", pos))
@@ -329,7 +459,7 @@ func (cp *RemoteCheckPoint) allFilesFinished(files map[string][]int64) bool {
 }
 
 // AllFinished implements CheckPoint.AllFinished.
-func (cp *RemoteCheckPoint) AllFinished() bool {
+func (cp *restoringState) AllFinished() bool {
 	cp.restoringFiles.RLock()
 	defer cp.restoringFiles.RUnlock()
 	for _, tables := range cp.restoringFiles.pos {
@@ -408,7 +538,7 @@ func (cp *RemoteCheckPoint) GenSQL(filename string, offset int64) string {
 }
 
 // UpdateOffset implements CheckPoint.UpdateOffset.
-func (cp *RemoteCheckPoint) UpdateOffset(filename string, offset int64) error {
+func (cp *restoringState) UpdateOffset(filename string, offset int64) error {
 	cp.restoringFiles.Lock()
 	defer cp.restoringFiles.Unlock()
 	db, table, err := getDBAndTableFromFilename(filename)
@@ -427,6 +557,27 @@ func (cp *RemoteCheckPoint) UpdateOffset(filename string, offset int64) error {
 	return terror.ErrLoadTaskCheckPointNotMatch.Generatef("db=%s table=%s not in checkpoint", db, filename)
 }
 
+// FlushOffsets implements CheckPoint.FlushOffsets.
+func (cp *RemoteCheckPoint) FlushOffsets(tctx *tcontext.Context, offsets map[string]int64) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	filenames := make([]string, 0, len(offsets))
+	for filename := range offsets {
+		filenames = append(filenames, filename)
+	}
+	// keep the order of updates stable to avoid deadlocks with other writers of the checkpoint table.
+	sort.Strings(filenames)
+	queries := make([]string, 0, len(filenames))
+	for _, filename := range filenames {
+		queries = append(queries, cp.GenSQL(filename, offsets[filename]))
+	}
+	cp.connMutex.Lock()
+	err := cp.conn.executeSQL(tctx, queries)
+	cp.connMutex.Unlock()
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
 // Clear implements CheckPoint.Clear.
 func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	sql2 := fmt.Sprintf("DELETE FROM %s WHERE `id` = '%s'", cp.tableName, cp.id)
@@ -436,6 +587,38 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	return terror.WithScope(err, terror.ScopeDownstream)
 }
 
+// Compact implements CheckPoint.Compact.
+// the records are replaced in one transaction, so the checkpoint is still valid if DM-worker crashes.
+func (cp *RemoteCheckPoint) Compact(tctx *tcontext.Context, allFiles map[string]Tables2DataFiles) error {
+	plans := cp.planCompaction(allFiles)
+	if len(plans) == 0 {
+		return nil
+	}
+
+	queries := make([]string, 0, 2*len(plans))
+	args := make([][]interface{}, 0, 2*len(plans))
+	for _, plan := range plans {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(plan.removed)), ",")
+		queries = append(queries, fmt.Sprintf("DELETE FROM %s WHERE `id` = ? AND `filename` IN (%s)", cp.tableName, placeholders))
+		deleteArgs := []interface{}{cp.id}
+		for _, filename := range plan.removed {
+			deleteArgs = append(deleteArgs, filename)
+		}
+		args = append(args, deleteArgs)
+
+		queries = append(queries, fmt.Sprintf("INSERT INTO %s (`id`, `filename`, `cp_schema`, `cp_table`, `offset`, `end_pos`) VALUES(?,?,?,?,?,?)", cp.tableName))
+		args = append(args, []interface{}{cp.id, plan.filename, plan.schema, plan.table, plan.size, plan.size})
+	}
+	cp.connMutex.Lock()
+	err := cp.conn.executeSQL(tctx, queries, args...)
+	cp.connMutex.Unlock()
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	cp.applyCompaction(plans)
+	return nil
+}
+
 // Count implements CheckPoint.Count.
 func (cp *RemoteCheckPoint) Count(tctx *tcontext.Context) (int, error) {
 	query := fmt.Sprintf("SELECT COUNT(id) FROM %s WHERE `id` = ?", cp.tableName)
@@ -460,7 +643,7 @@ func (cp *RemoteCheckPoint) Count(tctx *tcontext.Context) (int, error) {
 	return count, nil
 }
 
-func (cp *RemoteCheckPoint) String() string {
+func (cp *restoringState) String() string {
 	cp.restoringFiles.RLock()
 	defer cp.restoringFiles.RUnlock()
 	result := make(map[string][]int64)
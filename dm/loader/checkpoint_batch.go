@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+// checkpointBatcher collects the checkpoint updates of data files whose data are loaded,
+// and writes them to the checkpoint in one transaction at the interval. A data file may be
+// loaded while its checkpoint is not written yet, so at most the data loaded in the last
+// interval is loaded again after a crash.
+type checkpointBatcher struct {
+	interval time.Duration
+	cp       CheckPoint
+	logger   log.Logger
+
+	mu sync.Mutex
+	// filename -> the offset loaded but not written to the checkpoint.
+	pending map[string]int64
+}
+
+// newCheckpointBatcher creates a checkpointBatcher, it returns nil if the checkpoint is written with the data.
+func newCheckpointBatcher(cfg *config.SubTaskConfig, cp CheckPoint, logger log.Logger) *checkpointBatcher {
+	if cfg.CheckpointBatchIntervalLogical.Duration <= 0 {
+		return nil
+	}
+	return &checkpointBatcher{
+		interval: cfg.CheckpointBatchIntervalLogical.Duration,
+		cp:       cp,
+		logger:   logger,
+		pending:  make(map[string]int64),
+	}
+}
+
+// add records the offset of a data file, it should be called after the data is loaded.
+func (b *checkpointBatcher) add(filename string, offset int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if offset > b.pending[filename] {
+		b.pending[filename] = offset
+	}
+}
+
+// flush writes all the pending offsets to the checkpoint in one transaction. The offsets are
+// kept for the next flush if the writing fails.
+func (b *checkpointBatcher) flush(tctx *tcontext.Context) error {
+	b.mu.Lock()
+	offsets := b.pending
+	b.pending = make(map[string]int64)
+	b.mu.Unlock()
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	if err := b.cp.FlushOffsets(tctx, offsets); err != nil {
+		b.mu.Lock()
+		for filename, offset := range offsets {
+			if offset > b.pending[filename] {
+				b.pending[filename] = offset
+			}
+		}
+		b.mu.Unlock()
+		return err
+	}
+	b.logger.Debug("batched checkpoints flushed",
+		zap.Int("files", len(offsets)), zap.Duration("cost time", time.Since(start)))
+	return nil
+}
+
+// pendingCount returns the number of data files whose offsets are not written to the checkpoint.
+func (b *checkpointBatcher) pendingCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// run flushes the pending offsets periodically until the context is done. A failed flush is
+// retried in the next interval, the caller should flush again after all data are loaded.
+func (b *checkpointBatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	tctx := tcontext.NewContext(ctx, b.logger)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.flush(tctx); err != nil {
+				b.logger.Warn("flush batched checkpoints failed, will retry later", log.ShortError(err))
+			}
+		}
+	}
+}
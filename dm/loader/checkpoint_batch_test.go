@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+type flushRecordCheckPoint struct {
+	CheckPoint
+	flushed []map[string]int64
+	err     error
+}
+
+func (cp *flushRecordCheckPoint) FlushOffsets(_ *tcontext.Context, offsets map[string]int64) error {
+	if cp.err != nil {
+		return cp.err
+	}
+	cp.flushed = append(cp.flushed, offsets)
+	return nil
+}
+
+func TestCheckpointBatcher(t *testing.T) {
+	cfg := &config.SubTaskConfig{}
+	cp := &flushRecordCheckPoint{}
+	require.Nil(t, newCheckpointBatcher(cfg, cp, log.L()))
+
+	cfg.CheckpointBatchIntervalLogical.Duration = time.Second
+	b := newCheckpointBatcher(cfg, cp, log.L())
+	require.NotNil(t, b)
+	tctx := tcontext.Background()
+
+	// nothing is written if there is no pending offset.
+	require.NoError(t, b.flush(tctx))
+	require.Len(t, cp.flushed, 0)
+
+	b.add("db1.tbl1.sql", 100)
+	b.add("db1.tbl2.sql", 50)
+	// the largest offset of a file is kept.
+	b.add("db1.tbl1.sql", 200)
+	b.add("db1.tbl1.sql", 150)
+	require.Equal(t, 2, b.pendingCount())
+	require.NoError(t, b.flush(tctx))
+	require.Equal(t, []map[string]int64{{"db1.tbl1.sql": 200, "db1.tbl2.sql": 50}}, cp.flushed)
+	require.Equal(t, 0, b.pendingCount())
+
+	// the offsets are kept for the next flush if the writing fails.
+	cp.err = errors.New("mock flush error")
+	b.add("db1.tbl1.sql", 300)
+	require.Error(t, b.flush(tctx))
+	b.add("db1.tbl3.sql", 10)
+	require.Equal(t, 2, b.pendingCount())
+
+	cp.err = nil
+	require.NoError(t, b.flush(tctx))
+	require.Equal(t, map[string]int64{"db1.tbl1.sql": 300, "db1.tbl3.sql": 10}, cp.flushed[1])
+}
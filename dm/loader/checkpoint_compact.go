@@ -0,0 +1,166 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// compactedSeparator separates the first and the last data file in the filename of a compacted
+// checkpoint record, it never appears in the name of a data file.
+const compactedSeparator = "/"
+
+// checkpointCompaction replaces the records of contiguous finished data files of a table with
+// one compacted record.
+type checkpointCompaction struct {
+	schema string
+	table  string
+	// filename is the filename of the compacted record
+	filename string
+	// size is the total size of the data files, it's saved as both offset and end position
+	size int64
+	// files are the data files covered by the compacted record
+	files []string
+	// removed are the filenames of the records replaced by the compacted record
+	removed []string
+}
+
+// compactedFilename returns the filename of the compacted record covering data files from first
+// to last. The common `schema.table.` prefix is trimmed from last to keep the filename short.
+func compactedFilename(schema, table, first, last string) string {
+	return first + compactedSeparator + strings.TrimPrefix(last, schema+"."+table+".")
+}
+
+// parseCompactedFilename returns the first and the last data file covered by the compacted record.
+func parseCompactedFilename(schema, table, filename string) (string, string) {
+	idx := strings.Index(filename, compactedSeparator)
+	return filename[:idx], schema + "." + table + "." + filename[idx+len(compactedSeparator):]
+}
+
+func isCompactedFilename(filename string) bool {
+	return strings.Contains(filename, compactedSeparator)
+}
+
+// sortedDataFiles returns a sorted copy of data files, which decides whether data files are contiguous.
+func sortedDataFiles(files DataFiles) []string {
+	sorted := make([]string, len(files))
+	copy(sorted, files)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// expandCompacted expands the loaded compacted records into finished data files. The total size
+// is counted in the first data file, caller should hold the lock of restoringFiles.
+func (cp *restoringState) expandCompacted(allFiles map[string]Tables2DataFiles) {
+	for schema, tables := range cp.restoringFiles.compacted {
+		for table, records := range tables {
+			files := sortedDataFiles(allFiles[schema][table])
+			for filename, size := range records {
+				first, last := parseCompactedFilename(schema, table, filename)
+				start := sort.SearchStrings(files, first)
+				covered := 0
+				for i := start; i < len(files) && files[i] <= last; i++ {
+					pos := int64(0)
+					if covered == 0 {
+						pos = size
+					}
+					cp.addRestoringFile(schema, table, files[i], pos, pos)
+					cp.restoringFiles.compactedFiles[files[i]] = filename
+					covered++
+				}
+				if covered == 0 {
+					cp.logger.Warn("no data file found for compacted checkpoint",
+						zap.String("schema", schema), zap.String("table", table), zap.String("filename", filename))
+				}
+			}
+		}
+	}
+	cp.restoringFiles.compacted = make(map[string]map[string]map[string]int64)
+}
+
+// planCompaction finds out the contiguous finished data files which are not compacted yet.
+func (cp *restoringState) planCompaction(allFiles map[string]Tables2DataFiles) []*checkpointCompaction {
+	cp.restoringFiles.RLock()
+	defer cp.restoringFiles.RUnlock()
+
+	var plans []*checkpointCompaction
+	for schema, tables := range cp.restoringFiles.pos {
+		for table, restoringFiles := range tables {
+			var run []string
+			flush := func() {
+				if plan := cp.newCompaction(schema, table, run, restoringFiles); plan != nil {
+					plans = append(plans, plan)
+				}
+				run = nil
+			}
+			for _, file := range sortedDataFiles(allFiles[schema][table]) {
+				pos, ok := restoringFiles[file]
+				if !ok || len(pos) != 2 || pos[0] != pos[1] {
+					flush()
+					continue
+				}
+				run = append(run, file)
+			}
+			flush()
+		}
+	}
+	return plans
+}
+
+// newCompaction returns the compaction of the finished data files, or nil if there is nothing to compact.
+func (cp *restoringState) newCompaction(schema, table string, files []string, restoringFiles FilePosSet) *checkpointCompaction {
+	if len(files) < 2 {
+		return nil
+	}
+	plan := &checkpointCompaction{
+		schema:   schema,
+		table:    table,
+		filename: compactedFilename(schema, table, files[0], files[len(files)-1]),
+		files:    files,
+	}
+	removed := make(map[string]struct{})
+	for _, file := range files {
+		plan.size += restoringFiles[file][0]
+		filename, ok := cp.restoringFiles.compactedFiles[file]
+		if !ok {
+			filename = file
+		}
+		if _, ok := removed[filename]; !ok {
+			removed[filename] = struct{}{}
+			plan.removed = append(plan.removed, filename)
+		}
+	}
+	if len(plan.removed) == 1 && plan.removed[0] == plan.filename {
+		// already compacted
+		return nil
+	}
+	return plan
+}
+
+// applyCompaction updates memory after the compacted records are saved.
+func (cp *restoringState) applyCompaction(plans []*checkpointCompaction) {
+	cp.restoringFiles.Lock()
+	defer cp.restoringFiles.Unlock()
+	removed := 0
+	for _, plan := range plans {
+		for _, file := range plan.files {
+			cp.restoringFiles.compactedFiles[file] = plan.filename
+		}
+		removed += len(plan.removed)
+	}
+	cp.logger.Info("compact checkpoint", zap.Int("removed records", removed), zap.Int("compacted records", len(plans)))
+}
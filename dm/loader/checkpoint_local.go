@@ -0,0 +1,305 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	bolt "go.etcd.io/bbolt"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const localCheckpointOpenTimeout = 5 * time.Second
+
+// localCheckpointRecord is the value saved in the bolt file for every data file.
+type localCheckpointRecord struct {
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+	Offset int64  `json:"offset"`
+	EndPos int64  `json:"end_pos"`
+}
+
+// LocalCheckPoint implements CheckPoint by saving status in a bolt file of the DM-worker, it's used
+// when the downstream user has no privilege to create the checkpoint table. NOTE:
+//   - the load progress can't be resumed on another DM-worker, so the load task is kept in etcd until
+//     the meta data is removed, to keep the source bound to this DM-worker.
+//   - the checkpoint is not updated in the same transaction with the data, if DM-worker crashes
+//     between them, the last committed statements will be executed again when resuming.
+type LocalCheckPoint struct {
+	db       *bolt.DB
+	id       string
+	path     string
+	taskName string
+	sourceID string
+	restoringState
+}
+
+// localCheckpointPath returns the path of the bolt file. It's next to the dump directory, so it's
+// neither collected as a dump file nor removed together with the dump files.
+func localCheckpointPath(cfg *config.SubTaskConfig) string {
+	dir := filepath.Clean(cfg.Dir)
+	return filepath.Join(filepath.Dir(dir), cputil.LoaderCheckpoint(cfg.Name)+".db")
+}
+
+func newLocalCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id string, cli *clientv3.Client) (CheckPoint, error) {
+	path := localCheckpointPath(cfg)
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: localCheckpointOpenTimeout})
+	if err != nil {
+		return nil, terror.ErrLoadLocalCheckpoint.Delegate(err, path)
+	}
+
+	cp := &LocalCheckPoint{
+		db:       db,
+		id:       id,
+		path:     path,
+		taskName: cfg.Name,
+		sourceID: cfg.SourceID,
+		restoringState: restoringState{
+			finishedTables: make(map[string]struct{}),
+			logger:         tctx.L().WithFields(zap.String("component", "local checkpoint")),
+		},
+	}
+	cp.resetRestoringFiles()
+
+	if err = cp.clearIfStale(tctx, cli); err != nil {
+		cp.Close()
+		return nil, err
+	}
+	return cp, nil
+}
+
+// clearIfStale clears the recorded checkpoints if the load task is not in etcd. The load task is put
+// before restoring and only deleted by `--remove-meta` for local checkpoint, so these checkpoints
+// belong to a task whose meta data has been removed.
+func (cp *LocalCheckPoint) clearIfStale(tctx *tcontext.Context, cli *clientv3.Client) error {
+	if cli == nil {
+		return nil
+	}
+	count, err := cp.Count(tctx)
+	if err != nil || count == 0 {
+		return err
+	}
+	worker, err := getLoadTask(cli, cp.taskName, cp.sourceID)
+	if err != nil || worker != "" {
+		return err
+	}
+	cp.logger.Warn("load task not found in etcd, clear stale local checkpoint",
+		zap.String("path", cp.path), zap.Int("count", count))
+	return cp.Clear(tctx)
+}
+
+func (cp *LocalCheckPoint) bucket() []byte {
+	return []byte(cp.id)
+}
+
+// Load implements CheckPoint.Load.
+func (cp *LocalCheckPoint) Load(tctx *tcontext.Context) error {
+	begin := time.Now()
+	defer func() {
+		cp.logger.Info("load checkpoint", zap.Duration("cost time", time.Since(begin)))
+	}()
+
+	cp.restoringFiles.Lock()
+	defer cp.restoringFiles.Unlock()
+	cp.resetRestoringFiles() // reset to empty
+	err := cp.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(cp.bucket())
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var record localCheckpointRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return err
+			}
+			cp.loadRecord(record.Schema, record.Table, string(k), record.Offset, record.EndPos)
+			return nil
+		})
+	})
+	return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+}
+
+// Init implements CheckPoint.Init.
+func (cp *LocalCheckPoint) Init(tctx *tcontext.Context, filename string, endPos int64) error {
+	schema, table, err := getDBAndTableFromFilename(filename)
+	if err != nil {
+		return terror.ErrCheckpointInvalidTableFile.Generate(filename)
+	}
+	cp.logger.Info("initial checkpoint record",
+		zap.String("id", cp.id),
+		zap.String("filename", filename),
+		zap.String("schema", schema),
+		zap.String("table", table),
+		zap.Int64("offset", 0),
+		zap.Int64("end position", endPos))
+
+	record := localCheckpointRecord{Schema: schema, Table: table, EndPos: endPos}
+	exists := false
+	err = cp.db.Update(func(tx *bolt.Tx) error {
+		b, err2 := tx.CreateBucketIfNotExists(cp.bucket())
+		if err2 != nil {
+			return err2
+		}
+		if b.Get([]byte(filename)) != nil {
+			exists = true
+			return nil
+		}
+		value, err2 := json.Marshal(record)
+		if err2 != nil {
+			return err2
+		}
+		return b.Put([]byte(filename), value)
+	})
+	if err != nil {
+		return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+	}
+	if exists {
+		cp.logger.Warn("checkpoint record already exists, skip it.", zap.String("id", cp.id), zap.String("filename", filename))
+		return nil
+	}
+
+	cp.restoringFiles.Lock()
+	defer cp.restoringFiles.Unlock()
+	cp.addRestoringFile(record.Schema, record.Table, filename, record.Offset, record.EndPos)
+	return nil
+}
+
+// ResetConn implements CheckPoint.ResetConn.
+func (cp *LocalCheckPoint) ResetConn(tctx *tcontext.Context) error {
+	return nil
+}
+
+// Close implements CheckPoint.Close.
+func (cp *LocalCheckPoint) Close() {
+	if err := cp.db.Close(); err != nil {
+		cp.logger.Error("close local checkpoint file", log.ShortError(err))
+	}
+}
+
+// GenSQL implements CheckPoint.GenSQL.
+// the checkpoint is saved by UpdateOffset, so no SQL is executed in downstream.
+func (cp *LocalCheckPoint) GenSQL(filename string, offset int64) string {
+	return ""
+}
+
+// UpdateOffset implements CheckPoint.UpdateOffset.
+// it saves the offset into the bolt file before updating it in memory.
+func (cp *LocalCheckPoint) UpdateOffset(filename string, offset int64) error {
+	err := cp.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(cp.bucket())
+		if b == nil {
+			return terror.ErrLoadTaskCheckPointNotMatch.Generatef("file=%s not in checkpoint", filename)
+		}
+		value := b.Get([]byte(filename))
+		if value == nil {
+			return terror.ErrLoadTaskCheckPointNotMatch.Generatef("file=%s not in checkpoint", filename)
+		}
+		var record localCheckpointRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+		}
+		record.Offset = offset
+		newValue, err := json.Marshal(record)
+		if err != nil {
+			return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+		}
+		return b.Put([]byte(filename), newValue)
+	})
+	if err != nil {
+		return err
+	}
+	return cp.restoringState.UpdateOffset(filename, offset)
+}
+
+// FlushOffsets implements CheckPoint.FlushOffsets.
+// the offsets are already saved by UpdateOffset, so nothing is written.
+func (cp *LocalCheckPoint) FlushOffsets(tctx *tcontext.Context, offsets map[string]int64) error {
+	return nil
+}
+
+// Clear implements CheckPoint.Clear.
+func (cp *LocalCheckPoint) Clear(tctx *tcontext.Context) error {
+	err := cp.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(cp.bucket()) == nil {
+			return nil
+		}
+		return tx.DeleteBucket(cp.bucket())
+	})
+	return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+}
+
+// Compact implements CheckPoint.Compact.
+// the records are replaced in one bolt transaction, so the checkpoint is still valid if DM-worker crashes.
+func (cp *LocalCheckPoint) Compact(tctx *tcontext.Context, allFiles map[string]Tables2DataFiles) error {
+	plans := cp.planCompaction(allFiles)
+	if len(plans) == 0 {
+		return nil
+	}
+
+	err := cp.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(cp.bucket())
+		if b == nil {
+			return terror.ErrLoadTaskCheckPointNotMatch.Generatef("checkpoint of %s not found", cp.id)
+		}
+		for _, plan := range plans {
+			for _, filename := range plan.removed {
+				if err := b.Delete([]byte(filename)); err != nil {
+					return err
+				}
+			}
+			value, err := json.Marshal(localCheckpointRecord{
+				Schema: plan.schema,
+				Table:  plan.table,
+				Offset: plan.size,
+				EndPos: plan.size,
+			})
+			if err != nil {
+				return err
+			}
+			if err = b.Put([]byte(plan.filename), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+	}
+	cp.applyCompaction(plans)
+	return nil
+}
+
+// Count implements CheckPoint.Count.
+func (cp *LocalCheckPoint) Count(tctx *tcontext.Context) (int, error) {
+	count := 0
+	err := cp.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(cp.bucket()); b != nil {
+			count = b.Stats().KeyN
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, terror.ErrLoadLocalCheckpoint.Delegate(err, cp.path)
+	}
+	cp.logger.Debug("checkpoint record", zap.Int("count", count))
+	return count, nil
+}
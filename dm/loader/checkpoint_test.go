@@ -18,6 +18,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -28,6 +29,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 var (
@@ -266,6 +268,171 @@ func (t *testCheckPointSuite) TestDeepCopy(c *C) {
 	c.Assert(ret, DeepEquals, map[string][]int64{"file": {10, 100}, "file2": {0, 100}})
 }
 
+func (t *testCheckPointSuite) TestPreCreatedTable(c *C) {
+	cfg := *t.cfg
+	cfg.LoaderConfig.CheckpointSchema = "user_meta"
+	cfg.LoaderConfig.CheckpointTable = "loader_cp"
+	checkColumnsSQL := "SELECT COLUMN_NAME FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = \\? AND TABLE_NAME = \\?"
+	tctx := tcontext.Background()
+
+	// missing columns
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery(checkColumnsSQL).WithArgs("user_meta", "loader_cp").WillReturnRows(
+		sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("filename").AddRow("cp_schema").AddRow("cp_table"))
+	_, err := newRemoteCheckPoint(tctx, &cfg, "test_pre_created")
+	c.Assert(terror.ErrLoadCheckpointTableInvalid.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*missing columns \\[offset end_pos\\].*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// all columns exist, no CREATE statement is executed
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery(checkColumnsSQL).WithArgs("user_meta", "loader_cp").WillReturnRows(
+		sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("filename").AddRow("cp_schema").
+			AddRow("cp_table").AddRow("offset").AddRow("end_pos").AddRow("create_time").AddRow("update_time"))
+	cp, err := newRemoteCheckPoint(tctx, &cfg, "test_pre_created")
+	c.Assert(err, IsNil)
+	defer cp.Close()
+	// the checkpoints in the pre-created table are keyed by the task name and the source ID.
+	mock.ExpectQuery("SELECT COUNT.* FROM `user_meta`.`loader_cp` WHERE `id` = ?").
+		WithArgs(PreCreatedCheckpointID(cfg.Name, "test_pre_created")).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(id)"}).AddRow(0))
+	count, err := cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+	c.Assert(cp.GenSQL("db1.tbl1.sql", 10), Matches, "UPDATE `user_meta`.`loader_cp` SET .*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	c.Assert(PreCreatedCheckpointTable(cfg.Name, cfg.MetaSchema, &cfg.LoaderConfig), Equals, "`user_meta`.`loader_cp`")
+	c.Assert(PreCreatedCheckpointTable(t.cfg.Name, t.cfg.MetaSchema, &t.cfg.LoaderConfig), Equals, "")
+}
+
+func (t *testCheckPointSuite) TestLocalCheckPoint(c *C) {
+	cfg := *t.cfg
+	cfg.Name = "test_local"
+	cfg.LoaderConfig.Dir = filepath.Join(c.MkDir(), "dumped_data")
+	cfg.LoaderConfig.CheckpointStorage = config.LoaderCheckpointLocal
+	tctx := tcontext.Background()
+
+	cp, err := newLocalCheckPoint(tctx, &cfg, "test_for_local", nil)
+	c.Assert(err, IsNil)
+	count, err := cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+
+	c.Assert(cp.Init(tctx, "db1.tbl1.sql", 123), IsNil)
+	c.Assert(cp.Init(tctx, "db1.tbl2.sql", 456), IsNil)
+	c.Assert(cp.Init(tctx, "db1.tbl1.sql", 123), IsNil) // already exists
+	c.Assert(cp.GenSQL("db1.tbl1.sql", 123), Equals, "")
+	c.Assert(cp.UpdateOffset("db1.tbl1.sql", 123), IsNil)
+	c.Assert(cp.UpdateOffset("db1.tbl3.sql", 1), NotNil)
+	cp.Close()
+
+	// reopen and load the saved checkpoint
+	cp, err = newLocalCheckPoint(tctx, &cfg, "test_for_local", nil)
+	c.Assert(err, IsNil)
+	defer cp.Close()
+	count, err = cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+	c.Assert(cp.Load(tctx), IsNil)
+	c.Assert(cp.GetAllRestoringFileInfo(), DeepEquals, map[string][]int64{
+		"db1.tbl1.sql": {123, 123},
+		"db1.tbl2.sql": {0, 456},
+	})
+	c.Assert(cp.CalcProgress(map[string]Tables2DataFiles{
+		"db1": {"tbl1": {"db1.tbl1.sql"}, "tbl2": {"db1.tbl2.sql"}},
+	}), IsNil)
+	c.Assert(cp.IsTableFinished("db1", "tbl1"), IsTrue)
+	c.Assert(cp.IsTableFinished("db1", "tbl2"), IsFalse)
+	c.Assert(cp.AllFinished(), IsFalse)
+
+	c.Assert(cp.Clear(tctx), IsNil)
+	count, err = cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+}
+
+func (t *testCheckPointSuite) TestCompactLocalCheckPoint(c *C) {
+	cfg := *t.cfg
+	cfg.Name = "test_compact"
+	cfg.LoaderConfig.Dir = filepath.Join(c.MkDir(), "dumped_data")
+	cfg.LoaderConfig.CheckpointStorage = config.LoaderCheckpointLocal
+	tctx := tcontext.Background()
+	allFiles := map[string]Tables2DataFiles{
+		"db1": {
+			"tbl1": {"db1.tbl1.000005.sql", "db1.tbl1.000001.sql", "db1.tbl1.000002.sql", "db1.tbl1.000003.sql", "db1.tbl1.000004.sql"},
+			"tbl2": {"db1.tbl2.sql"},
+		},
+	}
+
+	cp, err := newLocalCheckPoint(tctx, &cfg, "test_for_compact", nil)
+	c.Assert(err, IsNil)
+	for i := 1; i <= 4; i++ {
+		filename := fmt.Sprintf("db1.tbl1.00000%d.sql", i)
+		c.Assert(cp.Init(tctx, filename, int64(i*100)), IsNil)
+		if i != 3 {
+			c.Assert(cp.UpdateOffset(filename, int64(i*100)), IsNil)
+		}
+	}
+	c.Assert(cp.Init(tctx, "db1.tbl2.sql", 100), IsNil)
+	c.Assert(cp.UpdateOffset("db1.tbl2.sql", 100), IsNil)
+
+	// only db1.tbl1.000001.sql and db1.tbl1.000002.sql are contiguous finished files
+	c.Assert(cp.Compact(tctx, allFiles), IsNil)
+	count, err := cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 4)
+	// compact again changes nothing
+	c.Assert(cp.Compact(tctx, allFiles), IsNil)
+	count, err = cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 4)
+
+	c.Assert(cp.UpdateOffset("db1.tbl1.000003.sql", 300), IsNil)
+	c.Assert(cp.Compact(tctx, allFiles), IsNil)
+	count, err = cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+	cp.Close()
+
+	// reopen and load the compacted checkpoint
+	cp, err = newLocalCheckPoint(tctx, &cfg, "test_for_compact", nil)
+	c.Assert(err, IsNil)
+	defer cp.Close()
+	c.Assert(cp.Load(tctx), IsNil)
+	c.Assert(cp.CalcProgress(allFiles), IsNil)
+	c.Assert(cp.GetRestoringFileInfo("db1", "tbl1"), DeepEquals, map[string][]int64{
+		"db1.tbl1.000001.sql": {1000, 1000},
+		"db1.tbl1.000002.sql": {0, 0},
+		"db1.tbl1.000003.sql": {0, 0},
+		"db1.tbl1.000004.sql": {0, 0},
+	})
+	c.Assert(cp.IsTableFinished("db1", "tbl1"), IsFalse)
+	c.Assert(cp.IsTableFinished("db1", "tbl2"), IsTrue)
+
+	// the compacted record is replaced when more files are finished
+	c.Assert(cp.Init(tctx, "db1.tbl1.000005.sql", 500), IsNil)
+	c.Assert(cp.UpdateOffset("db1.tbl1.000005.sql", 500), IsNil)
+	c.Assert(cp.Compact(tctx, allFiles), IsNil)
+	count, err = cp.Count(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+	c.Assert(cp.Load(tctx), IsNil)
+	c.Assert(cp.CalcProgress(allFiles), IsNil)
+	c.Assert(cp.IsTableFinished("db1", "tbl1"), IsTrue)
+	c.Assert(cp.AllFinished(), IsTrue)
+}
+
+func (t *testCheckPointSuite) TestCompactedFilename(c *C) {
+	filename := compactedFilename("db", "tbl", "db.tbl.000001.sql", "db.tbl.000010.sql")
+	c.Assert(filename, Equals, "db.tbl.000001.sql/000010.sql")
+	c.Assert(isCompactedFilename(filename), IsTrue)
+	c.Assert(isCompactedFilename("db.tbl.000001.sql"), IsFalse)
+	first, last := parseCompactedFilename("db", "tbl", filename)
+	c.Assert(first, Equals, "db.tbl.000001.sql")
+	c.Assert(last, Equals, "db.tbl.000010.sql")
+}
+
 type lightningCpListSuite struct {
 	mock   sqlmock.Sqlmock
 	cpList *LightningCheckpointList
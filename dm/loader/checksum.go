@@ -0,0 +1,231 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tiflow/dm/pkg/checksum"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// ChecksumMismatch records a chunk of a table whose checksum in the downstream
+// doesn't match the one in the source.
+type ChecksumMismatch struct {
+	// Range is the WHERE condition of the chunk, it can be used to find out
+	// the different rows directly.
+	Range          string
+	SourceCount    int64
+	TargetCount    int64
+	SourceChecksum uint64
+	TargetChecksum uint64
+}
+
+// TableChecksumResult is the result of verifying the checksum of a loaded table.
+type TableChecksumResult struct {
+	SourceTable string
+	TargetTable string
+	Chunks      int
+	Mismatches  []ChecksumMismatch
+}
+
+// verifyChecksum verifies the checksum of all loaded tables against the source.
+// A mismatch doesn't fail the load, it's logged and can be got by ChecksumResults.
+func (l *Loader) verifyChecksum(ctx context.Context) error {
+	if l.cfg.IsSharding || len(l.cfg.ColumnMappingRules) > 0 {
+		l.logger.Warn("skip verifying checksum after load for sharding task or task with column mapping rules")
+		return nil
+	}
+	if len(l.toReadDBConns) == 0 {
+		return nil
+	}
+
+	tctx := tcontext.NewContext(ctx, l.logger)
+	fromDB, err := conn.GetUpstreamDB(&l.cfg.From)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeUpstream)
+	}
+	defer func() {
+		if err2 := fromDB.Close(); err2 != nil {
+			l.logger.Warn("close upstream DB error", log.ShortError(err2))
+		}
+	}()
+	// every connection of the read pool verifies tables concurrently with
+	// its own upstream connection.
+	verifiers := make([]*checksumVerifier, 0, len(l.toReadDBConns))
+	for _, target := range l.toReadDBConns {
+		baseConn, err := fromDB.GetBaseConn(ctx)
+		if err != nil {
+			return terror.WithScope(err, terror.ScopeUpstream)
+		}
+		source := &DBConn{
+			baseConn: baseConn,
+			name:     l.cfg.Name,
+			sourceID: l.cfg.SourceID,
+			resetBaseConnFn: func(tctx *tcontext.Context, baseConn *conn.BaseConn) (*conn.BaseConn, error) {
+				if err2 := fromDB.ForceCloseConn(baseConn); err2 != nil {
+					tctx.L().Warn("failed to close baseConn in reset")
+				}
+				return fromDB.GetBaseConn(tctx.Context())
+			},
+		}
+		verifiers = append(verifiers, &checksumVerifier{
+			source:    source,
+			target:    target,
+			chunkSize: l.cfg.ChecksumChunkSizeLogical,
+		})
+	}
+
+	names := make([]string, 0, len(l.tableInfos))
+	for name := range l.tableInfos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]*TableChecksumResult, len(names))
+	tableCh := make(chan int, len(names))
+	for i := range names {
+		tableCh <- i
+	}
+	close(tableCh)
+	eg, egCtx := errgroup.WithContext(tctx.Context())
+	for _, verifier := range verifiers {
+		verifier := verifier
+		eg.Go(func() error {
+			vctx := tctx.WithContext(egCtx)
+			for i := range tableCh {
+				if egCtx.Err() != nil {
+					return egCtx.Err()
+				}
+				result, err := verifier.verifyTable(vctx, l.tableInfos[names[i]])
+				if err != nil {
+					return err
+				}
+				results[i] = result
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	mismatchTables := 0
+	for _, result := range results {
+		if len(result.Mismatches) > 0 {
+			mismatchTables++
+		}
+	}
+
+	l.Lock()
+	l.checksumResults = results
+	l.Unlock()
+	if mismatchTables > 0 {
+		l.logger.Warn("checksum of some tables mismatch after load",
+			zap.Int("tables", len(results)), zap.Int("mismatch tables", mismatchTables))
+	} else {
+		l.logger.Info("checksum of all tables match after load", zap.Int("tables", len(results)))
+	}
+	return nil
+}
+
+// ChecksumResults returns the results of the last checksum verification after load,
+// nil if checksum-logical is not enabled or the load is not finished.
+func (l *Loader) ChecksumResults() []*TableChecksumResult {
+	l.RLock()
+	defer l.RUnlock()
+	return l.checksumResults
+}
+
+// checksumVerifier verifies the loaded tables chunk by chunk, it compares the
+// checksum of the downstream rows with the one of the source rows.
+type checksumVerifier struct {
+	source    *DBConn
+	target    *DBConn
+	chunkSize int
+}
+
+// verifyTable verifies a loaded table. The table is split into chunks by the
+// first column of the primary key of the downstream table, or verified as a
+// whole if there is no primary key.
+func (v *checksumVerifier) verifyTable(tctx *tcontext.Context, table *tableInfo) (*TableChecksumResult, error) {
+	result := &TableChecksumResult{
+		SourceTable: dbutil.TableName(table.sourceSchema, table.sourceTable),
+		TargetTable: dbutil.TableName(table.targetSchema, table.targetTable),
+	}
+	// extended columns only exist in the downstream.
+	columns := make([]string, 0, len(table.columnNameList))
+	for _, col := range table.columnNameList[:len(table.columnNameList)-len(table.extendCol)] {
+		columns = append(columns, dbutil.ColumnName(col))
+	}
+
+	keyCol, err := v.chunkKey(tctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var lower *string
+	for {
+		var upper *string
+		if keyCol != "" {
+			upper, err = checksum.ChunkUpperBound(tctx, v.target.querySQL, result.TargetTable, keyCol, lower, v.chunkSize)
+			if err != nil {
+				return nil, terror.WithScope(err, terror.ScopeDownstream)
+			}
+		}
+
+		where, args := checksum.ChunkRange(keyCol, lower, upper)
+		sourceCount, sourceChecksum, err := checksum.ChunkChecksum(tctx, v.source.querySQL, result.SourceTable, columns, where, args)
+		if err != nil {
+			return nil, terror.WithScope(err, terror.ScopeUpstream)
+		}
+		targetCount, targetChecksum, err := checksum.ChunkChecksum(tctx, v.target.querySQL, result.TargetTable, columns, where, args)
+		if err != nil {
+			return nil, terror.WithScope(err, terror.ScopeDownstream)
+		}
+		result.Chunks++
+		if sourceCount != targetCount || sourceChecksum != targetChecksum {
+			mismatch := ChecksumMismatch{
+				Range:          checksum.DescribeChunkRange(where, args),
+				SourceCount:    sourceCount,
+				TargetCount:    targetCount,
+				SourceChecksum: sourceChecksum,
+				TargetChecksum: targetChecksum,
+			}
+			result.Mismatches = append(result.Mismatches, mismatch)
+			tctx.L().Warn("checksum mismatch after load",
+				zap.String("source table", result.SourceTable),
+				zap.String("target table", result.TargetTable),
+				zap.Reflect("mismatch", mismatch))
+		}
+
+		if upper == nil {
+			return result, nil
+		}
+		lower = upper
+	}
+}
+
+// chunkKey returns the quoted first column of the primary key of the downstream table.
+func (v *checksumVerifier) chunkKey(tctx *tcontext.Context, table *tableInfo) (string, error) {
+	col, err := checksum.PrimaryKeyColumn(tctx, v.target.querySQL, table.targetSchema, table.targetTable)
+	return col, terror.WithScope(err, terror.ScopeDownstream)
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockDBConn(t *testing.T) (*DBConn, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	return &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+	}, mock
+}
+
+func TestVerifyTableChecksum(t *testing.T) {
+	t.Parallel()
+
+	source, sourceMock := newMockDBConn(t)
+	target, targetMock := newMockDBConn(t)
+	verifier := &checksumVerifier{source: source, target: target, chunkSize: 2}
+	table := &tableInfo{
+		sourceSchema:   "db",
+		sourceTable:    "tbl",
+		targetSchema:   "db",
+		targetTable:    "tbl_1",
+		columnNameList: []string{"id", "name", "source"},
+		extendCol:      []string{"source"},
+	}
+
+	targetMock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "tbl_1").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+	// first chunk: (-inf, 2]
+	targetMock.ExpectQuery("SELECT `id` FROM `db`.`tbl_1` ORDER BY `id` LIMIT 1 OFFSET 1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("2"))
+	sourceMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl` WHERE `id` <= \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(2, 123))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).*CONCAT_WS\\(',', `id`, `name`, .* FROM `db`.`tbl_1` WHERE `id` <= \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(2, 123))
+	// last chunk: (2, +inf)
+	targetMock.ExpectQuery("SELECT `id` FROM `db`.`tbl_1` WHERE `id` > \\? ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sourceMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl` WHERE `id` > \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(1, 456))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl_1` WHERE `id` > \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(1, 789))
+
+	result, err := verifier.verifyTable(tcontext.Background(), table)
+	require.NoError(t, err)
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, "`db`.`tbl`", result.SourceTable)
+	require.Equal(t, "`db`.`tbl_1`", result.TargetTable)
+	require.Equal(t, 2, result.Chunks)
+	require.Equal(t, []ChecksumMismatch{{
+		Range:          "`id` > '2'",
+		SourceCount:    1,
+		TargetCount:    1,
+		SourceChecksum: 456,
+		TargetChecksum: 789,
+	}}, result.Mismatches)
+}
+
+func TestVerifyTableChecksumWithoutPrimaryKey(t *testing.T) {
+	t.Parallel()
+
+	source, sourceMock := newMockDBConn(t)
+	target, targetMock := newMockDBConn(t)
+	verifier := &checksumVerifier{source: source, target: target, chunkSize: 2}
+	table := &tableInfo{
+		sourceSchema:   "db",
+		sourceTable:    "tbl",
+		targetSchema:   "db",
+		targetTable:    "tbl",
+		columnNameList: []string{"a"},
+	}
+
+	targetMock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "tbl").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}))
+	sourceMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl`$").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(3, 42))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl`$").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(3, 42))
+
+	result, err := verifier.verifyTable(tcontext.Background(), table)
+	require.NoError(t, err)
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, 1, result.Chunks)
+	require.Empty(t, result.Mismatches)
+}
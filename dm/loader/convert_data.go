@@ -313,7 +313,13 @@ func reassemble(data []byte, table *tableInfo, columnMapping *cm.Mapping) (strin
 		return "", err
 	}
 
-	query := bytes.NewBuffer(make([]byte, 0, len(data)))
+	return assembleInsert(table, rows, len(data)), nil
+}
+
+// assembleInsert generates the INSERT statement of the rows, sizeHint is the
+// expected length of the statement.
+func assembleInsert(table *tableInfo, rows [][]string, sizeHint int) string {
+	query := bytes.NewBuffer(make([]byte, 0, sizeHint))
 	fmt.Fprint(query, table.insertHeadStmt)
 	seq := ","
 
@@ -325,5 +331,5 @@ func reassemble(data []byte, table *tableInfo, columnMapping *cm.Mapping) (strin
 		fmt.Fprintf(query, "(%s)%s", strings.Join(row, ","), seq)
 	}
 
-	return query.String(), nil
+	return query.String()
 }
@@ -0,0 +1,219 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// cursorName is the alias of the table opened by the cursor of a connection,
+// a connection scans at most one table by a cursor at a time.
+const cursorName = "`dm_loader_cursor`"
+
+// cursorCloseTimeout is the timeout of closing a cursor, the cursor is closed
+// even if the scan is canceled, otherwise the alias can't be opened again on
+// the connection.
+var cursorCloseTimeout = 10 * time.Second
+
+// tableCursor is the option of scanning the downstream tables by server-side
+// cursors. The cursor of a table is opened by HANDLER statements, which read
+// the rows in batches on the connection, so neither the client nor the server
+// holds the whole result of a scan. It can be shared by connections.
+type tableCursor struct {
+	batchSize int
+	// unsupported is true if the downstream doesn't support HANDLER statements,
+	// the tables are scanned by queries after that.
+	unsupported atomic.Bool
+}
+
+// newTableCursor creates a tableCursor fetching batchSize rows per batch, it
+// returns nil if cursor-batch-size-logical is not set.
+func newTableCursor(batchSize int) *tableCursor {
+	if batchSize <= 0 {
+		return nil
+	}
+	return &tableCursor{batchSize: batchSize}
+}
+
+// isErrCursorUnsupported returns whether the error means the downstream doesn't
+// support HANDLER statements, TiDB fails to parse them, and some storage
+// engines don't support them.
+func isErrCursorUnsupported(err error) bool {
+	return conn.IsMySQLError(err, tmysql.ErrParse) ||
+		conn.IsMySQLError(err, tmysql.ErrNotSupportedYet) ||
+		conn.IsMySQLError(err, tmysql.ErrIllegalHa)
+}
+
+// scanTable reads at most limit rows of the table and calls fn with the values
+// of columns of each row in order, the values are only valid in fn, and the
+// scan stops if fn returns false. The rows are read by a server-side cursor if
+// the connection has a tableCursor and the downstream supports it, otherwise
+// they're streamed by a query.
+func (conn *DBConn) scanTable(
+	tctx *tcontext.Context,
+	schema, table string,
+	columns []string,
+	limit int,
+	fn func(values []sql.RawBytes) bool,
+) error {
+	if conn == nil || conn.baseConn == nil {
+		return terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+	if conn.cursor != nil && !conn.cursor.unsupported.Load() {
+		scanned, err := conn.scanTableByCursor(tctx, schema, table, columns, limit, fn)
+		if scanned || err != nil {
+			return err
+		}
+	}
+
+	rows, err := conn.querySQL(tctx, fmt.Sprintf("SELECT %s FROM %s LIMIT %d",
+		quoteColumns(columns), tableName(schema, table), limit))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	_, _, err = scanColumns(rows, columns, fn)
+	return terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+}
+
+// scanTableByCursor scans the table by the cursor, it returns false if the
+// downstream doesn't support cursors. The cursor is closed when the scan ends,
+// whether it succeeds or not.
+func (conn *DBConn) scanTableByCursor(
+	tctx *tcontext.Context,
+	schema, table string,
+	columns []string,
+	limit int,
+	fn func(values []sql.RawBytes) bool,
+) (bool, error) {
+	err := conn.execCursor(tctx, fmt.Sprintf("HANDLER %s OPEN AS %s", tableName(schema, table), cursorName))
+	if err != nil {
+		if !isErrCursorUnsupported(err) {
+			return false, err
+		}
+		if conn.cursor.unsupported.CompareAndSwap(false, true) {
+			tctx.L().Warn("downstream doesn't support server-side cursors, scan tables by queries",
+				zap.String("table", tableName(schema, table)), log.ShortError(err))
+		}
+		return false, nil
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cursorCloseTimeout)
+		defer cancel()
+		if err2 := conn.execCursor(tctx.WithContext(ctx), "HANDLER "+cursorName+" CLOSE"); err2 != nil {
+			tctx.L().Warn("fail to close the cursor of table",
+				zap.String("table", tableName(schema, table)), log.ShortError(err2))
+		}
+	}()
+
+	read := "FIRST"
+	for scanned := 0; scanned < limit; {
+		batchSize := conn.cursor.batchSize
+		if limit-scanned < batchSize {
+			batchSize = limit - scanned
+		}
+		n, stopped, err := conn.fetchCursor(tctx,
+			fmt.Sprintf("HANDLER %s READ %s LIMIT %d", cursorName, read, batchSize), columns, fn)
+		if err != nil {
+			return true, err
+		}
+		scanned += n
+		if stopped || n < batchSize {
+			break
+		}
+		read = "NEXT"
+	}
+	return true, nil
+}
+
+// execCursor executes a HANDLER statement which returns no rows, it's not
+// retried since the cursor is lost after the connection is reset.
+func (conn *DBConn) execCursor(tctx *tcontext.Context, query string) error {
+	rows, err := conn.baseConn.QuerySQL(tctx, query)
+	if err != nil {
+		return err
+	}
+	return terror.DBErrorAdapt(rows.Close(), conn.Scope(), terror.ErrDBDriverError)
+}
+
+// fetchCursor fetches a batch of rows by the cursor, it returns the number of
+// rows fetched, and whether fn stops the scan.
+func (conn *DBConn) fetchCursor(
+	tctx *tcontext.Context, query string, columns []string, fn func(values []sql.RawBytes) bool,
+) (int, bool, error) {
+	rows, err := conn.baseConn.QuerySQL(tctx, query)
+	if err != nil {
+		return 0, false, err
+	}
+	defer rows.Close()
+	n, stopped, err := scanColumns(rows, columns, fn)
+	return n, stopped, terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+}
+
+// scanColumns calls fn with the values of columns of each row, the rows may
+// have other columns, e.g. the rows read by a cursor have all the columns of
+// the table. It returns the number of rows scanned, and whether fn stops the scan.
+func scanColumns(rows *sql.Rows, columns []string, fn func(values []sql.RawBytes) bool) (int, bool, error) {
+	names, err := rows.Columns()
+	if err != nil {
+		return 0, false, err
+	}
+	indexes := make([]int, len(columns))
+	for i, col := range columns {
+		indexes[i] = -1
+		for j, name := range names {
+			if strings.EqualFold(name, col) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return 0, false, errors.Errorf("column %s is not in the result", col)
+		}
+	}
+
+	values := make([]sql.RawBytes, len(names))
+	dest := make([]interface{}, len(names))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	picked := make([]sql.RawBytes, len(columns))
+	n := 0
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return n, false, err
+		}
+		n++
+		for i, idx := range indexes {
+			picked[i] = values[idx]
+		}
+		if !fn(picked) {
+			return n, true, nil
+		}
+	}
+	return n, false, rows.Err()
+}
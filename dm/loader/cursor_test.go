@@ -0,0 +1,148 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/stretchr/testify/require"
+)
+
+func expectCursor(mock sqlmock.Sqlmock, query string) *sqlmock.ExpectedQuery {
+	return mock.ExpectQuery(regexp.QuoteMeta(query))
+}
+
+func collectValues(collected *[]string, limit int) func(values []sql.RawBytes) bool {
+	return func(values []sql.RawBytes) bool {
+		*collected = append(*collected, string(values[0]))
+		return len(*collected) < limit
+	}
+}
+
+func TestScanTableByCursor(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, newTableCursor(0))
+	dbConn, mock := newMockDBConn(t)
+	dbConn.cursor = newTableCursor(2)
+	tctx := tcontext.Background()
+
+	// the rows are fetched in batches, and the columns are picked from the rows.
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").WillReturnRows(sqlmock.NewRows(nil))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ FIRST LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "id"}).AddRow("a", "1").AddRow("b", "2"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ NEXT LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "id"}).AddRow("c", "3").AddRow("d", "4"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ NEXT LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "id"}).AddRow("e", "5"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` CLOSE").WillReturnRows(sqlmock.NewRows(nil))
+	var ids []string
+	require.NoError(t, dbConn.scanTable(tctx, "db", "tbl", []string{"ID"}, 10, collectValues(&ids, 10)))
+	require.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the last batch is bounded by the limit.
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").WillReturnRows(sqlmock.NewRows(nil))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ FIRST LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ NEXT LIMIT 1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("3"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` CLOSE").WillReturnRows(sqlmock.NewRows(nil))
+	ids = nil
+	require.NoError(t, dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 3, collectValues(&ids, 10)))
+	require.Equal(t, []string{"1", "2", "3"}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the cursor is closed if the scan is stopped.
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").WillReturnRows(sqlmock.NewRows(nil))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ FIRST LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` CLOSE").WillReturnRows(sqlmock.NewRows(nil))
+	ids = nil
+	require.NoError(t, dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 1)))
+	require.Equal(t, []string{"1"}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the cursor is closed if the scan fails.
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").WillReturnRows(sqlmock.NewRows(nil))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ FIRST LIMIT 2").WillReturnError(errors.New("read error"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` CLOSE").WillReturnRows(sqlmock.NewRows(nil))
+	err := dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 10))
+	require.ErrorContains(t, err, "read error")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the column not in the rows fails the scan.
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").WillReturnRows(sqlmock.NewRows(nil))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` READ FIRST LIMIT 2").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+	expectCursor(mock, "HANDLER `dm_loader_cursor` CLOSE").WillReturnRows(sqlmock.NewRows(nil))
+	err = dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 10))
+	require.ErrorContains(t, err, "column id is not in the result")
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.False(t, dbConn.cursor.unsupported.Load())
+}
+
+func TestScanTableFallback(t *testing.T) {
+	t.Parallel()
+
+	dbConn, mock := newMockDBConn(t)
+	tctx := tcontext.Background()
+
+	// the rows are streamed by a query without a cursor.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `db`.`tbl` LIMIT 10")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2"))
+	var ids []string
+	require.NoError(t, dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 10)))
+	require.Equal(t, []string{"1", "2"}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the downstream doesn't support cursors, e.g. TiDB fails to parse HANDLER.
+	cursor := newTableCursor(2)
+	dbConn.cursor = cursor
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").
+		WillReturnError(&mysql.MySQLError{Number: tmysql.ErrParse, Message: "You have an error in your SQL syntax"})
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `db`.`tbl` LIMIT 10")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2").AddRow("3"))
+	ids = nil
+	require.NoError(t, dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 2)))
+	require.Equal(t, []string{"1", "2"}, ids)
+	require.True(t, cursor.unsupported.Load())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the cursor is not tried again, which is shared by the connections.
+	other, mock2 := newMockDBConn(t)
+	other.cursor = cursor
+	mock2.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `db`.`tbl2` LIMIT 10")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	ids = nil
+	require.NoError(t, other.scanTable(tctx, "db", "tbl2", []string{"id"}, 10, collectValues(&ids, 10)))
+	require.Equal(t, []string{"1"}, ids)
+	require.NoError(t, mock2.ExpectationsWereMet())
+
+	// other errors of opening the cursor fail the scan.
+	dbConn.cursor = newTableCursor(2)
+	expectCursor(mock, "HANDLER `db`.`tbl` OPEN AS `dm_loader_cursor`").
+		WillReturnError(&mysql.MySQLError{Number: tmysql.ErrNoSuchTable, Message: "Table 'db.tbl' doesn't exist"})
+	err := dbConn.scanTable(tctx, "db", "tbl", []string{"id"}, 10, collectValues(&ids, 10))
+	require.ErrorContains(t, err, "doesn't exist")
+	require.False(t, dbConn.cursor.unsupported.Load())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
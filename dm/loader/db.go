@@ -17,10 +17,12 @@ import (
 	"database/sql"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/errno"
 	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tiflow/dm/config"
@@ -31,14 +33,188 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
+// DeadlockInfo records a deadlock met when executing statements and how it's resolved.
+type DeadlockInfo struct {
+	Time    time.Time
+	Queries []string
+	Error   string
+	// RetryCount is the number of retries caused by deadlocks before the statements succeed or fail.
+	RetryCount int
+	Resolved   bool
+	// Delay is the duration from meeting the first deadlock to finishing the retries.
+	Delay time.Duration
+}
+
+// deadlockRecorder records the last deadlock met by the connections, it's thread-safe.
+type deadlockRecorder struct {
+	mu   sync.Mutex
+	last *DeadlockInfo
+}
+
+func (r *deadlockRecorder) record(info *DeadlockInfo) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = info
+}
+
+func (r *deadlockRecorder) lastInfo() *DeadlockInfo {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.last == nil {
+		return nil
+	}
+	info := *r.last
+	return &info
+}
+
+// connResetWindow is the window to calculate the success ratio of resets.
+const connResetWindow = time.Minute
+
+// the backoff of retrying write conflicts increases linearly from
+// writeConflictBackoff to writeConflictMaxBackoff.
+const (
+	writeConflictBackoff    = 100 * time.Millisecond
+	writeConflictMaxBackoff = time.Second
+)
+
+type connResetResult struct {
+	at      time.Time
+	success bool
+}
+
+// connResetTracker tracks the resets of the connections sharing it, so that
+// the recovery of the connections from a downstream outage can be observed.
+// A reset storm starts from the first reset when all connections are healthy,
+// and ends when no reset is in progress and the last reset of every connection
+// succeeded. It's thread-safe.
+type connResetTracker struct {
+	task     string
+	sourceID string
+	now      func() time.Time
+
+	mu        sync.Mutex
+	resetting int
+	// broken are the connections whose last reset failed.
+	broken     map[*DBConn]struct{}
+	stormStart time.Time
+	// results are the results of resets in the last connResetWindow, in time order.
+	results []connResetResult
+}
+
+func newConnResetTracker(task, sourceID string) *connResetTracker {
+	return &connResetTracker{
+		task:     task,
+		sourceID: sourceID,
+		now:      time.Now,
+		broken:   make(map[*DBConn]struct{}),
+	}
+}
+
+func (t *connResetTracker) begin() {
+	if t == nil {
+		return
+	}
+	connResettingGauge.WithLabelValues(t.task, t.sourceID).Inc()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.resetting == 0 && len(t.broken) == 0 {
+		t.stormStart = t.now()
+	}
+	t.resetting++
+}
+
+func (t *connResetTracker) finish(conn *DBConn, err error) {
+	if t == nil {
+		return
+	}
+	connResettingGauge.WithLabelValues(t.task, t.sourceID).Dec()
+	result := "success"
+	if err != nil {
+		result = "fail"
+	}
+	connResetCounter.WithLabelValues(t.task, t.sourceID, result).Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.resetting--
+	if err != nil {
+		t.broken[conn] = struct{}{}
+	} else {
+		delete(t.broken, conn)
+	}
+
+	t.results = append(t.results, connResetResult{at: now, success: err == nil})
+	expired := 0
+	for expired < len(t.results) && now.Sub(t.results[expired].at) > connResetWindow {
+		expired++
+	}
+	t.results = t.results[expired:]
+	succeeded := 0
+	for _, r := range t.results {
+		if r.success {
+			succeeded++
+		}
+	}
+	connResetSuccessRatioGauge.WithLabelValues(t.task, t.sourceID).Set(float64(succeeded) / float64(len(t.results)))
+
+	if t.resetting == 0 && len(t.broken) == 0 && !t.stormStart.IsZero() {
+		connResetRecoveryHistogram.WithLabelValues(t.task, t.sourceID).Observe(now.Sub(t.stormStart).Seconds())
+		t.stormStart = time.Time{}
+	}
+}
+
 // DBConn represents a live DB connection
 // it's not thread-safe.
 type DBConn struct {
 	name     string
 	sourceID string
 	baseConn *conn.BaseConn
+	// deadlocks records the last deadlock, it can be shared by connections.
+	deadlocks *deadlockRecorder
+	// throttle limits the rate of executing transactions, it can be shared by connections.
+	throttle *loadThrottle
+	// resets tracks the resets of the connection, it can be shared by connections.
+	resets *connResetTracker
+	// plans captures the plans of slow queries, it can be shared by connections.
+	plans *slowQueryPlanCapturer
+	// retries tunes the retry params of the statements, it can be shared by connections.
+	retries *retryTuner
+	// report collects the executions of the statements for the load report, it can be shared by connections.
+	report *loadReportRecorder
+	// idempotency writes the idempotency keys of transactions, it can be shared by connections.
+	idempotency *idempotencyKeeper
+	// writeConflictRetries is the max number of times a transaction is retried on the
+	// connection when it meets write conflicts, 0 to retry them like other retryable errors.
+	writeConflictRetries int
+	// cursor scans the tables by server-side cursors in scanTable, it can be shared by connections.
+	cursor *tableCursor
+
+	// preparedQueries are the statements pre-registered by PrepareStatements,
+	// they are prepared again after the connection is reset.
+	preparedQueries []string
+	// preparedStmtsEvicted tells whether the prepared statements have been
+	// evicted because max_prepared_stmt_count of the downstream is exhausted,
+	// prepared statements are not used in the connection after that.
+	preparedStmtsEvicted bool
+	// timeZone is the session time zone set by SetTimeZone, it's set again
+	// after the connection is reset, so that TIMESTAMP values are never
+	// loaded with the default time zone of the downstream.
+	timeZone string
+	// sqlMode is the session sql_mode set by SetSQLMode, it's set again after
+	// the connection is reset. hasSQLMode tells whether it's set, since an
+	// empty sql_mode is valid.
+	sqlMode    string
+	hasSQLMode bool
 
 	// generate new BaseConn and close old one
 	resetBaseConnFn func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error)
@@ -52,36 +228,38 @@ func (conn *DBConn) Scope() terror.ErrScope {
 	return conn.baseConn.Scope
 }
 
+// querySQL queries the downstream with retries. The returned rows are read from
+// the connection lazily when iterating, so a large result set is not buffered
+// as a whole by the client, but the connection is occupied until the rows are
+// closed. Huge tables should be scanned by scanTable which reads the rows by a
+// server-side cursor in batches, or split into chunks by the caller, see
+// checksum.ChunkUpperBound.
 func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	if conn == nil || conn.baseConn == nil {
 		return nil, terror.ErrDBUnExpect.Generate("database connection not valid")
 	}
 
-	params := retry.Params{
-		RetryCount:         10,
-		FirstRetryDuration: time.Second,
-		BackoffStrategy:    retry.Stable,
-		IsRetryableFn: func(retryTime int, err error) bool {
-			if retry.IsConnectionError(err) {
-				err = conn.resetConn(ctx)
-				if err != nil {
-					ctx.L().Error("reset connection failed", zap.Int("retry", retryTime),
-						zap.String("query", utils.TruncateInterface(query, -1)),
-						zap.String("arguments", utils.TruncateInterface(args, -1)),
-						log.ShortError(err))
-					return false
-				}
-				return true
-			}
-			if dbutil.IsRetryableError(err) {
-				ctx.L().Warn("query statement", zap.Int("retry", retryTime),
-					zap.String("query", utils.TruncateString(query, -1)),
-					zap.String("argument", utils.TruncateInterface(args, -1)),
+	params := conn.retries.tune(queryRetryParams)
+	params.IsRetryableFn = func(retryTime int, err error) bool {
+		if retry.IsConnectionError(err) {
+			err = conn.resetConn(ctx)
+			if err != nil {
+				ctx.L().Error("reset connection failed", zap.Int("retry", retryTime),
+					zap.String("query", utils.TruncateInterface(query, -1)),
+					zap.String("arguments", utils.TruncateInterface(args, -1)),
 					log.ShortError(err))
-				return true
+				return false
 			}
-			return false
-		},
+			return true
+		}
+		if dbutil.IsRetryableError(err) {
+			ctx.L().Warn("query statement", zap.Int("retry", retryTime),
+				zap.String("query", utils.TruncateString(query, -1)),
+				zap.String("argument", utils.TruncateInterface(args, -1)),
+				log.ShortError(err))
+			return true
+		}
+		return false
 	}
 
 	ret, _, err := conn.baseConn.ApplyRetryStrategy(
@@ -90,10 +268,11 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 		func(ctx *tcontext.Context) (interface{}, error) {
 			startTime := time.Now()
 			ret, err := conn.baseConn.QuerySQL(ctx, query, args...)
+			if err == nil && ret.Err() != nil {
+				err = ret.Err()
+			}
+			conn.retries.record(err)
 			if err == nil {
-				if ret.Err() != nil {
-					return ret, ret.Err()
-				}
 				cost := time.Since(startTime)
 				// duration seconds
 				ds := cost.Seconds()
@@ -103,6 +282,7 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 						zap.Duration("cost time", cost),
 						zap.String("query", utils.TruncateString(query, -1)),
 						zap.String("argument", utils.TruncateInterface(args, -1)))
+					conn.plans.capture(query, args, cost)
 				}
 			}
 			return ret, err
@@ -118,93 +298,499 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 }
 
 func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...[]interface{}) error {
+	_, err := conn.execute(ctx, queries, false, "", args...)
+	return err
+}
+
+// executeSQLWithTimings is the same as executeSQL, but also returns the
+// elapsed time of each statement in the successful execution. It's used to
+// find the slow statements in a batch, and it's opt-in to avoid the overhead
+// of timing on the hot path.
+func (conn *DBConn) executeSQLWithTimings(ctx *tcontext.Context, queries []string, args ...[]interface{}) ([]time.Duration, error) {
+	return conn.execute(ctx, queries, true, "", args...)
+}
+
+// executeSQLIdempotently is the same as executeSQL, but also writes the
+// idempotency key in the transaction, and skips the transaction if the key
+// exists when it's retried after an ambiguous failure. The elapsed time of
+// each statement is returned if withTimings is true.
+func (conn *DBConn) executeSQLIdempotently(ctx *tcontext.Context, key string, withTimings bool, queries []string, args ...[]interface{}) ([]time.Duration, error) {
+	return conn.execute(ctx, queries, withTimings, key, args...)
+}
+
+func (conn *DBConn) execute(ctx *tcontext.Context, queries []string, withTimings bool, idempotencyKey string, args ...[]interface{}) ([]time.Duration, error) {
 	if len(queries) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	if conn == nil || conn.baseConn == nil {
-		return terror.ErrDBUnExpect.Generate("database connection not valid")
+		return nil, terror.ErrDBUnExpect.Generate("database connection not valid")
 	}
 
-	params := retry.Params{
-		RetryCount:         10,
-		FirstRetryDuration: 2 * time.Second,
-		BackoffStrategy:    retry.LinearIncrease,
-		IsRetryableFn: func(retryTime int, err error) bool {
-			tidbExecutionErrorCounter.WithLabelValues(conn.name, conn.sourceID).Inc()
-			if retry.IsConnectionError(err) {
-				err = conn.resetConn(ctx)
-				if err != nil {
-					ctx.L().Error("reset connection failed", zap.Int("retry", retryTime),
-						zap.String("queries", utils.TruncateInterface(queries, -1)),
-						zap.String("arguments", utils.TruncateInterface(args, -1)),
-						log.ShortError(err))
-					return false
-				}
-				return true
+	if idempotencyKey != "" {
+		if conn.idempotency == nil {
+			return nil, terror.ErrDBUnExpect.Generate("idempotency key is not enabled")
+		}
+		insert, insertArgs := conn.idempotency.insertSQL(idempotencyKey)
+		fullArgs := make([][]interface{}, len(queries)+1)
+		copy(fullArgs, args)
+		fullArgs[len(queries)] = insertArgs
+		queries = append(slices.Clone(queries), insert)
+		args = fullArgs
+	}
+
+	if err := conn.throttle.wait(ctx); err != nil {
+		return nil, terror.ErrDBExecuteFailed.Delegate(err, "wait for rate limit")
+	}
+
+	var (
+		deadlock *DeadlockInfo
+		// ambiguous is true if a failed attempt may be committed, since the
+		// connection is broken before the result of committing is received.
+		ambiguous bool
+	)
+	params := conn.retries.tune(executeRetryParams)
+	params.IsRetryableFn = func(retryTime int, err error) bool {
+		tidbExecutionErrorCounter.WithLabelValues(conn.name, conn.sourceID).Inc()
+		ambiguous = ambiguous || retry.IsConnectionError(err)
+		if conn.writeConflictRetries > 0 && isErrWriteConflict(err) {
+			// the write conflicts have been retried on the connection.
+			return false
+		}
+		if isErrDeadlock(err) {
+			deadlockCounter.WithLabelValues(conn.name, conn.sourceID).Inc()
+			if deadlock == nil {
+				deadlock = &DeadlockInfo{Time: time.Now(), Queries: append([]string(nil), queries...)}
 			}
-			if dbutil.IsRetryableError(err) {
-				ctx.L().Warn("execute statements", zap.Int("retry", retryTime),
+			deadlock.Error = err.Error()
+			deadlock.RetryCount++
+		}
+		if retry.IsConnectionError(err) {
+			err = conn.resetConn(ctx)
+			if err != nil {
+				ctx.L().Error("reset connection failed", zap.Int("retry", retryTime),
 					zap.String("queries", utils.TruncateInterface(queries, -1)),
 					zap.String("arguments", utils.TruncateInterface(args, -1)),
 					log.ShortError(err))
-				return true
+				return false
 			}
-			return false
-		},
+			return true
+		}
+		if dbutil.IsRetryableError(err) {
+			ctx.L().Warn("execute statements", zap.Int("retry", retryTime),
+				zap.String("queries", utils.TruncateInterface(queries, -1)),
+				zap.String("arguments", utils.TruncateInterface(args, -1)),
+				log.ShortError(err))
+			return true
+		}
+		return false
 	}
 
+	var (
+		timings            []time.Duration
+		attempts, failures int
+	)
 	_, _, err := conn.baseConn.ApplyRetryStrategy(
 		ctx,
 		params,
 		func(ctx *tcontext.Context) (interface{}, error) {
+			attempts++
+			if ambiguous && idempotencyKey != "" {
+				committed, err := conn.idempotency.committed(ctx, conn.baseConn, idempotencyKey)
+				if err != nil {
+					conn.retries.record(err)
+					failures++
+					return nil, err
+				}
+				if committed {
+					timings = nil
+					return nil, nil
+				}
+			}
 			startTime := time.Now()
-			_, err := conn.baseConn.ExecuteSQL(ctx, stmtHistogram, conn.name, queries, args...)
-			failpoint.Inject("LoadExecCreateTableFailed", func(val failpoint.Value) {
-				errCode, err1 := strconv.ParseUint(val.(string), 10, 16)
-				if err1 != nil {
-					ctx.L().Fatal("failpoint LoadExecCreateTableFailed's value is invalid", zap.String("val", val.(string)))
+			var err error
+			for writeConflicts := 0; ; {
+				if withTimings {
+					timings, err = conn.baseConn.ExecuteSQLWithTimings(ctx, stmtHistogram, conn.name, queries, args...)
+				} else {
+					_, err = conn.baseConn.ExecuteSQL(ctx, stmtHistogram, conn.name, queries, args...)
 				}
+				failpoint.Inject("LoadExecCreateTableFailed", func(val failpoint.Value) {
+					errCode, err1 := strconv.ParseUint(val.(string), 10, 16)
+					if err1 != nil {
+						ctx.L().Fatal("failpoint LoadExecCreateTableFailed's value is invalid", zap.String("val", val.(string)))
+					}
 
-				if len(queries) == 1 && strings.Contains(queries[0], "CREATE TABLE") {
-					err = &mysql.MySQLError{Number: uint16(errCode), Message: ""}
-					ctx.L().Warn("executeSQL failed", zap.String("failpoint", "LoadExecCreateTableFailed"), zap.Error(err))
+					if len(queries) == 1 && strings.Contains(queries[0], "CREATE TABLE") {
+						err = &mysql.MySQLError{Number: uint16(errCode), Message: ""}
+						ctx.L().Warn("executeSQL failed", zap.String("failpoint", "LoadExecCreateTableFailed"), zap.Error(err))
+					}
+				})
+				// after evicting the prepared statements, the statements are
+				// retried at once without them, which happens once at most.
+				if !conn.evictPreparedStmts(ctx, err) {
+					if !conn.waitWriteConflictRetry(ctx, err, writeConflicts, queries) {
+						break
+					}
+					writeConflicts++
 				}
-			})
+				attempts++
+				failures++
+			}
+			conn.retries.record(err)
 			if err == nil {
 				cost := time.Since(startTime)
 				// duration seconds
 				ds := cost.Seconds()
 				if ds > 1 {
-					ctx.L().Warn("execute transaction too slow",
+					fields := []zap.Field{
 						zap.Duration("cost time", cost),
 						zap.String("query", utils.TruncateInterface(queries, -1)),
-						zap.String("argument", utils.TruncateInterface(args, -1)))
+						zap.String("argument", utils.TruncateInterface(args, -1)),
+					}
+					if slowest := slowestStatement(timings); slowest >= 0 {
+						fields = append(fields,
+							zap.Int("slowest statement index", slowest),
+							zap.Duration("slowest statement cost time", timings[slowest]))
+					}
+					ctx.L().Warn("execute transaction too slow", fields...)
 				}
+			} else {
+				failures++
 			}
 			return nil, err
 		})
+	conn.report.recordExecution(attempts, failures)
+	if deadlock != nil {
+		deadlock.Resolved = err == nil
+		deadlock.Delay = time.Since(deadlock.Time)
+		deadlockRetryDelayHistogram.WithLabelValues(conn.name, conn.sourceID).Observe(deadlock.Delay.Seconds())
+		conn.deadlocks.record(deadlock)
+		ctx.L().Warn("execute statements met deadlock",
+			zap.String("queries", utils.TruncateInterface(queries, -1)),
+			zap.Int("retry count", deadlock.RetryCount),
+			zap.Bool("resolved", deadlock.Resolved),
+			zap.Duration("delay", deadlock.Delay))
+	}
 	if err != nil {
 		ctx.L().ErrorFilterContextCanceled("execute statements failed after retry",
 			zap.String("queries", utils.TruncateInterface(queries, -1)),
 			zap.String("arguments", utils.TruncateInterface(args, -1)),
 			log.ShortError(err))
+		return nil, err
+	}
+
+	return timings, nil
+}
+
+// waitWriteConflictRetry returns whether the transaction which fails with err
+// should be retried on the connection because of a write conflict, and waits
+// for the backoff if it should. `retried` is the number of times it has been
+// retried for write conflicts. The conflicting transaction usually commits
+// soon, so the backoff is much shorter than the one of other errors.
+func (conn *DBConn) waitWriteConflictRetry(ctx *tcontext.Context, err error, retried int, queries []string) bool {
+	if conn.writeConflictRetries <= 0 || !isErrWriteConflict(err) {
+		return false
+	}
+	writeConflictCounter.WithLabelValues(conn.name, conn.sourceID).Inc()
+	if retried >= conn.writeConflictRetries {
+		return false
+	}
+	backoff := time.Duration(retried+1) * writeConflictBackoff
+	if backoff > writeConflictMaxBackoff {
+		backoff = writeConflictMaxBackoff
+	}
+	ctx.L().Warn("execute statements met write conflict, retry it",
+		zap.Int("retry", retried),
+		zap.Duration("backoff", backoff),
+		zap.String("queries", utils.TruncateInterface(queries, -1)),
+		log.ShortError(err))
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Context().Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// evictPreparedStmts evicts the prepared statements of the connection if err
+// is caused by exhausting max_prepared_stmt_count of the downstream, and stops
+// using prepared statements in the connection. Statements leaked by other
+// clients can exhaust the limit too, so the load falls back to executing the
+// statements directly instead of failing. It returns whether the statements
+// should be retried without prepared statements.
+func (conn *DBConn) evictPreparedStmts(ctx *tcontext.Context, err error) bool {
+	if conn.preparedStmtsEvicted || !isErrMaxPreparedStmtCount(err) {
+		return false
+	}
+	// closing the statements deallocates them in the downstream.
+	evicted := conn.baseConn.EvictPreparedStmts()
+	conn.preparedQueries = nil
+	conn.preparedStmtsEvicted = true
+	preparedStmtEvictionCounter.WithLabelValues(conn.name, conn.sourceID).Inc()
+	ctx.L().Warn("max_prepared_stmt_count of the downstream is exhausted, evict the prepared statements and execute statements without them",
+		zap.Int("evicted statements", evicted),
+		log.ShortError(err))
+	return true
+}
+
+// slowestStatement returns the index of the largest timing, or -1 if
+// timings is empty.
+func slowestStatement(timings []time.Duration) int {
+	slowest := -1
+	for i, timing := range timings {
+		if slowest < 0 || timing > timings[slowest] {
+			slowest = i
+		}
+	}
+	return slowest
+}
+
+// EffectiveRetryParams returns the retry params currently used by querySQL and
+// executeSQL, which are tuned by the recent errors if adaptive-retry-logical is
+// enabled. IsRetryableFn of the returned params is not set.
+func (conn *DBConn) EffectiveRetryParams() (query, execute retry.Params) {
+	return conn.retries.tune(queryRetryParams), conn.retries.tune(executeRetryParams)
+}
+
+// PrepareStatements prepares the given statements in the connection, later
+// executions of the same queries in executeSQL will reuse the prepared statements.
+// The statements are prepared again after the connection is reset.
+// It's a no-op after the prepared statements are evicted, see evictPreparedStmts.
+func (conn *DBConn) PrepareStatements(queries []string) error {
+	if conn == nil || conn.baseConn == nil {
+		return terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+	if conn.preparedStmtsEvicted {
+		return nil
+	}
+	tctx := tcontext.Background()
+	if err := conn.baseConn.PrepareSQL(tctx, queries); err != nil {
+		if conn.evictPreparedStmts(tctx, err) {
+			return nil
+		}
+		return err
+	}
+	for _, query := range queries {
+		if !slices.Contains(conn.preparedQueries, query) {
+			conn.preparedQueries = append(conn.preparedQueries, query)
+		}
+	}
+	return nil
+}
+
+// SetTimeZone sets the session time zone of the connection, it returns
+// ErrLoadTimeZoneNotAccepted if the downstream doesn't accept the time zone.
+// The time zone is set again after the connection is reset.
+func (conn *DBConn) SetTimeZone(tctx *tcontext.Context, timeZone string) error {
+	if conn == nil || conn.baseConn == nil {
+		return terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+	if err := conn.applyTimeZone(tctx, timeZone); err != nil {
+		return err
+	}
+	conn.timeZone = timeZone
+	return nil
+}
+
+func (conn *DBConn) applyTimeZone(tctx *tcontext.Context, timeZone string) error {
+	_, err := conn.baseConn.ExecuteSQL(tctx, nil, conn.name,
+		[]string{"SET time_zone = ?"}, []interface{}{timeZone})
+	if err != nil {
+		if isErrUnknownTimeZone(err) {
+			return terror.ErrLoadTimeZoneNotAccepted.Delegate(err, timeZone)
+		}
+		return err
 	}
+	return nil
+}
 
+// SetSQLMode sets the session sql_mode of the connection. The sql_mode is set
+// again after the connection is reset.
+func (conn *DBConn) SetSQLMode(tctx *tcontext.Context, sqlMode string) error {
+	if conn == nil || conn.baseConn == nil {
+		return terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+	if err := conn.applySQLMode(tctx, sqlMode); err != nil {
+		return err
+	}
+	conn.sqlMode, conn.hasSQLMode = sqlMode, true
+	return nil
+}
+
+func (conn *DBConn) applySQLMode(tctx *tcontext.Context, sqlMode string) error {
+	_, err := conn.baseConn.ExecuteSQL(tctx, nil, conn.name,
+		[]string{"SET SESSION sql_mode = ?"}, []interface{}{sqlMode})
 	return err
 }
 
+// globalSQLMode returns the global sql_mode of the downstream, which is the
+// sql_mode of a new session if it's not set by the DSN.
+func (conn *DBConn) globalSQLMode(tctx *tcontext.Context) (string, error) {
+	rows, err := conn.querySQL(tctx, "SELECT @@GLOBAL.sql_mode")
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	var sqlMode string
+	if rows.Next() {
+		if err = rows.Scan(&sqlMode); err != nil {
+			return "", terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return "", terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+	}
+	return sqlMode, nil
+}
+
+// strictSQLModes are the modes removed by the auto-relax sql-mode-logical,
+// with them an invalid value fails the statement instead of being adjusted.
+var strictSQLModes = []string{
+	"STRICT_TRANS_TABLES",
+	"STRICT_ALL_TABLES",
+	"ONLY_FULL_GROUP_BY",
+	"NO_ZERO_IN_DATE",
+	"NO_ZERO_DATE",
+	"ERROR_FOR_DIVISION_BY_ZERO",
+}
+
+// relaxSQLMode removes the strict modes from sqlMode, it returns the relaxed
+// sql_mode and the removed modes.
+func relaxSQLMode(sqlMode string) (string, []string) {
+	var kept, removed []string
+	for _, mode := range strings.Split(sqlMode, ",") {
+		mode = strings.TrimSpace(mode)
+		if mode == "" {
+			continue
+		}
+		if slices.Contains(strictSQLModes, strings.ToUpper(mode)) {
+			removed = append(removed, mode)
+		} else {
+			kept = append(kept, mode)
+		}
+	}
+	return strings.Join(kept, ","), removed
+}
+
 // resetConn reset one worker connection from specify *BaseDB.
-func (conn *DBConn) resetConn(tctx *tcontext.Context) error {
+func (conn *DBConn) resetConn(tctx *tcontext.Context) (err error) {
+	conn.resets.begin()
+	defer func() {
+		conn.resets.finish(conn, err)
+	}()
 	baseConn, err := conn.resetBaseConnFn(tctx, conn.baseConn)
 	if err != nil {
 		return err
 	}
 	conn.baseConn = baseConn
+	if conn.timeZone != "" {
+		if err := conn.applyTimeZone(tctx, conn.timeZone); err != nil {
+			return err
+		}
+	}
+	if conn.hasSQLMode {
+		if err := conn.applySQLMode(tctx, conn.sqlMode); err != nil {
+			return err
+		}
+	}
+	if len(conn.preparedQueries) > 0 {
+		err = conn.baseConn.PrepareSQL(tctx, conn.preparedQueries)
+		if conn.evictPreparedStmts(tctx, err) {
+			return nil
+		}
+		return err
+	}
 	return nil
 }
 
+// createConns creates the write and read connections to the downstream. They
+// are got from two separate pools sized by writeCount and readCount, so that
+// reads and writes don't contend for the same connections. The read pool is
+// not created if readCount is 0.
 func createConns(tctx *tcontext.Context, cfg *config.SubTaskConfig,
+	name, sourceID string,
+	writeCount, readCount int,
+) (writeDB *conn.BaseDB, writeConns []*DBConn, readDB *conn.BaseDB, readConns []*DBConn, err error) {
+	writeDB, writeConns, err = createPoolConns(tctx, cfg, name, sourceID, writeCount)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if cfg.ClockSkewCheck != config.LoaderClockSkewCheckNone && len(writeConns) > 0 {
+		if err = checkClockSkew(tctx, cfg, writeConns[0]); err != nil {
+			if terr := writeDB.Close(); terr != nil {
+				tctx.L().Error("failed to close baseDB", zap.Error(terr))
+			}
+			return nil, nil, nil, nil, err
+		}
+	}
+	if readCount == 0 {
+		return writeDB, writeConns, nil, nil, nil
+	}
+	readDB, readConns, err = createPoolConns(tctx, cfg, name, sourceID, readCount)
+	if err != nil {
+		if terr := writeDB.Close(); terr != nil {
+			tctx.L().Error("failed to close baseDB", zap.Error(terr))
+		}
+		return nil, nil, nil, nil, err
+	}
+	return writeDB, writeConns, readDB, readConns, nil
+}
+
+// clockSkew returns the skew of the downstream clock from the local clock,
+// which is positive if the downstream is ahead. The local time is taken at the
+// middle of the query to offset the round trip.
+func (conn *DBConn) clockSkew(tctx *tcontext.Context) (time.Duration, error) {
+	before := time.Now()
+	rows, err := conn.querySQL(tctx, "SELECT UNIX_TIMESTAMP(NOW(6))")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var ts float64
+	if rows.Next() {
+		if err = rows.Scan(&ts); err != nil {
+			return 0, terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return 0, terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+	}
+	local := before.Add(time.Since(before) / 2)
+	downstream := time.Unix(0, int64(ts*float64(time.Second)))
+	return downstream.Sub(local), nil
+}
+
+// checkClockSkew measures the clock skew of the downstream, and logs a warning
+// or returns an error according to clock-skew-check if it exceeds the threshold.
+func checkClockSkew(tctx *tcontext.Context, cfg *config.SubTaskConfig, dbConn *DBConn) error {
+	skew, err := dbConn.clockSkew(tctx)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	clockSkewGauge.WithLabelValues(cfg.Name, cfg.SourceID).Set(skew.Seconds())
+	abs := skew
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs <= cfg.ClockSkewThreshold.Duration {
+		tctx.L().Info("clock skew of the downstream is checked", zap.Duration("skew", skew))
+		return nil
+	}
+	if cfg.ClockSkewCheck == config.LoaderClockSkewCheckFail {
+		return terror.ErrLoadDownstreamClockSkew.Generate(skew, cfg.ClockSkewThreshold.Duration)
+	}
+	tctx.L().Warn("clock of the downstream is skewed",
+		zap.Duration("skew", skew),
+		zap.Duration("threshold", cfg.ClockSkewThreshold.Duration))
+	return nil
+}
+
+// createPoolConns creates a connection pool to the downstream and gets
+// `workerCount` connections from it.
+func createPoolConns(tctx *tcontext.Context, cfg *config.SubTaskConfig,
 	name, sourceID string,
 	workerCount int,
 ) (*conn.BaseDB, []*DBConn, error) {
@@ -242,6 +828,22 @@ func isErrTableExists(err error) bool {
 	return conn.IsMySQLError(err, tmysql.ErrTableExists)
 }
 
+func isErrDeadlock(err error) bool {
+	return conn.IsMySQLError(err, tmysql.ErrLockDeadlock)
+}
+
+func isErrWriteConflict(err error) bool {
+	return conn.IsMySQLError(err, errno.ErrWriteConflict)
+}
+
+func isErrMaxPreparedStmtCount(err error) bool {
+	return conn.IsMySQLError(err, errno.ErrMaxPreparedStmtCountReached)
+}
+
+func isErrUnknownTimeZone(err error) bool {
+	return conn.IsMySQLError(err, tmysql.ErrUnknownTimeZone)
+}
+
 func isErrDupEntry(err error) bool {
 	return conn.IsMySQLError(err, tmysql.ErrDupEntry)
 }
@@ -0,0 +1,433 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb/errno"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteSQLRecordDeadlock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	recorder := &deadlockRecorder{}
+	session := &DBConn{
+		name:      "test",
+		sourceID:  "source",
+		baseConn:  conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		deadlocks: recorder,
+	}
+	require.Nil(t, recorder.lastInfo())
+
+	query := "INSERT INTO `db`.`tbl` VALUES (1)"
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnError(&mysql.MySQLError{Number: tmysql.ErrLockDeadlock})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, session.executeSQL(tcontext.Background(), []string{query}))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	info := recorder.lastInfo()
+	require.NotNil(t, info)
+	require.Equal(t, []string{query}, info.Queries)
+	require.Equal(t, 1, info.RetryCount)
+	require.True(t, info.Resolved)
+	require.Greater(t, info.Delay, time.Duration(0))
+	require.Contains(t, info.Error, "1213")
+}
+
+func TestExecuteSQLRetryWriteConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:                 "test",
+		sourceID:             "source",
+		baseConn:             conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		writeConflictRetries: 2,
+	}
+	require.True(t, isErrWriteConflict(&mysql.MySQLError{Number: errno.ErrWriteConflict}))
+	require.False(t, isErrWriteConflict(&mysql.MySQLError{Number: tmysql.ErrLockDeadlock}))
+
+	query := "INSERT INTO `db`.`tbl` VALUES (1)"
+	expectWriteConflict := func() {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO").WillReturnError(&mysql.MySQLError{Number: errno.ErrWriteConflict})
+		mock.ExpectRollback()
+	}
+
+	// the write conflicts are retried on the connection.
+	expectWriteConflict()
+	expectWriteConflict()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	start := time.Now()
+	require.NoError(t, session.executeSQL(tcontext.Background(), []string{query}))
+	require.NoError(t, mock.ExpectationsWereMet())
+	// the backoff is shorter than the one of other errors.
+	require.Less(t, time.Since(start), executeRetryParams.FirstRetryDuration)
+
+	// the transaction fails once the write conflict retries are exhausted,
+	// and the write conflict isn't retried again like other retryable errors.
+	expectWriteConflict()
+	expectWriteConflict()
+	expectWriteConflict()
+	err = session.executeSQL(tcontext.Background(), []string{query})
+	require.True(t, isErrWriteConflict(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExecuteSQLWithTimings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+	}
+
+	queries := []string{"INSERT INTO `db`.`tbl` VALUES (1)", "INSERT INTO `db`.`tbl` VALUES (2)"}
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO").WillDelayFor(100 * time.Millisecond).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	timings, err := session.executeSQLWithTimings(tcontext.Background(), queries)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, timings, 2)
+	require.Equal(t, 1, slowestStatement(timings))
+	require.Equal(t, -1, slowestStatement(nil))
+}
+
+func TestPrepareStatements(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		resetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+			dbConn, err := db.Conn(context.Background())
+			require.NoError(t, err)
+			return conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}), nil
+		},
+	}
+
+	query := "INSERT INTO `db`.`tbl` VALUES (?)"
+	prepared := mock.ExpectPrepare("INSERT INTO")
+	require.NoError(t, session.PrepareStatements([]string{query}))
+	// prepare the same statement again is a no-op.
+	require.NoError(t, session.PrepareStatements([]string{query}))
+	require.Equal(t, []string{query}, session.preparedQueries)
+
+	// executions reuse the prepared statement.
+	for i := 0; i < 2; i++ {
+		mock.ExpectExec("BEGIN").WillReturnResult(sqlmock.NewResult(0, 0))
+		prepared.ExpectExec().WithArgs(i).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("COMMIT").WillReturnResult(sqlmock.NewResult(0, 0))
+		require.NoError(t, session.executeSQL(tcontext.Background(), []string{query}, []interface{}{i}))
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// statements are prepared again after reset.
+	mock.ExpectPrepare("INSERT INTO")
+	require.NoError(t, session.resetConn(tcontext.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// prepare failures are reported distinctly.
+	mock.ExpectPrepare("REPLACE INTO").WillReturnError(&mysql.MySQLError{Number: tmysql.ErrParse})
+	err = session.PrepareStatements([]string{"REPLACE INTO `db`.`tbl` VALUES (?)"})
+	require.True(t, terror.ErrDBPrepareFailed.Equal(err))
+	require.Equal(t, []string{query}, session.preparedQueries)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEvictPreparedStmts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+	}
+	errMaxPreparedStmtCount := &mysql.MySQLError{Number: errno.ErrMaxPreparedStmtCountReached}
+	require.True(t, isErrMaxPreparedStmtCount(errMaxPreparedStmtCount))
+	require.False(t, isErrMaxPreparedStmtCount(&mysql.MySQLError{Number: tmysql.ErrDupEntry}))
+
+	query := "INSERT INTO `db`.`tbl` VALUES (?)"
+	prepared := mock.ExpectPrepare("INSERT INTO").WillBeClosed()
+	require.NoError(t, session.PrepareStatements([]string{query}))
+
+	// the prepared statements are evicted, and the transaction is retried at once without them.
+	mock.ExpectExec("BEGIN").WillReturnResult(sqlmock.NewResult(0, 0))
+	prepared.ExpectExec().WithArgs(1).WillReturnError(errMaxPreparedStmtCount)
+	mock.ExpectExec("ROLLBACK").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, session.executeSQL(tcontext.Background(), []string{query}, []interface{}{1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.True(t, session.preparedStmtsEvicted)
+	require.Empty(t, session.preparedQueries)
+
+	// statements are not prepared anymore.
+	require.NoError(t, session.PrepareStatements([]string{query}))
+	require.Empty(t, session.preparedQueries)
+
+	// the error is returned if it's met again.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WithArgs(2).WillReturnError(errMaxPreparedStmtCount)
+	mock.ExpectRollback()
+	err = session.executeSQL(tcontext.Background(), []string{query}, []interface{}{2})
+	require.True(t, isErrMaxPreparedStmtCount(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the statements are executed without prepared statements if they can't be prepared.
+	dbConn, err = db.Conn(context.Background())
+	require.NoError(t, err)
+	session = &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+	}
+	mock.ExpectPrepare("INSERT INTO").WillReturnError(errMaxPreparedStmtCount)
+	require.NoError(t, session.PrepareStatements([]string{query}))
+	require.True(t, session.preparedStmtsEvicted)
+	require.Empty(t, session.preparedQueries)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTimeZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		resetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+			dbConn, err := db.Conn(context.Background())
+			require.NoError(t, err)
+			return conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}), nil
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET time_zone = ?").WithArgs("+08:00").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, session.SetTimeZone(tcontext.Background(), "+08:00"))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the time zone is set again after reset.
+	mock.ExpectBegin()
+	mock.ExpectExec("SET time_zone = ?").WithArgs("+08:00").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, session.resetConn(tcontext.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the time zone not accepted by the downstream is reported, and the
+	// previous one is kept.
+	mock.ExpectBegin()
+	mock.ExpectExec("SET time_zone = ?").WithArgs("Asia/Unknown").
+		WillReturnError(&mysql.MySQLError{Number: tmysql.ErrUnknownTimeZone})
+	mock.ExpectRollback()
+	err = session.SetTimeZone(tcontext.Background(), "Asia/Unknown")
+	require.True(t, terror.ErrLoadTimeZoneNotAccepted.Equal(err))
+	require.Equal(t, "+08:00", session.timeZone)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetSQLMode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		resetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+			dbConn, err := db.Conn(context.Background())
+			require.NoError(t, err)
+			return conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}), nil
+		},
+	}
+
+	// the sql_mode is not set again after reset if it's never set.
+	require.NoError(t, session.resetConn(tcontext.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectQuery("SELECT @@GLOBAL.sql_mode").WillReturnRows(
+		sqlmock.NewRows([]string{"@@GLOBAL.sql_mode"}).AddRow("ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES"))
+	sqlMode, err := session.globalSQLMode(tcontext.Background())
+	require.NoError(t, err)
+	require.Equal(t, "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES", sqlMode)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// an empty sql_mode is also set again after reset.
+	mock.ExpectBegin()
+	mock.ExpectExec("SET SESSION sql_mode = ?").WithArgs("").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, session.SetSQLMode(tcontext.Background(), ""))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET SESSION sql_mode = ?").WithArgs("").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, session.resetConn(tcontext.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelaxSQLMode(t *testing.T) {
+	t.Parallel()
+
+	relaxed, removed := relaxSQLMode("ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE," +
+		"ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION")
+	require.Equal(t, "NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION", relaxed)
+	require.Equal(t, []string{
+		"ONLY_FULL_GROUP_BY", "STRICT_TRANS_TABLES", "NO_ZERO_IN_DATE", "NO_ZERO_DATE", "ERROR_FOR_DIVISION_BY_ZERO",
+	}, removed)
+
+	relaxed, removed = relaxSQLMode("strict_all_tables")
+	require.Equal(t, "", relaxed)
+	require.Equal(t, []string{"strict_all_tables"}, removed)
+
+	relaxed, removed = relaxSQLMode("")
+	require.Equal(t, "", relaxed)
+	require.Empty(t, removed)
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:     "test",
+		sourceID: "source",
+		baseConn: conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+	}
+	cfg := &config.SubTaskConfig{Name: "test-clock-skew", SourceID: "source"}
+	cfg.ClockSkewCheck = config.LoaderClockSkewCheckWarn
+	cfg.ClockSkewThreshold.Duration = time.Minute
+	expectNow := func(offset time.Duration) {
+		ts := float64(time.Now().Add(offset).UnixNano()) / float64(time.Second)
+		mock.ExpectQuery("SELECT UNIX_TIMESTAMP").WillReturnRows(
+			sqlmock.NewRows([]string{"UNIX_TIMESTAMP(NOW(6))"}).AddRow(ts))
+	}
+	readSkew := func() float64 {
+		metric := &dto.Metric{}
+		require.NoError(t, clockSkewGauge.WithLabelValues(cfg.Name, cfg.SourceID).Write(metric))
+		return metric.GetGauge().GetValue()
+	}
+
+	expectNow(-time.Hour)
+	skew, err := session.clockSkew(tcontext.Background())
+	require.NoError(t, err)
+	require.InDelta(t, -time.Hour.Seconds(), skew.Seconds(), 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// a skew within the threshold is only recorded.
+	expectNow(10 * time.Second)
+	require.NoError(t, checkClockSkew(tcontext.Background(), cfg, session))
+	require.InDelta(t, 10, readSkew(), 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// a skew exceeding the threshold is only warned.
+	expectNow(2 * time.Minute)
+	require.NoError(t, checkClockSkew(tcontext.Background(), cfg, session))
+	require.InDelta(t, 120, readSkew(), 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	cfg.ClockSkewCheck = config.LoaderClockSkewCheckFail
+	expectNow(-2 * time.Minute)
+	err = checkClockSkew(tcontext.Background(), cfg, session)
+	require.True(t, terror.ErrLoadDownstreamClockSkew.Equal(err))
+	require.InDelta(t, -120, readSkew(), 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConnResetTracker(t *testing.T) {
+	var nilTracker *connResetTracker
+	nilTracker.begin()
+	nilTracker.finish(nil, nil)
+
+	tracker := newConnResetTracker("test-reset", "source")
+	now := time.Date(2023, 1, 2, 10, 0, 0, 0, time.Local)
+	tracker.now = func() time.Time { return now }
+	readMetric := func(m prometheus.Metric) *dto.Metric {
+		metric := &dto.Metric{}
+		require.NoError(t, m.Write(metric))
+		return metric
+	}
+	resetting := connResettingGauge.WithLabelValues("test-reset", "source")
+	ratio := connResetSuccessRatioGauge.WithLabelValues("test-reset", "source")
+	recovery := connResetRecoveryHistogram.WithLabelValues("test-reset", "source").(prometheus.Metric)
+	conn1, conn2 := &DBConn{}, &DBConn{}
+
+	// a reset storm of two connections, the reset of conn1 fails.
+	tracker.begin()
+	tracker.begin()
+	require.Equal(t, 2.0, readMetric(resetting).GetGauge().GetValue())
+	now = now.Add(time.Second)
+	tracker.finish(conn1, terror.ErrDBUnExpect.Generate("reset failed"))
+	tracker.finish(conn2, nil)
+	require.Equal(t, 0.0, readMetric(resetting).GetGauge().GetValue())
+	require.Equal(t, 0.5, readMetric(ratio).GetGauge().GetValue())
+	// not recovered since conn1 is still broken.
+	require.Equal(t, uint64(0), readMetric(recovery).GetHistogram().GetSampleCount())
+
+	now = now.Add(2 * time.Second)
+	tracker.begin()
+	tracker.finish(conn1, nil)
+	require.InDelta(t, 2.0/3, readMetric(ratio).GetGauge().GetValue(), 1e-9)
+	histogram := readMetric(recovery).GetHistogram()
+	require.Equal(t, uint64(1), histogram.GetSampleCount())
+	require.Equal(t, 3.0, histogram.GetSampleSum())
+
+	// the results out of the window are not counted.
+	now = now.Add(2 * connResetWindow)
+	tracker.begin()
+	tracker.finish(conn2, nil)
+	require.Equal(t, 1.0, readMetric(ratio).GetGauge().GetValue())
+	require.Equal(t, uint64(2), readMetric(recovery).GetHistogram().GetSampleCount())
+}
@@ -0,0 +1,445 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// bloomFilter is a fixed size bloom filter, it never reports a false negative,
+// and reports a false positive with the expected rate as long as no more than
+// `capacity` keys are added.
+type bloomFilter struct {
+	bits     []uint64
+	m        uint64
+	k        uint64
+	count    int
+	capacity int
+}
+
+func newBloomFilter(capacity int, fpRate float64) *bloomFilter {
+	if capacity < 1 {
+		capacity = 1
+	}
+	// m = -n*ln(p)/ln(2)^2, k = m/n*ln(2)
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint64(math.Round(float64(m) / float64(capacity) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &bloomFilter{
+		bits:     make([]uint64, (m+63)/64),
+		m:        m,
+		k:        k,
+		capacity: capacity,
+	}
+}
+
+// hashes returns two independent hashes of the key, the i-th hash of the
+// filter is h1+i*h2 as described in "Less Hashing, Same Performance".
+func (f *bloomFilter) hashes(key string) (uint64, uint64) {
+	h1 := fnv.New64a()
+	_, _ = h1.Write([]byte(key))
+	h2 := fnv.New64()
+	_, _ = h2.Write([]byte(key))
+	return h1.Sum64(), h2.Sum64() | 1
+}
+
+// add adds the key to the filter, it returns false if the filter is full.
+func (f *bloomFilter) add(key string) bool {
+	if f.count >= f.capacity {
+		return false
+	}
+	h1, h2 := f.hashes(key)
+	for i := uint64(0); i < f.k; i++ {
+		pos := (h1 + i*h2) % f.m
+		f.bits[pos/64] |= 1 << (pos % 64)
+	}
+	f.count++
+	return true
+}
+
+func (f *bloomFilter) mayContain(key string) bool {
+	h1, h2 := f.hashes(key)
+	for i := uint64(0); i < f.k; i++ {
+		pos := (h1 + i*h2) % f.m
+		if f.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// tableDedup is the dedup state of a target table.
+type tableDedup struct {
+	mu sync.Mutex
+	// initialized is true after the primary key is fetched and the filter is seeded.
+	initialized bool
+	// disabled is true if the table has no usable primary key or the filter is full,
+	// all the rows of the table are applied then.
+	disabled bool
+	pkCols   []string
+	// pkIdx are the indexes of pkCols in the column list of the dumped rows.
+	pkIdx  []int
+	filter *bloomFilter
+}
+
+// loadDedup skips the rows which are already applied to the downstream, which
+// speeds up the replay of huge tables after restarting. It keeps a bloom filter
+// of the primary keys of each target table, a row missing in the filter is
+// certainly not applied, and a row hitting the filter is confirmed by querying
+// the downstream, so a row is never skipped because of a false positive.
+type loadDedup struct {
+	capacity int
+	fpRate   float64
+	logger   log.Logger
+
+	skippedCounter       prometheus.Counter
+	falsePositiveCounter prometheus.Counter
+
+	mu     sync.Mutex
+	tables map[string]*tableDedup
+}
+
+// newLoadDedup creates a loadDedup, it returns nil if dedup-logical is not enabled.
+func newLoadDedup(cfg *config.SubTaskConfig, logger log.Logger) *loadDedup {
+	if !cfg.DedupLogical {
+		return nil
+	}
+	return &loadDedup{
+		capacity:             cfg.DedupMaxKeysLogical,
+		fpRate:               cfg.DedupFalsePositiveRateLogical,
+		logger:               logger,
+		skippedCounter:       dedupRowCounter.WithLabelValues(cfg.Name, cfg.SourceID, "skipped"),
+		falsePositiveCounter: dedupRowCounter.WithLabelValues(cfg.Name, cfg.SourceID, "false_positive"),
+		tables:               make(map[string]*tableDedup),
+	}
+}
+
+func (d *loadDedup) getTable(table *tableInfo) *tableDedup {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := tableName(table.targetSchema, table.targetTable)
+	t, ok := d.tables[key]
+	if !ok {
+		t = &tableDedup{}
+		d.tables[key] = t
+	}
+	return t
+}
+
+// filterRows returns the rows which are not applied to the downstream yet.
+func (d *loadDedup) filterRows(tctx *tcontext.Context, conn *DBConn, table *tableInfo, rows [][]string) ([][]string, error) {
+	t := d.getTable(table)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.initialized {
+		if err := d.initTable(tctx, conn, table, t); err != nil {
+			return nil, err
+		}
+		t.initialized = true
+	}
+	if t.disabled {
+		return rows, nil
+	}
+
+	var (
+		toApply  = make([][]string, 0, len(rows))
+		hitRows  [][]string
+		hitKeys  []string
+		keys     = make([]string, len(rows))
+		hasValid = make([]bool, len(rows))
+	)
+	for i, row := range rows {
+		keys[i], hasValid[i] = dedupKeyOfRow(row, t.pkIdx)
+		if hasValid[i] && t.filter.mayContain(keys[i]) {
+			hitRows = append(hitRows, row)
+			hitKeys = append(hitKeys, keys[i])
+		}
+	}
+
+	var applied map[string]struct{}
+	if len(hitRows) > 0 {
+		var err error
+		applied, err = d.queryApplied(tctx, conn, table, t, hitRows)
+		if err != nil {
+			return nil, err
+		}
+		falsePositives := 0
+		for _, key := range hitKeys {
+			if _, ok := applied[key]; !ok {
+				falsePositives++
+			}
+		}
+		d.falsePositiveCounter.Add(float64(falsePositives))
+		d.skippedCounter.Add(float64(len(hitKeys) - falsePositives))
+	}
+
+	for i, row := range rows {
+		if hasValid[i] {
+			if _, ok := applied[keys[i]]; ok {
+				continue
+			}
+			if !t.disabled && !t.filter.add(keys[i]) {
+				d.disableTable(table, t, "the bloom filter is full")
+			}
+		}
+		toApply = append(toApply, row)
+	}
+	return toApply, nil
+}
+
+func (d *loadDedup) disableTable(table *tableInfo, t *tableDedup, reason string) {
+	if t.disabled {
+		return
+	}
+	d.logger.Warn("dedup of loaded rows is disabled for table",
+		zap.String("schema", table.targetSchema),
+		zap.String("table", table.targetTable),
+		zap.String("reason", reason),
+		zap.Int("dedup-max-keys-logical", d.capacity))
+	t.disabled = true
+	// release the memory of the filter
+	t.filter = nil
+}
+
+// initTable fetches the primary key of the target table, and seeds the filter
+// with the primary keys already in the downstream table.
+func (d *loadDedup) initTable(tctx *tcontext.Context, conn *DBConn, table *tableInfo, t *tableDedup) error {
+	t.pkCols, t.pkIdx = nil, nil
+	rows, err := conn.querySQL(tctx,
+		"SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE "+
+			"WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY' ORDER BY ORDINAL_POSITION",
+		table.targetSchema, table.targetTable)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var col string
+		if err = rows.Scan(&col); err != nil {
+			rows.Close()
+			return terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+		}
+		t.pkCols = append(t.pkCols, col)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+	}
+	if len(t.pkCols) == 0 {
+		d.disableTable(table, t, "the table has no primary key")
+		return nil
+	}
+	for _, col := range t.pkCols {
+		idx := -1
+		for i, name := range table.columnNameList {
+			if strings.EqualFold(name, col) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			d.disableTable(table, t, fmt.Sprintf("the primary key column %s is not in the dumped rows", col))
+			return nil
+		}
+		t.pkIdx = append(t.pkIdx, idx)
+	}
+
+	t.filter = newBloomFilter(d.capacity, d.fpRate)
+	// fetch one more key to know whether the filter can hold all the keys
+	seeded := 0
+	err = conn.scanTable(tctx, table.targetSchema, table.targetTable, t.pkCols, d.capacity+1,
+		func(values []sql.RawBytes) bool {
+			if !t.filter.add(dedupKeyOfValues(values)) {
+				d.disableTable(table, t, "the downstream table has too many rows")
+				return false
+			}
+			seeded++
+			return true
+		})
+	if err != nil || t.disabled {
+		return err
+	}
+	d.logger.Info("seeded the dedup filter of table",
+		zap.String("schema", table.targetSchema),
+		zap.String("table", table.targetTable),
+		zap.Strings("primary key", t.pkCols),
+		zap.Int("keys", seeded))
+	return nil
+}
+
+// queryApplied returns the keys of the rows which exist in the downstream table.
+func (d *loadDedup) queryApplied(tctx *tcontext.Context, conn *DBConn, table *tableInfo, t *tableDedup, rows [][]string) (map[string]struct{}, error) {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "SELECT %s FROM %s WHERE (%s) IN (",
+		quoteColumns(t.pkCols), tableName(table.targetSchema, table.targetTable), quoteColumns(t.pkCols))
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('(')
+		for j, idx := range t.pkIdx {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			// the values in dumped rows are SQL literals
+			buf.WriteString(row[idx])
+		}
+		buf.WriteByte(')')
+	}
+	buf.WriteByte(')')
+
+	result, err := conn.querySQL(tctx, buf.String())
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	applied := make(map[string]struct{}, len(rows))
+	for result.Next() {
+		key, err2 := scanDedupKey(result, len(t.pkCols))
+		if err2 != nil {
+			return nil, terror.DBErrorAdapt(err2, conn.Scope(), terror.ErrDBDriverError)
+		}
+		applied[key] = struct{}{}
+	}
+	if err = result.Err(); err != nil {
+		return nil, terror.DBErrorAdapt(err, conn.Scope(), terror.ErrDBDriverError)
+	}
+	return applied, nil
+}
+
+func quoteColumns(cols []string) string {
+	quoted := make([]string, 0, len(cols))
+	for _, col := range cols {
+		quoted = append(quoted, "`"+strings.ReplaceAll(col, "`", "``")+"`")
+	}
+	return strings.Join(quoted, ",")
+}
+
+func scanDedupKey(rows *sql.Rows, colCount int) (string, error) {
+	values := make([]sql.RawBytes, colCount)
+	dest := make([]interface{}, colCount)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return "", err
+	}
+	return dedupKeyOfValues(values), nil
+}
+
+// dedupKeyOfValues returns the key of the values of the primary key read from the downstream.
+func dedupKeyOfValues(values []sql.RawBytes) string {
+	var key strings.Builder
+	for _, v := range values {
+		appendDedupKeyPart(&key, string(v))
+	}
+	return key.String()
+}
+
+func appendDedupKeyPart(key *strings.Builder, v string) {
+	// length-prefixed, so the parts can contain any bytes
+	key.WriteString(strconv.Itoa(len(v)))
+	key.WriteByte(':')
+	key.WriteString(v)
+}
+
+// dedupKeyOfRow returns the key of a dumped row in the same format as the keys
+// read from the downstream. It returns false if any value of the primary key is
+// not a plain number or string literal, such rows are always applied.
+func dedupKeyOfRow(row []string, pkIdx []int) (string, bool) {
+	var key strings.Builder
+	for _, idx := range pkIdx {
+		v, ok := unquoteLiteral(row[idx])
+		if !ok {
+			return "", false
+		}
+		appendDedupKeyPart(&key, v)
+	}
+	return key.String(), true
+}
+
+// unquoteLiteral returns the value of a number or quoted string literal in dumped rows.
+func unquoteLiteral(lit string) (string, bool) {
+	if lit == "" {
+		return "", false
+	}
+	quote := lit[0]
+	if quote != '\'' && quote != '"' {
+		if lit[0] != '-' && lit[0] != '+' && (lit[0] < '0' || lit[0] > '9') {
+			// NULL, hex literals and so on
+			return "", false
+		}
+		if strings.HasPrefix(lit, "0x") || strings.HasPrefix(lit, "0b") {
+			return "", false
+		}
+		return lit, true
+	}
+	if len(lit) < 2 || lit[len(lit)-1] != quote {
+		return "", false
+	}
+	s := lit[1 : len(lit)-1]
+	if strings.IndexByte(s, '\\') < 0 && strings.IndexByte(s, quote) < 0 {
+		return s, true
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case '0':
+				b.WriteByte(0)
+			case 'b':
+				b.WriteByte('\b')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'Z':
+				b.WriteByte(26)
+			default:
+				b.WriteByte(s[i])
+			}
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			// a doubled quote
+			i++
+			b.WriteByte(quote)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), true
+}
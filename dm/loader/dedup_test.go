@@ -0,0 +1,150 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	f := newBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		require.True(t, f.add(fmt.Sprintf("key-%d", i)))
+	}
+	// never a false negative
+	for i := 0; i < 1000; i++ {
+		require.True(t, f.mayContain(fmt.Sprintf("key-%d", i)))
+	}
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.mayContain(fmt.Sprintf("key-%d", i)) {
+			falsePositives++
+		}
+	}
+	// the expected count is 100, leave some room for the variance
+	require.Less(t, falsePositives, 200)
+
+	// the filter is bounded
+	require.False(t, f.add("key-1000"))
+}
+
+func TestUnquoteLiteral(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		lit      string
+		expected string
+		ok       bool
+	}{
+		{"123", "123", true},
+		{"-1.5", "-1.5", true},
+		{"'abc'", "abc", true},
+		{`"abc"`, "abc", true},
+		{`'it\'s'`, "it's", true},
+		{`'it''s'`, "it's", true},
+		{`'a\nb\\c'`, "a\nb\\c", true},
+		{"NULL", "", false},
+		{"0x1F", "", false},
+		{"_binary 'a'", "", false},
+		{"'abc", "", false},
+	}
+	for _, c := range cases {
+		v, ok := unquoteLiteral(c.lit)
+		require.Equal(t, c.ok, ok, c.lit)
+		require.Equal(t, c.expected, v, c.lit)
+	}
+
+	key1, ok := dedupKeyOfRow([]string{"1", "'a'", "'b'"}, []int{0, 2})
+	require.True(t, ok)
+	key2, ok := dedupKeyOfRow([]string{"1", "'x'", "'b'"}, []int{0, 2})
+	require.True(t, ok)
+	require.Equal(t, key1, key2)
+	_, ok = dedupKeyOfRow([]string{"NULL", "'a'"}, []int{0})
+	require.False(t, ok)
+}
+
+func TestLoadDedupFilterRows(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "source"}
+	require.Nil(t, newLoadDedup(cfg, log.L()))
+	cfg.DedupLogical = true
+	cfg.DedupMaxKeysLogical = 10
+	cfg.DedupFalsePositiveRateLogical = 0.0001
+	d := newLoadDedup(cfg, log.L())
+	require.NotNil(t, d)
+
+	dbConn, mock := newMockDBConn(t)
+	tctx := tcontext.Background()
+	table := &tableInfo{
+		targetSchema:   "db",
+		targetTable:    "tbl",
+		columnNameList: []string{"name", "id"},
+	}
+
+	mock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "tbl").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("ID"))
+	mock.ExpectQuery("SELECT `ID` FROM `db`.`tbl` LIMIT 11").
+		WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow("1").AddRow("2"))
+	// the rows 1 and 2 hit the filter, but only 1 is in the downstream now
+	mock.ExpectQuery("SELECT `ID` FROM `db`.`tbl` WHERE \\(`ID`\\) IN \\(\\(1\\),\\(2\\)\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow("1"))
+	rows, err := d.filterRows(tctx, dbConn, table, [][]string{{"'a'", "1"}, {"'b'", "2"}, {"'c'", "3"}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"'b'", "2"}, {"'c'", "3"}}, rows)
+
+	// the rows without a valid key are always applied, and the new rows are
+	// applied without querying the downstream.
+	rows, err = d.filterRows(tctx, dbConn, table, [][]string{{"'d'", "NULL"}, {"'e'", "4"}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"'d'", "NULL"}, {"'e'", "4"}}, rows)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the table without primary key is never filtered
+	noPK := &tableInfo{targetSchema: "db", targetTable: "no_pk", columnNameList: []string{"id"}}
+	mock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "no_pk").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}))
+	rows, err = d.filterRows(tctx, dbConn, noPK, [][]string{{"1"}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"1"}}, rows)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the table having more rows than the filter can hold is never filtered
+	huge := &tableInfo{targetSchema: "db", targetTable: "huge", columnNameList: []string{"id"}}
+	mock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "huge").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+	seedRows := sqlmock.NewRows([]string{"id"})
+	for i := 0; i < 11; i++ {
+		seedRows.AddRow(fmt.Sprintf("%d", i))
+	}
+	mock.ExpectQuery("SELECT `id` FROM `db`.`huge` LIMIT 11").WillReturnRows(seedRows)
+	rows, err = d.filterRows(tctx, dbConn, huge, [][]string{{"1"}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"1"}}, rows)
+	require.True(t, d.getTable(huge).disabled)
+	require.Nil(t, d.getTable(huge).filter)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// maxIdempotencyKeyLen is the max length of an idempotency key, a longer
+// position key is replaced by its hash.
+const maxIdempotencyKeyLen = 128
+
+// idempotencyTableName returns the schema and table name of the idempotency table, and whether
+// the table is specified by user.
+func idempotencyTableName(taskName, metaSchema string, cfg *config.LoaderConfig) (string, string, bool) {
+	if cfg.IdempotencyTableLogical == "" {
+		return metaSchema, cputil.LoaderIdempotency(taskName), false
+	}
+	return metaSchema, cfg.IdempotencyTableLogical, true
+}
+
+// SpecifiedIdempotencyTable returns the quoted name of the idempotency table specified by
+// idempotency-table-logical, or returns "" if loader uses the default one.
+func SpecifiedIdempotencyTable(taskName, metaSchema string, cfg *config.LoaderConfig) string {
+	if cfg.IdempotencyKeyLogical == config.LoaderIdempotencyKeyNone {
+		return ""
+	}
+	schema, table, specified := idempotencyTableName(taskName, metaSchema, cfg)
+	if !specified {
+		return ""
+	}
+	return dbutil.TableName(schema, table)
+}
+
+// idempotencyKeeper writes an idempotency key in each transaction of data, so
+// that a transaction retried after an ambiguous failure, e.g. the connection is
+// broken when committing, is skipped if it's committed before. It's shared by
+// the connections.
+type idempotencyKeeper struct {
+	task     string
+	sourceID string
+	keyType  config.LoaderIdempotencyKey
+	schema   string
+	// tableName is the quoted name of the idempotency table.
+	tableName string
+	logger    log.Logger
+
+	skippedCounter   prometheus.Counter
+	reappliedCounter prometheus.Counter
+}
+
+// newIdempotencyKeeper creates an idempotencyKeeper, it returns nil if idempotency-key-logical is not set.
+func newIdempotencyKeeper(cfg *config.SubTaskConfig, logger log.Logger) *idempotencyKeeper {
+	if cfg.IdempotencyKeyLogical == config.LoaderIdempotencyKeyNone {
+		return nil
+	}
+	schema, table, _ := idempotencyTableName(cfg.Name, cfg.MetaSchema, &cfg.LoaderConfig)
+	return &idempotencyKeeper{
+		task:             cfg.Name,
+		sourceID:         cfg.SourceID,
+		keyType:          cfg.IdempotencyKeyLogical,
+		schema:           schema,
+		tableName:        dbutil.TableName(schema, table),
+		logger:           logger,
+		skippedCounter:   idempotentTxnCounter.WithLabelValues(cfg.Name, cfg.SourceID, "skipped"),
+		reappliedCounter: idempotentTxnCounter.WithLabelValues(cfg.Name, cfg.SourceID, "reapplied"),
+	}
+}
+
+// prepare creates the idempotency table if it doesn't exist.
+func (k *idempotencyKeeper) prepare(tctx *tcontext.Context, dbConn *DBConn) error {
+	createSchema := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(k.schema))
+	createTable := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		task_name varchar(255) NOT NULL,
+		source_name varchar(255) NOT NULL,
+		idempotency_key varchar(%d) NOT NULL,
+		create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (task_name, source_name, idempotency_key)
+	)`, k.tableName, maxIdempotencyKeyLen)
+	for _, query := range []string{createSchema, createTable} {
+		if err := dbConn.executeSQL(tctx, []string{query}); err != nil {
+			return terror.WithScope(err, terror.ScopeDownstream)
+		}
+	}
+	return nil
+}
+
+// genKey generates the idempotency key of a transaction, which loads the data
+// file until offset by the queries.
+func (k *idempotencyKeeper) genKey(file string, offset int64, queries []string) string {
+	if k.keyType == config.LoaderIdempotencyKeyPosition {
+		key := file + ":" + strconv.FormatInt(offset, 10)
+		if len(key) <= maxIdempotencyKeyLen {
+			return key
+		}
+		sum := sha256.Sum256([]byte(key))
+		return hex.EncodeToString(sum[:])
+	}
+	h := sha256.New()
+	for _, query := range queries {
+		_, _ = h.Write([]byte(query))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// insertSQL returns the statement writing the key in the transaction.
+func (k *idempotencyKeeper) insertSQL(key string) (string, []interface{}) {
+	return "INSERT INTO " + k.tableName + " (task_name, source_name, idempotency_key) VALUES (?, ?, ?)",
+		[]interface{}{k.task, k.sourceID, key}
+}
+
+// committed returns whether the transaction of the key is committed. It should
+// be called before retrying the transaction after an ambiguous failure.
+func (k *idempotencyKeeper) committed(tctx *tcontext.Context, baseConn *conn.BaseConn, key string) (bool, error) {
+	rows, err := baseConn.QuerySQL(tctx,
+		"SELECT 1 FROM "+k.tableName+" WHERE task_name = ? AND source_name = ? AND idempotency_key = ?",
+		k.task, k.sourceID, key)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	found := rows.Next()
+	if err = rows.Err(); err != nil {
+		return false, terror.DBErrorAdapt(err, baseConn.Scope, terror.ErrDBDriverError)
+	}
+	if found {
+		k.skippedCounter.Inc()
+		tctx.L().Warn("transaction is committed before the ambiguous failure, skip it",
+			zap.String("idempotency key", key))
+	} else {
+		k.reappliedCounter.Inc()
+	}
+	return found, nil
+}
+
+// clean deletes the keys of the source, it should be called after all data files are loaded.
+// The failure is only logged, since the keys are only used to retry the loaded data.
+func (k *idempotencyKeeper) clean(tctx *tcontext.Context, dbConn *DBConn) {
+	err := dbConn.executeSQL(tctx,
+		[]string{"DELETE FROM " + k.tableName + " WHERE task_name = ? AND source_name = ?"},
+		[]interface{}{k.task, k.sourceID})
+	if err != nil {
+		k.logger.Warn("failed to delete the idempotency keys of the loaded data",
+			zap.String("table", k.tableName), log.ShortError(err))
+		return
+	}
+	k.logger.Info("idempotency keys of the loaded data are deleted", zap.String("table", k.tableName))
+}
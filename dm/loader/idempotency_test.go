@@ -0,0 +1,125 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotencyKeeper4Test(keyType config.LoaderIdempotencyKey) *idempotencyKeeper {
+	cfg := &config.SubTaskConfig{Name: "test-task", SourceID: "source", MetaSchema: "dm_meta"}
+	cfg.IdempotencyKeyLogical = keyType
+	return newIdempotencyKeeper(cfg, log.L())
+}
+
+func TestIdempotencyKeeperGenKey(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, newIdempotencyKeeper4Test(config.LoaderIdempotencyKeyNone))
+
+	k := newIdempotencyKeeper4Test(config.LoaderIdempotencyKeyPosition)
+	require.Equal(t, "`dm_meta`.`test-task_loader_idempotency`", k.tableName)
+	queries := []string{"USE `db`;", "INSERT INTO `tbl` VALUES (1)"}
+	require.Equal(t, "db.tbl.sql:100", k.genKey("db.tbl.sql", 100, queries))
+	longKey := k.genKey(strings.Repeat("a", maxIdempotencyKeyLen), 100, queries)
+	require.Len(t, longKey, 64)
+	require.NotEqual(t, longKey, k.genKey(strings.Repeat("a", maxIdempotencyKeyLen), 200, queries))
+
+	k = newIdempotencyKeeper4Test(config.LoaderIdempotencyKeyContent)
+	key := k.genKey("db.tbl.sql", 100, queries)
+	require.Len(t, key, 64)
+	require.Equal(t, key, k.genKey("db.tbl.sql", 200, queries))
+	require.NotEqual(t, key, k.genKey("db.tbl.sql", 100, queries[1:]))
+	// the boundaries of statements are a part of the key
+	require.NotEqual(t, key, k.genKey("db.tbl.sql", 100, []string{queries[0] + queries[1]}))
+}
+
+func TestSpecifiedIdempotencyTable(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.LoaderConfig{IdempotencyTableLogical: "keys"}
+	require.Equal(t, "", SpecifiedIdempotencyTable("task", "dm_meta", cfg))
+	cfg.IdempotencyKeyLogical = config.LoaderIdempotencyKeyPosition
+	require.Equal(t, "`dm_meta`.`keys`", SpecifiedIdempotencyTable("task", "dm_meta", cfg))
+	cfg.IdempotencyTableLogical = ""
+	require.Equal(t, "", SpecifiedIdempotencyTable("task", "dm_meta", cfg))
+}
+
+func TestExecuteSQLIdempotently(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	session := &DBConn{
+		name:        "test",
+		sourceID:    "source",
+		baseConn:    conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}),
+		idempotency: newIdempotencyKeeper4Test(config.LoaderIdempotencyKeyPosition),
+		resetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+			dbConn, err := db.Conn(context.Background())
+			require.NoError(t, err)
+			return conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}), nil
+		},
+	}
+	queries := []string{"INSERT INTO `db`.`tbl` VALUES (1)"}
+
+	// the key is written in the same transaction.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `db`.`tbl`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `dm_meta`.`test-task_loader_idempotency`").
+		WithArgs("test-task", "source", "db.tbl.sql:100").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	_, err = session.executeSQLIdempotently(tcontext.Background(), "db.tbl.sql:100", false, queries)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the transaction is skipped if it's committed before the connection is broken.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `db`.`tbl`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `dm_meta`.`test-task_loader_idempotency`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit().WillReturnError(driver.ErrBadConn)
+	mock.ExpectQuery("SELECT 1 FROM `dm_meta`.`test-task_loader_idempotency`").
+		WithArgs("test-task", "source", "db.tbl.sql:200").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	_, err = session.executeSQLIdempotently(tcontext.Background(), "db.tbl.sql:200", false, queries)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the transaction is applied again if it's not committed.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `db`.`tbl`").WillReturnError(driver.ErrBadConn)
+	mock.ExpectRollback()
+	mock.ExpectQuery("SELECT 1 FROM `dm_meta`.`test-task_loader_idempotency`").
+		WithArgs("test-task", "source", "db.tbl.sql:300").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `db`.`tbl`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `dm_meta`.`test-task_loader_idempotency`").
+		WithArgs("test-task", "source", "db.tbl.sql:300").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	_, err = session.executeSQLIdempotently(tcontext.Background(), "db.tbl.sql:300", false, queries)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -86,7 +87,7 @@ type LightningLoader struct {
 // NewLightning creates a new Loader importing data with lightning.
 func NewLightning(cfg *config.SubTaskConfig, cli *clientv3.Client, workerName string) *LightningLoader {
 	lightningCfg := MakeGlobalConfig(cfg)
-	logger := log.L()
+	logger := log.ForTask(cfg.Name, cfg.SourceID)
 	if cfg.FrameworkLogger != nil {
 		logger = log.Logger{Logger: cfg.FrameworkLogger}
 	}
@@ -140,7 +141,7 @@ func (l *LightningLoader) Init(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	l.toDB, l.toDBConns, err = createConns(tctx, l.cfg, toCfg.Name, toCfg.SourceID, 1)
+	l.toDB, l.toDBConns, _, _, err = createConns(tctx, l.cfg, toCfg.Name, toCfg.SourceID, 1, 0)
 	if err != nil {
 		return err
 	}
@@ -326,6 +327,11 @@ func GetLightningConfig(globalCfg *lcfg.GlobalConfig, subtaskCfg *config.SubTask
 	if err := cfg.LoadFromGlobal(globalCfg); err != nil {
 		return nil, err
 	}
+	password, err := secret.Resolve(context.Background(), cfg.TiDB.Psw)
+	if err != nil {
+		return nil, err
+	}
+	cfg.TiDB.Psw = password
 	// TableConcurrency is adjusted to the value of RegionConcurrency
 	// when using TiDB backend.
 	// TODO: should we set the TableConcurrency separately.
@@ -335,7 +341,7 @@ func GetLightningConfig(globalCfg *lcfg.GlobalConfig, subtaskCfg *config.SubTask
 	cfg.Checkpoint.Driver = lcfg.CheckpointDriverFile
 	var cpPath string
 	// l.cfg.LoaderConfig.Dir may be a s3 path, and Lightning supports checkpoint in s3, we can use storage.AdjustPath to adjust path both local and s3.
-	cpPath, err := storage.AdjustPath(subtaskCfg.LoaderConfig.Dir, string(filepath.Separator)+lightningCheckpointFileName)
+	cpPath, err = storage.AdjustPath(subtaskCfg.LoaderConfig.Dir, string(filepath.Separator)+lightningCheckpointFileName)
 	if err != nil {
 		return nil, err
 	}
@@ -436,6 +442,11 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 	} else {
 		l.finish.Store(true)
 	}
+	if err == nil && l.finish.Load() {
+		if err = writeLoadSyncMarker(ctx, l.toDB, l.cfg, l.logger); err != nil {
+			return err
+		}
+	}
 	if err == nil && l.finish.Load() && l.cfg.Mode == config.ModeFull {
 		if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 			return err
@@ -0,0 +1,58 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	tablefilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// loadOrder assigns the upstream tables to the priority tiers of load-order-logical.
+// The data files of a tier are restored only after all data files of the previous
+// tiers are restored, so the tables referenced by foreign keys can be loaded first.
+type loadOrder struct {
+	tiers []tablefilter.Filter
+}
+
+// newLoadOrder creates a loadOrder from the table patterns of each tier.
+func newLoadOrder(tiers [][]string, caseSensitive bool) (*loadOrder, error) {
+	o := &loadOrder{tiers: make([]tablefilter.Filter, 0, len(tiers))}
+	for _, patterns := range tiers {
+		f, err := tablefilter.Parse(patterns)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidLoaderLoadOrder.Delegate(err, "parse table patterns")
+		}
+		if !caseSensitive {
+			f = tablefilter.CaseInsensitive(f)
+		}
+		o.tiers = append(o.tiers, f)
+	}
+	return o, nil
+}
+
+// tierCount returns the number of tiers, including the implicit last tier of unlisted tables.
+func (o *loadOrder) tierCount() int {
+	return len(o.tiers) + 1
+}
+
+// tierOf returns the index of the first tier which matches the table,
+// the unlisted tables belong to the last tier.
+func (o *loadOrder) tierOf(schema, table string) int {
+	for i, f := range o.tiers {
+		if f.MatchTable(schema, table) {
+			return i
+		}
+	}
+	return len(o.tiers)
+}
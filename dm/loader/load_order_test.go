@@ -0,0 +1,51 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOrder(t *testing.T) {
+	t.Parallel()
+
+	o, err := newLoadOrder(nil, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, o.tierCount())
+	require.Equal(t, 0, o.tierOf("db", "tbl"))
+
+	o, err = newLoadOrder([][]string{
+		{"db.region", "db.nation"},
+		{"db.customer*", "db.orders"},
+	}, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, o.tierCount())
+	require.Equal(t, 0, o.tierOf("db", "nation"))
+	require.Equal(t, 0, o.tierOf("DB", "Region"))
+	require.Equal(t, 1, o.tierOf("db", "customer_1"))
+	require.Equal(t, 1, o.tierOf("db", "orders"))
+	require.Equal(t, 2, o.tierOf("db", "lineitem"))
+	require.Equal(t, 2, o.tierOf("db2", "orders"))
+
+	o, err = newLoadOrder([][]string{{"db.Region"}, {"db.*"}}, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, o.tierOf("db", "region"))
+	require.Equal(t, 0, o.tierOf("db", "Region"))
+
+	_, err = newLoadOrder([][]string{{"db.region["}}, false)
+	require.True(t, terror.ErrConfigInvalidLoaderLoadOrder.Equal(err))
+}
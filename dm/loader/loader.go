@@ -54,6 +54,10 @@ const (
 	uninitializedOffset = -1
 )
 
+// compactCheckpointTimeout is the timeout of compacting the checkpoint when the task is paused,
+// so that an unresponsive downstream doesn't block pausing the task.
+var compactCheckpointTimeout = conn.DefaultDBTimeout
+
 // FilePosSet represents a set in mathematics.
 type FilePosSet map[string][]int64
 
@@ -73,6 +77,23 @@ type dataJob struct {
 	absPath      string
 	offset       int64
 	lastOffset   int64
+	// rows and info are only set when dedup-logical is enabled, the rows which
+	// are already applied are removed before executing.
+	rows [][]string
+	info *tableInfo
+	// rowCount is the number of rows in sql, it's counted by the lines of the
+	// statement because the dumped files write one row per line.
+	rowCount int
+	// stmt is the dumped statement, parsed is closed after stmt is parsed into
+	// sql and rows by the statementParser, and parseErr is the error of parsing.
+	// They are only set when parse-pool-size-logical is not 0.
+	stmt     []byte
+	parsed   chan struct{}
+	parseErr error
+	// progress is the progress of the data file and reader is the ID of the
+	// worker reading it, they are only set when work-stealing-logical is enabled.
+	progress *fileProgress
+	reader   int
 }
 
 type fileJob struct {
@@ -81,6 +102,8 @@ type fileJob struct {
 	dataFile string
 	offset   int64
 	info     *tableInfo
+	// done is notified after the data file is restored, nil if not needed
+	done *sync.WaitGroup
 }
 
 // Worker represents a worker.
@@ -92,6 +115,8 @@ type Worker struct {
 	wg         sync.WaitGroup
 	jobQueue   chan *dataJob
 	loader     *Loader
+	// progress is the progress of the data file being read, only set when work-stealing-logical is enabled
+	progress *fileProgress
 
 	logger log.Logger
 
@@ -151,6 +176,134 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 
 	ctctx := tcontext.NewContext(newCtx, w.logger)
 
+	// execute executes the job, it returns false if the job fails.
+	execute := func(job *dataJob) bool {
+		if job.parsed != nil {
+			select {
+			case <-newCtx.Done():
+				return false
+			case <-job.parsed:
+			}
+			if job.parseErr != nil {
+				runFatalChan <- unit.NewProcessError(terror.Annotatef(job.parseErr, "file %s", job.file))
+				return false
+			}
+		}
+
+		rowCount := job.rowCount
+		if w.loader.dedup != nil && job.rows != nil {
+			rows, err := w.loader.dedup.filterRows(ctctx, w.conn, job.info, job.rows)
+			if err != nil {
+				err = terror.WithScope(terror.Annotatef(err, "file %s", job.file), terror.ScopeDownstream)
+				if !utils.IsContextCanceledError(err) {
+					runFatalChan <- unit.NewProcessError(err)
+				}
+				return false
+			}
+			if len(rows) > 0 {
+				job.sql = assembleInsert(job.info, rows, len(job.sql))
+			} else {
+				job.sql = ""
+			}
+			rowCount = len(rows)
+		}
+
+		sqls := make([]string, 0, 3)
+		sqls = append(sqls, "USE `"+unescapePercent(job.schema, w.logger)+"`;")
+		// all the rows of the job may be skipped by the dedup, but the checkpoint is still updated
+		if job.sql != "" {
+			sqls = append(sqls, job.sql)
+		}
+
+		// local checkpoint doesn't save offset in downstream, it's saved in UpdateOffset.
+		// the batched checkpoint is written by the checkpointBatcher after the data is loaded.
+		if w.loader.cpBatcher == nil {
+			if offsetSQL := w.checkPoint.GenSQL(job.file, job.offset); offsetSQL != "" {
+				sqls = append(sqls, offsetSQL)
+			}
+		}
+
+		failpoint.Inject("LoadExceedOffsetExit", func(val failpoint.Value) {
+			threshold, _ := val.(int)
+			if job.offset >= int64(threshold) {
+				w.logger.Warn("load offset execeeds threshold, it will exit", zap.Int64("load offset", job.offset), zap.Int("value", threshold), zap.String("failpoint", "LoadExceedOffsetExit"))
+				utils.OsExit(1)
+			}
+		})
+
+		failpoint.Inject("LoadDataSlowDown", nil)
+
+		failpoint.Inject("LoadDataSlowDownByTask", func(val failpoint.Value) {
+			tasks := val.(string)
+			taskNames := strings.Split(tasks, ",")
+			for _, taskName := range taskNames {
+				if w.cfg.Name == taskName {
+					w.logger.Info("inject failpoint LoadDataSlowDownByTask", zap.String("task", taskName))
+					<-newCtx.Done()
+				}
+			}
+		})
+
+		startTime := time.Now()
+		var (
+			timings []time.Duration
+			err     error
+		)
+		// the timings of statements are only recorded in debug level
+		// to avoid the overhead on the hot path.
+		withTimings := w.logger.Core().Enabled(zap.DebugLevel)
+		switch {
+		case w.loader.idempotency != nil:
+			key := w.loader.idempotency.genKey(job.file, job.offset, sqls)
+			timings, err = w.conn.executeSQLIdempotently(ctctx, key, withTimings, sqls)
+		case withTimings:
+			timings, err = w.conn.executeSQLWithTimings(ctctx, sqls)
+		default:
+			err = w.conn.executeSQL(ctctx, sqls)
+		}
+		if err == nil && withTimings {
+			w.logger.Debug("statements executed",
+				zap.String("file", job.file),
+				zap.Int64("offset", job.offset),
+				zap.Durations("timings", timings))
+		}
+		failpoint.Inject("executeSQLError", func(_ failpoint.Value) {
+			w.logger.Info("", zap.String("failpoint", "executeSQLError"))
+			err = errors.New("inject failpoint executeSQLError")
+		})
+		if err != nil {
+			// expect pause rather than exit
+			err = terror.WithScope(terror.Annotatef(err, "file %s", job.file), terror.ScopeDownstream)
+			if !utils.IsContextCanceledError(err) {
+				runFatalChan <- unit.NewProcessError(err)
+			}
+			return false
+		}
+		txnHistogram.WithLabelValues(w.cfg.Name, w.cfg.WorkerName, w.cfg.SourceID, job.schema, job.table).Observe(time.Since(startTime).Seconds())
+		w.loader.report.recordTransaction(job, rowCount)
+		failpoint.Inject("loaderCPUpdateOffsetError", func(_ failpoint.Value) {
+			job.file = "notafile" + job.file
+		})
+		return true
+	}
+
+	// saveCheckpoint saves the checkpoint offset of the file of the job, it returns false if it fails.
+	saveCheckpoint := func(job *dataJob, offset int64) bool {
+		if err := w.loader.checkPoint.UpdateOffset(job.file, offset); err != nil {
+			runFatalChan <- unit.NewProcessError(err)
+			return false
+		}
+		if w.loader.cpBatcher != nil {
+			w.loader.cpBatcher.add(job.file, offset)
+		}
+		if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema]; ok {
+			if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable]; ok {
+				w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Store(offset)
+			}
+		}
+		return true
+	}
+
 	doJob := func() {
 		hasError := false
 		for {
@@ -167,46 +320,7 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				continue // continue to read so than the sender will not be blocked
 			}
 
-			sqls := make([]string, 0, 3)
-			sqls = append(sqls, "USE `"+unescapePercent(job.schema, w.logger)+"`;")
-			sqls = append(sqls, job.sql)
-
-			offsetSQL := w.checkPoint.GenSQL(job.file, job.offset)
-			sqls = append(sqls, offsetSQL)
-
-			failpoint.Inject("LoadExceedOffsetExit", func(val failpoint.Value) {
-				threshold, _ := val.(int)
-				if job.offset >= int64(threshold) {
-					w.logger.Warn("load offset execeeds threshold, it will exit", zap.Int64("load offset", job.offset), zap.Int("value", threshold), zap.String("failpoint", "LoadExceedOffsetExit"))
-					utils.OsExit(1)
-				}
-			})
-
-			failpoint.Inject("LoadDataSlowDown", nil)
-
-			failpoint.Inject("LoadDataSlowDownByTask", func(val failpoint.Value) {
-				tasks := val.(string)
-				taskNames := strings.Split(tasks, ",")
-				for _, taskName := range taskNames {
-					if w.cfg.Name == taskName {
-						w.logger.Info("inject failpoint LoadDataSlowDownByTask", zap.String("task", taskName))
-						<-newCtx.Done()
-					}
-				}
-			})
-
-			startTime := time.Now()
-			err := w.conn.executeSQL(ctctx, sqls)
-			failpoint.Inject("executeSQLError", func(_ failpoint.Value) {
-				w.logger.Info("", zap.String("failpoint", "executeSQLError"))
-				err = errors.New("inject failpoint executeSQLError")
-			})
-			if err != nil {
-				// expect pause rather than exit
-				err = terror.WithScope(terror.Annotatef(err, "file %s", job.file), terror.ScopeDownstream)
-				if !utils.IsContextCanceledError(err) {
-					runFatalChan <- unit.NewProcessError(err)
-				}
+			if !execute(job) {
 				hasError = true
 				failpoint.Inject("returnDoJobError", func(_ failpoint.Value) {
 					w.logger.Info("", zap.String("failpoint", "returnDoJobError"))
@@ -214,25 +328,52 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				})
 				continue
 			}
-			txnHistogram.WithLabelValues(w.cfg.Name, w.cfg.WorkerName, w.cfg.SourceID, job.schema, job.table).Observe(time.Since(startTime).Seconds())
-			failpoint.Inject("loaderCPUpdateOffsetError", func(_ failpoint.Value) {
-				job.file = "notafile" + job.file
-			})
-			if err := w.loader.checkPoint.UpdateOffset(job.file, job.offset); err != nil {
-				runFatalChan <- unit.NewProcessError(err)
+			if !saveCheckpoint(job, job.offset) {
 				hasError = true
 				continue
 			}
 			// update finished offset after checkpoint updated
 			w.loader.finishedDataSize.Add(job.offset - job.lastOffset)
-			if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema]; ok {
-				if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable]; ok {
-					w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Store(job.offset)
-				}
+		}
+	}
+
+	// executeChunks executes the chunks in the queue of the chunkScheduler, which may be read by
+	// other workers, until all workers finish reading. The chunks of a failed file are skipped.
+	executeChunks := func(scheduler *chunkScheduler) {
+		for {
+			job, ok := scheduler.next(newCtx)
+			if !ok {
+				w.logger.Info("chunk queue was closed, execution goroutine exits")
+				return
+			}
+			startTime := time.Now()
+			succeeded := !job.progress.isFailed() && execute(job)
+			job.progress.finish(job.offset, succeeded, func(offset int64) bool {
+				return saveCheckpoint(job, offset)
+			})
+			if succeeded {
+				w.loader.finishedDataSize.Add(job.offset - job.lastOffset)
 			}
+			scheduler.record(w.id, job, time.Since(startTime))
 		}
 	}
 
+	if scheduler := w.loader.scheduler; scheduler != nil {
+		var executing sync.WaitGroup
+		executing.Add(1)
+		go func() {
+			defer executing.Done()
+			executeChunks(scheduler)
+		}()
+		// the worker keeps executing the chunks read by others after it finishes reading.
+		defer func() {
+			scheduler.readerDone()
+			executing.Wait()
+			scheduler.updateUtilization(w.id)
+			w.logger.Info("worker finishes executing chunks", zap.Int64("stolen chunks", scheduler.stolenCount(w.id)))
+		}()
+	}
+
 	// worker main routine
 	for {
 		select {
@@ -245,11 +386,13 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				return
 			}
 
-			w.wg.Add(1)
-			go func() {
-				defer w.wg.Done()
-				doJob()
-			}()
+			if w.loader.scheduler == nil {
+				w.wg.Add(1)
+				go func() {
+					defer w.wg.Done()
+					doJob()
+				}()
+			}
 
 			// restore a table
 			if err := w.restoreDataFile(ctx, filepath.Join(w.cfg.Dir, job.dataFile), job.offset, job.info); err != nil {
@@ -260,12 +403,21 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				}
 				return
 			}
+			if job.done != nil {
+				job.done.Done()
+			}
 		}
 	}
 }
 
 func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset int64, table *tableInfo) error {
 	w.logger.Info("start to restore dump sql file", zap.String("data file", filePath))
+	if w.loader.scheduler != nil {
+		w.progress = w.loader.scheduler.newFile()
+		defer func() {
+			w.progress = nil
+		}()
+	}
 	err := w.dispatchSQL(ctx, filePath, offset, table)
 	if err != nil {
 		return err
@@ -276,11 +428,18 @@ func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset in
 		failpoint.Return(errors.New("inject failpoint dispatchError"))
 	})
 
-	// dispatchSQL completed, send nil to make sure all dmls are applied to target database
-	// we don't want to close and re-make chan frequently
-	// but if we need to re-call w.run, we need re-make jobQueue chan
-	w.jobQueue <- nil
-	w.wg.Wait()
+	if w.progress != nil {
+		// the chunks may be executed by other workers, wait for all of them are finished
+		if err = w.progress.wait(ctx); err != nil {
+			return err
+		}
+	} else {
+		// dispatchSQL completed, send nil to make sure all dmls are applied to target database
+		// we don't want to close and re-make chan frequently
+		// but if we need to re-call w.run, we need re-make jobQueue chan
+		w.jobQueue <- nil
+		w.wg.Wait()
+	}
 
 	w.logger.Info("finish to restore dump sql file", zap.String("data file", filePath))
 	return nil
@@ -288,9 +447,10 @@ func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset in
 
 func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, table *tableInfo) error {
 	var (
-		f   *os.File
-		err error
-		cur int64
+		f        *os.File
+		err      error
+		cur      int64
+		rowCount int
 	)
 
 	baseFile := filepath.Base(file)
@@ -357,34 +517,22 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 		}
 
 		data = append(data, []byte(line)...)
+		if realLine[0] == '(' {
+			rowCount++
+		}
 		if realLine[len(realLine)-1] == ';' {
-			query := strings.TrimSpace(string(data))
-			if strings.HasPrefix(query, "/*") && strings.HasSuffix(query, "*/;") {
+			trimmed := bytes.TrimSpace(data)
+			if bytes.HasPrefix(trimmed, []byte("/*")) && bytes.HasSuffix(trimmed, []byte("*/;")) {
 				data = data[0:0]
+				rowCount = 0
 				continue
 			}
 
-			// extend column also need use reassemble to write SQL and the table name has been renamed
-			if w.loader.columnMapping != nil || len(table.extendCol) > 0 {
-				// column mapping and route table
-				query, err = reassemble(data, table, w.loader.columnMapping)
-				if err != nil {
-					return terror.Annotatef(err, "file %s", file)
-				}
-			} else if table.sourceTable != table.targetTable {
-				// dumped data files always use backquote as quotes
-				query = renameShardingTable(query, table.sourceTable, table.targetTable, false)
-			}
-
-			idx := strings.Index(query, "INSERT INTO")
-			if idx < 0 {
-				return terror.ErrLoadUnitInvalidInsertSQL.Generate(query)
+			// the rows are in the same line of INSERT INTO if the statement is one line.
+			if rowCount == 0 {
+				rowCount = 1
 			}
-
-			data = data[0:0]
-
 			j := &dataJob{
-				sql:          query,
 				schema:       table.targetSchema,
 				table:        table.targetTable,
 				sourceSchema: table.sourceSchema,
@@ -393,9 +541,35 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 				absPath:      file,
 				offset:       cur,
 				lastOffset:   lastOffset,
+				info:         table,
+				rowCount:     rowCount,
 			}
+			if w.loader.parser != nil {
+				// the statement is parsed by the parsers, the job is sent to
+				// the job queue in order and waits for the parsing there.
+				j.stmt = append([]byte(nil), data...)
+				if err = w.loader.parser.submit(ctx, j); err != nil {
+					w.logger.Info("sql dispatcher is ready to quit.", zap.String("data file", file), zap.Int64("offset", offset))
+					return nil
+				}
+			} else {
+				j.sql, j.rows, err = parseStatement(data, table, w.loader.columnMapping, w.loader.dedup != nil)
+				if err != nil {
+					return terror.Annotatef(err, "file %s", file)
+				}
+			}
+			data = data[0:0]
 			lastOffset = cur
+			rowCount = 0
 
+			if w.progress != nil {
+				j.progress, j.reader = w.progress, w.id
+				if err = w.loader.scheduler.submit(ctx, j); err != nil {
+					w.logger.Info("sql dispatcher is ready to quit.", zap.String("data file", file), zap.Int64("offset", offset))
+					return nil
+				}
+				continue
+			}
 			w.jobQueue <- j
 		}
 	}
@@ -422,6 +596,8 @@ type Loader struct {
 	cli        *clientv3.Client
 	workerName string
 	checkPoint CheckPoint
+	// cpBatcher batches the checkpoint updates, nil if checkpoint-batch-interval-logical is not set
+	cpBatcher *checkpointBatcher
 
 	logger log.Logger
 
@@ -435,9 +611,36 @@ type Loader struct {
 	tableRouter   *regexprrouter.RouteTable
 	baList        *filter.Filter
 	columnMapping *cm.Mapping
+	loadOrder     *loadOrder
 
 	toDB      *conn.BaseDB
 	toDBConns []*DBConn
+	// toReadDB and toReadDBConns are used by verification reads, nil if checksum-logical is not enabled
+	toReadDB      *conn.BaseDB
+	toReadDBConns []*DBConn
+	deadlocks     *deadlockRecorder
+	// throttle limits the rate of loading, nil if neither rate-limit-logical nor backpressure-logical is set
+	throttle *loadThrottle
+	// dedup skips the rows already applied to the downstream, nil if dedup-logical is not enabled
+	dedup *loadDedup
+	// slowQueryPlans captures the plans of slow queries, nil if slow-query-plan-logical is not set
+	slowQueryPlans *slowQueryPlanCapturer
+	// retryTuner tunes the retry params of the downstream statements, nil if adaptive-retry-logical is not enabled
+	retryTuner *retryTuner
+	// report collects the statistics for GenerateLoadReport
+	report *loadReportRecorder
+	// parser parses the dumped statements for workers, nil if parse-pool-size-logical is 0
+	parser *statementParser
+	// scheduler shares the chunks of data files among workers, nil if work-stealing-logical is not enabled
+	scheduler *chunkScheduler
+	// idempotency writes the idempotency keys of transactions, nil if idempotency-key-logical is not set
+	idempotency *idempotencyKeeper
+	// restoringSQLMode is the sql_mode of the downstream restored after loading, only set when
+	// sql-mode-logical is "auto-relax" and some strict modes are relaxed
+	restoringSQLMode string
+
+	// results of verifying checksum after load, only set when checksum-logical is enabled
+	checksumResults []*TableChecksumResult
 
 	totalFileCount   atomic.Int64 // schema + table + data
 	totalDataSize    atomic.Int64
@@ -474,9 +677,11 @@ func NewLoader(cfg *config.SubTaskConfig, cli *clientv3.Client, workerName strin
 		db2Tables:     make(map[string]Tables2DataFiles),
 		tableInfos:    make(map[string]*tableInfo),
 		workerWg:      new(sync.WaitGroup),
-		logger:        log.With(zap.String("task", cfg.Name), zap.String("unit", "load")),
+		logger:        log.ForTask(cfg.Name, cfg.SourceID).WithFields(zap.String("task", cfg.Name), zap.String("unit", "load")),
 		workerName:    workerName,
 		speedRecorder: export.NewSpeedRecorder(),
+		deadlocks:     &deadlockRecorder{},
+		report:        newLoadReportRecorder(),
 	}
 	loader.fileJobQueueClosed.Store(true) // not open yet
 	return loader
@@ -499,7 +704,12 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 
 	tctx := tcontext.NewContext(ctx, l.logger)
 
-	checkpoint, err := newRemoteCheckPoint(tctx, l.cfg, l.checkpointID())
+	var checkpoint CheckPoint
+	if l.cfg.CheckpointStorage == config.LoaderCheckpointLocal {
+		checkpoint, err = newLocalCheckPoint(tctx, l.cfg, l.checkpointID(), l.cli)
+	} else {
+		checkpoint, err = newRemoteCheckPoint(tctx, l.cfg, l.checkpointID())
+	}
 	failpoint.Inject("ignoreLoadCheckpointErr", func(_ failpoint.Value) {
 		l.logger.Info("", zap.String("failpoint", "ignoreLoadCheckpointErr"))
 		err = nil
@@ -509,6 +719,7 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 	}
 	l.checkPoint = checkpoint
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-checkpoint", Fn: l.checkPoint.Close})
+	l.cpBatcher = newCheckpointBatcher(l.cfg, l.checkPoint, l.logger)
 
 	l.baList, err = filter.New(l.cfg.CaseSensitive, l.cfg.BAList)
 	if err != nil {
@@ -520,6 +731,11 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 		return err
 	}
 
+	l.loadOrder, err = newLoadOrder(l.cfg.LoadOrderLogical, l.cfg.CaseSensitive)
+	if err != nil {
+		return err
+	}
+
 	if len(l.cfg.ColumnMappingRules) > 0 {
 		l.columnMapping, err = cm.NewMapping(l.cfg.CaseSensitive, l.cfg.ColumnMappingRules)
 		if err != nil {
@@ -574,14 +790,110 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 
 	l.logger.Info("loader's sql_mode is", zap.String("sqlmode", lcfg.To.Session["sql_mode"]))
 
-	l.toDB, l.toDBConns, err = createConns(tctx, lcfg, lcfg.Name, lcfg.SourceID, l.cfg.PoolSize)
+	readPoolSize := 0
+	if l.cfg.ChecksumLogical {
+		readPoolSize = l.cfg.ReadPoolSizeLogical
+	}
+	l.toDB, l.toDBConns, l.toReadDB, l.toReadDBConns, err = createConns(
+		tctx, lcfg, lcfg.Name, lcfg.SourceID, l.cfg.PoolSize, readPoolSize)
 	if err != nil {
 		return err
 	}
+	l.throttle = newLoadThrottle(l.cfg, l.toDB, l.logger)
+	l.dedup = newLoadDedup(l.cfg, l.logger)
+	// the plans are captured on side connections of the write pool.
+	l.slowQueryPlans = newSlowQueryPlanCapturer(l.cfg, l.toDB, l.logger)
+	l.retryTuner = newRetryTuner(l.cfg, l.logger)
+	l.idempotency = newIdempotencyKeeper(l.cfg, l.logger)
+	resets := newConnResetTracker(l.cfg.Name, l.cfg.SourceID)
+	cursor := newTableCursor(l.cfg.CursorBatchSizeLogical)
+	for _, dbConn := range l.toDBConns {
+		dbConn.deadlocks = l.deadlocks
+		dbConn.throttle = l.throttle
+		dbConn.resets = resets
+		dbConn.plans = l.slowQueryPlans
+		dbConn.retries = l.retryTuner
+		dbConn.report = l.report
+		dbConn.idempotency = l.idempotency
+		dbConn.writeConflictRetries = l.cfg.WriteConflictRetryCountLogical
+		dbConn.cursor = cursor
+	}
+	for _, dbConn := range l.toReadDBConns {
+		dbConn.resets = resets
+		dbConn.cursor = cursor
+		dbConn.plans = l.slowQueryPlans
+		dbConn.retries = l.retryTuner
+	}
+	// the time zone is also in the session variables of the DSN, but set it
+	// explicitly so that the downstream is validated to accept it and a reset
+	// connection never loads TIMESTAMP values with the default time zone.
+	for _, dbConn := range append(append([]*DBConn{}, l.toDBConns...), l.toReadDBConns...) {
+		if err = dbConn.SetTimeZone(tctx, timeZone); err != nil {
+			return err
+		}
+	}
+	if l.idempotency != nil {
+		if err = l.idempotency.prepare(tctx, l.toDBConns[0]); err != nil {
+			return err
+		}
+	}
+
+	return l.setSQLMode(tctx)
+}
 
+// setSQLMode sets the session sql_mode of the downstream connections by sql-mode-logical.
+func (l *Loader) setSQLMode(tctx *tcontext.Context) error {
+	sqlMode := l.cfg.SQLModeLogical
+	switch sqlMode {
+	case "":
+		// the sql_mode in the session variables of the DSN is used.
+		return nil
+	case config.LoaderSQLModeAuto, config.LoaderSQLModeAutoRelax:
+		detected, err := l.toDBConns[0].globalSQLMode(tctx)
+		if err != nil {
+			return terror.WithScope(err, terror.ScopeDownstream)
+		}
+		sqlMode = detected
+		if l.cfg.SQLModeLogical == config.LoaderSQLModeAutoRelax {
+			var relaxed []string
+			sqlMode, relaxed = relaxSQLMode(detected)
+			if len(relaxed) > 0 {
+				l.logger.Warn("relax the strict sql_mode of the downstream during loading, invalid values will be adjusted instead of failing the load",
+					zap.String("sql_mode", detected), zap.Strings("relaxed", relaxed))
+				l.restoringSQLMode = detected
+			}
+		}
+	}
+	for _, dbConn := range append(append([]*DBConn{}, l.toDBConns...), l.toReadDBConns...) {
+		if err := dbConn.SetSQLMode(tctx, sqlMode); err != nil {
+			return terror.WithScope(err, terror.ScopeDownstream)
+		}
+	}
+	l.logger.Info("loader's session sql_mode is set", zap.String("sqlmode", sqlMode))
 	return nil
 }
 
+// restoreSQLMode restores the sql_mode relaxed by setSQLMode after loading. The
+// failure is only logged, since the data is already loaded.
+func (l *Loader) restoreSQLMode(tctx *tcontext.Context) {
+	if l.restoringSQLMode == "" {
+		return
+	}
+	for _, dbConn := range append(append([]*DBConn{}, l.toDBConns...), l.toReadDBConns...) {
+		if err := dbConn.SetSQLMode(tctx, l.restoringSQLMode); err != nil {
+			l.logger.Warn("failed to restore the sql_mode of the downstream connection",
+				zap.String("sql_mode", l.restoringSQLMode), log.ShortError(err))
+		}
+	}
+	l.logger.Info("restore the sql_mode of the downstream after loading", zap.String("sqlmode", l.restoringSQLMode))
+	l.restoringSQLMode = ""
+}
+
+// LastDeadlockInfo returns the details of the most recent deadlock met by the loader, nil if no deadlock.
+func (l *Loader) LastDeadlockInfo() *DeadlockInfo {
+	return l.deadlocks.lastInfo()
+}
+
 func (l *Loader) handleExitErrMetric(err *pb.ProcessError) {
 	resumable := fmt.Sprintf("%t", unit.IsResumableError(err))
 	loaderExitWithErrorCounter.WithLabelValues(l.cfg.Name, l.cfg.SourceID, resumable).Inc()
@@ -595,11 +907,14 @@ func (l *Loader) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	newCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	l.report.begin(time.Now())
+
 	l.newFileJobQueue()
 	binlog, gtid, err := getMydumpMetadata(ctx, l.cli, l.cfg, l.workerName)
 	if err != nil {
 		processError := unit.NewProcessError(err)
 		l.handleExitErrMetric(processError)
+		l.report.end(time.Now(), []*pb.ProcessError{processError})
 		pr <- pb.ProcessResult{
 			Errors: []*pb.ProcessError{processError},
 		}
@@ -658,6 +973,7 @@ func (l *Loader) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	default:
 	}
 
+	l.report.end(time.Now(), errs)
 	pr <- pb.ProcessResult{
 		IsCanceled: isCanceled,
 		Errors:     errs,
@@ -758,6 +1074,31 @@ func (l *Loader) Restore(ctx context.Context) error {
 		return err
 	}
 	l.loadFinishedSize()
+	if l.throttle != nil {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.throttle.run(ctx)
+		}()
+	}
+	if l.cpBatcher != nil {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.cpBatcher.run(ctx)
+		}()
+	}
+	// the parser is created for every restoring, because the column mapping may be updated.
+	l.parser = newStatementParser(l.cfg.ParsePoolSizeLogical, l.columnMapping, l.dedup != nil)
+	if l.parser != nil {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.parser.run(ctx)
+		}()
+	}
+	// the scheduler is created for every restoring, because its queue is closed after the workers exit.
+	l.scheduler = newChunkScheduler(l.cfg.WorkStealingLogical, l.cfg.Name, l.cfg.SourceID, l.cfg.PoolSize, l.chunksOrdered())
 	if err2 := l.initAndStartWorkerPool(ctx); err2 != nil {
 		l.logger.Error("initial and start worker pools failed", log.ShortError(err))
 		return err2
@@ -775,11 +1116,37 @@ func (l *Loader) Restore(ctx context.Context) error {
 	l.closeFileJobQueue() // all data file dispatched, close it
 	l.workerWg.Wait()
 
+	if l.cpBatcher != nil {
+		// the loaded data are kept even if the loader is paused, so flush their checkpoints with a
+		// new context, otherwise they are loaded again when resuming.
+		if err2 := l.cpBatcher.flush(tcontext.NewContext(context.Background(), l.logger)); err2 != nil {
+			l.logger.Error("flush batched checkpoints failed",
+				zap.Int("pending files", l.cpBatcher.pendingCount()), log.ShortError(err2))
+			if err == nil {
+				return err2
+			}
+		}
+	}
+
 	if err == nil {
 		l.finish.Store(true)
 		l.logger.Info("all data files have been finished", zap.Duration("cost time", time.Since(begin)))
+		l.restoreSQLMode(tcontext.NewContext(ctx, l.logger))
 		if l.checkPoint.AllFinished() {
-			if l.cfg.Mode == config.ModeFull {
+			if l.idempotency != nil {
+				l.idempotency.clean(tcontext.NewContext(ctx, l.logger), l.toDBConns[0])
+			}
+			if l.cfg.ChecksumLogical {
+				if err = l.verifyChecksum(ctx); err != nil {
+					return err
+				}
+			}
+			if err = writeLoadSyncMarker(ctx, l.toDB, l.cfg, l.logger); err != nil {
+				return err
+			}
+			// keep the load task for local checkpoint, it's used to find out whether the checkpoint
+			// is removed by `--remove-meta`, see LocalCheckPoint.clearIfStale
+			if l.cfg.Mode == config.ModeFull && l.cfg.CheckpointStorage != config.LoaderCheckpointLocal {
 				if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 					return err
 				}
@@ -818,9 +1185,15 @@ func (l *Loader) Close() {
 
 	l.stopLoad()
 
+	l.slowQueryPlans.close()
 	if err := l.toDB.Close(); err != nil {
 		l.logger.Error("close downstream DB error", log.ShortError(err))
 	}
+	if l.toReadDB != nil {
+		if err := l.toReadDB.Close(); err != nil {
+			l.logger.Error("close downstream read DB error", log.ShortError(err))
+		}
+	}
 	l.checkPoint.Close()
 	l.removeLabelValuesWithTaskInMetrics(l.cfg.Name)
 	l.closed.Store(true)
@@ -855,6 +1228,24 @@ func (l *Loader) Pause() {
 	}
 
 	l.stopLoad()
+
+	ctx, cancel := context.WithTimeout(context.Background(), compactCheckpointTimeout)
+	defer cancel()
+	if err := l.CompactCheckpoint(ctx); err != nil {
+		l.logger.Warn("compact checkpoint failed when pausing",
+			zap.Duration("timeout", compactCheckpointTimeout), log.ShortError(err))
+	}
+}
+
+// CompactCheckpoint merges the checkpoints of contiguous finished data files to reduce the size
+// of checkpoint and speed up loading it when resuming. It's called when the task is paused, and
+// can also be called periodically.
+func (l *Loader) CompactCheckpoint(ctx context.Context) error {
+	if len(l.db2Tables) == 0 {
+		// data files are not scanned yet
+		return nil
+	}
+	return l.checkPoint.Compact(tcontext.NewContext(ctx, l.logger), l.db2Tables)
 }
 
 // Resume resumes the paused process.
@@ -887,6 +1278,12 @@ func (l *Loader) resetDBs(ctx context.Context) error {
 			return terror.WithScope(err, terror.ScopeDownstream)
 		}
 	}
+	for i := 0; i < len(l.toReadDBConns); i++ {
+		err = l.toReadDBConns[i].resetConn(tctx)
+		if err != nil {
+			return terror.WithScope(err, terror.ScopeDownstream)
+		}
+	}
 
 	err = l.checkPoint.ResetConn(tctx)
 	if err != nil {
@@ -964,6 +1361,17 @@ func (l *Loader) genRouter(rules []*router.TableRule) error {
 	return nil
 }
 
+// chunksOrdered returns whether the chunks of a data file must be executed in order when the workers
+// steal chunks. It's true if the loaded data can't be loaded again, because a chunk loaded before the
+// checkpoint advances over it is loaded again after a crash, and the checkpoint written with the data
+// can't go back.
+func (l *Loader) chunksOrdered() bool {
+	if _, ok := l.checkPoint.(*LocalCheckPoint); !ok && l.cpBatcher == nil {
+		return true
+	}
+	return l.cfg.OnDuplicateLogical == config.OnDuplicateError && !l.cfg.DedupLogical
+}
+
 func (l *Loader) initAndStartWorkerPool(ctx context.Context) error {
 	for i := 0; i < l.cfg.PoolSize; i++ {
 		worker := NewWorker(l, i)
@@ -1359,9 +1767,11 @@ func (q *jobQueue) startConsumers(handler func(ctx context.Context, job *restore
 							}
 						}(baseConn)
 						session = &DBConn{
-							name:     job.loader.cfg.Name,
-							sourceID: job.loader.cfg.SourceID,
-							baseConn: baseConn,
+							name:                 job.loader.cfg.Name,
+							sourceID:             job.loader.cfg.SourceID,
+							baseConn:             baseConn,
+							deadlocks:            job.loader.deadlocks,
+							writeConflictRetries: job.loader.cfg.WriteConflictRetryCountLogical,
 							resetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
 								return nil, terror.WithScope(terror.ErrDBBadConn.Generate("bad connection error restoreData"), terror.ScopeDownstream)
 							},
@@ -1383,7 +1793,11 @@ func (q *jobQueue) startConsumers(handler func(ctx context.Context, job *restore
 
 func (l *Loader) restoreData(ctx context.Context) error {
 	begin := time.Now()
-	dispatchMap := make(map[string]*fileJob)
+	// dispatchMaps[i] holds the data file jobs of the i-th tier of load order
+	dispatchMaps := make([]map[string]*fileJob, l.loadOrder.tierCount())
+	for i := range dispatchMaps {
+		dispatchMaps[i] = make(map[string]*fileJob)
+	}
 	concurrency := l.cfg.PoolSize
 	// `for v := range map` would present random order
 	// `dbs` array keep same order for restore schema job generating
@@ -1496,6 +1910,7 @@ tblSchemaLoop:
 	for _, db := range dbs {
 		table2DataFileMap := l.db2Tables[db]
 		for table := range table2DataFileMap {
+			dispatchMap := dispatchMaps[l.loadOrder.tierOf(db, table)]
 			restoringFiles := l.checkPoint.GetRestoringFileInfo(db, table)
 			l.logger.Debug("restoring table data", zap.String("schema", db), zap.String("table", table), zap.Reflect("data files", restoringFiles))
 
@@ -1525,14 +1940,45 @@ tblSchemaLoop:
 		}
 	}
 
-	// a simple and naive approach to dispatch files randomly based on the feature of golang map(range by random)
-	for _, j := range dispatchMap {
+	// dispatch tiers one by one, the restored data files are skipped by checkpoint when resuming,
+	// so a tier still starts after all data files of the previous tiers are restored.
+	for tier, dispatchMap := range dispatchMaps {
+		if len(dispatchMap) == 0 {
+			continue
+		}
+		l.logger.Info("dispatch data files of load order tier", zap.Int("tier", tier+1), zap.Int("data files", len(dispatchMap)))
+		isLastTier := tier == len(dispatchMaps)-1
+		var done sync.WaitGroup
+		// a simple and naive approach to dispatch files randomly based on the feature of golang map(range by random)
+		for _, j := range dispatchMap {
+			if !isLastTier {
+				done.Add(1)
+				j.done = &done
+			}
+			select {
+			case <-ctx.Done():
+				l.logger.Warn("stop dispatch data file job", log.ShortError(ctx.Err()))
+				return ctx.Err()
+			case l.fileJobQueue <- j:
+			}
+		}
+		if isLastTier {
+			break
+		}
+
+		// a failed worker exits without notifying, and the context is canceled then
+		doneCh := make(chan struct{})
+		go func() {
+			done.Wait()
+			close(doneCh)
+		}()
 		select {
 		case <-ctx.Done():
-			l.logger.Warn("stop dispatch data file job", log.ShortError(ctx.Err()))
+			l.logger.Warn("stop waiting for data files of load order tier", zap.Int("tier", tier+1), log.ShortError(ctx.Err()))
 			return ctx.Err()
-		case l.fileJobQueue <- j:
+		case <-doneCh:
 		}
+		l.logger.Info("all data files of load order tier have been restored", zap.Int("tier", tier+1))
 	}
 
 	l.logger.Info("all data files have been dispatched, waiting for them finished")
@@ -97,6 +97,136 @@ var (
 			Help:      "counter for loader exits with error",
 		}, []string{"task", "source_id", "resumable_err"})
 
+	deadlockCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "deadlock_count",
+			Help:      "Total count of deadlocks met when executing statements",
+		}, []string{"task", "source_id"})
+
+	deadlockRetryDelayHistogram = f.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "deadlock_retry_delay",
+			Help:      "Bucketed histogram of time (s) from meeting a deadlock to finishing the retries",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+		}, []string{"task", "source_id"})
+
+	writeConflictCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "write_conflict_count",
+			Help:      "Total count of write conflicts met when executing statements",
+		}, []string{"task", "source_id"})
+
+	preparedStmtEvictionCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "prepared_stmt_eviction_count",
+			Help:      "Total count of evicting prepared statements when max_prepared_stmt_count of the downstream is exhausted",
+		}, []string{"task", "source_id"})
+
+	throttleMultiplierGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "throttle_multiplier",
+			Help:      "the multiplier of the rate limit of loader in the active throttle window",
+		}, []string{"task", "source_id"})
+
+	backpressureThrottledGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "backpressure_throttled",
+			Help:      "whether the loader is throttled by the overload signal of the downstream, 1 for throttled and 0 for not",
+		}, []string{"task", "source_id"})
+
+	connResetCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "conn_reset_count",
+			Help:      "Total count of resetting connections to the downstream",
+		}, []string{"task", "source_id", "result"})
+
+	connResettingGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "conn_resetting",
+			Help:      "the number of connections to the downstream being reset",
+		}, []string{"task", "source_id"})
+
+	connResetSuccessRatioGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "conn_reset_success_ratio",
+			Help:      "the ratio of successful resets of connections to the downstream in the last minute",
+		}, []string{"task", "source_id"})
+
+	connResetRecoveryHistogram = f.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "conn_reset_recovery_duration",
+			Help:      "Bucketed histogram of time (s) from the first reset of connections to all of them are reset successfully",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+		}, []string{"task", "source_id"})
+
+	dedupRowCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "dedup_row_count",
+			Help:      "Total count of rows hitting the dedup bloom filter, by whether they are skipped or false positives",
+		}, []string{"task", "source_id", "result"})
+
+	slowQueryPlanCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "slow_query_plan_count",
+			Help:      "Total count of slow queries, by whether their plans are captured, failed to capture or skipped",
+		}, []string{"task", "source_id", "result"})
+
+	idempotentTxnCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "idempotent_txn_count",
+			Help:      "Total count of transactions retried after ambiguous failures, by whether they are committed before and skipped",
+		}, []string{"task", "source_id", "result"})
+
+	clockSkewGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "downstream_clock_skew",
+			Help:      "the skew (s) of the downstream clock from the local clock, which is positive if the downstream is ahead",
+		}, []string{"task", "source_id"})
+
+	stolenChunkCounter = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "stolen_chunk_count",
+			Help:      "Total count of chunks executed by the workers other than their readers when work-stealing-logical is enabled",
+		}, []string{"task", "source_id"})
+
+	workerUtilizationGauge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "worker_utilization",
+			Help:      "the ratio of the time a worker is busy executing chunks when work-stealing-logical is enabled",
+		}, []string{"task", "source_id", "worker_id"})
+
 	remainingTimeGauge = f.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "dm",
@@ -118,6 +248,22 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(progressGauge)
 	registry.MustRegister(loaderExitWithErrorCounter)
 	registry.MustRegister(remainingTimeGauge)
+	registry.MustRegister(deadlockCounter)
+	registry.MustRegister(deadlockRetryDelayHistogram)
+	registry.MustRegister(writeConflictCounter)
+	registry.MustRegister(preparedStmtEvictionCounter)
+	registry.MustRegister(throttleMultiplierGauge)
+	registry.MustRegister(backpressureThrottledGauge)
+	registry.MustRegister(connResetCounter)
+	registry.MustRegister(connResettingGauge)
+	registry.MustRegister(connResetSuccessRatioGauge)
+	registry.MustRegister(connResetRecoveryHistogram)
+	registry.MustRegister(dedupRowCounter)
+	registry.MustRegister(slowQueryPlanCounter)
+	registry.MustRegister(idempotentTxnCounter)
+	registry.MustRegister(clockSkewGauge)
+	registry.MustRegister(stolenChunkCounter)
+	registry.MustRegister(workerUtilizationGauge)
 }
 
 func (l *Loader) removeLabelValuesWithTaskInMetrics(task string) {
@@ -131,4 +277,20 @@ func (l *Loader) removeLabelValuesWithTaskInMetrics(task string) {
 	progressGauge.DeletePartialMatch(prometheus.Labels{"task": task})
 	loaderExitWithErrorCounter.DeletePartialMatch(prometheus.Labels{"task": task})
 	remainingTimeGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	deadlockCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	deadlockRetryDelayHistogram.DeletePartialMatch(prometheus.Labels{"task": task})
+	writeConflictCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	preparedStmtEvictionCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	throttleMultiplierGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	backpressureThrottledGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	connResetCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	connResettingGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	connResetSuccessRatioGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	connResetRecoveryHistogram.DeletePartialMatch(prometheus.Labels{"task": task})
+	dedupRowCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	slowQueryPlanCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	idempotentTxnCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	clockSkewGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	stolenChunkCounter.DeletePartialMatch(prometheus.Labels{"task": task})
+	workerUtilizationGauge.DeletePartialMatch(prometheus.Labels{"task": task})
 }
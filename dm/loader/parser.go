@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// parseStatement rewrites the dumped INSERT statement in data for the target
+// table, and parses its rows if they are needed by the dedup.
+func parseStatement(data []byte, table *tableInfo, columnMapping *cm.Mapping, withRows bool) (string, [][]string, error) {
+	var err error
+	query := strings.TrimSpace(string(data))
+	// extend column also need use reassemble to write SQL and the table name has been renamed
+	if columnMapping != nil || len(table.extendCol) > 0 {
+		// column mapping and route table
+		query, err = reassemble(data, table, columnMapping)
+		if err != nil {
+			return "", nil, err
+		}
+	} else if table.sourceTable != table.targetTable {
+		// dumped data files always use backquote as quotes
+		query = renameShardingTable(query, table.sourceTable, table.targetTable, false)
+	}
+
+	idx := strings.Index(query, "INSERT INTO")
+	if idx < 0 {
+		return "", nil, terror.ErrLoadUnitInvalidInsertSQL.Generate(query)
+	}
+
+	var rows [][]string
+	if withRows {
+		rows, err = parseInsertStmt(data, table, columnMapping)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	return query, rows, nil
+}
+
+// statementParser is a pool of goroutines parsing and rewriting the dumped
+// statements, so that the CPU-bound parsing is separated from the workers
+// executing statements on the downstream. It's shared by workers.
+type statementParser struct {
+	size          int
+	columnMapping *cm.Mapping
+	withRows      bool
+	// jobs are the data jobs waiting for parsing, it's bounded so that the
+	// readers of data files are blocked if the parsers fall behind.
+	jobs chan *dataJob
+}
+
+// newStatementParser creates a statementParser, it returns nil if
+// parse-pool-size-logical is 0, then the statements are parsed by the readers
+// of data files.
+func newStatementParser(size int, columnMapping *cm.Mapping, withRows bool) *statementParser {
+	if size <= 0 {
+		return nil
+	}
+	return &statementParser{
+		size:          size,
+		columnMapping: columnMapping,
+		withRows:      withRows,
+		jobs:          make(chan *dataJob, jobCount),
+	}
+}
+
+// run runs the parsers until ctx is done.
+func (p *statementParser) run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < p.size; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-p.jobs:
+					job.sql, job.rows, job.parseErr = parseStatement(job.stmt, job.info, p.columnMapping, p.withRows)
+					job.stmt = nil
+					close(job.parsed)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// submit submits the job to be parsed, job.parsed is closed after it's parsed.
+// It's blocked if there are too many jobs waiting for parsing.
+func (p *statementParser) submit(ctx context.Context, job *dataJob) error {
+	job.parsed = make(chan struct{})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case p.jobs <- job:
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementParser(t *testing.T) {
+	require.Nil(t, newStatementParser(0, nil, false))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	parser := newStatementParser(4, nil, false)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		parser.run(ctx)
+	}()
+
+	table := &tableInfo{
+		sourceSchema: "db",
+		sourceTable:  "t",
+		targetSchema: "db",
+		targetTable:  "t_all",
+	}
+	jobs := make([]*dataJob, 0, 100)
+	for i := 0; i < 100; i++ {
+		job := &dataJob{
+			stmt: []byte(fmt.Sprintf("INSERT INTO `t` VALUES\n(%d,'a');\n", i)),
+			info: table,
+		}
+		require.NoError(t, parser.submit(ctx, job))
+		jobs = append(jobs, job)
+	}
+	for i, job := range jobs {
+		<-job.parsed
+		require.NoError(t, job.parseErr)
+		require.Equal(t, fmt.Sprintf("INSERT INTO `t_all` VALUES\n(%d,'a');", i), job.sql)
+		require.Nil(t, job.stmt)
+	}
+
+	job := &dataJob{stmt: []byte("SELECT 1;"), info: table}
+	require.NoError(t, parser.submit(ctx, job))
+	<-job.parsed
+	require.True(t, terror.ErrLoadUnitInvalidInsertSQL.Equal(job.parseErr))
+
+	// the parsers exit after ctx is done, and the submitting is canceled.
+	cancel()
+	<-done
+	require.ErrorIs(t, parser.submit(ctx, &dataJob{info: table}), context.Canceled)
+}
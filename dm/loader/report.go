@@ -0,0 +1,257 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pb"
+)
+
+// TableLoadReport is the load summary of a source table.
+type TableLoadReport struct {
+	SourceSchema string `json:"source_schema"`
+	SourceTable  string `json:"source_table"`
+	TargetSchema string `json:"target_schema,omitempty"`
+	TargetTable  string `json:"target_table,omitempty"`
+	// Rows is the number of rows loaded since the loader is created, the rows
+	// loaded before a restart are not counted.
+	Rows int64 `json:"rows"`
+	// LoadedBytes and TotalBytes are calculated from the checkpoints of the
+	// data files of the table.
+	LoadedBytes int64 `json:"loaded_bytes"`
+	TotalBytes  int64 `json:"total_bytes"`
+	Finished    bool  `json:"finished"`
+}
+
+// FileCheckpoint is the checkpoint of a data file.
+type FileCheckpoint struct {
+	File   string `json:"file"`
+	Offset int64  `json:"offset"`
+	EndPos int64  `json:"end_pos"`
+}
+
+// LoadReport is the machine-readable summary of a load. It reflects the
+// partial progress if the load is unfinished or failed.
+type LoadReport struct {
+	Task     string `json:"task"`
+	SourceID string `json:"source_id"`
+	Finished bool   `json:"finished"`
+	// StartTime is the time the load is started, EndTime is the time the last
+	// run of the load returned, it's zero if the load is still running.
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Rows            int64     `json:"rows"`
+	LoadedBytes     int64     `json:"loaded_bytes"`
+	TotalBytes      int64     `json:"total_bytes"`
+	// Transactions is the number of data transactions executed successfully.
+	Transactions int64 `json:"transactions"`
+	// ExecutionErrors is the number of failed executions of the downstream
+	// statements, including the ones succeeded after retrying.
+	ExecutionErrors int64 `json:"execution_errors"`
+	Retries         int64 `json:"retries"`
+	// Errors are the errors of the last run of the load.
+	Errors      []string          `json:"errors,omitempty"`
+	Tables      []TableLoadReport `json:"tables"`
+	Checkpoints []FileCheckpoint  `json:"checkpoints"`
+}
+
+type tableLoadStats struct {
+	targetSchema string
+	targetTable  string
+	rows         int64
+}
+
+// loadReportRecorder collects the statistics of a load for the report, it's
+// thread-safe and can be shared by connections. All methods of a nil
+// loadReportRecorder are no-ops.
+type loadReportRecorder struct {
+	mu              sync.Mutex
+	startTime       time.Time
+	endTime         time.Time
+	tables          map[string]map[string]*tableLoadStats
+	transactions    int64
+	executionErrors int64
+	retries         int64
+	errors          []string
+}
+
+func newLoadReportRecorder() *loadReportRecorder {
+	return &loadReportRecorder{tables: make(map[string]map[string]*tableLoadStats)}
+}
+
+// begin records a run of the load is started, the start time of the first run
+// is kept.
+func (r *loadReportRecorder) begin(now time.Time) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.startTime.IsZero() {
+		r.startTime = now
+	}
+	r.endTime = time.Time{}
+	r.errors = nil
+}
+
+// end records a run of the load is returned with errs.
+func (r *loadReportRecorder) end(now time.Time, errs []*pb.ProcessError) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endTime = now
+	r.errors = make([]string, 0, len(errs))
+	for _, err := range errs {
+		r.errors = append(r.errors, err.String())
+	}
+}
+
+// recordTransaction records a data transaction of the job is executed.
+func (r *loadReportRecorder) recordTransaction(job *dataJob, rows int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transactions++
+	tables, ok := r.tables[job.sourceSchema]
+	if !ok {
+		tables = make(map[string]*tableLoadStats)
+		r.tables[job.sourceSchema] = tables
+	}
+	stats, ok := tables[job.sourceTable]
+	if !ok {
+		stats = &tableLoadStats{targetSchema: job.schema, targetTable: job.table}
+		tables[job.sourceTable] = stats
+	}
+	stats.rows += int64(rows)
+}
+
+// recordExecution records an execution of statements which is tried
+// `attempts` times and failed `failures` times.
+func (r *loadReportRecorder) recordExecution(attempts, failures int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if attempts > 1 {
+		r.retries += int64(attempts - 1)
+	}
+	r.executionErrors += int64(failures)
+}
+
+// report generates the report from the recorded statistics and the checkpoints
+// of the data files, which are got by GetAllRestoringFileInfo.
+func (r *loadReportRecorder) report(now time.Time, checkpoints map[string][]int64) LoadReport {
+	if r == nil {
+		r = newLoadReportRecorder()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := LoadReport{
+		StartTime:       r.startTime,
+		EndTime:         r.endTime,
+		Transactions:    r.transactions,
+		ExecutionErrors: r.executionErrors,
+		Retries:         r.retries,
+		Errors:          append([]string(nil), r.errors...),
+		Tables:          []TableLoadReport{},
+		Checkpoints:     make([]FileCheckpoint, 0, len(checkpoints)),
+	}
+	if !r.startTime.IsZero() {
+		end := r.endTime
+		if end.IsZero() {
+			end = now
+		}
+		report.DurationSeconds = end.Sub(r.startTime).Seconds()
+	}
+
+	tables := make(map[string]map[string]*TableLoadReport)
+	getTable := func(schema, table string) *TableLoadReport {
+		if _, ok := tables[schema]; !ok {
+			tables[schema] = make(map[string]*TableLoadReport)
+		}
+		t, ok := tables[schema][table]
+		if !ok {
+			t = &TableLoadReport{SourceSchema: schema, SourceTable: table}
+			tables[schema][table] = t
+		}
+		return t
+	}
+	for schema, stats := range r.tables {
+		for table, s := range stats {
+			t := getTable(schema, table)
+			t.TargetSchema, t.TargetTable, t.Rows = s.targetSchema, s.targetTable, s.rows
+			report.Rows += s.rows
+		}
+	}
+	// a table is finished if all its data files are finished.
+	unfinished := make(map[*TableLoadReport]bool)
+	for file, pos := range checkpoints {
+		if len(pos) < 2 {
+			continue
+		}
+		report.Checkpoints = append(report.Checkpoints, FileCheckpoint{File: file, Offset: pos[0], EndPos: pos[1]})
+		schema, table, err := getDBAndTableFromFilename(file)
+		if err != nil {
+			continue
+		}
+		t := getTable(schema, table)
+		t.LoadedBytes += pos[0]
+		t.TotalBytes += pos[1]
+		unfinished[t] = unfinished[t] || pos[0] < pos[1]
+	}
+	for _, ts := range tables {
+		for _, t := range ts {
+			if isUnfinished, ok := unfinished[t]; ok {
+				t.Finished = !isUnfinished
+			}
+			report.Tables = append(report.Tables, *t)
+		}
+	}
+	sort.Slice(report.Tables, func(i, j int) bool {
+		if report.Tables[i].SourceSchema != report.Tables[j].SourceSchema {
+			return report.Tables[i].SourceSchema < report.Tables[j].SourceSchema
+		}
+		return report.Tables[i].SourceTable < report.Tables[j].SourceTable
+	})
+	sort.Slice(report.Checkpoints, func(i, j int) bool {
+		return report.Checkpoints[i].File < report.Checkpoints[j].File
+	})
+	return report
+}
+
+// GenerateLoadReport generates the summary of the load, it can be called at
+// any time, e.g. after the load failed, and reflects the progress so far.
+func (l *Loader) GenerateLoadReport() LoadReport {
+	var checkpoints map[string][]int64
+	if l.checkPoint != nil {
+		checkpoints = l.checkPoint.GetAllRestoringFileInfo()
+	}
+	report := l.report.report(time.Now(), checkpoints)
+	report.Task = l.cfg.Name
+	report.SourceID = l.cfg.SourceID
+	report.Finished = l.finish.Load()
+	report.LoadedBytes = l.finishedDataSize.Load()
+	report.TotalBytes = l.totalDataSize.Load()
+	return report
+}
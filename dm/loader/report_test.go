@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadReport(t *testing.T) {
+	r := newLoadReportRecorder()
+	now := time.Unix(1700000000, 0)
+	report := r.report(now, nil)
+	require.Zero(t, report.DurationSeconds)
+	require.Empty(t, report.Tables)
+
+	r.begin(now)
+	job := &dataJob{sourceSchema: "db", sourceTable: "t1", schema: "tdb", table: "tt1"}
+	r.recordTransaction(job, 10)
+	r.recordTransaction(job, 5)
+	r.recordExecution(1, 0)
+	r.recordExecution(3, 2)
+	checkpoints := map[string][]int64{
+		"db.t1.0.sql": {100, 100},
+		"db.t1.1.sql": {20, 50},
+		"db.t2.0.sql": {30, 30},
+	}
+
+	// the load is still running.
+	report = r.report(now.Add(time.Minute), checkpoints)
+	require.True(t, report.EndTime.IsZero())
+	require.Equal(t, 60.0, report.DurationSeconds)
+	require.Equal(t, int64(15), report.Rows)
+	require.Equal(t, int64(2), report.Transactions)
+	require.Equal(t, int64(2), report.Retries)
+	require.Equal(t, int64(2), report.ExecutionErrors)
+	require.Equal(t, []TableLoadReport{
+		{
+			SourceSchema: "db", SourceTable: "t1", TargetSchema: "tdb", TargetTable: "tt1",
+			Rows: 15, LoadedBytes: 120, TotalBytes: 150,
+		},
+		{SourceSchema: "db", SourceTable: "t2", LoadedBytes: 30, TotalBytes: 30, Finished: true},
+	}, report.Tables)
+	require.Equal(t, []FileCheckpoint{
+		{File: "db.t1.0.sql", Offset: 100, EndPos: 100},
+		{File: "db.t1.1.sql", Offset: 20, EndPos: 50},
+		{File: "db.t2.0.sql", Offset: 30, EndPos: 30},
+	}, report.Checkpoints)
+
+	// the load failed, the partial progress is reported.
+	r.end(now.Add(2*time.Minute), []*pb.ProcessError{{Message: "injected error"}})
+	report = r.report(now.Add(time.Hour), checkpoints)
+	require.Equal(t, now.Add(2*time.Minute), report.EndTime)
+	require.Equal(t, 120.0, report.DurationSeconds)
+	require.Len(t, report.Errors, 1)
+	require.Contains(t, report.Errors[0], "injected error")
+	_, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	// the start time of the first run is kept after resuming.
+	r.begin(now.Add(3 * time.Minute))
+	report = r.report(now.Add(4*time.Minute), checkpoints)
+	require.Equal(t, now, report.StartTime)
+	require.Empty(t, report.Errors)
+
+	l := &Loader{
+		cfg:    &config.SubTaskConfig{Name: "test-load-report", SourceID: "source"},
+		report: r,
+	}
+	l.totalDataSize.Store(180)
+	l.finishedDataSize.Store(150)
+	report = l.GenerateLoadReport()
+	require.Equal(t, "test-load-report", report.Task)
+	require.Equal(t, "source", report.SourceID)
+	require.False(t, report.Finished)
+	require.Equal(t, int64(150), report.LoadedBytes)
+	require.Equal(t, int64(180), report.TotalBytes)
+	// the checkpoints are not loaded yet.
+	require.Empty(t, report.Checkpoints)
+}
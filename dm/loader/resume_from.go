@@ -0,0 +1,207 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// ResumeFrom positions the loader to begin at the offset of the table when it's
+// processed next time, the data of the tables loaded before it and the data of
+// the table before the offset are skipped. The table is in the form of
+// `schema.table` of the dumped files, the offset is counted over its data files
+// in the order of file names and should be at the boundary of statements.
+//
+// The skipped data files are recorded as finished in the checkpoint, so they are
+// skipped by the following restoring like the loaded ones. To avoid applying
+// the loaded data twice, the resume point can't be before any checkpoint.
+// It should be called when the loader is not running, e.g. after Init or Pause.
+func (l *Loader) ResumeFrom(table string, offset int64) error {
+	if !l.fileJobQueueClosed.Load() {
+		return terror.ErrLoadInvalidResumePoint.Generate(table, offset, "the loader is running, please pause it first")
+	}
+	fields := strings.Split(table, ".")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return terror.ErrLoadInvalidResumePoint.Generate(table, offset, "the table should be in the form of `schema.table`")
+	}
+	if offset < 0 {
+		return terror.ErrLoadInvalidResumePoint.Generate(table, offset, "the offset should not be negative")
+	}
+	schema, tbl := fields[0], fields[1]
+
+	tctx := tcontext.NewContext(context.Background(), l.logger)
+	if err := l.prepare(); err != nil {
+		return err
+	}
+	if err := l.checkPoint.Load(tctx); err != nil {
+		return err
+	}
+	if err := l.checkPoint.CalcProgress(l.db2Tables); err != nil {
+		return err
+	}
+	if _, ok := l.db2Tables[schema][tbl]; !ok {
+		return terror.ErrLoadInvalidResumePoint.Generate(table, offset, "the table is not found in the dumped files")
+	}
+
+	positions, sizes, err := l.planResumeFrom(schema, tbl, offset)
+	if err != nil {
+		return terror.ErrLoadInvalidResumePoint.Generate(table, offset, err.Error())
+	}
+
+	restoringFiles := l.checkPoint.GetAllRestoringFileInfo()
+	offsets := make(map[string]int64, len(positions))
+	for file, pos := range positions {
+		if cp, ok := restoringFiles[file]; ok {
+			if cp[0] > pos {
+				return terror.ErrLoadResumeBeforeCheckpoint.Generate(table, offset, cp[0], file)
+			}
+			if cp[0] == pos {
+				continue
+			}
+		} else {
+			if pos == 0 {
+				continue
+			}
+			if err = l.checkPoint.Init(tctx, file, sizes[file]); err != nil {
+				return err
+			}
+		}
+		offsets[file] = pos
+	}
+
+	// like the batched checkpoint updates, the offsets are written to DB before memory.
+	if err = l.checkPoint.FlushOffsets(tctx, offsets); err != nil {
+		return err
+	}
+	for file, pos := range offsets {
+		if err = l.checkPoint.UpdateOffset(file, pos); err != nil {
+			return err
+		}
+	}
+	l.logger.Info("resume loading from the table",
+		zap.String("schema", schema),
+		zap.String("table", tbl),
+		zap.Int64("offset", offset),
+		zap.Int("skipped data files", len(offsets)))
+	return nil
+}
+
+// planResumeFrom returns the offsets of the data files to skip for resuming from
+// the offset of the table, and the sizes of them. The tables are loaded before
+// the table if they are in the previous tiers of load order, or have smaller
+// names in the same tier.
+func (l *Loader) planResumeFrom(schema, table string, offset int64) (map[string]int64, map[string]int64, error) {
+	positions := make(map[string]int64)
+	sizes := make(map[string]int64)
+	fileSize := func(file string) (int64, error) {
+		size, err := utils.GetFileSize(filepath.Join(l.cfg.Dir, file))
+		if err != nil {
+			return 0, err
+		}
+		sizes[file] = size
+		return size, nil
+	}
+
+	tier := l.loadOrder.tierOf(schema, table)
+	for db, tables := range l.db2Tables {
+		for tbl, files := range tables {
+			t := l.loadOrder.tierOf(db, tbl)
+			if t > tier || (t == tier && (db > schema || (db == schema && tbl >= table))) {
+				continue
+			}
+			for _, file := range files {
+				size, err := fileSize(file)
+				if err != nil {
+					return nil, nil, err
+				}
+				positions[file] = size
+			}
+		}
+	}
+
+	files := append(DataFiles(nil), l.db2Tables[schema][table]...)
+	sort.Strings(files)
+	remaining := offset
+	for _, file := range files {
+		size, err := fileSize(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		if remaining >= size {
+			positions[file] = size
+			remaining -= size
+			continue
+		}
+		ok, err := isStatementBoundary(filepath.Join(l.cfg.Dir, file), remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("offset %d of data file %s is not at the boundary of statements", remaining, file)
+		}
+		positions[file] = remaining
+		return positions, sizes, nil
+	}
+	if remaining > 0 {
+		return nil, nil, fmt.Errorf("the offset exceeds the size %d of the data files", offset-remaining)
+	}
+	return positions, sizes, nil
+}
+
+// isStatementBoundary returns whether the offset of the data file is between two
+// statements, where dispatchSQL can begin to read the statements.
+func isStatementBoundary(file string, offset int64) (bool, error) {
+	if offset == 0 {
+		return true, nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return false, terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err)
+	}
+	defer f.Close()
+
+	var (
+		cur     int64
+		pending bool // whether a statement is read partially
+	)
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return false, terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err)
+		}
+		cur += int64(len(line))
+		if realLine := strings.TrimSpace(line); len(realLine) > 0 {
+			pending = realLine[len(realLine)-1] != ';'
+		}
+		if cur >= offset {
+			return cur == offset && !pending, nil
+		}
+		if err == io.EOF {
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeFrom(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "dumped_data")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	files := map[string]string{
+		"db1-schema-create.sql": "CREATE DATABASE `db1`;\n",
+		"db1.t1-schema.sql":     "CREATE TABLE `t1` (`id` int);\n",
+		"db1.t1.sql":            "INSERT INTO t1 VALUES(1);\n",
+		"db1.t2-schema.sql":     "CREATE TABLE `t2` (`id` int);\n",
+		"db1.t2.000000000.sql":  "INSERT INTO t2 VALUES\n(1),\n(2);\n",
+		"db1.t2.000000001.sql":  "INSERT INTO t2 VALUES(3);\nINSERT INTO t2 VALUES(4);\n",
+		"db1.t3-schema.sql":     "CREATE TABLE `t3` (`id` int);\n",
+		"db1.t3.sql":            "INSERT INTO t3 VALUES(1);\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	cfg := &config.SubTaskConfig{Name: "test_resume_from", SourceID: "source"}
+	cfg.LoaderConfig.Dir = dir
+	cfg.LoaderConfig.CheckpointStorage = config.LoaderCheckpointLocal
+	l := NewLoader(cfg, nil, "worker")
+	var err error
+	l.loadOrder, err = newLoadOrder(nil, false)
+	require.NoError(t, err)
+	l.checkPoint, err = newLocalCheckPoint(tcontext.Background(), cfg, "test_resume_from", nil)
+	require.NoError(t, err)
+	defer l.checkPoint.Close()
+
+	for _, c := range []struct {
+		table  string
+		offset int64
+	}{
+		{"db1", 0},
+		{"db1.t4", 0},
+		{"db1.t2", -1},
+		{"db1.t2", 27}, // in the middle of a statement
+		{"db1.t2", 85}, // exceeds the size of the data files
+	} {
+		err = l.ResumeFrom(c.table, c.offset)
+		require.True(t, terror.ErrLoadInvalidResumePoint.Equal(err), "%v", c)
+	}
+
+	// the data files of db1.t1 and the first data file of db1.t2 are skipped.
+	require.NoError(t, l.ResumeFrom("db1.t2", 32+26))
+	expected := map[string][]int64{
+		"db1.t1.sql":           {26, 26},
+		"db1.t2.000000000.sql": {32, 32},
+		"db1.t2.000000001.sql": {26, 52},
+	}
+	require.Equal(t, expected, l.checkPoint.GetAllRestoringFileInfo())
+
+	// the checkpoint is persisted, and can't be rewound.
+	require.NoError(t, l.checkPoint.Load(tcontext.Background()))
+	require.Equal(t, expected, l.checkPoint.GetAllRestoringFileInfo())
+	err = l.ResumeFrom("db1.t2", 32)
+	require.True(t, terror.ErrLoadResumeBeforeCheckpoint.Equal(err))
+	require.NoError(t, l.ResumeFrom("db1.t2", 32+26))
+	require.NoError(t, l.ResumeFrom("db1.t3", 0))
+	expected["db1.t2.000000001.sql"] = []int64{52, 52}
+	require.Equal(t, expected, l.checkPoint.GetAllRestoringFileInfo())
+
+	l.fileJobQueueClosed.Store(false)
+	err = l.ResumeFrom("db1.t3", 0)
+	require.True(t, terror.ErrLoadInvalidResumePoint.Equal(err))
+}
+
+func TestIsStatementBoundary(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "db.tbl.sql")
+	content := "/*!40101 SET NAMES binary*/;\n\nINSERT INTO tbl VALUES\n(1),\n(2);\nINSERT INTO tbl VALUES(3);"
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	for offset, expected := range map[int64]bool{
+		0:                       true,
+		29:                      true,
+		30:                      true, // after the blank line
+		31:                      false,
+		53:                      false, // after "INSERT INTO tbl VALUES\n"
+		63:                      true,
+		int64(len(content)):     true,
+		int64(len(content) + 1): false,
+	} {
+		ok, err := isStatementBoundary(file, offset)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, "offset %d", offset)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// retryTunerWindow is the number of recent outcomes to tune the retry params by.
+	retryTunerWindow = 100
+	// retryTunerMinSamples is the min number of outcomes to tune the retry params,
+	// the base params are used before that.
+	retryTunerMinSamples = 20
+	// errors are rare if the ratio of errors is less than retryTunerRareErrorRatio.
+	retryTunerRareErrorRatio = 0.01
+	// retryTunerBackoffScale is how much the backoff is lengthened when connection
+	// errors dominate.
+	retryTunerBackoffScale = 4
+)
+
+// retryMode is how the retry params are tuned.
+type retryMode string
+
+const (
+	// retryModeBase uses the base retry params.
+	retryModeBase retryMode = "base"
+	// retryModeTight halves the retry count and the backoff for faster failure detection.
+	retryModeTight retryMode = "tight"
+	// retryModeBackoff lengthens the backoff to wait for the downstream to recover.
+	retryModeBackoff retryMode = "backoff"
+	// retryModeJitter adds jitter to the backoff to spread out the retries of deadlocked transactions.
+	retryModeJitter retryMode = "jitter"
+)
+
+type retryOutcome uint8
+
+const (
+	retryOutcomeSuccess retryOutcome = iota
+	retryOutcomeConnError
+	retryOutcomeDeadlock
+	retryOutcomeOtherError
+)
+
+// queryRetryParams and executeRetryParams are the base retry params of
+// querySQL and executeSQL, IsRetryableFn is set by the callers.
+var (
+	queryRetryParams = retry.Params{
+		RetryCount:         10,
+		FirstRetryDuration: time.Second,
+		BackoffStrategy:    retry.Stable,
+	}
+	executeRetryParams = retry.Params{
+		RetryCount:         10,
+		FirstRetryDuration: 2 * time.Second,
+		BackoffStrategy:    retry.LinearIncrease,
+	}
+)
+
+// retryTuner tunes the retry params of the statements by the outcomes of the
+// recent executions: the backoff is lengthened if connection errors dominate,
+// jitter is added if deadlocks dominate, and the retries are tightened if
+// errors are rare. It can be shared by connections and it's thread-safe.
+type retryTuner struct {
+	minCount   int
+	maxCount   int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	logger     log.Logger
+
+	mu sync.Mutex
+	// outcomes is a ring buffer of the recent outcomes, next is the index to
+	// overwrite once it's full.
+	outcomes []retryOutcome
+	next     int
+	mode     retryMode
+}
+
+// newRetryTuner creates a retryTuner, it returns nil if adaptive-retry-logical is not enabled.
+func newRetryTuner(cfg *config.SubTaskConfig, logger log.Logger) *retryTuner {
+	if !cfg.AdaptiveRetryLogical {
+		return nil
+	}
+	return &retryTuner{
+		minCount:   cfg.AdaptiveRetryMinCountLogical,
+		maxCount:   cfg.AdaptiveRetryMaxCountLogical,
+		minBackoff: cfg.AdaptiveRetryMinBackoffLogical.Duration,
+		maxBackoff: cfg.AdaptiveRetryMaxBackoffLogical.Duration,
+		logger:     logger,
+		outcomes:   make([]retryOutcome, 0, retryTunerWindow),
+		mode:       retryModeBase,
+	}
+}
+
+// record records the outcome of an execution, err is nil if it succeeds.
+func (t *retryTuner) record(err error) {
+	if t == nil || utils.IsContextCanceledError(err) {
+		return
+	}
+	outcome := retryOutcomeSuccess
+	switch {
+	case err == nil:
+	case retry.IsConnectionError(err):
+		outcome = retryOutcomeConnError
+	case isErrDeadlock(err):
+		outcome = retryOutcomeDeadlock
+	default:
+		outcome = retryOutcomeOtherError
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.outcomes) < retryTunerWindow {
+		t.outcomes = append(t.outcomes, outcome)
+	} else {
+		t.outcomes[t.next] = outcome
+		t.next = (t.next + 1) % retryTunerWindow
+	}
+
+	mode := t.decideMode()
+	if mode != t.mode {
+		t.logger.Info("tune retry params of the downstream statements",
+			zap.String("from", string(t.mode)), zap.String("to", string(mode)))
+		t.mode = mode
+	}
+}
+
+// decideMode decides the retry mode by the recent outcomes, it must be called
+// with the lock held.
+func (t *retryTuner) decideMode() retryMode {
+	if len(t.outcomes) < retryTunerMinSamples {
+		return retryModeBase
+	}
+	var errCount, connErrCount, deadlockCount int
+	for _, outcome := range t.outcomes {
+		switch outcome {
+		case retryOutcomeConnError:
+			connErrCount++
+		case retryOutcomeDeadlock:
+			deadlockCount++
+		}
+		if outcome != retryOutcomeSuccess {
+			errCount++
+		}
+	}
+	switch {
+	case float64(errCount) < retryTunerRareErrorRatio*float64(len(t.outcomes)):
+		return retryModeTight
+	case connErrCount*2 > errCount:
+		return retryModeBackoff
+	case deadlockCount*2 > errCount:
+		return retryModeJitter
+	default:
+		return retryModeBase
+	}
+}
+
+// tune returns the retry params tuned from base by the current mode.
+func (t *retryTuner) tune(base retry.Params) retry.Params {
+	if t == nil {
+		return base
+	}
+	t.mu.Lock()
+	mode := t.mode
+	t.mu.Unlock()
+
+	params := base
+	switch mode {
+	case retryModeTight:
+		params.RetryCount /= 2
+		params.FirstRetryDuration /= 2
+	case retryModeBackoff:
+		params.FirstRetryDuration *= retryTunerBackoffScale
+	case retryModeJitter:
+		params.MaxJitter = params.FirstRetryDuration
+	}
+
+	if params.RetryCount < t.minCount {
+		params.RetryCount = t.minCount
+	}
+	if params.RetryCount > t.maxCount {
+		params.RetryCount = t.maxCount
+	}
+	if params.FirstRetryDuration < t.minBackoff {
+		params.FirstRetryDuration = t.minBackoff
+	}
+	if params.FirstRetryDuration > t.maxBackoff {
+		params.FirstRetryDuration = t.maxBackoff
+	}
+	if params.MaxJitter > t.maxBackoff {
+		params.MaxJitter = t.maxBackoff
+	}
+	return params
+}
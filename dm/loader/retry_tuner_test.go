@@ -0,0 +1,93 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTuner(t *testing.T) {
+	cfg := &config.SubTaskConfig{Name: "test-retry-tuner", SourceID: "source"}
+	require.Nil(t, newRetryTuner(cfg, log.L()))
+	// a nil tuner uses the base params.
+	var nilTuner *retryTuner
+	nilTuner.record(driver.ErrBadConn)
+	require.Equal(t, executeRetryParams, nilTuner.tune(executeRetryParams))
+
+	cfg.AdaptiveRetryLogical = true
+	cfg.AdaptiveRetryMinCountLogical = 3
+	cfg.AdaptiveRetryMaxCountLogical = 20
+	cfg.AdaptiveRetryMinBackoffLogical.Duration = 500 * time.Millisecond
+	cfg.AdaptiveRetryMaxBackoffLogical.Duration = 5 * time.Second
+	tuner := newRetryTuner(cfg, log.L())
+	recordN := func(n int, err error) {
+		for i := 0; i < n; i++ {
+			tuner.record(err)
+		}
+	}
+
+	// the base params are used before enough outcomes are observed.
+	recordN(retryTunerMinSamples-1, nil)
+	require.Equal(t, retryModeBase, tuner.mode)
+	require.Equal(t, executeRetryParams, tuner.tune(executeRetryParams))
+	// the canceled executions are not counted.
+	recordN(retryTunerMinSamples, context.Canceled)
+	require.Equal(t, retryModeBase, tuner.mode)
+
+	// errors are rare, the retries are tightened.
+	recordN(1, nil)
+	require.Equal(t, retryModeTight, tuner.mode)
+	params := tuner.tune(executeRetryParams)
+	require.Equal(t, 5, params.RetryCount)
+	require.Equal(t, time.Second, params.FirstRetryDuration)
+	params = tuner.tune(queryRetryParams)
+	require.Equal(t, 500*time.Millisecond, params.FirstRetryDuration)
+
+	// connection errors dominate, the backoff is lengthened within the bound.
+	recordN(5, driver.ErrBadConn)
+	recordN(2, &mysql.MySQLError{Number: tmysql.ErrLockDeadlock})
+	require.Equal(t, retryModeBackoff, tuner.mode)
+	params = tuner.tune(queryRetryParams)
+	require.Equal(t, 10, params.RetryCount)
+	require.Equal(t, 4*time.Second, params.FirstRetryDuration)
+	params = tuner.tune(executeRetryParams)
+	require.Equal(t, 5*time.Second, params.FirstRetryDuration)
+	require.Zero(t, params.MaxJitter)
+
+	// deadlocks dominate, jitter is added.
+	recordN(4, &mysql.MySQLError{Number: tmysql.ErrLockDeadlock})
+	require.Equal(t, retryModeJitter, tuner.mode)
+	params = tuner.tune(executeRetryParams)
+	require.Equal(t, 2*time.Second, params.FirstRetryDuration)
+	require.Equal(t, 2*time.Second, params.MaxJitter)
+
+	// the old outcomes are evicted from the window.
+	recordN(retryTunerWindow, nil)
+	require.Len(t, tuner.outcomes, retryTunerWindow)
+	require.Equal(t, retryModeTight, tuner.mode)
+
+	conn := &DBConn{retries: tuner}
+	query, execute := conn.EffectiveRetryParams()
+	require.Equal(t, tuner.tune(queryRetryParams), query)
+	require.Equal(t, tuner.tune(executeRetryParams), execute)
+}
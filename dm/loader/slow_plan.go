@@ -0,0 +1,164 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// slowQueryPlanTimeout is the timeout of capturing a plan, EXPLAIN ANALYZE runs
+// the slow query again so it may take a while.
+var slowQueryPlanTimeout = time.Minute
+
+// slowQueryPlanCapturer captures the execution plans of slow queries on a side
+// connection and logs them. At most one plan is captured at a time and at most
+// one per interval, the other slow queries are skipped. It can be shared by connections.
+type slowQueryPlanCapturer struct {
+	explain string
+	db      *conn.BaseDB
+	limiter *rate.Limiter
+	counter *prometheus.CounterVec
+	logger  log.Logger
+
+	capturing atomic.Bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// newSlowQueryPlanCapturer creates a slowQueryPlanCapturer capturing plans on the
+// connections of db, it returns nil if slow-query-plan-logical is not set.
+func newSlowQueryPlanCapturer(cfg *config.SubTaskConfig, db *conn.BaseDB, logger log.Logger) *slowQueryPlanCapturer {
+	var explain string
+	switch cfg.SlowQueryPlanLogical {
+	case config.LoaderSlowQueryPlanExplain:
+		explain = "EXPLAIN "
+	case config.LoaderSlowQueryPlanExplainAnalyze:
+		explain = "EXPLAIN ANALYZE "
+	default:
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &slowQueryPlanCapturer{
+		explain: explain,
+		db:      db,
+		limiter: rate.NewLimiter(rate.Every(cfg.SlowQueryPlanIntervalLogical.Duration), 1),
+		counter: slowQueryPlanCounter.MustCurryWith(prometheus.Labels{"task": cfg.Name, "source_id": cfg.SourceID}),
+		logger:  logger,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// capture captures the plan of the slow query in background, it's a no-op for
+// a nil slowQueryPlanCapturer.
+func (c *slowQueryPlanCapturer) capture(query string, args []interface{}, cost time.Duration) {
+	if c == nil {
+		return
+	}
+	if !c.capturing.CompareAndSwap(false, true) {
+		c.counter.WithLabelValues("skipped").Inc()
+		return
+	}
+	if !c.limiter.Allow() {
+		c.capturing.Store(false)
+		c.counter.WithLabelValues("skipped").Inc()
+		return
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.capturing.Store(false)
+		plan, err := c.explainQuery(query, args)
+		if err != nil {
+			c.counter.WithLabelValues("failed").Inc()
+			c.logger.Warn("fail to capture the plan of slow query",
+				zap.String("query", utils.TruncateString(query, -1)),
+				zap.String("argument", utils.TruncateInterface(args, -1)),
+				log.ShortError(err))
+			return
+		}
+		c.counter.WithLabelValues("captured").Inc()
+		c.logger.Warn("plan of slow query",
+			zap.Duration("cost time", cost),
+			zap.String("query", utils.TruncateString(query, -1)),
+			zap.String("argument", utils.TruncateInterface(args, -1)),
+			zap.String("plan", plan))
+	}()
+}
+
+func (c *slowQueryPlanCapturer) explainQuery(query string, args []interface{}) (string, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, slowQueryPlanTimeout)
+	defer cancel()
+	rows, err := c.db.QueryContext(tcontext.NewContext(ctx, c.logger), c.explain+query, args...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	return formatPlan(rows)
+}
+
+// close cancels the capturing plan and waits for it.
+func (c *slowQueryPlanCapturer) close() {
+	if c == nil {
+		return
+	}
+	c.cancel()
+	c.wg.Wait()
+}
+
+// formatPlan formats the result of EXPLAIN as lines of tab separated columns,
+// the first line is the column names.
+func formatPlan(rows *sql.Rows) (string, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(columns, "\t"))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return "", err
+		}
+		b.WriteByte('\n')
+		for i, v := range values {
+			if i > 0 {
+				b.WriteByte('\t')
+			}
+			b.WriteString(v.String)
+		}
+	}
+	return b.String(), rows.Err()
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestSlowQueryPlanCapturer(t *testing.T) {
+	cfg := &config.SubTaskConfig{Name: "test-slow-query-plan", SourceID: "source"}
+	require.Nil(t, newSlowQueryPlanCapturer(cfg, nil, log.L()))
+	// a nil capturer doesn't capture.
+	var nilCapturer *slowQueryPlanCapturer
+	nilCapturer.capture("SELECT 1", nil, time.Second)
+	nilCapturer.close()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	cfg.SlowQueryPlanLogical = config.LoaderSlowQueryPlanExplainAnalyze
+	cfg.SlowQueryPlanIntervalLogical.Duration = time.Hour
+	c := newSlowQueryPlanCapturer(cfg, conn.NewBaseDBForTest(db), log.L())
+	require.NotNil(t, c)
+	defer slowQueryPlanCounter.DeletePartialMatch(map[string]string{"task": cfg.Name})
+	count := func(result string) float64 {
+		m := &dto.Metric{}
+		require.NoError(t, c.counter.WithLabelValues(result).Write(m))
+		return m.GetCounter().GetValue()
+	}
+
+	query := "SELECT * FROM `db`.`t` WHERE `id` = ?"
+	mock.ExpectQuery(regexp.QuoteMeta("EXPLAIN ANALYZE " + query)).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "estRows", "actRows"}).
+			AddRow("Point_Get_1", "1.00", "1"))
+	c.capture(query, []interface{}{1}, 2*time.Second)
+	c.wg.Wait()
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, float64(1), count("captured"))
+
+	// the plan is captured at most once per interval.
+	c.capture(query, []interface{}{1}, 2*time.Second)
+	c.wg.Wait()
+	require.Equal(t, float64(1), count("skipped"))
+
+	c.limiter = rate.NewLimiter(rate.Inf, 1)
+	mock.ExpectQuery(regexp.QuoteMeta("EXPLAIN ANALYZE " + query)).WithArgs(2).
+		WillReturnError(errors.New("timeout"))
+	c.capture(query, []interface{}{2}, 2*time.Second)
+	c.close()
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, float64(1), count("failed"))
+}
+
+func TestFormatPlan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery("EXPLAIN").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "estRows", "access object"}).
+			AddRow("IndexLookUp_10", "10.00", nil).
+			AddRow("└─IndexRangeScan_8(Build)", "10.00", "table:t, index:idx(a)"))
+	rows, err := db.Query("EXPLAIN SELECT * FROM t WHERE a = 1")
+	require.NoError(t, err)
+	defer rows.Close()
+	plan, err := formatPlan(rows)
+	require.NoError(t, err)
+	require.Equal(t, "id\testRows\taccess object\n"+
+		"IndexLookUp_10\t10.00\t\n"+
+		"└─IndexRangeScan_8(Build)\t10.00\ttable:t, index:idx(a)", plan)
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/dumpling"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// loadSyncMarkerStatusLoaded means the load unit is finished and the marker is
+// waiting for the sync unit to install it as the initial checkpoint.
+const loadSyncMarkerStatusLoaded = "loaded"
+
+// writeLoadSyncMarker records the dump position of a finished load unit in the
+// downstream. The sync unit installs its initial checkpoint from the marker in
+// the same downstream transaction which marks it as installed, so the position
+// used by sync unit won't race with the checkpoint written after load unit.
+func writeLoadSyncMarker(ctx context.Context, db *conn.BaseDB, cfg *config.SubTaskConfig, logger log.Logger) error {
+	if cfg.Mode != config.ModeAll {
+		return nil
+	}
+
+	loc, safeModeExitLoc, err := dumpling.ParseMetaData(ctx, cfg.LoaderConfig.Dir, "metadata", cfg.Flavor, cfg.ExtStorage)
+	if err != nil {
+		// the sync unit will report the error when parsing the dump metadata.
+		if storage.IsNotExistError(err) || terror.ErrMetadataNoBinlogLoc.Equal(err) {
+			logger.Warn("no binlog location in dump metadata, skip writing load sync marker", log.ShortError(err))
+			return nil
+		}
+		return err
+	}
+
+	connection, err := db.GetBaseConn(ctx)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer db.ForceCloseConnWithoutErr(connection)
+
+	tctx := tcontext.NewContext(ctx, logger.WithFields(zap.String("job", "load-sync-marker")))
+	tableName := dbutil.TableName(cfg.MetaSchema, cputil.LoadSyncMarker(cfg.Name))
+	createSchema := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(cfg.MetaSchema))
+	createTable := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		source_name VARCHAR(255) NOT NULL,
+		binlog_name VARCHAR(128),
+		binlog_pos INT UNSIGNED,
+		binlog_gtid TEXT,
+		exit_safe_binlog_name VARCHAR(128) DEFAULT '',
+		exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
+		exit_safe_binlog_gtid TEXT,
+		status VARCHAR(10) NOT NULL COMMENT 'loaded,installed',
+		create_time timestamp DEFAULT CURRENT_TIMESTAMP,
+		update_time timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (source_name)
+	)`, tableName)
+	_, err = connection.ExecuteSQL(tctx, nil, "load-sync-marker", []string{createSchema})
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	_, err = connection.ExecuteSQL(tctx, nil, "load-sync-marker", []string{createTable})
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+
+	var (
+		exitSafeBinlogName string
+		exitSafeBinlogPos  uint32
+		exitSafeBinlogGTID string
+	)
+	if safeModeExitLoc != nil {
+		exitSafeBinlogName = safeModeExitLoc.Position.Name
+		exitSafeBinlogPos = safeModeExitLoc.Position.Pos
+		exitSafeBinlogGTID = safeModeExitLoc.GTIDSetStr()
+	}
+	upsert := `INSERT INTO ` + tableName + `
+		(source_name, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, status) VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			binlog_name = VALUES(binlog_name),
+			binlog_pos = VALUES(binlog_pos),
+			binlog_gtid = VALUES(binlog_gtid),
+			exit_safe_binlog_name = VALUES(exit_safe_binlog_name),
+			exit_safe_binlog_pos = VALUES(exit_safe_binlog_pos),
+			exit_safe_binlog_gtid = VALUES(exit_safe_binlog_gtid),
+			status = VALUES(status)`
+	args := []interface{}{
+		cfg.SourceID, loc.Position.Name, loc.Position.Pos, loc.GTIDSetStr(),
+		exitSafeBinlogName, exitSafeBinlogPos, exitSafeBinlogGTID, loadSyncMarkerStatusLoaded,
+	}
+	_, err = connection.ExecuteSQL(tctx, nil, "load-sync-marker", []string{upsert}, args)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "write load sync marker"), terror.ScopeDownstream)
+	}
+	logger.Info("load sync marker written", zap.Stringer("location", loc))
+
+	failpoint.Inject("LoadSyncMarkerWrittenExit", func() {
+		logger.Warn("exit triggered", zap.String("failpoint", "LoadSyncMarkerWrittenExit"))
+		utils.OsExit(1)
+	})
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// throttleUpdateInterval is the interval to check which throttle window is active.
+var throttleUpdateInterval = 30 * time.Second
+
+// loadThrottle limits the rate of executing transactions of the loader,
+// the rate limit is scaled by the multiplier of the active throttle window,
+// and lowered to the throttled rate while the downstream signals overload.
+// It can be shared by connections.
+type loadThrottle struct {
+	// baseLimit is rate.Inf if only the backpressure is enabled.
+	baseLimit    float64
+	windows      []config.LoaderThrottleWindow
+	limiter      *rate.Limiter
+	gauge        prometheus.Gauge
+	backpressure *downstreamBackpressure
+	logger       log.Logger
+
+	mu         sync.Mutex
+	multiplier float64
+	overloaded bool
+}
+
+// newLoadThrottle creates a loadThrottle, it returns nil if neither the rate limit
+// nor the backpressure is set. The overload signal is polled on the connections of db.
+func newLoadThrottle(cfg *config.SubTaskConfig, db *conn.BaseDB, logger log.Logger) *loadThrottle {
+	backpressure := newDownstreamBackpressure(cfg, db, logger)
+	if cfg.RateLimitLogical <= 0 && backpressure == nil {
+		return nil
+	}
+	t := &loadThrottle{
+		baseLimit:    float64(rate.Inf),
+		windows:      cfg.ThrottleWindowsLogical,
+		limiter:      rate.NewLimiter(rate.Inf, 1),
+		gauge:        throttleMultiplierGauge.WithLabelValues(cfg.Name, cfg.SourceID),
+		backpressure: backpressure,
+		logger:       logger,
+	}
+	if cfg.RateLimitLogical > 0 {
+		t.baseLimit = float64(cfg.RateLimitLogical)
+		t.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimitLogical), cfg.RateLimitLogical)
+	}
+	t.update(time.Now())
+	return t
+}
+
+// activeMultiplier returns the rate multiplier of the first window containing `now`,
+// 1 means the rate limit is not scaled.
+func (t *loadThrottle) activeMultiplier(now time.Time) float64 {
+	for _, w := range t.windows {
+		if w.Contains(now) {
+			return w.RateMultiplier
+		}
+	}
+	return 1
+}
+
+// update adjusts the rate limit according to the active window at `now`.
+func (t *loadThrottle) update(now time.Time) {
+	multiplier := t.activeMultiplier(now)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if multiplier == t.multiplier {
+		return
+	}
+	t.multiplier = multiplier
+	limit := t.applyLimitLocked(now)
+	t.gauge.Set(multiplier)
+	t.logger.Info("loader rate limit changed",
+		zap.Float64("multiplier", multiplier), zap.Float64("limit", limit))
+}
+
+// setOverloaded lowers the rate limit to the throttled rate if the downstream
+// is overloaded, or restores it after the overload clears.
+func (t *loadThrottle) setOverloaded(now time.Time, overloaded bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if overloaded == t.overloaded {
+		return
+	}
+	t.overloaded = overloaded
+	limit := t.applyLimitLocked(now)
+	if overloaded {
+		t.backpressure.gauge.Set(1)
+		t.logger.Warn("downstream is overloaded, loader is throttled", zap.Float64("limit", limit))
+	} else {
+		t.backpressure.gauge.Set(0)
+		t.logger.Info("downstream overload is cleared, loader is not throttled", zap.Float64("limit", limit))
+	}
+}
+
+// applyLimitLocked sets the limit of the limiter by the multiplier and the
+// overload state, and returns the limit.
+func (t *loadThrottle) applyLimitLocked(now time.Time) float64 {
+	limit := t.baseLimit
+	if limit != float64(rate.Inf) {
+		limit *= t.multiplier
+	}
+	if t.overloaded {
+		limit = math.Min(limit, t.backpressure.throttledRate)
+	}
+	t.limiter.SetLimitAt(now, rate.Limit(limit))
+	if limit != float64(rate.Inf) {
+		t.limiter.SetBurstAt(now, int(math.Max(1, math.Ceil(limit))))
+	}
+	return limit
+}
+
+// currentMultiplier returns the multiplier of the current rate limit.
+func (t *loadThrottle) currentMultiplier() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.multiplier
+}
+
+// wait blocks until a transaction can be executed, it's a no-op for a nil loadThrottle.
+func (t *loadThrottle) wait(ctx *tcontext.Context) error {
+	if t == nil {
+		return nil
+	}
+	return t.limiter.Wait(ctx.Context())
+}
+
+// run updates the rate limit periodically and polls the overload signal of
+// the downstream if the backpressure is enabled, until the context is done.
+func (t *loadThrottle) run(ctx context.Context) {
+	ticker := time.NewTicker(throttleUpdateInterval)
+	defer ticker.Stop()
+	// a nil channel never fires if the backpressure is not enabled.
+	var pollC <-chan time.Time
+	if t.backpressure != nil {
+		pollTicker := time.NewTicker(t.backpressure.interval)
+		defer pollTicker.Stop()
+		pollC = pollTicker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			t.update(now)
+		case now := <-pollC:
+			overloaded, err := t.backpressure.overloaded(ctx)
+			if err != nil {
+				// keep the current state, the downstream may be too busy to answer.
+				t.logger.Warn("fail to poll the overload signal of downstream", log.ShortError(err))
+				continue
+			}
+			t.setOverloaded(now, overloaded)
+		}
+	}
+}
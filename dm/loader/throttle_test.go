@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestLoadThrottle(t *testing.T) {
+	cfg := &config.SubTaskConfig{Name: "test-throttle", SourceID: "source"}
+	require.Nil(t, newLoadThrottle(cfg, nil, log.L()))
+	// a nil throttle doesn't limit the rate.
+	var nilThrottle *loadThrottle
+	require.NoError(t, nilThrottle.wait(tcontext.Background()))
+
+	cfg.RateLimitLogical = 100
+	cfg.ThrottleWindowsLogical = []config.LoaderThrottleWindow{
+		{Start: "09:00", End: "17:00", RateMultiplier: 0.2},
+		{Start: "12:00", End: "13:00", RateMultiplier: 0.5},
+	}
+	throttle := newLoadThrottle(cfg, nil, log.L())
+	require.NotNil(t, throttle)
+	defer throttleMultiplierGauge.DeletePartialMatch(map[string]string{"task": cfg.Name})
+
+	day := time.Date(2023, 1, 2, 0, 0, 0, 0, time.Local)
+	cases := []struct {
+		clock      time.Duration
+		multiplier float64
+	}{
+		{8 * time.Hour, 1},
+		{9 * time.Hour, 0.2},
+		// the first active window takes effect.
+		{12*time.Hour + 30*time.Minute, 0.2},
+		{17 * time.Hour, 1},
+	}
+	for _, c := range cases {
+		throttle.update(day.Add(c.clock))
+		require.Equal(t, c.multiplier, throttle.currentMultiplier())
+		require.Equal(t, rate.Limit(100*c.multiplier), throttle.limiter.Limit())
+		m := &dto.Metric{}
+		require.NoError(t, throttle.gauge.Write(m))
+		require.Equal(t, c.multiplier, m.GetGauge().GetValue())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, throttle.wait(tcontext.Background().WithContext(ctx)))
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// fileProgress tracks the chunks, i.e. the data jobs, of a data file which are
+// executed by different workers. The chunks may finish out of order, while the
+// checkpoint of the file only advances over the chunks which are all finished.
+type fileProgress struct {
+	// ordered is true if the chunks of the file must be executed one by one,
+	// then a chunk is submitted after the previous one finishes.
+	ordered bool
+	idle    chan struct{}
+	wg      sync.WaitGroup
+
+	mu sync.Mutex
+	// offsets are the end offsets of the submitted chunks not checkpointed yet, in the order of the file.
+	offsets []int64
+	done    map[int64]struct{}
+	// failed is true if a chunk of the file failed, the checkpoint doesn't advance after that.
+	failed bool
+}
+
+func newFileProgress(ordered bool) *fileProgress {
+	p := &fileProgress{
+		ordered: ordered,
+		done:    make(map[int64]struct{}),
+	}
+	if ordered {
+		p.idle = make(chan struct{}, 1)
+		p.idle <- struct{}{}
+	}
+	return p
+}
+
+// add adds the chunk ending at offset, it's blocked until the previous chunk
+// finishes if the file is ordered.
+func (p *fileProgress) add(ctx context.Context, offset int64) error {
+	if p.ordered {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.idle:
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.offsets = append(p.offsets, offset)
+	p.wg.Add(1)
+	return nil
+}
+
+// finish marks the chunk ending at offset finished, ok is false if it failed.
+// If the finished chunks advance the checkpoint of the file, save is called
+// with the new checkpoint offset, and the file is failed if save returns false.
+// save is called with the lock held, so the offsets are saved in order.
+func (p *fileProgress) finish(offset int64, ok bool, save func(offset int64) bool) {
+	defer p.wg.Done()
+	p.mu.Lock()
+	defer func() {
+		p.mu.Unlock()
+		if p.ordered {
+			p.idle <- struct{}{}
+		}
+	}()
+
+	if !ok {
+		p.failed = true
+	}
+	if p.failed {
+		return
+	}
+	p.done[offset] = struct{}{}
+	checkpoint := int64(-1)
+	for len(p.offsets) > 0 {
+		if _, ok := p.done[p.offsets[0]]; !ok {
+			break
+		}
+		checkpoint = p.offsets[0]
+		delete(p.done, checkpoint)
+		p.offsets = p.offsets[1:]
+	}
+	if checkpoint >= 0 && !save(checkpoint) {
+		p.failed = true
+	}
+}
+
+// isFailed returns whether a chunk of the file failed, then the rest chunks are skipped.
+func (p *fileProgress) isFailed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failed
+}
+
+// wait waits until all the added chunks finish or ctx is done.
+func (p *fileProgress) wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+// workerUsage is the statistics of a worker executing chunks.
+type workerUsage struct {
+	stolen atomic.Int64
+	busy   atomic.Duration
+}
+
+// chunkScheduler shares the chunks read from the data files by all workers in
+// a queue, and every worker executes the chunks in the queue when it's idle,
+// so that a worker which finishes its own data files steals the chunks of the
+// large files read by others. A chunk executed by a worker other than its
+// reader is counted as stolen.
+type chunkScheduler struct {
+	task     string
+	sourceID string
+	ordered  bool
+	start    time.Time
+	queue    chan *dataJob
+	// readers is the number of workers which may still submit chunks, the queue
+	// is closed after all of them finish reading.
+	readers atomic.Int64
+	usages  []*workerUsage
+}
+
+// newChunkScheduler creates a chunkScheduler for workerCount workers, it
+// returns nil if work-stealing-logical is not enabled. ordered is true if the
+// chunks of a data file must be executed in order.
+func newChunkScheduler(enabled bool, task, sourceID string, workerCount int, ordered bool) *chunkScheduler {
+	if !enabled {
+		return nil
+	}
+	s := &chunkScheduler{
+		task:     task,
+		sourceID: sourceID,
+		ordered:  ordered,
+		start:    time.Now(),
+		queue:    make(chan *dataJob, jobCount),
+		usages:   make([]*workerUsage, workerCount),
+	}
+	s.readers.Store(int64(workerCount))
+	for i := range s.usages {
+		s.usages[i] = &workerUsage{}
+	}
+	return s
+}
+
+// newFile returns the progress of a data file to be read.
+func (s *chunkScheduler) newFile() *fileProgress {
+	return newFileProgress(s.ordered)
+}
+
+// submit puts the chunk into the queue, job.progress and job.reader must be set.
+// It's blocked if the queue is full, or the previous chunk of an ordered file
+// doesn't finish.
+func (s *chunkScheduler) submit(ctx context.Context, job *dataJob) error {
+	if err := job.progress.add(ctx, job.offset); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		job.progress.finish(job.offset, false, nil)
+		return ctx.Err()
+	case s.queue <- job:
+		return nil
+	}
+}
+
+// readerDone is called after a worker finishes reading data files.
+func (s *chunkScheduler) readerDone() {
+	if s.readers.Dec() == 0 {
+		close(s.queue)
+	}
+}
+
+// next returns the next chunk to execute, it returns false if all readers
+// finish and the queue is drained, or ctx is done.
+func (s *chunkScheduler) next(ctx context.Context) (*dataJob, bool) {
+	if ctx.Err() != nil {
+		return nil, false
+	}
+	select {
+	case <-ctx.Done():
+		return nil, false
+	case job, ok := <-s.queue:
+		return job, ok
+	}
+}
+
+// record records that the worker spent busy executing the job.
+func (s *chunkScheduler) record(workerID int, job *dataJob, busy time.Duration) {
+	usage := s.usages[workerID]
+	if job.reader != workerID {
+		usage.stolen.Inc()
+		stolenChunkCounter.WithLabelValues(s.task, s.sourceID).Inc()
+	}
+	usage.busy.Add(busy)
+	s.updateUtilization(workerID)
+}
+
+// updateUtilization updates the utilization of the worker, which is the ratio
+// of its busy time since the scheduler is created.
+func (s *chunkScheduler) updateUtilization(workerID int) {
+	elapsed := time.Since(s.start)
+	if elapsed <= 0 {
+		return
+	}
+	utilization := s.usages[workerID].busy.Load().Seconds() / elapsed.Seconds()
+	workerUtilizationGauge.WithLabelValues(s.task, s.sourceID, strconv.Itoa(workerID)).Set(utilization)
+}
+
+// stolenCount returns the number of chunks stolen by the worker.
+func (s *chunkScheduler) stolenCount(workerID int) int64 {
+	return s.usages[workerID].stolen.Load()
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileProgress(t *testing.T) {
+	ctx := context.Background()
+	p := newFileProgress(false)
+	for _, offset := range []int64{10, 20, 30, 40} {
+		require.NoError(t, p.add(ctx, offset))
+	}
+
+	var saved []int64
+	save := func(offset int64) bool {
+		saved = append(saved, offset)
+		return true
+	}
+	// the checkpoint doesn't advance until the first chunk finishes.
+	p.finish(30, true, save)
+	p.finish(20, true, save)
+	require.Empty(t, saved)
+	p.finish(10, true, save)
+	require.Equal(t, []int64{30}, saved)
+	p.finish(40, true, save)
+	require.Equal(t, []int64{30, 40}, saved)
+	require.NoError(t, p.wait(ctx))
+	require.False(t, p.isFailed())
+
+	// the checkpoint doesn't advance after a chunk fails.
+	p = newFileProgress(false)
+	for _, offset := range []int64{10, 20, 30} {
+		require.NoError(t, p.add(ctx, offset))
+	}
+	saved = nil
+	p.finish(10, true, save)
+	p.finish(20, false, save)
+	p.finish(30, true, save)
+	require.Equal(t, []int64{10}, saved)
+	require.True(t, p.isFailed())
+	require.NoError(t, p.wait(ctx))
+
+	// the file is failed if saving the checkpoint fails.
+	p = newFileProgress(false)
+	require.NoError(t, p.add(ctx, 10))
+	p.finish(10, true, func(int64) bool { return false })
+	require.True(t, p.isFailed())
+
+	// wait returns when ctx is done.
+	p = newFileProgress(false)
+	require.NoError(t, p.add(ctx, 10))
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, p.wait(cctx), context.Canceled)
+}
+
+func TestFileProgressOrdered(t *testing.T) {
+	ctx := context.Background()
+	p := newFileProgress(true)
+	require.NoError(t, p.add(ctx, 10))
+
+	// the next chunk is added after the previous one finishes.
+	added := make(chan struct{})
+	go func() {
+		defer close(added)
+		require.NoError(t, p.add(ctx, 20))
+	}()
+	select {
+	case <-added:
+		t.Fatal("the chunk is added before the previous one finishes")
+	case <-time.After(50 * time.Millisecond):
+	}
+	p.finish(10, true, func(int64) bool { return true })
+	<-added
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, p.add(cctx, 30), context.Canceled)
+	p.finish(20, true, func(int64) bool { return true })
+	require.NoError(t, p.wait(ctx))
+}
+
+func TestChunkScheduler(t *testing.T) {
+	require.Nil(t, newChunkScheduler(false, "task", "source", 2, false))
+
+	ctx := context.Background()
+	s := newChunkScheduler(true, "test-chunk-scheduler", "source", 2, false)
+	p := s.newFile()
+	for i := int64(1); i <= 4; i++ {
+		require.NoError(t, s.submit(ctx, &dataJob{offset: i, lastOffset: i - 1, progress: p, reader: 0}))
+	}
+
+	// worker 1 steals the chunks read by worker 0.
+	var (
+		mu    sync.Mutex
+		saved int64
+		wg    sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			job, ok := s.next(ctx)
+			if !ok {
+				return
+			}
+			job.progress.finish(job.offset, true, func(offset int64) bool {
+				mu.Lock()
+				defer mu.Unlock()
+				require.Greater(t, offset, saved)
+				saved = offset
+				return true
+			})
+			s.record(1, job, time.Millisecond)
+		}
+	}()
+	require.NoError(t, p.wait(ctx))
+	require.Equal(t, int64(4), saved)
+
+	// the queue is closed after all readers are done.
+	s.readerDone()
+	s.readerDone()
+	wg.Wait()
+	require.Equal(t, int64(4), s.stolenCount(1))
+	require.Zero(t, s.stolenCount(0))
+
+	// submit fails when ctx is done and the queue is full.
+	s = newChunkScheduler(true, "test-chunk-scheduler", "source", 1, false)
+	p = s.newFile()
+	for i := 0; i < jobCount; i++ {
+		require.NoError(t, s.submit(ctx, &dataJob{offset: int64(i + 1), progress: p}))
+	}
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, s.submit(cctx, &dataJob{offset: jobCount + 1, progress: p}), context.Canceled)
+	require.True(t, p.isFailed())
+	_, ok := s.next(cctx)
+	require.False(t, ok)
+}
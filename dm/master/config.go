@@ -28,6 +28,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/pingcap/tiflow/dm/config/security"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -46,6 +47,8 @@ const (
 	defaultMaxTxnOps               = 2048
 	defaultQuotaBackendBytes       = 2 * 1024 * 1024 * 1024 // 2GB
 	quotaBackendBytesLowerBound    = 500 * 1024 * 1024      // 500MB
+	defaultShardDDLLockAlertAfter  = "30m"
+	defaultShardDDLLockAlertIntv   = "1h"
 )
 
 // SampleConfig is sample config of dm-master.
@@ -136,6 +139,18 @@ type Config struct {
 	// if this path set, DM-master leader will try to upgrade from v1.0.x to the current version.
 	V1SourcesPath string `toml:"v1-sources-path" json:"v1-sources-path"`
 
+	// if ShardDDLLockWebhook is set, the DM-master leader posts an alert to it when a pessimistic shard DDL lock
+	// stays unresolved longer than ShardDDLLockAlertAfter, and repeats it every ShardDDLLockAlertInterval.
+	ShardDDLLockWebhook          string        `toml:"shard-ddl-lock-webhook" json:"shard-ddl-lock-webhook"`
+	ShardDDLLockAlertAfterStr    string        `toml:"shard-ddl-lock-alert-after" json:"shard-ddl-lock-alert-after"`
+	ShardDDLLockAlertAfter       time.Duration `toml:"-" json:"-"`
+	ShardDDLLockAlertIntervalStr string        `toml:"shard-ddl-lock-alert-interval" json:"shard-ddl-lock-alert-interval"`
+	ShardDDLLockAlertInterval    time.Duration `toml:"-" json:"-"`
+
+	// SecretProviders configures the providers of the secrets referenced by the passwords
+	// in source and task configs, such as `vault://path#key` and `aws-sm://name`.
+	SecretProviders secret.Config `toml:"secret-providers" json:"secret-providers"`
+
 	// tls config
 	security.Security
 
@@ -273,6 +288,10 @@ func (c *Config) adjust() error {
 	}
 	c.RPCTimeout = timeout
 
+	if err = c.adjustShardDDLLockAlert(); err != nil {
+		return err
+	}
+
 	// for backward compatibility
 	if c.RPCRateLimit <= 0 {
 		log.L().Warn("invalid rpc-rate-limit, default value used", zap.Float64("specified rpc-rate-limit", c.RPCRateLimit), zap.Float64("default rpc-rate-limit", DefaultRate))
@@ -474,3 +493,32 @@ func genEmbedEtcdConfigWithLogger(logLevel string) *embed.Config {
 
 	return cfg
 }
+
+func (c *Config) adjustShardDDLLockAlert() error {
+	if c.ShardDDLLockWebhook != "" {
+		u, err := url.Parse(c.ShardDDLLockWebhook)
+		if err != nil {
+			return terror.ErrMasterConfigInvalidShardDDLLockAlert.Delegate(err, "shard-ddl-lock-webhook is not a valid URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return terror.ErrMasterConfigInvalidShardDDLLockAlert.Generate("the scheme of shard-ddl-lock-webhook must be http or https")
+		}
+	}
+	if c.ShardDDLLockAlertAfterStr == "" {
+		c.ShardDDLLockAlertAfterStr = defaultShardDDLLockAlertAfter
+	}
+	after, err := time.ParseDuration(c.ShardDDLLockAlertAfterStr)
+	if err != nil || after <= 0 {
+		return terror.ErrMasterConfigInvalidShardDDLLockAlert.Generate("shard-ddl-lock-alert-after must be a positive duration")
+	}
+	c.ShardDDLLockAlertAfter = after
+	if c.ShardDDLLockAlertIntervalStr == "" {
+		c.ShardDDLLockAlertIntervalStr = defaultShardDDLLockAlertIntv
+	}
+	interval, err := time.ParseDuration(c.ShardDDLLockAlertIntervalStr)
+	if err != nil || interval <= 0 {
+		return terror.ErrMasterConfigInvalidShardDDLLockAlert.Generate("shard-ddl-lock-alert-interval must be a positive duration")
+	}
+	c.ShardDDLLockAlertInterval = interval
+	return nil
+}
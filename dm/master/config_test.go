@@ -20,6 +20,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	capturer "github.com/kami-zh/go-capturer"
 	"github.com/pingcap/check"
@@ -294,3 +295,31 @@ func (t *testConfigSuite) TestAdjustOpenAPI(c *check.C) {
 	c.Assert(cfg.adjust(), check.IsNil)
 	c.Assert(cfg.OpenAPI, check.Equals, true)
 }
+
+func (t *testConfigSuite) TestAdjustShardDDLLockAlert(c *check.C) {
+	cfg := NewConfig()
+	c.Assert(cfg.FromContent(SampleConfig), check.IsNil)
+
+	// test default value
+	c.Assert(cfg.ShardDDLLockWebhook, check.Equals, "")
+	c.Assert(cfg.ShardDDLLockAlertAfter, check.Equals, 30*time.Minute)
+	c.Assert(cfg.ShardDDLLockAlertInterval, check.Equals, time.Hour)
+
+	cfg.ShardDDLLockWebhook = "http://127.0.0.1:8080/alert"
+	cfg.ShardDDLLockAlertAfterStr = "10m"
+	cfg.ShardDDLLockAlertIntervalStr = "20m"
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.ShardDDLLockAlertAfter, check.Equals, 10*time.Minute)
+	c.Assert(cfg.ShardDDLLockAlertInterval, check.Equals, 20*time.Minute)
+
+	cfg.ShardDDLLockWebhook = "127.0.0.1:8080/alert"
+	c.Assert(terror.ErrMasterConfigInvalidShardDDLLockAlert.Equal(cfg.adjust()), check.IsTrue)
+	cfg.ShardDDLLockWebhook = "https://127.0.0.1:8080/alert"
+	c.Assert(cfg.adjust(), check.IsNil)
+
+	cfg.ShardDDLLockAlertAfterStr = "-1m"
+	c.Assert(terror.ErrMasterConfigInvalidShardDDLLockAlert.Equal(cfg.adjust()), check.IsTrue)
+	cfg.ShardDDLLockAlertAfterStr = "10m"
+	cfg.ShardDDLLockAlertIntervalStr = "abc"
+	c.Assert(terror.ErrMasterConfigInvalidShardDDLLockAlert.Equal(cfg.adjust()), check.IsTrue)
+}
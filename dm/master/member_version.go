@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	ctlcommon "github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+)
+
+// checkVersionDrift returns a warning message if the members run with versions which differ beyond a patch
+// difference, the key of `versions` is the member name. Members of unknown versions are ignored.
+func checkVersionDrift(versions map[string]string) string {
+	byMinor := make(map[string][]string)
+	for name, v := range versions {
+		ver, err := semver.NewVersion(strings.TrimPrefix(v, "v"))
+		if err != nil {
+			continue
+		}
+		minor := fmt.Sprintf("v%d.%d", ver.Major, ver.Minor)
+		byMinor[minor] = append(byMinor[minor], name)
+	}
+	if len(byMinor) <= 1 {
+		return ""
+	}
+
+	minors := make([]string, 0, len(byMinor))
+	for minor, names := range byMinor {
+		sort.Strings(names)
+		minors = append(minors, fmt.Sprintf("%s.x: [%s]", minor, strings.Join(names, ", ")))
+	}
+	sort.Strings(minors)
+	return fmt.Sprintf("members are running with mixed versions beyond a patch difference, %s", strings.Join(minors, "; "))
+}
+
+// memberVersionDrift checks the version drift of the members in ListMember response.
+func memberVersionDrift(members []*pb.Members) string {
+	versions := make(map[string]string)
+	for _, m := range members {
+		switch v := m.Member.(type) {
+		case *pb.Members_Master:
+			for _, master := range v.Master.GetMasters() {
+				versions["master "+master.Name] = master.Version
+			}
+		case *pb.Members_Worker:
+			for _, worker := range v.Worker.GetWorkers() {
+				versions["worker "+worker.Name] = worker.Version
+			}
+		}
+	}
+	return checkVersionDrift(versions)
+}
+
+func newClusterMemberVersion(name, role string, v ha.MemberVersion) openapi.ClusterMemberVersion {
+	return openapi.ClusterMemberVersion{
+		Name:       name,
+		Role:       role,
+		Version:    &v.Version,
+		GitHash:    &v.GitHash,
+		ConfigHash: &v.ConfigHash,
+	}
+}
+
+// getClusterMemberVersions returns the version information of all masters and workers, sorted by role and name.
+func (s *Server) getClusterMemberVersions() ([]openapi.ClusterMemberVersion, error) {
+	masterVersions, _, err := ha.GetAllMasterVersion(s.etcdClient)
+	if err != nil {
+		return nil, err
+	}
+	workers, err := s.scheduler.GetAllWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]openapi.ClusterMemberVersion, 0, len(masterVersions)+len(workers))
+	for name, v := range masterVersions {
+		members = append(members, newClusterMemberVersion(name, ctlcommon.Master, v))
+	}
+	for _, w := range workers {
+		members = append(members, newClusterMemberVersion(w.BaseInfo().Name, ctlcommon.Worker, w.Version()))
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Role != members[j].Role {
+			return members[i].Role < members[j].Role
+		}
+		return members[i].Name < members[j].Name
+	})
+	return members, nil
+}
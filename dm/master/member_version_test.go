@@ -0,0 +1,61 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckVersionDrift(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, checkVersionDrift(nil))
+	// patch difference and unknown versions are allowed.
+	require.Empty(t, checkVersionDrift(map[string]string{
+		"master-1": "v6.5.0",
+		"master-2": "v6.5.1",
+		"worker-1": "None",
+		"worker-2": "",
+	}))
+	require.Equal(t,
+		"members are running with mixed versions beyond a patch difference, v6.4.x: [worker-1]; v6.5.x: [master-1, master-2]",
+		checkVersionDrift(map[string]string{
+			"master-1": "v6.5.0",
+			"master-2": "v6.5.1",
+			"worker-1": "v6.4.0",
+		}))
+}
+
+func TestMemberVersionDrift(t *testing.T) {
+	t.Parallel()
+
+	members := []*pb.Members{
+		{Member: &pb.Members_Leader{Leader: &pb.ListLeaderMember{Name: "master-1"}}},
+		{Member: &pb.Members_Master{Master: &pb.ListMasterMember{Masters: []*pb.MasterInfo{
+			{Name: "master-1", Version: "v6.5.0"},
+		}}}},
+		{Member: &pb.Members_Worker{Worker: &pb.ListWorkerMember{Workers: []*pb.WorkerInfo{
+			{Name: "worker-1", Version: "v6.5.2"},
+		}}}},
+	}
+	require.Empty(t, memberVersionDrift(members))
+
+	members[2].GetWorker().Workers[0].Version = "v7.0.0"
+	require.Equal(t,
+		"members are running with mixed versions beyond a patch difference, v6.5.x: [master master-1]; v7.0.x: [worker worker-1]",
+		memberVersionDrift(members))
+}
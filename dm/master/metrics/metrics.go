@@ -71,6 +71,14 @@ var (
 			Help:      "number of pending DDL in different states, Un-synced (waiting all upstream), Synced (all upstream finished, waiting all downstream)",
 		}, []string{"task", "type"})
 
+	ddlLockUnresolvedAge = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "master",
+			Name:      "shard_ddl_lock_unresolved_seconds",
+			Help:      "the age of the unresolved pessimistic shard DDL lock in seconds",
+		}, []string{"task", "lock"})
+
 	ddlErrCounter = f.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "dm",
@@ -124,6 +132,7 @@ func RegistryMetrics() {
 	registry.MustRegister(workerState)
 	registry.MustRegister(cpuUsageGauge)
 	registry.MustRegister(ddlPendingCounter)
+	registry.MustRegister(ddlLockUnresolvedAge)
 	registry.MustRegister(ddlErrCounter)
 	registry.MustRegister(workerEventErrCounter)
 	registry.MustRegister(startLeaderCounter)
@@ -154,6 +163,16 @@ func RemoveDDLPending(task string) {
 	ddlPendingCounter.DeletePartialMatch(prometheus.Labels{"task": task})
 }
 
+// ReportDDLLockUnresolvedAge is a setter for ddlLockUnresolvedAge.
+func ReportDDLLockUnresolvedAge(task, lockID string, age time.Duration) {
+	ddlLockUnresolvedAge.WithLabelValues(task, lockID).Set(age.Seconds())
+}
+
+// RemoveDDLLockUnresolvedAge removes the age of the resolved lock.
+func RemoveDDLLockUnresolvedAge(task, lockID string) {
+	ddlLockUnresolvedAge.DeleteLabelValues(task, lockID)
+}
+
 // ReportDDLError is a setter for ddlErrCounter.
 func ReportDDLError(task, errType string) {
 	ddlErrCounter.WithLabelValues(task, errType).Inc()
@@ -174,5 +193,6 @@ func OnRetireLeader() {
 	workerState.Reset()
 	ddlErrCounter.Reset()
 	ddlPendingCounter.Reset()
+	ddlLockUnresolvedAge.Reset()
 	workerEventErrCounter.Reset()
 }
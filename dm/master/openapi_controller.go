@@ -57,6 +57,19 @@ func (s *Server) getClusterInfo(ctx context.Context) (*openapi.GetClusterInfoRes
 		}
 		info.Topology = topo
 	}
+
+	members, err := s.getClusterMemberVersions()
+	if err != nil {
+		return nil, err
+	}
+	info.Members = &members
+	versions := make(map[string]string, len(members))
+	for _, m := range members {
+		versions[m.Role+" "+m.Name] = *m.Version
+	}
+	if warning := checkVersionDrift(versions); warning != "" {
+		info.VersionWarning = &warning
+	}
 	return info, nil
 }
 
@@ -475,7 +488,12 @@ func (s *Server) deleteTask(ctx context.Context, taskName string, force bool) er
 		}
 	}
 	metaSchema := *task.MetaSchema
-	err = s.removeMetaData(ctx, taskName, metaSchema, toDBCfg)
+	subTaskCfgM := s.scheduler.GetSubTaskCfgsByTask(taskName)
+	subTaskCfgs := make([]*config.SubTaskConfig, 0, len(subTaskCfgM))
+	for _, subTaskCfg := range subTaskCfgM {
+		subTaskCfgs = append(subTaskCfgs, subTaskCfg)
+	}
+	err = s.removeMetaData(ctx, taskName, metaSchema, toDBCfg, subTaskCfgs...)
 	if err != nil {
 		if !ignoreCannotConnectError(err) {
 			return terror.Annotate(err, "while removing metadata")
@@ -712,7 +730,7 @@ func (s *Server) startTask(ctx context.Context, taskName string, req openapi.Sta
 		defer release()
 		metaSchema := needStartSubTaskList[0].MetaSchema
 		targetDB := needStartSubTaskList[0].To
-		err = s.removeMetaData(ctx, taskName, metaSchema, &targetDB)
+		err = s.removeMetaData(ctx, taskName, metaSchema, &targetDB, needStartSubTaskList...)
 		if err != nil {
 			return terror.Annotate(err, "while removing metadata")
 		}
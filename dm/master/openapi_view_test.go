@@ -223,6 +223,14 @@ func (s *OpenAPIViewSuite) TestClusterAPI() {
 	s.NoError(result.UnmarshalBodyToObject(&info))
 	s.Greater(info.ClusterId, uint64(0))
 	s.Nil(info.Topology)
+	// both masters report their versions
+	s.NotNil(info.Members)
+	s.Len(*info.Members, 2)
+	for _, m := range *info.Members {
+		s.Equal("master", m.Role)
+		s.NotEmpty(*m.ConfigHash)
+	}
+	s.Nil(info.VersionWarning)
 
 	// update topo info
 	fakeHost := "1.1.1.1"
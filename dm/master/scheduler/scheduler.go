@@ -108,6 +108,9 @@ type Scheduler struct {
 	// the latch key is task name.
 	// TODO: also sourceLatch, relayLatch
 	subtaskLatch *latches
+	// must acquire latch from sourceCfgLatch before hot updating the source config,
+	// the latch key is source ID.
+	sourceCfgLatch *latches
 
 	// all source configs, source ID -> source config.
 	// add:
@@ -206,6 +209,7 @@ func NewScheduler(pLogger *log.Logger, securityCfg security.Security) *Scheduler
 	return &Scheduler{
 		logger:            pLogger.WithFields(zap.String("component", "scheduler")),
 		subtaskLatch:      newLatches(),
+		sourceCfgLatch:    newLatches(),
 		sourceCfgs:        make(map[string]*config.SourceConfig),
 		workers:           make(map[string]*Worker),
 		bounds:            make(map[string]*Worker),
@@ -434,6 +438,105 @@ func (s *Scheduler) UpdateSourceCfg(cfg *config.SourceConfig) error {
 	return nil
 }
 
+// HotUpdateSourceCfg updates the connection parameters of the upstream source config online, the bound
+// worker applies them to the running relay and tasks without unbinding the source. It returns the name of
+// the bound worker and its response, which are empty if the source is not bound to a worker now.
+// The config is persisted only after the bound worker applies it, otherwise the old config is pushed back
+// to the worker.
+func (s *Scheduler) HotUpdateSourceCfg(ctx context.Context, cfg *config.SourceConfig) (string, *pb.UpdateSourceCfgResponse, error) {
+	// the updates of a source are serialized, otherwise the worker and etcd may end up with different configs.
+	release, err := s.sourceCfgLatch.tryAcquire(cfg.SourceID)
+	if err != nil {
+		return "", nil, terror.ErrSchedulerLatchInUse.Generate("HotUpdateSourceCfg", cfg.SourceID)
+	}
+	defer release()
+
+	// 1. check whether the config exists and only the connection parameters are changed, and get the bound
+	// worker. The lock is not held while the worker applies the config, which may take a long time.
+	s.mu.RLock()
+	if !s.started.Load() {
+		s.mu.RUnlock()
+		return "", nil, terror.ErrSchedulerNotStarted.Generate()
+	}
+	oldCfg, ok := s.sourceCfgs[cfg.SourceID]
+	if !ok {
+		s.mu.RUnlock()
+		return "", nil, terror.ErrSchedulerSourceCfgNotExist.Generate(cfg.SourceID)
+	}
+	if err := config.CheckSourceCfgHotUpdate(oldCfg, cfg); err != nil {
+		s.mu.RUnlock()
+		return "", nil, err
+	}
+	w := s.bounds[cfg.SourceID]
+	s.mu.RUnlock()
+
+	// 2. push the config to the bound worker.
+	var (
+		workerName string
+		workerResp *pb.UpdateSourceCfgResponse
+	)
+	if w != nil {
+		workerName = w.BaseInfo().Name
+		resp, err := w.updateSourceCfg(ctx, cfg)
+		if err == nil {
+			workerResp = resp.UpdateSourceCfg
+		}
+		if err != nil || !sourceCfgApplied(workerResp) {
+			s.rollbackSourceCfg(ctx, w, oldCfg)
+			return workerName, workerResp, err
+		}
+	}
+
+	// 3. put the config into etcd, so the worker bound later and the refreshed subtasks use it,
+	// and record it in the scheduler.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sourceCfgs[cfg.SourceID]; !ok {
+		// the source is removed while the worker applies the config.
+		return workerName, workerResp, terror.ErrSchedulerSourceCfgNotExist.Generate(cfg.SourceID)
+	}
+	if s.bounds[cfg.SourceID] != w {
+		// the source is bound to another worker with the old config while the worker applies the config.
+		if w != nil {
+			s.rollbackSourceCfg(ctx, w, oldCfg)
+		}
+		return workerName, workerResp, terror.ErrSchedulerLatchInUse.Generate("HotUpdateSourceCfg", cfg.SourceID)
+	}
+	if _, err := ha.PutSourceCfg(s.etcdCli, cfg); err != nil {
+		if w != nil {
+			s.rollbackSourceCfg(ctx, w, oldCfg)
+		}
+		return workerName, workerResp, err
+	}
+	s.sourceCfgs[cfg.SourceID] = cfg
+	return workerName, workerResp, nil
+}
+
+// sourceCfgApplied returns whether the worker and all of its units applied the source config.
+func sourceCfgApplied(resp *pb.UpdateSourceCfgResponse) bool {
+	if resp == nil || !resp.Result {
+		return false
+	}
+	for _, unitResult := range resp.Units {
+		if !unitResult.Result {
+			return false
+		}
+	}
+	return true
+}
+
+// rollbackSourceCfg pushes the old source config back to the worker after it fails to apply the new one.
+func (s *Scheduler) rollbackSourceCfg(ctx context.Context, w *Worker, oldCfg *config.SourceConfig) {
+	resp, err := w.updateSourceCfg(ctx, oldCfg)
+	if err == nil && !sourceCfgApplied(resp.UpdateSourceCfg) {
+		err = errors.New(resp.UpdateSourceCfg.GetMsg())
+	}
+	if err != nil {
+		s.logger.Warn("fail to roll back the source config of the worker",
+			zap.String("source", oldCfg.SourceID), zap.String("worker", w.BaseInfo().Name), zap.Error(err))
+	}
+}
+
 // RemoveSourceCfg removes the upstream source config in the cluster.
 // when removing the upstream source config, it should also remove:
 // - any existing relay stage.
@@ -1245,6 +1348,12 @@ func (s *Scheduler) GetTaskNameListBySourceName(sourceName string, expectStage *
 // in order to know whether it's online (ready to handle works),
 // we need to wait for its healthy status through keep-alive.
 func (s *Scheduler) AddWorker(name, addr string) error {
+	return s.AddWorkerWithVersion(name, addr, ha.MemberVersion{})
+}
+
+// AddWorkerWithVersion adds the information of the DM-worker with its version information.
+// If the DM-worker has been added, only its version information is updated.
+func (s *Scheduler) AddWorkerWithVersion(name, addr string, version ha.MemberVersion) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -1259,6 +1368,16 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 		// because this is needed when restarting the worker.
 		if addr == w.BaseInfo().Addr {
 			s.logger.Warn("add the same worker again", zap.Stringer("worker info", w.BaseInfo()))
+			if version == (ha.MemberVersion{}) || version == w.Version() {
+				return nil
+			}
+			info := w.BaseInfo()
+			info.MemberVersion = version
+			if _, err := ha.PutWorkerInfo(s.etcdCli, info); err != nil {
+				return err
+			}
+			w.setVersion(version)
+			s.logger.Info("update version of worker", zap.Stringer("worker info", info))
 			return nil
 		}
 		return terror.ErrSchedulerWorkerExist.Generate(w.BaseInfo())
@@ -1266,6 +1385,7 @@ func (s *Scheduler) AddWorker(name, addr string) error {
 
 	// 2. put the base info into etcd.
 	info := ha.NewWorkerInfo(name, addr)
+	info.MemberVersion = version
 	_, err := ha.PutWorkerInfo(s.etcdCli, info)
 	if err != nil {
 		return err
@@ -1682,6 +1802,21 @@ func (s *Scheduler) GetExpectRelayStage(source string) ha.Stage {
 // because some user may want to update `{Running, Paused, ...}` to `{Running, Running, ...}`.
 // so, this should be also supported in DM-worker.
 func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, taskName string, sources ...string) error {
+	return s.updateExpectSubTaskStage(newStage, taskName, sources, func(source string) ha.Stage {
+		return ha.NewSubTaskStage(newStage, source, taskName)
+	})
+}
+
+// PauseSubTasksAt updates the expect subtask stage to `Paused` with a pause-at target,
+// the sync units pause after applying all the binlog events not later than target,
+// or pause anyway at deadline.
+func (s *Scheduler) PauseSubTasksAt(taskName string, target, deadline time.Time, sources ...string) error {
+	return s.updateExpectSubTaskStage(pb.Stage_Paused, taskName, sources, func(source string) ha.Stage {
+		return ha.NewSubTaskPauseAtStage(source, taskName, target, deadline)
+	})
+}
+
+func (s *Scheduler) updateExpectSubTaskStage(newStage pb.Stage, taskName string, sources []string, genStage func(source string) ha.Stage) error {
 	if !s.started.Load() {
 		return terror.ErrSchedulerNotStarted.Generate()
 	}
@@ -1721,7 +1856,7 @@ func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, taskName string,
 		} else {
 			currStagesM[currStage.Expect.String()] = struct{}{}
 		}
-		stages = append(stages, ha.NewSubTaskStage(newStage, source, taskName))
+		stages = append(stages, genStage(source))
 	}
 	notExistSources := strMapToSlice(notExistSourcesM)
 	currStages := strMapToSlice(currStagesM)
@@ -2493,6 +2628,7 @@ func (s *Scheduler) updateStatusToUnbound(source string) {
 // reset resets the internal status.
 func (s *Scheduler) reset() {
 	s.subtaskLatch = newLatches()
+	s.sourceCfgLatch = newLatches()
 	s.sourceCfgs = make(map[string]*config.SourceConfig)
 	s.subTaskCfgs = sync.Map{}
 	s.workers = make(map[string]*Worker)
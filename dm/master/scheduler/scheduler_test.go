@@ -277,6 +277,17 @@ func (t *testSchedulerSuite) testSchedulerProgress(restart int) {
 	t.subTaskStageMatch(s, taskName1, sourceID1, pb.Stage_Paused)
 	require.NoError(t.T(), s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1))
 	t.subTaskStageMatch(s, taskName1, sourceID1, pb.Stage_Running)
+	// pause task1 at a binlog timestamp.
+	pauseAt := time.Unix(1672531200, 0)
+	require.NoError(t.T(), s.PauseSubTasksAt(taskName1, pauseAt, pauseAt.Add(time.Minute), sourceID1))
+	pauseAtStage := ha.NewSubTaskPauseAtStage(sourceID1, taskName1, pauseAt, pauseAt.Add(time.Minute))
+	stageDeepEqualExcludeRev(t.T(), s.GetExpectSubTaskStage(taskName1, sourceID1), pauseAtStage)
+	eStageM, _, err := ha.GetSubTaskStage(t.etcdTestCli, sourceID1, taskName1)
+	require.NoError(t.T(), err)
+	stageDeepEqualExcludeRev(t.T(), eStageM[taskName1], pauseAtStage)
+	require.True(t.T(), terror.ErrSchedulerSubTaskOpSourceNotExist.Equal(s.PauseSubTasksAt(taskName1, pauseAt, time.Time{}, sourceID1, sourceID2)))
+	require.NoError(t.T(), s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1))
+	t.subTaskStageMatch(s, taskName1, sourceID1, pb.Stage_Running)
 	// update subtask stage without source or task take no effect now (and return without error).
 	require.NoError(t.T(), s.UpdateExpectSubTaskStage(pb.Stage_Paused, "", sourceID1))
 	require.NoError(t.T(), s.UpdateExpectSubTaskStage(pb.Stage_Paused, taskName1))
@@ -2119,6 +2130,57 @@ func (t *testSchedulerSuite) TestUpdateSubTasksAndSourceCfg() {
 	sourceCfg1.MetaDir = "new meta"
 	t.NoError(s.UpdateSourceCfg(sourceCfg1))
 	t.Equal(s.GetSourceCfgByID(sourceID1).MetaDir, sourceCfg1.MetaDir)
+
+	// can't update the items other than the connection parameters online
+	hotCfg := sourceCfg1.Clone()
+	hotCfg.ServerID++
+	_, _, err = s.HotUpdateSourceCfg(ctx, hotCfg)
+	t.True(terror.ErrConfigSourceCfgHotUpdate.Equal(err))
+
+	// can't update online when worker rpc error, and the config is not persisted
+	hotCfg = sourceCfg1.Clone()
+	hotCfg.From.Password = "new password"
+	t.NoError(failpoint.Enable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg", `return("error")`))
+	_, _, err = s.HotUpdateSourceCfg(ctx, hotCfg)
+	t.Regexp("update error", err)
+	t.NoError(failpoint.Disable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg"))
+	t.Equal(sourceCfg1.From.Password, s.GetSourceCfgByID(sourceID1).From.Password)
+	scm, _, err := ha.GetSourceCfg(t.etcdTestCli, sourceID1, 0)
+	t.NoError(err)
+	t.Equal(sourceCfg1.From.Password, scm[sourceID1].From.Password)
+
+	// the config is not persisted when the worker fails to apply it
+	t.NoError(failpoint.Enable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg", `return("failed")`))
+	worker, resp, err := s.HotUpdateSourceCfg(ctx, hotCfg)
+	t.NoError(failpoint.Disable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg"))
+	t.NoError(err)
+	t.Equal(workerName1, worker)
+	t.False(resp.Result)
+	t.Equal("error happened", resp.Msg)
+	t.Equal(sourceCfg1.From.Password, s.GetSourceCfgByID(sourceID1).From.Password)
+	scm, _, err = ha.GetSourceCfg(t.etcdTestCli, sourceID1, 0)
+	t.NoError(err)
+	t.Equal(sourceCfg1.From.Password, scm[sourceID1].From.Password)
+
+	// the updates of a source are serialized
+	release, err := s.sourceCfgLatch.tryAcquire(sourceID1)
+	t.NoError(err)
+	_, _, err = s.HotUpdateSourceCfg(ctx, hotCfg)
+	t.True(terror.ErrSchedulerLatchInUse.Equal(err))
+	release()
+
+	// update online success, the bound worker applies the new config
+	t.NoError(failpoint.Enable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg", `return("success")`))
+	worker, resp, err = s.HotUpdateSourceCfg(ctx, hotCfg)
+	t.NoError(failpoint.Disable("github.com/pingcap/tiflow/dm/master/scheduler/operateUpdateSourceCfg"))
+	t.NoError(err)
+	t.Equal(workerName1, worker)
+	t.True(resp.Result)
+	t.Len(resp.Units, 1)
+	t.Equal(hotCfg.From.Password, s.GetSourceCfgByID(sourceID1).From.Password)
+	scm, _, err = ha.GetSourceCfg(t.etcdTestCli, sourceID1, 0)
+	t.NoError(err)
+	t.Equal(hotCfg.From.Password, scm[sourceID1].From.Password)
 }
 
 func (t *testSchedulerSuite) TestValidatorEnabledAndGetValidatorStage() {
@@ -2141,3 +2203,38 @@ func (t *testSchedulerSuite) TestValidatorEnabledAndGetValidatorStage() {
 	t.Nil(s.GetValidatorStage("not-exist", source))
 	t.Nil(s.GetValidatorStage("not-exist", "not-exist"))
 }
+
+func (t *testSchedulerSuite) TestAddWorkerWithVersion() {
+	var (
+		logger      = log.L()
+		s           = NewScheduler(&logger, security.Security{})
+		workerName1 = "dm-worker-1"
+		workerAddr1 = "127.0.0.1:8262"
+		v1          = ha.MemberVersion{Version: "v6.5.0", GitHash: "abc", ConfigHash: "def"}
+		v2          = ha.MemberVersion{Version: "v6.5.1", GitHash: "abd", ConfigHash: "def"}
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t.T(), s.Start(ctx, t.etcdTestCli))
+	defer func() {
+		s.Close()
+	}()
+
+	require.NoError(t.T(), s.AddWorkerWithVersion(workerName1, workerAddr1, v1))
+	require.Equal(t.T(), v1, s.GetWorkerByName(workerName1).Version())
+	// the version is not changed when the worker registers without version.
+	require.NoError(t.T(), s.AddWorker(workerName1, workerAddr1))
+	require.Equal(t.T(), v1, s.GetWorkerByName(workerName1).Version())
+	// the version is updated when the worker registers again after upgraded.
+	require.NoError(t.T(), s.AddWorkerWithVersion(workerName1, workerAddr1, v2))
+	require.Equal(t.T(), v2, s.GetWorkerByName(workerName1).Version())
+	infos, _, err := ha.GetAllWorkerInfo(t.etcdTestCli)
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), v2, infos[workerName1].MemberVersion)
+
+	// the version is loaded when the scheduler restarts.
+	s.Close()
+	s = NewScheduler(&logger, security.Security{})
+	require.NoError(t.T(), s.Start(ctx, t.etcdTestCli))
+	require.Equal(t.T(), v2, s.GetWorkerByName(workerName1).Version())
+}
@@ -90,6 +90,9 @@ type Worker struct {
 
 	// the source ID from which the worker is pulling relay log. should keep consistent with Scheduler.relayWorkers
 	relaySource string
+
+	// the version information reported when the DM-worker registers, it may be updated after the DM-worker upgraded.
+	version ha.MemberVersion
 }
 
 // NewWorker creates a new Worker instance with Offline stage.
@@ -103,6 +106,7 @@ func NewWorker(baseInfo ha.WorkerInfo, securityCfg security.Security) (*Worker,
 		cli:      cli,
 		baseInfo: baseInfo,
 		stage:    WorkerOffline,
+		version:  baseInfo.MemberVersion,
 	}
 	w.reportMetrics()
 	return w, nil
@@ -220,6 +224,19 @@ func (w *Worker) BaseInfo() ha.WorkerInfo {
 	return w.baseInfo
 }
 
+// Version returns the version information of the worker.
+func (w *Worker) Version() ha.MemberVersion {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.version
+}
+
+func (w *Worker) setVersion(version ha.MemberVersion) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.version = version
+}
+
 // Stage returns the current stage.
 func (w *Worker) Stage() WorkerStage {
 	w.mu.RLock()
@@ -307,6 +324,36 @@ func (w *Worker) checkSubtasksCanUpdate(ctx context.Context, cfg *config.SubTask
 	return w.SendRequest(ctx, req, rpcTimeOut)
 }
 
+func (w *Worker) updateSourceCfg(ctx context.Context, cfg *config.SourceConfig) (*workerrpc.Response, error) {
+	rpcTimeOut := time.Minute // the units are paused and resumed to rebuild the upstream connections
+	tomlStr, err := cfg.Toml()
+	if err != nil {
+		return nil, err
+	}
+	req := &workerrpc.Request{
+		Type:            workerrpc.CmdUpdateSourceCfg,
+		UpdateSourceCfg: &pb.UpdateSourceCfgRequest{SourceCfgTomlString: tomlStr},
+	}
+	failpoint.Inject("operateUpdateSourceCfg", func(v failpoint.Value) {
+		resp := &workerrpc.Response{
+			Type: workerrpc.CmdUpdateSourceCfg, UpdateSourceCfg: &pb.UpdateSourceCfgResponse{},
+		}
+		switch v.(string) {
+		case "success":
+			resp.UpdateSourceCfg.Result = true
+			resp.UpdateSourceCfg.Units = []*pb.UpdateSourceCfgUnitResult{{Unit: pb.UnitType_Relay, Result: true}}
+			failpoint.Return(resp, nil)
+		case "failed":
+			resp.UpdateSourceCfg.Msg = "error happened"
+			failpoint.Return(resp, nil)
+		default:
+			failpoint.Return(nil, errors.New("update error"))
+		}
+	})
+
+	return w.SendRequest(ctx, req, rpcTimeOut)
+}
+
 // NewMockWorker is used in tests.
 func NewMockWorker(cli workerrpc.Client) *Worker {
 	return &Worker{cli: cli}
@@ -52,6 +52,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/ui"
@@ -70,6 +71,9 @@ const (
 
 	// getLeaderBlockTime is the max block time for get leader information from election.
 	getLeaderBlockTime = 10 * time.Minute
+
+	// defaultPauseAtTimeout is the max time to wait the sync units reaching the target of `pause-task --at-time`.
+	defaultPauseAtTimeout = 10 * time.Minute
 )
 
 var (
@@ -145,6 +149,7 @@ func NewServer(cfg *Config) *Server {
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
 	}
 	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskSourceNameList)
+	server.pessimist.SetLockAlert(cfg.ShardDDLLockWebhook, cfg.ShardDDLLockAlertAfter, cfg.ShardDDLLockAlertInterval)
 	server.optimist = shardddl.NewOptimist(&logger, server.scheduler.GetDownstreamMetaByTask)
 	server.closed.Store(true)
 	setUseTLS(&cfg.Security)
@@ -243,6 +248,12 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		return
 	}
 
+	// report the version of this master, so the mixed versions of the members can be detected.
+	cfgContent, _ := s.cfg.Toml()
+	if _, err2 := ha.PutMasterVersion(s.etcdClient, s.cfg.Name, ha.NewMemberVersion(cfgContent)); err2 != nil {
+		log.L().Warn("fail to put master version", zap.String("name", s.cfg.Name), zap.Error(err2))
+	}
+
 	// start leader election
 	// TODO: s.cfg.Name -> address
 	s.election, err = election.NewElection(ctx, s.etcdClient, electionTTL, electionKey, s.cfg.Name, s.cfg.AdvertiseAddr, getLeaderBlockTime)
@@ -264,6 +275,12 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		s.electionNotify(ctx)
 	}()
 
+	s.bgFunWg.Add(1)
+	go func() {
+		defer s.bgFunWg.Done()
+		s.cleanSoftDeletedMetaLoop(ctx)
+	}()
+
 	runBackgroundOnce.Do(func() {
 		s.bgFunWg.Add(1)
 		go func() {
@@ -337,7 +354,11 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 		return resp2, err2
 	}
 
-	err := s.scheduler.AddWorker(req.Name, req.Address)
+	err := s.scheduler.AddWorkerWithVersion(req.Name, req.Address, ha.MemberVersion{
+		Version:    req.Version,
+		GitHash:    req.GitHash,
+		ConfigHash: req.ConfigHash,
+	})
 	if err != nil {
 		// nolint:nilerr
 		return &pb.RegisterWorkerResponse{
@@ -345,7 +366,8 @@ func (s *Server) RegisterWorker(ctx context.Context, req *pb.RegisterWorkerReque
 			Msg:    err.Error(),
 		}, nil
 	}
-	log.L().Info("register worker successfully", zap.String("name", req.Name), zap.String("address", req.Address))
+	log.L().Info("register worker successfully", zap.String("name", req.Name), zap.String("address", req.Address),
+		zap.String("version", req.Version), zap.String("git hash", req.GitHash))
 	return &pb.RegisterWorkerResponse{
 		Result: true,
 	}, nil
@@ -421,6 +443,10 @@ func (s *Server) deleteMasterByName(ctx context.Context, name string) error {
 	}
 
 	_, err = etcdutil.RemoveMember(cli, id)
+	if err != nil {
+		return err
+	}
+	_, err = ha.DeleteMasterVersion(cli, name)
 	return err
 }
 
@@ -567,12 +593,17 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 				return respWithErr(terror.Annotate(terror.ErrSchedulerSubTaskExist.Generate(cfg.Name, sources),
 					"while remove-meta is true"))
 			}
-			err = s.removeMetaData(ctx, cfg.Name, cfg.MetaSchema, cfg.TargetDB)
+			err = s.removeMetaData(ctx, cfg.Name, cfg.MetaSchema, cfg.TargetDB, stCfgs...)
 			if err != nil {
 				return respWithErr(terror.Annotate(err, "while removing metadata"))
 			}
 		}
 
+		restoredMsg, err3 := s.restoreSoftDeletedTaskMeta(cfg.Name, req.RemoveMeta)
+		if err3 != nil {
+			return respWithErr(terror.Annotate(err3, "while restoring soft deleted metadata"))
+		}
+
 		if req.StartTime == "" {
 			err = ha.DeleteAllTaskCliArgs(s.etcdClient, cfg.Name)
 			if err != nil {
@@ -599,6 +630,12 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 		if cfg.RemoveMeta {
 			resp.Msg = "`remove-meta` in task config is deprecated, please use `start-task ... --remove-meta` instead"
 		}
+		if restoredMsg != "" {
+			if resp.Msg != "" {
+				resp.Msg += "; "
+			}
+			resp.Msg += restoredMsg
+		}
 		sourceResps = s.getSourceRespsAfterOperation(ctx, cfg.Name, sources, []string{}, req)
 	}
 
@@ -630,6 +667,20 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 		resp.Msg = fmt.Sprintf("task %s has no source or not exist, please check the task name and status", req.Name)
 		return resp, nil
 	}
+	if req.KeepMetaDays != 0 && (req.Op != pb.TaskOp_Delete || req.KeepMetaDays < 0 || len(req.Sources) > 0) {
+		resp.Msg = "`keep-meta-days` must be a positive number and can only be used when stopping the whole task"
+		return resp, nil
+	}
+	var pauseAt, pauseAtDeadline time.Time
+	if req.PauseAtTime != "" {
+		var err error
+		pauseAt, pauseAtDeadline, err = parsePauseAtTarget(req)
+		if err != nil {
+			resp.Msg = err.Error()
+			// nolint:nilerr
+			return resp, nil
+		}
+	}
 	var expect pb.Stage
 	switch req.Op {
 	case pb.TaskOp_Pause:
@@ -644,7 +695,17 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 	}
 	var err error
 	if req.Op == pb.TaskOp_Delete {
+		if req.KeepMetaDays > 0 {
+			err = s.softDeleteTaskMeta(req.Name, req.KeepMetaDays, s.scheduler.GetSubTaskCfgsByTask(req.Name))
+			if err != nil {
+				resp.Msg = terror.Annotate(err, "while keeping metadata").Error()
+				// nolint:nilerr
+				return resp, nil
+			}
+		}
 		err = s.scheduler.RemoveSubTasks(req.Name, sources...)
+	} else if !pauseAt.IsZero() {
+		err = s.scheduler.PauseSubTasksAt(req.Name, pauseAt, pauseAtDeadline, sources...)
 	} else {
 		err = s.scheduler.UpdateExpectSubTaskStage(expect, req.Name, sources...)
 	}
@@ -667,10 +728,36 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 		if err2 != nil {
 			log.L().Error("failed to delete metadata for task", zap.String("task name", req.Name), log.ShortError(err2))
 		}
+		// the task is stopped without keeping its meta data anymore
+		if len(req.Sources) == 0 && req.KeepMetaDays == 0 {
+			err2 = ha.DeleteSoftDeletedTaskMeta(s.etcdClient, req.Name)
+			if err2 != nil {
+				log.L().Error("failed to delete soft deleted metadata for task", zap.String("task name", req.Name), log.ShortError(err2))
+			}
+		}
 	}
 	return resp, nil
 }
 
+// parsePauseAtTarget parses the target and the deadline of `pause-task --at-time`.
+func parsePauseAtTarget(req *pb.OperateTaskRequest) (time.Time, time.Time, error) {
+	if req.Op != pb.TaskOp_Pause {
+		return time.Time{}, time.Time{}, errors.New("`at-time` can only be used when pausing task")
+	}
+	target, err := time.Parse(time.RFC3339, req.PauseAtTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Errorf("invalid `at-time` %s, it should be in RFC3339 format", req.PauseAtTime)
+	}
+	timeout := defaultPauseAtTimeout
+	if req.PauseAtTimeout != "" {
+		timeout, err = time.ParseDuration(req.PauseAtTimeout)
+		if err != nil || timeout <= 0 {
+			return time.Time{}, time.Time{}, errors.Errorf("invalid `at-time-timeout` %s, it should be a positive duration like 10m", req.PauseAtTimeout)
+		}
+	}
+	return target, time.Now().Add(timeout), nil
+}
+
 // GetSubTaskCfg implements MasterServer.GetSubTaskCfg.
 func (s *Server) GetSubTaskCfg(ctx context.Context, req *pb.GetSubTaskCfgRequest) (*pb.GetSubTaskCfgResponse, error) {
 	var (
@@ -852,7 +939,16 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 	for _, sourceName := range sources {
 		workerResps = append(workerResps, workerRespMap[sourceName]...)
 	}
-	return &pb.QueryStatusListResponse{Result: true, Sources: workerResps}, nil
+	resp := &pb.QueryStatusListResponse{Result: true, Sources: workerResps}
+	if len(req.GetName()) > 0 {
+		meta, err2 := ha.GetSoftDeletedTaskMeta(s.etcdClient, req.GetName())
+		if err2 != nil {
+			log.L().Warn("failed to get soft deleted metadata for task", zap.String("task name", req.GetName()), log.ShortError(err2))
+		} else if meta != nil && meta.IsRestored() {
+			resp.Msg = softDeletedMetaRestoredMsg(meta)
+		}
+	}
+	return resp, nil
 }
 
 // adjust unsynced field in sync status by looking at DDL locks.
@@ -1374,6 +1470,68 @@ func checkAndAdjustSourceConfigForDMCtl(ctx context.Context, cfg *config.SourceC
 	return innerCheckAndAdjustSourceConfig(ctx, cfg, nil)
 }
 
+// parseAndAdjustUpdatedSourceConfig is like parseAndAdjustSourceConfig, but the server-id and flavor which
+// are not specified are inherited from the current source config rather than generated again.
+func (s *Server) parseAndAdjustUpdatedSourceConfig(ctx context.Context, contents []string) ([]*config.SourceConfig, error) {
+	cfgs := make([]*config.SourceConfig, len(contents))
+	for i, content := range contents {
+		cfg, err := config.ParseYaml(content)
+		if err != nil {
+			return cfgs, err
+		}
+		if oldCfg := s.scheduler.GetSourceCfgByID(cfg.SourceID); oldCfg != nil {
+			if cfg.ServerID == 0 {
+				cfg.ServerID = oldCfg.ServerID
+			}
+			if cfg.Flavor == "" {
+				cfg.Flavor = oldCfg.Flavor
+			}
+		}
+		if err := checkAndAdjustSourceConfigForDMCtlFunc(ctx, cfg); err != nil {
+			return cfgs, err
+		}
+		cfgs[i] = cfg
+	}
+	return cfgs, nil
+}
+
+// hotUpdateSource updates the connection parameters of the source online, and returns the result of
+// each unit in the bound worker.
+func (s *Server) hotUpdateSource(ctx context.Context, cfg *config.SourceConfig) *pb.CommonWorkerResponse {
+	resp := &pb.CommonWorkerResponse{Source: cfg.SourceID}
+	worker, workerResp, err := s.scheduler.HotUpdateSourceCfg(ctx, cfg)
+	resp.Worker = worker
+	switch {
+	case err != nil:
+		resp.Msg = err.Error()
+	case workerResp == nil:
+		resp.Result = true
+		resp.Msg = "source is updated but there is no worker bound to apply it"
+	case !workerResp.Result:
+		resp.Msg = workerResp.Msg
+	default:
+		resp.Result = true
+		unitMsgs := make([]string, 0, len(workerResp.Units))
+		for _, unitResult := range workerResp.Units {
+			name := unitResult.Unit.String()
+			if unitResult.Task != "" {
+				name = unitResult.Task + "/" + name
+			}
+			unitMsg := name + ": updated"
+			if !unitResult.Result {
+				resp.Result = false
+				unitMsg = name + ": failed"
+			}
+			if unitResult.Msg != "" {
+				unitMsg += ", " + unitResult.Msg
+			}
+			unitMsgs = append(unitMsgs, unitMsg)
+		}
+		resp.Msg = strings.Join(unitMsgs, "; ")
+	}
+	return resp
+}
+
 func parseSourceConfig(contents []string) ([]*config.SourceConfig, error) {
 	cfgs := make([]*config.SourceConfig, len(contents))
 	for i, content := range contents {
@@ -1461,8 +1619,10 @@ func (s *Server) OperateSource(ctx context.Context, req *pb.OperateSourceRequest
 		}
 	)
 	switch req.Op {
-	case pb.SourceOp_StartSource, pb.SourceOp_UpdateSource:
+	case pb.SourceOp_StartSource:
 		cfgs, err = parseAndAdjustSourceConfig(ctx, req.Config)
+	case pb.SourceOp_UpdateSource:
+		cfgs, err = s.parseAndAdjustUpdatedSourceConfig(ctx, req.Config)
 	default:
 		// don't check the upstream connections, because upstream may be inaccessible
 		cfgs, err = parseSourceConfig(req.Config)
@@ -1516,8 +1676,13 @@ func (s *Server) OperateSource(ctx context.Context, req *pb.OperateSourceRequest
 			boundM[sid] = s.scheduler.GetWorkerBySource(sid)
 		}
 	case pb.SourceOp_UpdateSource:
-		// TODO: support SourceOp_UpdateSource later
-		resp.Msg = "Update worker config is not supported by dm-ha now"
+		// only the connection parameters can be updated here, the bound workers apply them online.
+		resp.Result = true
+		for _, cfg := range cfgs {
+			sourceResp := s.hotUpdateSource(ctx, cfg)
+			resp.Result = resp.Result && sourceResp.Result
+			resp.Sources = append(resp.Sources, sourceResp)
+		}
 		return resp, nil
 	case pb.SourceOp_StopSource:
 		toRemove := make([]string, 0, len(cfgs)+len(req.SourceID))
@@ -1706,7 +1871,10 @@ func withHost(addr string) string {
 	return addr
 }
 
-func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string, toDBCfg *dbconfig.DBConfig) error {
+// removeMetaData removes meta data of the task. subTaskCfgs are used to find the pre-created loader checkpoint
+// tables, from which only the checkpoints of the task and its sources are deleted, and the idempotency tables
+// specified by users, from which only the keys of the task are deleted.
+func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string, toDBCfg *dbconfig.DBConfig, subTaskCfgs ...*config.SubTaskConfig) error {
 	failpoint.Inject("MockSkipRemoveMetaData", func() {
 		failpoint.Return(nil)
 	})
@@ -1751,6 +1919,10 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.LoaderCheckpoint(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.LightningCheckpoint(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.LoadSyncMarker(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.LoaderIdempotency(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerCheckpoint(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
@@ -1769,7 +1941,27 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 	sqls = append(sqls, fmt.Sprintf("DROP DATABASE IF EXISTS %s",
 		dbutil.ColumnName(loader.GetTaskInfoSchemaName(metaSchema, taskName))))
 
-	_, err = dbConn.ExecuteSQL(ctctx, nil, taskName, sqls)
+	// the pre-created checkpoint tables and the idempotency tables specified by user may be shared by tasks,
+	// the loader checkpoints are identified by the task name and the source ID.
+	args := make([][]interface{}, len(sqls))
+	for _, subTaskCfg := range subTaskCfgs {
+		if table := loader.PreCreatedCheckpointTable(taskName, metaSchema, &subTaskCfg.LoaderConfig); table != "" {
+			sqls = append(sqls, fmt.Sprintf("DELETE FROM %s WHERE `id` = ?", table))
+			args = append(args, []interface{}{loader.PreCreatedCheckpointID(taskName, subTaskCfg.SourceID)})
+		}
+	}
+	idempotencyTables := make(map[string]struct{})
+	for _, subTaskCfg := range subTaskCfgs {
+		if table := loader.SpecifiedIdempotencyTable(taskName, metaSchema, &subTaskCfg.LoaderConfig); table != "" {
+			if _, ok := idempotencyTables[table]; !ok {
+				idempotencyTables[table] = struct{}{}
+				sqls = append(sqls, fmt.Sprintf("DELETE FROM %s WHERE task_name = ?", table))
+				args = append(args, []interface{}{taskName})
+			}
+		}
+	}
+
+	_, err = dbConn.ExecuteSQL(ctctx, nil, taskName, sqls, args...)
 	if err == nil {
 		metrics.RemoveDDLPending(taskName)
 	}
@@ -1952,6 +2144,16 @@ func (s *Server) waitOperationOk(
 						} else if subtaskStatus.Name == taskName && (subtaskStatus.Stage == expect || subtaskStatus.Stage == finished) {
 							ok = true
 						}
+						// for `pause-task --at-time`, the sync unit may keep running until it reaches the target,
+						// report its progress once the worker accepted the target.
+						if opTaskReq, ok2 := masterReq.(*pb.OperateTaskRequest); ok2 && opTaskReq.PauseAtTime != "" && subtaskStatus.Name == taskName {
+							if progress := subtaskStatus.GetSync().GetPauseAtProgress(); progress != "" {
+								ok = true
+								if msg == "" {
+									msg = progress
+								}
+							}
+						}
 						if ok || msg != "" {
 							return ok, msg, queryResp, nil
 						}
@@ -2090,6 +2292,11 @@ func (s *Server) listMemberMaster(ctx context.Context, names []string) (*pb.Memb
 	etcdMembers := memberList.Members
 	masters := make([]*pb.MasterInfo, 0, len(etcdMembers))
 
+	versions, _, err := ha.GetAllMasterVersion(s.etcdClient)
+	if err != nil {
+		log.L().Warn("fail to get master versions", zap.Error(err))
+	}
+
 	client := &http.Client{}
 	if len(s.cfg.SSLCA) != 0 {
 		inner, err := toolutils.ToTLSConfigWithVerify(s.cfg.SSLCA, s.cfg.SSLCert, s.cfg.SSLKey, s.cfg.CertAllowedCN)
@@ -2116,12 +2323,16 @@ func (s *Server) listMemberMaster(ctx context.Context, names []string) (*pb.Memb
 			}
 		}
 
+		version := versions[etcdMember.Name]
 		masters = append(masters, &pb.MasterInfo{
 			Name:       etcdMember.Name,
 			MemberID:   etcdMember.ID,
 			Alive:      alive,
 			ClientURLs: etcdMember.ClientURLs,
 			PeerURLs:   etcdMember.PeerURLs,
+			Version:    version.Version,
+			GitHash:    version.GitHash,
+			ConfigHash: version.ConfigHash,
 		})
 	}
 
@@ -2156,11 +2367,15 @@ func (s *Server) listMemberWorker(names []string) *pb.Members_Worker {
 			continue
 		}
 
+		version := workerAgent.Version()
 		workers = append(workers, &pb.WorkerInfo{
-			Name:   workerAgent.BaseInfo().Name,
-			Addr:   workerAgent.BaseInfo().Addr,
-			Stage:  string(workerAgent.Stage()),
-			Source: workerAgent.Bound().Source,
+			Name:       workerAgent.BaseInfo().Name,
+			Addr:       workerAgent.BaseInfo().Addr,
+			Stage:      string(workerAgent.Stage()),
+			Source:     workerAgent.Bound().Source,
+			Version:    version.Version,
+			GitHash:    version.GitHash,
+			ConfigHash: version.ConfigHash,
 		})
 	}
 
@@ -2245,6 +2460,10 @@ func (s *Server) ListMember(ctx context.Context, req *pb.ListMemberRequest) (*pb
 
 	resp.Result = true
 	resp.Members = members
+	resp.VersionWarning = memberVersionDrift(members)
+	if resp.VersionWarning != "" {
+		log.L().Warn("detect version drift of members", zap.String("warning", resp.VersionWarning))
+	}
 	return resp, nil
 }
 
@@ -2389,7 +2608,10 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 		// For the get-config command, we want to filter out fields that are not easily readable by humans,
 		// such as SSLXXBytes field in `Security` struct
 		taskCfg := config.SubTaskConfigsToTaskConfig(subCfgList...)
-		taskCfg.TargetDB.Password = config.ObfuscatedPasswordForFeedback
+		// a reference to a secret is not sensitive and helps to locate the secret.
+		if !secret.IsReference(taskCfg.TargetDB.Password) {
+			taskCfg.TargetDB.Password = config.ObfuscatedPasswordForFeedback
+		}
 		if taskCfg.TargetDB.Security != nil {
 			taskCfg.TargetDB.Security.ClearSSLBytesData()
 		}
@@ -2493,7 +2715,9 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 
 			return resp2, nil
 		}
-		sourceCfg.From.Password = config.ObfuscatedPasswordForFeedback
+		if !secret.IsReference(sourceCfg.From.Password) {
+			sourceCfg.From.Password = config.ObfuscatedPasswordForFeedback
+		}
 		if sourceCfg.From.Security != nil {
 			sourceCfg.From.Security.ClearSSLBytesData()
 		}
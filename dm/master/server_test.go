@@ -1011,6 +1011,8 @@ func (t *testMasterSuite) TestStartTaskWithRemoveMeta() {
 	mock.ExpectBegin()
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoaderCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LightningCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoadSyncMarker(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoaderIdempotency(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -1106,6 +1108,8 @@ func (t *testMasterSuite) TestStartTaskWithRemoveMeta() {
 	mock.ExpectBegin()
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoaderCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LightningCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoadSyncMarker(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.LoaderIdempotency(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -1221,6 +1225,35 @@ func (t *testMasterSuite) TestOperateTask() {
 	}
 
 	require.Equal(t.T(), sourceResps, stResp.Sources)
+	// pause task at an invalid time
+	resp, err = server.OperateTask(context.Background(), &pb.OperateTaskRequest{
+		Op:          pauseOp,
+		Name:        taskName,
+		PauseAtTime: "2023-01-01 00:00:00",
+	})
+	require.NoError(t.T(), err)
+	require.False(t.T(), resp.Result)
+	require.Contains(t.T(), resp.Msg, "RFC3339")
+	resp, err = server.OperateTask(context.Background(), &pb.OperateTaskRequest{
+		Op:             pauseOp,
+		Name:           taskName,
+		PauseAtTime:    "2023-01-01T00:00:00+08:00",
+		PauseAtTimeout: "-1m",
+	})
+	require.NoError(t.T(), err)
+	require.False(t.T(), resp.Result)
+	require.Contains(t.T(), resp.Msg, "positive duration")
+	resp, err = server.OperateTask(context.Background(), &pb.OperateTaskRequest{
+		Op:          pb.TaskOp_Resume,
+		Name:        taskName,
+		PauseAtTime: "2023-01-01T00:00:00+08:00",
+	})
+	require.NoError(t.T(), err)
+	require.False(t.T(), resp.Result)
+	require.Contains(t.T(), resp.Msg, "can only be used when pausing task")
+	for _, source := range sources {
+		t.subTaskStageMatch(server.scheduler, taskName, source, pb.Stage_Running)
+	}
 	// 2. pause task
 	resp, err = server.OperateTask(context.Background(), pauseReq)
 	require.NoError(t.T(), err)
@@ -54,6 +54,8 @@ type Pessimist struct {
 	taskSources func(task string) []string
 
 	infoOpMu sync.Mutex
+
+	alertCfg lockAlertConfig
 }
 
 // NewPessimist creates a new Pessimist instance.
@@ -87,6 +89,11 @@ func (p *Pessimist) Start(pCtx context.Context, etcdCli *clientv3.Client) error
 		//nolint:errcheck
 		p.run(ctx, etcdCli, rev1, rev2)
 	}()
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.runLockAlert(ctx)
+	}()
 
 	p.closed = false // started now.
 	p.cancel = cancel
@@ -0,0 +1,171 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shardddl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/master/metrics"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+// LockAlertKindUnresolved is the kind of the alert for a shard DDL lock which
+// stays unresolved too long.
+const LockAlertKindUnresolved = "shard-ddl-lock-unresolved"
+
+var (
+	// the interval to check the unresolved locks, variable for testing.
+	lockAlertCheckInterval = 10 * time.Second
+	lockAlertPostTimeout   = 5 * time.Second
+)
+
+// LockAlert is the alert posted to the webhook for a pessimistic shard DDL
+// lock which needs manual action, e.g. `shard-ddl-lock unlock`.
+type LockAlert struct {
+	Kind   string   `json:"kind"`
+	LockID string   `json:"lock-id"`
+	Task   string   `json:"task"`
+	DDLs   []string `json:"ddls"`
+	Owner  string   `json:"owner"`
+	// UnsyncedSources are the sources which haven't received the shard DDL.
+	UnsyncedSources   []string `json:"unsynced-sources"`
+	UnresolvedSeconds int64    `json:"unresolved-seconds"`
+	// Count is the number of times the alert is fired for the lock, starting from 1.
+	Count int `json:"count"`
+}
+
+type lockAlertConfig struct {
+	webhook  string
+	after    time.Duration
+	interval time.Duration
+}
+
+// lockAlertState is the alerting state of a lock.
+type lockAlertState struct {
+	task       string
+	createTime time.Time
+	count      int
+	lastAlert  time.Time
+}
+
+// SetLockAlert sets the webhook to alert the locks which stay unresolved
+// longer than `after`, the alert is repeated every `interval` until the lock
+// is resolved. It should be called before Start.
+func (p *Pessimist) SetLockAlert(webhook string, after, interval time.Duration) {
+	p.alertCfg = lockAlertConfig{
+		webhook:  webhook,
+		after:    after,
+		interval: interval,
+	}
+}
+
+// runLockAlert reports the age of the unresolved locks and fires the alerts.
+func (p *Pessimist) runLockAlert(ctx context.Context) {
+	ticker := time.NewTicker(lockAlertCheckInterval)
+	defer ticker.Stop()
+
+	states := make(map[string]*lockAlertState)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkUnresolvedLocks(ctx, states, time.Now())
+		}
+	}
+}
+
+func (p *Pessimist) checkUnresolvedLocks(ctx context.Context, states map[string]*lockAlertState, now time.Time) {
+	locks := p.lk.Locks()
+	for id, state := range states {
+		if lock, ok := locks[id]; !ok || !lock.CreateTime().Equal(state.createTime) {
+			metrics.RemoveDDLLockUnresolvedAge(state.task, id)
+			delete(states, id)
+		}
+	}
+
+	for id, lock := range locks {
+		state, ok := states[id]
+		if !ok {
+			state = &lockAlertState{task: lock.Task, createTime: lock.CreateTime()}
+			states[id] = state
+		}
+		age := now.Sub(state.createTime)
+		metrics.ReportDDLLockUnresolvedAge(lock.Task, id, age)
+
+		if p.alertCfg.webhook == "" || age < p.alertCfg.after {
+			continue
+		}
+		if state.count > 0 && now.Sub(state.lastAlert) < p.alertCfg.interval {
+			continue
+		}
+		state.count++
+		state.lastAlert = now
+
+		unsynced := make([]string, 0)
+		for source, synced := range lock.Ready() {
+			if !synced {
+				unsynced = append(unsynced, source)
+			}
+		}
+		sort.Strings(unsynced)
+		alert := &LockAlert{
+			Kind:              LockAlertKindUnresolved,
+			LockID:            id,
+			Task:              lock.Task,
+			DDLs:              lock.DDLs,
+			Owner:             lock.Owner,
+			UnsyncedSources:   unsynced,
+			UnresolvedSeconds: int64(age.Seconds()),
+			Count:             state.count,
+		}
+		p.logger.Warn("shard DDL lock stays unresolved, fire the alert",
+			zap.String("lock", id), zap.Duration("age", age),
+			zap.Strings("unsynced sources", unsynced), zap.Int("count", state.count))
+		if err := postLockAlert(ctx, p.alertCfg.webhook, alert); err != nil {
+			// the alert is fired again after the interval.
+			p.logger.Warn("fail to post shard DDL lock alert", zap.String("lock", id), log.ShortError(err))
+		}
+	}
+}
+
+func postLockAlert(ctx context.Context, webhook string, alert *LockAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, lockAlertPostTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shardddl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/shardddl/pessimism"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUnresolvedLocks(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		alerts []*LockAlert
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		alert := &LockAlert{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(alert))
+		mu.Lock()
+		alerts = append(alerts, alert)
+		mu.Unlock()
+	}))
+	defer server.Close()
+	getAlerts := func() []*LockAlert {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*LockAlert(nil), alerts...)
+	}
+
+	logger := log.L()
+	p := NewPessimist(&logger, nil)
+	p.SetLockAlert(server.URL, time.Minute, 10*time.Minute)
+
+	var (
+		ctx     = context.Background()
+		sources = []string{"mysql-replica-1", "mysql-replica-2", "mysql-replica-3"}
+		ddls    = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		info    = pessimism.NewInfo("task", sources[0], "foo", "bar", ddls)
+		states  = make(map[string]*lockAlertState)
+	)
+	lockID, _, _, err := p.lk.TrySync(info, sources)
+	require.NoError(t, err)
+	info.Source = sources[1]
+	_, _, _, err = p.lk.TrySync(info, sources)
+	require.NoError(t, err)
+	createTime := p.lk.FindLock(lockID).CreateTime()
+
+	// not exceed the alert duration.
+	p.checkUnresolvedLocks(ctx, states, createTime.Add(30*time.Second))
+	require.Len(t, getAlerts(), 0)
+	require.Contains(t, states, lockID)
+
+	// fire the first alert.
+	p.checkUnresolvedLocks(ctx, states, createTime.Add(2*time.Minute))
+	require.Len(t, getAlerts(), 1)
+	alert := getAlerts()[0]
+	require.Equal(t, LockAlertKindUnresolved, alert.Kind)
+	require.Equal(t, lockID, alert.LockID)
+	require.Equal(t, "task", alert.Task)
+	require.Equal(t, ddls, alert.DDLs)
+	require.Equal(t, sources[0], alert.Owner)
+	require.Equal(t, []string{sources[2]}, alert.UnsyncedSources)
+	require.Equal(t, int64(120), alert.UnresolvedSeconds)
+	require.Equal(t, 1, alert.Count)
+
+	// not exceed the escalation interval.
+	p.checkUnresolvedLocks(ctx, states, createTime.Add(5*time.Minute))
+	require.Len(t, getAlerts(), 1)
+
+	// repeat the alert.
+	p.checkUnresolvedLocks(ctx, states, createTime.Add(13*time.Minute))
+	require.Len(t, getAlerts(), 2)
+	require.Equal(t, 2, getAlerts()[1].Count)
+
+	// the lock is resolved.
+	require.True(t, p.lk.RemoveLock(lockID))
+	p.checkUnresolvedLocks(ctx, states, createTime.Add(30*time.Minute))
+	require.Len(t, getAlerts(), 2)
+	require.NotContains(t, states, lockID)
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// softDeletedMetaCleanInterval is the interval for the leader to remove the expired meta data of tasks stopped
+// with `--keep-meta-days`.
+var softDeletedMetaCleanInterval = time.Hour
+
+// softDeleteTaskMeta keeps the meta data of a task which is being stopped for keepMetaDays, so the task can resume
+// from its checkpoints when it's started again before expired.
+func (s *Server) softDeleteTaskMeta(taskName string, keepMetaDays int32, subTaskCfgs map[string]*config.SubTaskConfig) error {
+	cfgs := make([]string, 0, len(subTaskCfgs))
+	for _, cfg := range subTaskCfgs {
+		data, err := cfg.Toml()
+		if err != nil {
+			return err
+		}
+		cfgs = append(cfgs, data)
+	}
+	now := time.Now()
+	return ha.PutSoftDeletedTaskMeta(s.etcdClient, &ha.SoftDeletedTaskMeta{
+		Task:        taskName,
+		SubTaskCfgs: cfgs,
+		DeleteTime:  now,
+		ExpireTime:  now.Add(time.Duration(keepMetaDays) * 24 * time.Hour),
+	})
+}
+
+// restoreSoftDeletedTaskMeta is called when a task is started. If the task was stopped with `--keep-meta-days`, the
+// kept meta data is either dropped together with the record when removeMeta is true, or marked as restored so it
+// won't be removed when expired. A message for the user is returned if the task resumes from the kept meta data.
+func (s *Server) restoreSoftDeletedTaskMeta(taskName string, removeMeta bool) (string, error) {
+	meta, err := ha.GetSoftDeletedTaskMeta(s.etcdClient, taskName)
+	if err != nil || meta == nil {
+		return "", err
+	}
+	if removeMeta {
+		return "", ha.DeleteSoftDeletedTaskMeta(s.etcdClient, taskName)
+	}
+	if !meta.IsRestored() {
+		meta.RestoredTime = time.Now()
+		if err = ha.PutSoftDeletedTaskMeta(s.etcdClient, meta); err != nil {
+			return "", err
+		}
+	}
+	return softDeletedMetaRestoredMsg(meta), nil
+}
+
+func softDeletedMetaRestoredMsg(meta *ha.SoftDeletedTaskMeta) string {
+	return fmt.Sprintf("task %s resumed from the meta data kept since it was stopped at %s",
+		meta.Task, meta.DeleteTime.Format(time.RFC3339))
+}
+
+// cleanSoftDeletedMetaLoop removes the expired meta data of soft deleted tasks periodically when this member is
+// the leader.
+func (s *Server) cleanSoftDeletedMetaLoop(ctx context.Context) {
+	ticker := time.NewTicker(softDeletedMetaCleanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if s.leader.Load() != oneselfLeader {
+				continue
+			}
+			if err := s.cleanExpiredSoftDeletedMeta(ctx, time.Now()); err != nil {
+				log.L().Warn("fail to clean expired soft deleted task meta", zap.Error(err))
+			}
+		}
+	}
+}
+
+// cleanExpiredSoftDeletedMeta removes the meta data of soft deleted tasks which are expired at now and not restored.
+// The records of restored tasks are dropped when expired without touching the meta data.
+func (s *Server) cleanExpiredSoftDeletedMeta(ctx context.Context, now time.Time) error {
+	metas, err := ha.GetAllSoftDeletedTaskMeta(s.etcdClient)
+	if err != nil {
+		return err
+	}
+
+	for taskName, meta := range metas {
+		if !meta.IsExpired(now) {
+			continue
+		}
+		if err = s.removeSoftDeletedTaskMeta(ctx, meta); err != nil {
+			log.L().Warn("fail to remove expired soft deleted task meta", zap.String("task", taskName), zap.Error(err))
+			continue
+		}
+		log.L().Info("expired soft deleted task meta removed", zap.String("task", taskName),
+			zap.Bool("restored", meta.IsRestored()))
+	}
+	return nil
+}
+
+func (s *Server) removeSoftDeletedTaskMeta(ctx context.Context, meta *ha.SoftDeletedTaskMeta) error {
+	// use same latch for start-task
+	release, err := s.scheduler.AcquireSubtaskLatch(meta.Task)
+	if err != nil {
+		return terror.ErrSchedulerLatchInUse.Generate("RemoveSoftDeletedMeta", meta.Task)
+	}
+	defer release()
+
+	// the meta data is in use if the task has been started again
+	if !meta.IsRestored() && len(s.scheduler.GetSubTaskCfgsByTask(meta.Task)) == 0 && len(meta.SubTaskCfgs) > 0 {
+		cfgs := make([]*config.SubTaskConfig, 0, len(meta.SubTaskCfgs))
+		for _, data := range meta.SubTaskCfgs {
+			cfg := config.NewSubTaskConfig()
+			if err = cfg.Decode(data, true); err != nil {
+				return err
+			}
+			cfgs = append(cfgs, cfg)
+		}
+		err = s.removeMetaData(ctx, meta.Task, cfgs[0].MetaSchema, &cfgs[0].To, cfgs...)
+		if err != nil {
+			return terror.Annotate(err, "while removing metadata")
+		}
+	}
+	return ha.DeleteSoftDeletedTaskMeta(s.etcdClient, meta.Task)
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/config/security"
+	"github.com/pingcap/tiflow/dm/master/scheduler"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func (t *testMasterSuite) TestSoftDeletedTaskMeta() {
+	require.NoError(t.T(), failpoint.Enable("github.com/pingcap/tiflow/dm/master/MockSkipRemoveMetaData", `return(true)`))
+	//nolint:errcheck
+	defer failpoint.Disable("github.com/pingcap/tiflow/dm/master/MockSkipRemoveMetaData")
+
+	logger := log.L()
+	server := testDefaultMasterServer(t.T())
+	server.etcdClient = t.etcdTestCli
+	server.scheduler = scheduler.NewScheduler(&logger, security.Security{})
+
+	task1, task2 := "task1", "task2"
+	cfg := config.NewSubTaskConfig()
+	cfg.Name = task1
+	cfg.SourceID = "mysql-replica-01"
+	subTaskCfgs := map[string]*config.SubTaskConfig{cfg.SourceID: cfg}
+
+	require.NoError(t.T(), server.softDeleteTaskMeta(task1, 1, subTaskCfgs))
+	require.NoError(t.T(), server.softDeleteTaskMeta(task2, 3, subTaskCfgs))
+	meta, err := ha.GetSoftDeletedTaskMeta(t.etcdTestCli, task1)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), meta.SubTaskCfgs, 1)
+	require.Equal(t.T(), 24*time.Hour, meta.ExpireTime.Sub(meta.DeleteTime))
+	require.False(t.T(), meta.IsRestored())
+
+	// start task2 again, it resumes from the kept meta data
+	msg, err := server.restoreSoftDeletedTaskMeta(task2, false)
+	require.NoError(t.T(), err)
+	require.Contains(t.T(), msg, "resumed from the meta data")
+	meta, err = ha.GetSoftDeletedTaskMeta(t.etcdTestCli, task2)
+	require.NoError(t.T(), err)
+	require.True(t.T(), meta.IsRestored())
+
+	// nothing is expired now
+	require.NoError(t.T(), server.cleanExpiredSoftDeletedMeta(context.Background(), time.Now()))
+	metas, err := ha.GetAllSoftDeletedTaskMeta(t.etcdTestCli)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), metas, 2)
+
+	// task1 is expired after one day
+	require.NoError(t.T(), server.cleanExpiredSoftDeletedMeta(context.Background(), time.Now().Add(25*time.Hour)))
+	metas, err = ha.GetAllSoftDeletedTaskMeta(t.etcdTestCli)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), metas, 1)
+	require.Contains(t.T(), metas, task2)
+
+	// start task2 with remove-meta drops the record
+	msg, err = server.restoreSoftDeletedTaskMeta(task2, true)
+	require.NoError(t.T(), err)
+	require.Empty(t.T(), msg)
+	meta, err = ha.GetSoftDeletedTaskMeta(t.etcdTestCli, task2)
+	require.NoError(t.T(), err)
+	require.Nil(t.T(), meta)
+}
@@ -52,6 +52,8 @@ const (
 	CmdGetValidationStatus
 	CmdGetValidationError
 	CmdOperateValidationError
+
+	CmdUpdateSourceCfg
 )
 
 // Request wraps all dm-worker rpc requests.
@@ -73,6 +75,8 @@ type Request struct {
 	GetValidationStatus    *pb.GetValidationStatusRequest
 	GetValidationError     *pb.GetValidationErrorRequest
 	OperateValidationError *pb.OperateValidationErrorRequest
+
+	UpdateSourceCfg *pb.UpdateSourceCfgRequest
 }
 
 // Response wraps all dm-worker rpc responses.
@@ -94,6 +98,8 @@ type Response struct {
 	GetValidationStatus    *pb.GetValidationStatusResponse
 	GetValidationError     *pb.GetValidationErrorResponse
 	OperateValidationError *pb.OperateValidationErrorResponse
+
+	UpdateSourceCfg *pb.UpdateSourceCfgResponse
 }
 
 // Client is a client that sends RPC.
@@ -124,6 +124,8 @@ func callRPC(ctx context.Context, client pb.WorkerClient, req *Request) (*Respon
 		resp.GetValidationError, err = client.GetValidatorError(ctx, req.GetValidationError)
 	case CmdOperateValidationError:
 		resp.OperateValidationError, err = client.OperateValidatorError(ctx, req.OperateValidationError)
+	case CmdUpdateSourceCfg:
+		resp.UpdateSourceCfg, err = client.UpdateSourceCfg(ctx, req.UpdateSourceCfg)
 	default:
 		return nil, terror.ErrMasterGRPCInvalidReqType.Generate(req.Type)
 	}
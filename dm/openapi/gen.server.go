@@ -1248,109 +1248,112 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TXVEKCHADmklDtNs/ay6Zp/TSazrgUFXPqXkg97F1FjXSu0xVFP",
-	"MVwcJenrkLoI5ZRPSq4RkzwL+dUn9DVHmovqeysgv+pjKTmBYiTIr4KQkhgvgxgnDqLph0A+BJiANUwT",
-	"EFOWQgFWQmT8eDqNaMj3MkyWIcz2QppO/72aChwtplzARYKmcpGJnidnUM47kdNN4jxJ9pxk68OcZ5Rw",
-	"9KdE3eYYhY4DUidvMAQFulAc5GUNzWB9FNKTWGrLx/OTfqY3K/ohfiBWdlHOtegJ5nJjPqEErq1lG3ow",
-	"lH8AQQEXNAMQMDkcMDN+3IDSolKp2Pv1+XuYonM52snwJ3maXSg/pA1e5Z9EeZqBnOA2THLZBAkUBYoR",
-	"1W+ad0fHo4jmiwRVe0fydCF9ufEIcYFTKFAgqIBJwOjN0DdjTDBfoShYrAXa+KUNFtKQObDCRBwdjno9",
-	"1Nr74zahWqg0wXRTycVsp2QzXoNM9DKbehosMEnoMlgKHDn5gwlMluDt5dlJYczzjAuGYAr0qzVjh17C",
-	"eRzu709QOHsxmc/Ry8liH4aT2f7hPgzn89lsdnA8nzx/cfhyNB6RPEkkXg2XtTKRNRDdVr8EUeqzyup3",
-	"g6kN/wKTvZn83/5wWCJsvJ0Y5onklb2pfqCXqMMmwYgwQ6GgbA1uVoghBZrel4QuAeZSMUh+GgDBNrTD",
-	"KWOU/R2L1TvEudPXkSyj7A1AcmyLjdSvQSjdnta76hkItUvUlKaxeTXlS9+bqQGqzzZUE41teFyS9BYJ",
-	"49GekZj6HYBQDwpcYmGeASy3rdQauU9tSE0zzOVvhk1NPC2gunHTAYncdj+GERRwcORQj7YdAY5SYMrS",
-	"DlCaUlLk6t1IaP59eCRMKLNlJLTv84DQV87U9sHWDsODAm58kC2DL324B6R56eJvGeR3eMmUC8uWSPAH",
-	"BL428WNg8rCcky+qOR8D+ktpgC8Ey0ORM+THQgMYhCrwCPjXpB7UvPl0+uryFFy+en1+Cr6I+Rfwly84",
-	"+gIwEX+Zz38B7z9cgve/n5+DV79ffgjO3r/5dPru9P3l+OOns3evPv0D/PfpP/Qbv4Dpr5f/759G76Mo",
-	"wCRCf3wGb85/v7g8/XR6An6d/gJO3789e3/61zNC6MlrcHL626vfzy/Bm7+9+nRxevnXXMQv0sUhePPh",
-	"/PzV5Wnxb+lWudISBrV2pBYtnIkS5ew6hqvf5wMi0/L1Yi6Lqs6taiTvHjw9fTCbze6dnj6nMOoPuxIK",
-	"I3fY1REF+f2MFAlo3GVLKCpUreelx9+mB6NLhjh3PtRxynCYGlRrBUT2fNbSdVQcgLtI3sjC3pcvfOny",
-	"QTz0Yv9o3ksNw/V9rPRBeeCoO2EVrlB4FTDEVVjS5LiMoYkaAcwIOxqqHmIOMsg5ivaAW9Tvk0QZ12Hs",
-	"wbSpiXuDXh2nIKB0iDfojZOcr2oRnA626rP+nWGBuIrVNF5yAZXIlhhkFBMBuPwFCnDyDoSQaEnGAsBY",
-	"RgYMlXGpfK1Iv7WOZPjXJAgpEYg4cONfE7CmObiBRFgY1vbOYWnAl3BemZrCGkhzMwZfwn3/owP3o3vY",
-	"l/90Gpg1CdvI/p5FsKA5zQROMRc4BHwFWSTJKDWAtN7gBouVzribraEkWYOco0hG2ARAE6gCGoY54wAT",
-	"75wnJ+cgrQWn5dY0k4/WPrkY13FWs41T0/ubpY85cwX5VUYilPjnGchogsM1qGWc27H/Hxlmxq8r5GnW",
-	"FCY1SGcQBNb5mXI5O34uTIgnD2KZOfknu9aOX7nuwdGstfTlCoFisJSgDDFMIxzCJFkDo/LidkpGoxWN",
-	"gZkcXMMkR8dALSEZiqOQkojfDXqGUohJwDMYohoG82dN+N9hgtM8BTFDCESYXwH1loLh7eu7LH/r44kH",
-	"zWM/Yt6uL09XWzNDIY7XBnieL6zsXEwZaIG9B85iQKgA+k0seUKduUtVJQAlCNzgJAELpBTQHrhQkJqz",
-	"nWOwD9Hzo8ODw0n8/GU8mc/Ri8kiQvtFOlQ6mi80KvP+BGBD0ts0dsm72tY3Sojb9FAWTR9NFULZFnGV",
-	"eQ70w8ojtGzYzzzyTuWRb31c0h+t2Gq7ziWmeqIKPepTNGhYHIRqMdGGpSLqXxpUnY/B/OXzl7+4hL22",
-	"rof5XDx3D2brZi43CJpwRRWEBOjhAQihCFdBngVpWRFVB+JmJT0UJpW4GgvyTDtT5e5Y4ZdPzJ16dTP+",
-	"rPDem/J8oaZ0uYnu0ouCiJora9N9ygmRL/dpzjqzOpnIRte1wz6iF2C7VPGFclfL45i2nGl3Vukedbwz",
-	"rpJk/VmYRmLsAoU5w2LdXkY50aZKhvOk7uFp8xZjlESlZVvhKEJEO9dLJMqgxp6oNgmIGU3VEOV7xdLP",
-	"aaulRviKmAhgktAbFAUhaYP9hqYpJeC90cwXF+dAvoNjHEKdPCiJ1UsczpMghP7Ay5pYq6pipM1tTp6V",
-	"E0tMvFP/Zk0n8fh4+s54C9P/eTZ7WVSFNFDrX/UKrf2LvqnWk7uSMXwtUbtC67IkxVq8Z71mZFSnpYMG",
-	"bQCd0mGCsreM5pkjbRwl7VK33o2OMeMiSGiorYzrFRmNomizaYXOpruG5mTzCVvJEjX7uMK5hUgJtrWg",
-	"k6hllU5D1ejf3b5ezS+JYcJb6ZHSkqgoXGsAGTap12sq3rzetibGrazM5aD1qHSztRMpo7lcKiillbnW",
-	"OS7z7gUhTuA1dVgz/XtZ11fSquH2uSSxCPFd1AamJtJd+OjMAEDObyiLvDOWA+pTHhw+OxriiRYZBvfc",
-	"8qE178HB7MgVzWZFQqGzlFUNqlyVMh7peskOXaSgWhat88yoGCff6aoXtSpFB1eFaq9js6Lb3uNPyK+G",
-	"F3VcQn5VlXSMRzl3+XoGN/mwhR+jVAystgscGWqzZF2Ei391aKEOx8cq2fU7PnrUZJj3Y5Pct17pQbrq",
-	"WfqLUrRDxFXeT7pEN4y6fM+C53kJTC/PV6xyD/5lKEtwCD183CjHbGfNTDWz8baTNdAlzyYN7tCJG9Zx",
-	"FpxlA+LkHRmWd1Z2MpTSaxSkSJ8rD7Yk+j2VV1au7AJy5QlF9IaYeKj42Z26hzEKUhqhQOAUBVGRI21H",
-	"RzhFoHgszYp8s8g7W3p7xp0apyLXIP3QEDats5hQQDpgg/zKlAuqATZA+7PZ0WQ2n8z2wfzZ8ezwePZs",
-	"WIn1haBZ55bdHycJLM3FYKrfQKzjFo0vzeqkf8YHYlarR2g7qXmaDRR0qyp3g0K4wTonoTAaCIl1UG0d",
-	"ejrYpDij7+DQPiXlD/L7TN6FGmj89YGYXaxJWGGmTtndmMlHQMFmc4U6pxq7XHyGOE2uURQoD52GV4Hn",
-	"KL1TzRYXRpykcZ8U+3VnQUqDp1OVVuToyPFJrN0VCSb/oed1ILuQlMBkKaniWsI+dbtZ4XBVJsQwB8XL",
-	"G8XxrazjwPygw0SHiIhAZEMLLcwBULBAK0wiK+U25N0yQHQYFfmsE6PaCD9Guq4CXRd3PAfAZcrZB9PA",
-	"koOlDNq79lwPaGw7ZAjkZFLMYm99p1jXMgW90bRNCBvJ2q6PhyUF69vj3IymHLjoZIXvtlD52MolzKo8",
-	"4r65RF+JVlvSLk3lR1t5+tREjBNJP5brhAKMIizfgsnH2ug+vf8ak3O6/E1N9knO5TLLiKwgCVGgb90G",
-	"RXHeCpIl6q31sFxCHcMAnmcy0lFHgqp0QF/mjaIEZEm+xGTIZVu8JJShQB0yS2Yoyd+40KuGgYwhcxyt",
-	"hjl36xoxrpM//YoRCWjIUD9litKJcpSbRHA4vQp9Ligrqi+8BzbVpN4aKr87YXMjv3KHd5QEUa7CGeGY",
-	"bUVv5OatIIl0bjVOcChQpDBRkWme6gPTLNGp6OIigya+JV+WmpVKRrn37uOOG7hWBymUSl0EBZJmzVos",
-	"Q5ybepPReFQVn7gX02Z9WFpEeUPqBSs3cpe0RG9trQrvU11AXApycyelwJgxQI0ZDy9OVkrMVCg3hLuR",
-	"a92ANrrU+QQK+FqGbkWCxb2VBeRFNGZ2L86TRCJCQoZSRHTtMExUPWrFsDBJhjpuFQg92qrB7E38nbvS",
-	"ZCC3vXDoUtfphEBK4OXEHEBRnNgm6BolLV1vlJyyro7QRf5c+NUe/VcbUyMtiNJkiK4zMJga7HYFXQaF",
-	"QEzVrmib5AfGN7yC639PmIod+zP6zh34LU8Sw+9SeH0Xha1cgeTEUr4kF3HHBU3CMReIhI7TPqWjiGA0",
-	"AYXawsT4YeoAT5c7USYVZqwua5WzAch5ziSv1vcmF9RFAjmdp+5GUCaj1wizttrfmxbrB0Zht2bWAwKx",
-	"YghG9Wqzw6YlUwTTL0j6hZQYd9Ppw+LUO/P8yDm1fqN3ah8HnJGQbcYBlhLyMIA0bMECirBe2Dpv18PZ",
-	"c0kXdMUowf8ul1JzAPQHCnP1k5SHrzkkAqul3MVsWTKQfE1E7kzD+p0Wt3dRiYy6UdOimdGYlY/Ue8Ju",
-	"3hDFEZnluPguRijNvcES5o2hS7gTq2a9BsBNcBqL+UyGP8IofbjO+IJfDQ4vKp+mnVhrRLvVCrODOJzt",
-	"Hx1M9l+EzyfzOXo+gUfPDiZH4Wzx4jB69jI+mB3PJ89nh/PD/YPx7Nnh88PoILSGvzh4tj/Znx1Ei/3D",
-	"oyg6iI7nk/nzmbMlSL1+zGrxoR5UhXy+NzNaJ9ChMz2wnZx/Rxbet/k1L9MDyoShBErb0V0oLFVn6bSE",
-	"Zo/7PLmmtbzVHtnG8zR1bt3j9hK5idFgt9bi5L7shA2HdxuKHGnhnV4ImmUqe1BVPP1mLtY44wunr+0v",
-	"0tNOvaD2UYjt4vOBMX/DeqqHaoKCfx0qQz4edsbHO2sbBvKlHSN78idjcIOTKIQsKhID9eB3Mfn1nlnx",
-	"1hmnL1suqvKMdhA2AFbhhLXzfM4yFz47ITx2uOKeh9yMiCKuS7JNlqbAmDe2ZX5HCg5cwGeRG+QZ3sTG",
-	"Ebt2kLRK03TT9ElVpGynAuUuhSFbqppw1kmUNPHuOkozKR/e81J6jdgNwwJtdMBdvqW9bWFWKf/ov/VU",
-	"rdsPuu9eYgxxolri8Kt2fqqj8sJ5+bBUp/3drgoFVk3q1F1No5KHIeLcA+5mdXztucZtariA0lfhHrQB",
-	"13A1pBd/5F5ajU41XUelHeGGvwSlvdHVit47T+ZyEweF9RLUlMXwrsZdfQe9dyiZ6SuSabR1fPiLz97G",
-	"hFu9+XyrUj9CKuPkhIaOhN3JO/AhQ+TVxzNw8uGNVLksGR2P+nrqTaTxnGiXFlNiWuzp+CKmisWxUIi3",
-	"FigOYY5HR5KA6nQiQwRmeHQ8OlA/SY0vVgraKczw9Ho+Nf0bpsX0xl8qWyudRWqtVx/P6u2J1CGl1qxq",
-	"vv3ZzGT8ikJvmOlUsUTjX1wXwlR+VGcPVHcjJEX1hlnUikxtIs/TFLL16FjiAMpGSCSmgOfhCkAOat2R",
-	"BFxyq3PR6LMqGfVhr5VPkwBKDF/TaP1guLf7LLWQNsuChVz39gnvQ65oVtuKPSfhb8ctftQHzHwoS1Zd",
-	"pR6HMR1drLrIMh4dPiAYrc5ojqW1Oe8QDKvhbWG4NtmY6Tf9h4oIb7X+S5D2Ax079SGOE0yQJtt7fdqU",
-	"QQZTpHf5n63jLwu8IiZX7SKgWI0KQzCyYBjZalwffbvym/6+0p9bjHPo8MOf2I5STddG++JBG1k4DAMl",
-	"rGp59jgS5mixtmMSZrVd3kjCzMZMvxkvbCMJM97jAAmzwfNLmAXDjy1h9SbanRsZpXsFcE7JeovECQ3/",
-	"6+LDe48o1cGSc5X3/NrsFtEQqOUqqCIaNiAyPmoHOH+7fHc+CBw5sAecldAH5D5wdJDXr3qqRoV9zCzl",
-	"q7jvpW4Ol1coFE9/zRFbW0yNxSooRziY2F06dTt2fExhDRgSOdOtXHSZ1sR0cSiuIrhAqDUv2ASGz9vV",
-	"vo7ekA5JsS/YJkUH1wYfNIdU/FDE+CpG4779t5t9b8vZdvQT39zhnj8YPGVO5MnbOd0ID0ASFaWJEBB0",
-	"Y++6a8PbOmD6zTpZ6LdyJ+phyRSdOmGZ0IVqp5MT/DWv3wr3G7z6Qccgg+e9lddWGDHV97toVkACE25a",
-	"1xR9CVRCx5RTuFSHmuOeOmMHDK/mAwD7eGo8xIbsIq88jk3bpj3p0Gdlx+BDJy8aylMBYvWRkrZ96WKI",
-	"vjTOzvDE5+3YPVca/7aeCJXg3n4f1nhieshkseB9bds00p/lUElwv9tjPt6xWyzaFzM8OduiifwAm1o1",
-	"pujYU/2NjJ9bus0tLd3Q++6oCsk2E9ZPRX+6H9OcuL43dGvsya5qhqpBWJwT3WKyuHT1MAy2geL4wdnL",
-	"8YWhXeUuo6S2zlxl65sO3qp6q/64rNXuLzvcDX7anKY4oNYWc3Nesr6+OyDE1k0EhyRrt8A6/hY82w1w",
-	"640Td+SAqugypItXfcnZoewx/ab/qDJ4A5hF1Xw/PV4ZdxT4epavcB+4vLP+d6tcWr+Rv1tMquuf786j",
-	"ZVeRIRqsbLv1dKxh58WZRzkLanw2aUfYx/62s/2t8/t7WIJBwmPzwWm/e3Vphv3oucZ2OeufxcUqGKFU",
-	"VRRA/T0EXSvQw136iKdPMxVfjetlIMnzkF895um3uTe1WBfNy3SbJ9eaxbOhBqtsq9W1qkM+mss227mN",
-	"N0pPWzZzy6q29XFABxMqIiemzdzTUbQlVBW762r6Icf7l7pPz/YO9+3rAt/zaN/1pawdOucvvxNV3+Gm",
-	"OpuGxTfseY95NB+73+b+1z+n72UBHGsexhxgkuVC91Y2ulT3mS+w0l1Gqy/V6x7llIFrHCJwjRiHW2Wi",
-	"Bkq7w0aXqkBKUZmYRq2mnTyNAWz26G8RdW8A5xV3x4aZ1OJ22CPUs+64ai8v591Lx19WN/u2IevmTtf3",
-	"U+8+AJ6oPq/t7CbCNdVNZnqU+5ka9Ej73ryjujkb7G8Jnt3Rz6Z10N3Z4ptqkbdJDV+DOzaKju0ufY6w",
-	"uIRlYFDsa++303Vz/pvVTQU+2FjuzjbNfjjF3rbXXVvuLZCr7lj/3PSdKU0buu8t/X03rf1UOaKr2FrB",
-	"gK4RAThWXeoBzxdF2MfKXkU/y619kf4AM7EzfPEIudLvoZ0aQeShrzNeR1G1f/f7SqqfMgNstYr6fgnG",
-	"2Y+eYCyrqwcmGC2T5TmfK3rwFf01h6SDan07+c4oskcvjnCesegu0aY78chX9PDr8Bl1I+nuCdWYXx//",
-	"TLzNLTt3Mq7O6uzqCkgi043W/MBoLsxdNFy7WHx3qRxcS1ZWkb1eS1q/ItHdTtB/EKH8Wd3Wxd/uErd7",
-	"c/GGJW9lsdtPlv5ZhLezsuSsxHtgUZLvLRK0YUpikaALwfJQ5OynTD01mRr7O9r6SF5wwGCau78Vtfvp",
-	"+5rkcYvFN03O/JSQnxIy/z7BUp35dj9Y6hRDf5asTM/8FMWNF/9RBPHhU5RWUrAph3+uWmwtcRuazW6v",
-	"VcDeOpfyE+A/WOa79enzXb2Pq78vfrfk87CbRdaHDHdQ2ZctzXe9tn5HLzGZaxWaezbjTpr1Ki/9Lfwf",
-	"TndptHdfddHMr7nUx0fYdbGj9ebza5rvRTSFmKjW8yNJajOBWxeM+rrdRzQc3OLe9LSffs1xeDVRGnii",
-	"y1InVVewmo4ZuTwzhfZ2obrBYjWJUgsetWwbmqILbDmu+OH28+3/BQAA//8+kDDk07YAAA==",
+	"H4sIAAAAAAAC/+09a3PbOJJ/Bae7DzMpyZL8yuNqPySxk/WdnaRi5+a2pnIKRUIWNxTB4cMebcr//brx",
+	"IEESIClbcqyxd6rWColHo7vRLzSaP3ouW0QspGGa9F796CXunC4c/vN1QOP0zAmdSxpfsIgF7HKJz6OY",
+	"RfDGp7zVnCUp/qV/OosooL1XvfHu850R/Dfu9XvpMsJHSRr74WXvpt+LWFxu/nL0ci9v54cphdl6N9Ay",
+	"pn9kfky93qvfxSSy89e8NZv+k7opjvo2yJKUxmcO/n8dRsfz+FOPJm7sR6nPQuiOT2mSEDYj6ZwSN4tj",
+	"wAJZ8EFIyDwKUxqW9erF7qFxbU7gX9H6PCwM/JCSJHXSTM7mJ3IafYY0zmg+6pSxgDohDgt/PWqAHwbR",
+	"RuJrkE07DBo6C1ommxjGsLAKLXhPtdgcur5AchNx6GJK4/+hccLBr9LIZeHMv5zMnWReXyo+VXQKmOsE",
+	"RDQvsMkHNxHl0k8tg8Ibog889UMnXnYYso67axZ/N+IOUMcCA0/g08pMfUVIFhMxXon98ke1Ga4KlFYm",
+	"oUCchBLZoL6yYvSrw52DnVFX2vM1NZDaLi0clCmThRAqk1Rr9x8xncFY/z4s5NFQCqOhURIhbWNnBk87",
+	"j/NetNeHEDjPR5gEvhBnfkoXSdt4Qt7ow0mMOHHs8H/D6hcUmCtLOgP5Ke+iDyzIf0s4f+Od7XDe2Ekp",
+	"uv40kTplWehNEpbFLp2ofVeekzchognBJjmfC5zVp10skz+CwahpwhRYzToVvmydhLe9o/SwSF5EfRlS",
+	"E6KM+5OFIAyQZ53k+2cYmgouKtM2hZdtLIUDcEaCvxMpu2e+SdRJSY0viR+SpbMIyIzFCwekb5pGyavh",
+	"0GNushPBkl0n2oHJhv+aD1Pfmw5hddOADnGSgRgnix0cd4DDDWZZEOwY0da28gTWk9C/5NJ1juHLMUBq",
+	"5I2YOik95xxkZQ3BYG0YEoNoYsvG84N2ppcz2iFeEyubMGea9MhPkDCfaeAstWkrctDFHyRlICxYRBwS",
+	"Y3MSy/b9CpQalnLB3i7PP0DzU2xtZPijbBGdc5OzDl5hinrQimShX4cJpw1oSr0JZ0T+TPAuDOCxDJ4V",
+	"tAszblHAtLA8H9pQ0FSpE0xidt2158wP/WQO802XKV250woTCcgMqwLv43C/1+qMlPr364iqLaUKphlL",
+	"JmY7DlfjNSdOW5mNv52AsQu2wOQSZI2RP6B5eEneX5wcKWWeRbBDqbMgomtJ2dGXznjm7u4OqDt6MRiP",
+	"6cvBdNdxB6PdffgzHo9Go71X48HzF/svoV8IsgvXVfFONJtZB9Gs9XMQUZ4VWr8ZTKH44cXOCP+32x0W",
+	"z5fWzszJAuSVnaF4IaYow4ZgQAegIQN/4npOY8pBE3SBHgTsBhAMyE8dINiEdDiOYxb/5qfzMzDXjLYO",
+	"sgzXN4Ri2xob8aegVDxDX/6OuMIkqu6mvuy6SC5tPRcSqDbdUAzU1+Ex7aT3NJUW7Uk4Y3YDwBWNJqZt",
+	"Id8RH8mWS43MJjbAueCOlkEAK4/MD8UwuXdGierT72baG51rgyPS1fuoenCFfzm5duIQqVBbTchSQhdR",
+	"ynk95KuQfbiOkSsiDmyDhf8n9ciULhkY0g6JnNSdw16ZzWCPhG47xTXyNFNZuGa4Aey09pzU6exDlUNM",
+	"RgyDKOc2Rwf1gTIDZ29ehNjJ61+EdOo2vAhhBa4R+sKs3DzYwnRaK+DSGtsw+GjNrhHnubOzYZDP/MuY",
+	"G/PxJU2TNQJfGvg+VrJezsmmxZj3Af0FmiLnYIm4aRZT+yoEgBOXu2ATMKvK7t3bz8evL47Jxes3p8fk",
+	"Wzr+Rn755nvfQOGlv4zHv5IPHy/Ihy+np+T1l4uPk5MP0P7s+MNF/9Pnk7PXn/9B/vv4H6LHr2T47OLf",
+	"fpdyHyxoP/Ton1/J29Mv5xfHn4+PyLPhr+T4w/uTD8d/OwlDdvSGHB2/e/3l9IK8/fvrz+fHF3/L0tmL",
+	"xXSfvP14egpQqX+jgWkK0Mil1X1Wb2oMGXGz39CcPx938NHz7mosDatGUlXCmGs/k9kD4/jOZzKnzPHa",
+	"HdAAWpkd0AZ/sMniSh3pOGiboliq9j73fer4iNklhjGNL4XH1h2mCtZqrqE+njZ1eSkGwE0or8Sj78oX",
+	"tjOiTjyEEd1WbEiub2Olj9wXoc2hO9i17vcJII87aFWOi2I64C2IbKH7hcVLcMwiJ0mot0PMW/0u4aR+",
+	"GcaWlVYlcav7Lzw2cDWxo9X9n4EknZd8WeF2lkf9LQb1lHBTXqwLJ+AhfVxBxICgJMEnTkqOzojrhGIn",
+	"+ylxZugjwRqVh47dVCCydg4JMg4jkymg0CAm/gjIkmXk2oHpihWWaGfQNOSbOy5UjdIGqG768GrX/mrP",
+	"/OoO+uU/jQpmGbr1xX6JQDdLnDN4uAAjzndJMndiD9GIEgC1N7kGt12cPUjSsDBYkgyYVvhfjnTZCXPd",
+	"DPwuP7SOeXR0ShYlNz0nTTUMq9HJxLiGU6tNpArcXS19ymJTuKOIzbi4/iwisArfXZJS7L0eBfkzgomT",
+	"0n4aVTcTbyScfCABj1Tl0+mRBKVCLBEhTc3hz/hKGH75vHuHo9rUF3M8eRCNcQcB6D7zfNcJgGWkyJvV",
+	"g1NiWV6fyMEJdM/oK8KnQIZKKODFS24HfQxM64eTJHJcWlrB+KAK/xmoy0W2ILOYYkwt+U54Lw7D+ze3",
+	"mf7GxhNrjejfYwSzLWJZmjOirj9bSuCTbKrFKQGTpAb2DjmZEYzviJ4+8gRPwkBRlYLkoSCOgoBMKRdA",
+	"O+ScQypPuV6RXYc+P9zf2x/Mnr+cYWD4xWDq0V0VGEZD84VYyrg9FFrZ6XUcm/Y7J+tbvonr+OAaLc8k",
+	"4QPWtziPwU/Ey8Ii1HTYU0R9qyLqNzYuafdWdLFd5hKZR1K4HuUhKjhUR8J59LeE1F8qWB33yfjl85e/",
+	"mjZ7aV4L85l47g7M1sxcZhAE4lQ+CAK0fgBcDCZPsmiyyNMAy0AA3wAKYhTivC0gQxhTOXU098u2zY1y",
+	"dTX+LNa9MwQZzIc0mYnmJBSFRMGVpeE+ZyGP0LdJzjKzGplIX66JwjakK7BNovicm6v5wVR9nwlzlsse",
+	"ftClnYG0R2EqgbFzCojy02V9Gm5Ey3yhJAnKFp5Qb6DoAi/XbHPf88Cu5sb1JU1zp0YfqDQImCtswZtw",
+	"22vm8JONqliquK+YGAdmGbum3sQ1ZPO9ZYsFDP1BSubz81OCfUApu44IHuTIakUOLBsoZ3e8tIGFqFIt",
+	"dW4z8iwOjCuxDv1OGw7X8en4TFoLw/89GL1U+TGVpbXP+p0u7ZO+LeZDqkSxf4VLgz55co42ect8Vc+o",
+	"jEsDDuoAGneHdMrexyyLDGFjL6gn/bUSeubHSTrBlNlUpogavVHqrTZsKqLppqZZuPqAtWAJH71frLm2",
+	"kBxsbUIjUvN8pYqoEc/Ntl7JLpk5QVILj+SahHvhQgKg28S7l0S87F7XJtKsLNRlp/kYmtnCiERvLkMB",
+	"xaVyImSOSb1bQZgFzhUzaDPxPM9wzHFVMftMO1G5+CZsE5kdak4BNUYAnCS5ZrFnHTFvUB5yb//gsIsl",
+	"qiIM5rHxpTbu3t7o0OTNRiqg0JjUyxsVpkrujzR10l0X3KiaRms8M1LtsE9T5qyWM9s5P1ZYHaulH7ce",
+	"f2J6Yuf0FoyNFsktsP8Tk60n14Yva+uLGUs75h1ODBFqOWV5C6t/NUihBsNHS162Gz6i1aCb9aOj3DZf",
+	"bkGaMnva03OEQZTwuB+aRNcxM9meiueTHJhWni9Y5Q78G9MoAPvBwseVxNR61EzmdUtrO1gSkfwtw+AG",
+	"mbhiRqviLB0QI++gW96Y4xrTBbuiE4wPr6RJRD8eV+am7BTvioAl5LHrUPpD6rE5dO/MYFbm0QlGNSee",
+	"ipHWvSMMeqrXqFawp4o7a3J7lBglToGuTvKhstmEzAJrDKEwwOZgTJEnTvIGOkC7o9EhsA94oWR88Gq0",
+	"/2p00C3Z/DxlUSPJ7r4mBJZlaWesXzu+8FvEellURv1B0nFlpXyEupGaLaKOG13LT14hJbCzzMHTqI6Q",
+	"aAfV2qGngU3UGX0Dh7YJKbuT36byznlDaa93XNk5NC1Wxk/ZzSvDV4TDpnMFP6fqm0x8MONYcAWOKrfQ",
+	"mft9YjlKbxSz6uqMETXmk2K77FSolOs0itICHQ0xPly1OSNBxj/EuIbFThET8BuxYppCP3W7nvvuPA+I",
+	"gZWtOq/kx9eijh3jgwYV7QIckzTqmmghD4AmUwr70NNCbl365g6iQangu8YVlVrYVyTyKuiVutjcAS6Z",
+	"2N8ZB9o+uESnvYnmokGF7JgRm4UDNUrXnN9ypKDVm9YRoS+yRPV+t6BgmTxGYlT3gQlPmvuubyobW5k2",
+	"M0+PuGss0ZaiVd9pFzLzoy48bWJi5geIvzgTAQVwfn3s5QSfSq3b5P4bPzxll+/4YJ9xLJNapuHcAXxO",
+	"xFXziUrOg4eXtDXXQzMJhQ9DkixCT4cfCfLUAXGDHchJoiC79MMuN8z9y5DFdMIPmZEZcvRXbrHzZiQC",
+	"XhTH0byZkVrqonMHcvF0KYGG8imTtxhwQ7mKBIPRy5ePQXuVfWE9sCkGteZQ2c0JnRuT72b3joVg6HF3",
+	"JjWMNmfXSDygtidiqzNomVKPr4R7ptlCHJhGgQhFqysdAvna/tLELAoZbt6bjzuunSU/SGEMZRFGU0Gt",
+	"aZNFYLDJfBN4WiSfmCcTar1bWIRbQ7yDFhu5TViiNbeWu/cLkUCcb+QqJXHDyDaEt+l3T07mQkxmKFc2",
+	"dyXWugJuRKrzEdD+DbpuKsBiJqWCXHljknp4vRQXErrgJcIMPHfYCXg+asGwThB0NdwKEFqkVYXZq+s3",
+	"UqXKQGZ9YZClptMJeIcbHgdOiJOqE9sANGhQk/VSyHHtanBd8LGyqy3yr9SmhFriLYIusk7CIHOw6xl0",
+	"kZPCmnjuitBJdmBszQu4/u8o5r5je0TfSIF3wFeS33Hz2q5Ma7EC5MR8fyEXJYarqmECG5+GruG0j8uo",
+	"MI1ZQJTY8kNph/EDPJHuBOoOBOaMX1vLRyNOkgAoYSXS42QpM6EAh7Pk3YAWQe8VXtbF/s5QzT+RArs2",
+	"smgwSeegoLxyttl+VZNxhIkOiD9YjjQ3jTasv7COPD40Di16tA5t44ATECmrcYAmhCwMgIptMsWT6PIC",
+	"6vlw+lhogs5jFvr/yqfiYwDyqJvxR7gf/sicMPX5VOZkNpi7G/qqC7k1Dst3WszWRbFl+I2aGs6kxCxs",
+	"pNYTdtkjVUdkmuFiuxjBJfcKU8geXacwB1blfBWAq+BUJrOpDLuHkdtwjf5F8r2ze1HYNPXAWsXbLWYY",
+	"7c3c0e7h3mD3hfscM2eeD5zDg73BoTuavtj3Dl7O9kaYOTPaH+/v7vVHB/vP9709V2v+Yu9gd7A72vOm",
+	"u/uHnrfnQfPx85GxOEo5f0wrdsJfFIl8tp4RKyNo3xge2EzMvyEKbyN+ycq0gDLA0w3UHc2Jwig6c6PF",
+	"lTRus+Sq2vJGWGQrj1OVuWWL24rk6oo6m7UaJ7dFJ3Q4rGRQMVJlnWJ8PeLRgyLj6Z28WGP0L4y2tj1J",
+	"Txj1YDloXqFu4icdff6K9uQv+QCKfw0iA193O+NLGnMbOvKl7iNb4id9TITyXHAHVWCg7PxOB8/uGBWv",
+	"nXHaouVpkZ5Rd8I6wJoaYW08n9PUhU1PpBY9XHDPOonhMfBLeEq2jNKoFScVsoxvicGOE9g0cgU93cv5",
+	"GHzXBpQWYZpmnD6ojJTNZKDcJjFkQ1kTxjyJHCdWqlOYA8Wy7byUXdH4Gq+krXTAnfcS1nYqZ8l/tN96",
+	"KuZtB912L3Hm+AEvDpR8r8enGjIvjJcPc3HaXvdLCbBiUKPsqiqVzHVhR1jAXS2Prz5Wv44NE1DiKtxa",
+	"S5F1F0Ni8nuuKlap2dN0VNrgbthTUOqELma03nmSl5sSorQX7CkxRdJUwqztoPcWKTNtSTKVApfrv/jc",
+	"UOB1gzefb3joB6NxTnDEXEPA7uiMfIxo+PrTCTn6+BZFbhzgiUBLdcEBKs+BMGlhIFlsUPgXM8ZZ3E/5",
+	"wmsT5NVme4eIQH46AQ2cyIdHe/wRSvx0zqEdwvPh1Xgo6zcM1fDSXsqLTJ14fC6YplyoiR9SCsnKx9sd",
+	"jWTETyV6O5EIFeMy/pmIRJjCjmqsBmsuCcWxXlGLQpBxIibZYuHES6wfTFOSl4SCEcBecufESUipThT4",
+	"LolWuaj3laeM2lYvhE8VAXwbvmHecm1rr5V5qi9aTkumOO/NA6ZDxnFWIsWOEfHQq8qP4oA56cqSRVWp",
+	"+2FMQxWrJrT0e/trBKNWI84wtVDnDRtDK/2rFNcqhBn+ED+4R3gj5B8WW7RQ6uNshkdKAm0fxGlT5MTQ",
+	"V1D599rxlwae8sl5uQgQYD2lCHoaDD1djIujb1N8015M/WuNcfYNdvgDoygTeK0Ucu5ESGUwdNxhRcmz",
+	"+9lhhhJrW7bDtALUK+0wSZjhD2mFrbTDpPXYYYfp4Nl3mAbD495h5XLijYT0FjsKOOPOAiYHo/G/zj9+",
+	"sGylMlg4Vn7Pr85uYEoSPl0BFTyqQCRt1AZw/n5xdtoJHGzYAs48FQfkNnCEk9cueopChW3MjPtL3ffi",
+	"N4fzKxScp8FgipcaU0OLSd7CwMTm1KmbvuELIlizIc1iUcpFpGkNZBUHdRXBBEKpeMEqMHzdrPQ11IY0",
+	"7BT9gm2gatlW+KDapOAH5eNzHy2x0V8ve74pY9tQWX11g3u8NnjymMiD13OiEB5xQk+lJjokpNc61U0E",
+	"r8uA4Q/tZKFdyx3xlzlTNMqEy4BNeTmdLPSBgCWOtCu88kFHJ4VnvZVXFxgzJu53sUhB4gSJLF2j6hLw",
+	"gI5MpzCJDj7GHWXGFihewQfEaeOpfhcdso28cj86bZP6pEGe5RWD9428KDHPMNUZP9dS1y9NDNEWxtka",
+	"nvi6Gb1nCuPflAOhCO7Nz2GNByaHZBTLuatuG3riAyU8CG43e+RnTLaLRdt8hgenWwSS10DUojBFA03F",
+	"10KeSLpJkuZm6F0pyl2y1TbrZ1Wf7nGqE9OXl26kPtlWyVAUCJtloSgxqS5drYfBVhAcj5y9DN9a2lbu",
+	"kkJq48yVl75p4K2iturjZa16fdnuZvDD5jTOAaWymKvzkvbJ6Q4utigi2CVYuwHWsZfg2ayDWy6cuCUH",
+	"VKrKkEhetQVnu7IHPOU/igheB2bhOd8Pj1f6DQm+lumLtXec3pj/u1EuLd/I3y4mFfnPt+fRvKpIFwmW",
+	"l916ONqw8eLMvZwFVT6btCXso3/luighsw4LK42dMJnJT2/bzasL2eyxxxrr6ax/FRNLMUIuqhhxxPcQ",
+	"RK5AC3eJI542yaS+GtfKQMjzmEx/j6ff8t7UdKmKl4kyT6Y51buuCisvq9U0q2F/VKetlnPrrxSe1nTm",
+	"hkVt7eOABibkSA5kmbmHI2hzqAp2F9n0XY73L0Sdns0d7uvXBX7m0b7pS1lbdM6ffyeqTOGqOIO5wysa",
+	"q8zdJvKLhpukvwKlhQXwMzvIwz5+kCnKUlFbWcpSUWderUpUGcUbMvLbJLxGOYvJle+KT+s6G2WiypK2",
+	"h40ueIIUx3IoC7XKcvJglDnVGv01pO504Dx1d6ybSlW3w+4hn3XLRXt+Oe9OMv6iuNm3ib0u73T9PPFu",
+	"A+CByvMSZVfZXENRZKZFuJ/wRvdE9+od1dXZYHdD8GyPfJalg27PFj94ibxVcvgq3LGSd6xX6TO4xTks",
+	"HZ1iW3m/rc6bs9+srgrwzspye8g0enSCva6vm0huTZAr7lg/EX1rUtO60r0mv28ntR8qRzQlW3MYsKIj",
+	"flUVq9TjNzaV2xfntYqe0q1tnn4HNbE1fHEPsdKfIZ0qTuS+rTJeQ1K1nfptKdUPmQE2mkV9twDj6LEH",
+	"GPPs6o4BRk1lWc7nVA0+VV+zSzioVLcz2RpBdu/JEcYzFlElWlYn7tmSHp51H1EUkm4ekLd5dv9n4nVu",
+	"2bqTcX5Wp2dX4C0+sVvkg5hlqbyL5pcuFt9+V3bOJcuzyN4sEdevQ+92J+iPZFM+Zbc18bc5xe3OXLxi",
+	"ylue7PbE0k9JeFu7l4yZeGveStgPCyisFpLAu1VAajfN4qc99dD2VN9e0daGcsUBnXFu/lbU9ofvSzsv",
+	"0Vh81eDM0w552iHjn+MslZlv+52lxm1oj5Ll4Zmnrbjy5I9lI64/RKkFBav78K+Viy123Ipqs9lqxU9P",
+	"t+S55J8Af2SR79qnz7f1Pq74vvjtgs/dbhZpHzLcQmGflzTf9tz6Lb3EJK9VCO5ZjTtZ1Cq8WPQoZZdY",
+	"9vaLLhbZJRf/+Eh8pShaLj6/ZNmOxxaOH/LS8z1EtRzALAt6bdXuscJn1xL3sqb9EFjD/T7gEngg0lIH",
+	"RVWwkozpmSwzvuzNQoWH/wNvocHDp61Do6rA5u3Ug5uvN/8POjvAWMi5AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -61,6 +61,22 @@ type ClusterMaster struct {
 	Name   string `json:"name"`
 }
 
+// ClusterMemberVersion defines model for ClusterMemberVersion.
+type ClusterMemberVersion struct {
+	// hash of the local config of this member
+	ConfigHash *string `json:"config_hash,omitempty"`
+
+	// git hash of the binary of this member
+	GitHash *string `json:"git_hash,omitempty"`
+	Name    string  `json:"name"`
+
+	// role of this member, master or worker
+	Role string `json:"role"`
+
+	// release version of this member
+	Version *string `json:"version,omitempty"`
+}
+
 // ClusterTopology defines model for ClusterTopology.
 type ClusterTopology struct {
 	AlertManagerTopology *AlertManagerTopology `json:"alert_manager_topology,omitempty"`
@@ -156,8 +172,14 @@ type ErrorWithMessage struct {
 // GetClusterInfoResponse defines model for GetClusterInfoResponse.
 type GetClusterInfoResponse struct {
 	// cluster id
-	ClusterId uint64           `json:"cluster_id"`
-	Topology  *ClusterTopology `json:"topology,omitempty"`
+	ClusterId uint64 `json:"cluster_id"`
+
+	// version information of the members
+	Members  *[]ClusterMemberVersion `json:"members,omitempty"`
+	Topology *ClusterTopology        `json:"topology,omitempty"`
+
+	// not empty when the versions of members are mixed beyond a patch difference
+	VersionWarning *string `json:"version_warning,omitempty"`
 }
 
 // GetClusterMasterListResponse defines model for GetClusterMasterListResponse.
@@ -319,9 +319,12 @@ func (m *StartTaskResponse) GetCheckResult() string {
 }
 
 type OperateTaskRequest struct {
-	Op      TaskOp   `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Sources []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	Op             TaskOp   `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
+	Name           string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sources        []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	KeepMetaDays   int32    `protobuf:"varint,4,opt,name=keepMetaDays,proto3" json:"keepMetaDays,omitempty"`
+	PauseAtTime    string   `protobuf:"bytes,5,opt,name=pauseAtTime,proto3" json:"pauseAtTime,omitempty"`
+	PauseAtTimeout string   `protobuf:"bytes,6,opt,name=pauseAtTimeout,proto3" json:"pauseAtTimeout,omitempty"`
 }
 
 func (m *OperateTaskRequest) Reset()         { *m = OperateTaskRequest{} }
@@ -378,6 +381,27 @@ func (m *OperateTaskRequest) GetSources() []string {
 	return nil
 }
 
+func (m *OperateTaskRequest) GetKeepMetaDays() int32 {
+	if m != nil {
+		return m.KeepMetaDays
+	}
+	return 0
+}
+
+func (m *OperateTaskRequest) GetPauseAtTime() string {
+	if m != nil {
+		return m.PauseAtTime
+	}
+	return ""
+}
+
+func (m *OperateTaskRequest) GetPauseAtTimeout() string {
+	if m != nil {
+		return m.PauseAtTimeout
+	}
+	return ""
+}
+
 type OperateTaskResponse struct {
 	Op      TaskOp                  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
 	Result  bool                    `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -1565,8 +1589,11 @@ func (m *OperateSourceResponse) GetSources() []*CommonWorkerResponse {
 }
 
 type RegisterWorkerRequest struct {
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Version    string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	GitHash    string `protobuf:"bytes,4,opt,name=gitHash,proto3" json:"gitHash,omitempty"`
+	ConfigHash string `protobuf:"bytes,5,opt,name=configHash,proto3" json:"configHash,omitempty"`
 }
 
 func (m *RegisterWorkerRequest) Reset()         { *m = RegisterWorkerRequest{} }
@@ -1616,6 +1643,27 @@ func (m *RegisterWorkerRequest) GetAddress() string {
 	return ""
 }
 
+func (m *RegisterWorkerRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RegisterWorkerRequest) GetGitHash() string {
+	if m != nil {
+		return m.GitHash
+	}
+	return ""
+}
+
+func (m *RegisterWorkerRequest) GetConfigHash() string {
+	if m != nil {
+		return m.ConfigHash
+	}
+	return ""
+}
+
 type RegisterWorkerResponse struct {
 	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1874,6 +1922,9 @@ type MasterInfo struct {
 	Alive      bool     `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
 	PeerURLs   []string `protobuf:"bytes,4,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
 	ClientURLs []string `protobuf:"bytes,5,rep,name=clientURLs,proto3" json:"clientURLs,omitempty"`
+	Version    string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	GitHash    string   `protobuf:"bytes,7,opt,name=gitHash,proto3" json:"gitHash,omitempty"`
+	ConfigHash string   `protobuf:"bytes,8,opt,name=configHash,proto3" json:"configHash,omitempty"`
 }
 
 func (m *MasterInfo) Reset()         { *m = MasterInfo{} }
@@ -1944,11 +1995,35 @@ func (m *MasterInfo) GetClientURLs() []string {
 	return nil
 }
 
+func (m *MasterInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetGitHash() string {
+	if m != nil {
+		return m.GitHash
+	}
+	return ""
+}
+
+func (m *MasterInfo) GetConfigHash() string {
+	if m != nil {
+		return m.ConfigHash
+	}
+	return ""
+}
+
 type WorkerInfo struct {
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Stage  string `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Addr       string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Stage      string `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Source     string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Version    string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	GitHash    string `protobuf:"bytes,6,opt,name=gitHash,proto3" json:"gitHash,omitempty"`
+	ConfigHash string `protobuf:"bytes,7,opt,name=configHash,proto3" json:"configHash,omitempty"`
 }
 
 func (m *WorkerInfo) Reset()         { *m = WorkerInfo{} }
@@ -2012,6 +2087,27 @@ func (m *WorkerInfo) GetSource() string {
 	return ""
 }
 
+func (m *WorkerInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetGitHash() string {
+	if m != nil {
+		return m.GitHash
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetConfigHash() string {
+	if m != nil {
+		return m.ConfigHash
+	}
+	return ""
+}
+
 type ListLeaderMember struct {
 	Msg  string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2344,9 +2440,10 @@ func (m *ListMemberRequest) GetNames() []string {
 }
 
 type ListMemberResponse struct {
-	Result  bool       `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg     string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Members []*Members `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Result         bool       `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg            string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Members        []*Members `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	VersionWarning string     `protobuf:"bytes,4,opt,name=versionWarning,proto3" json:"versionWarning,omitempty"`
 }
 
 func (m *ListMemberResponse) Reset()         { *m = ListMemberResponse{} }
@@ -2403,6 +2500,13 @@ func (m *ListMemberResponse) GetMembers() []*Members {
 	return nil
 }
 
+func (m *ListMemberResponse) GetVersionWarning() string {
+	if m != nil {
+		return m.VersionWarning
+	}
+	return ""
+}
+
 type OperateSchemaRequest struct {
 	Op         SchemaOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.SchemaOp" json:"op,omitempty"`
 	Task       string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
@@ -3591,155 +3695,162 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xd1, 0x1c, 0x92, 0xa2, 0xc8, 0xa2, 0x44, 0x53, 0x2d, 0x89, 0x1a, 0x8f, 0x65, 0x5a, 0xee, 0x7d,
-	0x40, 0x10, 0x3e, 0x58, 0xb0, 0xbe, 0x9c, 0x16, 0xd8, 0x20, 0x6b, 0xd1, 0x6b, 0x0b, 0x91, 0xd7,
-	0x9b, 0x91, 0xed, 0x64, 0x11, 0x20, 0x9b, 0x21, 0xd9, 0xa4, 0x08, 0x0d, 0x67, 0xc6, 0x33, 0x43,
-	0x69, 0x0d, 0x63, 0x73, 0xc8, 0x29, 0xa7, 0x3c, 0xb0, 0x41, 0xf6, 0x98, 0x43, 0xfe, 0x40, 0x7e,
-	0x46, 0x8e, 0x0b, 0xe4, 0x92, 0x4b, 0x80, 0xc0, 0xce, 0x3d, 0x7f, 0x21, 0xe8, 0xea, 0x9e, 0x99,
-	0x9e, 0x07, 0xb9, 0xe1, 0x02, 0x11, 0x72, 0x9b, 0xaa, 0x6a, 0xd6, 0xbb, 0xab, 0xab, 0x0a, 0x84,
-	0xd6, 0x70, 0x3a, 0xb5, 0x82, 0x90, 0xf9, 0xf7, 0x3c, 0xdf, 0x0d, 0x5d, 0x52, 0xf6, 0xfa, 0x46,
-	0x6b, 0x38, 0xbd, 0x72, 0xfd, 0x8b, 0x08, 0x67, 0xec, 0x8e, 0x5d, 0x77, 0x6c, 0xb3, 0x43, 0xcb,
-	0x9b, 0x1c, 0x5a, 0x8e, 0xe3, 0x86, 0x56, 0x38, 0x71, 0x9d, 0x40, 0x50, 0xe9, 0x2f, 0xa0, 0x7d,
-	0x16, 0x5a, 0x7e, 0xf8, 0xcc, 0x0a, 0x2e, 0x4c, 0xf6, 0x72, 0xc6, 0x82, 0x90, 0x10, 0xa8, 0x86,
-	0x56, 0x70, 0xa1, 0x6b, 0x7b, 0xda, 0x7e, 0xc3, 0xc4, 0x6f, 0xa2, 0xc3, 0x6a, 0xe0, 0xce, 0xfc,
-	0x01, 0x0b, 0xf4, 0xf2, 0x5e, 0x65, 0xbf, 0x61, 0x46, 0x20, 0xe9, 0x02, 0xf8, 0x6c, 0xea, 0x5e,
-	0xb2, 0x27, 0x2c, 0xb4, 0xf4, 0xca, 0x9e, 0xb6, 0x5f, 0x37, 0x15, 0x0c, 0xd9, 0x85, 0x46, 0x80,
-	0x12, 0x26, 0x53, 0xa6, 0x57, 0x91, 0x65, 0x82, 0xa0, 0x5f, 0x69, 0xb0, 0xa1, 0x28, 0x10, 0x78,
-	0xae, 0x13, 0x30, 0xd2, 0x81, 0x9a, 0xcf, 0x82, 0x99, 0x1d, 0xa2, 0x0e, 0x75, 0x53, 0x42, 0xa4,
-	0x0d, 0x95, 0x69, 0x30, 0xd6, 0xcb, 0xc8, 0x85, 0x7f, 0x92, 0xa3, 0x44, 0xaf, 0xca, 0x5e, 0x65,
-	0xbf, 0x79, 0xa4, 0xdf, 0xf3, 0xfa, 0xf7, 0x8e, 0xdd, 0xe9, 0xd4, 0x75, 0x7e, 0x8c, 0x6e, 0x88,
-	0x98, 0x26, 0x1a, 0xef, 0x41, 0x73, 0x70, 0xce, 0x06, 0x5c, 0x1c, 0x17, 0x21, 0x74, 0x52, 0x51,
-	0xf4, 0x67, 0x40, 0x9e, 0x7a, 0xcc, 0xb7, 0x42, 0xa6, 0xfa, 0xc5, 0x80, 0xb2, 0xeb, 0xa1, 0x46,
-	0xad, 0x23, 0xe0, 0x62, 0x38, 0xf1, 0xa9, 0x67, 0x96, 0x5d, 0x8f, 0xfb, 0xcc, 0xb1, 0xa6, 0x4c,
-	0xaa, 0x86, 0xdf, 0xaa, 0xcf, 0x2a, 0x29, 0x9f, 0xd1, 0xdf, 0x68, 0xb0, 0x99, 0x12, 0x20, 0xed,
-	0x5e, 0x24, 0x21, 0xf1, 0x49, 0xb9, 0xc8, 0x27, 0x95, 0x42, 0x9f, 0x54, 0xff, 0x43, 0x9f, 0xd0,
-	0x8f, 0x60, 0xe3, 0xb9, 0x37, 0xcc, 0x18, 0xbc, 0x54, 0x22, 0xd0, 0xdf, 0x6b, 0x40, 0x54, 0x1e,
-	0xff, 0x23, 0xb1, 0xfc, 0x18, 0x3a, 0x3f, 0x9a, 0x31, 0xff, 0xd5, 0x59, 0x68, 0x85, 0xb3, 0xe0,
-	0x74, 0x12, 0x84, 0x8a, 0x79, 0x18, 0x33, 0xad, 0x38, 0x66, 0x19, 0xf3, 0x2e, 0x61, 0x27, 0xc7,
-	0x67, 0x69, 0x13, 0xef, 0x67, 0x4d, 0xdc, 0xe1, 0x26, 0x2a, 0x7c, 0xf3, 0x91, 0x39, 0x86, 0xcd,
-	0xb3, 0x73, 0xf7, 0xaa, 0xd7, 0x3b, 0x3d, 0x75, 0x07, 0x17, 0xc1, 0x77, 0x8b, 0xcd, 0x1f, 0x35,
-	0x58, 0x95, 0x1c, 0x48, 0x0b, 0xca, 0x27, 0x3d, 0xf9, 0xbb, 0xf2, 0x49, 0x2f, 0xe6, 0x54, 0x56,
-	0x38, 0x11, 0xa8, 0x4e, 0xdd, 0x21, 0x93, 0x59, 0x85, 0xdf, 0x64, 0x0b, 0x56, 0xdc, 0x2b, 0x87,
-	0xf9, 0xd2, 0xc9, 0x02, 0xe0, 0x27, 0x7b, 0xbd, 0xd3, 0x40, 0x5f, 0x41, 0x81, 0xf8, 0xcd, 0xfd,
-	0x11, 0xbc, 0x72, 0x06, 0x6c, 0xa8, 0xd7, 0x10, 0x2b, 0x21, 0x62, 0x40, 0x7d, 0xe6, 0x48, 0xca,
-	0x2a, 0x52, 0x62, 0x98, 0x0e, 0x60, 0x2b, 0x6d, 0xe6, 0xd2, 0xbe, 0xbd, 0x0b, 0x2b, 0x36, 0xff,
-	0xa9, 0xf4, 0x6c, 0x93, 0x7b, 0x56, 0xb2, 0x33, 0x05, 0x85, 0xfe, 0x5d, 0x83, 0xad, 0xe7, 0x0e,
-	0xff, 0x8e, 0x08, 0xd2, 0x9b, 0x59, 0x9f, 0x50, 0x58, 0xf3, 0x99, 0x67, 0x5b, 0x03, 0xf6, 0x14,
-	0x4d, 0x16, 0x62, 0x52, 0x38, 0x9e, 0x7a, 0x23, 0xd7, 0x1f, 0x30, 0x13, 0x6b, 0x9d, 0xac, 0x7c,
-	0x2a, 0x8a, 0xbc, 0x83, 0xd7, 0xb9, 0x8a, 0xd7, 0x79, 0x93, 0xab, 0x93, 0x92, 0x2d, 0xef, 0xb5,
-	0x12, 0xb4, 0x95, 0x74, 0x65, 0x35, 0xa0, 0x3e, 0xb4, 0x42, 0xab, 0x6f, 0x05, 0x4c, 0xaf, 0xa1,
-	0x02, 0x31, 0xcc, 0x83, 0x11, 0x5a, 0x7d, 0x9b, 0xe9, 0xab, 0x22, 0x18, 0x08, 0xd0, 0x8f, 0x60,
-	0x3b, 0x63, 0xde, 0xb2, 0x5e, 0xa4, 0x26, 0xdc, 0x94, 0x95, 0x29, 0xba, 0x72, 0xb6, 0xf5, 0x2a,
-	0x72, 0xd3, 0x2d, 0xa5, 0x3e, 0xa1, 0x7f, 0x91, 0x9a, 0x37, 0x24, 0x93, 0x7d, 0x5f, 0x6b, 0x60,
-	0x14, 0x31, 0x95, 0xca, 0x2d, 0xe4, 0xfa, 0xdf, 0x2d, 0x7b, 0x5f, 0x6b, 0xb0, 0xf3, 0xe9, 0xcc,
-	0x1f, 0x17, 0x19, 0xab, 0xd8, 0xa3, 0xe5, 0x02, 0x33, 0x71, 0xac, 0x41, 0x38, 0xb9, 0x64, 0x52,
-	0xab, 0x18, 0xc6, 0xdb, 0xc4, 0x5f, 0x3a, 0xae, 0x58, 0xc5, 0xc4, 0x6f, 0x7e, 0x7e, 0x34, 0xb1,
-	0x19, 0x16, 0x1b, 0x71, 0x79, 0x62, 0x18, 0xef, 0xca, 0xac, 0xdf, 0x9b, 0xf8, 0xfa, 0x0a, 0x52,
-	0x24, 0x44, 0xbf, 0x00, 0x3d, 0xaf, 0xd8, 0x75, 0x94, 0x54, 0x7a, 0x09, 0xed, 0x63, 0x5e, 0x3f,
-	0xbf, 0xed, 0x25, 0xe8, 0x40, 0x8d, 0xf9, 0xfe, 0xb1, 0x23, 0x22, 0x53, 0x31, 0x25, 0xc4, 0xfd,
-	0x76, 0x65, 0xf9, 0x0e, 0x27, 0x08, 0x27, 0x44, 0xe0, 0xb7, 0xb4, 0x02, 0x1f, 0xc2, 0x86, 0x22,
-	0x77, 0xe9, 0xc4, 0xfd, 0x95, 0x06, 0x5b, 0x32, 0xc9, 0xce, 0xd0, 0x92, 0x48, 0xf7, 0x5d, 0x25,
-	0xbd, 0xd6, 0xb8, 0xf9, 0x82, 0x9c, 0xe4, 0xd7, 0xc0, 0x75, 0x46, 0x93, 0xb1, 0x4c, 0x5a, 0x09,
-	0xf1, 0x98, 0x09, 0x87, 0x9c, 0xf4, 0xe4, 0xeb, 0x1d, 0xc3, 0xbc, 0xe5, 0x11, 0x2d, 0xd6, 0x27,
-	0x49, 0x44, 0x15, 0x0c, 0x9d, 0xc1, 0x76, 0x46, 0x93, 0x6b, 0x09, 0xdc, 0x43, 0xd8, 0x36, 0xd9,
-	0x78, 0xc2, 0xfb, 0xc1, 0xe8, 0xc8, 0xc2, 0x87, 0xce, 0x1a, 0x0e, 0x7d, 0x16, 0x04, 0x52, 0x6c,
-	0x04, 0xd2, 0x07, 0xd0, 0xc9, 0xb2, 0x59, 0x3a, 0x18, 0xdf, 0x87, 0xad, 0xa7, 0xa3, 0x91, 0x3d,
-	0x71, 0xd8, 0x13, 0x36, 0xed, 0xa7, 0x34, 0x09, 0x5f, 0x79, 0xb1, 0x26, 0xfc, 0xbb, 0xa8, 0x75,
-	0xe2, 0x85, 0x2c, 0xf3, 0xfb, 0xa5, 0x55, 0xf8, 0x5e, 0x9c, 0x0e, 0xa7, 0xcc, 0x1a, 0x26, 0x2a,
-	0xe4, 0xd2, 0x41, 0x90, 0x45, 0x3a, 0xa0, 0xe0, 0xf4, 0xaf, 0x96, 0x16, 0xfc, 0x6b, 0x0d, 0xe0,
-	0x09, 0x76, 0xe5, 0x27, 0xce, 0xc8, 0x2d, 0x74, 0xbe, 0x01, 0xf5, 0x29, 0xda, 0x75, 0xd2, 0xc3,
-	0x5f, 0x56, 0xcd, 0x18, 0xe6, 0x95, 0xdd, 0xb2, 0x27, 0xf1, 0x83, 0x22, 0x00, 0xfe, 0x0b, 0x8f,
-	0x31, 0xff, 0xb9, 0x79, 0x2a, 0xaa, 0x5b, 0xc3, 0x8c, 0x61, 0x9e, 0x8e, 0x03, 0x7b, 0xc2, 0x9c,
-	0x10, 0xa9, 0xe2, 0x11, 0x51, 0x30, 0xb4, 0x0f, 0x20, 0x02, 0x39, 0x57, 0x1f, 0x02, 0x55, 0x1e,
-	0xfd, 0x28, 0x04, 0xfc, 0x9b, 0xeb, 0x11, 0x84, 0xd6, 0x38, 0xea, 0x01, 0x04, 0x80, 0xe5, 0x0a,
-	0xd3, 0x4d, 0xa6, 0xbd, 0x84, 0xe8, 0x29, 0xb4, 0x79, 0x4b, 0x24, 0x9c, 0x26, 0x62, 0x16, 0xb9,
-	0x46, 0x4b, 0xb2, 0xba, 0xa8, 0x4b, 0x8e, 0x64, 0x57, 0x12, 0xd9, 0xf4, 0x13, 0xc1, 0x4d, 0x78,
-	0x71, 0x2e, 0xb7, 0x7d, 0x58, 0x15, 0xd3, 0x8f, 0x78, 0x70, 0x9a, 0x47, 0x2d, 0x1e, 0xce, 0xc4,
-	0xf5, 0x66, 0x44, 0x8e, 0xf8, 0x09, 0x2f, 0x2c, 0xe2, 0x27, 0x2e, 0x71, 0x8a, 0x5f, 0xe2, 0x3a,
-	0x33, 0x22, 0xd3, 0x3f, 0x69, 0xb0, 0x2a, 0xd8, 0x04, 0xe4, 0x1e, 0xd4, 0x6c, 0xb4, 0x1a, 0x59,
-	0x35, 0x8f, 0xb6, 0x30, 0xa7, 0x32, 0xbe, 0x78, 0x5c, 0x32, 0xe5, 0x29, 0x7e, 0x5e, 0xa8, 0x85,
-	0x5e, 0x50, 0xce, 0xab, 0xd6, 0xf2, 0xf3, 0xe2, 0x14, 0x3f, 0x2f, 0xc4, 0xa2, 0x87, 0x94, 0xf3,
-	0xaa, 0x35, 0xfc, 0xbc, 0x38, 0xf5, 0xa0, 0x0e, 0x35, 0x91, 0x4b, 0xf4, 0x25, 0x6c, 0x20, 0xdf,
-	0xd4, 0x0d, 0xec, 0xa4, 0xd4, 0xad, 0xc7, 0x6a, 0x75, 0x52, 0x6a, 0xd5, 0x63, 0xf1, 0x9d, 0x94,
-	0xf8, 0x7a, 0x24, 0x86, 0xa7, 0x07, 0x0f, 0x5f, 0x94, 0x8d, 0x02, 0xa0, 0x0c, 0x88, 0x2a, 0x72,
-	0xe9, 0xb2, 0xf7, 0x1e, 0xac, 0x0a, 0xe5, 0x53, 0x5d, 0x9c, 0x74, 0xb5, 0x19, 0xd1, 0xe8, 0x1f,
-	0xca, 0x49, 0xad, 0x1f, 0x9c, 0xb3, 0xa9, 0x35, 0xbf, 0xd6, 0x23, 0x39, 0x19, 0xd2, 0x72, 0x9d,
-	0xee, 0xdc, 0x21, 0x2d, 0xd5, 0x7e, 0x55, 0xe7, 0xb5, 0x5f, 0x2b, 0x4a, 0xfb, 0x85, 0x97, 0x03,
-	0xe5, 0xc9, 0x76, 0x4d, 0x42, 0xfc, 0xf4, 0xc8, 0x9e, 0x05, 0xe7, 0xd8, 0xac, 0xd5, 0x4d, 0x01,
-	0x70, 0x6d, 0x78, 0xef, 0xab, 0xd7, 0x11, 0x89, 0xdf, 0xfc, 0x2a, 0x8f, 0x7c, 0x77, 0x2a, 0x9e,
-	0x0d, 0xbd, 0x21, 0x86, 0xe9, 0x04, 0x13, 0xd1, 0x9f, 0x59, 0xfe, 0x98, 0x85, 0x3a, 0x24, 0x74,
-	0x81, 0x51, 0x5f, 0x1e, 0xe9, 0x97, 0x6b, 0x79, 0x79, 0x0e, 0x60, 0xeb, 0x11, 0x0b, 0xcf, 0x66,
-	0x7d, 0xfe, 0x76, 0x1f, 0x8f, 0xc6, 0x0b, 0x1e, 0x1e, 0xfa, 0x1c, 0xb6, 0x33, 0x67, 0x97, 0x56,
-	0x91, 0x40, 0x75, 0x30, 0x1a, 0x47, 0x01, 0xc3, 0x6f, 0xda, 0x83, 0xf5, 0x47, 0x2c, 0x54, 0x64,
-	0xdf, 0x51, 0x9e, 0x1a, 0xd9, 0x57, 0x1e, 0x8f, 0xc6, 0xcf, 0x5e, 0x79, 0x6c, 0xc1, 0xbb, 0x73,
-	0x0a, 0xad, 0x88, 0xcb, 0xd2, 0x5a, 0xb5, 0xa1, 0x32, 0x18, 0xc5, 0x1d, 0xe9, 0x60, 0x34, 0xa6,
-	0xdb, 0xb0, 0xf9, 0x88, 0xc9, 0x7b, 0x9d, 0x68, 0x46, 0xf7, 0xd1, 0x5b, 0x0a, 0x5a, 0x8a, 0x92,
-	0x0c, 0xb4, 0x84, 0xc1, 0xef, 0x34, 0x20, 0x8f, 0x2d, 0x67, 0x68, 0xb3, 0x87, 0xbe, 0xef, 0xfa,
-	0x73, 0xdb, 0x70, 0xa4, 0x7e, 0xa7, 0x24, 0xdf, 0x85, 0x46, 0x7f, 0xe2, 0xd8, 0xee, 0xf8, 0x53,
-	0x37, 0x88, 0x5a, 0xb2, 0x18, 0x81, 0x29, 0xfa, 0xd2, 0x8e, 0x87, 0x3b, 0xfe, 0x4d, 0x03, 0xd8,
-	0x4c, 0xa9, 0x74, 0x2d, 0x09, 0xf6, 0x08, 0xb6, 0x9f, 0xf9, 0x96, 0x13, 0x8c, 0x98, 0x9f, 0x6e,
-	0xee, 0x92, 0xf7, 0x48, 0x53, 0xdf, 0x23, 0xa5, 0x6c, 0x09, 0xc9, 0x12, 0xe2, 0xcd, 0x4d, 0x96,
-	0xd1, 0xd2, 0x0f, 0xfc, 0x30, 0x5e, 0xde, 0xa4, 0xe6, 0x85, 0xdb, 0x4a, 0x54, 0xd6, 0x95, 0x31,
-	0xe6, 0xc5, 0x51, 0xd4, 0x68, 0x4a, 0x4d, 0xcb, 0x73, 0x34, 0x15, 0xa1, 0x89, 0x34, 0x0d, 0xe3,
-	0x12, 0x77, 0x9d, 0xcd, 0xff, 0x9f, 0x35, 0xe8, 0xe0, 0x3e, 0xee, 0x85, 0x65, 0x4f, 0x86, 0xb8,
-	0x2a, 0x4c, 0x2e, 0x14, 0x4c, 0xdd, 0x21, 0xfb, 0xfc, 0xd2, 0xb2, 0x67, 0xd2, 0xdd, 0x8f, 0x4b,
-	0x66, 0x83, 0xe3, 0x5e, 0x70, 0x14, 0x39, 0x80, 0x36, 0x76, 0xf3, 0x9f, 0xf3, 0xa1, 0x47, 0x1e,
-	0x43, 0x75, 0x1e, 0x6b, 0x66, 0x2b, 0xee, 0xf3, 0xc5, 0xd9, 0x85, 0x65, 0x97, 0xe7, 0xac, 0xd2,
-	0x5a, 0xc7, 0xf0, 0x83, 0x9a, 0x58, 0x4b, 0x3c, 0x68, 0x2a, 0x83, 0x04, 0xbd, 0x82, 0x9d, 0x9c,
-	0xc6, 0xd7, 0xe2, 0xab, 0x27, 0xb0, 0x7d, 0x16, 0xba, 0x5e, 0xde, 0x53, 0x0b, 0x27, 0xc7, 0xd8,
-	0xb8, 0x72, 0xda, 0x38, 0x7a, 0xc9, 0x3d, 0x9f, 0x66, 0x77, 0x1d, 0x66, 0x1c, 0xfc, 0x00, 0x6e,
-	0x64, 0xf6, 0x12, 0x64, 0x03, 0xd6, 0x4f, 0x9c, 0x4b, 0xae, 0x88, 0x40, 0xb4, 0x4b, 0x64, 0x0d,
-	0xea, 0x67, 0x17, 0x13, 0x8f, 0xc3, 0x6d, 0x8d, 0x43, 0x0f, 0xbf, 0x60, 0x03, 0x84, 0xca, 0x07,
-	0x7d, 0xa8, 0x47, 0x33, 0x15, 0xd9, 0x84, 0x1b, 0xf2, 0xa7, 0x11, 0xaa, 0x5d, 0x22, 0x37, 0xa0,
-	0x89, 0x21, 0x12, 0xa8, 0xb6, 0x46, 0xda, 0xb0, 0x26, 0x56, 0x85, 0x12, 0x53, 0x26, 0x2d, 0x00,
-	0x6e, 0xbd, 0x84, 0x2b, 0x08, 0x9f, 0xbb, 0x57, 0x12, 0xae, 0x1e, 0xfc, 0x10, 0xea, 0x51, 0xa3,
-	0xae, 0xc8, 0x88, 0x50, 0xed, 0x12, 0xd7, 0xf9, 0xe1, 0xe5, 0x64, 0x10, 0xc6, 0x28, 0x8d, 0xec,
-	0xc0, 0xe6, 0xb1, 0xe5, 0x0c, 0x98, 0x9d, 0x26, 0x94, 0x0f, 0x1c, 0x58, 0x95, 0x6f, 0x01, 0x57,
-	0x4d, 0xf2, 0xe2, 0xa0, 0x30, 0x94, 0xbf, 0x4c, 0x08, 0x69, 0x5c, 0x0d, 0x51, 0xa8, 0x11, 0x46,
-	0x35, 0x85, 0x1f, 0x11, 0x16, 0x6a, 0xa2, 0x8a, 0x08, 0x57, 0xc9, 0x16, 0xb4, 0xf1, 0xd7, 0x6c,
-	0xea, 0xd9, 0x56, 0x28, 0xb0, 0x2b, 0x07, 0x3d, 0x68, 0xc4, 0xc5, 0x80, 0x1f, 0x91, 0x12, 0x63,
-	0x5c, 0xbb, 0xc4, 0x3d, 0x82, 0x2e, 0x42, 0xdc, 0x8b, 0xa3, 0xb6, 0x26, 0x9c, 0xe6, 0x7a, 0x11,
-	0xa2, 0x7c, 0xf4, 0xaf, 0x0d, 0xa8, 0x09, 0x65, 0xc8, 0x67, 0xd0, 0x88, 0xb7, 0xe6, 0x04, 0x3b,
-	0xc2, 0xec, 0x16, 0xdf, 0xd8, 0xce, 0x60, 0x45, 0xd8, 0xe9, 0x9d, 0x5f, 0xfe, 0xf5, 0x9f, 0x5f,
-	0x95, 0x6f, 0xd2, 0xad, 0x43, 0xcb, 0x9b, 0x04, 0x87, 0x97, 0xf7, 0x2d, 0xdb, 0x3b, 0xb7, 0xee,
-	0x1f, 0xf2, 0x34, 0x0c, 0x3e, 0xd0, 0x0e, 0xc8, 0x08, 0x9a, 0xca, 0x6a, 0x9a, 0x74, 0x38, 0x9b,
-	0xfc, 0x32, 0xdc, 0xd8, 0xc9, 0xe1, 0xa5, 0x80, 0xf7, 0x51, 0xc0, 0x9e, 0x71, 0xab, 0x48, 0xc0,
-	0xe1, 0x6b, 0xfe, 0xcc, 0x7e, 0xc9, 0xe5, 0x7c, 0x08, 0x90, 0x6c, 0x8b, 0x09, 0x6a, 0x9b, 0xdb,
-	0x40, 0x1b, 0x9d, 0x2c, 0x5a, 0x0a, 0x29, 0x11, 0x1b, 0x9a, 0xca, 0xda, 0x94, 0x18, 0x99, 0x3d,
-	0xaa, 0xb2, 0xe7, 0x35, 0x6e, 0x15, 0xd2, 0x24, 0xa7, 0x77, 0x51, 0xdd, 0x2e, 0xd9, 0xcd, 0xa8,
-	0x1b, 0xe0, 0x51, 0xa9, 0x2f, 0x39, 0x86, 0x35, 0x75, 0x3b, 0x49, 0xd0, 0xfa, 0x82, 0xb5, 0xac,
-	0xa1, 0xe7, 0x09, 0xb1, 0xca, 0x1f, 0xc3, 0x7a, 0xea, 0xa2, 0x11, 0x3d, 0xb7, 0x13, 0x8c, 0xd8,
-	0xdc, 0x2c, 0xa0, 0xc4, 0x7c, 0x3e, 0x83, 0x4e, 0x7e, 0x9b, 0x86, 0x5e, 0xbc, 0xad, 0x04, 0x25,
-	0xbf, 0xd1, 0x32, 0xba, 0xf3, 0xc8, 0x31, 0xeb, 0xa7, 0xd0, 0xce, 0x6e, 0x9d, 0x08, 0xba, 0x6f,
-	0xce, 0x92, 0xcc, 0xd8, 0x2d, 0x26, 0xc6, 0x0c, 0x3f, 0x80, 0x46, 0xbc, 0xd4, 0x11, 0x89, 0x9a,
-	0xdd, 0x2d, 0x89, 0x44, 0xcd, 0x6d, 0x7e, 0x68, 0x89, 0x8c, 0x61, 0x3d, 0xb5, 0x46, 0x11, 0xfe,
-	0x2a, 0xda, 0xf1, 0x08, 0x7f, 0x15, 0xee, 0x5c, 0xe8, 0x5d, 0x0c, 0xf0, 0x2d, 0xa3, 0x93, 0x0d,
-	0xb0, 0x28, 0x7f, 0x3c, 0x15, 0x4f, 0xa0, 0x95, 0xde, 0x78, 0x90, 0x9b, 0xe2, 0xfd, 0x2e, 0x58,
-	0xa6, 0x18, 0x46, 0x11, 0x29, 0xd6, 0xd9, 0x87, 0xf5, 0xd4, 0xe2, 0x42, 0xea, 0x5c, 0xb0, 0x0b,
-	0x91, 0x3a, 0x17, 0x6d, 0x39, 0xe8, 0xff, 0xa1, 0xce, 0xef, 0x1f, 0xbc, 0x9b, 0xd1, 0x59, 0xce,
-	0x3f, 0x87, 0xaf, 0x79, 0x03, 0xfb, 0x65, 0x94, 0x9c, 0x17, 0xb1, 0x9f, 0x44, 0x89, 0x4b, 0xf9,
-	0x29, 0xb5, 0xfc, 0x48, 0xf9, 0x29, 0xbd, 0xe0, 0xa0, 0xef, 0xa1, 0xcc, 0x3b, 0x86, 0x91, 0x91,
-	0x29, 0xe6, 0xc3, 0xc3, 0xd7, 0xae, 0x87, 0xd7, 0xf6, 0xa7, 0x00, 0xc9, 0x84, 0x27, 0xae, 0x6d,
-	0x6e, 0xc8, 0x14, 0xd7, 0x36, 0x3f, 0x08, 0xd2, 0x2e, 0xca, 0xd0, 0x49, 0xa7, 0xd8, 0x2e, 0x32,
-	0x4a, 0x22, 0x2e, 0x26, 0xa7, 0x54, 0xc4, 0xd5, 0x49, 0x2f, 0x1d, 0xf1, 0xd4, 0xac, 0x43, 0xf7,
-	0x50, 0x8a, 0x61, 0x6c, 0x67, 0x23, 0x8e, 0xc7, 0xb8, 0x11, 0x36, 0x0e, 0x0b, 0xc9, 0x0c, 0x22,
-	0xe4, 0x14, 0x8d, 0x30, 0x42, 0x4e, 0xe1, 0xc0, 0x12, 0x55, 0x3a, 0xd2, 0xcd, 0xca, 0x99, 0xf5,
-	0xd5, 0x62, 0x47, 0x9e, 0x41, 0x4d, 0x0c, 0x15, 0x64, 0x43, 0x32, 0x53, 0xf8, 0x13, 0x15, 0x25,
-	0x19, 0xbf, 0x83, 0x8c, 0x6f, 0x93, 0x45, 0x25, 0x94, 0xfc, 0x1c, 0x9a, 0x4a, 0x1f, 0x2e, 0xea,
-	0x74, 0x7e, 0x56, 0x10, 0x75, 0xba, 0xa0, 0x61, 0x9f, 0xeb, 0x25, 0xc6, 0x4f, 0xe1, 0xb5, 0x38,
-	0x86, 0x35, 0x75, 0x4e, 0x11, 0x45, 0xaf, 0x60, 0xa0, 0x31, 0xf4, 0x3c, 0x21, 0xbe, 0x10, 0x27,
-	0xd0, 0x4a, 0x37, 0xdc, 0xe2, 0x6e, 0x15, 0x76, 0xf3, 0xe2, 0x6e, 0x15, 0xf7, 0xe7, 0xb4, 0xc4,
-	0xf5, 0x51, 0x3b, 0x62, 0xa2, 0x3e, 0x41, 0xa9, 0xa2, 0xa4, 0xe7, 0x09, 0x31, 0x93, 0x53, 0xb8,
-	0x91, 0xe9, 0x16, 0xc5, 0xdb, 0x51, 0xdc, 0xf4, 0x8a, 0xb7, 0x63, 0x4e, 0x7b, 0x29, 0xac, 0x4b,
-	0xf7, 0x6c, 0xc2, 0xba, 0xc2, 0xb6, 0xd0, 0x30, 0x8a, 0x48, 0x31, 0xab, 0x9f, 0xe0, 0xb0, 0x98,
-	0x90, 0xe4, 0xc3, 0xd6, 0x95, 0xbe, 0xcd, 0x12, 0x22, 0xa6, 0x77, 0xe6, 0xd2, 0x63, 0xce, 0xcf,
-	0x81, 0xa4, 0x0e, 0x88, 0x84, 0xb9, 0x9d, 0xfb, 0x61, 0x2a, 0x6f, 0xba, 0xf3, 0xc8, 0x31, 0x5b,
-	0x2b, 0x7e, 0x86, 0xb2, 0xac, 0xef, 0x2a, 0xfe, 0x9f, 0xc3, 0x9e, 0x2e, 0x3a, 0x12, 0x89, 0x78,
-	0xa0, 0xff, 0xe5, 0x4d, 0x57, 0xfb, 0xe6, 0x4d, 0x57, 0xfb, 0xc7, 0x9b, 0xae, 0xf6, 0xdb, 0xb7,
-	0xdd, 0xd2, 0x37, 0x6f, 0xbb, 0xa5, 0xbf, 0xbd, 0xed, 0x96, 0xfa, 0x35, 0xfc, 0xfb, 0xc2, 0xff,
-	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf5, 0x22, 0xfb, 0x02, 0x21, 0x00, 0x00,
+	// 2465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x1a, 0x4d, 0x6f, 0x1b, 0xd7,
+	0xd1, 0x4b, 0x7d, 0x51, 0x23, 0x4b, 0x96, 0x9e, 0x24, 0x8a, 0x5e, 0xcb, 0xb2, 0xbc, 0x49, 0x0c,
+	0x43, 0x28, 0x2c, 0x58, 0xed, 0x29, 0x40, 0x8a, 0x46, 0x92, 0x13, 0x1b, 0x95, 0xe3, 0x94, 0x92,
+	0x9c, 0x04, 0x3d, 0xa4, 0x2b, 0xea, 0x91, 0x22, 0x44, 0xee, 0xd2, 0xbb, 0x4b, 0x29, 0x82, 0x91,
+	0x1e, 0x7a, 0xea, 0xa5, 0x68, 0x8b, 0x14, 0x2d, 0x7a, 0xea, 0xa1, 0x7f, 0xa0, 0xa7, 0xfe, 0x86,
+	0x1e, 0x7a, 0x08, 0xd0, 0x4b, 0x2f, 0x05, 0x8a, 0xb4, 0xf7, 0xfe, 0x85, 0xce, 0xcc, 0x7b, 0xbb,
+	0xfb, 0xf6, 0x83, 0x4c, 0x18, 0xa0, 0x42, 0x0f, 0x02, 0xde, 0xcc, 0xbc, 0x9d, 0xef, 0x37, 0x6f,
+	0xe6, 0x51, 0xb0, 0x70, 0xda, 0xeb, 0xb9, 0x61, 0x24, 0x83, 0x47, 0xfd, 0xc0, 0x8f, 0x7c, 0x51,
+	0xe9, 0x9f, 0xd8, 0x88, 0xbb, 0xf4, 0x83, 0xf3, 0x18, 0x67, 0xaf, 0xb7, 0x7d, 0xbf, 0xdd, 0x95,
+	0xdb, 0x6e, 0xbf, 0xb3, 0xed, 0x7a, 0x9e, 0x1f, 0xb9, 0x51, 0xc7, 0xf7, 0x42, 0x45, 0x75, 0x7e,
+	0x0a, 0x8b, 0x87, 0x91, 0x1b, 0x44, 0x47, 0x6e, 0x78, 0xde, 0x90, 0xaf, 0x06, 0x32, 0x8c, 0x84,
+	0x80, 0xc9, 0x08, 0xc1, 0xba, 0xb5, 0x69, 0x3d, 0x9c, 0x6d, 0xf0, 0x5a, 0xd4, 0x61, 0x26, 0xf4,
+	0x07, 0x41, 0x53, 0x86, 0xf5, 0xca, 0xe6, 0x04, 0xa2, 0x63, 0x50, 0x6c, 0x00, 0x04, 0xb2, 0xe7,
+	0x5f, 0xc8, 0xe7, 0x32, 0x72, 0xeb, 0x13, 0xf8, 0x4d, 0xb5, 0x61, 0x60, 0xc4, 0x3a, 0xcc, 0x86,
+	0x2c, 0xa1, 0xd3, 0x93, 0xf5, 0x49, 0x66, 0x99, 0x22, 0x9c, 0x2f, 0x2c, 0x58, 0x32, 0x14, 0x08,
+	0xfb, 0xa8, 0x9a, 0x14, 0x35, 0x98, 0x0e, 0x64, 0x38, 0xe8, 0x46, 0xac, 0x43, 0xb5, 0xa1, 0x21,
+	0xb1, 0x08, 0x13, 0xbd, 0xb0, 0x8d, 0x1a, 0x10, 0x17, 0x5a, 0x8a, 0x9d, 0x54, 0xaf, 0x09, 0xd4,
+	0x6b, 0x6e, 0xa7, 0xfe, 0xa8, 0x7f, 0xf2, 0x68, 0xcf, 0xef, 0xf5, 0x7c, 0xef, 0x23, 0x76, 0x43,
+	0xcc, 0x34, 0xd5, 0x78, 0x13, 0xe6, 0x9a, 0x67, 0xb2, 0x49, 0xe2, 0x48, 0x84, 0xd2, 0xc9, 0x44,
+	0x39, 0x7f, 0xb5, 0x40, 0xbc, 0xe8, 0xcb, 0xc0, 0x8d, 0xa4, 0xe9, 0x18, 0x1b, 0x2a, 0x7e, 0x9f,
+	0x55, 0x5a, 0xd8, 0x01, 0x92, 0x43, 0xc4, 0x17, 0xfd, 0x06, 0x62, 0xc9, 0x69, 0x9e, 0x8b, 0x16,
+	0x2a, 0xdd, 0x78, 0x6d, 0x3a, 0x6d, 0x22, 0xeb, 0x34, 0x07, 0x6e, 0x9e, 0x4b, 0xd9, 0x27, 0x07,
+	0xed, 0xbb, 0x57, 0x21, 0xeb, 0x30, 0xd5, 0xc8, 0xe0, 0x48, 0xcd, 0xbe, 0x3b, 0x08, 0xe5, 0xbb,
+	0xca, 0x75, 0x53, 0x4a, 0x4d, 0x03, 0x25, 0x1e, 0xc0, 0x82, 0x01, 0xfa, 0x83, 0xa8, 0x3e, 0xcd,
+	0x9b, 0x72, 0x58, 0xe7, 0x97, 0x16, 0x2c, 0x67, 0xcc, 0xd1, 0x6e, 0x1e, 0x65, 0x4f, 0x1a, 0x82,
+	0x4a, 0x59, 0x08, 0x26, 0x4a, 0x43, 0x30, 0xf9, 0x0d, 0x43, 0xe0, 0xbc, 0x0b, 0x4b, 0xc7, 0xfd,
+	0xd3, 0x9c, 0x7b, 0xc7, 0xca, 0x3b, 0xe7, 0x37, 0x18, 0x23, 0x93, 0xc7, 0xff, 0x49, 0xea, 0xbc,
+	0x07, 0xb5, 0x1f, 0x0d, 0x64, 0x70, 0x85, 0x49, 0x1d, 0x0d, 0xc2, 0x83, 0x4e, 0x18, 0x19, 0xe6,
+	0x71, 0x86, 0x58, 0xe5, 0x19, 0x92, 0x33, 0xef, 0x02, 0xd6, 0x0a, 0x7c, 0xc6, 0x36, 0xf1, 0x71,
+	0xde, 0xc4, 0x35, 0x32, 0xd1, 0xe0, 0x5b, 0x8c, 0xcc, 0x1e, 0x2c, 0x1f, 0x9e, 0xf9, 0x97, 0xfb,
+	0xfb, 0x07, 0x07, 0x7e, 0xf3, 0x3c, 0xfc, 0x76, 0xb1, 0xf9, 0x83, 0x05, 0x33, 0x9a, 0x83, 0x58,
+	0x80, 0xca, 0xb3, 0x7d, 0xfd, 0x1d, 0xae, 0x12, 0x4e, 0x15, 0x83, 0x13, 0xe2, 0x7a, 0xfe, 0xa9,
+	0xd4, 0x59, 0xc5, 0x6b, 0xb1, 0x02, 0x53, 0xfe, 0xa5, 0x27, 0x03, 0xed, 0x64, 0x05, 0xd0, 0x4e,
+	0x64, 0x1c, 0xe2, 0x69, 0x20, 0x81, 0xbc, 0x26, 0x7f, 0x84, 0x57, 0x5e, 0x53, 0x9e, 0x62, 0xfa,
+	0x13, 0x56, 0x43, 0x98, 0xde, 0xd5, 0x81, 0xa7, 0x29, 0x33, 0x4c, 0x49, 0x60, 0xa7, 0x09, 0x2b,
+	0x59, 0x33, 0xc7, 0xf6, 0xed, 0x7d, 0x98, 0xea, 0xd2, 0xa7, 0xda, 0xb3, 0x73, 0xe4, 0x59, 0xcd,
+	0xae, 0xa1, 0x28, 0xce, 0x3f, 0x2c, 0x58, 0x39, 0xf6, 0x68, 0x1d, 0x13, 0xb4, 0x37, 0xf3, 0x3e,
+	0xc1, 0x72, 0x10, 0xc8, 0x7e, 0xd7, 0x6d, 0xca, 0x17, 0x6c, 0xb2, 0x12, 0x93, 0xc1, 0x51, 0xea,
+	0xb5, 0x7c, 0xf4, 0x6e, 0x83, 0x4b, 0xab, 0x2e, 0xb4, 0x26, 0x4a, 0xbc, 0xc1, 0xc7, 0x79, 0x92,
+	0x8f, 0xf3, 0x32, 0xa9, 0x93, 0x91, 0xad, 0xcf, 0xb5, 0x11, 0xb4, 0xa9, 0x6c, 0x4d, 0x42, 0x77,
+	0xe1, 0x69, 0x72, 0x4f, 0xdc, 0x50, 0xea, 0x3a, 0x92, 0xc0, 0x14, 0x0c, 0x5c, 0x75, 0x25, 0xfa,
+	0x91, 0x83, 0xc1, 0x00, 0x9e, 0xe2, 0xd5, 0x9c, 0x79, 0xe3, 0x7a, 0xd1, 0x69, 0xc0, 0x6d, 0x5d,
+	0x99, 0xe2, 0x23, 0xd7, 0x75, 0xaf, 0x62, 0x37, 0xdd, 0x31, 0xea, 0x13, 0xfb, 0x97, 0xa9, 0x45,
+	0x43, 0x72, 0xd9, 0xf7, 0x3b, 0x0b, 0xec, 0x32, 0xa6, 0x5a, 0xb9, 0x91, 0x5c, 0xff, 0xb7, 0x65,
+	0x0f, 0x35, 0x5b, 0xfb, 0x70, 0x10, 0xb4, 0xcb, 0x8c, 0x35, 0xec, 0xb1, 0x0a, 0x81, 0xe9, 0x78,
+	0x6e, 0x33, 0xea, 0x5c, 0x48, 0xad, 0x55, 0x02, 0xf3, 0x69, 0xa2, 0xdb, 0x81, 0x14, 0x9b, 0x68,
+	0xf0, 0x9a, 0xf6, 0xb7, 0x3a, 0x5d, 0xc9, 0xc5, 0x46, 0x1d, 0x9e, 0x04, 0xe6, 0xb3, 0x32, 0x38,
+	0xd9, 0xef, 0x04, 0xfa, 0x3e, 0xd1, 0x90, 0xf3, 0x19, 0xd4, 0x8b, 0x8a, 0x5d, 0x47, 0x49, 0xc5,
+	0x42, 0xb7, 0xb8, 0x47, 0xf5, 0xf3, 0xeb, 0x6e, 0x02, 0xd4, 0x42, 0x06, 0xc1, 0x9e, 0xa7, 0x22,
+	0x33, 0xd1, 0xd0, 0x10, 0xf9, 0xed, 0xd2, 0x0d, 0x3c, 0x22, 0x28, 0x27, 0xc4, 0xe0, 0xd7, 0x74,
+	0x1e, 0xef, 0xc0, 0x92, 0x21, 0x77, 0xec, 0xc4, 0xfd, 0x39, 0x9e, 0x6d, 0x9d, 0x64, 0x87, 0x6c,
+	0x49, 0xac, 0xfb, 0xba, 0x91, 0x5e, 0x37, 0xc9, 0x7c, 0x45, 0x4e, 0xf3, 0xab, 0xe9, 0x7b, 0xad,
+	0x4e, 0x5b, 0x27, 0xad, 0x86, 0x28, 0x66, 0xca, 0x21, 0x58, 0x17, 0x54, 0xaf, 0x90, 0xc0, 0xd4,
+	0x61, 0xa9, 0x8e, 0xee, 0x83, 0x34, 0xa2, 0x06, 0xc6, 0x19, 0xc0, 0x6a, 0x4e, 0x93, 0x6b, 0x09,
+	0xdc, 0xef, 0x2d, 0x58, 0x6d, 0xc8, 0x76, 0x87, 0xfa, 0xcf, 0x78, 0xcf, 0xc8, 0x9b, 0xce, 0x3d,
+	0x3d, 0x45, 0x05, 0x42, 0x2d, 0x37, 0x06, 0x89, 0x72, 0x21, 0x83, 0x10, 0x9b, 0x52, 0x7d, 0xbc,
+	0x62, 0x90, 0x28, 0xed, 0x4e, 0xf4, 0xd4, 0x0d, 0xcf, 0xb4, 0xd5, 0x31, 0x48, 0x2e, 0x51, 0x8e,
+	0x63, 0xa2, 0x4a, 0x65, 0x03, 0xe3, 0xec, 0x42, 0x2d, 0xaf, 0xda, 0xd8, 0x11, 0xfe, 0x3e, 0x06,
+	0xb8, 0xd5, 0xea, 0x76, 0x3c, 0xec, 0x63, 0x7b, 0x27, 0x19, 0xeb, 0xa2, 0xab, 0x7e, 0x62, 0x1d,
+	0xad, 0xcb, 0xba, 0x3f, 0xaa, 0x8e, 0xb9, 0xef, 0xc7, 0x56, 0xe1, 0x7b, 0x49, 0x8e, 0x1d, 0x48,
+	0xf7, 0x34, 0x55, 0xa1, 0x90, 0x63, 0x8a, 0xac, 0x72, 0x8c, 0x05, 0x67, 0xbf, 0x1a, 0x5b, 0xf0,
+	0x57, 0x16, 0xc0, 0x73, 0x9e, 0x2c, 0x9e, 0x79, 0x2d, 0xbf, 0x34, 0xa0, 0x98, 0xb1, 0x3d, 0xb6,
+	0x0b, 0x33, 0x96, 0xbe, 0x9c, 0x6c, 0x24, 0x30, 0x5d, 0x17, 0x6e, 0xb7, 0x93, 0xdc, 0x52, 0x0a,
+	0xa0, 0x2f, 0xfa, 0x52, 0x06, 0xc7, 0x8d, 0x03, 0x55, 0x32, 0x31, 0xc7, 0x63, 0x98, 0x03, 0xda,
+	0xed, 0x48, 0x2f, 0x62, 0xaa, 0xba, 0x99, 0x0c, 0x8c, 0x99, 0x24, 0xd3, 0x43, 0x93, 0x64, 0x66,
+	0x54, 0x92, 0x54, 0x0b, 0x49, 0xf2, 0x67, 0x34, 0x52, 0x65, 0xc7, 0x50, 0x23, 0x11, 0x47, 0x69,
+	0x1a, 0xc7, 0x95, 0xd6, 0x64, 0x1c, 0x56, 0x91, 0x76, 0xdc, 0xad, 0x28, 0x80, 0x0b, 0x2b, 0x1f,
+	0x0c, 0x9d, 0xaa, 0x1a, 0x32, 0x15, 0x9f, 0x1a, 0xaa, 0xf8, 0xf4, 0x28, 0xc5, 0x67, 0x0a, 0x8a,
+	0x1f, 0xc0, 0x22, 0x35, 0x84, 0x2a, 0xba, 0x2a, 0xb9, 0xe2, 0x18, 0x5a, 0xe9, 0x99, 0x2e, 0x9b,
+	0x48, 0x62, 0x7b, 0x26, 0x52, 0x7b, 0x9c, 0x0f, 0x14, 0x37, 0x15, 0xee, 0xa1, 0xdc, 0x1e, 0xc2,
+	0x8c, 0x1a, 0x35, 0xd5, 0x75, 0x3b, 0xb7, 0xb3, 0x40, 0x79, 0x97, 0xe6, 0x48, 0x23, 0x26, 0xc7,
+	0xfc, 0x94, 0x67, 0x47, 0xf1, 0x53, 0x25, 0x2c, 0xc3, 0x2f, 0x0d, 0x47, 0x23, 0x26, 0x3b, 0x7f,
+	0xc4, 0x66, 0x52, 0xb1, 0x09, 0xc5, 0x23, 0x98, 0xee, 0xb2, 0xd5, 0xcc, 0x6a, 0x6e, 0x67, 0x85,
+	0x93, 0x3f, 0xe7, 0x8b, 0xa7, 0x37, 0x1a, 0x7a, 0x17, 0xed, 0x57, 0x6a, 0xb1, 0x17, 0x8c, 0xfd,
+	0xa6, 0xb5, 0xb4, 0x5f, 0xed, 0xa2, 0xfd, 0x4a, 0x2c, 0x7b, 0xc8, 0xd8, 0x6f, 0x5a, 0x43, 0xfb,
+	0xd5, 0xae, 0xdd, 0x2a, 0xf2, 0x67, 0x9c, 0xf3, 0x0a, 0x96, 0x98, 0x6f, 0xa6, 0x54, 0xd4, 0x32,
+	0xea, 0x56, 0x13, 0xb5, 0x6a, 0x19, 0xb5, 0xaa, 0x89, 0xf8, 0x5a, 0x46, 0x7c, 0x35, 0x16, 0x43,
+	0x29, 0x47, 0xe1, 0x8b, 0x8f, 0x8d, 0x02, 0x9c, 0x5f, 0xe0, 0x04, 0x64, 0xca, 0x1c, 0xbb, 0xea,
+	0xbf, 0x85, 0x31, 0x55, 0x8e, 0x35, 0x9b, 0x58, 0xed, 0xeb, 0x46, 0x4c, 0xa3, 0x31, 0x53, 0xe7,
+	0xec, 0x47, 0x78, 0xb3, 0x76, 0xbc, 0xb6, 0x4e, 0xf1, 0x1c, 0xd6, 0xf9, 0x6d, 0x25, 0xbd, 0x12,
+	0x71, 0x24, 0xea, 0xb9, 0xc3, 0xaf, 0x44, 0x26, 0xa7, 0x93, 0x73, 0x61, 0x20, 0x18, 0x3e, 0x39,
+	0x9b, 0x5d, 0xea, 0xe4, 0xb0, 0x2e, 0x75, 0xca, 0xe8, 0x52, 0xf9, 0x64, 0xb2, 0x3c, 0x7d, 0xcc,
+	0x34, 0x44, 0xbb, 0x5b, 0xdd, 0x81, 0x3e, 0x60, 0x58, 0xa4, 0x18, 0x20, 0x6d, 0x68, 0x44, 0xe0,
+	0x72, 0x51, 0x6d, 0xf0, 0x9a, 0xce, 0x63, 0x2b, 0xf0, 0x7b, 0xea, 0x76, 0xad, 0xcf, 0xaa, 0x27,
+	0x8e, 0x14, 0x13, 0xd3, 0x8f, 0x5c, 0x6c, 0xa0, 0xa2, 0x3a, 0xa4, 0x74, 0x85, 0x31, 0x2f, 0x68,
+	0xed, 0x97, 0x6b, 0xb9, 0xa0, 0xb7, 0x60, 0xe5, 0x7d, 0x19, 0x1d, 0x0e, 0x4e, 0xa8, 0xc5, 0xd9,
+	0x6b, 0xb5, 0x47, 0x5c, 0xcf, 0xce, 0x31, 0xac, 0xe6, 0xf6, 0x8e, 0xad, 0x22, 0xb2, 0x6d, 0xb6,
+	0xda, 0x71, 0xc0, 0x78, 0xed, 0xec, 0xc3, 0x3c, 0xb2, 0x35, 0x64, 0xdf, 0x33, 0x2e, 0x4f, 0xdd,
+	0x7e, 0x23, 0xf5, 0x08, 0x51, 0x23, 0x6e, 0xd2, 0x03, 0x58, 0x88, 0xb9, 0x8c, 0xad, 0x15, 0x62,
+	0x50, 0x93, 0xb8, 0x71, 0xc7, 0xa5, 0xb3, 0x0a, 0xcb, 0xc8, 0x4d, 0x15, 0x80, 0x54, 0x33, 0xe7,
+	0x21, 0x7b, 0xcb, 0x40, 0x6b, 0x51, 0x9a, 0x81, 0x95, 0x32, 0xf8, 0x35, 0x9e, 0xbb, 0xa7, 0xae,
+	0x77, 0xda, 0x95, 0x4f, 0x82, 0xc0, 0x0f, 0x86, 0x4e, 0x2b, 0x4c, 0xfd, 0x56, 0x49, 0x8e, 0x9d,
+	0xeb, 0x49, 0x07, 0x27, 0xab, 0xf6, 0x87, 0x7e, 0x18, 0x77, 0xae, 0x09, 0x82, 0x53, 0xf4, 0x55,
+	0x37, 0x99, 0x81, 0x69, 0xed, 0x84, 0xb0, 0x9c, 0x51, 0xe9, 0x5a, 0x12, 0xec, 0x7d, 0x58, 0x3d,
+	0x0a, 0x5c, 0x2f, 0x6c, 0xc9, 0x20, 0xdb, 0x03, 0xa7, 0x97, 0xa1, 0x95, 0xb9, 0x0c, 0xd3, 0xfa,
+	0xa6, 0x24, 0x6b, 0x88, 0xda, 0xb5, 0x3c, 0xa3, 0xb1, 0x5b, 0x96, 0xd3, 0xe4, 0x8d, 0x2b, 0x33,
+	0x56, 0xdd, 0x35, 0xa2, 0x32, 0x6f, 0x4c, 0x7b, 0x2f, 0x77, 0xe2, 0x7e, 0x5c, 0x6b, 0x5a, 0x19,
+	0xa2, 0xa9, 0x0a, 0x4d, 0xac, 0x69, 0x94, 0x94, 0xb8, 0xeb, 0x9c, 0x91, 0xfe, 0x64, 0x41, 0x8d,
+	0x5f, 0x49, 0x5f, 0x62, 0x27, 0x75, 0xca, 0x0f, 0xb8, 0xe9, 0x81, 0x02, 0x7a, 0x2e, 0xf9, 0xf4,
+	0xc2, 0xed, 0x0e, 0xb4, 0xbb, 0xf1, 0x7e, 0x9a, 0x25, 0xdc, 0x4b, 0x42, 0x89, 0x2d, 0x58, 0xe4,
+	0xa1, 0xe7, 0x53, 0x9a, 0x0d, 0xf5, 0x36, 0x56, 0xe7, 0xa9, 0xd5, 0x58, 0x48, 0xc6, 0x21, 0xb5,
+	0x77, 0x64, 0xd9, 0xa5, 0x9c, 0x35, 0x26, 0x90, 0x04, 0xde, 0x9d, 0x56, 0xaf, 0x37, 0xbb, 0x73,
+	0xc6, 0xbc, 0xe5, 0x5c, 0xc2, 0x5a, 0x41, 0xe3, 0x6b, 0xf1, 0xd5, 0x73, 0x58, 0x3d, 0x8c, 0xfc,
+	0x7e, 0xd1, 0x53, 0x23, 0x07, 0xec, 0xc4, 0xb8, 0x4a, 0xd6, 0x38, 0x1c, 0x4f, 0x6b, 0x79, 0x76,
+	0xd7, 0x61, 0xc6, 0xd6, 0x0f, 0xe0, 0x56, 0xee, 0xf9, 0x46, 0x2c, 0xc1, 0xfc, 0x33, 0xef, 0x82,
+	0x14, 0x51, 0x88, 0xc5, 0x1b, 0xe2, 0x26, 0x54, 0x0f, 0xcf, 0x3b, 0x7d, 0x82, 0x17, 0x2d, 0x82,
+	0x9e, 0x7c, 0x26, 0x9b, 0x0c, 0x55, 0xb6, 0x4e, 0x90, 0xa6, 0x47, 0x4f, 0xb1, 0x0c, 0xb7, 0xf4,
+	0xa7, 0x31, 0x0a, 0x3f, 0xbe, 0x05, 0x73, 0x1c, 0x22, 0x85, 0xc2, 0xef, 0x17, 0xe1, 0xa6, 0x7a,
+	0x51, 0xd5, 0x98, 0x8a, 0x58, 0x00, 0x20, 0xeb, 0x35, 0x3c, 0xc1, 0xf0, 0x99, 0x7f, 0xa9, 0xe1,
+	0xc9, 0xad, 0x1f, 0x42, 0x35, 0x1e, 0x3d, 0x0c, 0x19, 0x31, 0x0a, 0x65, 0xa0, 0xce, 0x4f, 0x2e,
+	0x3a, 0xcd, 0x28, 0x41, 0x59, 0x62, 0x0d, 0x96, 0xf7, 0x5c, 0xaf, 0x29, 0xbb, 0x59, 0x42, 0x65,
+	0xcb, 0x83, 0x19, 0x7d, 0x17, 0x90, 0x6a, 0x9a, 0x17, 0x81, 0xca, 0x50, 0xba, 0x99, 0x18, 0xb2,
+	0x48, 0x0d, 0x55, 0xa8, 0x19, 0x66, 0x35, 0x95, 0x1f, 0x19, 0x56, 0x6a, 0xb2, 0x8a, 0x0c, 0x4f,
+	0xe2, 0x55, 0xbf, 0xc8, 0x5f, 0xcb, 0x5e, 0xbf, 0x4b, 0x0f, 0xc6, 0x84, 0x9d, 0xda, 0xda, 0x87,
+	0xd9, 0xa4, 0x18, 0xd0, 0x16, 0x2d, 0x31, 0xc1, 0xa1, 0x58, 0xf4, 0x08, 0xbb, 0x88, 0x71, 0x88,
+	0xb1, 0x94, 0xd3, 0xfc, 0x7e, 0x8c, 0xa8, 0xec, 0xfc, 0x67, 0x09, 0xa6, 0x95, 0x32, 0xe2, 0x13,
+	0x98, 0x4d, 0x7e, 0xcb, 0x10, 0xdc, 0x3a, 0xe6, 0x7f, 0x5b, 0xb1, 0x57, 0x73, 0x58, 0x15, 0x76,
+	0xe7, 0xde, 0xcf, 0xfe, 0xf6, 0xef, 0x2f, 0x2a, 0xb7, 0x9d, 0x15, 0xfa, 0x99, 0x26, 0xdc, 0xbe,
+	0x78, 0xec, 0x76, 0xfb, 0x67, 0xee, 0xe3, 0x6d, 0x4a, 0xc3, 0xf0, 0x6d, 0x6b, 0x4b, 0xb4, 0x60,
+	0xce, 0x78, 0xc1, 0x17, 0x35, 0x62, 0x53, 0xfc, 0x85, 0xc2, 0x5e, 0x2b, 0xe0, 0xb5, 0x80, 0x07,
+	0x2c, 0x60, 0xd3, 0xbe, 0x53, 0x26, 0x60, 0xfb, 0x35, 0x5d, 0xb3, 0x9f, 0x93, 0x9c, 0x77, 0x00,
+	0xd2, 0x47, 0x75, 0xc1, 0xda, 0x16, 0x1e, 0xea, 0xed, 0x5a, 0x1e, 0xad, 0x85, 0xdc, 0x10, 0x5d,
+	0x98, 0x33, 0x5e, 0x97, 0x85, 0x9d, 0x7b, 0x6e, 0x36, 0x9e, 0xc3, 0xed, 0x3b, 0xa5, 0x34, 0xcd,
+	0xe9, 0x4d, 0x56, 0x77, 0x43, 0xac, 0xe7, 0xd4, 0x0d, 0x79, 0xab, 0xd6, 0x57, 0xec, 0x61, 0x74,
+	0x8c, 0x47, 0x5c, 0xc1, 0xd6, 0x97, 0xbc, 0x5e, 0xdb, 0xf5, 0x22, 0x21, 0x51, 0xf9, 0x3d, 0x98,
+	0xcf, 0x1c, 0x34, 0x51, 0x2f, 0x3c, 0x9d, 0xc6, 0x6c, 0x6e, 0x97, 0x50, 0x12, 0x3e, 0x9f, 0x40,
+	0xad, 0xf8, 0xe8, 0xc8, 0x5e, 0xbc, 0x6b, 0x04, 0xa5, 0xf8, 0xf0, 0x67, 0x6f, 0x0c, 0x23, 0x27,
+	0xac, 0x5f, 0xc0, 0x62, 0xfe, 0x71, 0x4e, 0xb0, 0xfb, 0x86, 0xbc, 0x25, 0xda, 0xeb, 0xe5, 0xc4,
+	0x84, 0xe1, 0xdb, 0x30, 0x9b, 0xbc, 0x7d, 0xa9, 0x44, 0xcd, 0x3f, 0xc1, 0xa9, 0x44, 0x2d, 0x3c,
+	0x90, 0xe1, 0xb7, 0x6d, 0x98, 0xcf, 0xbc, 0x36, 0x29, 0x7f, 0x95, 0x3d, 0x85, 0x29, 0x7f, 0x95,
+	0x3e, 0x4d, 0x39, 0xf7, 0x39, 0xc0, 0x77, 0xec, 0x5a, 0x3e, 0xc0, 0xaa, 0xfc, 0x51, 0x2a, 0x3e,
+	0x83, 0x85, 0xec, 0x1b, 0x8e, 0xb8, 0xad, 0xee, 0xef, 0x92, 0x27, 0x27, 0xdb, 0x2e, 0x23, 0x25,
+	0x3a, 0x07, 0xa8, 0xb3, 0xf9, 0x14, 0xa3, 0x75, 0x2e, 0x79, 0xdd, 0xd1, 0x3a, 0x97, 0xbd, 0xdb,
+	0x38, 0xdf, 0x61, 0x9d, 0x1f, 0x6c, 0xbd, 0x99, 0xd3, 0x59, 0xcf, 0x49, 0xdb, 0xaf, 0xa9, 0x81,
+	0xfd, 0x3c, 0x4e, 0xce, 0xf3, 0xc4, 0x4f, 0xaa, 0xc4, 0x65, 0xfc, 0x94, 0x79, 0xce, 0xc9, 0xf8,
+	0x29, 0xfb, 0x64, 0xe3, 0xbc, 0xc5, 0x32, 0xef, 0xd9, 0x76, 0x4e, 0xa6, 0x1a, 0x24, 0xb7, 0x5f,
+	0xfb, 0x7d, 0x3e, 0xb6, 0x3f, 0x06, 0x48, 0x27, 0x41, 0x75, 0x6c, 0x0b, 0xd3, 0xa8, 0x3a, 0xb6,
+	0xc5, 0x81, 0xd1, 0xd9, 0x60, 0x19, 0x75, 0x51, 0x2b, 0xb7, 0x0b, 0x6b, 0xcf, 0x7c, 0x66, 0x7c,
+	0xc9, 0x46, 0xdc, 0x9c, 0xf4, 0xb2, 0x11, 0xcf, 0xcc, 0x3a, 0xce, 0x26, 0x4b, 0xb1, 0xed, 0xd5,
+	0x7c, 0xc4, 0x79, 0x1b, 0x19, 0xd1, 0xe5, 0x61, 0x21, 0x9d, 0x41, 0x94, 0x9c, 0xb2, 0x11, 0x46,
+	0xc9, 0x29, 0x1d, 0x58, 0xe2, 0x4a, 0x27, 0x36, 0xf2, 0x72, 0x06, 0x27, 0x66, 0xb1, 0x13, 0x47,
+	0x30, 0xad, 0x86, 0x0a, 0xb1, 0xa4, 0x99, 0x19, 0xfc, 0x85, 0x89, 0xd2, 0x8c, 0xdf, 0x60, 0xc6,
+	0x77, 0xc5, 0xa8, 0x12, 0x2a, 0x7e, 0x02, 0x73, 0x46, 0x1f, 0xae, 0xea, 0x74, 0x71, 0x56, 0x50,
+	0x75, 0xba, 0xa4, 0x61, 0x1f, 0xea, 0x25, 0x49, 0xbb, 0xf8, 0x58, 0x60, 0xd1, 0x33, 0xe7, 0x14,
+	0x55, 0xf4, 0x4a, 0x06, 0x1a, 0xbb, 0x5e, 0x24, 0x24, 0x07, 0x02, 0xcf, 0x56, 0xb6, 0xe1, 0x56,
+	0x67, 0xab, 0xb4, 0x9b, 0x57, 0x67, 0xab, 0xbc, 0x3f, 0x47, 0x56, 0xa8, 0x8f, 0xd9, 0x11, 0x0b,
+	0xf3, 0x0a, 0xca, 0x14, 0xa5, 0x7a, 0x91, 0x90, 0x30, 0x39, 0x80, 0x5b, 0xb9, 0x6e, 0x51, 0xdd,
+	0x1d, 0xe5, 0x4d, 0xaf, 0xba, 0x3b, 0x86, 0xb4, 0x97, 0xca, 0xba, 0x6c, 0xcf, 0xa6, 0xac, 0x2b,
+	0x6d, 0x0b, 0x6d, 0xbb, 0x8c, 0x94, 0xb0, 0xfa, 0x98, 0x87, 0xc5, 0x94, 0xa4, 0x2f, 0xb6, 0x0d,
+	0xed, 0xdb, 0x3c, 0x21, 0x66, 0x7a, 0x6f, 0x28, 0x3d, 0xe1, 0x7c, 0x0c, 0x22, 0xb3, 0x41, 0x25,
+	0xcc, 0xdd, 0xc2, 0x87, 0x99, 0xbc, 0xd9, 0x18, 0x46, 0x4e, 0xd8, 0xba, 0xc9, 0x35, 0x94, 0x67,
+	0x7d, 0xdf, 0xf0, 0xff, 0x10, 0xf6, 0xce, 0xa8, 0x2d, 0xb1, 0x88, 0xdd, 0xfa, 0x5f, 0xbe, 0xda,
+	0xb0, 0xbe, 0xc4, 0xbf, 0x7f, 0xe2, 0xdf, 0xaf, 0xfe, 0xb5, 0x71, 0xe3, 0x4b, 0xfc, 0xfb, 0x3b,
+	0xfe, 0x9d, 0x4c, 0xf3, 0x3f, 0x95, 0x7c, 0xf7, 0xbf, 0xb6, 0x64, 0xc3, 0xeb, 0x98, 0x22, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4885,6 +4996,25 @@ func (m *OperateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PauseAtTimeout) > 0 {
+		i -= len(m.PauseAtTimeout)
+		copy(dAtA[i:], m.PauseAtTimeout)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PauseAtTimeout)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PauseAtTime) > 0 {
+		i -= len(m.PauseAtTime)
+		copy(dAtA[i:], m.PauseAtTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PauseAtTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeepMetaDays != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.KeepMetaDays))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Sources) > 0 {
 		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Sources[iNdEx])
@@ -5872,6 +6002,27 @@ func (m *RegisterWorkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConfigHash) > 0 {
+		i -= len(m.ConfigHash)
+		copy(dAtA[i:], m.ConfigHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ConfigHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GitHash) > 0 {
+		i -= len(m.GitHash)
+		copy(dAtA[i:], m.GitHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.GitHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -6094,6 +6245,27 @@ func (m *MasterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConfigHash) > 0 {
+		i -= len(m.ConfigHash)
+		copy(dAtA[i:], m.ConfigHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ConfigHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.GitHash) > 0 {
+		i -= len(m.GitHash)
+		copy(dAtA[i:], m.GitHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.GitHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.ClientURLs) > 0 {
 		for iNdEx := len(m.ClientURLs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ClientURLs[iNdEx])
@@ -6157,6 +6329,27 @@ func (m *WorkerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConfigHash) > 0 {
+		i -= len(m.ConfigHash)
+		copy(dAtA[i:], m.ConfigHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ConfigHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GitHash) > 0 {
+		i -= len(m.GitHash)
+		copy(dAtA[i:], m.GitHash)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.GitHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Source) > 0 {
 		i -= len(m.Source)
 		copy(dAtA[i:], m.Source)
@@ -6497,6 +6690,13 @@ func (m *ListMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VersionWarning) > 0 {
+		i -= len(m.VersionWarning)
+		copy(dAtA[i:], m.VersionWarning)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.VersionWarning)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Members) > 0 {
 		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7507,6 +7707,17 @@ func (m *OperateTaskRequest) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	if m.KeepMetaDays != 0 {
+		n += 1 + sovDmmaster(uint64(m.KeepMetaDays))
+	}
+	l = len(m.PauseAtTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.PauseAtTimeout)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -7955,6 +8166,18 @@ func (m *RegisterWorkerRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.GitHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ConfigHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -8063,6 +8286,18 @@ func (m *MasterInfo) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.GitHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ConfigHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -8088,6 +8323,18 @@ func (m *WorkerInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.GitHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ConfigHash)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -8241,6 +8488,10 @@ func (m *ListMemberResponse) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	l = len(m.VersionWarning)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -9096,6 +9347,89 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepMetaDays", wireType)
+			}
+			m.KeepMetaDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepMetaDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAtTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseAtTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAtTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseAtTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -11904,27 +12238,123 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterWorkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12578,6 +13008,102 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -12756,6 +13282,102 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -13567,6 +14189,38 @@ func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionWarning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionWarning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -961,6 +961,11 @@ type SyncStatus struct {
 	TotalRows           int64            `protobuf:"varint,15,opt,name=totalRows,proto3" json:"totalRows,omitempty"`
 	TotalRps            int64            `protobuf:"varint,16,opt,name=totalRps,proto3" json:"totalRps,omitempty"`
 	RecentRps           int64            `protobuf:"varint,17,opt,name=recentRps,proto3" json:"recentRps,omitempty"`
+	FiredDDLHooks       []string         `protobuf:"bytes,18,rep,name=firedDDLHooks,proto3" json:"firedDDLHooks,omitempty"`
+	HandleErrorProgress string           `protobuf:"bytes,19,opt,name=handleErrorProgress,proto3" json:"handleErrorProgress,omitempty"`
+	PauseAtProgress     string           `protobuf:"bytes,20,opt,name=pauseAtProgress,proto3" json:"pauseAtProgress,omitempty"`
+	TableTunings        []string         `protobuf:"bytes,21,rep,name=tableTunings,proto3" json:"tableTunings,omitempty"`
+	UnexpectedTables    []string         `protobuf:"bytes,22,rep,name=unexpectedTables,proto3" json:"unexpectedTables,omitempty"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
@@ -1115,12 +1120,49 @@ func (m *SyncStatus) GetRecentRps() int64 {
 	return 0
 }
 
+func (m *SyncStatus) GetFiredDDLHooks() []string {
+	if m != nil {
+		return m.FiredDDLHooks
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetHandleErrorProgress() string {
+	if m != nil {
+		return m.HandleErrorProgress
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetPauseAtProgress() string {
+	if m != nil {
+		return m.PauseAtProgress
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetTableTunings() []string {
+	if m != nil {
+		return m.TableTunings
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetUnexpectedTables() []string {
+	if m != nil {
+		return m.UnexpectedTables
+	}
+	return nil
+}
+
 // SourceStatus represents status for source runing on dm-worker
 type SourceStatus struct {
 	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	Worker      string         `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
 	Result      *ProcessResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	RelayStatus *RelayStatus   `protobuf:"bytes,4,opt,name=relayStatus,proto3" json:"relayStatus,omitempty"`
+	// used/max connections to the upstream if max-connections is set, otherwise empty
+	UpstreamConnections string `protobuf:"bytes,5,opt,name=upstreamConnections,proto3" json:"upstreamConnections,omitempty"`
 }
 
 func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
@@ -1184,6 +1226,13 @@ func (m *SourceStatus) GetRelayStatus() *RelayStatus {
 	return nil
 }
 
+func (m *SourceStatus) GetUpstreamConnections() string {
+	if m != nil {
+		return m.UpstreamConnections
+	}
+	return ""
+}
+
 // RelayStatus represents status for relay unit.
 type RelayStatus struct {
 	MasterBinlog       string         `protobuf:"bytes,1,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
@@ -1311,8 +1360,10 @@ type SubTaskStatus struct {
 	//	*SubTaskStatus_Dump
 	//	*SubTaskStatus_Load
 	//	*SubTaskStatus_Sync
-	Status     isSubTaskStatus_Status `protobuf_oneof:"status"`
-	Validation *ValidationStatus      `protobuf:"bytes,11,opt,name=validation,proto3" json:"validation,omitempty"`
+	Status             isSubTaskStatus_Status `protobuf_oneof:"status"`
+	Validation         *ValidationStatus      `protobuf:"bytes,11,opt,name=validation,proto3" json:"validation,omitempty"`
+	AutoResumeBreaker  string                 `protobuf:"bytes,12,opt,name=autoResumeBreaker,proto3" json:"autoResumeBreaker,omitempty"`
+	NextAutoResumeTime string                 `protobuf:"bytes,13,opt,name=nextAutoResumeTime,proto3" json:"nextAutoResumeTime,omitempty"`
 }
 
 func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
@@ -1460,6 +1511,20 @@ func (m *SubTaskStatus) GetValidation() *ValidationStatus {
 	return nil
 }
 
+func (m *SubTaskStatus) GetAutoResumeBreaker() string {
+	if m != nil {
+		return m.AutoResumeBreaker
+	}
+	return ""
+}
+
+func (m *SubTaskStatus) GetNextAutoResumeTime() string {
+	if m != nil {
+		return m.NextAutoResumeTime
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SubTaskStatus) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -3501,6 +3566,183 @@ func (m *OperateValidationErrorResponse) GetMsg() string {
 	return ""
 }
 
+type UpdateSourceCfgRequest struct {
+	SourceCfgTomlString string `protobuf:"bytes,1,opt,name=sourceCfgTomlString,proto3" json:"sourceCfgTomlString,omitempty"`
+}
+
+func (m *UpdateSourceCfgRequest) Reset()         { *m = UpdateSourceCfgRequest{} }
+func (m *UpdateSourceCfgRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSourceCfgRequest) ProtoMessage()    {}
+func (*UpdateSourceCfgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{42}
+}
+func (m *UpdateSourceCfgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSourceCfgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSourceCfgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSourceCfgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSourceCfgRequest.Merge(m, src)
+}
+func (m *UpdateSourceCfgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSourceCfgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSourceCfgRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSourceCfgRequest proto.InternalMessageInfo
+
+func (m *UpdateSourceCfgRequest) GetSourceCfgTomlString() string {
+	if m != nil {
+		return m.SourceCfgTomlString
+	}
+	return ""
+}
+
+// UpdateSourceCfgUnitResult represents the result of applying the updated source config to a unit
+// task: empty for the relay unit
+type UpdateSourceCfgUnitResult struct {
+	Task   string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Unit   UnitType `protobuf:"varint,2,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	Result bool     `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *UpdateSourceCfgUnitResult) Reset()         { *m = UpdateSourceCfgUnitResult{} }
+func (m *UpdateSourceCfgUnitResult) String() string { return proto.CompactTextString(m) }
+func (*UpdateSourceCfgUnitResult) ProtoMessage()    {}
+func (*UpdateSourceCfgUnitResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{43}
+}
+func (m *UpdateSourceCfgUnitResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSourceCfgUnitResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSourceCfgUnitResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSourceCfgUnitResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSourceCfgUnitResult.Merge(m, src)
+}
+func (m *UpdateSourceCfgUnitResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSourceCfgUnitResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSourceCfgUnitResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSourceCfgUnitResult proto.InternalMessageInfo
+
+func (m *UpdateSourceCfgUnitResult) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *UpdateSourceCfgUnitResult) GetUnit() UnitType {
+	if m != nil {
+		return m.Unit
+	}
+	return UnitType_InvalidUnit
+}
+
+func (m *UpdateSourceCfgUnitResult) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func (m *UpdateSourceCfgUnitResult) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+// UpdateSourceCfgResponse represents the result of updating the source config online
+// result: whether the source config is updated in the worker
+// units: the result of each unit, the relay and sync units rebuild the upstream connections
+type UpdateSourceCfgResponse struct {
+	Result bool                         `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string                       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Units  []*UpdateSourceCfgUnitResult `protobuf:"bytes,3,rep,name=units,proto3" json:"units,omitempty"`
+}
+
+func (m *UpdateSourceCfgResponse) Reset()         { *m = UpdateSourceCfgResponse{} }
+func (m *UpdateSourceCfgResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateSourceCfgResponse) ProtoMessage()    {}
+func (*UpdateSourceCfgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{44}
+}
+func (m *UpdateSourceCfgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSourceCfgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSourceCfgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSourceCfgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSourceCfgResponse.Merge(m, src)
+}
+func (m *UpdateSourceCfgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSourceCfgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSourceCfgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSourceCfgResponse proto.InternalMessageInfo
+
+func (m *UpdateSourceCfgResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func (m *UpdateSourceCfgResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *UpdateSourceCfgResponse) GetUnits() []*UpdateSourceCfgUnitResult {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
 	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
@@ -3555,192 +3797,209 @@ func init() {
 	proto.RegisterType((*GetValidationErrorResponse)(nil), "pb.GetValidationErrorResponse")
 	proto.RegisterType((*OperateValidationErrorRequest)(nil), "pb.OperateValidationErrorRequest")
 	proto.RegisterType((*OperateValidationErrorResponse)(nil), "pb.OperateValidationErrorResponse")
+	proto.RegisterType((*UpdateSourceCfgRequest)(nil), "pb.UpdateSourceCfgRequest")
+	proto.RegisterType((*UpdateSourceCfgUnitResult)(nil), "pb.UpdateSourceCfgUnitResult")
+	proto.RegisterType((*UpdateSourceCfgResponse)(nil), "pb.UpdateSourceCfgResponse")
 }
 
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2880 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcd, 0x6f, 0x24, 0x57,
-	0xf1, 0xd3, 0xdd, 0xf3, 0x59, 0x33, 0xb6, 0xdb, 0x6f, 0xbd, 0xfb, 0x9b, 0x38, 0xbb, 0x13, 0xa7,
-	0x37, 0xca, 0xcf, 0xb1, 0x82, 0x95, 0x98, 0xa0, 0xa0, 0x48, 0x90, 0x64, 0xed, 0x8d, 0x77, 0x83,
-	0x37, 0xde, 0x6d, 0x3b, 0xcb, 0x09, 0x89, 0x76, 0xcf, 0xf3, 0xb8, 0x71, 0x4f, 0x77, 0x6f, 0x77,
-	0x8f, 0x2d, 0x1f, 0x10, 0x17, 0xc4, 0x15, 0x2e, 0x20, 0x81, 0xb8, 0x80, 0x84, 0xc4, 0x89, 0x03,
-	0x7f, 0x00, 0x47, 0xc8, 0x31, 0xe2, 0xc4, 0x11, 0x65, 0xff, 0x06, 0xae, 0x08, 0x55, 0xbd, 0xf7,
-	0xba, 0x5f, 0xcf, 0x87, 0x37, 0x8b, 0xc4, 0xad, 0xeb, 0xe3, 0x55, 0xd5, 0xab, 0xaf, 0x57, 0x35,
-	0x36, 0x2c, 0x0f, 0xc7, 0x97, 0x71, 0x7a, 0xce, 0xd3, 0xed, 0x24, 0x8d, 0xf3, 0x98, 0x99, 0xc9,
-	0x89, 0xb3, 0x09, 0xec, 0xc9, 0x84, 0xa7, 0x57, 0x47, 0xb9, 0x97, 0x4f, 0x32, 0x97, 0x3f, 0x9b,
-	0xf0, 0x2c, 0x67, 0x0c, 0xea, 0x91, 0x37, 0xe6, 0x7d, 0x63, 0xc3, 0xd8, 0xec, 0xb8, 0xf4, 0xed,
-	0x24, 0xb0, 0xb6, 0x1b, 0x8f, 0xc7, 0x71, 0xf4, 0x7d, 0x92, 0xe1, 0xf2, 0x2c, 0x89, 0xa3, 0x8c,
-	0xb3, 0x5b, 0xd0, 0x4c, 0x79, 0x36, 0x09, 0x73, 0xe2, 0x6e, 0xbb, 0x12, 0x62, 0x36, 0x58, 0xe3,
-	0x6c, 0xd4, 0x37, 0x49, 0x04, 0x7e, 0x22, 0x67, 0x16, 0x4f, 0x52, 0x9f, 0xf7, 0x2d, 0x42, 0x4a,
-	0x08, 0xf1, 0xc2, 0xae, 0x7e, 0x5d, 0xe0, 0x05, 0xe4, 0xfc, 0xc9, 0x80, 0x1b, 0x15, 0xe3, 0x5e,
-	0x5a, 0xe3, 0x7b, 0xd0, 0x13, 0x3a, 0x84, 0x04, 0xd2, 0xdb, 0xdd, 0xb1, 0xb7, 0x93, 0x93, 0xed,
-	0x23, 0x0d, 0xef, 0x56, 0xb8, 0xd8, 0xfb, 0xb0, 0x94, 0x4d, 0x4e, 0x8e, 0xbd, 0xec, 0x5c, 0x1e,
-	0xab, 0x6f, 0x58, 0x9b, 0xdd, 0x9d, 0x55, 0x3a, 0xa6, 0x13, 0xdc, 0x2a, 0x9f, 0xf3, 0x07, 0x03,
-	0xba, 0xbb, 0x67, 0xdc, 0x97, 0x30, 0x1a, 0x9a, 0x78, 0x59, 0xc6, 0x87, 0xca, 0x50, 0x01, 0xb1,
-	0x35, 0x68, 0xe4, 0x71, 0xee, 0x85, 0x64, 0x6a, 0xc3, 0x15, 0x00, 0x1b, 0x00, 0x64, 0x13, 0xdf,
-	0xe7, 0x59, 0x76, 0x3a, 0x09, 0xc9, 0xd4, 0x86, 0xab, 0x61, 0x50, 0xda, 0xa9, 0x17, 0x84, 0x7c,
-	0x48, 0x6e, 0x6a, 0xb8, 0x12, 0x62, 0x7d, 0x68, 0x5d, 0x7a, 0x69, 0x14, 0x44, 0xa3, 0x7e, 0x83,
-	0x08, 0x0a, 0xc4, 0x13, 0x43, 0x9e, 0x7b, 0x41, 0xd8, 0x6f, 0x6e, 0x18, 0x9b, 0x3d, 0x57, 0x42,
-	0xce, 0xbf, 0x0d, 0x80, 0xbd, 0xc9, 0x38, 0x91, 0x66, 0x6e, 0x40, 0x97, 0x2c, 0x38, 0xf6, 0x4e,
-	0x42, 0x9e, 0x91, 0xad, 0x96, 0xab, 0xa3, 0xd8, 0x26, 0xac, 0xf8, 0xf1, 0x38, 0x09, 0x79, 0xce,
-	0x87, 0x92, 0x0b, 0x4d, 0x37, 0xdc, 0x69, 0x34, 0x7b, 0x03, 0x96, 0x4e, 0x83, 0x28, 0xc8, 0xce,
-	0xf8, 0xf0, 0xde, 0x55, 0xce, 0x85, 0xcb, 0x0d, 0xb7, 0x8a, 0x64, 0x0e, 0xf4, 0x14, 0xc2, 0x8d,
-	0x2f, 0x33, 0xba, 0x90, 0xe1, 0x56, 0x70, 0xec, 0x6d, 0x58, 0xe5, 0x59, 0x1e, 0x8c, 0xbd, 0x9c,
-	0x1f, 0xa3, 0x29, 0xc4, 0xd8, 0x20, 0xc6, 0x59, 0x02, 0xc6, 0xfe, 0x24, 0xc9, 0xe8, 0x9e, 0x96,
-	0x8b, 0x9f, 0x6c, 0x1d, 0xda, 0x49, 0x1a, 0x8f, 0x52, 0x9e, 0x65, 0xfd, 0x16, 0xa5, 0x44, 0x01,
-	0x3b, 0x5f, 0x18, 0x00, 0x07, 0xb1, 0x37, 0x94, 0x0e, 0x98, 0x31, 0x5a, 0xb8, 0x60, 0xca, 0xe8,
-	0x01, 0x00, 0xf9, 0x44, 0xb0, 0x98, 0xc4, 0xa2, 0x61, 0x2a, 0x0a, 0xad, 0xaa, 0x42, 0x3c, 0x3b,
-	0xe6, 0xb9, 0x77, 0x2f, 0x88, 0xc2, 0x78, 0x24, 0xd3, 0x5c, 0xc3, 0xb0, 0x37, 0x61, 0xb9, 0x84,
-	0xf6, 0x8f, 0x1f, 0xee, 0xd1, 0x4d, 0x3b, 0xee, 0x14, 0x76, 0xf6, 0x9a, 0xce, 0x2f, 0x0d, 0x58,
-	0x3a, 0x3a, 0xf3, 0xd2, 0x61, 0x10, 0x8d, 0xf6, 0xd3, 0x78, 0x92, 0x60, 0xd4, 0x73, 0x2f, 0x1d,
-	0xf1, 0x5c, 0x96, 0xaf, 0x84, 0xb0, 0xa8, 0xf7, 0xf6, 0x0e, 0xd0, 0x72, 0x0b, 0x8b, 0x1a, 0xbf,
-	0xc5, 0xcd, 0xd3, 0x2c, 0x3f, 0x88, 0x7d, 0x2f, 0x0f, 0xe2, 0x48, 0x1a, 0x5e, 0x45, 0x52, 0xe1,
-	0x5e, 0x45, 0x3e, 0x65, 0x9e, 0x45, 0x85, 0x4b, 0x10, 0xde, 0x78, 0x12, 0x49, 0x4a, 0x83, 0x28,
-	0x05, 0xec, 0xfc, 0xab, 0x0e, 0x70, 0x74, 0x15, 0xf9, 0x53, 0x39, 0x76, 0xff, 0x82, 0x47, 0x79,
-	0x35, 0xc7, 0x04, 0x0a, 0x85, 0x89, 0x94, 0x4b, 0x94, 0x73, 0x0b, 0x98, 0xdd, 0x86, 0x4e, 0xca,
-	0x7d, 0x1e, 0xe5, 0x48, 0xb4, 0x88, 0x58, 0x22, 0x30, 0x9b, 0xc6, 0x5e, 0x96, 0xf3, 0xb4, 0xe2,
-	0xde, 0x0a, 0x8e, 0x6d, 0x81, 0xad, 0xc3, 0xfb, 0x79, 0x30, 0x94, 0x2e, 0x9e, 0xc1, 0xa3, 0x3c,
-	0xba, 0x84, 0x92, 0xd7, 0x14, 0xf2, 0x74, 0x1c, 0xca, 0xd3, 0x61, 0x92, 0x27, 0xb2, 0x6c, 0x06,
-	0x8f, 0xf2, 0x4e, 0xc2, 0xd8, 0x3f, 0x0f, 0xa2, 0x11, 0x05, 0xa0, 0x4d, 0xae, 0xaa, 0xe0, 0xd8,
-	0x77, 0xc0, 0x9e, 0x44, 0x29, 0xcf, 0xe2, 0xf0, 0x82, 0x0f, 0x29, 0x8e, 0x59, 0xbf, 0xa3, 0xb5,
-	0x1d, 0x3d, 0xc2, 0xee, 0x0c, 0xab, 0x16, 0x21, 0x10, 0x9d, 0x46, 0x46, 0x68, 0x00, 0x70, 0x42,
-	0x86, 0x1c, 0x5f, 0x25, 0xbc, 0xdf, 0x15, 0x79, 0x57, 0x62, 0xd8, 0x3b, 0x70, 0x23, 0xe3, 0x7e,
-	0x1c, 0x0d, 0xb3, 0x7b, 0xfc, 0x2c, 0x88, 0x86, 0x8f, 0xc8, 0x17, 0xfd, 0x1e, 0xb9, 0x78, 0x1e,
-	0x09, 0x33, 0x86, 0x0c, 0xdf, 0xdb, 0x3b, 0x38, 0xbc, 0x8c, 0x78, 0xda, 0x5f, 0x12, 0x19, 0x53,
-	0x41, 0x62, 0xb8, 0xfd, 0x38, 0x3a, 0x0d, 0x03, 0x3f, 0x7f, 0x94, 0x8d, 0xfa, 0xcb, 0xc4, 0xa3,
-	0xa3, 0x30, 0xa4, 0x79, 0x51, 0xd6, 0x2b, 0x22, 0xa4, 0x05, 0xa2, 0x48, 0x06, 0x37, 0xc9, 0xfa,
-	0xb6, 0x96, 0x0c, 0xae, 0x9e, 0x0c, 0x48, 0x5c, 0xd5, 0x93, 0xc1, 0x4d, 0x32, 0xe7, 0xb7, 0x06,
-	0xf4, 0xf4, 0xde, 0xae, 0xbd, 0x3a, 0xc6, 0x82, 0x57, 0xc7, 0xd4, 0x5f, 0x1d, 0xf6, 0x56, 0xf1,
-	0xba, 0x88, 0xd7, 0x82, 0xfc, 0xff, 0x38, 0x8d, 0xb1, 0x0d, 0xbb, 0x44, 0x28, 0x1e, 0x9c, 0x77,
-	0xa1, 0x9b, 0xf2, 0xd0, 0xbb, 0x2a, 0x9e, 0x09, 0xe4, 0x5f, 0x41, 0x7e, 0xb7, 0x44, 0xbb, 0x3a,
-	0x8f, 0xf3, 0x37, 0x13, 0xba, 0x1a, 0x71, 0x26, 0x77, 0x8d, 0xaf, 0x99, 0xbb, 0xe6, 0x82, 0xdc,
-	0xdd, 0x50, 0x26, 0x4d, 0x4e, 0xf6, 0x82, 0x54, 0x96, 0xb3, 0x8e, 0x2a, 0x38, 0x2a, 0xc5, 0xa2,
-	0xa3, 0xb0, 0xdb, 0x6b, 0xa0, 0x56, 0x2a, 0xd3, 0x68, 0xb6, 0x0d, 0x8c, 0x50, 0xbb, 0x5e, 0xee,
-	0x9f, 0x7d, 0x9e, 0xc8, 0xec, 0x69, 0x52, 0x0a, 0xce, 0xa1, 0xb0, 0xd7, 0xa0, 0x91, 0xe5, 0xde,
-	0x88, 0x53, 0xa9, 0x2c, 0xef, 0x74, 0x28, 0xb5, 0x11, 0xe1, 0x0a, 0xbc, 0xe6, 0xfc, 0xf6, 0x0b,
-	0x9c, 0xef, 0xfc, 0xd9, 0x82, 0xa5, 0xca, 0x6b, 0x3c, 0x6f, 0x6a, 0x29, 0x35, 0x9a, 0x0b, 0x34,
-	0x6e, 0x40, 0x7d, 0x12, 0x05, 0x22, 0xd8, 0xcb, 0x3b, 0x3d, 0xa4, 0x7f, 0x1e, 0x05, 0x39, 0x56,
-	0x87, 0x4b, 0x14, 0xcd, 0xa6, 0xfa, 0x8b, 0x12, 0xe2, 0x1d, 0xb8, 0x51, 0x96, 0xe6, 0xde, 0xde,
-	0xc1, 0x41, 0xec, 0x9f, 0x17, 0xbd, 0x7c, 0x1e, 0x89, 0x31, 0x31, 0xb3, 0x50, 0x8b, 0x79, 0x50,
-	0x13, 0x53, 0xcb, 0xff, 0x43, 0xc3, 0xc7, 0x29, 0x82, 0xbc, 0x24, 0x13, 0x4a, 0x1b, 0x2b, 0x1e,
-	0xd4, 0x5c, 0x41, 0x67, 0x6f, 0x40, 0x7d, 0x38, 0x19, 0x27, 0xd2, 0x57, 0xcb, 0xc8, 0x57, 0x3e,
-	0xeb, 0x0f, 0x6a, 0x2e, 0x51, 0x91, 0x2b, 0x8c, 0xbd, 0x61, 0xbf, 0x53, 0x72, 0x95, 0x6f, 0x1f,
-	0x72, 0x21, 0x15, 0xb9, 0xb0, 0x67, 0x50, 0xff, 0x90, 0x5c, 0x65, 0xfb, 0x46, 0x2e, 0xa4, 0xb2,
-	0xf7, 0x00, 0x2e, 0xbc, 0x30, 0x18, 0x8a, 0xc7, 0xa2, 0x4b, 0xbc, 0x6b, 0xc8, 0xfb, 0xb4, 0xc0,
-	0xca, 0xac, 0xd7, 0xf8, 0xee, 0xb5, 0xa1, 0x99, 0x89, 0xf4, 0xff, 0x2e, 0xac, 0x56, 0x62, 0x76,
-	0x10, 0x64, 0xe4, 0x60, 0x41, 0xee, 0x1b, 0x8b, 0x06, 0x2d, 0x75, 0x7e, 0x00, 0x40, 0x9e, 0xb8,
-	0x9f, 0xa6, 0x71, 0xaa, 0x06, 0x3e, 0xa3, 0x18, 0xf8, 0x9c, 0x3b, 0xd0, 0x41, 0x0f, 0x5c, 0x43,
-	0xc6, 0xab, 0x2f, 0x22, 0x27, 0xd0, 0xa3, 0x3b, 0x3f, 0x39, 0x58, 0xc0, 0xc1, 0x76, 0x60, 0x4d,
-	0x4c, 0x5d, 0xa2, 0x08, 0x1e, 0xc7, 0x59, 0x40, 0x9e, 0x10, 0xe5, 0x38, 0x97, 0x86, 0xbd, 0x8c,
-	0xa3, 0xb8, 0xa3, 0x27, 0x07, 0x6a, 0x2e, 0x50, 0xb0, 0xf3, 0x2d, 0xe8, 0xa0, 0x46, 0xa1, 0x6e,
-	0x13, 0x9a, 0x44, 0x50, 0x7e, 0xb0, 0x8b, 0x20, 0x48, 0x83, 0x5c, 0x49, 0x77, 0x7e, 0x6e, 0x40,
-	0x57, 0x34, 0x39, 0x71, 0xf2, 0x65, 0x7b, 0xdc, 0x46, 0xe5, 0xb8, 0xea, 0x12, 0xba, 0xc4, 0x6d,
-	0x00, 0x6a, 0x53, 0x82, 0xa1, 0x5e, 0x26, 0x45, 0x89, 0x75, 0x35, 0x0e, 0x0c, 0x4c, 0x09, 0xcd,
-	0x71, 0xed, 0xaf, 0x4d, 0xe8, 0xc9, 0x90, 0x0a, 0x96, 0xff, 0x51, 0xb1, 0xca, 0x7a, 0xaa, 0xeb,
-	0xf5, 0xf4, 0xa6, 0xaa, 0xa7, 0x46, 0x79, 0x8d, 0x32, 0x8b, 0xca, 0x72, 0xba, 0x2b, 0xcb, 0xa9,
-	0x49, 0x6c, 0x4b, 0xaa, 0x9c, 0x14, 0x97, 0xa8, 0xa6, 0xbb, 0xb2, 0x9a, 0x5a, 0x25, 0x53, 0x91,
-	0x52, 0x45, 0x31, 0xdd, 0x95, 0xc5, 0xd4, 0x2e, 0x99, 0x8a, 0x30, 0xab, 0x5a, 0xba, 0xd7, 0x82,
-	0x06, 0x85, 0xd3, 0xf9, 0x00, 0x6c, 0xdd, 0x35, 0x54, 0x13, 0x6f, 0x4a, 0x62, 0x25, 0x15, 0x34,
-	0x26, 0x57, 0x9e, 0x7d, 0x06, 0x4b, 0x95, 0x56, 0x84, 0x2f, 0x7e, 0x90, 0xed, 0x7a, 0x91, 0xcf,
-	0xc3, 0x62, 0xef, 0xd0, 0x30, 0x5a, 0x92, 0x99, 0xa5, 0x64, 0x29, 0xa2, 0x92, 0x64, 0xda, 0xf6,
-	0x60, 0x55, 0xb6, 0x87, 0xbf, 0x1b, 0xd0, 0xd3, 0x0f, 0xe0, 0x02, 0x72, 0x3f, 0x4d, 0x77, 0xe3,
-	0xa1, 0x88, 0x66, 0xc3, 0x55, 0x20, 0xa6, 0x3e, 0x7e, 0x86, 0x5e, 0x96, 0xc9, 0x0c, 0x2c, 0x60,
-	0x49, 0x3b, 0xf2, 0xe3, 0x44, 0xed, 0x83, 0x05, 0x2c, 0x69, 0x07, 0xfc, 0x82, 0x87, 0xf2, 0x81,
-	0x2a, 0x60, 0xd4, 0xf6, 0x88, 0x67, 0x19, 0xa6, 0x89, 0xe8, 0xab, 0x0a, 0xc4, 0x53, 0xae, 0x77,
-	0xb9, 0xeb, 0x4d, 0x32, 0x2e, 0x67, 0xb6, 0x02, 0x46, 0xb7, 0xe0, 0xde, 0xea, 0xa5, 0xf1, 0x24,
-	0x52, 0x93, 0x9a, 0x86, 0x71, 0x2e, 0x61, 0xf5, 0xf1, 0x24, 0x1d, 0x71, 0x4a, 0x62, 0xb5, 0x06,
-	0xaf, 0x43, 0x3b, 0x88, 0x3c, 0x3f, 0x0f, 0x2e, 0xb8, 0xf4, 0x64, 0x01, 0x63, 0xfe, 0xe6, 0xc1,
-	0x98, 0xcb, 0x51, 0x95, 0xbe, 0x91, 0xff, 0x34, 0x08, 0x39, 0xe5, 0xb5, 0xbc, 0x92, 0x82, 0xa9,
-	0x44, 0xc5, 0x9b, 0x2c, 0x97, 0x5c, 0x01, 0x39, 0xbf, 0x31, 0x61, 0xfd, 0x30, 0xe1, 0xa9, 0x97,
-	0x73, 0xb1, 0x58, 0x1f, 0xf9, 0x67, 0x7c, 0xec, 0x29, 0x13, 0x6e, 0x83, 0x19, 0x27, 0xa4, 0x5c,
-	0xe6, 0xbb, 0x20, 0x1f, 0x26, 0xae, 0x19, 0x27, 0x64, 0x84, 0x97, 0x9d, 0x4b, 0xdf, 0xd2, 0xf7,
-	0xc2, 0x2d, 0x7b, 0x1d, 0xda, 0x43, 0x2f, 0xf7, 0x4e, 0xbc, 0x8c, 0x2b, 0x9f, 0x2a, 0x98, 0x16,
-	0x52, 0xdc, 0xdf, 0xa4, 0x47, 0x05, 0x40, 0x92, 0x48, 0x9b, 0xf4, 0xa6, 0x84, 0x90, 0xfb, 0x34,
-	0x9c, 0x64, 0x67, 0xe4, 0xc6, 0xb6, 0x2b, 0x00, 0xb4, 0xa5, 0xc8, 0xf9, 0xb6, 0x7c, 0x2e, 0x06,
-	0x00, 0xa7, 0x69, 0x3c, 0x16, 0x8d, 0x85, 0x1e, 0xa0, 0xb6, 0xab, 0x61, 0x14, 0xfd, 0x58, 0xac,
-	0x2b, 0x50, 0xd2, 0x05, 0xc6, 0xc9, 0x61, 0xe9, 0xe9, 0xbb, 0x32, 0xed, 0x1f, 0xf1, 0xdc, 0x63,
-	0xeb, 0x9a, 0x3b, 0x00, 0xdd, 0x81, 0x14, 0xe9, 0x8c, 0x17, 0x76, 0x0f, 0xd5, 0x72, 0x2c, 0xad,
-	0xe5, 0x28, 0x0f, 0xd6, 0x29, 0xc5, 0xe9, 0xdb, 0x79, 0x0f, 0xd6, 0x64, 0x44, 0x9e, 0xbe, 0x8b,
-	0x5a, 0x17, 0xc6, 0x42, 0x90, 0x85, 0x7a, 0xe7, 0xaf, 0x06, 0xdc, 0x9c, 0x3a, 0xf6, 0xd2, 0xbf,
-	0x57, 0xbc, 0x0f, 0x75, 0x5c, 0xf8, 0xfa, 0x16, 0x95, 0xe6, 0x5d, 0xd4, 0x31, 0x57, 0xe4, 0x36,
-	0x02, 0xf7, 0xa3, 0x3c, 0xbd, 0x72, 0xe9, 0xc0, 0xfa, 0xa7, 0xd0, 0x29, 0x50, 0x28, 0xf7, 0x9c,
-	0x5f, 0xa9, 0xee, 0x7b, 0xce, 0xaf, 0x70, 0xa2, 0xb8, 0xf0, 0xc2, 0x89, 0x70, 0x8d, 0x7c, 0x60,
-	0x2b, 0x8e, 0x75, 0x05, 0xfd, 0x03, 0xf3, 0xdb, 0x86, 0xf3, 0x63, 0xe8, 0x3f, 0xf0, 0xa2, 0x61,
-	0x28, 0xf3, 0x51, 0x34, 0x05, 0xe9, 0x82, 0x57, 0x35, 0x17, 0x74, 0x51, 0x0a, 0x51, 0xaf, 0xc9,
-	0xc6, 0xdb, 0xd0, 0x39, 0x51, 0xcf, 0xa1, 0x74, 0x7c, 0x89, 0xa0, 0x9c, 0x79, 0x16, 0x66, 0x72,
-	0xad, 0xa4, 0x6f, 0xe7, 0x26, 0xdc, 0xd8, 0xe7, 0xb9, 0xd0, 0xbd, 0x7b, 0x3a, 0x92, 0x9a, 0x9d,
-	0x4d, 0x58, 0xab, 0xa2, 0xa5, 0x73, 0x6d, 0xb0, 0xfc, 0xd3, 0xe2, 0xa9, 0xf1, 0x4f, 0x47, 0xce,
-	0x11, 0xdc, 0x11, 0xd3, 0xd2, 0xe4, 0x04, 0x4d, 0xc0, 0xd6, 0xf7, 0x79, 0x32, 0xf4, 0x72, 0xae,
-	0x2e, 0xb1, 0x03, 0x6b, 0x99, 0xa0, 0xed, 0x9e, 0x8e, 0x8e, 0xe3, 0x71, 0x78, 0x94, 0xa7, 0x41,
-	0xa4, 0x64, 0xcc, 0xa5, 0x39, 0x07, 0x30, 0x58, 0x24, 0x54, 0x1a, 0xd2, 0x87, 0x96, 0xfc, 0xb1,
-	0x46, 0x86, 0x59, 0x81, 0xb3, 0x71, 0x76, 0x46, 0xb0, 0xbe, 0xcf, 0xf3, 0x99, 0x99, 0xa9, 0x6c,
-	0x3b, 0xa8, 0xe3, 0xb3, 0xf2, 0x79, 0x2c, 0x60, 0xf6, 0x0d, 0xe8, 0x9d, 0x06, 0x61, 0xce, 0x53,
-	0xb9, 0x73, 0xcc, 0xe4, 0x7a, 0x85, 0xec, 0xfc, 0xd4, 0x02, 0x7b, 0x5a, 0x4d, 0x11, 0x27, 0x63,
-	0x6e, 0xd7, 0x30, 0x2b, 0x5d, 0x83, 0x41, 0x7d, 0x8c, 0x8d, 0x5d, 0xd6, 0x0c, 0x7e, 0x97, 0x85,
-	0x56, 0x5f, 0x50, 0x68, 0x9b, 0xb0, 0x22, 0xa7, 0xbf, 0x58, 0xed, 0x35, 0x72, 0x81, 0x98, 0x42,
-	0xe3, 0xc0, 0x3c, 0x85, 0xa2, 0x75, 0x43, 0xf4, 0x9b, 0x79, 0x24, 0x6d, 0x1a, 0x6f, 0x7d, 0x8d,
-	0x69, 0x3c, 0x11, 0x04, 0xf1, 0x93, 0x92, 0x74, 0x59, 0x5b, 0x08, 0x9f, 0x43, 0x62, 0x6f, 0xc3,
-	0x6a, 0xc2, 0x23, 0x5c, 0xb4, 0x35, 0xfe, 0x0e, 0xf1, 0xcf, 0x12, 0xf0, 0x9a, 0xf4, 0x54, 0x6a,
-	0xbc, 0x20, 0xae, 0x39, 0x85, 0x76, 0x7e, 0x6f, 0xc0, 0xcd, 0x32, 0x0c, 0xf4, 0x53, 0xd9, 0x0b,
-	0xb6, 0xd3, 0x75, 0x68, 0x67, 0xa9, 0x4f, 0x9c, 0xea, 0xe5, 0x54, 0x30, 0x75, 0xf2, 0x2c, 0x17,
-	0x34, 0xf9, 0xcc, 0x28, 0xf8, 0xc5, 0xb1, 0xe9, 0x43, 0x6b, 0x5c, 0x7d, 0x3e, 0x25, 0xe8, 0xfc,
-	0xc5, 0x80, 0x57, 0xe7, 0x66, 0xe5, 0x7f, 0xf1, 0xb3, 0x2b, 0x14, 0xa1, 0xcb, 0x64, 0x33, 0xbb,
-	0x7e, 0x4b, 0xc0, 0x79, 0xe3, 0x43, 0x58, 0xca, 0x4b, 0xcf, 0x70, 0xf5, 0xb3, 0xeb, 0x2b, 0xd5,
-	0x83, 0x9a, 0xf3, 0xdc, 0x2a, 0xbf, 0x73, 0x0e, 0xaf, 0x54, 0xec, 0xaf, 0x74, 0xae, 0x1d, 0x9a,
-	0xc2, 0x91, 0x97, 0xcb, 0xfe, 0x75, 0x4b, 0x13, 0x2c, 0xa6, 0x5e, 0xa2, 0xba, 0x05, 0x5f, 0xa5,
-	0x10, 0xcd, 0x6a, 0x21, 0x3a, 0xbf, 0x33, 0x61, 0x65, 0x4a, 0x15, 0x5b, 0x06, 0x33, 0x18, 0xca,
-	0x40, 0x9a, 0xc1, 0x70, 0x61, 0x51, 0xe9, 0xc1, 0xb5, 0xa6, 0x82, 0x8b, 0x6d, 0x24, 0xf5, 0xf7,
-	0xbc, 0xdc, 0x93, 0xaf, 0xb4, 0x02, 0x2b, 0x61, 0x6f, 0x4c, 0x85, 0xbd, 0x0f, 0xad, 0x61, 0x96,
-	0xd3, 0x29, 0x51, 0x3b, 0x0a, 0xc4, 0x06, 0x4c, 0xd9, 0x48, 0x3f, 0x00, 0x89, 0xb9, 0xa7, 0x44,
-	0xb0, 0xed, 0x62, 0xf5, 0x6a, 0x5f, 0xeb, 0x13, 0xc9, 0x55, 0x4c, 0x3d, 0x1d, 0xd9, 0x3a, 0x70,
-	0xea, 0xd1, 0x32, 0x0a, 0xaa, 0x19, 0xf5, 0x6c, 0xaa, 0xcd, 0xc9, 0x80, 0xbc, 0x74, 0x3e, 0xbd,
-	0xa5, 0x86, 0x61, 0x91, 0x4a, 0x37, 0xaa, 0x19, 0x51, 0x99, 0x87, 0x7f, 0x65, 0xc0, 0x1d, 0xf5,
-	0x64, 0xce, 0x4f, 0x84, 0xbb, 0xda, 0x13, 0x36, 0x2b, 0x49, 0x3e, 0x65, 0x34, 0x45, 0x7f, 0x1c,
-	0x86, 0x62, 0xfd, 0x31, 0xd5, 0x14, 0xad, 0x30, 0x95, 0xcc, 0xb0, 0xa6, 0x5a, 0xf4, 0x1a, 0x59,
-	0xfb, 0x50, 0xfc, 0x4c, 0x5f, 0x77, 0x05, 0xe0, 0x7c, 0x0a, 0x83, 0x45, 0x76, 0xbd, 0xac, 0x3f,
-	0xb6, 0xce, 0xa1, 0x29, 0xe6, 0x1e, 0xb6, 0x04, 0x9d, 0x87, 0x11, 0xd5, 0xd0, 0x61, 0x62, 0xd7,
-	0x58, 0x1b, 0xea, 0x47, 0x79, 0x9c, 0xd8, 0x06, 0xeb, 0x40, 0xe3, 0x31, 0x0e, 0xbe, 0xb6, 0xc9,
-	0x00, 0x9a, 0xd8, 0x18, 0xc7, 0xdc, 0xb6, 0x10, 0x7d, 0x94, 0x7b, 0x69, 0x6e, 0xd7, 0x11, 0x2d,
-	0x5e, 0x30, 0xbb, 0xc1, 0x96, 0x01, 0x3e, 0x9e, 0xe4, 0xb1, 0x64, 0x6b, 0x22, 0x6d, 0x8f, 0x87,
-	0x3c, 0xe7, 0x76, 0x6b, 0xeb, 0x27, 0x74, 0x64, 0x84, 0x2f, 0x6d, 0x4f, 0xea, 0x22, 0xd8, 0xae,
-	0xb1, 0x16, 0x58, 0x9f, 0xf1, 0x4b, 0xdb, 0x60, 0x5d, 0x68, 0xb9, 0x93, 0x28, 0x0a, 0xa2, 0x91,
-	0xd0, 0x47, 0xaa, 0x87, 0xb6, 0x85, 0x04, 0x34, 0x28, 0xe1, 0x43, 0xbb, 0xce, 0x7a, 0xd0, 0xfe,
-	0x44, 0xfe, 0xa2, 0x6e, 0x37, 0x90, 0x84, 0x6c, 0x78, 0xa6, 0x89, 0x24, 0x52, 0x8e, 0x50, 0x0b,
-	0x21, 0x3a, 0x85, 0x50, 0x7b, 0xeb, 0x10, 0xda, 0x6a, 0xc9, 0x63, 0x2b, 0xd0, 0x95, 0x36, 0x20,
-	0xca, 0xae, 0xe1, 0x85, 0xe8, 0x5d, 0xb6, 0x0d, 0xbc, 0x3c, 0xae, 0x6b, 0xb6, 0x89, 0x5f, 0xb8,
-	0x93, 0xd9, 0x16, 0x39, 0xe4, 0x2a, 0xf2, 0xed, 0x3a, 0x32, 0xd2, 0x6c, 0x6f, 0x0f, 0xb7, 0x1e,
-	0x41, 0x8b, 0x3e, 0x0f, 0x71, 0x64, 0x59, 0x96, 0xf2, 0x24, 0xc6, 0xae, 0xa1, 0x4f, 0x51, 0xbb,
-	0xe0, 0x36, 0xd0, 0x37, 0x74, 0x1d, 0x01, 0x9b, 0x68, 0x82, 0xf0, 0x93, 0x40, 0x58, 0x5b, 0x3f,
-	0x33, 0xa0, 0xad, 0xa6, 0x72, 0x76, 0x03, 0x56, 0x94, 0x93, 0x24, 0x4a, 0x48, 0xdc, 0xe7, 0xb9,
-	0x40, 0xd8, 0x06, 0x29, 0x28, 0x40, 0x13, 0xfd, 0xea, 0xf2, 0x71, 0x7c, 0xc1, 0x25, 0xc6, 0x42,
-	0x95, 0xb8, 0x04, 0x4a, 0xb8, 0x8e, 0x07, 0x10, 0xa6, 0x52, 0xb7, 0x1b, 0xec, 0x16, 0x30, 0x04,
-	0x1f, 0x05, 0x23, 0x4c, 0x27, 0x31, 0x2a, 0x67, 0x76, 0x73, 0xeb, 0x23, 0x68, 0xab, 0x89, 0x54,
-	0xb3, 0x43, 0xa1, 0x0a, 0x3b, 0x04, 0xc2, 0x36, 0x4a, 0xc5, 0x12, 0x63, 0x6e, 0x3d, 0xa5, 0x4d,
-	0x0e, 0x07, 0x3a, 0xcd, 0x33, 0x12, 0x23, 0xd3, 0xeb, 0x3c, 0x48, 0x64, 0xc0, 0x79, 0x12, 0x7a,
-	0x7e, 0x91, 0x60, 0x17, 0x3c, 0xcd, 0x6d, 0x0b, 0xbf, 0x1f, 0x46, 0x3f, 0xe2, 0x3e, 0x66, 0x18,
-	0x86, 0x21, 0xc8, 0x72, 0xbb, 0xb1, 0x75, 0x00, 0xdd, 0xa7, 0xaa, 0xd1, 0x1f, 0x26, 0x78, 0x01,
-	0x65, 0x5c, 0x89, 0xb5, 0x6b, 0xa8, 0x93, 0xb2, 0xb3, 0xc0, 0xda, 0x06, 0x5b, 0x85, 0x25, 0x8c,
-	0x46, 0x89, 0x32, 0xb7, 0x9e, 0x00, 0x9b, 0x6d, 0x51, 0xe8, 0xb4, 0xd2, 0x60, 0xbb, 0x86, 0x96,
-	0x7c, 0xc6, 0x2f, 0xf1, 0x9b, 0x62, 0xf8, 0x70, 0x14, 0xc5, 0x29, 0x27, 0x9a, 0x8a, 0x21, 0xfd,
-	0x14, 0x87, 0x08, 0x6b, 0xeb, 0xe9, 0x54, 0x33, 0x3f, 0x4c, 0xb4, 0x74, 0x27, 0xd8, 0xae, 0x51,
-	0xf2, 0x91, 0x14, 0x81, 0x90, 0x0e, 0x24, 0x31, 0x02, 0x63, 0xa2, 0xa2, 0xdd, 0x90, 0x7b, 0xa9,
-	0x80, 0xad, 0x9d, 0x3f, 0x36, 0xa1, 0x29, 0x66, 0x56, 0xf6, 0x11, 0x74, 0xb5, 0x3f, 0x66, 0x32,
-	0xea, 0xb4, 0xb3, 0x7f, 0x7a, 0x5d, 0xff, 0xbf, 0x19, 0xbc, 0x68, 0x0f, 0x4e, 0x8d, 0x7d, 0x08,
-	0x50, 0xee, 0xa8, 0xec, 0x26, 0x0d, 0x3e, 0xd3, 0x3b, 0xeb, 0x7a, 0x9f, 0x7e, 0xdd, 0x98, 0xf3,
-	0x87, 0x5a, 0xa7, 0xc6, 0xbe, 0x07, 0x4b, 0xb2, 0x07, 0x89, 0xd4, 0x62, 0x03, 0x6d, 0xc3, 0x98,
-	0xb3, 0x7d, 0x5e, 0x2b, 0xec, 0x93, 0x42, 0x98, 0x48, 0x1f, 0xd6, 0x9f, 0xb3, 0xae, 0x08, 0x31,
-	0xaf, 0x2c, 0x5c, 0x64, 0x9c, 0x1a, 0xdb, 0x87, 0xae, 0x58, 0x37, 0x44, 0x67, 0xbd, 0x8d, 0xbc,
-	0x8b, 0xf6, 0x8f, 0x6b, 0x0d, 0xda, 0x85, 0x9e, 0xbe, 0x21, 0x30, 0xf2, 0xe4, 0x9c, 0x55, 0x42,
-	0x08, 0x99, 0xb7, 0x4c, 0x38, 0x35, 0xe6, 0xc1, 0xad, 0xf9, 0x73, 0x3e, 0x7b, 0xbd, 0xfc, 0x19,
-	0x76, 0xc1, 0x62, 0xb1, 0xee, 0x5c, 0xc7, 0x52, 0xa8, 0xf8, 0x01, 0xf4, 0x0b, 0xe5, 0x45, 0x5a,
-	0xcb, 0xac, 0x18, 0x48, 0xd3, 0x16, 0xac, 0x06, 0xeb, 0xaf, 0x2d, 0xa4, 0x17, 0xe2, 0x8f, 0x61,
-	0xb5, 0x64, 0x88, 0x85, 0xfb, 0xd8, 0x9d, 0x99, 0x73, 0x15, 0xb7, 0x0e, 0x16, 0x91, 0x0b, 0xa9,
-	0x3f, 0x2c, 0x97, 0xdb, 0xaa, 0xe4, 0xd7, 0xf5, 0xd8, 0xce, 0x97, 0xee, 0x5c, 0xc7, 0xa2, 0x34,
-	0xdc, 0xeb, 0x7f, 0xf1, 0xd5, 0xc0, 0xf8, 0xf2, 0xab, 0x81, 0xf1, 0xcf, 0xaf, 0x06, 0xc6, 0x2f,
-	0x9e, 0x0f, 0x6a, 0x5f, 0x3e, 0x1f, 0xd4, 0xfe, 0xf1, 0x7c, 0x50, 0x3b, 0x69, 0xd2, 0xbf, 0x2b,
-	0x7c, 0xf3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xfc, 0xb2, 0x14, 0xc0, 0x20, 0x00, 0x00,
+	// 3089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0x1c, 0xc7,
+	0xb1, 0x3b, 0xfb, 0xbd, 0xb5, 0xfc, 0x18, 0xb5, 0x28, 0x79, 0x44, 0x4b, 0x6b, 0x79, 0x64, 0xf8,
+	0xd1, 0xc4, 0x7b, 0xc2, 0x33, 0xed, 0x07, 0x3f, 0x18, 0x78, 0xcf, 0xb6, 0x48, 0x59, 0x92, 0xb3,
+	0x32, 0xa5, 0x21, 0xad, 0x9c, 0x02, 0x64, 0x38, 0xdb, 0x5c, 0x4e, 0x38, 0x3b, 0x33, 0x9a, 0x9e,
+	0x25, 0x4d, 0x04, 0x41, 0x2e, 0x41, 0xae, 0xf1, 0x25, 0x01, 0x92, 0x5b, 0x02, 0xe4, 0x9a, 0x9f,
+	0x90, 0x63, 0xec, 0x53, 0x60, 0xe4, 0x94, 0x63, 0x60, 0xff, 0x87, 0x1c, 0x83, 0xa0, 0xaa, 0xbb,
+	0x67, 0x7a, 0xf6, 0x83, 0xb2, 0x02, 0xe4, 0x36, 0xf5, 0xd1, 0xd5, 0xd5, 0x55, 0xd5, 0xf5, 0xd1,
+	0xbb, 0xb0, 0x36, 0x9a, 0x9c, 0x27, 0xd9, 0x29, 0xcf, 0xee, 0xa6, 0x59, 0x92, 0x27, 0xac, 0x9e,
+	0x1e, 0xb9, 0x5b, 0xc0, 0x9e, 0x4e, 0x79, 0x76, 0x71, 0x90, 0xfb, 0xf9, 0x54, 0x78, 0xfc, 0xf9,
+	0x94, 0x8b, 0x9c, 0x31, 0x68, 0xc6, 0xfe, 0x84, 0x3b, 0xd6, 0x6d, 0x6b, 0xab, 0xe7, 0xd1, 0xb7,
+	0x9b, 0xc2, 0xc6, 0x6e, 0x32, 0x99, 0x24, 0xf1, 0xf7, 0x49, 0x86, 0xc7, 0x45, 0x9a, 0xc4, 0x82,
+	0xb3, 0xeb, 0xd0, 0xce, 0xb8, 0x98, 0x46, 0x39, 0x71, 0x77, 0x3d, 0x05, 0x31, 0x1b, 0x1a, 0x13,
+	0x31, 0x76, 0xea, 0x24, 0x02, 0x3f, 0x91, 0x53, 0x24, 0xd3, 0x2c, 0xe0, 0x4e, 0x83, 0x90, 0x0a,
+	0x42, 0xbc, 0xd4, 0xcb, 0x69, 0x4a, 0xbc, 0x84, 0xdc, 0x3f, 0x58, 0x70, 0xb5, 0xa2, 0xdc, 0x4b,
+	0xef, 0xf8, 0x2e, 0xac, 0xc8, 0x3d, 0xa4, 0x04, 0xda, 0xb7, 0xbf, 0x63, 0xdf, 0x4d, 0x8f, 0xee,
+	0x1e, 0x18, 0x78, 0xaf, 0xc2, 0xc5, 0xde, 0x83, 0x55, 0x31, 0x3d, 0x3a, 0xf4, 0xc5, 0xa9, 0x5a,
+	0xd6, 0xbc, 0xdd, 0xd8, 0xea, 0xef, 0x5c, 0xa1, 0x65, 0x26, 0xc1, 0xab, 0xf2, 0xb9, 0xbf, 0xb7,
+	0xa0, 0xbf, 0x7b, 0xc2, 0x03, 0x05, 0xa3, 0xa2, 0xa9, 0x2f, 0x04, 0x1f, 0x69, 0x45, 0x25, 0xc4,
+	0x36, 0xa0, 0x95, 0x27, 0xb9, 0x1f, 0x91, 0xaa, 0x2d, 0x4f, 0x02, 0x6c, 0x00, 0x20, 0xa6, 0x41,
+	0xc0, 0x85, 0x38, 0x9e, 0x46, 0xa4, 0x6a, 0xcb, 0x33, 0x30, 0x28, 0xed, 0xd8, 0x0f, 0x23, 0x3e,
+	0x22, 0x33, 0xb5, 0x3c, 0x05, 0x31, 0x07, 0x3a, 0xe7, 0x7e, 0x16, 0x87, 0xf1, 0xd8, 0x69, 0x11,
+	0x41, 0x83, 0xb8, 0x62, 0xc4, 0x73, 0x3f, 0x8c, 0x9c, 0xf6, 0x6d, 0x6b, 0x6b, 0xc5, 0x53, 0x90,
+	0xfb, 0x0f, 0x0b, 0x60, 0x6f, 0x3a, 0x49, 0x95, 0x9a, 0xb7, 0xa1, 0x4f, 0x1a, 0x1c, 0xfa, 0x47,
+	0x11, 0x17, 0xa4, 0x6b, 0xc3, 0x33, 0x51, 0x6c, 0x0b, 0xd6, 0x83, 0x64, 0x92, 0x46, 0x3c, 0xe7,
+	0x23, 0xc5, 0x85, 0xaa, 0x5b, 0xde, 0x2c, 0x9a, 0xbd, 0x01, 0xab, 0xc7, 0x61, 0x1c, 0x8a, 0x13,
+	0x3e, 0xba, 0x77, 0x91, 0x73, 0x69, 0x72, 0xcb, 0xab, 0x22, 0x99, 0x0b, 0x2b, 0x1a, 0xe1, 0x25,
+	0xe7, 0x82, 0x0e, 0x64, 0x79, 0x15, 0x1c, 0xfb, 0x4f, 0xb8, 0xc2, 0x45, 0x1e, 0x4e, 0xfc, 0x9c,
+	0x1f, 0xa2, 0x2a, 0xc4, 0xd8, 0x22, 0xc6, 0x79, 0x02, 0xfa, 0xfe, 0x28, 0x15, 0x74, 0xce, 0x86,
+	0x87, 0x9f, 0x6c, 0x13, 0xba, 0x69, 0x96, 0x8c, 0x33, 0x2e, 0x84, 0xd3, 0xa1, 0x90, 0x28, 0x60,
+	0xf7, 0x2b, 0x0b, 0x60, 0x98, 0xf8, 0x23, 0x65, 0x80, 0x39, 0xa5, 0xa5, 0x09, 0x66, 0x94, 0x1e,
+	0x00, 0x90, 0x4d, 0x24, 0x4b, 0x9d, 0x58, 0x0c, 0x4c, 0x65, 0xc3, 0x46, 0x75, 0x43, 0x5c, 0x3b,
+	0xe1, 0xb9, 0x7f, 0x2f, 0x8c, 0xa3, 0x64, 0xac, 0xc2, 0xdc, 0xc0, 0xb0, 0x37, 0x61, 0xad, 0x84,
+	0x1e, 0x1c, 0x3e, 0xda, 0xa3, 0x93, 0xf6, 0xbc, 0x19, 0xec, 0xfc, 0x31, 0xdd, 0x5f, 0x5a, 0xb0,
+	0x7a, 0x70, 0xe2, 0x67, 0xa3, 0x30, 0x1e, 0x3f, 0xc8, 0x92, 0x69, 0x8a, 0x5e, 0xcf, 0xfd, 0x6c,
+	0xcc, 0x73, 0x75, 0x7d, 0x15, 0x84, 0x97, 0x7a, 0x6f, 0x6f, 0x88, 0x9a, 0x37, 0xf0, 0x52, 0xe3,
+	0xb7, 0x3c, 0x79, 0x26, 0xf2, 0x61, 0x12, 0xf8, 0x79, 0x98, 0xc4, 0x4a, 0xf1, 0x2a, 0x92, 0x2e,
+	0xee, 0x45, 0x1c, 0x50, 0xe4, 0x35, 0xe8, 0xe2, 0x12, 0x84, 0x27, 0x9e, 0xc6, 0x8a, 0xd2, 0x22,
+	0x4a, 0x01, 0xbb, 0x5f, 0xb6, 0x01, 0x0e, 0x2e, 0xe2, 0x60, 0x26, 0xc6, 0xee, 0x9f, 0xf1, 0x38,
+	0xaf, 0xc6, 0x98, 0x44, 0xa1, 0x30, 0x19, 0x72, 0xa9, 0x36, 0x6e, 0x01, 0xb3, 0x9b, 0xd0, 0xcb,
+	0x78, 0xc0, 0xe3, 0x1c, 0x89, 0x0d, 0x22, 0x96, 0x08, 0x8c, 0xa6, 0x89, 0x2f, 0x72, 0x9e, 0x55,
+	0xcc, 0x5b, 0xc1, 0xb1, 0x6d, 0xb0, 0x4d, 0xf8, 0x41, 0x1e, 0x8e, 0x94, 0x89, 0xe7, 0xf0, 0x28,
+	0x8f, 0x0e, 0xa1, 0xe5, 0xb5, 0xa5, 0x3c, 0x13, 0x87, 0xf2, 0x4c, 0x98, 0xe4, 0xc9, 0x28, 0x9b,
+	0xc3, 0xa3, 0xbc, 0xa3, 0x28, 0x09, 0x4e, 0xc3, 0x78, 0x4c, 0x0e, 0xe8, 0x92, 0xa9, 0x2a, 0x38,
+	0xf6, 0x7f, 0x60, 0x4f, 0xe3, 0x8c, 0x8b, 0x24, 0x3a, 0xe3, 0x23, 0xf2, 0xa3, 0x70, 0x7a, 0x46,
+	0xda, 0x31, 0x3d, 0xec, 0xcd, 0xb1, 0x1a, 0x1e, 0x02, 0x99, 0x69, 0x24, 0x84, 0x71, 0x77, 0x44,
+	0x8a, 0x1c, 0x5e, 0xa4, 0xdc, 0xe9, 0xcb, 0xb8, 0x2b, 0x31, 0xec, 0xbf, 0xe1, 0xaa, 0xe0, 0x41,
+	0x12, 0x8f, 0xc4, 0x3d, 0x7e, 0x12, 0xc6, 0xa3, 0xc7, 0x64, 0x0b, 0x67, 0x85, 0x4c, 0xbc, 0x88,
+	0x84, 0x11, 0x43, 0x8a, 0xef, 0xed, 0x0d, 0xf7, 0xcf, 0x63, 0x9e, 0x39, 0xab, 0x32, 0x62, 0x2a,
+	0x48, 0x74, 0x77, 0x90, 0xc4, 0xc7, 0x51, 0x18, 0xe4, 0x8f, 0xc5, 0xd8, 0x59, 0x23, 0x1e, 0x13,
+	0x85, 0x2e, 0xcd, 0x8b, 0x6b, 0xbd, 0x2e, 0x5d, 0x5a, 0x20, 0x8a, 0x60, 0xf0, 0x52, 0xe1, 0xd8,
+	0x46, 0x30, 0x78, 0x66, 0x30, 0x20, 0xf1, 0x8a, 0x19, 0x0c, 0x5e, 0xaa, 0x23, 0x9a, 0x8f, 0xf6,
+	0xf6, 0x86, 0x0f, 0x93, 0xe4, 0x54, 0x38, 0x8c, 0xac, 0x5d, 0x45, 0xe2, 0xb9, 0x4f, 0xfc, 0x78,
+	0x14, 0xf1, 0xfb, 0x59, 0x96, 0x64, 0x4f, 0xf4, 0xb5, 0xbd, 0x4a, 0x7a, 0x2e, 0x22, 0x61, 0x0a,
+	0x4c, 0xfd, 0xa9, 0xe0, 0x1f, 0xe5, 0x05, 0xf7, 0x06, 0x71, 0xcf, 0xa2, 0xd1, 0xdd, 0x39, 0x26,
+	0xc3, 0xc3, 0x29, 0x26, 0x61, 0xe1, 0x5c, 0x93, 0xee, 0x36, 0x71, 0x18, 0x3e, 0xd3, 0x98, 0x7f,
+	0x9e, 0xf2, 0xa0, 0xcc, 0xa8, 0xd7, 0x89, 0x6f, 0x0e, 0xef, 0xfe, 0xd9, 0x82, 0x15, 0xb3, 0x5a,
+	0x19, 0x75, 0xd4, 0x5a, 0x52, 0x47, 0xeb, 0x66, 0x1d, 0x65, 0x6f, 0x15, 0xf5, 0x52, 0xd6, 0x3f,
+	0x8a, 0xa8, 0x27, 0x59, 0x82, 0x85, 0xc5, 0x23, 0x42, 0x51, 0x42, 0xdf, 0x86, 0x7e, 0xc6, 0x23,
+	0xff, 0xa2, 0x28, 0x7c, 0xc8, 0xbf, 0x8e, 0xfc, 0x5e, 0x89, 0xf6, 0x4c, 0x1e, 0x34, 0xe5, 0x34,
+	0x15, 0x79, 0xc6, 0xfd, 0xc9, 0x6e, 0x12, 0xc7, 0x3c, 0xc0, 0x94, 0x21, 0xd4, 0xe5, 0x5a, 0x44,
+	0x72, 0xbf, 0xac, 0x43, 0xdf, 0x10, 0x37, 0x77, 0x7f, 0xad, 0xef, 0x78, 0x7f, 0xeb, 0x4b, 0xee,
+	0xef, 0x6d, 0x7d, 0x88, 0xe9, 0xd1, 0x5e, 0x98, 0xa9, 0x94, 0x66, 0xa2, 0x0a, 0x8e, 0x4a, 0xc2,
+	0x30, 0x51, 0xe8, 0x6e, 0x03, 0x34, 0xd2, 0xc5, 0x2c, 0x9a, 0xdd, 0x05, 0x46, 0xa8, 0x5d, 0x3f,
+	0x0f, 0x4e, 0x3e, 0x4b, 0xd5, 0x0d, 0x6a, 0xd3, 0x35, 0x5c, 0x40, 0x61, 0xaf, 0x41, 0x4b, 0xe4,
+	0xfe, 0x98, 0x53, 0xba, 0x58, 0xdb, 0xe9, 0xd1, 0xf5, 0x46, 0x84, 0x27, 0xf1, 0x86, 0xbb, 0xba,
+	0x2f, 0x70, 0x97, 0xfb, 0x45, 0x13, 0x56, 0x2b, 0x1d, 0xc9, 0xa2, 0xce, 0xad, 0xdc, 0xb1, 0xbe,
+	0x64, 0xc7, 0xdb, 0xd0, 0x9c, 0xc6, 0xa1, 0x0c, 0x8f, 0xb5, 0x9d, 0x15, 0xa4, 0x7f, 0x16, 0x87,
+	0x39, 0x66, 0x08, 0x8f, 0x28, 0x86, 0x4e, 0xcd, 0x17, 0x85, 0x10, 0xc6, 0x43, 0x91, 0x9e, 0xf6,
+	0xf6, 0x86, 0xc3, 0x24, 0x38, 0x2d, 0xea, 0xd9, 0x22, 0x12, 0x63, 0xb2, 0x6f, 0xa3, 0x34, 0xfb,
+	0xb0, 0x26, 0x3b, 0xb7, 0xff, 0x80, 0x56, 0x80, 0x9d, 0x94, 0xd3, 0x29, 0x43, 0xd0, 0x68, 0xad,
+	0x1e, 0xd6, 0x3c, 0x49, 0x67, 0x6f, 0x40, 0x73, 0x34, 0x9d, 0xa4, 0xca, 0x56, 0x6b, 0xc8, 0x57,
+	0xb6, 0x36, 0x0f, 0x6b, 0x1e, 0x51, 0x91, 0x2b, 0x4a, 0xfc, 0x91, 0xd3, 0x2b, 0xb9, 0xca, 0xfa,
+	0x8f, 0x5c, 0x48, 0x45, 0x2e, 0xcc, 0x9b, 0x0e, 0x94, 0x5c, 0x65, 0x09, 0x43, 0x2e, 0xa4, 0xb2,
+	0x77, 0x01, 0xce, 0xfc, 0x28, 0x1c, 0xc9, 0x82, 0xd9, 0x27, 0xde, 0x0d, 0xe4, 0x7d, 0x56, 0x60,
+	0xd5, 0x3d, 0x31, 0xf8, 0xb0, 0x9d, 0xf1, 0xa7, 0x79, 0x82, 0xc6, 0x9a, 0xf0, 0x7b, 0x19, 0xf7,
+	0x4f, 0x55, 0x9e, 0xed, 0x79, 0xf3, 0x04, 0x0c, 0xaa, 0x98, 0x7f, 0x9e, 0x7f, 0x54, 0x10, 0x0e,
+	0xc3, 0x09, 0x57, 0xa9, 0x76, 0x01, 0xe5, 0x5e, 0x17, 0xda, 0x42, 0xf6, 0xa0, 0xff, 0x0f, 0x57,
+	0x2a, 0x11, 0x31, 0x0c, 0x05, 0xb9, 0x4f, 0x92, 0x1d, 0x6b, 0x59, 0x2b, 0xab, 0xd7, 0x0f, 0x00,
+	0xc8, 0xce, 0x94, 0xfd, 0x74, 0x4b, 0x6d, 0x15, 0x2d, 0xb5, 0x7b, 0x0b, 0x7a, 0x68, 0xdf, 0x4b,
+	0xc8, 0x68, 0xd8, 0x65, 0xe4, 0x14, 0x56, 0xc8, 0xa2, 0x4f, 0x87, 0x4b, 0x38, 0xd8, 0x0e, 0x6c,
+	0xc8, 0xbe, 0x56, 0x5e, 0xb1, 0x27, 0x89, 0x08, 0xc9, 0xce, 0xf2, 0xb2, 0x2f, 0xa4, 0x61, 0xb5,
+	0xe0, 0x28, 0xee, 0xe0, 0xe9, 0x50, 0x77, 0x5e, 0x1a, 0x76, 0xff, 0x07, 0x7a, 0xb8, 0xa3, 0xdc,
+	0x6e, 0x0b, 0xda, 0x44, 0xd0, 0x76, 0xb0, 0x0b, 0x17, 0x2b, 0x85, 0x3c, 0x45, 0x77, 0x7f, 0x61,
+	0x41, 0x5f, 0x26, 0x5d, 0xb9, 0xf2, 0x65, 0x73, 0xee, 0xed, 0xca, 0x72, 0x9d, 0x83, 0x4c, 0x89,
+	0x77, 0x01, 0x28, 0x09, 0x4a, 0x86, 0x66, 0x19, 0x72, 0x25, 0xd6, 0x33, 0x38, 0xd0, 0x31, 0x25,
+	0xb4, 0xc0, 0xb4, 0xbf, 0xae, 0xc3, 0x8a, 0x72, 0xa9, 0x64, 0xf9, 0x37, 0xa5, 0x02, 0x75, 0x5b,
+	0x9b, 0xe6, 0x6d, 0x7d, 0x53, 0xdf, 0xd6, 0x56, 0x79, 0x8c, 0x32, 0x8a, 0xca, 0xcb, 0x7a, 0x47,
+	0x5d, 0xd6, 0x36, 0xb1, 0xad, 0xea, 0xcb, 0xaa, 0xb9, 0x88, 0x88, 0x4c, 0x74, 0x57, 0x3b, 0x25,
+	0x53, 0x11, 0x52, 0xc5, 0x55, 0xbd, 0xa3, 0xae, 0x6a, 0xb7, 0x64, 0x2a, 0xdc, 0xac, 0x6f, 0xea,
+	0xbd, 0x0e, 0xb4, 0xc8, 0x9d, 0xee, 0xfb, 0x60, 0x9b, 0xa6, 0xa1, 0x3b, 0xf1, 0xa6, 0x22, 0x56,
+	0x42, 0xc1, 0x60, 0xf2, 0xd4, 0xda, 0xe7, 0xb0, 0x5a, 0x49, 0x74, 0xd8, 0x53, 0x85, 0x62, 0xd7,
+	0x8f, 0x03, 0x1e, 0x15, 0x93, 0x9d, 0x81, 0x31, 0x82, 0xac, 0x5e, 0x4a, 0x56, 0x22, 0x2a, 0x41,
+	0x66, 0xcc, 0x67, 0x8d, 0xca, 0x7c, 0xf6, 0x17, 0x0b, 0x56, 0xcc, 0x05, 0x38, 0xe2, 0xdd, 0xcf,
+	0xb2, 0xdd, 0x64, 0x24, 0xbd, 0xd9, 0xf2, 0x34, 0x88, 0xa1, 0x8f, 0x9f, 0x91, 0x2f, 0x84, 0x8a,
+	0xc0, 0x02, 0x56, 0xb4, 0x83, 0x20, 0x49, 0xf5, 0xc4, 0x5d, 0xc0, 0x8a, 0x36, 0xe4, 0x67, 0x3c,
+	0x52, 0xe5, 0xaf, 0x80, 0x71, 0xb7, 0xc7, 0x5c, 0x08, 0x0c, 0x13, 0x99, 0xb5, 0x35, 0x88, 0xab,
+	0x3c, 0xff, 0x7c, 0xd7, 0x9f, 0x0a, 0xae, 0xba, 0xe2, 0x02, 0x46, 0xb3, 0xe0, 0xcb, 0x80, 0x9f,
+	0x25, 0xd3, 0x58, 0xf7, 0xc2, 0x06, 0xc6, 0x3d, 0x87, 0x2b, 0x4f, 0xa6, 0xd9, 0x98, 0x53, 0x10,
+	0xeb, 0x87, 0x86, 0x4d, 0xe8, 0x86, 0xb1, 0x1f, 0xe4, 0xe1, 0x19, 0x57, 0x96, 0x2c, 0x60, 0x8c,
+	0xdf, 0x1c, 0xb3, 0x9e, 0x1c, 0x06, 0xe8, 0x1b, 0xf9, 0x8f, 0xc3, 0x88, 0x53, 0x5c, 0xab, 0x23,
+	0x69, 0x98, 0xae, 0xa8, 0xac, 0xf8, 0xea, 0x19, 0x41, 0x42, 0xee, 0x6f, 0xea, 0xb0, 0xb9, 0x9f,
+	0xf2, 0xcc, 0xcf, 0xb9, 0x7c, 0xba, 0x38, 0x08, 0x4e, 0xf8, 0xc4, 0xd7, 0x2a, 0xdc, 0x84, 0x7a,
+	0x92, 0x3a, 0x56, 0x19, 0xef, 0x92, 0xbc, 0x9f, 0x7a, 0xf5, 0x24, 0x25, 0x25, 0x7c, 0x71, 0xaa,
+	0x6c, 0x4b, 0xdf, 0x4b, 0xdf, 0x31, 0x36, 0xa1, 0x3b, 0xf2, 0x73, 0xff, 0xc8, 0x17, 0x5c, 0xdb,
+	0x54, 0xc3, 0x34, 0xf2, 0x63, 0x3b, 0xa7, 0x2c, 0x2a, 0x01, 0x92, 0x44, 0xbb, 0x29, 0x6b, 0x2a,
+	0x08, 0xb9, 0x8f, 0xa3, 0xa9, 0x38, 0x21, 0x33, 0x76, 0x3d, 0x09, 0xa0, 0x2e, 0x45, 0xcc, 0x77,
+	0x55, 0x31, 0x1a, 0x00, 0x1c, 0x67, 0xc9, 0x44, 0x26, 0x16, 0x2a, 0x6f, 0x5d, 0xcf, 0xc0, 0x68,
+	0xfa, 0xa1, 0x1c, 0x08, 0xa1, 0xa4, 0x4b, 0x8c, 0x9b, 0xc3, 0xea, 0xb3, 0xb7, 0x55, 0xd8, 0x3f,
+	0xe6, 0xb9, 0xcf, 0x36, 0x0d, 0x73, 0x00, 0x9a, 0x03, 0x29, 0xca, 0x18, 0x2f, 0xcc, 0x1e, 0x3a,
+	0xe5, 0x34, 0x8c, 0x94, 0xa3, 0x2d, 0xd8, 0xa4, 0x10, 0xa7, 0x6f, 0xf7, 0x5d, 0xd8, 0x50, 0x1e,
+	0x79, 0xf6, 0x36, 0xee, 0xba, 0xd4, 0x17, 0x92, 0x2c, 0xb7, 0x77, 0xff, 0x64, 0xc1, 0xb5, 0x99,
+	0x65, 0x2f, 0xfd, 0x22, 0xf4, 0x1e, 0x34, 0x71, 0xa4, 0x76, 0x1a, 0x74, 0x35, 0xef, 0xe0, 0x1e,
+	0x0b, 0x45, 0xde, 0x45, 0xe0, 0x7e, 0x9c, 0x67, 0x17, 0x1e, 0x2d, 0xd8, 0xfc, 0x04, 0x7a, 0x05,
+	0x0a, 0xe5, 0x9e, 0xf2, 0x0b, 0x9d, 0x7d, 0x4f, 0xf9, 0x05, 0xf6, 0x2b, 0x67, 0x7e, 0x34, 0x95,
+	0xa6, 0x51, 0x05, 0xb6, 0x62, 0x58, 0x4f, 0xd2, 0xdf, 0xaf, 0xff, 0xaf, 0xe5, 0xfe, 0x04, 0x9c,
+	0x87, 0x34, 0x62, 0xc8, 0x78, 0x94, 0x49, 0x41, 0x99, 0xe0, 0x55, 0xc3, 0x04, 0x7d, 0x94, 0x42,
+	0xd4, 0x4b, 0xa2, 0xf1, 0x26, 0xf4, 0x8e, 0x74, 0x39, 0x54, 0x86, 0x2f, 0x11, 0xb8, 0x42, 0x3c,
+	0x8f, 0x84, 0x1a, 0xdc, 0xe9, 0xdb, 0xbd, 0x06, 0x57, 0x1f, 0xf0, 0x5c, 0xee, 0xbd, 0x7b, 0x3c,
+	0x56, 0x3b, 0xbb, 0x5b, 0xb0, 0x51, 0x45, 0x2b, 0xe3, 0xda, 0xd0, 0x08, 0x8e, 0x8b, 0x52, 0x13,
+	0x1c, 0x8f, 0xdd, 0x03, 0xb8, 0x25, 0x7b, 0xb1, 0xe9, 0x11, 0xaa, 0x80, 0xa9, 0xef, 0xb3, 0x74,
+	0xe4, 0xe7, 0x5c, 0x1f, 0x62, 0x07, 0x36, 0x84, 0xa4, 0xed, 0x1e, 0x8f, 0x0f, 0x93, 0x49, 0x74,
+	0x90, 0x67, 0x61, 0xac, 0x65, 0x2c, 0xa4, 0xb9, 0x43, 0x18, 0x2c, 0x13, 0xaa, 0x14, 0x71, 0xa0,
+	0xa3, 0x9e, 0xc3, 0x94, 0x9b, 0x35, 0x38, 0xef, 0x67, 0x77, 0x0c, 0x9b, 0x0f, 0x78, 0x3e, 0xd7,
+	0x91, 0x95, 0x69, 0x07, 0xf7, 0xf8, 0xb4, 0x2c, 0x8f, 0x05, 0xcc, 0xfe, 0x0b, 0xdf, 0xa6, 0xa2,
+	0x9c, 0x67, 0x72, 0xc9, 0x7c, 0xac, 0x57, 0xc8, 0xee, 0xcf, 0x1a, 0x60, 0xcf, 0x6e, 0x53, 0xf8,
+	0xc9, 0x5a, 0x98, 0x35, 0xea, 0x95, 0xac, 0xc1, 0xa0, 0x39, 0xc1, 0xc4, 0xae, 0xee, 0x0c, 0x7e,
+	0x97, 0x17, 0xad, 0xb9, 0xe4, 0xa2, 0x6d, 0xc1, 0xba, 0xea, 0x2d, 0x13, 0x3d, 0x35, 0xa9, 0xf1,
+	0x64, 0x06, 0x8d, 0xed, 0xf8, 0x0c, 0x8a, 0x86, 0x19, 0x99, 0x6f, 0x16, 0x91, 0x8c, 0x5e, 0xbf,
+	0xf3, 0x1d, 0x7a, 0xfd, 0x54, 0x12, 0xe4, 0xa3, 0x9d, 0x32, 0x59, 0x57, 0x0a, 0x5f, 0x40, 0xc2,
+	0x36, 0x38, 0xe5, 0x31, 0x3e, 0x65, 0x18, 0xfc, 0x3d, 0xd9, 0x06, 0xcf, 0x11, 0xf0, 0x98, 0x54,
+	0x2a, 0x0d, 0x5e, 0x90, 0xc7, 0x9c, 0x41, 0xbb, 0xbf, 0xb3, 0xe0, 0x5a, 0xe9, 0x06, 0x9a, 0x9c,
+	0x5f, 0x30, 0x2d, 0x6f, 0x42, 0x57, 0x64, 0x01, 0x71, 0xea, 0xca, 0xa9, 0x61, 0xa4, 0x8d, 0x44,
+	0x2e, 0x69, 0xaa, 0xcc, 0x68, 0xf8, 0xc5, 0xbe, 0x71, 0xa0, 0x33, 0xa9, 0x96, 0x4f, 0x05, 0xba,
+	0x7f, 0xb4, 0xe0, 0xd5, 0x85, 0x51, 0xf9, 0x2f, 0x3c, 0x6c, 0x43, 0xe1, 0x3a, 0xa1, 0x92, 0xd9,
+	0xe5, 0x33, 0x08, 0xf6, 0x1b, 0x1f, 0xc0, 0x6a, 0x5e, 0x5a, 0x86, 0xeb, 0x87, 0xed, 0x1b, 0xd5,
+	0x85, 0x86, 0xf1, 0xbc, 0x2a, 0xbf, 0x7b, 0x0a, 0x37, 0x2a, 0xfa, 0x57, 0x32, 0xd7, 0x0e, 0x75,
+	0xe1, 0xc8, 0xcb, 0x55, 0xfe, 0xba, 0x6e, 0x08, 0x96, 0x5d, 0x2f, 0x51, 0xbd, 0x82, 0xaf, 0x72,
+	0x11, 0xeb, 0xd5, 0x8b, 0xe8, 0xfe, 0xb6, 0x0e, 0xeb, 0x33, 0x5b, 0xb1, 0x35, 0xa8, 0x87, 0x23,
+	0xe5, 0xc8, 0x7a, 0x38, 0x5a, 0x7a, 0xa9, 0x4c, 0xe7, 0x36, 0x66, 0x9c, 0x8b, 0x69, 0x24, 0x0b,
+	0xf6, 0xfc, 0xdc, 0x57, 0x55, 0x5a, 0x83, 0x15, 0xb7, 0xb7, 0x66, 0xdc, 0xee, 0x40, 0x67, 0x24,
+	0x72, 0x5a, 0x25, 0xef, 0x8e, 0x06, 0x31, 0x01, 0x53, 0x34, 0xd2, 0x13, 0x9b, 0xec, 0x7b, 0x4a,
+	0x04, 0xbb, 0x5b, 0x8c, 0x5e, 0xdd, 0x4b, 0x6d, 0xa2, 0xb8, 0x8a, 0xae, 0xa7, 0xa7, 0x52, 0x47,
+	0x38, 0xa9, 0x44, 0x14, 0x54, 0x23, 0xea, 0xf9, 0x4c, 0x9a, 0x53, 0x0e, 0x79, 0xe9, 0x78, 0x7a,
+	0x4b, 0x37, 0xc3, 0x32, 0x94, 0xae, 0x56, 0x23, 0xa2, 0xd2, 0x0f, 0xff, 0xca, 0x82, 0x5b, 0xba,
+	0x64, 0x2e, 0x0e, 0x84, 0x3b, 0x46, 0x09, 0x9b, 0x97, 0xa4, 0x4a, 0x19, 0x75, 0xd1, 0x1f, 0x45,
+	0x11, 0xad, 0x74, 0xea, 0xba, 0x8b, 0xd6, 0x98, 0x4a, 0x64, 0x34, 0x66, 0x52, 0xf4, 0x06, 0x69,
+	0xfb, 0x48, 0xfe, 0x10, 0xd2, 0xf4, 0x24, 0xe0, 0x7e, 0x02, 0x83, 0x65, 0x7a, 0xbd, 0xac, 0x3d,
+	0xdc, 0x4f, 0xe0, 0xba, 0x2c, 0x3e, 0xb2, 0x8d, 0x2a, 0xab, 0x24, 0xbd, 0x98, 0x6a, 0xdc, 0x5c,
+	0x65, 0x5b, 0x44, 0x72, 0x7f, 0x0c, 0x37, 0x66, 0x64, 0xe1, 0x44, 0xa5, 0x86, 0x89, 0x45, 0x95,
+	0x42, 0xcf, 0x60, 0xf5, 0xa5, 0x33, 0xd8, 0xf5, 0xca, 0x8b, 0xde, 0xdc, 0x41, 0x9a, 0xe5, 0x41,
+	0x3e, 0x87, 0x57, 0xe6, 0x0e, 0xf2, 0xd2, 0xd1, 0xf1, 0x0e, 0xb4, 0x70, 0x5b, 0x9d, 0x68, 0x6e,
+	0x91, 0x46, 0xcb, 0x8e, 0xe4, 0x49, 0xde, 0xed, 0x53, 0x68, 0xcb, 0xd6, 0x91, 0xad, 0x42, 0xef,
+	0x51, 0x4c, 0x69, 0x68, 0x3f, 0xb5, 0x6b, 0xac, 0x0b, 0xcd, 0x83, 0x3c, 0x49, 0x6d, 0x8b, 0xf5,
+	0xa0, 0xf5, 0xc4, 0x9f, 0x0a, 0x6e, 0xd7, 0x19, 0x40, 0x5b, 0x3e, 0x67, 0xd8, 0x0d, 0x44, 0x1f,
+	0xe4, 0x7e, 0x96, 0xdb, 0x4d, 0x44, 0xcb, 0x8d, 0xec, 0x16, 0x5b, 0x03, 0x28, 0x5f, 0x3d, 0xec,
+	0x36, 0xd2, 0xf6, 0x78, 0xc4, 0x73, 0x6e, 0x77, 0xb6, 0x7f, 0x4a, 0x4b, 0xc6, 0xd8, 0xac, 0xac,
+	0xa8, 0xbd, 0x08, 0xb6, 0x6b, 0xac, 0x03, 0x8d, 0x4f, 0xf9, 0xb9, 0x6d, 0xb1, 0x3e, 0x74, 0xbc,
+	0x69, 0x8c, 0xef, 0xaf, 0x72, 0x3f, 0xda, 0x7a, 0x64, 0x37, 0x90, 0x80, 0x0a, 0xa5, 0x7c, 0x64,
+	0x37, 0xd9, 0x0a, 0x74, 0x3f, 0x56, 0x3f, 0xfb, 0xd8, 0x2d, 0x24, 0x21, 0x1b, 0xae, 0x69, 0x23,
+	0x89, 0x36, 0x47, 0xa8, 0x83, 0x10, 0xad, 0x42, 0xa8, 0xbb, 0xbd, 0x0f, 0x5d, 0xed, 0x23, 0xb6,
+	0x0e, 0x7d, 0xa5, 0x03, 0xa2, 0xec, 0x1a, 0x1e, 0x88, 0x5a, 0x1b, 0xdb, 0xc2, 0xc3, 0xe3, 0xc4,
+	0x6b, 0xd7, 0xf1, 0x0b, 0xc7, 0x5a, 0xbb, 0x41, 0x06, 0xb9, 0x88, 0x03, 0xbb, 0x89, 0x8c, 0x34,
+	0x1e, 0xd9, 0xa3, 0xed, 0xc7, 0xd0, 0xa1, 0xcf, 0x7d, 0xec, 0xfa, 0xd6, 0x94, 0x3c, 0x85, 0xb1,
+	0x6b, 0x68, 0x53, 0xdc, 0x5d, 0x72, 0x5b, 0x68, 0x1b, 0x3a, 0x8e, 0x84, 0xeb, 0xa8, 0x82, 0xb4,
+	0x93, 0x44, 0x34, 0xb6, 0x7f, 0x6e, 0x41, 0x57, 0x0f, 0x36, 0xec, 0x2a, 0xac, 0x6b, 0x23, 0x29,
+	0x94, 0x94, 0xf8, 0x80, 0xe7, 0x12, 0x61, 0x5b, 0xb4, 0x41, 0x01, 0xd6, 0xd1, 0xae, 0x1e, 0x9f,
+	0x24, 0x67, 0x5c, 0x61, 0x1a, 0xb8, 0x25, 0xce, 0xd1, 0x0a, 0x6e, 0xe2, 0x82, 0x61, 0xa8, 0xb2,
+	0xa5, 0xdd, 0x62, 0xd7, 0x81, 0x21, 0xf8, 0x38, 0x1c, 0xe3, 0x8d, 0x94, 0xd3, 0x86, 0xb0, 0xdb,
+	0xdb, 0x1f, 0x42, 0x57, 0x37, 0xf5, 0x86, 0x1e, 0x1a, 0x55, 0xe8, 0x21, 0x11, 0xb6, 0x55, 0x6e,
+	0xac, 0x30, 0xf5, 0xed, 0x67, 0xd0, 0x51, 0x3d, 0xb1, 0x61, 0x19, 0x85, 0x51, 0xe1, 0x75, 0x1a,
+	0xa6, 0xca, 0xe1, 0x3c, 0x8d, 0xfc, 0xa0, 0x08, 0xb0, 0x33, 0x9e, 0xe5, 0x76, 0x03, 0xbf, 0x1f,
+	0xc5, 0x3f, 0xe2, 0x01, 0x46, 0x18, 0xba, 0x21, 0x14, 0xb9, 0xdd, 0xda, 0x1e, 0x42, 0xff, 0x99,
+	0xae, 0x95, 0xfb, 0xf8, 0x33, 0x1a, 0xd3, 0xca, 0x95, 0x58, 0xbb, 0x86, 0x7b, 0x52, 0x74, 0x16,
+	0x58, 0xdb, 0x62, 0x57, 0x60, 0x15, 0xbd, 0x51, 0xa2, 0xea, 0xdb, 0x4f, 0x81, 0xcd, 0x67, 0x79,
+	0x34, 0x5a, 0xa9, 0xb0, 0x5d, 0x43, 0x4d, 0x3e, 0xe5, 0xe7, 0xf8, 0x4d, 0x3e, 0x7c, 0x34, 0x8e,
+	0x93, 0x8c, 0x13, 0x4d, 0xfb, 0x90, 0xde, 0x4a, 0x11, 0xd1, 0xd8, 0x7e, 0x36, 0x53, 0x0f, 0xf7,
+	0x53, 0x23, 0xdc, 0x09, 0xb6, 0x6b, 0x14, 0x7c, 0x24, 0x45, 0x22, 0x94, 0x01, 0x49, 0x8c, 0xc4,
+	0xd4, 0x71, 0xa3, 0xdd, 0x88, 0xfb, 0x99, 0x84, 0x1b, 0x3b, 0x7f, 0x6f, 0x43, 0x5b, 0xb6, 0xfd,
+	0xec, 0x43, 0xe8, 0x1b, 0xbf, 0xb8, 0x33, 0x2a, 0x56, 0xf3, 0xff, 0x0f, 0xd8, 0x7c, 0x65, 0x0e,
+	0x2f, 0x73, 0x8a, 0x5b, 0x63, 0x1f, 0x00, 0x94, 0x63, 0x3e, 0xbb, 0x46, 0xbd, 0xe3, 0xec, 0xd8,
+	0xbf, 0xe9, 0x20, 0x7a, 0xd1, 0xbf, 0x09, 0xdc, 0x1a, 0xfb, 0x1e, 0xac, 0xaa, 0x34, 0x2e, 0x43,
+	0x8b, 0x0d, 0x8c, 0x21, 0x6d, 0xc1, 0x00, 0x7f, 0xa9, 0xb0, 0x8f, 0x0b, 0x61, 0x32, 0x7c, 0x98,
+	0xb3, 0x60, 0xe2, 0x93, 0x62, 0x6e, 0x2c, 0x9d, 0x05, 0xdd, 0x1a, 0x7b, 0x00, 0xfd, 0x87, 0xe5,
+	0x8f, 0x42, 0xec, 0x26, 0xf2, 0x2e, 0x1b, 0xe1, 0x2e, 0x55, 0x68, 0x17, 0x56, 0xcc, 0x21, 0x8b,
+	0x91, 0x25, 0x17, 0x4c, 0x63, 0x9b, 0xce, 0x3c, 0xa1, 0x10, 0xe2, 0xc3, 0xf5, 0xc5, 0xa3, 0x12,
+	0x7b, 0xbd, 0x7c, 0x27, 0x5f, 0x32, 0x9b, 0x6d, 0xba, 0x97, 0xb1, 0x14, 0x5b, 0xfc, 0x00, 0x9c,
+	0x62, 0xf3, 0x22, 0xac, 0x55, 0x54, 0x0c, 0x94, 0x6a, 0x4b, 0xa6, 0xab, 0xcd, 0xd7, 0x96, 0xd2,
+	0x0b, 0xf1, 0x87, 0x70, 0xa5, 0x64, 0x48, 0xa4, 0xf9, 0xd8, 0xad, 0xb9, 0x75, 0x15, 0xb3, 0x0e,
+	0x96, 0x91, 0x0b, 0xa9, 0x3f, 0x2c, 0xdf, 0x07, 0xaa, 0x92, 0x5f, 0x37, 0x7d, 0xbb, 0x58, 0xba,
+	0x7b, 0x19, 0x4b, 0xb1, 0xc3, 0x10, 0xd6, 0x67, 0x0a, 0x1f, 0xdb, 0x5c, 0x50, 0x0d, 0xb5, 0xd0,
+	0x57, 0x17, 0xd2, 0xb4, 0xb4, 0x7b, 0xce, 0x57, 0xdf, 0x0c, 0xac, 0xaf, 0xbf, 0x19, 0x58, 0x7f,
+	0xfb, 0x66, 0x60, 0x7d, 0xf1, 0xed, 0xa0, 0xf6, 0xf5, 0xb7, 0x83, 0xda, 0x5f, 0xbf, 0x1d, 0xd4,
+	0x8e, 0xda, 0xf4, 0x0f, 0x9d, 0x77, 0xfe, 0x39, 0x00, 0xf5, 0xfb, 0x63, 0x47, 0xb3, 0x23, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3770,6 +4029,8 @@ type WorkerClient interface {
 	GetWorkerValidatorStatus(ctx context.Context, in *GetValidationStatusRequest, opts ...grpc.CallOption) (*GetValidationStatusResponse, error)
 	GetValidatorError(ctx context.Context, in *GetValidationErrorRequest, opts ...grpc.CallOption) (*GetValidationErrorResponse, error)
 	OperateValidatorError(ctx context.Context, in *OperateValidationErrorRequest, opts ...grpc.CallOption) (*OperateValidationErrorResponse, error)
+	// only the connection parameters of the bound source can be updated online, see `UpdateSourceCfgResponse`
+	UpdateSourceCfg(ctx context.Context, in *UpdateSourceCfgRequest, opts ...grpc.CallOption) (*UpdateSourceCfgResponse, error)
 }
 
 type workerClient struct {
@@ -3870,6 +4131,15 @@ func (c *workerClient) OperateValidatorError(ctx context.Context, in *OperateVal
 	return out, nil
 }
 
+func (c *workerClient) UpdateSourceCfg(ctx context.Context, in *UpdateSourceCfgRequest, opts ...grpc.CallOption) (*UpdateSourceCfgResponse, error) {
+	out := new(UpdateSourceCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateSourceCfg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 type WorkerServer interface {
 	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
@@ -3887,6 +4157,8 @@ type WorkerServer interface {
 	GetWorkerValidatorStatus(context.Context, *GetValidationStatusRequest) (*GetValidationStatusResponse, error)
 	GetValidatorError(context.Context, *GetValidationErrorRequest) (*GetValidationErrorResponse, error)
 	OperateValidatorError(context.Context, *OperateValidationErrorRequest) (*OperateValidationErrorResponse, error)
+	// only the connection parameters of the bound source can be updated online, see `UpdateSourceCfgResponse`
+	UpdateSourceCfg(context.Context, *UpdateSourceCfgRequest) (*UpdateSourceCfgResponse, error)
 }
 
 // UnimplementedWorkerServer can be embedded to have forward compatible implementations.
@@ -3923,6 +4195,9 @@ func (*UnimplementedWorkerServer) GetValidatorError(ctx context.Context, req *Ge
 func (*UnimplementedWorkerServer) OperateValidatorError(ctx context.Context, req *OperateValidationErrorRequest) (*OperateValidationErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperateValidatorError not implemented")
 }
+func (*UnimplementedWorkerServer) UpdateSourceCfg(ctx context.Context, req *UpdateSourceCfgRequest) (*UpdateSourceCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSourceCfg not implemented")
+}
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
 	s.RegisterService(&_Worker_serviceDesc, srv)
@@ -4108,6 +4383,24 @@ func _Worker_OperateValidatorError_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_UpdateSourceCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSourceCfgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).UpdateSourceCfg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/UpdateSourceCfg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).UpdateSourceCfg(ctx, req.(*UpdateSourceCfgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -4152,6 +4445,10 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OperateValidatorError",
 			Handler:    _Worker_OperateValidatorError_Handler,
 		},
+		{
+			MethodName: "UpdateSourceCfg",
+			Handler:    _Worker_UpdateSourceCfg_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dmworker.proto",
@@ -4574,6 +4871,57 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnexpectedTables) > 0 {
+		for iNdEx := len(m.UnexpectedTables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnexpectedTables[iNdEx])
+			copy(dAtA[i:], m.UnexpectedTables[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.UnexpectedTables[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.TableTunings) > 0 {
+		for iNdEx := len(m.TableTunings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TableTunings[iNdEx])
+			copy(dAtA[i:], m.TableTunings[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.TableTunings[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.PauseAtProgress) > 0 {
+		i -= len(m.PauseAtProgress)
+		copy(dAtA[i:], m.PauseAtProgress)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.PauseAtProgress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.HandleErrorProgress) > 0 {
+		i -= len(m.HandleErrorProgress)
+		copy(dAtA[i:], m.HandleErrorProgress)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.HandleErrorProgress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FiredDDLHooks) > 0 {
+		for iNdEx := len(m.FiredDDLHooks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FiredDDLHooks[iNdEx])
+			copy(dAtA[i:], m.FiredDDLHooks[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.FiredDDLHooks[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if m.RecentRps != 0 {
 		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentRps))
 		i--
@@ -4718,6 +5066,13 @@ func (m *SourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpstreamConnections) > 0 {
+		i -= len(m.UpstreamConnections)
+		copy(dAtA[i:], m.UpstreamConnections)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.UpstreamConnections)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.RelayStatus != nil {
 		{
 			size, err := m.RelayStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -4864,6 +5219,20 @@ func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextAutoResumeTime) > 0 {
+		i -= len(m.NextAutoResumeTime)
+		copy(dAtA[i:], m.NextAutoResumeTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.NextAutoResumeTime)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.AutoResumeBreaker) > 0 {
+		i -= len(m.AutoResumeBreaker)
+		copy(dAtA[i:], m.AutoResumeBreaker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.AutoResumeBreaker)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Validation != nil {
 		{
 			size, err := m.Validation.MarshalToSizedBuffer(dAtA[:i])
@@ -6619,33 +6988,169 @@ func (m *OperateValidationErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDmworker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateSourceCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpdateSourceCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSourceCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.SourceCfgTomlString) > 0 {
+		i -= len(m.SourceCfgTomlString)
+		copy(dAtA[i:], m.SourceCfgTomlString)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SourceCfgTomlString)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommonWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateSourceCfgUnitResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSourceCfgUnitResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSourceCfgUnitResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Unit != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSourceCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSourceCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSourceCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Units) > 0 {
+		for iNdEx := len(m.Units) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Units[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDmworker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -6887,6 +7392,32 @@ func (m *SyncStatus) Size() (n int) {
 	if m.RecentRps != 0 {
 		n += 2 + sovDmworker(uint64(m.RecentRps))
 	}
+	if len(m.FiredDDLHooks) > 0 {
+		for _, s := range m.FiredDDLHooks {
+			l = len(s)
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.HandleErrorProgress)
+	if l > 0 {
+		n += 2 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.PauseAtProgress)
+	if l > 0 {
+		n += 2 + l + sovDmworker(uint64(l))
+	}
+	if len(m.TableTunings) > 0 {
+		for _, s := range m.TableTunings {
+			l = len(s)
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
+	if len(m.UnexpectedTables) > 0 {
+		for _, s := range m.UnexpectedTables {
+			l = len(s)
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6912,6 +7443,10 @@ func (m *SourceStatus) Size() (n int) {
 		l = m.RelayStatus.Size()
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	l = len(m.UpstreamConnections)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
 	return n
 }
 
@@ -6985,6 +7520,14 @@ func (m *SubTaskStatus) Size() (n int) {
 		l = m.Validation.Size()
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	l = len(m.AutoResumeBreaker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.NextAutoResumeTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
 	return n
 }
 
@@ -7779,6 +8322,64 @@ func (m *OperateValidationErrorResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpdateSourceCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCfgTomlString)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateSourceCfgUnitResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmworker(uint64(m.Unit))
+	}
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateSourceCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Units) > 0 {
+		for _, e := range m.Units {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDmworker(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -9434,59 +10035,9 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SourceStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FiredDDLHooks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9514,11 +10065,11 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.FiredDDLHooks = append(m.FiredDDLHooks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleErrorProgress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9546,13 +10097,13 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.HandleErrorProgress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAtProgress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9562,33 +10113,29 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PauseAtProgress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableTunings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9598,7 +10145,221 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableTunings = append(m.TableTunings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnexpectedTables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnexpectedTables = append(m.UnexpectedTables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9620,6 +10381,38 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamConnections = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10301,21 +11094,85 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResumeBreaker", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoResumeBreaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAutoResumeTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextAutoResumeTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -14983,6 +15840,377 @@ func (m *OperateValidationErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateSourceCfgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSourceCfgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSourceCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCfgTomlString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCfgTomlString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSourceCfgUnitResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSourceCfgUnitResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSourceCfgUnitResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSourceCfgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSourceCfgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSourceCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Units = append(m.Units, &UpdateSourceCfgUnitResult{})
+			if err := m.Units[len(m.Units)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDmworker(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -236,6 +236,26 @@ func (mr *MockWorkerClientMockRecorder) QueryStatus(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerClient)(nil).QueryStatus), varargs...)
 }
 
+// UpdateSourceCfg mocks base method.
+func (m *MockWorkerClient) UpdateSourceCfg(arg0 context.Context, arg1 *pb.UpdateSourceCfgRequest, arg2 ...grpc.CallOption) (*pb.UpdateSourceCfgResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSourceCfg", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateSourceCfgResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSourceCfg indicates an expected call of UpdateSourceCfg.
+func (mr *MockWorkerClientMockRecorder) UpdateSourceCfg(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSourceCfg", reflect.TypeOf((*MockWorkerClient)(nil).UpdateSourceCfg), varargs...)
+}
+
 // MockWorkerServer is a mock of WorkerServer interface.
 type MockWorkerServer struct {
 	ctrl     *gomock.Controller
@@ -408,3 +428,18 @@ func (mr *MockWorkerServerMockRecorder) QueryStatus(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerServer)(nil).QueryStatus), arg0, arg1)
 }
+
+// UpdateSourceCfg mocks base method.
+func (m *MockWorkerServer) UpdateSourceCfg(arg0 context.Context, arg1 *pb.UpdateSourceCfgRequest) (*pb.UpdateSourceCfgResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSourceCfg", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UpdateSourceCfgResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSourceCfg indicates an expected call of UpdateSourceCfg.
+func (mr *MockWorkerServerMockRecorder) UpdateSourceCfg(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSourceCfg", reflect.TypeOf((*MockWorkerServer)(nil).UpdateSourceCfg), arg0, arg1)
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+)
+
+// LoadOrderChecker checks whether the target tables have foreign keys when the
+// loader restores tables in random order, which means load-order-logical is not set.
+type LoadOrderChecker struct {
+	db              *sql.DB
+	dbinfo          *dbutil.DBConfig
+	db2TargetTables map[string][]filter.Table
+}
+
+// NewLoadOrderChecker returns a RealChecker.
+func NewLoadOrderChecker(db *sql.DB, dbinfo *dbutil.DBConfig, db2TargetTables map[string][]filter.Table) RealChecker {
+	return &LoadOrderChecker{db: db, dbinfo: dbinfo, db2TargetTables: db2TargetTables}
+}
+
+// Name implements the RealChecker interface.
+func (c *LoadOrderChecker) Name() string {
+	return "loader load order"
+}
+
+// Check implements the RealChecker interface.
+func (c *LoadOrderChecker) Check(ctx context.Context) *Result {
+	result := &Result{
+		Name:  c.Name(),
+		Desc:  "check whether target tables have foreign keys when load-order-logical is not set",
+		State: StateSuccess,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", c.dbinfo.Host, c.dbinfo.Port),
+	}
+	if len(c.db2TargetTables) == 0 {
+		return result
+	}
+
+	schemas := make([]string, 0, len(c.db2TargetTables))
+	targetTables := make(map[filter.Table]struct{})
+	for schema, tables := range c.db2TargetTables {
+		schemas = append(schemas, schema)
+		for _, table := range tables {
+			targetTables[table] = struct{}{}
+		}
+	}
+	sort.Strings(schemas)
+	args := make([]interface{}, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+	}
+	query := "SELECT CONSTRAINT_SCHEMA, TABLE_NAME, UNIQUE_CONSTRAINT_SCHEMA, REFERENCED_TABLE_NAME " +
+		"FROM information_schema.REFERENTIAL_CONSTRAINTS WHERE CONSTRAINT_SCHEMA IN (" +
+		strings.TrimSuffix(strings.Repeat("?,", len(schemas)), ",") + ")"
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		markCheckError(result, err)
+		return result
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var child, parent filter.Table
+		if err = rows.Scan(&child.Schema, &child.Name, &parent.Schema, &parent.Name); err != nil {
+			markCheckError(result, err)
+			return result
+		}
+		if _, ok := targetTables[child]; !ok {
+			continue
+		}
+		result.State = StateWarning
+		result.Errors = append(result.Errors, NewWarn("table %s has a foreign key referencing table %s", child.String(), parent.String()))
+	}
+	if err = rows.Err(); err != nil {
+		markCheckError(result, err)
+		return result
+	}
+	if result.State == StateWarning {
+		result.Instruction = "The loader restores tables in random order, which may violate foreign key constraints. " +
+			"Please set `load-order-logical` of loader to restore the referenced tables first."
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOrderChecker(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbinfo := &dbutil.DBConfig{Host: "127.0.0.1", Port: 4000}
+	db2TargetTables := map[string][]filter.Table{
+		"db1": {{Schema: "db1", Name: "orders"}, {Schema: "db1", Name: "customer"}},
+		"db2": {{Schema: "db2", Name: "t"}},
+	}
+	columns := []string{"CONSTRAINT_SCHEMA", "TABLE_NAME", "UNIQUE_CONSTRAINT_SCHEMA", "REFERENCED_TABLE_NAME"}
+
+	mock.ExpectQuery("SELECT .* FROM information_schema.REFERENTIAL_CONSTRAINTS WHERE CONSTRAINT_SCHEMA IN \\(\\?,\\?\\)").
+		WithArgs("db1", "db2").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("db1", "other", "db1", "customer"))
+	checker := NewLoadOrderChecker(db, dbinfo, db2TargetTables)
+	result := checker.Check(context.Background())
+	require.Equal(t, StateSuccess, result.State)
+	require.Len(t, result.Errors, 0)
+
+	mock.ExpectQuery("SELECT .* FROM information_schema.REFERENTIAL_CONSTRAINTS").
+		WithArgs("db1", "db2").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("db1", "orders", "db1", "customer"))
+	result = checker.Check(context.Background())
+	require.Equal(t, StateWarning, result.State)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "table `db1`.`orders` has a foreign key referencing table `db1`.`customer`", result.Errors[0].ShortErr)
+	require.Contains(t, result.Instruction, "load-order-logical")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// no target tables
+	result = NewLoadOrderChecker(db, dbinfo, nil).Check(context.Background())
+	require.Equal(t, StateSuccess, result.State)
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// QueryFunc executes a query and returns the rows, e.g. (*conn.BaseConn).QuerySQL.
+type QueryFunc func(tctx *tcontext.Context, query string, args ...interface{}) (*sql.Rows, error)
+
+// DBQueryFunc returns a QueryFunc executing queries by the connection pool db.
+func DBQueryFunc(db *sql.DB) QueryFunc {
+	return func(tctx *tcontext.Context, query string, args ...interface{}) (*sql.Rows, error) {
+		rows, err := db.QueryContext(tctx.Context(), query, args...)
+		if err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ScopeNotSet, terror.ErrDBQueryFailed, query)
+		}
+		return rows, nil
+	}
+}
+
+// PrimaryKeyColumn returns the quoted first column of the primary key of the
+// table, or "" if the table has no primary key.
+func PrimaryKeyColumn(tctx *tcontext.Context, query QueryFunc, schema, table string) (string, error) {
+	rows, err := query(tctx, `SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'
+		ORDER BY ORDINAL_POSITION LIMIT 1`, schema, table)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var col string
+	if rows.Next() {
+		if err = rows.Scan(&col); err != nil {
+			return "", terror.DBErrorAdapt(err, terror.ScopeNotSet, terror.ErrDBDriverError)
+		}
+		col = dbutil.ColumnName(col)
+	}
+	return col, terror.DBErrorAdapt(rows.Err(), terror.ScopeNotSet, terror.ErrDBDriverError)
+}
+
+// TableColumns returns the quoted columns of the table in the order of definition.
+func TableColumns(tctx *tcontext.Context, query QueryFunc, schema, table string) ([]string, error) {
+	rows, err := query(tctx, `SELECT COLUMN_NAME FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION`, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var col string
+		if err = rows.Scan(&col); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ScopeNotSet, terror.ErrDBDriverError)
+		}
+		columns = append(columns, dbutil.ColumnName(col))
+	}
+	return columns, terror.DBErrorAdapt(rows.Err(), terror.ScopeNotSet, terror.ErrDBDriverError)
+}
+
+// ChunkUpperBound returns the inclusive upper bound of the chunk of chunkSize
+// rows after lower, nil means the chunk is the last one. table is the quoted
+// table name.
+func ChunkUpperBound(tctx *tcontext.Context, query QueryFunc, table, keyCol string, lower *string, chunkSize int) (*string, error) {
+	where, args := ChunkRange(keyCol, lower, nil)
+	rows, err := query(tctx, fmt.Sprintf("SELECT %s FROM %s%s ORDER BY %s LIMIT 1 OFFSET %d",
+		keyCol, table, where, keyCol, chunkSize-1), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var upper *string
+	if rows.Next() {
+		var value sql.NullString
+		if err = rows.Scan(&value); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ScopeNotSet, terror.ErrDBDriverError)
+		}
+		upper = &value.String
+	}
+	return upper, terror.DBErrorAdapt(rows.Err(), terror.ScopeNotSet, terror.ErrDBDriverError)
+}
+
+// ChunkRange returns the WHERE clause of the chunk (lower, upper].
+func ChunkRange(keyCol string, lower, upper *string) (string, []interface{}) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	if lower != nil {
+		conds = append(conds, keyCol+" > ?")
+		args = append(args, *lower)
+	}
+	if upper != nil {
+		conds = append(conds, keyCol+" <= ?")
+		args = append(args, *upper)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// DescribeChunkRange returns the condition of the chunk with the arguments
+// inlined, it can be used to find out the rows of the chunk directly.
+func DescribeChunkRange(where string, args []interface{}) string {
+	if where == "" {
+		return "TRUE"
+	}
+	desc := strings.TrimPrefix(where, " WHERE ")
+	for _, arg := range args {
+		desc = strings.Replace(desc, "?", fmt.Sprintf("'%v'", arg), 1)
+	}
+	return desc
+}
+
+// ChunkChecksum calculates the row count and the checksum of the rows in the
+// chunk, the checksum is the XOR of the CRC32 of each row. Since XOR is
+// commutative, the checksum of a table merged from shards equals to the XOR of
+// the checksums of the shards.
+func ChunkChecksum(tctx *tcontext.Context, query QueryFunc, table string, columns []string, where string, args []interface{}) (int64, uint64, error) {
+	isNulls := make([]string, 0, len(columns))
+	for _, col := range columns {
+		isNulls = append(isNulls, "ISNULL("+col+")")
+	}
+	rows, err := query(tctx, fmt.Sprintf("SELECT COUNT(*), BIT_XOR(CAST(CRC32(CONCAT_WS(',', %s, CONCAT(%s))) AS UNSIGNED)) FROM %s%s",
+		strings.Join(columns, ", "), strings.Join(isNulls, ", "), table, where), args...)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		count    int64
+		checksum uint64
+	)
+	if rows.Next() {
+		if err = rows.Scan(&count, &checksum); err != nil {
+			return 0, 0, terror.DBErrorAdapt(err, terror.ScopeNotSet, terror.ErrDBDriverError)
+		}
+	}
+	return count, checksum, terror.DBErrorAdapt(rows.Err(), terror.ScopeNotSet, terror.ErrDBDriverError)
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkRange(t *testing.T) {
+	t.Parallel()
+
+	lower, upper := "1", "10"
+	where, args := ChunkRange("`id`", nil, nil)
+	require.Equal(t, "", where)
+	require.Nil(t, args)
+	require.Equal(t, "TRUE", DescribeChunkRange(where, args))
+
+	where, args = ChunkRange("`id`", nil, &upper)
+	require.Equal(t, " WHERE `id` <= ?", where)
+	require.Equal(t, []interface{}{"10"}, args)
+
+	where, args = ChunkRange("`id`", &lower, &upper)
+	require.Equal(t, " WHERE `id` > ? AND `id` <= ?", where)
+	require.Equal(t, []interface{}{"1", "10"}, args)
+	require.Equal(t, "`id` > '1' AND `id` <= '10'", DescribeChunkRange(where, args))
+}
+
+func newMockTable(t *testing.T, sourceID, schema, name string) (*Table, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return &Table{SourceID: sourceID, Schema: schema, Name: name, Query: DBQueryFunc(db)}, mock
+}
+
+func TestCompareTable(t *testing.T) {
+	t.Parallel()
+
+	target, targetMock := newMockTable(t, "", "db", "tbl")
+	source1, sourceMock1 := newMockTable(t, "mysql-01", "db_1", "tbl_1")
+	source2, sourceMock2 := newMockTable(t, "mysql-02", "db_2", "tbl_1")
+
+	sourceMock1.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.COLUMNS").
+		WithArgs("db_1", "tbl_1").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("name"))
+	targetMock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "tbl").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+	// first chunk: (-inf, 2]
+	targetMock.ExpectQuery("SELECT `id` FROM `db`.`tbl` ORDER BY `id` LIMIT 1 OFFSET 1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("2"))
+	sourceMock1.ExpectQuery("SELECT COUNT\\(\\*\\).*CONCAT_WS\\(',', `id`, `name`, .* FROM `db_1`.`tbl_1` WHERE `id` <= \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(1, 3))
+	sourceMock2.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db_2`.`tbl_1` WHERE `id` <= \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(1, 5))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl` WHERE `id` <= \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(2, 6))
+	// last chunk: (2, +inf)
+	targetMock.ExpectQuery("SELECT `id` FROM `db`.`tbl` WHERE `id` > \\? ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sourceMock1.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db_1`.`tbl_1` WHERE `id` > \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(1, 8))
+	sourceMock2.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db_2`.`tbl_1` WHERE `id` > \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(0, 0))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl` WHERE `id` > \\?").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(2, 9))
+
+	chunks := 0
+	report, err := CompareTable(tcontext.Background(), target, []*Table{source1, source2}, 2, func(r *TableReport) {
+		chunks++
+		require.Equal(t, chunks, r.Chunks)
+	})
+	require.NoError(t, err)
+	require.NoError(t, sourceMock1.ExpectationsWereMet())
+	require.NoError(t, sourceMock2.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, &TableReport{
+		TargetTable:  "`db`.`tbl`",
+		SourceTables: []string{"mysql-01:`db_1`.`tbl_1`", "mysql-02:`db_2`.`tbl_1`"},
+		Chunks:       2,
+		Mismatches: []ChunkMismatch{{
+			Range:          "`id` > '2'",
+			SourceCount:    1,
+			TargetCount:    2,
+			SourceChecksum: 8,
+			TargetChecksum: 9,
+			SourceSQLs: []string{
+				"SELECT `id`, `name` FROM `db_1`.`tbl_1` WHERE `id` > '2' ORDER BY `id`",
+				"SELECT `id`, `name` FROM `db_2`.`tbl_1` WHERE `id` > '2' ORDER BY `id`",
+			},
+			TargetSQL: "SELECT `id`, `name` FROM `db`.`tbl` WHERE `id` > '2' ORDER BY `id`",
+		}},
+	}, report)
+	require.Equal(t, 2, chunks)
+}
+
+func TestCompareTableWithoutUpstreamKey(t *testing.T) {
+	t.Parallel()
+
+	target, targetMock := newMockTable(t, "", "db", "tbl")
+	source, sourceMock := newMockTable(t, "mysql-01", "db", "tbl")
+
+	sourceMock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.COLUMNS").
+		WithArgs("db", "tbl").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("a"))
+	// the key is an extended column which doesn't exist in the upstream.
+	targetMock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE").
+		WithArgs("db", "tbl").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("c_source"))
+	sourceMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl`$").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(3, 42))
+	targetMock.ExpectQuery("SELECT COUNT\\(\\*\\).* FROM `db`.`tbl`$").
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(3, 42))
+
+	report, err := CompareTable(tcontext.Background(), target, []*Table{source}, 2, nil)
+	require.NoError(t, err)
+	require.NoError(t, sourceMock.ExpectationsWereMet())
+	require.NoError(t, targetMock.ExpectationsWereMet())
+	require.Equal(t, 1, report.Chunks)
+	require.Empty(t, report.Mismatches)
+}
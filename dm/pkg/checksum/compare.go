@@ -0,0 +1,172 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// Table is a table to compare, query executes queries on the database of the table.
+type Table struct {
+	SourceID string
+	Schema   string
+	Name     string
+	Query    QueryFunc
+}
+
+// QuotedName returns the quoted name of the table.
+func (t *Table) QuotedName() string {
+	return dbutil.TableName(t.Schema, t.Name)
+}
+
+// ChunkMismatch records a chunk whose checksum in the downstream doesn't match
+// the one merged from the upstream tables.
+type ChunkMismatch struct {
+	// Range is the WHERE condition of the chunk.
+	Range          string `json:"range"`
+	SourceCount    int64  `json:"source_count"`
+	TargetCount    int64  `json:"target_count"`
+	SourceChecksum uint64 `json:"source_checksum"`
+	TargetChecksum uint64 `json:"target_checksum"`
+	// SourceSQLs and TargetSQL are the queries to inspect the rows of the
+	// chunk in the upstream tables and the downstream table.
+	SourceSQLs []string `json:"source_sqls"`
+	TargetSQL  string   `json:"target_sql"`
+}
+
+// TableReport is the result of comparing a downstream table with its upstream tables.
+type TableReport struct {
+	TargetTable  string          `json:"target_table"`
+	SourceTables []string        `json:"source_tables"`
+	Chunks       int             `json:"chunks"`
+	Mismatches   []ChunkMismatch `json:"mismatches,omitempty"`
+}
+
+// CompareTable compares the downstream table target with the upstream tables
+// merged into it chunk by chunk. The chunks are split by the first column of
+// the primary key of the downstream table, the table is compared as a whole if
+// there is no primary key or the column doesn't exist in the upstream. Only
+// the columns of the first upstream table are compared, so the extended
+// columns of the downstream are ignored. onChunk is called after each chunk
+// is compared, it can be nil.
+func CompareTable(
+	tctx *tcontext.Context,
+	target *Table,
+	sources []*Table,
+	chunkSize int,
+	onChunk func(report *TableReport),
+) (*TableReport, error) {
+	report := &TableReport{
+		TargetTable:  target.QuotedName(),
+		SourceTables: make([]string, 0, len(sources)),
+	}
+	for _, source := range sources {
+		name := source.QuotedName()
+		if source.SourceID != "" {
+			name = source.SourceID + ":" + name
+		}
+		report.SourceTables = append(report.SourceTables, name)
+	}
+	if len(sources) == 0 {
+		return report, nil
+	}
+
+	columns, err := TableColumns(tctx, sources[0].Query, sources[0].Schema, sources[0].Name)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeUpstream)
+	}
+	keyCol, err := PrimaryKeyColumn(tctx, target.Query, target.Schema, target.Name)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	if !containsString(columns, keyCol) {
+		keyCol = ""
+	}
+
+	var lower *string
+	for {
+		var upper *string
+		if keyCol != "" {
+			upper, err = ChunkUpperBound(tctx, target.Query, report.TargetTable, keyCol, lower, chunkSize)
+			if err != nil {
+				return nil, terror.WithScope(err, terror.ScopeDownstream)
+			}
+		}
+
+		where, args := ChunkRange(keyCol, lower, upper)
+		var (
+			sourceCount    int64
+			sourceChecksum uint64
+		)
+		for _, source := range sources {
+			count, checksum, err2 := ChunkChecksum(tctx, source.Query, source.QuotedName(), columns, where, args)
+			if err2 != nil {
+				return nil, terror.WithScope(err2, terror.ScopeUpstream)
+			}
+			sourceCount += count
+			sourceChecksum ^= checksum
+		}
+		targetCount, targetChecksum, err := ChunkChecksum(tctx, target.Query, report.TargetTable, columns, where, args)
+		if err != nil {
+			return nil, terror.WithScope(err, terror.ScopeDownstream)
+		}
+		report.Chunks++
+		if sourceCount != targetCount || sourceChecksum != targetChecksum {
+			desc := DescribeChunkRange(where, args)
+			mismatch := ChunkMismatch{
+				Range:          desc,
+				SourceCount:    sourceCount,
+				TargetCount:    targetCount,
+				SourceChecksum: sourceChecksum,
+				TargetChecksum: targetChecksum,
+				SourceSQLs:     make([]string, 0, len(sources)),
+				TargetSQL:      inspectSQL(report.TargetTable, columns, desc, keyCol),
+			}
+			for _, source := range sources {
+				mismatch.SourceSQLs = append(mismatch.SourceSQLs, inspectSQL(source.QuotedName(), columns, desc, keyCol))
+			}
+			report.Mismatches = append(report.Mismatches, mismatch)
+		}
+		if onChunk != nil {
+			onChunk(report)
+		}
+
+		if upper == nil {
+			return report, nil
+		}
+		lower = upper
+	}
+}
+
+func inspectSQL(table string, columns []string, cond, keyCol string) string {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s", strings.Join(columns, ", "), table, cond)
+	if keyCol != "" {
+		query += " ORDER BY " + keyCol
+	}
+	return query
+}
+
+func containsString(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
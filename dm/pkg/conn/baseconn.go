@@ -14,6 +14,7 @@
 package conn
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
@@ -75,6 +76,9 @@ type BaseConn struct {
 	DBConn        *sql.Conn
 	Scope         terror.ErrScope
 	RetryStrategy retry.Strategy
+
+	// preparedStmts caches the prepared statements of this connection, keyed by the query.
+	preparedStmts map[string]*sql.Stmt
 }
 
 // NewBaseConn builds BaseConn to connect real DB.
@@ -110,6 +114,93 @@ func (conn *BaseConn) SetRetryStrategy(strategy retry.Strategy) error {
 	return nil
 }
 
+// PrepareSQL prepares the statements and caches them in this connection,
+// later executions of the same queries will use the prepared statements.
+func (conn *BaseConn) PrepareSQL(tctx *tcontext.Context, queries []string) error {
+	if conn == nil || conn.DBConn == nil {
+		return terror.ErrDBUnExpect.Generate("database connection not valid")
+	}
+	for _, query := range queries {
+		if _, ok := conn.preparedStmts[query]; ok {
+			continue
+		}
+		stmt, err := conn.DBConn.PrepareContext(tctx.Context(), query)
+		if err != nil {
+			tctx.L().ErrorFilterContextCanceled("prepare statement failed",
+				zap.String("query", utils.TruncateString(query, -1)),
+				log.ShortError(err))
+			return terror.ErrDBPrepareFailed.Delegate(err, utils.TruncateString(query, -1))
+		}
+		if conn.preparedStmts == nil {
+			conn.preparedStmts = make(map[string]*sql.Stmt)
+		}
+		conn.preparedStmts[query] = stmt
+	}
+	return nil
+}
+
+// sqlTxn is the transaction used to execute statements.
+type sqlTxn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	Commit() error
+	Rollback() error
+}
+
+// stmtTxn is a transaction started by executing BEGIN on the connection, so
+// the statements prepared in the connection can be used without preparing
+// them again, which `sql.Tx.StmtContext` does for statements of `sql.Conn`.
+type stmtTxn struct {
+	conn  *sql.Conn
+	stmts map[string]*sql.Stmt
+}
+
+func (t *stmtTxn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if stmt, ok := t.stmts[query]; ok {
+		return stmt.ExecContext(ctx, args...)
+	}
+	return t.conn.ExecContext(ctx, query, args...)
+}
+
+func (t *stmtTxn) Commit() error {
+	_, err := t.conn.ExecContext(context.Background(), "COMMIT")
+	return err
+}
+
+func (t *stmtTxn) Rollback() error {
+	_, err := t.conn.ExecContext(context.Background(), "ROLLBACK")
+	return err
+}
+
+func (conn *BaseConn) beginTxn(ctx context.Context) (sqlTxn, error) {
+	if len(conn.preparedStmts) == 0 {
+		return conn.DBConn.BeginTx(ctx, nil)
+	}
+	if _, err := conn.DBConn.ExecContext(ctx, "BEGIN"); err != nil {
+		return nil, err
+	}
+	return &stmtTxn{conn: conn.DBConn, stmts: conn.preparedStmts}, nil
+}
+
+// closePreparedStmts closes all cached prepared statements.
+func (conn *BaseConn) closePreparedStmts() {
+	for query, stmt := range conn.preparedStmts {
+		_ = stmt.Close()
+		delete(conn.preparedStmts, query)
+	}
+}
+
+// EvictPreparedStmts closes all cached prepared statements, which deallocates
+// them in the downstream, and returns the number of them. Later executions of
+// the queries don't use prepared statements until they're prepared again.
+func (conn *BaseConn) EvictPreparedStmts() int {
+	if conn == nil {
+		return 0
+	}
+	evicted := len(conn.preparedStmts)
+	conn.closePreparedStmts()
+	return evicted
+}
+
 // QuerySQL runs a query statement.
 func (conn *BaseConn) QuerySQL(tctx *tcontext.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	if conn == nil || conn.DBConn == nil {
@@ -135,6 +226,22 @@ func (conn *BaseConn) QuerySQL(tctx *tcontext.Context, query string, args ...int
 // 1. failed: (the index of sqls executed error, error)
 // 2. succeed: (rows affected, nil).
 func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, ignoreErr func(error) bool, queries []string, args ...[]interface{}) (int, error) {
+	return conn.executeSQL(tctx, hVec, task, ignoreErr, nil, queries, args...)
+}
+
+// ExecuteSQLWithTimings is the same as ExecuteSQL, but also returns the
+// elapsed time of each executed statement, so that the slow statements in a
+// batch can be found. The returned timings don't contain the statements
+// after the failed one.
+func (conn *BaseConn) ExecuteSQLWithTimings(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, queries []string, args ...[]interface{}) ([]time.Duration, error) {
+	timings := make([]time.Duration, 0, len(queries))
+	_, err := conn.executeSQL(tctx, hVec, task, nil, &timings, queries, args...)
+	return timings, err
+}
+
+// executeSQL executes queries in a transaction, the elapsed time of each
+// statement is appended to timings if it's not nil.
+func (conn *BaseConn) executeSQL(tctx *tcontext.Context, hVec *prometheus.HistogramVec, task string, ignoreErr func(error) bool, timings *[]time.Duration, queries []string, args ...[]interface{}) (int, error) {
 	var affect int64
 	// inject an error to trigger retry, this should be placed before the real execution of the SQL statement.
 	failpoint.Inject("retryableError", func(val failpoint.Value) {
@@ -163,7 +270,7 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *pr
 	}
 
 	startTime := time.Now()
-	txn, err := conn.DBConn.BeginTx(tctx.Context(), nil)
+	txn, err := conn.beginTxn(tctx.Context())
 	if err != nil {
 		return 0, terror.ErrDBExecuteFailed.Delegate(err, "begin")
 	}
@@ -188,6 +295,9 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *pr
 
 		startTime = time.Now()
 		result, err2 := txn.ExecContext(tctx.Context(), query, arg...)
+		if timings != nil {
+			*timings = append(*timings, time.Since(startTime))
+		}
 		if err2 == nil {
 			rows, _ := result.RowsAffected()
 			affect += rows
@@ -280,6 +390,7 @@ func (conn *BaseConn) close() error {
 	if conn == nil || conn.DBConn == nil {
 		return nil
 	}
+	conn.closePreparedStmts()
 	return conn.DBConn.Close()
 }
 
@@ -289,6 +400,7 @@ func (conn *BaseConn) forceClose() error {
 	if conn == nil || conn.DBConn == nil {
 		return nil
 	}
+	conn.closePreparedStmts()
 
 	err := conn.DBConn.Raw(func(dc interface{}) error {
 		// return an `ErrBadConn` to ensure close the connection, but do not put it back to the pool.
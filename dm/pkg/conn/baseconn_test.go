@@ -16,6 +16,7 @@ package conn
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-sql-driver/mysql"
@@ -100,6 +101,23 @@ func TestBaseConn(t *testing.T) {
 	require.Contains(t, err.Error(), "don't ignore me")
 	require.Equal(t, 1, affected)
 
+	mock.ExpectBegin()
+	mock.ExpectExec("create database test1").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("create database test2").WillDelayFor(100 * time.Millisecond).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	timings, err := baseConn.ExecuteSQLWithTimings(tctx, nil, "test", []string{"create database test1", "create database test2"})
+	require.NoError(t, err)
+	require.Len(t, timings, 2)
+	require.GreaterOrEqual(t, timings[1], 100*time.Millisecond)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("create database test1").WillReturnError(errors.New("invalid connection"))
+	mock.ExpectRollback()
+	timings, err = baseConn.ExecuteSQLWithTimings(tctx, nil, "test", []string{"create database test1", "create database test2"})
+	require.True(t, terror.ErrDBExecuteFailed.Equal(err))
+	require.Len(t, timings, 1)
+
 	require.NoError(t, mock.ExpectationsWereMet())
 	require.NoError(t, baseConn.forceClose())
 }
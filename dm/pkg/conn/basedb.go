@@ -27,11 +27,13 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
@@ -83,8 +85,41 @@ func GetDownstreamDB(cfg *dbconfig.DBConfig) (*BaseDB, error) {
 	return DefaultDBProvider.Apply(DownstreamDBConfig(cfg))
 }
 
-// Apply will build BaseDB with DBConfig.
+// Apply will build BaseDB with DBConfig. If the password is a reference to a
+// secret, it's resolved from the secret provider, and resolved again once if it's
+// rejected by the database, in case the secret has been rotated.
 func (d *DefaultDBProviderImpl) Apply(config ScopedDBConfig) (*BaseDB, error) {
+	if config.DBConfig == nil || !secret.IsReference(config.Password) {
+		return d.apply(config)
+	}
+	ref := config.Password
+	db, err := d.applyWithSecret(config, ref)
+	if err != nil && isAccessDenied(err) {
+		log.L().Warn("access denied with the resolved secret, resolve it again", zap.String("reference", ref))
+		secret.Invalidate(ref)
+		db, err = d.applyWithSecret(config, ref)
+	}
+	return db, err
+}
+
+func (d *DefaultDBProviderImpl) applyWithSecret(config ScopedDBConfig, ref string) (*BaseDB, error) {
+	password, err := secret.Resolve(context.Background(), ref)
+	if err != nil {
+		return nil, err
+	}
+	// don't modify the config of the caller, which keeps the reference.
+	resolved := *config.DBConfig
+	resolved.Password = password
+	config.DBConfig = &resolved
+	return d.apply(config)
+}
+
+func isAccessDenied(err error) bool {
+	mysqlErr, ok := errors.Cause(err).(*mysql.MySQLError)
+	return ok && mysqlErr.Number == tmysql.ErrAccessDenied
+}
+
+func (d *DefaultDBProviderImpl) apply(config ScopedDBConfig) (*BaseDB, error) {
 	// maxAllowedPacket=0 can be used to automatically fetch the max_allowed_packet variable from server on every connection.
 	// https://github.com/go-sql-driver/mysql#maxallowedpacket
 	hostPort := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
@@ -92,12 +127,26 @@ func (d *DefaultDBProviderImpl) Apply(config ScopedDBConfig) (*BaseDB, error) {
 	if config.Net != "" {
 		net = config.Net
 	}
+	// the connections to the upstream are limited by max-connections of the user in the process, the
+	// limiter is bypassed if the connections are dialed by a custom dialer like the IO counter of
+	// the physical import mode, and only the size of the pool is limited.
+	var limiter *upstreamLimiter
+	if config.Scope == terror.ScopeUpstream && config.MaxConnections > 0 && config.Net == "" {
+		limiter = getUpstreamLimiter(config.DBConfig)
+		net = limiter.net
+	}
 	dsn := fmt.Sprintf("%s:%s@%s(%s)/?charset=utf8mb4&interpolateParams=true&maxAllowedPacket=0",
 		config.User, config.Password, net, hostPort)
 
 	doFuncInClose := func() {}
+	if limiter != nil {
+		doFuncInClose = func() {
+			putUpstreamLimiter(limiter)
+		}
+	}
 	if config.Security != nil {
 		if loadErr := config.Security.LoadTLSContent(); loadErr != nil {
+			doFuncInClose()
 			return nil, terror.ErrCtlLoadTLSCfg.Delegate(loadErr)
 		}
 		tlsConfig, err := util.NewTLSConfig(
@@ -106,6 +155,7 @@ func (d *DefaultDBProviderImpl) Apply(config ScopedDBConfig) (*BaseDB, error) {
 			util.WithVerifyCommonName(config.Security.CertAllowedCN),
 		)
 		if err != nil {
+			doFuncInClose()
 			return nil, terror.ErrConnInvalidTLSConfig.Delegate(err)
 		}
 
@@ -113,12 +163,15 @@ func (d *DefaultDBProviderImpl) Apply(config ScopedDBConfig) (*BaseDB, error) {
 			name := "dm" + strconv.FormatInt(atomic.AddInt64(&customID, 1), 10)
 			err = mysql.RegisterTLSConfig(name, tlsConfig)
 			if err != nil {
+				doFuncInClose()
 				return nil, terror.ErrConnRegistryTLSConfig.Delegate(err)
 			}
 			dsn += "&tls=" + name
 
+			putLimiter := doFuncInClose
 			doFuncInClose = func() {
 				mysql.DeregisterTLSConfig(name)
+				putLimiter()
 			}
 		}
 	}
@@ -152,8 +205,23 @@ func (d *DefaultDBProviderImpl) Apply(config ScopedDBConfig) (*BaseDB, error) {
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
+		doFuncInClose()
 		return nil, terror.DBErrorAdapt(err, config.Scope, terror.ErrDBDriverError)
 	}
+	if config.Scope == terror.ScopeUpstream && config.MaxConnections > 0 {
+		if limiter != nil {
+			// the pools sharing the limiter open at most their shares of the max connections.
+			db.SetConnMaxIdleTime(upstreamConnMaxIdleTime)
+			limiter.addPool(db)
+			putLimiter := doFuncInClose
+			doFuncInClose = func() {
+				limiter.removePool(db)
+				putLimiter()
+			}
+		} else {
+			db.SetMaxOpenConns(config.MaxConnections)
+		}
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), netTimeout)
 	defer cancel()
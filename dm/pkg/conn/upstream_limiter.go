@@ -0,0 +1,307 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// MinUpstreamMaxConnections is the min max-connections of the upstream, the dump unit needs
+// at least a connection to dump data and another one to hold the consistent snapshot.
+const MinUpstreamMaxConnections = 2
+
+// upstreamConnMaxIdleTime is the max idle time of a connection in a limited upstream pool,
+// so that the idle connections of a pool don't starve the other pools for long.
+var upstreamConnMaxIdleTime = 5 * time.Second
+
+var upstreamLimiters = struct {
+	sync.Mutex
+	nextID int64
+	// user@host:port -> limiter
+	m map[string]*upstreamLimiter
+}{m: make(map[string]*upstreamLimiter)}
+
+// upstreamLimiter limits the number of connections opened to the upstream by a user in the
+// process, it's shared by all BaseDBs of the same user and upstream, and the connections are
+// borrowed when they are dialed and returned when they are closed.
+type upstreamLimiter struct {
+	key string
+	// net is the name of the dialer registered to the mysql driver.
+	net string
+	max int
+
+	mu   sync.Mutex
+	used int
+	// refs is the number of BaseDBs and reservations using the limiter.
+	refs int
+	// released is closed and replaced when some connections are returned.
+	released chan struct{}
+
+	// poolsMu serializes the resizing of pools, it's not l.mu because resizing a pool may close
+	// its idle connections, which are returned to the limiter with l.mu.
+	poolsMu sync.Mutex
+	// pools are the connection pools of BaseDBs using the limiter, each of them can open its share
+	// of the max connections, so that a busy pool doesn't starve the others.
+	pools map[*sql.DB]struct{}
+}
+
+func upstreamKey(cfg *dbconfig.DBConfig) string {
+	return cfg.User + "@" + net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+}
+
+// getUpstreamLimiter returns the limiter of the upstream and increases its reference count,
+// the caller should call putUpstreamLimiter when it's not used. The limit of the limiter is
+// the max-connections of the first config if the configs of the same user are different.
+func getUpstreamLimiter(cfg *dbconfig.DBConfig) *upstreamLimiter {
+	key := upstreamKey(cfg)
+	upstreamLimiters.Lock()
+	defer upstreamLimiters.Unlock()
+	l, ok := upstreamLimiters.m[key]
+	if !ok {
+		upstreamLimiters.nextID++
+		l = &upstreamLimiter{
+			key:      key,
+			net:      "dm-upstream-" + strconv.FormatInt(upstreamLimiters.nextID, 10),
+			max:      cfg.MaxConnections,
+			released: make(chan struct{}),
+			pools:    make(map[*sql.DB]struct{}),
+		}
+		mysql.RegisterDialContext(l.net, l.dial)
+		upstreamLimiters.m[key] = l
+	} else if l.max != cfg.MaxConnections {
+		log.L().Warn("max-connections of the upstream is different from the one in use, the one in use takes effect",
+			zap.String("upstream", key), zap.Int("in use", l.max), zap.Int("max-connections", cfg.MaxConnections))
+	}
+	l.mu.Lock()
+	l.refs++
+	l.mu.Unlock()
+	return l
+}
+
+// putUpstreamLimiter decreases the reference count of the limiter, and removes it when it's not used.
+func putUpstreamLimiter(l *upstreamLimiter) {
+	upstreamLimiters.Lock()
+	defer upstreamLimiters.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refs--
+	if l.refs <= 0 && upstreamLimiters.m[l.key] == l {
+		delete(upstreamLimiters.m, l.key)
+	}
+}
+
+// addPool adds a connection pool to the limiter and resizes all pools to their shares.
+func (l *upstreamLimiter) addPool(db *sql.DB) {
+	l.poolsMu.Lock()
+	defer l.poolsMu.Unlock()
+	l.pools[db] = struct{}{}
+	l.resizePools()
+}
+
+// removePool removes a connection pool from the limiter and resizes the other pools to their shares.
+func (l *upstreamLimiter) removePool(db *sql.DB) {
+	l.poolsMu.Lock()
+	defer l.poolsMu.Unlock()
+	delete(l.pools, db)
+	l.resizePools()
+}
+
+// resizePools sets the max open connections of each pool to its share of the max connections,
+// the caller should hold l.poolsMu.
+func (l *upstreamLimiter) resizePools() {
+	if len(l.pools) == 0 {
+		return
+	}
+	share := l.max / len(l.pools)
+	if share < 1 {
+		share = 1
+	}
+	for db := range l.pools {
+		db.SetMaxOpenConns(share)
+	}
+}
+
+// acquire waits until n connections are available and borrows them.
+func (l *upstreamLimiter) acquire(ctx context.Context, n int) error {
+	for {
+		l.mu.Lock()
+		if l.used+n <= l.max {
+			l.used += n
+			l.mu.Unlock()
+			return nil
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return terror.ErrDBDriverError.Delegate(ctx.Err(),
+				fmt.Sprintf("wait for a connection to the upstream %s, max-connections %d is reached", l.key, l.max))
+		case <-released:
+		}
+	}
+}
+
+// tryAcquireUpTo borrows at most n connections without waiting, and returns the number borrowed.
+func (l *upstreamLimiter) tryAcquireUpTo(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if n > l.max-l.used {
+		n = l.max - l.used
+	}
+	if n < 0 {
+		n = 0
+	}
+	l.used += n
+	return n
+}
+
+// release returns n connections to the limiter.
+func (l *upstreamLimiter) release(n int) {
+	if n <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.used -= n
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+func (l *upstreamLimiter) usage() (used, max int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.used, l.max
+}
+
+// dial is registered to the mysql driver, it borrows a connection before dialing the upstream.
+func (l *upstreamLimiter) dial(ctx context.Context, addr string) (net.Conn, error) {
+	return l.dialNetwork(ctx, "tcp", addr)
+}
+
+func (l *upstreamLimiter) dialNetwork(ctx context.Context, network, addr string) (*limitedConn, error) {
+	if err := l.acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+	d := &net.Dialer{KeepAlive: 30 * time.Second}
+	c, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		l.release(1)
+		return nil, err
+	}
+	return &limitedConn{Conn: c, limiter: l}, nil
+}
+
+// limitedConn returns the borrowed connection to the limiter when it's closed.
+type limitedConn struct {
+	net.Conn
+	limiter *upstreamLimiter
+	// putLimiter is whether the connection holds a reference of the limiter, which is put when
+	// the connection is closed.
+	putLimiter bool
+	once       sync.Once
+}
+
+// Close implements net.Conn.Close.
+func (c *limitedConn) Close() error {
+	c.once.Do(func() {
+		c.limiter.release(1)
+		if c.putLimiter {
+			putUpstreamLimiter(c.limiter)
+		}
+	})
+	return c.Conn.Close()
+}
+
+// UpstreamBinlogDialer returns the dialer of binlog streamers, which counts their connections in
+// the max connections of the upstream. It returns nil if max-connections is not set, then the
+// streamers use their default dialer.
+func UpstreamBinlogDialer(cfg *dbconfig.DBConfig) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if cfg.MaxConnections <= 0 {
+		return nil
+	}
+	limitCfg := &dbconfig.DBConfig{Host: cfg.Host, Port: cfg.Port, User: cfg.User, MaxConnections: cfg.MaxConnections}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		// the streamers don't close the dialer, so each connection holds a reference of the limiter.
+		l := getUpstreamLimiter(limitCfg)
+		c, err := l.dialNetwork(ctx, network, addr)
+		if err != nil {
+			putUpstreamLimiter(l)
+			return nil, err
+		}
+		c.putLimiter = true
+		return c, nil
+	}
+}
+
+// UpstreamReservation is the connections reserved from the limited upstream for the users
+// which can't connect the upstream via BaseDB, like dumpling.
+type UpstreamReservation struct {
+	limiter *upstreamLimiter
+	// Count is the number of reserved connections.
+	Count int
+}
+
+// ReserveUpstreamConnections reserves at most n connections to the upstream, it waits until
+// at least min connections are available. It returns nil if max-connections is not set.
+func ReserveUpstreamConnections(ctx context.Context, cfg *dbconfig.DBConfig, min, n int) (*UpstreamReservation, error) {
+	if cfg.MaxConnections <= 0 {
+		return nil, nil
+	}
+	l := getUpstreamLimiter(cfg)
+	if err := l.acquire(ctx, min); err != nil {
+		putUpstreamLimiter(l)
+		return nil, err
+	}
+	count := min + l.tryAcquireUpTo(n-min)
+	return &UpstreamReservation{limiter: l, Count: count}, nil
+}
+
+// Release returns the reserved connections, it's a no-op for a nil UpstreamReservation.
+func (r *UpstreamReservation) Release() {
+	if r == nil || r.limiter == nil {
+		return
+	}
+	r.limiter.release(r.Count)
+	putUpstreamLimiter(r.limiter)
+	r.limiter = nil
+}
+
+// UpstreamConnectionUsage returns the number of connections opened to the upstream by the
+// user in the process and the limit of them, it returns false if max-connections is not set.
+func UpstreamConnectionUsage(cfg *dbconfig.DBConfig) (used, max int, ok bool) {
+	if cfg.MaxConnections <= 0 {
+		return 0, 0, false
+	}
+	upstreamLimiters.Lock()
+	l, exist := upstreamLimiters.m[upstreamKey(cfg)]
+	upstreamLimiters.Unlock()
+	if !exist {
+		return 0, cfg.MaxConnections, true
+	}
+	used, max = l.usage()
+	return used, max, true
+}
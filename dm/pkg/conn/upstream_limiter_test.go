@@ -0,0 +1,173 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamLimiter(t *testing.T) {
+	cfg := &dbconfig.DBConfig{Host: "127.0.0.1", Port: 3306, User: "root", MaxConnections: 3}
+	l := getUpstreamLimiter(cfg)
+	defer putUpstreamLimiter(l)
+	// the limiter is shared by the same user of the upstream.
+	l2 := getUpstreamLimiter(&dbconfig.DBConfig{Host: "127.0.0.1", Port: 3306, User: "root", MaxConnections: 5})
+	require.Same(t, l, l2)
+	putUpstreamLimiter(l2)
+
+	ctx := context.Background()
+	require.NoError(t, l.acquire(ctx, 2))
+	require.Equal(t, 1, l.tryAcquireUpTo(2))
+	used, max, ok := UpstreamConnectionUsage(cfg)
+	require.True(t, ok)
+	require.Equal(t, 3, used)
+	require.Equal(t, 3, max)
+
+	// wait until a connection is released.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, l.acquire(timeoutCtx, 1))
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		l.release(1)
+	}()
+	require.NoError(t, l.acquire(ctx, 1))
+	l.release(3)
+	used, _, _ = UpstreamConnectionUsage(cfg)
+	require.Equal(t, 0, used)
+
+	_, _, ok = UpstreamConnectionUsage(&dbconfig.DBConfig{Host: "127.0.0.1", Port: 3306, User: "root"})
+	require.False(t, ok)
+}
+
+func TestReserveUpstreamConnections(t *testing.T) {
+	ctx := context.Background()
+	cfg := &dbconfig.DBConfig{Host: "127.0.0.1", Port: 3307, User: "root"}
+	r, err := ReserveUpstreamConnections(ctx, cfg, MinUpstreamMaxConnections, 5)
+	require.NoError(t, err)
+	require.Nil(t, r)
+	r.Release()
+
+	cfg.MaxConnections = 4
+	r, err = ReserveUpstreamConnections(ctx, cfg, MinUpstreamMaxConnections, 5)
+	require.NoError(t, err)
+	require.Equal(t, 4, r.Count)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = ReserveUpstreamConnections(timeoutCtx, cfg, MinUpstreamMaxConnections, 5)
+	require.Error(t, err)
+	r.Release()
+	// release twice is a no-op.
+	r.Release()
+
+	used, _, _ := UpstreamConnectionUsage(cfg)
+	require.Equal(t, 0, used)
+	upstreamLimiters.Lock()
+	require.NotContains(t, upstreamLimiters.m, upstreamKey(cfg))
+	upstreamLimiters.Unlock()
+}
+
+func TestLimitedDial(t *testing.T) {
+	port := freeport.GetPort()
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+	listener, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	cfg := &dbconfig.DBConfig{Host: "127.0.0.1", Port: port, User: "root", MaxConnections: 2}
+	l := getUpstreamLimiter(cfg)
+	defer putUpstreamLimiter(l)
+
+	ctx := context.Background()
+	c1, err := l.dial(ctx, addr)
+	require.NoError(t, err)
+	c2, err := l.dial(ctx, addr)
+	require.NoError(t, err)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = l.dial(timeoutCtx, addr)
+	require.Error(t, err)
+
+	// the connection is returned only once.
+	require.NoError(t, c1.Close())
+	c1.Close()
+	used, _ := l.usage()
+	require.Equal(t, 1, used)
+	require.NoError(t, c2.Close())
+	used, _ = l.usage()
+	require.Equal(t, 0, used)
+}
+
+func TestUpstreamBinlogDialer(t *testing.T) {
+	require.Nil(t, UpstreamBinlogDialer(&dbconfig.DBConfig{Host: "127.0.0.1", Port: 3306, User: "root"}))
+
+	port := freeport.GetPort()
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+	listener, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	cfg := &dbconfig.DBConfig{Host: "127.0.0.1", Port: port, User: "root", MaxConnections: 1}
+	dial := UpstreamBinlogDialer(cfg)
+	ctx := context.Background()
+	c, err := dial(ctx, "tcp", addr)
+	require.NoError(t, err)
+	used, _, _ := UpstreamConnectionUsage(cfg)
+	require.Equal(t, 1, used)
+	// the connections of binlog streamers are counted in the same limit.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = dial(timeoutCtx, "tcp", addr)
+	require.Error(t, err)
+
+	// the limiter is removed after the connection is closed.
+	require.NoError(t, c.Close())
+	used, _, _ = UpstreamConnectionUsage(cfg)
+	require.Equal(t, 0, used)
+	upstreamLimiters.Lock()
+	require.NotContains(t, upstreamLimiters.m, upstreamKey(cfg))
+	upstreamLimiters.Unlock()
+}
+
+func TestUpstreamLimiterPools(t *testing.T) {
+	cfg := &dbconfig.DBConfig{Host: "127.0.0.1", Port: 3308, User: "root", MaxConnections: 5}
+	l := getUpstreamLimiter(cfg)
+	defer putUpstreamLimiter(l)
+
+	db1, err := sql.Open("mysql", "root@tcp(127.0.0.1:3308)/")
+	require.NoError(t, err)
+	defer db1.Close()
+	db2, err := sql.Open("mysql", "root@tcp(127.0.0.1:3308)/")
+	require.NoError(t, err)
+	defer db2.Close()
+
+	// each pool opens at most its share of the max connections.
+	l.addPool(db1)
+	require.Equal(t, 5, db1.Stats().MaxOpenConnections)
+	l.addPool(db2)
+	require.Equal(t, 2, db1.Stats().MaxOpenConnections)
+	require.Equal(t, 2, db2.Stats().MaxOpenConnections)
+	l.removePool(db1)
+	require.Equal(t, 5, db2.Stats().MaxOpenConnections)
+	l.removePool(db2)
+}
@@ -23,6 +23,17 @@ func LightningCheckpoint(task string) string {
 	return task + "_lightning_checkpoint_list"
 }
 
+// LoadSyncMarker returns the table name of the marker which is written by load unit
+// and installed by sync unit as its initial checkpoint.
+func LoadSyncMarker(task string) string {
+	return task + "_load_sync_marker"
+}
+
+// LoaderIdempotency returns the default table name of loader's idempotency keys.
+func LoaderIdempotency(task string) string {
+	return task + "_loader_idempotency"
+}
+
 // SyncerCheckpoint returns syncer's checkpoint table name.
 func SyncerCheckpoint(task string) string {
 	return task + "_syncer_checkpoint"
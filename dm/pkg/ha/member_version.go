@@ -0,0 +1,97 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/version"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// MemberVersion represents the version information of a DM-master or DM-worker, it's used to detect
+// the members running with different versions or configs. Only the hash of the config is kept to keep
+// the information small.
+type MemberVersion struct {
+	Version    string `json:"version,omitempty"`
+	GitHash    string `json:"git-hash,omitempty"`
+	ConfigHash string `json:"config-hash,omitempty"`
+}
+
+// NewMemberVersion creates a MemberVersion of the current binary with the content of the local config.
+func NewMemberVersion(cfgContent string) MemberVersion {
+	return MemberVersion{
+		Version:    version.ReleaseVersion,
+		GitHash:    version.GitHash,
+		ConfigHash: ConfigHash(cfgContent),
+	}
+}
+
+// ConfigHash returns the hash of the content of a config.
+func ConfigHash(cfgContent string) string {
+	sum := sha256.Sum256([]byte(cfgContent))
+	return hex.EncodeToString(sum[:])
+}
+
+// PutMasterVersion puts the version information of the DM-master into etcd.
+// k/v: master-name -> MemberVersion.
+func PutMasterVersion(cli *clientv3.Client, name string, v MemberVersion) (int64, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, terror.ErrHAInvalidItem.Delegate(err, fmt.Sprintf("failed to marshal master version: %+v", v))
+	}
+	key := common.MasterVersionKeyAdapter.Encode(name)
+	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(clientv3.OpPut(key, string(data))))
+	return rev, err
+}
+
+// GetAllMasterVersion gets the version information of all DM-masters in etcd currently.
+// k/v: master-name -> MemberVersion.
+func GetAllMasterVersion(cli *clientv3.Client) (map[string]MemberVersion, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.MasterVersionKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, terror.ErrHAFailTxnOperation.Delegate(err, "failed to get all master version")
+	}
+
+	vm := make(map[string]MemberVersion, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys, err2 := common.MasterVersionKeyAdapter.Decode(string(kv.Key))
+		if err2 != nil {
+			return nil, 0, err2
+		}
+		var v MemberVersion
+		if err2 = json.Unmarshal(kv.Value, &v); err2 != nil {
+			return nil, 0, terror.ErrHAInvalidItem.Delegate(err2, fmt.Sprintf("failed to unmarshal master version: %s", kv.Value))
+		}
+		vm[keys[0]] = v
+	}
+	return vm, resp.Header.Revision, nil
+}
+
+// DeleteMasterVersion deletes the version information of the DM-master.
+func DeleteMasterVersion(cli *clientv3.Client, name string) (int64, error) {
+	key := common.MasterVersionKeyAdapter.Encode(name)
+	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(clientv3.OpDelete(key)))
+	return rev, err
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestMasterVersionEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	vm, _, err := GetAllMasterVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(vm, HasLen, 0)
+
+	v1 := NewMemberVersion("name = \"master-1\"")
+	c.Assert(v1.ConfigHash, Equals, ConfigHash("name = \"master-1\""))
+	c.Assert(v1.ConfigHash, Not(Equals), ConfigHash("name = \"master-2\""))
+	v2 := MemberVersion{Version: "v6.5.1", GitHash: "abc", ConfigHash: ConfigHash("")}
+	_, err = PutMasterVersion(etcdTestCli, "master-1", v1)
+	c.Assert(err, IsNil)
+	_, err = PutMasterVersion(etcdTestCli, "master-2", v2)
+	c.Assert(err, IsNil)
+
+	vm, _, err = GetAllMasterVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(vm, DeepEquals, map[string]MemberVersion{"master-1": v1, "master-2": v2})
+
+	_, err = DeleteMasterVersion(etcdTestCli, "master-1")
+	c.Assert(err, IsNil)
+	vm, _, err = GetAllMasterVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(vm, DeepEquals, map[string]MemberVersion{"master-2": v2})
+}
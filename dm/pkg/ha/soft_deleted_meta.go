@@ -0,0 +1,116 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// SoftDeletedTaskMeta records a task which is stopped with `--keep-meta-days`. Its downstream meta data is kept
+// until ExpireTime, so the task can resume from the checkpoints if it's started again before that.
+type SoftDeletedTaskMeta struct {
+	Task string `json:"task"`
+	// SubTaskCfgs are the TOML encoded subtask configs of the task when it's stopped, they are used to locate
+	// the meta data in the downstream.
+	SubTaskCfgs []string  `json:"subtask-cfgs"`
+	DeleteTime  time.Time `json:"delete-time"`
+	ExpireTime  time.Time `json:"expire-time"`
+	// RestoredTime is set when the task is started again and resumes from the kept meta data.
+	RestoredTime time.Time `json:"restored-time"`
+}
+
+// IsRestored returns whether the task has been started again after it's soft deleted.
+func (m *SoftDeletedTaskMeta) IsRestored() bool {
+	return !m.RestoredTime.IsZero()
+}
+
+// IsExpired returns whether the kept meta data is expired at now.
+func (m *SoftDeletedTaskMeta) IsExpired(now time.Time) bool {
+	return !now.Before(m.ExpireTime)
+}
+
+func softDeletedTaskMetaFromJSON(data []byte) (*SoftDeletedTaskMeta, error) {
+	meta := &SoftDeletedTaskMeta{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, terror.ErrHAInvalidItem.Delegate(err, "fail to unmarshal soft deleted task meta")
+	}
+	return meta, nil
+}
+
+// PutSoftDeletedTaskMeta puts the soft deleted meta of a task into etcd, the old one is overwritten.
+func PutSoftDeletedTaskMeta(cli *clientv3.Client, meta *SoftDeletedTaskMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return terror.ErrHAInvalidItem.Delegate(err, fmt.Sprintf("fail to marshal soft deleted task meta of task %s", meta.Task))
+	}
+	op := clientv3.OpPut(common.SoftDeletedTaskMetaKeyAdapter.Encode(meta.Task), string(data))
+	_, _, err = etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
+
+// GetSoftDeletedTaskMeta gets the soft deleted meta of the specified task, nil is returned if not exist.
+func GetSoftDeletedTaskMeta(cli *clientv3.Client, taskName string) (*SoftDeletedTaskMeta, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.SoftDeletedTaskMetaKeyAdapter.Encode(taskName))
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, fmt.Sprintf("fail to get soft deleted task meta, task: %s", taskName))
+	}
+
+	if resp.Count == 0 {
+		return nil, nil
+	} else if resp.Count > 1 {
+		// this should not happen.
+		return nil, terror.ErrConfigMoreThanOne.Generate(resp.Count, "SoftDeletedTaskMeta", "task: "+taskName)
+	}
+	return softDeletedTaskMetaFromJSON(resp.Kvs[0].Value)
+}
+
+// GetAllSoftDeletedTaskMeta gets the soft deleted meta of all tasks.
+// k/v: task name -> soft deleted meta.
+func GetAllSoftDeletedTaskMeta(cli *clientv3.Client) (map[string]*SoftDeletedTaskMeta, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.SoftDeletedTaskMetaKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "fail to get all soft deleted task meta")
+	}
+
+	metas := make(map[string]*SoftDeletedTaskMeta, resp.Count)
+	for _, kv := range resp.Kvs {
+		meta, err2 := softDeletedTaskMetaFromJSON(kv.Value)
+		if err2 != nil {
+			return nil, err2
+		}
+		metas[meta.Task] = meta
+	}
+	return metas, nil
+}
+
+// DeleteSoftDeletedTaskMeta deletes the soft deleted meta of the specified task.
+func DeleteSoftDeletedTaskMeta(cli *clientv3.Client, taskName string) error {
+	op := clientv3.OpDelete(common.SoftDeletedTaskMetaKeyAdapter.Encode(taskName))
+	_, _, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(op))
+	return err
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestSoftDeletedTaskMeta(c *C) {
+	defer clearTestInfoOperation(c)
+
+	task1 := "test-soft-deleted-1"
+	task2 := "test-soft-deleted-2"
+
+	ret, err := GetSoftDeletedTaskMeta(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ret, IsNil)
+	all, err := GetAllSoftDeletedTaskMeta(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 0)
+
+	now := time.Now().Truncate(time.Second)
+	meta1 := &SoftDeletedTaskMeta{
+		Task:        task1,
+		SubTaskCfgs: []string{"name = \"test-soft-deleted-1\""},
+		DeleteTime:  now,
+		ExpireTime:  now.Add(24 * time.Hour),
+	}
+	meta2 := &SoftDeletedTaskMeta{
+		Task:       task2,
+		DeleteTime: now,
+		ExpireTime: now.Add(48 * time.Hour),
+	}
+	c.Assert(PutSoftDeletedTaskMeta(etcdTestCli, meta1), IsNil)
+	c.Assert(PutSoftDeletedTaskMeta(etcdTestCli, meta2), IsNil)
+
+	ret, err = GetSoftDeletedTaskMeta(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ret.Task, Equals, task1)
+	c.Assert(ret.SubTaskCfgs, DeepEquals, meta1.SubTaskCfgs)
+	c.Assert(ret.ExpireTime.Equal(meta1.ExpireTime), IsTrue)
+	c.Assert(ret.IsRestored(), IsFalse)
+	c.Assert(ret.IsExpired(now), IsFalse)
+	c.Assert(ret.IsExpired(now.Add(24*time.Hour)), IsTrue)
+
+	// put will overwrite
+	meta1.RestoredTime = now.Add(time.Hour)
+	c.Assert(PutSoftDeletedTaskMeta(etcdTestCli, meta1), IsNil)
+	all, err = GetAllSoftDeletedTaskMeta(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 2)
+	c.Assert(all[task1].IsRestored(), IsTrue)
+	c.Assert(all[task2].IsRestored(), IsFalse)
+
+	c.Assert(DeleteSoftDeletedTaskMeta(etcdTestCli, task1), IsNil)
+	ret, err = GetSoftDeletedTaskMeta(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ret, IsNil)
+	all, err = GetAllSoftDeletedTaskMeta(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 1)
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/common"
 	"github.com/pingcap/tiflow/dm/config"
@@ -35,6 +36,11 @@ type Stage struct {
 	Source string   `json:"source"`         // the source ID of the upstream.
 	Task   string   `json:"task,omitempty"` // the task name for subtask; empty for relay.
 
+	// only used for a paused subtask, the sync unit pauses after applying all the binlog events
+	// not later than PauseAtTime (unix seconds), or pauses anyway at PauseAtDeadline.
+	PauseAtTime     int64 `json:"pause-at-time,omitempty"`
+	PauseAtDeadline int64 `json:"pause-at-deadline,omitempty"`
+
 	// only used to report to the caller of the watcher, do not marsh it.
 	// if it's true, it means the stage has been deleted in etcd.
 	IsDeleted bool `json:"-"`
@@ -52,6 +58,17 @@ func NewSubTaskStage(expect pb.Stage, source, task string) Stage {
 	return newStage(expect, source, task)
 }
 
+// NewSubTaskPauseAtStage creates a new paused Stage instance for subtask, which
+// asks the sync unit to pause after applying all the binlog events not later than target.
+func NewSubTaskPauseAtStage(source, task string, target, deadline time.Time) Stage {
+	stage := newStage(pb.Stage_Paused, source, task)
+	stage.PauseAtTime = target.Unix()
+	if !deadline.IsZero() {
+		stage.PauseAtDeadline = deadline.Unix()
+	}
+	return stage
+}
+
 func NewValidatorStage(expect pb.Stage, source, task string) Stage {
 	return newStage(expect, source, task)
 }
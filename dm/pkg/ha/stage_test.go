@@ -44,6 +44,18 @@ func (t *testForEtcd) TestStageJSON(c *C) {
 	sts2, err := stageFromJSON(j)
 	c.Assert(err, IsNil)
 	c.Assert(sts2, DeepEquals, sts1)
+
+	// paused stage for subtask with a pause-at target.
+	target := time.Unix(1672531200, 0)
+	sts3 := NewSubTaskPauseAtStage("mysql-replica-1", "task1", target, target.Add(10*time.Minute))
+	j, err = sts3.toJSON()
+	c.Assert(err, IsNil)
+	c.Assert(j, Equals, `{"expect":3,"source":"mysql-replica-1","task":"task1","pause-at-time":1672531200,"pause-at-deadline":1672531800}`)
+
+	sts4, err := stageFromJSON(j)
+	c.Assert(err, IsNil)
+	c.Assert(sts4, DeepEquals, sts3)
+	c.Assert(NewSubTaskPauseAtStage("mysql-replica-1", "task1", target, time.Time{}).PauseAtDeadline, Equals, int64(0))
 }
 
 func (t *testForEtcd) TestRelayStageEtcd(c *C) {
@@ -28,6 +28,9 @@ import (
 type WorkerInfo struct {
 	Name string `json:"name"` // the name of the node.
 	Addr string `json:"addr"` // the client address of the node to advertise.
+
+	// MemberVersion is reported when the DM-worker registers, it's empty for the DM-worker of old versions.
+	MemberVersion
 }
 
 // NewWorkerInfo creates a new WorkerInfo instance.
@@ -28,6 +28,15 @@ func (t *testForEtcd) TestWorkerInfoJSON(c *C) {
 	i2, err := workerInfoFromJSON(j)
 	c.Assert(err, IsNil)
 	c.Assert(i2, DeepEquals, i1)
+
+	// with version information.
+	i1.MemberVersion = MemberVersion{Version: "v6.5.0", GitHash: "abc", ConfigHash: "def"}
+	j, err = i1.toJSON()
+	c.Assert(err, IsNil)
+	c.Assert(j, Equals, `{"name":"dm-worker-1","addr":"192.168.0.100:8262","version":"v6.5.0","git-hash":"abc","config-hash":"def"}`)
+	i2, err = workerInfoFromJSON(j)
+	c.Assert(err, IsNil)
+	c.Assert(i2, DeepEquals, i1)
 }
 
 func (t *testForEtcd) TestWorkerInfoEtcd(c *C) {
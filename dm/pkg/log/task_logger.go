@@ -0,0 +1,103 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sync"
+
+	pclog "github.com/pingcap/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type taskKey struct {
+	task   string
+	source string
+}
+
+// task loggers, keyed by task name and source ID.
+var (
+	taskLoggersMu sync.RWMutex
+	taskLoggers   = make(map[taskKey]*taskLogger)
+)
+
+// taskLogger is the logger of a subtask which writes to a separate file with
+// an independent level.
+type taskLogger struct {
+	logger Logger
+	file   *lumberjack.Logger
+}
+
+// InitTaskLogger creates the logger of the subtask by cfg, later ForTask
+// returns it until CloseTaskLogger is called. The log file is rotated by size.
+// The logs of the subtask are not written to the global logger anymore.
+func InitTaskLogger(task, source string, cfg *Config) error {
+	cfg.Adjust()
+	file := &lumberjack.Logger{
+		Filename:   cfg.File,
+		MaxSize:    cfg.FileMaxSize,
+		MaxAge:     cfg.FileMaxDays,
+		MaxBackups: cfg.FileMaxBackups,
+		LocalTime:  true,
+	}
+	output := zapcore.AddSync(file)
+	logger, _, err := pclog.InitLoggerWithWriteSyncer(&pclog.Config{
+		Level:  cfg.Level,
+		Format: cfg.Format,
+	}, output, output)
+	if err != nil {
+		return terror.ErrInitLoggerFail.Delegate(err)
+	}
+	logger = logger.WithOptions(zap.AddStacktrace(zap.DPanicLevel))
+
+	key := taskKey{task: task, source: source}
+	taskLoggersMu.Lock()
+	old := taskLoggers[key]
+	taskLoggers[key] = &taskLogger{logger: Logger{logger}, file: file}
+	taskLoggersMu.Unlock()
+	if old != nil {
+		old.close()
+	}
+	return nil
+}
+
+// ForTask returns the logger of the subtask if it's initialized by
+// InitTaskLogger, otherwise returns the global logger.
+func ForTask(task, source string) Logger {
+	taskLoggersMu.RLock()
+	defer taskLoggersMu.RUnlock()
+	if l, ok := taskLoggers[taskKey{task: task, source: source}]; ok {
+		return l.logger
+	}
+	return appLogger
+}
+
+// CloseTaskLogger removes the logger of the subtask and closes its log file.
+func CloseTaskLogger(task, source string) {
+	key := taskKey{task: task, source: source}
+	taskLoggersMu.Lock()
+	l := taskLoggers[key]
+	delete(taskLoggers, key)
+	taskLoggersMu.Unlock()
+	if l != nil {
+		l.close()
+	}
+}
+
+func (l *taskLogger) close() {
+	_ = l.logger.Sync()
+	_ = l.file.Close()
+}
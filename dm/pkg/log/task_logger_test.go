@@ -0,0 +1,54 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogger(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "task-source.log")
+
+	// not initialized, fallback to the global logger.
+	require.Same(t, L().Logger, ForTask("task", "source").Logger)
+
+	require.NoError(t, InitTaskLogger("task", "source", &Config{Level: "debug", File: file}))
+	logger := ForTask("task", "source")
+	require.NotSame(t, L().Logger, logger.Logger)
+	require.Same(t, L().Logger, ForTask("task", "another-source").Logger)
+
+	logger.Debug("debug message of task")
+	require.NoError(t, logger.Sync())
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "debug message of task")
+
+	CloseTaskLogger("task", "source")
+	require.Same(t, L().Logger, ForTask("task", "source").Logger)
+
+	// the level is independent.
+	require.NoError(t, InitTaskLogger("task", "source", &Config{Level: "warn", File: file}))
+	logger = ForTask("task", "source")
+	logger.Info("info message of task")
+	require.NoError(t, logger.Sync())
+	content, err = os.ReadFile(file)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "info message of task")
+	CloseTaskLogger("task", "source")
+}
@@ -14,6 +14,7 @@
 package retry
 
 import (
+	"math/rand"
 	"time"
 
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -38,6 +39,10 @@ type Params struct {
 	FirstRetryDuration time.Duration
 
 	BackoffStrategy backoffStrategy
+	// MaxJitter is the upper bound of a random duration added to every retry
+	// wait, so that the retries of conflicting operations are spread out.
+	// It's 0 to not add jitter.
+	MaxJitter time.Duration
 
 	// IsRetryableFn tells whether we should retry when operateFn failed
 	// params: (number of retry, error of operation)
@@ -78,11 +83,16 @@ type Strategy interface {
 type FiniteRetryStrategy struct{}
 
 // Apply for FiniteRetryStrategy, it wait `FirstRetryDuration` before it starts first retry, and then rest of retries wait time depends on BackoffStrategy.
+// It returns the error of the last operation as soon as the context is canceled, even if it's waiting for the next retry.
 func (*FiniteRetryStrategy) Apply(ctx *tcontext.Context, params Params, operateFn OperateFunc,
 ) (ret interface{}, i int, err error) {
 	for ; i < params.RetryCount; i++ {
 		ret, err = operateFn(ctx)
 		if err != nil {
+			// don't retry, e.g. reset the connection in IsRetryableFn, if the operation is canceled.
+			if ctx.Context().Err() != nil {
+				return ret, i, err
+			}
 			if params.IsRetryableFn(i, err) {
 				duration := params.FirstRetryDuration
 
@@ -91,12 +101,17 @@ func (*FiniteRetryStrategy) Apply(ctx *tcontext.Context, params Params, operateF
 					duration = time.Duration(i+1) * params.FirstRetryDuration
 				default:
 				}
+				if params.MaxJitter > 0 {
+					duration += time.Duration(rand.Int63n(int64(params.MaxJitter)))
+				}
 				log.L().Warn("retry stratey takes effect", zap.Error(err), zap.Int("retry_times", i), zap.Int("retry_count", params.RetryCount))
 
+				timer := time.NewTimer(duration)
 				select {
 				case <-ctx.Context().Done():
+					timer.Stop()
 					return ret, i, err // return `ret` rather than `nil`
-				case <-time.After(duration):
+				case <-timer.C:
 				}
 				continue
 			}
@@ -14,6 +14,7 @@
 package retry
 
 import (
+	"context"
 	"database/sql/driver"
 	"testing"
 	"time"
@@ -87,3 +88,66 @@ func TestFiniteRetryStrategy(t *testing.T) {
 	require.Equal(t, 0, opCount)
 	require.NoError(t, err)
 }
+
+func TestFiniteRetryStrategyJitter(t *testing.T) {
+	t.Parallel()
+	strategy := &FiniteRetryStrategy{}
+
+	params := Params{
+		RetryCount:         3,
+		BackoffStrategy:    Stable,
+		FirstRetryDuration: 10 * time.Millisecond,
+		MaxJitter:          20 * time.Millisecond,
+		IsRetryableFn: func(int, error) bool {
+			return true
+		},
+	}
+	operateFn := func(*tcontext.Context) (interface{}, error) {
+		return nil, terror.ErrDBDriverError.Generate("test database error")
+	}
+
+	start := time.Now()
+	_, opCount, err := strategy.Apply(tcontext.Background(), params, operateFn)
+	elapsed := time.Since(start)
+	require.Equal(t, params.RetryCount, opCount)
+	require.True(t, terror.ErrDBDriverError.Equal(err))
+	require.GreaterOrEqual(t, elapsed, 3*params.FirstRetryDuration)
+}
+
+func TestFiniteRetryStrategyCancel(t *testing.T) {
+	t.Parallel()
+	strategy := &FiniteRetryStrategy{}
+
+	var retryableCalled int
+	params := Params{
+		RetryCount:         3,
+		BackoffStrategy:    Stable,
+		FirstRetryDuration: time.Hour,
+		IsRetryableFn: func(int, error) bool {
+			retryableCalled++
+			return true
+		},
+	}
+	operateFn := func(*tcontext.Context) (interface{}, error) {
+		return nil, terror.ErrDBDriverError.Generate("test database error")
+	}
+
+	// cancel during the backoff.
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	_, opCount, err := strategy.Apply(tcontext.NewContext(ctx, tcontext.Background().L()), params, operateFn)
+	require.Less(t, time.Since(start), 10*time.Second)
+	require.Equal(t, 0, opCount)
+	require.Equal(t, 1, retryableCalled)
+	require.True(t, terror.ErrDBDriverError.Equal(err))
+
+	// the operation is canceled, it's not retried.
+	_, opCount, err = strategy.Apply(tcontext.NewContext(ctx, tcontext.Background().L()), params, operateFn)
+	require.Equal(t, 0, opCount)
+	require.Equal(t, 1, retryableCalled)
+	require.True(t, terror.ErrDBDriverError.Equal(err))
+}
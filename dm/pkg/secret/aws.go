@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// AWSConfig is the config of AWS Secrets Manager. The credentials are got by the
+// default credential chain of AWS SDK, e.g. environment variables, shared
+// credentials file or the IAM role.
+type AWSConfig struct {
+	Region string `toml:"region" json:"region"`
+	// Endpoint overrides the endpoint of AWS Secrets Manager, e.g. a VPC endpoint.
+	Endpoint string `toml:"endpoint" json:"endpoint"`
+}
+
+type awsProvider struct {
+	client *secretsmanager.SecretsManager
+}
+
+func newAWSProvider(cfg AWSConfig) (*awsProvider, error) {
+	awsCfg := aws.Config{Region: aws.String(cfg.Region)}
+	if cfg.Endpoint != "" {
+		awsCfg.Endpoint = aws.String(cfg.Endpoint)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            awsCfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, terror.ErrConfigInvalidSecretProvider.Delegate(err, "aws")
+	}
+	return &awsProvider{client: secretsmanager.New(sess)}, nil
+}
+
+func (p *awsProvider) getSecret(ctx context.Context, ref Reference) (string, error) {
+	out, err := p.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ref.Path),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case "AccessDeniedException":
+				return "", errors.Errorf("AWS Secrets Manager denied access to secret %s, check that the credentials of DM are allowed to call secretsmanager:GetSecretValue on it",
+					ref.Path)
+			case secretsmanager.ErrCodeResourceNotFoundException:
+				return "", errors.Errorf("secret %s is not found in AWS Secrets Manager", ref.Path)
+			}
+			// only keep the code, the message of AWS doesn't contain the secret but
+			// may be verbose.
+			return "", errors.Errorf("AWS Secrets Manager returned %s for secret %s", aerr.Code(), ref.Path)
+		}
+		return "", errors.Annotatef(err, "get secret %s from AWS Secrets Manager", ref.Path)
+	}
+	if out.SecretString == nil {
+		return "", errors.Errorf("secret %s in AWS Secrets Manager is not a string", ref.Path)
+	}
+	return extractKey(*out.SecretString, ref)
+}
+
+// extractKey returns the value of ref.Key in the JSON secret, or the secret itself
+// if ref.Key is empty.
+func extractKey(secret string, ref Reference) (string, error) {
+	if ref.Key == "" {
+		return secret, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", errors.Errorf("secret %s is not a JSON object, so key %s can't be read from it", ref.Path, ref.Key)
+	}
+	value, ok := fields[ref.Key].(string)
+	if !ok {
+		return "", errors.Errorf("key %s is not found in secret %s or is not a string", ref.Key, ref.Path)
+	}
+	return value, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+const (
+	// SchemeVault is the scheme of a secret in HashiCorp Vault, the reference is
+	// `vault://<path>#<key>`, e.g. `vault://secret/data/dm/mysql-01#password`.
+	SchemeVault = "vault"
+	// SchemeAWSSecretsManager is the scheme of a secret in AWS Secrets Manager, the
+	// reference is `aws-sm://<name>` for a plaintext secret, or `aws-sm://<name>#<key>`
+	// for a key of a JSON secret.
+	SchemeAWSSecretsManager = "aws-sm"
+
+	defaultCacheTTL       = 5 * time.Minute
+	defaultResolveTimeout = 10 * time.Second
+)
+
+// Reference is a reference to a secret in an external secret provider, it's
+// used in place of a password in the source and task configs.
+type Reference struct {
+	Scheme string
+	// Path is the path of the secret in Vault, or the name of the secret in AWS Secrets Manager.
+	Path string
+	// Key is the key in the secret.
+	Key string
+}
+
+// String implements fmt.Stringer.
+func (r Reference) String() string {
+	s := r.Scheme + "://" + r.Path
+	if r.Key != "" {
+		s += "#" + r.Key
+	}
+	return s
+}
+
+// IsReference returns whether s is a reference to a secret rather than a password.
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, SchemeVault+"://") || strings.HasPrefix(s, SchemeAWSSecretsManager+"://")
+}
+
+// ParseReference parses a reference to a secret.
+func ParseReference(s string) (Reference, error) {
+	scheme, rest, ok := strings.Cut(s, "://")
+	if !ok || (scheme != SchemeVault && scheme != SchemeAWSSecretsManager) {
+		return Reference{}, terror.ErrConfigResolveSecret.Generatef(
+			"fail to resolve secret %s, the scheme should be %s or %s", s, SchemeVault, SchemeAWSSecretsManager)
+	}
+	path, key, _ := strings.Cut(rest, "#")
+	ref := Reference{Scheme: scheme, Path: path, Key: key}
+	if path == "" {
+		return Reference{}, terror.ErrConfigResolveSecret.Generatef("fail to resolve secret %s, the path is empty", s)
+	}
+	if scheme == SchemeVault && key == "" {
+		return Reference{}, terror.ErrConfigResolveSecret.Generatef(
+			"fail to resolve secret %s, the key is empty, the reference should be vault://<path>#<key>", s)
+	}
+	return ref, nil
+}
+
+// provider gets secrets from an external secret provider.
+type provider interface {
+	getSecret(ctx context.Context, ref Reference) (string, error)
+}
+
+// Config is the config of the external secret providers, a provider is enabled
+// when it's configured.
+type Config struct {
+	Vault VaultConfig `toml:"vault" json:"vault"`
+	AWS   AWSConfig   `toml:"aws" json:"aws"`
+	// CacheTTL is how long a resolved secret is cached, default 5m.
+	CacheTTL string `toml:"cache-ttl" json:"cache-ttl"`
+}
+
+type cachedSecret struct {
+	value     string
+	expiredAt time.Time
+}
+
+// Resolver resolves the references to secrets, and caches the resolved secrets.
+// It's thread-safe.
+type Resolver struct {
+	providers map[string]provider
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+// NewResolver creates a Resolver with the providers configured in cfg.
+func NewResolver(cfg Config) (*Resolver, error) {
+	ttl := defaultCacheTTL
+	if cfg.CacheTTL != "" {
+		var err error
+		ttl, err = time.ParseDuration(cfg.CacheTTL)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidSecretProvider.Delegate(err, "cache-ttl "+cfg.CacheTTL)
+		}
+		if ttl < 0 {
+			return nil, terror.ErrConfigInvalidSecretProvider.Generate("cache-ttl must not be negative")
+		}
+	}
+	r := &Resolver{
+		providers: make(map[string]provider),
+		ttl:       ttl,
+		now:       time.Now,
+		cache:     make(map[string]cachedSecret),
+	}
+	if cfg.Vault.Addr != "" {
+		p, err := newVaultProvider(cfg.Vault)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[SchemeVault] = p
+	}
+	if cfg.AWS.Region != "" {
+		p, err := newAWSProvider(cfg.AWS)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[SchemeAWSSecretsManager] = p
+	}
+	return r, nil
+}
+
+// Resolve returns the secret referenced by s, or s itself if it's not a reference.
+// The secret is never logged or returned in the error.
+func (r *Resolver) Resolve(ctx context.Context, s string) (string, error) {
+	if !IsReference(s) {
+		return s, nil
+	}
+	ref, err := ParseReference(s)
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	cached, ok := r.cache[s]
+	r.mu.Unlock()
+	if ok && r.now().Before(cached.expiredAt) {
+		return cached.value, nil
+	}
+
+	p, ok := r.providers[ref.Scheme]
+	if !ok {
+		return "", terror.ErrConfigResolveSecret.Generatef(
+			"fail to resolve secret %s, the %s provider is not configured in secret-providers", s, ref.Scheme)
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultResolveTimeout)
+	defer cancel()
+	value, err := p.getSecret(ctx, ref)
+	if err != nil {
+		return "", terror.ErrConfigResolveSecret.Delegate(err, s)
+	}
+
+	r.mu.Lock()
+	r.cache[s] = cachedSecret{value: value, expiredAt: r.now().Add(r.ttl)}
+	r.mu.Unlock()
+	log.L().Info("resolved secret", zap.Stringer("reference", ref))
+	return value, nil
+}
+
+// Invalidate drops the cached secret referenced by s, so that it's resolved from
+// the provider again next time, e.g. after it's rejected by the database.
+func (r *Resolver) Invalidate(s string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, s)
+}
+
+var (
+	globalMu       sync.RWMutex
+	globalResolver = &Resolver{
+		providers: make(map[string]provider),
+		now:       time.Now,
+		cache:     make(map[string]cachedSecret),
+	}
+)
+
+// InitGlobalResolver sets the Resolver used by Resolve and Invalidate, it's called
+// when DM-master and DM-worker start.
+func InitGlobalResolver(cfg Config) error {
+	r, err := NewResolver(cfg)
+	if err != nil {
+		return err
+	}
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalResolver = r
+	return nil
+}
+
+func getGlobalResolver() *Resolver {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return globalResolver
+}
+
+// Resolve returns the secret referenced by s by the global Resolver, or s itself
+// if it's not a reference.
+func Resolve(ctx context.Context, s string) (string, error) {
+	return getGlobalResolver().Resolve(ctx, s)
+}
+
+// Invalidate drops the cached secret referenced by s of the global Resolver.
+func Invalidate(s string) {
+	getGlobalResolver().Invalidate(s)
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("vault://secret/data/dm/mysql-01#password")
+	require.NoError(t, err)
+	require.Equal(t, Reference{Scheme: SchemeVault, Path: "secret/data/dm/mysql-01", Key: "password"}, ref)
+	require.Equal(t, "vault://secret/data/dm/mysql-01#password", ref.String())
+
+	ref, err = ParseReference("aws-sm://dm/mysql-01")
+	require.NoError(t, err)
+	require.Equal(t, Reference{Scheme: SchemeAWSSecretsManager, Path: "dm/mysql-01"}, ref)
+
+	for _, s := range []string{"vault://secret/dm", "vault://#password", "aws-sm://", "123456"} {
+		_, err = ParseReference(s)
+		require.True(t, terror.ErrConfigResolveSecret.Equal(err), s)
+	}
+
+	require.True(t, IsReference("vault://a#b"))
+	require.True(t, IsReference("aws-sm://a"))
+	require.False(t, IsReference("123456"))
+	require.False(t, IsReference("dmFzdA=="))
+}
+
+func TestResolveVault(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/dm":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"kv2-pass"},"metadata":{"version":1}}}`))
+		case "/v1/kv/dm":
+			_, _ = w.Write([]byte(`{"data":{"password":"kv1-pass"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("test-token\n"), 0o600))
+	r, err := NewResolver(Config{Vault: VaultConfig{Addr: server.URL, TokenFile: tokenFile}})
+	require.NoError(t, err)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	// not a reference.
+	pass, err := r.Resolve(ctx, "123456")
+	require.NoError(t, err)
+	require.Equal(t, "123456", pass)
+
+	pass, err = r.Resolve(ctx, "vault://secret/data/dm#password")
+	require.NoError(t, err)
+	require.Equal(t, "kv2-pass", pass)
+	pass, err = r.Resolve(ctx, "vault://kv/dm#password")
+	require.NoError(t, err)
+	require.Equal(t, "kv1-pass", pass)
+	require.Equal(t, 2, requests)
+
+	// cached.
+	_, err = r.Resolve(ctx, "vault://secret/data/dm#password")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+	// invalidated.
+	r.Invalidate("vault://secret/data/dm#password")
+	_, err = r.Resolve(ctx, "vault://secret/data/dm#password")
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+	// expired.
+	now = now.Add(defaultCacheTTL)
+	_, err = r.Resolve(ctx, "vault://kv/dm#password")
+	require.NoError(t, err)
+	require.Equal(t, 4, requests)
+
+	_, err = r.Resolve(ctx, "vault://secret/data/dm#user")
+	require.True(t, terror.ErrConfigResolveSecret.Equal(err))
+	require.Contains(t, err.Error(), "key user is not found")
+	_, err = r.Resolve(ctx, "vault://secret/data/other#password")
+	require.True(t, terror.ErrConfigResolveSecret.Equal(err))
+	require.Contains(t, err.Error(), "not found")
+
+	// the token is read again, and the secret is never in the error.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("wrong-token"), 0o600))
+	r.Invalidate("vault://kv/dm#password")
+	_, err = r.Resolve(ctx, "vault://kv/dm#password")
+	require.True(t, terror.ErrConfigResolveSecret.Equal(err))
+	require.Contains(t, err.Error(), "denied")
+	require.NotContains(t, err.Error(), "kv1-pass")
+	require.NotContains(t, err.Error(), "wrong-token")
+}
+
+func TestResolverConfig(t *testing.T) {
+	r, err := NewResolver(Config{})
+	require.NoError(t, err)
+	_, err = r.Resolve(context.Background(), "aws-sm://dm/mysql-01")
+	require.True(t, terror.ErrConfigResolveSecret.Equal(err))
+	require.Contains(t, err.Error(), "the aws-sm provider is not configured")
+
+	_, err = NewResolver(Config{CacheTTL: "1x"})
+	require.True(t, terror.ErrConfigInvalidSecretProvider.Equal(err))
+	_, err = NewResolver(Config{Vault: VaultConfig{Addr: "vault:8200", TokenFile: "token"}})
+	require.True(t, terror.ErrConfigInvalidSecretProvider.Equal(err))
+	t.Setenv(vaultTokenEnv, "")
+	_, err = NewResolver(Config{Vault: VaultConfig{Addr: "http://vault:8200"}})
+	require.True(t, terror.ErrConfigInvalidSecretProvider.Equal(err))
+	t.Setenv(vaultTokenEnv, "test-token")
+	_, err = NewResolver(Config{Vault: VaultConfig{Addr: "http://vault:8200"}})
+	require.NoError(t, err)
+}
+
+func TestExtractKey(t *testing.T) {
+	ref := Reference{Scheme: SchemeAWSSecretsManager, Path: "dm"}
+	v, err := extractKey("plain-pass", ref)
+	require.NoError(t, err)
+	require.Equal(t, "plain-pass", v)
+
+	ref.Key = "password"
+	v, err = extractKey(`{"username":"root","password":"json-pass"}`, ref)
+	require.NoError(t, err)
+	require.Equal(t, "json-pass", v)
+	_, err = extractKey("plain-pass", ref)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "plain-pass")
+	ref.Key = "port"
+	_, err = extractKey(`{"port":3306}`, ref)
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+const vaultTokenEnv = "VAULT_TOKEN"
+
+// VaultConfig is the config of HashiCorp Vault. The token is read from TokenFile,
+// or the VAULT_TOKEN environment variable if TokenFile is empty, so that it's
+// never written in the config file.
+type VaultConfig struct {
+	// Addr is the address of Vault, e.g. https://vault.example.com:8200.
+	Addr      string `toml:"addr" json:"addr"`
+	TokenFile string `toml:"token-file" json:"token-file"`
+	// Namespace is the Vault Enterprise namespace.
+	Namespace string `toml:"namespace" json:"namespace"`
+}
+
+type vaultProvider struct {
+	cfg    VaultConfig
+	client *http.Client
+}
+
+func newVaultProvider(cfg VaultConfig) (*vaultProvider, error) {
+	if !strings.HasPrefix(cfg.Addr, "http://") && !strings.HasPrefix(cfg.Addr, "https://") {
+		return nil, terror.ErrConfigInvalidSecretProvider.Generate(
+			fmt.Sprintf("vault addr %s should start with http:// or https://", cfg.Addr))
+	}
+	if cfg.TokenFile == "" && os.Getenv(vaultTokenEnv) == "" {
+		return nil, terror.ErrConfigInvalidSecretProvider.Generate(
+			"vault token-file is not set and the " + vaultTokenEnv + " environment variable is empty")
+	}
+	cfg.Addr = strings.TrimSuffix(cfg.Addr, "/")
+	return &vaultProvider{cfg: cfg, client: &http.Client{}}, nil
+}
+
+// token reads the token every time, so that a rotated token is picked up.
+func (p *vaultProvider) token() (string, error) {
+	if p.cfg.TokenFile == "" {
+		return os.Getenv(vaultTokenEnv), nil
+	}
+	b, err := os.ReadFile(p.cfg.TokenFile)
+	if err != nil {
+		return "", errors.Annotatef(err, "read vault token-file %s", p.cfg.TokenFile)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func (p *vaultProvider) getSecret(ctx context.Context, ref Reference) (string, error) {
+	token, err := p.token()
+	if err != nil {
+		return "", err
+	}
+	url := p.cfg.Addr + "/v1/" + strings.TrimPrefix(ref.Path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Annotatef(err, "request vault %s", p.cfg.Addr)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return "", errors.Errorf("vault denied access to path %s (HTTP %d), check that the token is valid and its policy can read the path",
+			ref.Path, resp.StatusCode)
+	case http.StatusNotFound:
+		return "", errors.Errorf("vault path %s is not found", ref.Path)
+	default:
+		return "", errors.Errorf("vault returned HTTP %d for path %s", resp.StatusCode, ref.Path)
+	}
+
+	// KV v2 returns {"data": {"data": {...}}}, KV v1 returns {"data": {...}}.
+	var body struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return "", errors.Annotatef(err, "decode the response of vault path %s", ref.Path)
+	}
+	data := body.Data
+	if inner, ok := data["data"]; ok {
+		var v2 map[string]json.RawMessage
+		if err := json.Unmarshal(inner, &v2); err == nil {
+			data = v2
+		}
+	}
+	raw, ok := data[ref.Key]
+	if !ok {
+		return "", errors.Errorf("key %s is not found in vault path %s", ref.Key, ref.Path)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", errors.Errorf("key %s in vault path %s is not a string", ref.Key, ref.Path)
+	}
+	return value, nil
+}
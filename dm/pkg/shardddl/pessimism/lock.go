@@ -15,6 +15,7 @@ package pessimism
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/master/metrics"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
@@ -39,6 +40,10 @@ type Lock struct {
 	// whether the operations have done (exec/skip the shard DDL).
 	// if all of them have done, then we call the lock `resolved`.
 	done map[string]bool
+
+	// the time when the lock is created in memory, it's reset when the lock
+	// is re-constructed, e.g. after the DM-master leader is changed.
+	createTime time.Time
 }
 
 // NewLock creates a new Lock instance.
@@ -51,6 +56,8 @@ func NewLock(id, task, owner string, ddls, sources []string) *Lock {
 		remain: len(sources),
 		ready:  make(map[string]bool),
 		done:   make(map[string]bool),
+
+		createTime: time.Now(),
 	}
 	for _, s := range sources {
 		l.ready[s] = false
@@ -61,6 +68,11 @@ func NewLock(id, task, owner string, ddls, sources []string) *Lock {
 	return l
 }
 
+// CreateTime returns the time when the lock is created.
+func (l *Lock) CreateTime() time.Time {
+	return l.createTime
+}
+
 // TrySync tries to sync the lock, does decrease on remain, re-entrant.
 // new upstream sources may join when the DDL lock is in syncing,
 // so we need to merge these new sources.
@@ -21,6 +21,7 @@ const (
 	codeDBUnExpect
 	codeDBQueryFailed
 	codeDBExecuteFailed
+	codeDBPrepareFailed
 )
 
 // Functional error code list.
@@ -268,6 +269,31 @@ const (
 	codeConfigConfictSafeModeDurationAndSafeMode
 	codeConfigInvalidLoadPhysicalDuplicateResolution
 	codeConfigInvalidLoadPhysicalChecksum
+	codeConfigInvalidLoaderCheckpointStorage
+	codeConfigInvalidLoaderCheckpoint
+	codeConfigDDLHookNotFound
+	codeConfigInvalidDDLHook
+	codeConfigInvalidLoaderChecksum
+	codeConfigInvalidLoaderThrottle
+	codeConfigInvalidTaskLog
+	codeConfigInvalidLoaderLoadOrder
+	codeConfigInvalidLoaderDedup
+	codeConfigInvalidLoaderSlowQueryPlan
+	codeConfigInvalidSecretProvider
+	codeConfigResolveSecret
+	codeConfigInvalidLoaderAdaptiveRetry
+	codeConfigInvalidLoaderParsePool
+	codeConfigInvalidLoaderSQLMode
+	codeConfigInvalidTableTuning
+	codeConfigInvalidMaxConnections
+	codeConfigInvalidLoaderIdempotency
+	codeConfigSourceCfgHotUpdate
+	codeConfigInvalidLoaderClockSkew
+	codeConfigInvalidStrictAllowList
+	codeConfigInvalidLoaderWriteConflictRetry
+	codeConfigInvalidLoaderBackpressure
+	codeConfigInvalidLoaderWorkStealing
+	codeConfigInvalidLoaderCursor
 )
 
 // Binlog operation error code list.
@@ -386,6 +412,12 @@ const (
 	codeLoadLightningRuntime
 	codeLoadLightningHasDup
 	codeLoadLightningChecksum
+	codeLoadCheckpointTableInvalid
+	codeLoadLocalCheckpoint
+	codeLoadTimeZoneNotAccepted
+	codeLoadDownstreamClockSkew
+	codeLoadInvalidResumePoint
+	codeLoadResumeBeforeCheckpoint
 )
 
 // Sync unit error code.
@@ -461,6 +493,10 @@ const (
 	codeSyncerGetEvent
 	codeSyncerDownstreamTableNotFound
 	codeSyncerReprocessWithSafeModeFail
+	codeSyncerExecDDLHook
+	codeSyncerLoadSyncMarkerMismatch
+	codeSyncerAppliedEventsChanged
+	codeSyncerUnexpectedTable
 )
 
 // DM-master error code.
@@ -523,6 +559,7 @@ const (
 	codeMasterOptimisticDownstreamMetaNotFound
 	codeMasterInvalidClusterID
 	codeMasterStartTask
+	codeMasterConfigInvalidShardDDLLockAlert
 )
 
 // DM-worker error code.
@@ -738,6 +775,7 @@ var (
 	ErrDBUnExpect      = New(codeDBUnExpect, ClassDatabase, ScopeNotSet, LevelHigh, "unexpect database error: %s", "")
 	ErrDBQueryFailed   = New(codeDBQueryFailed, ClassDatabase, ScopeNotSet, LevelHigh, "query statement failed: %s", "")
 	ErrDBExecuteFailed = New(codeDBExecuteFailed, ClassDatabase, ScopeNotSet, LevelHigh, "execute statement failed: %s", "")
+	ErrDBPrepareFailed = New(codeDBPrepareFailed, ClassDatabase, ScopeNotSet, LevelHigh, "prepare statement failed: %s", "")
 
 	// Functional error.
 	ErrParseMydumperMeta      = New(codeParseMydumperMeta, ClassFunctional, ScopeInternal, LevelHigh, "parse mydumper metadata error: %s, metadata: %s", "")
@@ -977,6 +1015,31 @@ var (
 	ErrConfigConfictSafeModeDurationAndSafeMode = New(codeConfigConfictSafeModeDurationAndSafeMode, ClassConfig, ScopeInternal, LevelLow, "safe-mode(true) conflicts with safe-mode-duration(0s)", "Please set safe-mode to false or safe-mode-duration to non-zero.")
 	ErrConfigInvalidPhysicalDuplicateResolution = New(codeConfigInvalidLoadPhysicalDuplicateResolution, ClassConfig, ScopeInternal, LevelMedium, "invalid load on-duplicate-physical option '%s'", "Please choose a valid value in ['none', 'manual'] or leave it empty.")
 	ErrConfigInvalidPhysicalChecksum            = New(codeConfigInvalidLoadPhysicalChecksum, ClassConfig, ScopeInternal, LevelMedium, "invalid load checksum-physical option '%s'", "Please choose a valid value in ['required', 'optional', 'off'] or leave it empty.")
+	ErrConfigInvalidLoaderCheckpointStorage     = New(codeConfigInvalidLoaderCheckpointStorage, ClassConfig, ScopeInternal, LevelMedium, "invalid load checkpoint-storage option '%s'", "Please choose a valid value in ['remote', 'local'] or leave it empty.")
+	ErrConfigInvalidLoaderCheckpoint            = New(codeConfigInvalidLoaderCheckpoint, ClassConfig, ScopeInternal, LevelMedium, "invalid loader checkpoint config: %s", "Please check the `checkpoint-storage`, `checkpoint-schema`, `checkpoint-table` and `checkpoint-batch-interval-logical` config in task configuration file.")
+	ErrConfigDDLHookNotFound                    = New(codeConfigDDLHookNotFound, ClassConfig, ScopeInternal, LevelHigh, "mysql-instance(%d)'s ddl-hooks %s not exist in ddl-hook", "Please check the `ddl-hooks` config in task configuration file.")
+	ErrConfigInvalidDDLHook                     = New(codeConfigInvalidDDLHook, ClassConfig, ScopeInternal, LevelHigh, "ddl-hook %s is invalid: %s", "Please check the `ddl-hook` config in task configuration file.")
+	ErrConfigInvalidLoaderChecksum              = New(codeConfigInvalidLoaderChecksum, ClassConfig, ScopeInternal, LevelMedium, "invalid loader checksum config: %s", "Please check the `checksum-logical`, `checksum-chunk-size-logical` and `read-pool-size-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderThrottle              = New(codeConfigInvalidLoaderThrottle, ClassConfig, ScopeInternal, LevelMedium, "invalid loader throttle config: %s", "Please check the `rate-limit-logical` and `throttle-windows-logical` config in task configuration file.")
+	ErrConfigInvalidTaskLog                     = New(codeConfigInvalidTaskLog, ClassConfig, ScopeInternal, LevelMedium, "invalid task log config: %s", "Please check the `log-level` and `log-file` config in task configuration file, `log-level` should be one of debug, info, warn, error.")
+	ErrConfigInvalidLoaderLoadOrder             = New(codeConfigInvalidLoaderLoadOrder, ClassConfig, ScopeInternal, LevelMedium, "invalid loader load order config: %s", "Please check the `load-order-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderDedup                 = New(codeConfigInvalidLoaderDedup, ClassConfig, ScopeInternal, LevelMedium, "invalid loader dedup config: %s", "Please check the `dedup-logical`, `dedup-false-positive-rate-logical` and `dedup-max-keys-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderSlowQueryPlan         = New(codeConfigInvalidLoaderSlowQueryPlan, ClassConfig, ScopeInternal, LevelMedium, "invalid loader slow query plan config: %s", "Please check the `slow-query-plan-logical` and `slow-query-plan-interval-logical` config in task configuration file.")
+	ErrConfigInvalidSecretProvider              = New(codeConfigInvalidSecretProvider, ClassConfig, ScopeInternal, LevelMedium, "invalid secret provider config: %s", "Please check the `secret-providers` config of DM-master and DM-worker.")
+	ErrConfigResolveSecret                      = New(codeConfigResolveSecret, ClassConfig, ScopeInternal, LevelHigh, "fail to resolve secret %s", "Please check the secret reference in the source or task config, the `secret-providers` config of DM-master and DM-worker, and that the provider grants access to the secret.")
+	ErrConfigInvalidLoaderAdaptiveRetry         = New(codeConfigInvalidLoaderAdaptiveRetry, ClassConfig, ScopeInternal, LevelMedium, "invalid loader adaptive retry config: %s", "Please check the `adaptive-retry-logical` config and the bounds of it in task configuration file.")
+	ErrConfigInvalidLoaderParsePool             = New(codeConfigInvalidLoaderParsePool, ClassConfig, ScopeInternal, LevelMedium, "invalid loader parse pool config: %s", "Please check the `parse-pool-size-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderSQLMode               = New(codeConfigInvalidLoaderSQLMode, ClassConfig, ScopeInternal, LevelMedium, "invalid loader sql mode config: %s", "Please check the `sql-mode-logical` config in task configuration file.")
+	ErrConfigInvalidTableTuning                 = New(codeConfigInvalidTableTuning, ClassConfig, ScopeInternal, LevelMedium, "table-tuning %s is invalid: %s", "Please check the `table-tunings` config of syncer in task configuration file.")
+	ErrConfigInvalidMaxConnections              = New(codeConfigInvalidMaxConnections, ClassConfig, ScopeInternal, LevelMedium, "invalid max-connections %d of the upstream, it must be 0 or at least %d", "Please check the `max-connections` config of `from` in source configuration file.")
+	ErrConfigInvalidLoaderIdempotency           = New(codeConfigInvalidLoaderIdempotency, ClassConfig, ScopeInternal, LevelMedium, "invalid loader idempotency config: %s", "Please check the `idempotency-key-logical` and `idempotency-table-logical` config in task configuration file.")
+	ErrConfigSourceCfgHotUpdate                 = New(codeConfigSourceCfgHotUpdate, ClassConfig, ScopeInternal, LevelMedium, "source config of %s can't be updated online, because %s are changed", "Only the `user`, `password`, `session` and `security` of `from` can be updated online, please stop the tasks and relay of the source to update other items.")
+	ErrConfigInvalidLoaderClockSkew             = New(codeConfigInvalidLoaderClockSkew, ClassConfig, ScopeInternal, LevelMedium, "invalid loader clock skew config: %s", "Please check the `clock-skew-check` and `clock-skew-threshold` config in task configuration file.")
+	ErrConfigInvalidStrictAllowList             = New(codeConfigInvalidStrictAllowList, ClassConfig, ScopeInternal, LevelMedium, "invalid strict-allow-list %s", "Please set `strict-allow-list` to `warn`, `error` or leave it empty in task configuration file.")
+	ErrConfigInvalidLoaderWriteConflictRetry    = New(codeConfigInvalidLoaderWriteConflictRetry, ClassConfig, ScopeInternal, LevelMedium, "invalid loader write conflict retry config: %s", "Please check the `write-conflict-retry-count-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderBackpressure          = New(codeConfigInvalidLoaderBackpressure, ClassConfig, ScopeInternal, LevelMedium, "invalid loader backpressure config: %s", "Please check the `backpressure-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderWorkStealing          = New(codeConfigInvalidLoaderWorkStealing, ClassConfig, ScopeInternal, LevelMedium, "invalid loader work stealing config: %s", "Please check the `work-stealing-logical` config in task configuration file.")
+	ErrConfigInvalidLoaderCursor                = New(codeConfigInvalidLoaderCursor, ClassConfig, ScopeInternal, LevelMedium, "invalid loader cursor config: %s", "Please check the `cursor-batch-size-logical` config in task configuration file.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -1081,6 +1144,12 @@ var (
 	ErrLoadLightningRuntime        = New(codeLoadLightningRuntime, ClassLoadUnit, ScopeInternal, LevelHigh, "", "")
 	ErrLoadLightningHasDup         = New(codeLoadLightningHasDup, ClassLoadUnit, ScopeInternal, LevelMedium, "physical import finished but the data has duplication, please check `%s`.`%s` to see the duplication", "You can refer to https://docs.pingcap.com/tidb/stable/tidb-lightning-physical-import-mode-usage#conflict-detection to manually insert data and resume the task.")
 	ErrLoadLightningChecksum       = New(codeLoadLightningChecksum, ClassLoadUnit, ScopeInternal, LevelMedium, "checksum mismatched, KV number in source files: %s, KV number in TiDB cluster: %s", "If TiDB cluster has more KV, please check if the migrated tables are empty before the task. If source files have more KV, please set `on-duplicate-physical` and restart the task to see data duplication. You can resume the task to ignore the error if you want.")
+	ErrLoadLocalCheckpoint         = New(codeLoadLocalCheckpoint, ClassLoadUnit, ScopeInternal, LevelHigh, "operate local checkpoint file %s", "Please check the local checkpoint file is accessible and not used by another DM-worker.")
+	ErrLoadCheckpointTableInvalid  = New(codeLoadCheckpointTableInvalid, ClassLoadUnit, ScopeDownstream, LevelHigh, "checkpoint table %s is not valid, missing columns %v", "Please create the checkpoint table with all required columns, or remove `checkpoint-table` from the task configuration file to let DM create it.")
+	ErrLoadTimeZoneNotAccepted     = New(codeLoadTimeZoneNotAccepted, ClassLoadUnit, ScopeDownstream, LevelHigh, "time zone %s is not accepted by the downstream database", "Please check the `timezone` config in task configuration file, or load the time zone tables into the downstream database if a named time zone is used.")
+	ErrLoadDownstreamClockSkew     = New(codeLoadDownstreamClockSkew, ClassLoadUnit, ScopeDownstream, LevelHigh, "the clock of the downstream database is skewed by %s from the local clock, which exceeds the threshold %s", "Please synchronize the clocks of the downstream database and DM-worker, e.g. by NTP, or set `clock-skew-check` to `warn` in task configuration file to ignore it.")
+	ErrLoadInvalidResumePoint      = New(codeLoadInvalidResumePoint, ClassLoadUnit, ScopeInternal, LevelMedium, "the resume point of table %s at offset %d is invalid, %s", "Please specify a table of the dumped files in the form of `schema.table`, and an offset at the boundary of statements of its data files.")
+	ErrLoadResumeBeforeCheckpoint  = New(codeLoadResumeBeforeCheckpoint, ClassLoadUnit, ScopeInternal, LevelMedium, "the resume point of table %s at offset %d is before the checkpoint %d of data file %s, the loaded data would be applied twice", "Please specify an offset after the checkpoint, or remove the checkpoint of the table to reload it from the beginning.")
 
 	// Sync unit error.
 	ErrSyncerUnitPanic                   = New(codeSyncerUnitPanic, ClassSyncUnit, ScopeInternal, LevelHigh, "panic error: %v", "")
@@ -1156,6 +1225,10 @@ var (
 	ErrSyncerDownstreamTableNotFound        = New(codeSyncerDownstreamTableNotFound, ClassSyncUnit, ScopeInternal, LevelHigh, "downstream table %s not found", "")
 	ErrSyncerCancelledDDL                   = New(codeSyncerCancelledDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s executed in background and met error", "Please manually check the error from TiDB and handle it.")
 	ErrSyncerReprocessWithSafeModeFail      = New(codeSyncerReprocessWithSafeModeFail, ClassSyncUnit, ScopeInternal, LevelMedium, "your `safe-mode-duration` in task.yaml is set to 0s, the task can't be re-processed without safe mode currently", "Please stop and re-start this task. If you want to start task successfully, you need set `safe-mode-duration` greater than `0s`.")
+	ErrSyncerExecDDLHook                    = New(codeSyncerExecDDLHook, ClassSyncUnit, ScopeDownstream, LevelHigh, "execute %s SQLs of ddl-hook %s for DDL %s failed", "Please check the SQLs of the ddl-hook, or set its `on-error` to `warn` to ignore the failure.")
+	ErrSyncerLoadSyncMarkerMismatch         = New(codeSyncerLoadSyncMarkerMismatch, ClassSyncUnit, ScopeInternal, LevelHigh, "location %s in the load sync marker doesn't match location %s in the dump metadata", "Please check whether the dump files are modified after the load unit finished, or use `start-task --remove-meta` to restart the task.")
+	ErrSyncerAppliedEventsChanged           = New(codeSyncerAppliedEventsChanged, ClassSyncUnit, ScopeInternal, LevelHigh, "the first %d statements at position %s have been applied, but the new statements %v change them", "Please keep the applied statements unchanged and only modify the following ones.")
+	ErrSyncerUnexpectedTable                = New(codeSyncerUnexpectedTable, ClassSyncUnit, ScopeUpstream, LevelHigh, "binlog event of table %s which is not matched by block-allow-list is found at %s", "Please add the table to `block-allow-list` if it should be replicated, or set `strict-allow-list` to `warn` or leave it empty to skip it, then update and resume the task.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
@@ -1221,6 +1294,7 @@ var (
 	ErrMasterOptimisticDownstreamMetaNotFound  = New(codeMasterOptimisticDownstreamMetaNotFound, ClassDMMaster, ScopeInternal, LevelHigh, "downstream database config and meta for task %s not found", "")
 	ErrMasterInvalidClusterID                  = New(codeMasterInvalidClusterID, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster id: %v", "")
 	ErrMasterStartTask                         = New(codeMasterStartTask, ClassDMMaster, ScopeInternal, LevelHigh, "can not start task: %s reason: %s", "")
+	ErrMasterConfigInvalidShardDDLLockAlert    = New(codeMasterConfigInvalidShardDDLLockAlert, ClassDMMaster, ScopeInternal, LevelMedium, "invalid shard DDL lock alert config: %s", "Please check the `shard-ddl-lock-webhook`, `shard-ddl-lock-alert-after` and `shard-ddl-lock-alert-interval` config in master configuration file.")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -37,6 +37,7 @@ var upgrades = []func(cli *clientv3.Client, uctx Context) error{
 	upgradeToVer1,
 	upgradeToVer2,
 	upgradeToVer4,
+	upgradeToVer5,
 }
 
 // upgradesBeforeScheduler records all upgrade functions before scheduler start. e.g. etcd key changed.
@@ -160,7 +161,18 @@ func upgradeToVer1(cli *clientv3.Client, uctx Context) error {
 
 // upgradeToVer2 does upgrade operations from Ver1 to Ver2 (v2.0.0-GA) to upgrade syncer checkpoint schema.
 func upgradeToVer2(cli *clientv3.Client, uctx Context) error {
-	upgradeTaskName := "upgradeToVer2"
+	return upgradeCheckpointSchema(uctx, "upgradeToVer2", func(tableName string) []string {
+		return []string{
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_name VARCHAR(128) DEFAULT '' AFTER binlog_gtid`, tableName),
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_pos INT UNSIGNED DEFAULT 0 AFTER exit_safe_binlog_name`, tableName),
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_gtid TEXT AFTER exit_safe_binlog_pos`, tableName),
+		}
+	})
+}
+
+// upgradeCheckpointSchema adds columns to syncer checkpoint tables of all subtasks,
+// genQueries generates the statements for a checkpoint table.
+func upgradeCheckpointSchema(uctx Context, upgradeTaskName string, genQueries func(tableName string) []string) error {
 	logger := log.L().WithFields(zap.String("task", upgradeTaskName))
 
 	if uctx.SubTaskConfigs == nil {
@@ -205,11 +217,7 @@ func upgradeToVer2(cli *clientv3.Client, uctx Context) error {
 		toClose = append(toClose, targetDB)
 		// try to add columns.
 		// NOTE: ignore already exists error to continue the process.
-		queries := []string{
-			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_name VARCHAR(128) DEFAULT '' AFTER binlog_gtid`, tableName),
-			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_pos INT UNSIGNED DEFAULT 0 AFTER exit_safe_binlog_name`, tableName),
-			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN exit_safe_binlog_gtid TEXT AFTER exit_safe_binlog_pos`, tableName),
-		}
+		queries := genQueries(tableName)
 		tctx := tcontext.NewContext(uctx.Context, logger)
 		dbConn, err := targetDB.GetBaseConn(tctx.Ctx)
 		if err != nil {
@@ -275,3 +283,13 @@ func upgradeToVer3(ctx context.Context, cli *clientv3.Client) error {
 func upgradeToVer4(cli *clientv3.Client, uctx Context) error {
 	return nil
 }
+
+// upgradeToVer5 does upgrade operations from Ver4 to Ver5 to upgrade syncer checkpoint schema,
+// the added column records how many statements of a `handle-error replace/inject` have been applied.
+func upgradeToVer5(cli *clientv3.Client, uctx Context) error {
+	return upgradeCheckpointSchema(uctx, "upgradeToVer5", func(tableName string) []string {
+		return []string{
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN binlog_suffix INT UNSIGNED DEFAULT 0 AFTER binlog_gtid`, tableName),
+		}
+	})
+}
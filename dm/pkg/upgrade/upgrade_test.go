@@ -105,7 +105,7 @@ func (t *testForEtcd) TestTryUpgrade(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(rev2, Greater, rev1)
 	c.Assert(ver, DeepEquals, CurrentVersion)
-	c.Assert(mockVerNo, Equals, uint64(5))
+	c.Assert(mockVerNo, Equals, uint64(6))
 
 	// try to upgrade again, do nothing because the version is the same.
 	mockVerNo = 0
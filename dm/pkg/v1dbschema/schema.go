@@ -63,6 +63,7 @@ func UpdateSchema(tctx *tcontext.Context, db *conn.BaseDB, cfg *config.SubTaskCo
 		Password:       cfg.From.Password, // plaintext.
 		UseDecimal:     false,
 		VerifyChecksum: true,
+		Dialer:         conn.UpstreamBinlogDialer(&cfg.From),
 	}
 	tcpReader := reader.NewTCPReader(syncCfg)
 
@@ -0,0 +1,292 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/util/dbutil"
+)
+
+const (
+	// eventStatsBucketDuration is the time span of a bucket of the event statistics.
+	eventStatsBucketDuration = time.Minute
+	// eventStatsBucketCount is the number of buckets, which covers the largest window.
+	eventStatsBucketCount = 60
+	// eventStatsTopTables is the number of tables with the most bytes reported in a window.
+	eventStatsTopTables = 10
+
+	// tableMapTableIDSize is the size of table id in the post-header of TableMapEvent,
+	// it's 6 for all binlog v4 servers DM supports.
+	tableMapTableIDSize = 6
+	// binlogChecksumLen is the length of CRC32 checksum at the end of an event.
+	binlogChecksumLen = 4
+)
+
+// the types of relayed binlog events in statistics.
+const (
+	EventTypeInsert   = "insert"
+	EventTypeUpdate   = "update"
+	EventTypeDelete   = "delete"
+	EventTypeDDL      = "ddl"
+	EventTypeTxn      = "txn"
+	EventTypeTableMap = "table-map"
+	EventTypeGTID     = "gtid"
+	EventTypeOther    = "other"
+)
+
+// eventStatsWindows are the rolling windows the event statistics are reported in.
+var eventStatsWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"1m", time.Minute},
+	{"10m", 10 * time.Minute},
+	{"1h", time.Hour},
+}
+
+// EventTally is the number and the total size of binlog events.
+type EventTally struct {
+	Events uint64 `json:"events"`
+	Bytes  uint64 `json:"bytes"`
+	// Ratio is the fraction of bytes in the window.
+	Ratio float64 `json:"ratio"`
+}
+
+// TableEventTally is the EventTally of the rows events of a table.
+type TableEventTally struct {
+	Table string `json:"table"`
+	EventTally
+}
+
+// EventStatsWindow is the event statistics in a rolling window.
+type EventStatsWindow struct {
+	Window    string                `json:"window"`
+	Total     EventTally            `json:"total"`
+	Types     map[string]EventTally `json:"types"`
+	TopTables []TableEventTally     `json:"top-tables"`
+}
+
+// EventStatsSnapshot is the event statistics of the relay of a source.
+type EventStatsSnapshot struct {
+	Source  string             `json:"source"`
+	Windows []EventStatsWindow `json:"windows"`
+}
+
+type eventStatsBucket struct {
+	start  time.Time
+	types  map[string]*EventTally
+	tables map[string]*EventTally
+}
+
+// EventStats tallies the relayed binlog events by type, by table and by bytes
+// into rolling windows for capacity planning. Only the event headers and the
+// table names of TableMapEvents are read, the row contents are not parsed.
+type EventStats struct {
+	mu      sync.Mutex
+	buckets [eventStatsBucketCount]eventStatsBucket
+	// lastTable is the table of the latest TableMapEvent, which the following
+	// rows events belong to.
+	lastTable string
+}
+
+// NewEventStats creates a new EventStats.
+func NewEventStats() *EventStats {
+	return &EventStats{}
+}
+
+// Observe tallies a binlog event written to relay log at `now`.
+func (s *EventStats) Observe(e *replication.BinlogEvent, now time.Time) {
+	tp := eventTypeOf(e)
+	size := uint64(e.Header.EventSize)
+	relayEventCounter.WithLabelValues(tp).Inc()
+	relayEventBytesCounter.WithLabelValues(tp).Add(float64(size))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	start := now.Truncate(eventStatsBucketDuration)
+	b := &s.buckets[start.Unix()/int64(eventStatsBucketDuration/time.Second)%eventStatsBucketCount]
+	if !b.start.Equal(start) {
+		*b = eventStatsBucket{
+			start:  start,
+			types:  make(map[string]*EventTally),
+			tables: make(map[string]*EventTally),
+		}
+	}
+	tallyEvent(b.types, tp, size)
+
+	switch tp {
+	case EventTypeTableMap:
+		s.lastTable = tableNameOf(e)
+	case EventTypeInsert, EventTypeUpdate, EventTypeDelete:
+		if s.lastTable != "" {
+			tallyEvent(b.tables, s.lastTable, size)
+		}
+	}
+}
+
+// Snapshot returns the event statistics in the rolling windows ending at `now`.
+func (s *EventStats) Snapshot(now time.Time) []EventStatsWindow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := now.Truncate(eventStatsBucketDuration)
+	windows := make([]EventStatsWindow, 0, len(eventStatsWindows))
+	for _, w := range eventStatsWindows {
+		// the current bucket is partial, so the window spans one more bucket at most.
+		since := current.Add(-w.duration + eventStatsBucketDuration)
+		types := make(map[string]*EventTally)
+		tables := make(map[string]*EventTally)
+		for i := range s.buckets {
+			b := &s.buckets[i]
+			if b.start.IsZero() || b.start.Before(since) || b.start.After(current) {
+				continue
+			}
+			for tp, t := range b.types {
+				mergeTally(types, tp, t)
+			}
+			for table, t := range b.tables {
+				mergeTally(tables, table, t)
+			}
+		}
+		windows = append(windows, newEventStatsWindow(w.name, types, tables))
+	}
+	return windows
+}
+
+func newEventStatsWindow(name string, types, tables map[string]*EventTally) EventStatsWindow {
+	window := EventStatsWindow{
+		Window:    name,
+		Types:     make(map[string]EventTally, len(types)),
+		TopTables: make([]TableEventTally, 0, len(tables)),
+	}
+	for _, t := range types {
+		window.Total.Events += t.Events
+		window.Total.Bytes += t.Bytes
+	}
+	ratio := func(t EventTally) EventTally {
+		if window.Total.Bytes > 0 {
+			t.Ratio = float64(t.Bytes) / float64(window.Total.Bytes)
+		}
+		return t
+	}
+	window.Total = ratio(window.Total)
+	for tp, t := range types {
+		window.Types[tp] = ratio(*t)
+	}
+	for table, t := range tables {
+		window.TopTables = append(window.TopTables, TableEventTally{Table: table, EventTally: ratio(*t)})
+	}
+	sort.Slice(window.TopTables, func(i, j int) bool {
+		if window.TopTables[i].Bytes != window.TopTables[j].Bytes {
+			return window.TopTables[i].Bytes > window.TopTables[j].Bytes
+		}
+		return window.TopTables[i].Table < window.TopTables[j].Table
+	})
+	if len(window.TopTables) > eventStatsTopTables {
+		window.TopTables = window.TopTables[:eventStatsTopTables]
+	}
+	return window
+}
+
+func tallyEvent(tallies map[string]*EventTally, key string, size uint64) {
+	t, ok := tallies[key]
+	if !ok {
+		t = &EventTally{}
+		tallies[key] = t
+	}
+	t.Events++
+	t.Bytes += size
+}
+
+func mergeTally(tallies map[string]*EventTally, key string, other *EventTally) {
+	t, ok := tallies[key]
+	if !ok {
+		t = &EventTally{}
+		tallies[key] = t
+	}
+	t.Events += other.Events
+	t.Bytes += other.Bytes
+}
+
+// eventTypeOf classifies the binlog event by its header. As DM requires the ROW
+// binlog format, the QueryEvents other than the beginning of transactions are DDLs.
+func eventTypeOf(e *replication.BinlogEvent) string {
+	switch e.Header.EventType {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2:
+		return EventTypeInsert
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		return EventTypeUpdate
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		return EventTypeDelete
+	case replication.QUERY_EVENT:
+		if isBeginQueryEvent(e) {
+			return EventTypeTxn
+		}
+		return EventTypeDDL
+	case replication.XID_EVENT:
+		return EventTypeTxn
+	case replication.TABLE_MAP_EVENT:
+		return EventTypeTableMap
+	case replication.GTID_EVENT, replication.ANONYMOUS_GTID_EVENT, replication.MARIADB_GTID_EVENT:
+		return EventTypeGTID
+	default:
+		return EventTypeOther
+	}
+}
+
+// isBeginQueryEvent returns whether the QueryEvent is a `BEGIN`. The query is at
+// the end of the event, so it's checked without decoding the event in raw mode.
+func isBeginQueryEvent(e *replication.BinlogEvent) bool {
+	if ev, ok := e.Event.(*replication.QueryEvent); ok {
+		return string(ev.Query) == "BEGIN"
+	}
+	begin := []byte("BEGIN")
+	data := e.RawData
+	if bytes.HasSuffix(data, begin) {
+		return true
+	}
+	return len(data) >= binlogChecksumLen && bytes.HasSuffix(data[:len(data)-binlogChecksumLen], begin)
+}
+
+// tableNameOf returns the quoted table name of the TableMapEvent, or "" if it
+// can't be read from the event.
+func tableNameOf(e *replication.BinlogEvent) string {
+	if ev, ok := e.Event.(*replication.TableMapEvent); ok {
+		return dbutil.TableName(string(ev.Schema), string(ev.Table))
+	}
+	// in raw mode, read the schema and the table from the payload, which are
+	// both length-prefixed and terminated by 0x00.
+	data := e.RawData
+	pos := replication.EventHeaderSize + tableMapTableIDSize + 2
+	if pos >= len(data) {
+		return ""
+	}
+	schemaLen := int(data[pos])
+	pos++
+	if pos+schemaLen+1 >= len(data) {
+		return ""
+	}
+	schema := data[pos : pos+schemaLen]
+	pos += schemaLen + 1
+	tableLen := int(data[pos])
+	pos++
+	if pos+tableLen > len(data) {
+		return ""
+	}
+	return dbutil.TableName(string(schema), string(data[pos:pos+tableLen]))
+}
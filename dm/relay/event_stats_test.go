@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+)
+
+var _ = Suite(&testEventStatsSuite{})
+
+type testEventStatsSuite struct{}
+
+func (t *testEventStatsSuite) TestEventStats(c *C) {
+	var (
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+		}
+		latestPos  uint32 = 4
+		columnType        = []byte{gmysql.MYSQL_TYPE_LONG}
+		rows              = [][]interface{}{{int32(1)}}
+		now               = time.Date(2023, 1, 1, 10, 0, 30, 0, time.UTC)
+		stats             = NewEventStats()
+	)
+
+	begin, err := event.GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("db"), []byte("BEGIN"))
+	c.Assert(err, IsNil)
+	ddl, err := event.GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("db"), []byte("CREATE TABLE t2 (c INT)"))
+	c.Assert(err, IsNil)
+	tableMap1, err := event.GenTableMapEvent(header, latestPos, 1, []byte("db"), []byte("t1"), columnType)
+	c.Assert(err, IsNil)
+	tableMap2, err := event.GenTableMapEvent(header, latestPos, 2, []byte("db"), []byte("t2"), columnType)
+	c.Assert(err, IsNil)
+	insert, err := event.GenRowsEvent(header, latestPos, replication.WRITE_ROWS_EVENTv2, 1, event.RowFlagsEndOfStatement, rows, columnType, nil)
+	c.Assert(err, IsNil)
+	update, err := event.GenRowsEvent(header, latestPos, replication.UPDATE_ROWS_EVENTv2, 2, event.RowFlagsEndOfStatement, [][]interface{}{{int32(1)}, {int32(2)}}, columnType, nil)
+	c.Assert(err, IsNil)
+	xid, err := event.GenXIDEvent(header, latestPos, 1)
+	c.Assert(err, IsNil)
+
+	// events of an old bucket out of all windows.
+	stats.Observe(ddl, now.Add(-2*time.Hour))
+	// a transaction of t1 in the previous minute.
+	for _, e := range []*replication.BinlogEvent{begin, tableMap1, insert, xid} {
+		stats.Observe(e, now.Add(-time.Minute))
+	}
+	// a DDL and a transaction of t2 in raw mode in the current minute.
+	stats.Observe(ddl, now)
+	for _, e := range []*replication.BinlogEvent{begin, tableMap2, update, update, xid} {
+		stats.Observe(&replication.BinlogEvent{
+			RawData: e.RawData,
+			Header:  e.Header,
+			Event:   &replication.GenericEvent{},
+		}, now)
+	}
+
+	windows := stats.Snapshot(now)
+	c.Assert(windows, HasLen, 3)
+
+	w1m := windows[0]
+	c.Assert(w1m.Window, Equals, "1m")
+	c.Assert(w1m.Total.Events, Equals, uint64(6))
+	c.Assert(w1m.Types, HasLen, 4)
+	c.Assert(w1m.Types[EventTypeDDL].Events, Equals, uint64(1))
+	c.Assert(w1m.Types[EventTypeTxn].Events, Equals, uint64(2))
+	c.Assert(w1m.Types[EventTypeTableMap].Events, Equals, uint64(1))
+	c.Assert(w1m.Types[EventTypeUpdate].Events, Equals, uint64(2))
+	c.Assert(w1m.Types[EventTypeUpdate].Bytes, Equals, 2*uint64(update.Header.EventSize))
+	c.Assert(w1m.Types[EventTypeUpdate].Ratio, Equals, float64(2*update.Header.EventSize)/float64(w1m.Total.Bytes))
+	c.Assert(w1m.TopTables, DeepEquals, []TableEventTally{
+		{Table: "`db`.`t2`", EventTally: EventTally{Events: 2, Bytes: 2 * uint64(update.Header.EventSize), Ratio: float64(2*update.Header.EventSize) / float64(w1m.Total.Bytes)}},
+	})
+
+	w10m := windows[1]
+	c.Assert(w10m.Window, Equals, "10m")
+	c.Assert(w10m.Total.Events, Equals, uint64(10))
+	c.Assert(w10m.Types[EventTypeInsert].Events, Equals, uint64(1))
+	c.Assert(w10m.Types[EventTypeTxn].Events, Equals, uint64(4))
+	c.Assert(w10m.TopTables, HasLen, 2)
+	c.Assert(w10m.TopTables[0].Table, Equals, "`db`.`t2`")
+	c.Assert(w10m.TopTables[1].Table, Equals, "`db`.`t1`")
+	c.Assert(w10m.TopTables[1].Events, Equals, uint64(1))
+
+	c.Assert(windows[2].Total, DeepEquals, w10m.Total)
+
+	// all buckets are expired.
+	windows = stats.Snapshot(now.Add(2 * time.Hour))
+	for _, w := range windows {
+		c.Assert(w.Total.Events, Equals, uint64(0))
+		c.Assert(w.TopTables, HasLen, 0)
+	}
+}
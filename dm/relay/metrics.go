@@ -133,6 +133,22 @@ var (
 			Name:      "exit_with_error_count",
 			Help:      "counter of relay unit exits with error",
 		}, []string{"resumable_err"})
+
+	relayEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "binlog_event_count",
+			Help:      "counter of relayed binlog events by type",
+		}, []string{"type"})
+
+	relayEventBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "binlog_event_bytes",
+			Help:      "total size of relayed binlog events by type",
+		}, []string{"type"})
 )
 
 // RegisterMetrics register metrics.
@@ -149,6 +165,8 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(binlogReadDurationHistogram)
 	registry.MustRegister(binlogTransformDurationHistogram)
 	registry.MustRegister(relayExitWithErrorCounter)
+	registry.MustRegister(relayEventCounter)
+	registry.MustRegister(relayEventBytesCounter)
 }
 
 func reportRelayLogSpaceInBackground(ctx context.Context, dirpath string) error {
@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	pkgstreamer "github.com/pingcap/tiflow/dm/pkg/streamer"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
@@ -110,6 +111,8 @@ type Process interface {
 	NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader
 	// IsActive check whether given uuid+filename is active binlog file, if true return current file offset
 	IsActive(uuid, filename string) (bool, int64)
+	// EventStats returns the statistics of relayed binlog events
+	EventStats() *EventStats
 }
 
 // Relay relays mysql binlog to local file.
@@ -129,17 +132,19 @@ type Relay struct {
 		info *pkgstreamer.RelayLogInfo
 	}
 
-	writer    Writer
-	listeners map[Listener]struct{} // make it a set to make it easier to remove listener
+	writer     Writer
+	listeners  map[Listener]struct{} // make it a set to make it easier to remove listener
+	eventStats *EventStats
 }
 
 // NewRealRelay creates an instance of Relay.
 func NewRealRelay(cfg *Config) Process {
 	r := &Relay{
-		cfg:       cfg,
-		meta:      NewLocalMeta(cfg.Flavor, cfg.RelayDir),
-		logger:    log.With(zap.String("component", "relay log")),
-		listeners: make(map[Listener]struct{}),
+		cfg:        cfg,
+		meta:       NewLocalMeta(cfg.Flavor, cfg.RelayDir),
+		logger:     log.With(zap.String("component", "relay log")),
+		listeners:  make(map[Listener]struct{}),
+		eventStats: NewEventStats(),
 	}
 	r.writer = NewFileWriter(r.logger, cfg.RelayDir)
 	return r
@@ -711,6 +716,7 @@ func (r *Relay) handleEvents(
 		}
 
 		relayLogWriteSizeHistogram.Observe(float64(e.Header.EventSize))
+		r.eventStats.Observe(e, time.Now())
 		relayPosGauge.Set(float64(lastPos.Pos))
 		if e.Header.EventType == replication.FORMAT_DESCRIPTION_EVENT {
 			if index, err2 := utils.GetFilenameIndex(lastPos.Name); err2 != nil {
@@ -1049,6 +1055,11 @@ func (r *Relay) Error() interface{} {
 	return &pb.RelayError{}
 }
 
+// EventStats implements Process.EventStats.
+func (r *Relay) EventStats() *EventStats {
+	return r.eventStats
+}
+
 // Type implements the dm.Unit interface.
 func (r *Relay) Type() pb.UnitType {
 	return pb.UnitType_Relay
@@ -1151,15 +1162,21 @@ func (r *Relay) setSyncConfig() error {
 		}
 	}
 
+	password, err := secret.Resolve(context.Background(), r.cfg.From.Password)
+	if err != nil {
+		return err
+	}
+
 	syncerCfg := replication.BinlogSyncerConfig{
 		ServerID:  r.cfg.ServerID,
 		Flavor:    r.cfg.Flavor,
 		Host:      r.cfg.From.Host,
 		Port:      uint16(r.cfg.From.Port),
 		User:      r.cfg.From.User,
-		Password:  r.cfg.From.Password,
+		Password:  password,
 		Charset:   r.cfg.Charset,
 		TLSConfig: tlsConfig,
+		Dialer:    conn.UpstreamBinlogDialer(&r.cfg.From),
 	}
 	common.SetDefaultReplicationCfg(&syncerCfg, common.MaxBinlogSyncerReconnect)
 
@@ -194,6 +194,25 @@ func (m *streamModifier) ListEqualAndAfter(posStr string) []*pb.HandleWorkerErro
 	return reqs
 }
 
+// AppliedEvents returns how many events of the Replace or Inject operator at the
+// position of `loc` have been applied, and how many events the operator has.
+// `loc.Suffix` is treated as the number of applied events, so `ok` is false if
+// it's 0 or there is no such operator.
+func (m *streamModifier) AppliedEvents(loc binlog.Location) (applied, total int, ok bool) {
+	if loc.Suffix == 0 {
+		return 0, 0, false
+	}
+	idx := m.minIdxLargerOrEqual(loc.Position)
+	if idx == len(m.ops) {
+		return 0, 0, false
+	}
+	op := m.ops[idx]
+	if op.pos.Compare(loc.Position) != 0 || (op.op != pb.ErrorOp_Replace && op.op != pb.ErrorOp_Inject) {
+		return 0, 0, false
+	}
+	return loc.Suffix, len(op.events), true
+}
+
 // RemoveOutdated removes outdated operators which will not be triggered again after
 // upstream binlog streamer reset. A common usage is to use global checkpoint as
 // the argument.
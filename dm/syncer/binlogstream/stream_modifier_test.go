@@ -348,3 +348,49 @@ func TestRemoveOutdated(t *testing.T) {
 	m.RemoveOutdated(mysql.Position{Name: "mysql.000001", Pos: 9999})
 	require.Len(t, m.ops, 0)
 }
+
+func TestAppliedEvents(t *testing.T) {
+	t.Parallel()
+	m := newStreamModifier(log.L())
+
+	pos := mysql.Position{Name: "mysql.000001", Pos: 1234}
+	loc := binlog.Location{Position: pos, Suffix: 1}
+	_, _, ok := m.AppliedEvents(loc)
+	require.False(t, ok)
+
+	m.ops = []*operator{
+		{
+			op:  pb.ErrorOp_Replace,
+			pos: pos,
+			events: []*replication.BinlogEvent{
+				{RawData: []byte("event1")},
+				{RawData: []byte("event2")},
+				{RawData: []byte("event3")},
+			},
+		},
+		{
+			op:  pb.ErrorOp_Skip,
+			pos: mysql.Position{Name: "mysql.000001", Pos: 2345},
+		},
+	}
+
+	applied, total, ok := m.AppliedEvents(loc)
+	require.True(t, ok)
+	require.Equal(t, 1, applied)
+	require.Equal(t, 3, total)
+
+	// no event has been applied
+	loc.Suffix = 0
+	_, _, ok = m.AppliedEvents(loc)
+	require.False(t, ok)
+
+	// skip operator has no events
+	loc = binlog.Location{Position: mysql.Position{Name: "mysql.000001", Pos: 2345}, Suffix: 1}
+	_, _, ok = m.AppliedEvents(loc)
+	require.False(t, ok)
+
+	// no operator at this position
+	loc = binlog.Location{Position: mysql.Position{Name: "mysql.000001", Pos: 2000}, Suffix: 1}
+	_, _, ok = m.AppliedEvents(loc)
+	require.False(t, ok)
+}
@@ -66,6 +66,12 @@ var (
 	batchFlushPoints     = 100
 )
 
+// status of the load sync marker, see loader.writeLoadSyncMarker.
+const (
+	loadSyncMarkerStatusLoaded    = "loaded"
+	loadSyncMarkerStatusInstalled = "installed"
+)
+
 type tablePoint struct {
 	location binlog.Location
 	ti       *model.TableInfo
@@ -140,8 +146,8 @@ func (b *binlogPoint) rollback() {
 	b.Lock()
 	defer b.Unlock()
 
-	// set suffix to 0 when we meet error
-	b.flushedPoint.location.ResetSuffix()
+	// keep the suffix of flushed point, so the events of a `handle-error replace/inject`
+	// which have been applied will not be applied again after the streamer is reset.
 	b.savedPoint.location = b.flushedPoint.location
 	if b.savedPoint.ti == nil {
 		// TODO: if we forget to save table info for table checkpoint, this is also nil!
@@ -243,6 +249,11 @@ type CheckPoint interface {
 	// LoadMeta loads checkpoints from meta config item or file
 	LoadMeta(ctx context.Context) error
 
+	// LoadSyncMarker loads the global checkpoint from the marker written by load unit, it returns false
+	// if there is no marker to install. The marker is verified against the dump metadata if it still exists,
+	// and is marked as installed in the same transaction of the next flush of the global checkpoint.
+	LoadSyncMarker(ctx context.Context) (bool, error)
+
 	// SaveTablePoint saves checkpoint for specified table in memory
 	SaveTablePoint(table *filter.Table, point binlog.Location, ti *model.TableInfo)
 
@@ -345,6 +356,11 @@ type RemoteCheckPoint struct {
 	tableName string // qualified table name: schema is set through task config, table is task name
 	id        string // checkpoint ID, now it is `source-id`
 
+	// loadSyncMarkerTableName is the qualified table name of the marker written by load unit.
+	loadSyncMarkerTableName string
+	// needInstallLoadSyncMarker is set when the global checkpoint is loaded from the marker and not flushed yet.
+	needInstallLoadSyncMarker bool
+
 	// source-schema -> source-table -> checkpoint
 	// used to filter the synced binlog when re-syncing for sharding group
 	points map[string]map[string]*binlogPoint
@@ -391,6 +407,8 @@ func NewRemoteCheckPoint(
 		logCtx:        tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "remote checkpoint"))),
 		snapshots:     make([]*remoteCheckpointSnapshot, 0),
 		snapshotSeq:   0,
+
+		loadSyncMarkerTableName: dbutil.TableName(cfg.MetaSchema, cputil.LoadSyncMarker(cfg.Name)),
 	}
 
 	return cp
@@ -518,6 +536,7 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	cp.points = make(map[string]map[string]*binlogPoint)
 	cp.snapshots = make([]*remoteCheckpointSnapshot, 0)
 	cp.safeModeExitPoint = nil
+	cp.needInstallLoadSyncMarker = false
 
 	return nil
 }
@@ -758,6 +777,13 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(
 		args = append(args, extraArgs[i])
 	}
 
+	// the load sync marker is installed along with the initial global checkpoint.
+	installLoadSyncMarker := cp.needInstallLoadSyncMarker && snapshotCp.globalPoint != nil
+	if installLoadSyncMarker {
+		sqls = append(sqls, `UPDATE `+cp.loadSyncMarkerTableName+` SET status = ? WHERE source_name = ?`)
+		args = append(args, []interface{}{loadSyncMarkerStatusInstalled, cp.id})
+	}
+
 	// updating global checkpoint should be the last SQL, its success indicates
 	// the checkpoint is flushed successfully.
 	if snapshotCp.globalPoint != nil {
@@ -781,6 +807,9 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(
 		cp.globalPoint.flushBy(*snapshotCp.globalPoint)
 		cp.Lock()
 		cp.globalPointSaveTime = snapshotCp.globalPointSaveTime
+		if installLoadSyncMarker {
+			cp.needInstallLoadSyncMarker = false
+		}
 		cp.Unlock()
 	}
 
@@ -998,6 +1027,7 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 			binlog_name VARCHAR(128),
 			binlog_pos INT UNSIGNED,
 			binlog_gtid TEXT,
+			binlog_suffix INT UNSIGNED DEFAULT 0,
 			exit_safe_binlog_name VARCHAR(128) DEFAULT '',
 			exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
 			exit_safe_binlog_gtid TEXT,
@@ -1018,7 +1048,7 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	cp.Lock()
 	defer cp.Unlock()
 
-	query := `SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global FROM ` + cp.tableName + ` WHERE id = ?`
+	query := `SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, binlog_suffix, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global FROM ` + cp.tableName + ` WHERE id = ?`
 	rows, err := cp.dbConn.QuerySQL(tctx, cp.metricProxies, query, cp.id)
 	defer func() {
 		if rows != nil {
@@ -1043,6 +1073,7 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		binlogName            string
 		binlogPos             uint32
 		binlogGTIDSet         sql.NullString
+		binlogSuffix          int
 		exitSafeBinlogName    string
 		exitSafeBinlogPos     uint32
 		exitSafeBinlogGTIDSet sql.NullString
@@ -1050,7 +1081,7 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 		isGlobal              bool
 	)
 	for rows.Next() {
-		err := rows.Scan(&cpSchema, &cpTable, &binlogName, &binlogPos, &binlogGTIDSet, &exitSafeBinlogName, &exitSafeBinlogPos, &exitSafeBinlogGTIDSet, &tiBytes, &isGlobal)
+		err := rows.Scan(&cpSchema, &cpTable, &binlogName, &binlogPos, &binlogGTIDSet, &binlogSuffix, &exitSafeBinlogName, &exitSafeBinlogPos, &exitSafeBinlogGTIDSet, &tiBytes, &isGlobal)
 		if err != nil {
 			return terror.DBErrorAdapt(err, cp.dbConn.Scope(), terror.ErrDBDriverError)
 		}
@@ -1067,6 +1098,8 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 			},
 			gset,
 		)
+		// suffix records how many events of a `handle-error replace/inject` at this position have been applied.
+		location.Suffix = binlogSuffix
 		if isGlobal {
 			// Use IsFreshPosition here to make sure checkpoint can be updated if gset is empty
 			if !binlog.IsFreshPosition(location, cp.cfg.Flavor, cp.cfg.EnableGTID) {
@@ -1241,17 +1274,85 @@ func (cp *RemoteCheckPoint) LoadMeta(ctx context.Context) error {
 	return nil
 }
 
+// LoadSyncMarker implements CheckPoint.LoadSyncMarker.
+func (cp *RemoteCheckPoint) LoadSyncMarker(ctx context.Context) (bool, error) {
+	cp.Lock()
+	defer cp.Unlock()
+
+	tctx := cp.logCtx.WithContext(ctx)
+	query := `SELECT binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid FROM ` +
+		cp.loadSyncMarkerTableName + ` WHERE source_name = ? AND status = ?`
+	rows, err := cp.dbConn.QuerySQL(tctx, cp.metricProxies, query, cp.id, loadSyncMarkerStatusLoaded)
+	if err != nil {
+		// the marker table doesn't exist if the load unit is finished by an older version.
+		if conn.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			return false, nil
+		}
+		return false, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return false, terror.DBErrorAdapt(rows.Err(), cp.dbConn.Scope(), terror.ErrDBDriverError)
+	}
+	var (
+		binlogName            string
+		binlogPos             uint32
+		binlogGTIDSet         sql.NullString
+		exitSafeBinlogName    string
+		exitSafeBinlogPos     uint32
+		exitSafeBinlogGTIDSet sql.NullString
+	)
+	if err = rows.Scan(&binlogName, &binlogPos, &binlogGTIDSet, &exitSafeBinlogName, &exitSafeBinlogPos, &exitSafeBinlogGTIDSet); err != nil {
+		return false, terror.DBErrorAdapt(err, cp.dbConn.Scope(), terror.ErrDBDriverError)
+	}
+	gset, err := gtid.ParserGTID(cp.cfg.Flavor, binlogGTIDSet.String)
+	if err != nil {
+		return false, err
+	}
+	location := binlog.NewLocation(mysql.Position{Name: binlogName, Pos: binlogPos}, gset)
+	var safeModeExitLoc *binlog.Location
+	if exitSafeBinlogName != "" {
+		gset2, err2 := gtid.ParserGTID(cp.cfg.Flavor, exitSafeBinlogGTIDSet.String)
+		if err2 != nil {
+			return false, err2
+		}
+		loc := binlog.NewLocation(mysql.Position{Name: exitSafeBinlogName, Pos: exitSafeBinlogPos}, gset2)
+		safeModeExitLoc = &loc
+	}
+
+	// the dump files may be cleaned after load unit finished, verify the marker only if they still exist.
+	metaLoc, _, err := cp.parseMetaData(ctx)
+	if err == nil {
+		if binlog.CompareLocation(*metaLoc, location, cp.cfg.EnableGTID) != 0 {
+			return false, terror.ErrSyncerLoadSyncMarkerMismatch.Generate(location, metaLoc)
+		}
+	} else {
+		cp.logCtx.L().Warn("can't verify load sync marker with dump metadata", log.ShortError(err))
+	}
+
+	cp.globalPoint = newBinlogPoint(location, location, nil, nil, cp.cfg.EnableGTID)
+	cp.logCtx.L().Info("loaded checkpoints from load sync marker", log.WrapStringerField("global checkpoint", cp.globalPoint))
+	if safeModeExitLoc != nil {
+		cp.SaveSafeModeExitPoint(safeModeExitLoc)
+		cp.logCtx.L().Info("set SafeModeExitLoc from load sync marker", zap.Stringer("SafeModeExitLoc", safeModeExitLoc))
+	}
+	cp.needInstallLoadSyncMarker = true
+	return true, nil
+}
+
 // genUpdateSQL generates SQL and arguments for update checkpoint.
 func (cp *RemoteCheckPoint) genUpdateSQL(cpSchema, cpTable string, location binlog.Location, safeModeExitLoc *binlog.Location, tiBytes []byte, isGlobal bool) (string, []interface{}) {
 	// use `INSERT INTO ... ON DUPLICATE KEY UPDATE` rather than `REPLACE INTO`
 	// to keep `create_time`, `update_time` correctly
 	sql2 := `INSERT INTO ` + cp.tableName + `
-		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES
-		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, binlog_suffix, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON DUPLICATE KEY UPDATE
 			binlog_name = VALUES(binlog_name),
 			binlog_pos = VALUES(binlog_pos),
 			binlog_gtid = VALUES(binlog_gtid),
+			binlog_suffix = VALUES(binlog_suffix),
 			exit_safe_binlog_name = VALUES(exit_safe_binlog_name),
 			exit_safe_binlog_pos = VALUES(exit_safe_binlog_pos),
 			exit_safe_binlog_gtid = VALUES(exit_safe_binlog_gtid),
@@ -1281,7 +1382,7 @@ func (cp *RemoteCheckPoint) genUpdateSQL(cpSchema, cpTable string, location binl
 
 	// convert tiBytes to string to get a readable log
 	args := []interface{}{
-		cp.id, cpSchema, cpTable, location.Position.Name, location.Position.Pos, location.GTIDSetStr(),
+		cp.id, cpSchema, cpTable, location.Position.Name, location.Position.Pos, location.GTIDSetStr(), location.Suffix,
 		exitSafeName, exitSafePos, exitSafeGTIDStr, string(tiBytes), isGlobal,
 	}
 	return sql2, args
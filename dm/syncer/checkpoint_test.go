@@ -24,11 +24,13 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-mysql-org/go-mysql/mysql"
+	gmysql "github.com/go-sql-driver/mysql"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	tidbddl "github.com/pingcap/tidb/ddl"
 	"github.com/pingcap/tidb/parser/ast"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
@@ -40,7 +42,10 @@ import (
 	dlog "github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	enginecp "github.com/pingcap/tiflow/engine/jobmaster/dm/checkpoint"
+	enginecfg "github.com/pingcap/tiflow/engine/jobmaster/dm/config"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 )
@@ -167,7 +172,7 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 	cp.SaveGlobalPoint(binlog.Location{Position: pos1})
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("(162)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("(162)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", 0, "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	err = cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil)
 	c.Log(errors.ErrorStack(err))
@@ -208,7 +213,7 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 
 	// flush + rollback
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("(202)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("(202)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", 0, "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	err = cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil)
 	c.Assert(err, IsNil)
@@ -220,8 +225,8 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 	pos3 := pos2
 	pos3.Pos = pos2.Pos + 1000 // > pos2 to enable save
 	cp.SaveGlobalPoint(binlog.Location{Position: pos3})
-	columns := []string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}
-	s.mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, "", "", 0, "", "null", true))
+	columns := []string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "binlog_suffix", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}
+	s.mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, "", 0, "", 0, "", "null", true))
 	err = cp.Load(tctx)
 	c.Assert(err, IsNil)
 	c.Assert(cp.GlobalPoint().Position, Equals, pos2)
@@ -306,7 +311,7 @@ SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */
 	snapshot = cp.Snapshot(true)
 	c.Assert(snapshot, NotNil)
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("(202)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", pos2.Name, pos2.Pos, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("(202)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", 0, pos2.Name, pos2.Pos, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	err = cp.FlushPointsExcept(tctx, snapshot.id, nil, nil, nil)
 	c.Assert(err, IsNil)
@@ -364,7 +369,7 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 
 	// flush + rollback
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("(284)?"+flushCheckPointSQL).WithArgs(cpid, table.Schema, table.Name, pos2.Name, pos2.Pos, "", "", 0, "", sqlmock.AnyArg(), false).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("(284)?"+flushCheckPointSQL).WithArgs(cpid, table.Schema, table.Name, pos2.Name, pos2.Pos, "", 0, "", 0, "", sqlmock.AnyArg(), false).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	err = cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil)
 	c.Assert(err, IsNil)
@@ -411,7 +416,7 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 	cp.SaveTablePoint(table, binlog.Location{Position: pos1}, ti)
 	tiBytes, _ := json.Marshal(ti)
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, schemaName, tableName, pos1.Name, pos1.Pos, "", "", 0, "", string(tiBytes), false).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, schemaName, tableName, pos1.Name, pos1.Pos, "", 0, "", 0, "", string(tiBytes), false).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	lastGlobalPoint := cp.GlobalPoint()
 	lastGlobalPointSavedTime := cp.GlobalPointSaveTime()
@@ -449,7 +454,7 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 
 	// flush but except + rollback
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("(320)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("(320)?"+flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", 0, "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
 	s.mock.ExpectCommit()
 	lastGlobalPoint = cp.GlobalPoint()
 	lastGlobalPointSavedTime = cp.GlobalPointSaveTime()
@@ -471,10 +476,10 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 	flavor := mysql.MySQLFlavor
 	gSetStr := "03fc0263-28c7-11e7-a653-6c0b84d59f30:123"
 	gs, _ := gtid.ParserGTID(flavor, gSetStr)
-	columns := []string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}
+	columns := []string{"cp_schema", "cp_table", "binlog_name", "binlog_pos", "binlog_gtid", "binlog_suffix", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid", "table_info", "is_global"}
 	s.mock.ExpectQuery(loadCheckPointSQL).WithArgs(cpid).WillReturnRows(
-		sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, gs.String(), pos2.Name, pos2.Pos, gs.String(), "null", true).
-			AddRow(schemaName, tableName, pos2.Name, pos2.Pos, gs.String(), "", 0, "", tiBytes, false))
+		sqlmock.NewRows(columns).AddRow("", "", pos2.Name, pos2.Pos, gs.String(), 0, pos2.Name, pos2.Pos, gs.String(), "null", true).
+			AddRow(schemaName, tableName, pos2.Name, pos2.Pos, gs.String(), 0, "", 0, "", tiBytes, false))
 	err = cp.Load(tctx)
 	c.Assert(err, IsNil)
 	c.Assert(cp.GlobalPoint(), DeepEquals, binlog.NewLocation(pos2, gs))
@@ -565,3 +570,132 @@ func TestLastFlushOutdated(t *testing.T) {
 	// though snapshot is nil, checkpoint is not outdated
 	require.False(t, checkpoint.LastFlushOutdated())
 }
+
+func TestRemoteCheckPointLoadSyncMarker(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.WorkerCount = 0
+	cfg.Mode = config.ModeAll
+	ctx := context.Background()
+	tctx := tcontext.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	cp := NewRemoteCheckPoint(tctx, cfg, nil, cpid)
+	checkpoint := cp.(*RemoteCheckPoint)
+	checkpoint.dbConn = dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+
+	markerTable := dbutil.TableName(cfg.MetaSchema, cputil.LoadSyncMarker(cfg.Name))
+	loadMarkerSQL := fmt.Sprintf("SELECT .* FROM %s WHERE source_name = \\? AND status = \\?", markerTable)
+	columns := []string{"binlog_name", "binlog_pos", "binlog_gtid", "exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid"}
+	pos1 := mysql.Position{Name: "mysql-bin.000003", Pos: 1943}
+	pos2 := mysql.Position{Name: "mysql-bin.000003", Pos: 2000}
+
+	// the marker table doesn't exist
+	mock.ExpectQuery(loadMarkerSQL).WithArgs(cpid, loadSyncMarkerStatusLoaded).
+		WillReturnError(&gmysql.MySQLError{Number: tmysql.ErrNoSuchTable})
+	loaded, err := cp.LoadSyncMarker(ctx)
+	require.NoError(t, err)
+	require.False(t, loaded)
+
+	// no marker of this source
+	mock.ExpectQuery(loadMarkerSQL).WithArgs(cpid, loadSyncMarkerStatusLoaded).
+		WillReturnRows(sqlmock.NewRows(columns))
+	loaded, err = cp.LoadSyncMarker(ctx)
+	require.NoError(t, err)
+	require.False(t, loaded)
+
+	// the dump files are cleaned, use the marker directly
+	mock.ExpectQuery(loadMarkerSQL).WithArgs(cpid, loadSyncMarkerStatusLoaded).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(pos1.Name, pos1.Pos, "", pos2.Name, pos2.Pos, ""))
+	loaded, err = cp.LoadSyncMarker(ctx)
+	require.NoError(t, err)
+	require.True(t, loaded)
+	require.Equal(t, pos1, cp.GlobalPoint().Position)
+	require.Equal(t, pos2, cp.SafeModeExitPoint().Position)
+
+	// the marker is installed along with the global checkpoint
+	snapshot := cp.Snapshot(true)
+	require.NotNil(t, snapshot)
+	mock.ExpectBegin()
+	mock.ExpectExec(fmt.Sprintf("UPDATE %s SET status = \\? WHERE source_name = \\?", markerTable)).
+		WithArgs(loadSyncMarkerStatusInstalled, cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(fmt.Sprintf("INSERT INTO %s .* VALUES.* ON DUPLICATE KEY UPDATE .*", dbutil.TableName(cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name)))).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, cp.FlushPointsExcept(tctx, snapshot.id, nil, nil, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.False(t, checkpoint.needInstallLoadSyncMarker)
+
+	// the marker doesn't match the dump metadata
+	err = os.WriteFile(filepath.Join(cfg.Dir, "metadata"), []byte(
+		fmt.Sprintf("SHOW MASTER STATUS:\n\tLog: %s\n\tPos: %d\n\tGTID:\n\n", pos2.Name, pos2.Pos)), 0o644)
+	require.NoError(t, err)
+	mock.ExpectQuery(loadMarkerSQL).WithArgs(cpid, loadSyncMarkerStatusLoaded).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(pos1.Name, pos1.Pos, "", "", 0, ""))
+	_, err = cp.LoadSyncMarker(ctx)
+	require.True(t, terror.ErrSyncerLoadSyncMarkerMismatch.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoteCheckPointOnEngineCreatedTable(t *testing.T) {
+	cluster, err := conn.NewCluster()
+	require.NoError(t, err)
+	require.NoError(t, cluster.Start())
+	defer cluster.Stop()
+
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.To.Port = cluster.Port
+	cfg.To.Password = ""
+	ctx := context.Background()
+	tctx := tcontext.Background()
+	tableName := dbutil.TableName(cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))
+
+	// the table created by the previous versions of DM-on-engine doesn't have binlog_suffix.
+	db, err := conn.GetDownstreamDB(&cfg.To)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.DB.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+dbutil.ColumnName(cfg.MetaSchema))
+	require.NoError(t, err)
+	_, err = db.DB.ExecContext(ctx, `CREATE TABLE `+tableName+` (
+		id VARCHAR(32) NOT NULL,
+		cp_schema VARCHAR(128) NOT NULL,
+		cp_table VARCHAR(128) NOT NULL,
+		binlog_name VARCHAR(128),
+		binlog_pos INT UNSIGNED,
+		binlog_gtid TEXT,
+		exit_safe_binlog_name VARCHAR(128) DEFAULT '',
+		exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
+		exit_safe_binlog_gtid TEXT,
+		table_info JSON NOT NULL,
+		is_global BOOLEAN,
+		create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
+	)`)
+	require.NoError(t, err)
+
+	// the checkpoint table is pre-created by the jobmaster on DM-on-engine, so the syncer doesn't create it.
+	targetDB := cfg.To
+	jobCfg := &enginecfg.JobCfg{TaskMode: config.ModeIncrement, MetaSchema: cfg.MetaSchema, TargetDB: &targetDB}
+	agent := enginecp.NewAgentImpl(cfg.Name, log.L())
+	require.NoError(t, agent.Create(ctx, jobCfg))
+	// create again as the job is restarted.
+	require.NoError(t, agent.Create(ctx, jobCfg))
+
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000003", Pos: 1943}, Suffix: 2}
+	cp := NewRemoteCheckPoint(tctx, cfg, nil, cpid)
+	require.NoError(t, cp.Init(tctx))
+	defer cp.Close()
+	require.NoError(t, cp.Load(tctx))
+	cp.SaveGlobalPoint(location)
+	require.NoError(t, cp.FlushPointsExcept(tctx, cp.Snapshot(true).id, nil, nil, nil))
+
+	cp2 := NewRemoteCheckPoint(tctx, cfg, nil, cpid)
+	require.NoError(t, cp2.Init(tctx))
+	defer cp2.Close()
+	require.NoError(t, cp2.Load(tctx))
+	require.Equal(t, location.Position, cp2.GlobalPoint().Position)
+	require.Equal(t, location.Suffix, cp2.GlobalPoint().Suffix)
+}
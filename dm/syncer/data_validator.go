@@ -223,7 +223,7 @@ func NewContinuousDataValidator(cfg *config.SubTaskConfig, syncerObj *Syncer, st
 		startWithSubtask: startWithSubtask,
 		vmetric:          metrics.NewValidatorMetrics(cfg.Name, cfg.SourceID),
 	}
-	v.L = log.With(zap.String("task", cfg.Name), zap.String("unit", "continuous validator"))
+	v.L = log.ForTask(cfg.Name, cfg.SourceID).WithFields(zap.String("task", cfg.Name), zap.String("unit", "continuous validator"))
 
 	v.setStage(pb.Stage_Stopped)
 	v.workerCnt = cfg.ValidatorCfg.WorkerCount
@@ -902,15 +902,17 @@ func (v *DataValidator) processRowsEvent(header *replication.EventHeader, ev *re
 		step = 2
 	}
 	estimatedRowSize := int32(header.EventSize) / int32(len(ev.Rows))
+	unsignedMap := ev.Table.UnsignedMap()
 	for i := 0; i < len(ev.Rows); i += step {
 		var beforeImage, afterImage []interface{}
 		switch changeType {
 		case rowInsert:
-			afterImage = ev.Rows[i]
+			afterImage = castRowValues(ev.Rows[i], tableInfo.Columns, unsignedMap)
 		case rowUpdated:
-			beforeImage, afterImage = ev.Rows[i], ev.Rows[i+1]
+			beforeImage = castRowValues(ev.Rows[i], tableInfo.Columns, unsignedMap)
+			afterImage = castRowValues(ev.Rows[i+1], tableInfo.Columns, unsignedMap)
 		default: // rowDeleted
-			beforeImage = ev.Rows[i]
+			beforeImage = castRowValues(ev.Rows[i], tableInfo.Columns, unsignedMap)
 		}
 
 		rowChange := sqlmodel.NewRowChange(
@@ -77,8 +77,10 @@ type DDLWorker struct {
 
 	recordSkipSQLsLocation func(ec *eventContext) error
 	trackDDL               func(usedSchema string, trackInfo *ddlInfo, ec *eventContext) error
+	recordInstantColumns   func(stmt ast.StmtNode, schema string)
 	saveTablePoint         func(table *filter.Table, location binlog.Location)
 	flushJobs              func() error
+	checkUnexpectedTable   func(table *filter.Table, location binlog.Location) error
 }
 
 // NewDDLWorker creates a new DDLWorker instance.
@@ -103,8 +105,10 @@ func NewDDLWorker(pLogger *log.Logger, syncer *Syncer) *DDLWorker {
 		baList:                     syncer.baList,
 		recordSkipSQLsLocation:     syncer.recordSkipSQLsLocation,
 		trackDDL:                   syncer.trackDDL,
+		recordInstantColumns:       syncer.recordInstantAddedColumns,
 		saveTablePoint:             syncer.saveTablePoint,
 		flushJobs:                  syncer.flushJobs,
+		checkUnexpectedTable:       syncer.checkUnexpectedTable,
 	}
 	switch syncer.cfg.ShardMode {
 	case config.ShardPessimistic:
@@ -294,6 +298,9 @@ func (ddl *DDLWorker) HandleQueryEvent(ev *replication.QueryEvent, ec eventConte
 
 	ddl.logger.Info("ready to split ddl", zap.String("event", "query"), zap.Stringer("queryEventContext", qec))
 
+	// the algorithm of ALTER TABLE is lost after splitting, record the instantly added columns here.
+	ddl.recordInstantColumns(stmt, qec.ddlSchema)
+
 	// TiDB can't handle multi schema change DDL, so we split it here.
 	qec.splitDDLs, err = parserpkg.SplitDDL(stmt, qec.ddlSchema)
 	if err != nil {
@@ -969,7 +976,7 @@ func (ddl *DDLWorker) skipQueryEvent(qec *queryEventContext, ddlInfo *ddlInfo) (
 		ddl.logger.Debug("query event info", zap.String("event", "query"), zap.String("origin sql", qec.originSQL), zap.Stringer("table", table), zap.Stringer("ddl info", ddlInfo))
 		if skipByTable(ddl.baList, table) {
 			ddl.logger.Debug("skip event by balist")
-			return true, nil
+			return true, ddl.checkUnexpectedTable(table, qec.startLocation)
 		}
 		needSkip, err := skipByFilter(ddl.binlogFilter, table, et, qec.originSQL)
 		if err != nil {
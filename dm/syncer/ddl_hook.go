@@ -0,0 +1,197 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	selector "github.com/pingcap/tidb/util/table-rule-selector"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	ddlHookStageBefore = "before"
+	ddlHookStageAfter  = "after"
+
+	// maxFiredDDLHooks is the max number of fired DDL hooks kept for query-status.
+	maxFiredDDLHooks = 10
+)
+
+// ddlHookMatch is a DDL hook matched by a DDL which is going to be executed in downstream.
+type ddlHookMatch struct {
+	hook  *config.DDLHook
+	ddl   string
+	table *filter.Table
+}
+
+// sqls returns the SQLs of the given stage, with placeholders replaced by the matched table.
+func (m *ddlHookMatch) sqls(stage string) []string {
+	raw := m.hook.BeforeSQL
+	if stage == ddlHookStageAfter {
+		raw = m.hook.AfterSQL
+	}
+	replacer := strings.NewReplacer(
+		config.DDLHookSchemaPlaceholder, dbutil.ColumnName(m.table.Schema),
+		config.DDLHookTablePlaceholder, dbutil.ColumnName(m.table.Name),
+	)
+	sqls := make([]string, 0, len(raw))
+	for _, sql := range raw {
+		sqls = append(sqls, replacer.Replace(sql))
+	}
+	return sqls
+}
+
+// DDLHookGroup groups DDL hooks of a subtask and records the fired ones.
+type DDLHookGroup struct {
+	hooks         []*config.DDLHook
+	selector      selector.Selector
+	caseSensitive bool
+	parser        *parser.Parser
+	logCtx        *tcontext.Context
+
+	mu    sync.Mutex
+	fired []string
+}
+
+// NewDDLHookGroup creates a DDLHookGroup.
+func NewDDLHookGroup(logCtx *tcontext.Context, caseSensitive bool, hooks []*config.DDLHook) (*DDLHookGroup, error) {
+	g := &DDLHookGroup{
+		hooks:         hooks,
+		selector:      selector.NewTrieSelector(),
+		caseSensitive: caseSensitive,
+		parser:        parser.New(),
+		logCtx:        logCtx,
+	}
+	for _, hook := range hooks {
+		schema, table := hook.SchemaPattern, hook.TablePattern
+		if !caseSensitive {
+			schema, table = strings.ToLower(schema), strings.ToLower(table)
+		}
+		if err := g.selector.Insert(schema, table, hook, selector.Append); err != nil {
+			return nil, terror.ErrConfigInvalidDDLHook.Generate(hook.Name, err.Error())
+		}
+	}
+	return g, nil
+}
+
+// Match returns the hooks matched by the (routed) DDLs, in the order of DDLs and then the order of hooks.
+func (g *DDLHookGroup) Match(ddls []string) []*ddlHookMatch {
+	if g == nil || len(g.hooks) == 0 {
+		return nil
+	}
+
+	var matches []*ddlHookMatch
+	for _, ddl := range ddls {
+		stmt, err := g.parser.ParseOneStmt(ddl, "", "")
+		if err != nil {
+			g.logCtx.L().Warn("fail to parse DDL for ddl-hook, skip it", zap.String("DDL", ddl), log.ShortError(err))
+			continue
+		}
+		tables, err := parserpkg.FetchDDLTables("", stmt, conn.LCTableNamesSensitive)
+		if err != nil || len(tables) == 0 {
+			continue
+		}
+		tp := bf.AstToDDLEvent(stmt)
+		table := tables[0]
+		schema, name := table.Schema, table.Name
+		if !g.caseSensitive {
+			schema, name = strings.ToLower(schema), strings.ToLower(name)
+		}
+		rules := g.selector.Match(schema, name)
+		if len(rules) == 0 {
+			continue
+		}
+		for _, hook := range g.hooks {
+			if !hook.MatchDDLType(tp) {
+				continue
+			}
+			for _, rule := range rules {
+				if rule == hook {
+					matches = append(matches, &ddlHookMatch{hook: hook, ddl: ddl, table: table})
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// Execute executes the SQLs of the given stage of the matched hooks on the DDL connection in order.
+// a failed hook whose on-error is `warn` is only recorded, otherwise the error is returned.
+func (g *DDLHookGroup) Execute(tctx *tcontext.Context, db *dbconn.DBConn, metricProxies *metrics.Proxies, matches []*ddlHookMatch, stage string) error {
+	for _, m := range matches {
+		sqls := m.sqls(stage)
+		if len(sqls) == 0 {
+			continue
+		}
+		_, err := db.ExecuteSQL(tctx, metricProxies, sqls)
+		g.record(m, stage, err)
+		if err == nil {
+			tctx.L().Info("ddl-hook fired", zap.String("hook", m.hook.Name), zap.String("stage", stage),
+				zap.String("DDL", m.ddl), zap.Strings("SQLs", sqls))
+			continue
+		}
+		if m.hook.OnError == config.DDLHookOnErrorWarn {
+			tctx.L().Warn("ddl-hook failed, ignore it", zap.String("hook", m.hook.Name), zap.String("stage", stage),
+				zap.String("DDL", m.ddl), zap.Strings("SQLs", sqls), log.ShortError(err))
+			continue
+		}
+		return terror.ErrSyncerExecDDLHook.Delegate(err, stage, m.hook.Name, m.ddl)
+	}
+	return nil
+}
+
+func (g *DDLHookGroup) record(m *ddlHookMatch, stage string, err error) {
+	msg := fmt.Sprintf("[%s] ddl-hook %s (%s) fired by DDL: %s", time.Now().Format(time.RFC3339), m.hook.Name, stage, m.ddl)
+	if err != nil {
+		msg += ", error: " + err.Error()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fired = append(g.fired, msg)
+	if len(g.fired) > maxFiredDDLHooks {
+		g.fired = g.fired[len(g.fired)-maxFiredDDLHooks:]
+	}
+}
+
+// FiredHooks returns the recently fired hooks, from the oldest to the newest.
+func (g *DDLHookGroup) FiredHooks() []string {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.fired) == 0 {
+		return nil
+	}
+	ret := make([]string, len(g.fired))
+	copy(ret, g.fired)
+	return ret
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLHookGroupMatch(t *testing.T) {
+	hooks := []*config.DDLHook{
+		{
+			Name:          "analyze",
+			SchemaPattern: "db*",
+			TablePattern:  "tbl",
+			DDLTypes:      []bf.EventType{bf.AlterTable, bf.CreateIndex},
+			AfterSQL:      []string{"ANALYZE TABLE ${schema}.${table}"},
+			OnError:       config.DDLHookOnErrorWarn,
+		},
+		{
+			Name:          "trigger",
+			SchemaPattern: "db1",
+			BeforeSQL:     []string{"DROP TRIGGER IF EXISTS ${schema}.trg"},
+			OnError:       config.DDLHookOnErrorPause,
+		},
+	}
+	g, err := NewDDLHookGroup(tcontext.Background(), false, hooks)
+	require.NoError(t, err)
+
+	matches := g.Match([]string{
+		"ALTER TABLE `DB1`.`tbl` ADD INDEX `idx`(`a`)",
+		"CREATE INDEX `idx2` ON `db2`.`tbl` (`b`)",
+		"DROP TABLE `db2`.`tbl`",
+		"ALTER TABLE `other`.`tbl` ADD COLUMN `c` INT",
+	})
+	require.Len(t, matches, 3)
+	require.Equal(t, "analyze", matches[0].hook.Name)
+	require.Equal(t, []string{"ANALYZE TABLE `DB1`.`tbl`"}, matches[0].sqls(ddlHookStageAfter))
+	require.Len(t, matches[0].sqls(ddlHookStageBefore), 0)
+	require.Equal(t, "trigger", matches[1].hook.Name)
+	require.Equal(t, []string{"DROP TRIGGER IF EXISTS `DB1`.trg"}, matches[1].sqls(ddlHookStageBefore))
+	require.Equal(t, "analyze", matches[2].hook.Name)
+	require.Equal(t, "CREATE INDEX `idx2` ON `db2`.`tbl` (`b`)", matches[2].ddl)
+
+	var nilGroup *DDLHookGroup
+	require.Nil(t, nilGroup.Match([]string{"DROP TABLE `db1`.`tbl`"}))
+	require.Nil(t, nilGroup.FiredHooks())
+}
+
+func TestDDLHookGroupExecute(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	tctx := tcontext.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	ddlConn := dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+
+	hooks := []*config.DDLHook{
+		{
+			Name:          "warn",
+			SchemaPattern: "db",
+			TablePattern:  "tbl",
+			AfterSQL:      []string{"ANALYZE TABLE ${schema}.${table}"},
+			OnError:       config.DDLHookOnErrorWarn,
+		},
+		{
+			Name:          "pause",
+			SchemaPattern: "db",
+			TablePattern:  "tbl",
+			AfterSQL:      []string{"SELECT 1"},
+			OnError:       config.DDLHookOnErrorPause,
+		},
+	}
+	g, err := NewDDLHookGroup(tctx, false, hooks)
+	require.NoError(t, err)
+	ddl := "ALTER TABLE `db`.`tbl` ADD INDEX `idx`(`a`)"
+	matches := g.Match([]string{ddl})
+	require.Len(t, matches, 2)
+
+	// no before-sql, nothing executed
+	require.NoError(t, g.Execute(tctx, ddlConn, nil, matches, ddlHookStageBefore))
+	require.Nil(t, g.FiredHooks())
+
+	// failure of the warn hook is ignored
+	mock.ExpectBegin()
+	mock.ExpectExec("ANALYZE TABLE `db`.`tbl`").WillReturnError(errors.New("analyze failed"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT 1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, g.Execute(tctx, ddlConn, nil, matches, ddlHookStageAfter))
+	require.NoError(t, mock.ExpectationsWereMet())
+	fired := g.FiredHooks()
+	require.Len(t, fired, 2)
+	require.Contains(t, fired[0], "ddl-hook warn (after) fired by DDL: "+ddl)
+	require.Contains(t, fired[0], "analyze failed")
+	require.Contains(t, fired[1], "ddl-hook pause (after) fired by DDL: "+ddl)
+
+	// failure of the pause hook is returned
+	mock.ExpectBegin()
+	mock.ExpectExec("ANALYZE TABLE `db`.`tbl`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT 1").WillReturnError(errors.New("select failed"))
+	mock.ExpectRollback()
+	err = g.Execute(tctx, ddlConn, nil, matches, ddlHookStageAfter)
+	require.True(t, terror.ErrSyncerExecDDLHook.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, g.FiredHooks(), 4)
+
+	// only the latest hooks are kept
+	for i := 0; i < maxFiredDDLHooks; i++ {
+		g.record(matches[0], ddlHookStageAfter, nil)
+	}
+	require.Len(t, g.FiredHooks(), maxFiredDDLHooks)
+}
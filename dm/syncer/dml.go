@@ -15,12 +15,18 @@ package syncer
 
 import (
 	"encoding/binary"
+	"fmt"
+	"strings"
 
 	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	tidbtypes "github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/filter"
 	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -41,6 +47,9 @@ type genDMLParam struct {
 	originalData    [][]interface{}  // all data
 	sourceTableInfo *model.TableInfo // all table info
 	extendData      [][]interface{}  // all data include extend data
+	// unsignedMap is the signedness of the numeric columns carried by the TABLE_MAP event,
+	// column index -> unsigned. It's nil if the upstream doesn't log the metadata.
+	unsignedMap map[int]bool
 }
 
 var latin1Decoder = charmap.ISO8859_1.NewDecoder()
@@ -48,12 +57,16 @@ var latin1Decoder = charmap.ISO8859_1.NewDecoder()
 // extractValueFromData adjust the values obtained from go-mysql so that
 // - the values can be correctly converted to TiDB datum
 // - the values are in the correct type that go-sql-driver/mysql uses.
-func extractValueFromData(data []interface{}, columns []*model.ColumnInfo, sourceTI *model.TableInfo) []interface{} {
+// unsignedMap is the signedness from the TABLE_MAP event, it takes precedence
+// over the tracked table when they disagree.
+func extractValueFromData(data []interface{}, columns []*model.ColumnInfo, sourceTI *model.TableInfo, unsignedMap map[int]bool) []interface{} {
 	value := make([]interface{}, 0, len(data))
 	var err error
 
 	for i, d := range data {
-		d = castUnsigned(d, &columns[i].FieldType)
+		ft := &columns[i].FieldType
+		d = castEnumOrSet(d, ft)
+		d = castUnsigned(d, ft.GetType(), isUnsignedColumn(i, ft, unsignedMap))
 		isLatin1 := columns[i].GetCharset() == charset.CharsetLatin1 || columns[i].GetCharset() == "" && sourceTI.Charset == charset.CharsetLatin1
 
 		switch v := d.(type) {
@@ -100,6 +113,238 @@ func extractValueFromData(data []interface{}, columns []*model.ColumnInfo, sourc
 	return value
 }
 
+// castRowValues applies the signedness and the ENUM/SET elements to the values
+// decoded from binlog, without the other adjustments of extractValueFromData.
+func castRowValues(data []interface{}, columns []*model.ColumnInfo, unsignedMap map[int]bool) []interface{} {
+	value := make([]interface{}, len(data))
+	for i, d := range data {
+		if i < len(columns) {
+			ft := &columns[i].FieldType
+			d = castEnumOrSet(d, ft)
+			d = castUnsigned(d, ft.GetType(), isUnsignedColumn(i, ft, unsignedMap))
+		}
+		value[i] = d
+	}
+	return value
+}
+
+// fillMissingColumns fills the trailing columns which are missing in the row
+// events with the default values of the tracked table. The upstream may produce
+// such rows for the columns added by `ALTER TABLE ... ALGORITHM=INSTANT`, we fill
+// the defaults explicitly rather than relying on the implicit defaults of downstream,
+// and warn if the default of downstream is different from the tracked one. The rows
+// are returned as is if the missing columns are not added instantly, which are
+// reported as ErrSyncerUnitDMLColumnNotMatch later.
+func (s *Syncer) fillMissingColumns(
+	tctx *tcontext.Context,
+	sourceTable *filter.Table,
+	targetTable *filter.Table,
+	ti *model.TableInfo,
+	rows [][]interface{},
+) ([][]interface{}, error) {
+	minLen := len(ti.Columns)
+	for _, row := range rows {
+		if len(row) < minLen {
+			minLen = len(row)
+		}
+	}
+	if minLen == len(ti.Columns) {
+		return rows, nil
+	}
+	instantColumns := s.instantAddedColumns[utils.GenTableID(sourceTable)]
+	for _, col := range ti.Columns[minLen:] {
+		if _, ok := instantColumns[col.Name.L]; !ok {
+			return rows, nil
+		}
+	}
+
+	filled, err := fillColumnDefaults(s.sessCtx, ti, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, utils.GenTableID(targetTable), ti)
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range diffColumnDefaults(ti.Columns[minLen:], downstreamTableInfo.TableInfo) {
+		key := targetTable.String() + "." + col
+		if _, ok := s.defaultDriftWarned[key]; ok {
+			continue
+		}
+		if s.defaultDriftWarned == nil {
+			s.defaultDriftWarned = make(map[string]struct{})
+		}
+		s.defaultDriftWarned[key] = struct{}{}
+		tctx.L().Warn("default value of column in downstream is different from the tracked one, use the tracked one for rows missing the column",
+			zap.Stringer("target table", targetTable),
+			zap.String("column", col))
+	}
+	return filled, nil
+}
+
+// fillColumnDefaults returns the rows whose missing trailing columns are filled with
+// the default value of the columns.
+func fillColumnDefaults(sessCtx sessionctx.Context, ti *model.TableInfo, rows [][]interface{}) ([][]interface{}, error) {
+	defaults := make([]interface{}, len(ti.Columns))
+	evaluated := make([]bool, len(ti.Columns))
+	filled := make([][]interface{}, 0, len(rows))
+	for _, row := range rows {
+		if len(row) >= len(ti.Columns) {
+			filled = append(filled, row)
+			continue
+		}
+		newRow := make([]interface{}, len(ti.Columns))
+		copy(newRow, row)
+		for i := len(row); i < len(ti.Columns); i++ {
+			col := ti.Columns[i]
+			// generated columns are not written to downstream.
+			if col.IsGenerated() {
+				continue
+			}
+			if !evaluated[i] {
+				// the value of a non-constant default is unknown for the existing rows.
+				if hasNonConstantDefault(col) {
+					return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(row))
+				}
+				datum, err := columnDefaultValue(sessCtx, col)
+				if err != nil {
+					return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Delegate(err, len(ti.Columns), len(row))
+				}
+				defaults[i] = datumToBinlogValue(datum)
+				evaluated[i] = true
+			}
+			newRow[i] = defaults[i]
+		}
+		filled = append(filled, newRow)
+	}
+	return filled, nil
+}
+
+// hasNonConstantDefault returns whether the default of the column is an expression
+// or CURRENT_TIMESTAMP, whose value depends on when it's evaluated.
+func hasNonConstantDefault(col *model.ColumnInfo) bool {
+	if col.DefaultIsExpr {
+		return true
+	}
+	def, ok := col.GetDefaultValue().(string)
+	return ok && strings.HasPrefix(strings.ToLower(def), ast.CurrentTimestamp)
+}
+
+// columnDefaultValue returns the value of the column for the rows which exist before
+// the column is added. The origin default is only set when the column is added by
+// ALTER TABLE, otherwise the default value (including expression) is used.
+func columnDefaultValue(sessCtx sessionctx.Context, col *model.ColumnInfo) (tidbtypes.Datum, error) {
+	if col.GetOriginDefaultValue() == nil {
+		return table.GetColDefaultValue(sessCtx, col)
+	}
+	return table.GetColOriginDefaultValue(sessCtx, col)
+}
+
+// recordInstantAddedColumns records the columns added to the end of the table by
+// the ALTER TABLE statement with ALGORITHM=INSTANT. The record of the table is
+// removed if the statement changes the existing columns or their order, since
+// the columns missing in the rows events are not the trailing ones then.
+func (s *Syncer) recordInstantAddedColumns(stmt ast.StmtNode, schema string) {
+	alter, ok := stmt.(*ast.AlterTableStmt)
+	if !ok {
+		return
+	}
+	table := &filter.Table{Schema: alter.Table.Schema.O, Name: alter.Table.Name.O}
+	if table.Schema == "" {
+		table.Schema = schema
+	}
+	tableID := utils.GenTableID(table)
+
+	var (
+		instant bool
+		columns []string
+	)
+	for _, spec := range alter.Specs {
+		switch spec.Tp {
+		case ast.AlterTableAlgorithm:
+			instant = spec.Algorithm == ast.AlgorithmTypeInstant
+		case ast.AlterTableAddColumns:
+			if spec.Position != nil && spec.Position.Tp != ast.ColumnPositionNone {
+				delete(s.instantAddedColumns, tableID)
+				return
+			}
+			for _, col := range spec.NewColumns {
+				columns = append(columns, col.Name.Name.L)
+			}
+		case ast.AlterTableDropColumn, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn,
+			ast.AlterTableRenameColumn:
+			delete(s.instantAddedColumns, tableID)
+			return
+		}
+	}
+	if len(columns) == 0 {
+		return
+	}
+	if !instant {
+		// the columns added by copying the table are written in all the rows events.
+		for _, col := range columns {
+			delete(s.instantAddedColumns[tableID], col)
+		}
+		return
+	}
+
+	if s.instantAddedColumns == nil {
+		s.instantAddedColumns = make(map[string]map[string]struct{})
+	}
+	if s.instantAddedColumns[tableID] == nil {
+		s.instantAddedColumns[tableID] = make(map[string]struct{})
+	}
+	for _, col := range columns {
+		s.instantAddedColumns[tableID][col] = struct{}{}
+	}
+}
+
+// datumToBinlogValue converts the datum to the value type in the row events of go-mysql.
+func datumToBinlogValue(d tidbtypes.Datum) interface{} {
+	switch d.Kind() {
+	case tidbtypes.KindNull:
+		return nil
+	case tidbtypes.KindMysqlEnum:
+		return int64(d.GetMysqlEnum().Value)
+	case tidbtypes.KindMysqlSet:
+		return int64(d.GetMysqlSet().Value)
+	case tidbtypes.KindMysqlTime, tidbtypes.KindMysqlDuration, tidbtypes.KindMysqlDecimal,
+		tidbtypes.KindMysqlJSON, tidbtypes.KindMysqlBit, tidbtypes.KindBinaryLiteral:
+		s, err := d.ToString()
+		if err != nil {
+			log.L().DPanic("can't convert default value to string", zap.Reflect("datum", d), zap.Error(err))
+		}
+		return s
+	default:
+		return d.GetValue()
+	}
+}
+
+// diffColumnDefaults returns the names of the columns whose default is different
+// from the default of the same column in downstream table.
+func diffColumnDefaults(columns []*model.ColumnInfo, downstreamTI *model.TableInfo) []string {
+	var diff []string
+	for _, col := range columns {
+		if col.IsGenerated() {
+			continue
+		}
+		downstreamCol := model.FindColumnInfo(downstreamTI.Columns, col.Name.L)
+		if downstreamCol == nil {
+			continue
+		}
+		trackedDefault := col.GetOriginDefaultValue()
+		if trackedDefault == nil {
+			trackedDefault = col.GetDefaultValue()
+		}
+		if col.DefaultIsExpr != downstreamCol.DefaultIsExpr ||
+			fmt.Sprint(trackedDefault) != fmt.Sprint(downstreamCol.GetDefaultValue()) {
+			diff = append(diff, col.Name.O)
+		}
+	}
+	return diff
+}
+
 func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLParam, filterExprs []expression.Expression) ([]*sqlmodel.RowChange, error) {
 	var (
 		tableID         = utils.GenTableID(param.targetTable)
@@ -125,7 +370,7 @@ RowLoop:
 			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(data))
 		}
 
-		originalValue := extractValueFromData(originalDataSeq[dataIdx], ti.Columns, ti)
+		originalValue := extractValueFromData(originalDataSeq[dataIdx], ti.Columns, ti, param.unsignedMap)
 
 		for _, expr := range filterExprs {
 			skip, err := SkipDMLByExpression(s.sessCtx, originalValue, expr, ti.Columns)
@@ -191,8 +436,14 @@ RowLoop:
 			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(oriOldData))
 		}
 
-		oriOldValues := extractValueFromData(oriOldData, ti.Columns, ti)
-		oriChangedValues := extractValueFromData(oriChangedData, ti.Columns, ti)
+		oriOldValues := extractValueFromData(oriOldData, ti.Columns, ti, param.unsignedMap)
+		oriChangedValues := extractValueFromData(oriChangedData, ti.Columns, ti, param.unsignedMap)
+
+		if s.exprFilterGroup.SkipUpdateByChangedColumns(param.sourceTable, ti, oriOldValues, oriChangedValues) {
+			s.filteredUpdate.Add(1)
+			s.metricsProxies.IgnoredUpdateTotal.WithLabelValues(s.cfg.Name, param.sourceTable.String(), s.cfg.SourceID).Inc()
+			continue
+		}
 
 		for j := range oldValueFilters {
 			// AND logic
@@ -253,7 +504,7 @@ RowLoop:
 			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(data))
 		}
 
-		value := extractValueFromData(data, ti.Columns, ti)
+		value := extractValueFromData(data, ti.Columns, ti, param.unsignedMap)
 
 		for _, expr := range filterExprs {
 			skip, err := SkipDMLByExpression(s.sessCtx, value, expr, ti.Columns)
@@ -282,8 +533,20 @@ RowLoop:
 	return dmls, nil
 }
 
-func castUnsigned(data interface{}, ft *types.FieldType) interface{} {
-	if !mysql.HasUnsignedFlag(ft.GetFlag()) {
+// isUnsignedColumn returns whether the i-th column is unsigned. The signedness
+// in the TABLE_MAP event is what the upstream used to encode the row, so it's
+// preferred, and the tracked table is only used when the event doesn't carry it.
+// ZEROFILL implies UNSIGNED in MySQL, the display width only affects how the
+// value is shown so the value itself is replicated as a plain unsigned number.
+func isUnsignedColumn(i int, ft *types.FieldType, unsignedMap map[int]bool) bool {
+	if unsigned, ok := unsignedMap[i]; ok {
+		return unsigned
+	}
+	return mysql.HasUnsignedFlag(ft.GetFlag()) || mysql.HasZerofillFlag(ft.GetFlag())
+}
+
+func castUnsigned(data interface{}, tp byte, unsigned bool) interface{} {
+	if !unsigned {
 		return data
 	}
 
@@ -295,7 +558,7 @@ func castUnsigned(data interface{}, ft *types.FieldType) interface{} {
 	case int16:
 		return uint16(v)
 	case int32:
-		if ft.GetType() == mysql.TypeInt24 {
+		if tp == mysql.TypeInt24 {
 			// we use int32 to store MEDIUMINT, if the value is signed, it's fine
 			// but if the value is un-signed, simply convert it use `uint32` may out of the range
 			// like -4692783 converted to 4290274513 (2^32 - 4692783), but we expect 12084433 (2^24 - 4692783)
@@ -311,6 +574,46 @@ func castUnsigned(data interface{}, ft *types.FieldType) interface{} {
 	return data
 }
 
+// castEnumOrSet converts the ENUM ordinal or the SET bitmap decoded from binlog
+// to the string value according to the elements of the tracked table. The value
+// is kept as is if it doesn't match the elements, to let downstream report it.
+func castEnumOrSet(data interface{}, ft *types.FieldType) interface{} {
+	var n uint64
+	switch v := data.(type) {
+	case int64:
+		n = uint64(v)
+	case uint64:
+		n = v
+	default:
+		return data
+	}
+
+	elems := ft.GetElems()
+	switch ft.GetType() {
+	case mysql.TypeEnum:
+		// ordinal 0 is the special error value '' of ENUM.
+		if n == 0 {
+			return ""
+		}
+		if n > uint64(len(elems)) {
+			return data
+		}
+		return elems[n-1]
+	case mysql.TypeSet:
+		if len(elems) < 64 && n>>uint(len(elems)) != 0 {
+			return data
+		}
+		names := make([]string, 0, len(elems))
+		for i, elem := range elems {
+			if n&(1<<uint(i)) != 0 {
+				names = append(names, elem)
+			}
+		}
+		return strings.Join(names, ",")
+	}
+	return data
+}
+
 func (s *Syncer) mappingDML(table *filter.Table, ti *model.TableInfo, data [][]interface{}) ([][]interface{}, error) {
 	if s.columnMapping == nil {
 		return data, nil
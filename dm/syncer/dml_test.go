@@ -14,6 +14,7 @@
 package syncer
 
 import (
+	"fmt"
 	"math"
 	"testing"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/util/mock"
 	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/stretchr/testify/require"
 )
@@ -60,15 +62,118 @@ func (s *testSyncerSuite) TestCastUnsigned(c *C) {
 		{int64(-math.Exp2(63)), true, mysql.TypeLonglong, uint64(math.Exp2(63))},
 	}
 	for _, cs := range cases {
-		ft := types.NewFieldType(cs.Type)
-		if cs.unsigned {
-			ft.AddFlag(mysql.UnsignedFlag)
-		}
-		obtained := castUnsigned(cs.data, ft)
+		obtained := castUnsigned(cs.data, cs.Type, cs.unsigned)
 		c.Assert(obtained, Equals, cs.expected)
 	}
 }
 
+func TestIsUnsignedColumn(t *testing.T) {
+	t.Parallel()
+
+	signed := types.NewFieldType(mysql.TypeLonglong)
+	unsigned := types.NewFieldType(mysql.TypeLonglong)
+	unsigned.AddFlag(mysql.UnsignedFlag)
+	zerofill := types.NewFieldType(mysql.TypeLong)
+	zerofill.AddFlag(mysql.ZerofillFlag)
+
+	// fallback to the tracked table if TABLE_MAP doesn't carry the signedness.
+	require.False(t, isUnsignedColumn(0, signed, nil))
+	require.True(t, isUnsignedColumn(0, unsigned, nil))
+	require.True(t, isUnsignedColumn(0, zerofill, nil))
+	require.True(t, isUnsignedColumn(0, signed, map[int]bool{1: false}))
+	// TABLE_MAP takes precedence when they disagree.
+	require.True(t, isUnsignedColumn(0, signed, map[int]bool{0: true}))
+	require.False(t, isUnsignedColumn(0, unsigned, map[int]bool{0: false}))
+}
+
+// integerBoundaryFixtures are the boundary values of every integer type, raw
+// is the value decoded by go-mysql and expected is the one sent to downstream.
+var integerBoundaryFixtures = []struct {
+	tp       string
+	unsigned bool
+	raw      interface{}
+	expected interface{}
+}{
+	{"tinyint", false, int8(math.MinInt8), int64(math.MinInt8)},
+	{"tinyint", false, int8(0), int64(0)},
+	{"tinyint", false, int8(math.MaxInt8), int64(math.MaxInt8)},
+	{"tinyint", true, int8(0), uint64(0)},
+	{"tinyint", true, int8(math.MinInt8), uint64(math.MaxInt8 + 1)},
+	{"tinyint", true, int8(-1), uint64(math.MaxUint8)},
+	{"smallint", false, int16(math.MinInt16), int64(math.MinInt16)},
+	{"smallint", false, int16(0), int64(0)},
+	{"smallint", false, int16(math.MaxInt16), int64(math.MaxInt16)},
+	{"smallint", true, int16(0), uint64(0)},
+	{"smallint", true, int16(math.MinInt16), uint64(math.MaxInt16 + 1)},
+	{"smallint", true, int16(-1), uint64(math.MaxUint16)},
+	{"mediumint", false, int32(-1 << 23), int64(-1 << 23)},
+	{"mediumint", false, int32(0), int64(0)},
+	{"mediumint", false, int32(1<<23 - 1), int64(1<<23 - 1)},
+	{"mediumint", true, int32(0), uint64(0)},
+	{"mediumint", true, int32(-1 << 23), uint64(1 << 23)},
+	{"mediumint", true, int32(-1), uint64(1<<24 - 1)},
+	{"int", false, int32(math.MinInt32), int64(math.MinInt32)},
+	{"int", false, int32(0), int64(0)},
+	{"int", false, int32(math.MaxInt32), int64(math.MaxInt32)},
+	{"int", true, int32(0), uint64(0)},
+	{"int", true, int32(math.MinInt32), uint64(math.MaxInt32 + 1)},
+	{"int", true, int32(-1), uint64(math.MaxUint32)},
+	{"bigint", false, int64(math.MinInt64), int64(math.MinInt64)},
+	{"bigint", false, int64(0), int64(0)},
+	{"bigint", false, int64(math.MaxInt64), int64(math.MaxInt64)},
+	{"bigint", true, int64(0), uint64(0)},
+	{"bigint", true, int64(math.MinInt64), uint64(math.MaxInt64 + 1)},
+	{"bigint", true, int64(-1), uint64(math.MaxUint64)},
+}
+
+func TestExtractIntegerBoundaryValues(t *testing.T) {
+	t.Parallel()
+
+	for _, fx := range integerBoundaryFixtures {
+		signedTI := mockTableInfo(t, "create table db.tb(c "+fx.tp+")")
+		unsignedTI := mockTableInfo(t, "create table db.tb(c "+fx.tp+" unsigned)")
+		zerofillTI := mockTableInfo(t, "create table db.tb(c "+fx.tp+"(8) zerofill)")
+		row := []interface{}{fx.raw}
+		comment := fmt.Sprintf("%s unsigned=%v raw=%v", fx.tp, fx.unsigned, fx.raw)
+
+		// TABLE_MAP carries the signedness, the tracked table is ignored.
+		unsignedMap := map[int]bool{0: fx.unsigned}
+		require.Equal(t, []interface{}{fx.expected}, extractValueFromData(row, signedTI.Columns, signedTI, unsignedMap), comment)
+		require.Equal(t, []interface{}{fx.expected}, extractValueFromData(row, unsignedTI.Columns, unsignedTI, unsignedMap), comment)
+
+		// TABLE_MAP doesn't carry the signedness, the tracked table is used.
+		ti := signedTI
+		if fx.unsigned {
+			ti = unsignedTI
+		}
+		require.Equal(t, []interface{}{fx.expected}, extractValueFromData(row, ti.Columns, ti, nil), comment)
+		if fx.unsigned {
+			require.Equal(t, []interface{}{fx.expected}, extractValueFromData(row, zerofillTI.Columns, zerofillTI, nil), comment)
+		}
+	}
+}
+
+func TestExtractEnumAndSetValues(t *testing.T) {
+	t.Parallel()
+
+	ti := mockTableInfo(t, "create table db.tb(e enum('a','b','c'), s set('x','y','z'))")
+	cases := []struct {
+		raw      []interface{}
+		expected []interface{}
+	}{
+		{[]interface{}{int64(1), int64(1)}, []interface{}{"a", "x"}},
+		{[]interface{}{int64(3), int64(5)}, []interface{}{"c", "x,z"}},
+		{[]interface{}{int64(0), int64(0)}, []interface{}{"", ""}},
+		{[]interface{}{int64(2), int64(7)}, []interface{}{"b", "x,y,z"}},
+		// out of the range of the tracked elements, keep the value as is.
+		{[]interface{}{int64(4), int64(8)}, []interface{}{int64(4), int64(8)}},
+		{[]interface{}{nil, nil}, []interface{}{nil, nil}},
+	}
+	for _, ca := range cases {
+		require.Equal(t, ca.expected, extractValueFromData(ca.raw, ti.Columns, ti, nil))
+	}
+}
+
 func createTableInfo(p *parser.Parser, se sessionctx.Context, tableID int64, sql string) (*model.TableInfo, error) {
 	node, err := p.ParseOneStmt(sql, "utf8mb4", "utf8mb4_bin")
 	if err != nil {
@@ -341,6 +446,35 @@ func (s *testSyncerSuite) TestGBKExtractValueFromData(c *C) {
 
 	row := []interface{}{1, "\xc4\xe3\xba\xc3"}
 	expect := []interface{}{1, []byte("\xc4\xe3\xba\xc3")}
-	got := extractValueFromData(row, ti.Columns, ti)
+	got := extractValueFromData(row, ti.Columns, ti, nil)
 	c.Assert(got, DeepEquals, expect)
 }
+
+func TestFillColumnDefaults(t *testing.T) {
+	t.Parallel()
+
+	ti := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24), c1 int default 10, c2 varchar(10) default 'abc', c4 int, c5 int default 1)")
+	// c5 is added by ALTER TABLE ... ALGORITHM=INSTANT, the existing rows use the origin default.
+	require.NoError(t, ti.Columns[5].SetOriginDefaultValue("5"))
+
+	rows := [][]interface{}{
+		{int32(1), "a"},
+		{int32(2), "b", int32(3), "x", int32(4), int32(6)},
+	}
+	filled, err := fillColumnDefaults(mock.NewContext(), ti, rows)
+	require.NoError(t, err)
+	require.Len(t, filled, 2)
+	require.Equal(t, []interface{}{int32(1), "a", int64(10), "abc", nil, int64(5)}, filled[0])
+	// complete rows are not changed
+	require.Equal(t, rows[1], filled[1])
+	// the original rows are not changed
+	require.Len(t, rows[0], 2)
+
+	downstreamTI := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24), c1 int default 20, c2 varchar(10) default 'abc', c4 int, c5 int default 5)")
+	require.Equal(t, []string{"c1"}, diffColumnDefaults(ti.Columns[2:], downstreamTI))
+
+	// the non-constant defaults are not evaluated.
+	ti = mockTableInfo(t, "create table db.tb(id int primary key, c1 datetime default current_timestamp)")
+	_, err = fillColumnDefaults(mock.NewContext(), ti, [][]interface{}{{int32(1)}})
+	require.True(t, terror.ErrSyncerUnitDMLColumnNotMatch.Equal(err))
+}
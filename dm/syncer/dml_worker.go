@@ -37,6 +37,7 @@ type DMLWorker struct {
 	chanSize      int
 	multipleRows  bool
 	toDBConns     []*dbconn.DBConn
+	tunings       *TableTunings
 	syncCtx       *tcontext.Context
 	logger        log.Logger
 	metricProxies *metrics.Proxies
@@ -81,6 +82,7 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		syncCtx:              syncer.syncCtx, // this ctx can be used to cancel all the workers
 		metricProxies:        syncer.metricsProxies,
 		toDBConns:            syncer.toDBConns,
+		tunings:              syncer.tableTunings,
 		inCh:                 inCh,
 		flushCh:              make(chan *job),
 	}
@@ -135,7 +137,7 @@ func (w *DMLWorker) run() {
 			j.flushWg.Wait()
 			w.updateJobMetricsFunc(true, adminQueueName, j)
 		default:
-			queueBucket := int(utils.GenHashKey(j.dmlQueueKey)) % w.workerCount
+			queueBucket := w.queueBucket(j)
 			w.updateJobMetricsFunc(false, queueBucketMapping[queueBucket], j)
 			startTime := time.Now()
 			w.logger.Debug("queue for key", zap.Int("queue", queueBucket), zap.String("key", j.dmlQueueKey))
@@ -145,6 +147,19 @@ func (w *DMLWorker) run() {
 	}
 }
 
+// queueBucket returns the DML queue of the job, the jobs with the same dmlQueueKey are always in the same queue.
+// If worker-count of the table is overridden, the jobs of the table are confined to that many consecutive queues.
+func (w *DMLWorker) queueBucket(j *job) int {
+	if j.dml != nil {
+		j.tuning = w.tunings.match(j.dml.GetSourceTable())
+	}
+	hash := int(utils.GenHashKey(j.dmlQueueKey))
+	if j.tuning != nil && j.tuning.workerCount > 0 && j.tuning.workerCount < w.workerCount {
+		return (j.tuning.queueOffset + hash%j.tuning.workerCount) % w.workerCount
+	}
+	return hash % w.workerCount
+}
+
 func (w *DMLWorker) sendJobToAllDmlQueue(j *job, jobChs []chan *job, queueBucketMapping []string) {
 	// flush for every DML queue
 	for i, jobCh := range jobChs {
@@ -160,6 +175,12 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 	jobs := make([]*job, 0, w.batch)
 	workerJobIdx := dmlWorkerJobIdx(queueID)
 	queueBucket := queueBucketName(queueID)
+	var (
+		// limits of the current batch, which are the tightest ones of the tables in it.
+		batchLimit int
+		sizeLimit  int64
+		size       int64
+	)
 	for j := range jobCh {
 		w.metricProxies.QueueSizeGauge.WithLabelValues(w.task, queueBucket, w.source).Set(float64(len(jobCh)))
 
@@ -167,9 +188,14 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 			if len(jobs) == 0 {
 				// set job TS when received first job of this batch.
 				w.lagFunc(j, workerJobIdx)
+				batchLimit, sizeLimit, size = 0, 0, 0
 			}
 			jobs = append(jobs, j)
-			if len(jobs) < w.batch && len(jobCh) > 0 {
+			batchLimit, sizeLimit = w.batchLimits(j, batchLimit, sizeLimit)
+			if w.tunings != nil && w.tunings.hasMaxDMLSize && j.dml != nil {
+				size += dmlSize(j.dml)
+			}
+			if len(jobs) < batchLimit && (sizeLimit == 0 || size < sizeLimit) && len(jobCh) > 0 {
 				continue
 			}
 		}
@@ -196,6 +222,23 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 	}
 }
 
+// batchLimits narrows the limits of the batch by the job.
+func (w *DMLWorker) batchLimits(j *job, batchLimit int, sizeLimit int64) (int, int64) {
+	batch := w.batch
+	if j.tuning != nil {
+		if j.tuning.batch > 0 {
+			batch = j.tuning.batch
+		}
+		if j.tuning.maxDMLSize > 0 && (sizeLimit == 0 || j.tuning.maxDMLSize < sizeLimit) {
+			sizeLimit = j.tuning.maxDMLSize
+		}
+	}
+	if batchLimit == 0 || batch < batchLimit {
+		batchLimit = batch
+	}
+	return batchLimit, sizeLimit
+}
+
 // executeBatchJobs execute jobs with batch size.
 func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	var (
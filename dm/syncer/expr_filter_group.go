@@ -14,6 +14,9 @@
 package syncer
 
 import (
+	"reflect"
+	"strings"
+
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/planner/core"
@@ -40,6 +43,9 @@ type ExprFilterGroup struct {
 	hasUpdateFilter map[string]struct{} // set(tableName)
 	hasDeleteFilter map[string]struct{} // set(tableName)
 
+	// tableName -> column sets (lower case), an UPDATE is skipped when its changed columns are a subset of one of them
+	ignoreUpdateColumns map[string][]map[string]struct{}
+
 	tidbCtx sessionctx.Context
 	logCtx  *tcontext.Context
 }
@@ -57,6 +63,8 @@ func NewExprFilterGroup(logCtx *tcontext.Context, tidbCtx sessionctx.Context, ex
 		hasDeleteFilter: map[string]struct{}{},
 		tidbCtx:         tidbCtx,
 		logCtx:          logCtx,
+
+		ignoreUpdateColumns: map[string][]map[string]struct{}{},
 	}
 	for _, c := range exprConfig {
 		tableName := dbutil.TableName(c.Schema, c.Table)
@@ -71,10 +79,54 @@ func NewExprFilterGroup(logCtx *tcontext.Context, tidbCtx sessionctx.Context, ex
 		if c.DeleteValueExpr != "" {
 			ret.hasDeleteFilter[tableName] = struct{}{}
 		}
+		if c.IgnoreUpdateByColumns() {
+			columns := make(map[string]struct{}, len(c.IgnoreUpdateWhenOnlyColumns))
+			for _, col := range c.IgnoreUpdateWhenOnlyColumns {
+				columns[strings.ToLower(col)] = struct{}{}
+			}
+			ret.ignoreUpdateColumns[tableName] = append(ret.ignoreUpdateColumns[tableName], columns)
+		}
 	}
 	return ret
 }
 
+// SkipUpdateByChangedColumns returns true when the changed columns of the UPDATE row are all in one of the
+// `ignore-update-when-only-columns` of given table, which means this row should be skipped.
+func (g *ExprFilterGroup) SkipUpdateByChangedColumns(
+	table *filter.Table,
+	ti *model.TableInfo,
+	oldValues, newValues []interface{},
+) bool {
+	if g == nil {
+		return false
+	}
+	columnSets, ok := g.ignoreUpdateColumns[utils.GenTableID(table)]
+	if !ok {
+		return false
+	}
+
+	changed := make([]string, 0, 1)
+	for i, col := range ti.Columns {
+		if i >= len(oldValues) || i >= len(newValues) {
+			break
+		}
+		if !reflect.DeepEqual(oldValues[i], newValues[i]) {
+			changed = append(changed, col.Name.L)
+		}
+	}
+
+ColumnSetLoop:
+	for _, columns := range columnSets {
+		for _, col := range changed {
+			if _, ok := columns[col]; !ok {
+				continue ColumnSetLoop
+			}
+		}
+		return true
+	}
+	return false
+}
+
 // GetInsertExprs returns the expression filters for given table to filter INSERT events.
 // This function will lazy calculate expressions if not initialized.
 func (g *ExprFilterGroup) GetInsertExprs(table *filter.Table, ti *model.TableInfo) ([]expression.Expression, error) {
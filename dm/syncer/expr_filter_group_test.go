@@ -120,8 +120,8 @@ create table t (
 		require.Len(t, exprs, 1)
 		expr := exprs[0]
 
-		ca.skippedRow = extractValueFromData(ca.skippedRow, ti.Columns, ti)
-		ca.passedRow = extractValueFromData(ca.passedRow, ti.Columns, ti)
+		ca.skippedRow = extractValueFromData(ca.skippedRow, ti.Columns, ti, nil)
+		ca.passedRow = extractValueFromData(ca.passedRow, ti.Columns, ti, nil)
 
 		skip, err := SkipDMLByExpression(sessCtx, ca.skippedRow, expr, ti.Columns)
 		require.NoError(t, err)
@@ -384,8 +384,8 @@ create table t (
 		require.Len(t, exprs, 1)
 		expr := exprs[0]
 
-		ca.skippedRow = extractValueFromData(ca.skippedRow, ti.Columns, ti)
-		ca.passedRow = extractValueFromData(ca.passedRow, ti.Columns, ti)
+		ca.skippedRow = extractValueFromData(ca.skippedRow, ti.Columns, ti, nil)
+		ca.passedRow = extractValueFromData(ca.passedRow, ti.Columns, ti, nil)
 
 		skip, err := SkipDMLByExpression(sessCtx, ca.skippedRow, expr, ti.Columns)
 		require.NoError(t, err)
@@ -507,3 +507,67 @@ create table t (
 	require.Equal(t, len(oldExprs), len(newExprs))
 	require.Len(t, oldExprs, 3)
 }
+
+func TestSkipUpdateByChangedColumns(t *testing.T) {
+	var (
+		table = &filter.Table{
+			Schema: "test",
+			Name:   "t",
+		}
+		otherTable = &filter.Table{
+			Schema: "test",
+			Name:   "t2",
+		}
+		tableStr = `
+create table t (
+	id int primary key,
+	name varchar(20),
+	updated_at datetime,
+	version int
+);`
+		sessCtx = utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
+	)
+
+	stmt, err := parseSQL(tableStr)
+	require.NoError(t, err)
+	tableInfo, err := ddl2.BuildTableInfoFromAST(stmt.(*ast.CreateTableStmt))
+	require.NoError(t, err)
+
+	g := NewExprFilterGroup(tcontext.Background(), sessCtx, []*config.ExpressionFilter{
+		{
+			Schema:                      table.Schema,
+			Table:                       table.Name,
+			IgnoreUpdateWhenOnlyColumns: []string{"Updated_At", "version"},
+		},
+		{
+			Schema:                      table.Schema,
+			Table:                       table.Name,
+			IgnoreUpdateWhenOnlyColumns: []string{"name"},
+		},
+	})
+	// this kind of filter should not produce any update expression
+	oldExprs, newExprs, err := g.GetUpdateExprs(table, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, oldExprs, 0)
+	require.Len(t, newExprs, 0)
+
+	oldRow := []interface{}{1, "a", "2023-01-01 00:00:00", 1}
+	cases := []struct {
+		newRow  []interface{}
+		skipped bool
+	}{
+		{[]interface{}{1, "a", "2023-01-01 00:00:01", 1}, true},
+		{[]interface{}{1, "a", "2023-01-01 00:00:01", 2}, true},
+		{[]interface{}{1, "b", "2023-01-01 00:00:00", 1}, true},
+		{[]interface{}{1, "a", "2023-01-01 00:00:00", 1}, true},
+		{[]interface{}{1, "b", "2023-01-01 00:00:01", 1}, false},
+		{[]interface{}{2, "a", "2023-01-01 00:00:01", 1}, false},
+	}
+	for i, c := range cases {
+		require.Equal(t, c.skipped, g.SkipUpdateByChangedColumns(table, tableInfo, oldRow, c.newRow), "case #%d", i)
+		require.False(t, g.SkipUpdateByChangedColumns(otherTable, tableInfo, oldRow, c.newRow), "case #%d", i)
+	}
+
+	var nilGroup *ExprFilterGroup
+	require.False(t, nilGroup.SkipUpdateByChangedColumns(table, tableInfo, oldRow, oldRow))
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tidb/parser"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
 )
 
 // HandleError handle error for syncer.
@@ -71,6 +73,9 @@ func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	events := make([]*replication.BinlogEvent, 0)
 
 	if req.Op == pb.ErrorOp_Replace || req.Op == pb.ErrorOp_Inject {
+		if err = s.checkAppliedStatements(pos, req.Sqls); err != nil {
+			return "", err
+		}
 		events, err = s.genEvents(ctx, req.Sqls)
 		if err != nil {
 			return "", err
@@ -82,6 +87,46 @@ func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	return "", s.streamerController.Set(req, events)
 }
 
+// checkAppliedStatements checks the statements of a new Replace or Inject operator
+// at `posStr`. If some statements at this position have been applied and flushed
+// into checkpoint, the syncer will continue from the next un-applied statement,
+// so the applied ones should be kept unchanged.
+func (s *Syncer) checkAppliedStatements(posStr string, sqls []string) error {
+	pos, err := binlog.PositionFromPosStr(posStr)
+	if err != nil {
+		return err
+	}
+	flushed := s.checkpoint.FlushedGlobalPoint()
+	applied := flushed.Suffix
+	if applied == 0 || binlog.ComparePosition(flushed.Position, pos) != 0 {
+		return nil
+	}
+	if len(sqls) < applied {
+		return terror.ErrSyncerAppliedEventsChanged.Generate(applied, posStr, sqls)
+	}
+
+	// the operator may be lost after DM-worker restarted, then we can only trust the user.
+	for _, req := range s.streamerController.ListEqualAndAfter(posStr) {
+		prePos, err2 := binlog.PositionFromPosStr(req.BinlogPos)
+		if err2 != nil || binlog.ComparePosition(prePos, pos) != 0 {
+			continue
+		}
+		if len(req.Sqls) < applied {
+			break
+		}
+		for i := 0; i < applied; i++ {
+			if strings.TrimSpace(req.Sqls[i]) != strings.TrimSpace(sqls[i]) {
+				return terror.ErrSyncerAppliedEventsChanged.Generate(applied, posStr, sqls)
+			}
+		}
+	}
+	s.tctx.L().Info("continue from the next un-applied statement",
+		zap.String("position", posStr),
+		zap.Int("applied", applied),
+		zap.Int("total", len(sqls)))
+	return nil
+}
+
 func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.BinlogEvent, error) {
 	events := make([]*replication.BinlogEvent, 0)
 
@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 	"github.com/stretchr/testify/require"
@@ -108,3 +111,49 @@ func TestHandleError(t *testing.T) {
 	}
 	require.NoError(t, mockDB.ExpectationsWereMet())
 }
+
+func TestHandleErrorWithAppliedStatements(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cfg    = genDefaultSubTaskConfig4Test()
+		syncer = NewSyncer(cfg, nil, nil)
+		task   = "test"
+		ctx    = context.Background()
+		pos    = "mysql-bin.000001:2345"
+		sqls   = []string{"alter table db.tb add column a int;", "alter table db.tb add column b int;"}
+	)
+	mockDB, err := conn.MockDefaultDBProvider()
+	require.NoError(t, err)
+	upstreamDB, err := conn.GetUpstreamDB(&cfg.From) // used to get parser
+	require.NoError(t, err)
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: upstreamDB}
+	syncer.streamerController = binlogstream.NewStreamerController4Test(nil, nil)
+
+	// replace before the position is reached
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, Task: task, BinlogPos: pos, Sqls: sqls})
+	require.NoError(t, err)
+
+	// the first statement has been applied and flushed
+	location := binlog.MustZeroLocation(cfg.Flavor)
+	location.Position = mysql.Position{Name: "mysql-bin.000001", Pos: 2345}
+	location.Suffix = 1
+	cp := syncer.checkpoint.(*RemoteCheckPoint)
+	cp.globalPoint = newBinlogPoint(location, location, nil, nil, cfg.EnableGTID)
+
+	// change the applied statement
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, Task: task, BinlogPos: pos, Sqls: []string{"alter table db.tb add column c int;", sqls[1]}})
+	require.True(t, terror.ErrSyncerAppliedEventsChanged.Equal(err))
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, Task: task, BinlogPos: pos, Sqls: []string{}})
+	require.True(t, terror.ErrSyncerAppliedEventsChanged.Equal(err))
+
+	// only change the un-applied statement
+	_, err = syncer.HandleError(ctx, &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, Task: task, BinlogPos: pos, Sqls: []string{sqls[0], "alter table db.tb add column c int;"}})
+	require.NoError(t, err)
+
+	applied, total, ok := syncer.streamerController.AppliedEvents(location)
+	require.True(t, ok)
+	require.Equal(t, 1, applied)
+	require.Equal(t, 2, total)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
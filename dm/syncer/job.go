@@ -79,6 +79,7 @@ type job struct {
 	targetTable     *filter.Table
 	dml             *sqlmodel.RowChange
 	dmlQueueKey     string
+	tuning          *dmlTuning // per-table tuning of the dml, set when dispatched to DML queues
 	safeMode        bool
 	retry           bool
 	location        binlog.Location // location of last received (ROTATE / QUERY / XID) event, for global/table checkpoint
@@ -50,6 +50,7 @@ type Metrics struct {
 	ShardLockResolving               prometheus.Gauge
 	FinishedTransactionTotal         prometheus.Counter
 	FlushCheckPointsTimeInterval     prometheus.Observer
+	SuppressedRedeliveredEventTotal  prometheus.Counter
 }
 
 // Proxies provides the ability to clean Metrics values when syncer is closed.
@@ -80,10 +81,12 @@ type Proxies struct {
 	replicationLagHistogram         *prometheus.HistogramVec
 	remainingTimeGauge              *prometheus.GaugeVec
 	UnsyncedTableGauge              *prometheus.GaugeVec
+	IgnoredUpdateTotal              *prometheus.CounterVec
 	shardLockResolving              *prometheus.GaugeVec
 	finishedTransactionTotal        *prometheus.CounterVec
 	ReplicationTransactionBatch     *prometheus.HistogramVec
 	flushCheckPointsTimeInterval    *prometheus.HistogramVec
+	suppressedRedeliveredEventTotal *prometheus.CounterVec
 }
 
 var DefaultMetricsProxies *Proxies
@@ -261,6 +264,13 @@ func (m *Proxies) Init(f promutil.Factory) {
 			Name:      "unsynced_table_number",
 			Help:      "number of unsynced tables in the subtask",
 		}, []string{"task", "table", "source_id"})
+	m.IgnoredUpdateTotal = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "ignored_update_total",
+			Help:      "total number of UPDATE rows skipped because only the ignored columns are changed",
+		}, []string{"task", "table", "source_id"})
 	m.shardLockResolving = f.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "dm",
@@ -291,6 +301,13 @@ func (m *Proxies) Init(f promutil.Factory) {
 			Help:      "checkpoint flushed time interval in seconds",
 			Buckets:   prometheus.LinearBuckets(1, 50, 21), // linear from 1 to 1001, i think this is enough
 		}, []string{"worker", "task", "source_id"})
+	m.suppressedRedeliveredEventTotal = f.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "suppressed_redelivered_event_total",
+			Help:      "total number of binlog events dropped because they are re-delivered after the binlog streamer reconnects",
+		}, []string{"task", "source_id"})
 }
 
 // CacheForOneTask returns a new Proxies with m.Metrics filled. It is used
@@ -317,6 +334,7 @@ func (m *Proxies) CacheForOneTask(taskName, workerName, sourceID string) *Proxie
 	ret.Metrics.ShardLockResolving = m.shardLockResolving.WithLabelValues(taskName, sourceID)
 	ret.Metrics.FinishedTransactionTotal = m.finishedTransactionTotal.WithLabelValues(taskName, workerName, sourceID)
 	ret.Metrics.FlushCheckPointsTimeInterval = m.flushCheckPointsTimeInterval.WithLabelValues(workerName, taskName, sourceID)
+	ret.Metrics.SuppressedRedeliveredEventTotal = m.suppressedRedeliveredEventTotal.WithLabelValues(taskName, sourceID)
 	return &ret
 }
 
@@ -343,11 +361,13 @@ func (m *Proxies) RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(m.replicationLagHistogram)
 	registry.MustRegister(m.remainingTimeGauge)
 	registry.MustRegister(m.UnsyncedTableGauge)
+	registry.MustRegister(m.IgnoredUpdateTotal)
 	registry.MustRegister(m.shardLockResolving)
 	registry.MustRegister(m.idealQPS)
 	registry.MustRegister(m.finishedTransactionTotal)
 	registry.MustRegister(m.ReplicationTransactionBatch)
 	registry.MustRegister(m.flushCheckPointsTimeInterval)
+	registry.MustRegister(m.suppressedRedeliveredEventTotal)
 }
 
 // RemoveLabelValuesWithTaskInMetrics cleans all Metrics related to the task.
@@ -373,9 +393,11 @@ func (m *Proxies) RemoveLabelValuesWithTaskInMetrics(task string) {
 	m.replicationLagHistogram.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.remainingTimeGauge.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.UnsyncedTableGauge.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.IgnoredUpdateTotal.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.shardLockResolving.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.idealQPS.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.finishedTransactionTotal.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.ReplicationTransactionBatch.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.flushCheckPointsTimeInterval.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.suppressedRedeliveredEventTotal.DeletePartialMatch(prometheus.Labels{"task": task})
 }
@@ -0,0 +1,214 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+// pauseAtCheckInterval is the interval to check whether an idle syncer has reached
+// the pause-at target, or the deadline has passed.
+var pauseAtCheckInterval = time.Second
+
+// pauseAtTarget is the target of `pause-task --at-time`, the syncer pauses itself
+// after it applied all the binlog events not later than time.
+type pauseAtTarget struct {
+	time     time.Time
+	deadline time.Time // zero for no deadline
+	pause    func()
+	cancel   context.CancelFunc
+}
+
+// PauseAt makes the syncer call pause after it applied all the binlog events not
+// later than target, so all sources of a task can be paused at a consistent snapshot.
+// pause is called at once if the syncer has already applied binlog events later than
+// target, and is called anyway when deadline passes.
+func (s *Syncer) PauseAt(target, deadline time.Time, pause func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &pauseAtTarget{
+		time:     target,
+		deadline: deadline,
+		pause:    pause,
+		cancel:   cancel,
+	}
+
+	s.pauseAtMu.Lock()
+	if s.pauseAtMu.target != nil {
+		s.pauseAtMu.target.cancel()
+	}
+	s.pauseAtMu.target = p
+	s.pauseAtMu.note = ""
+	s.pauseAtMu.Unlock()
+	s.tctx.L().Info("set pause-at target", zap.Time("target", target), zap.Time("deadline", deadline))
+
+	if lastTS := s.lastTxnTS.Load(); lastTS > target.Unix() {
+		s.reachPauseAt(fmt.Sprintf("already applied binlog events at %s later than %s, paused at %s",
+			formatBinlogTS(lastTS), target.Format(time.RFC3339), s.currentLocation()))
+		return
+	}
+	go s.watchPauseAt(ctx, p)
+}
+
+// CancelPauseAt cancels the pending pause-at target, and clears the note of the last one.
+func (s *Syncer) CancelPauseAt() {
+	s.pauseAtMu.Lock()
+	defer s.pauseAtMu.Unlock()
+	if s.pauseAtMu.target != nil {
+		s.pauseAtMu.target.cancel()
+		s.pauseAtMu.target = nil
+	}
+	s.pauseAtMu.note = ""
+}
+
+// pauseAtProgress returns the progress of the pending pause-at target, or the note
+// of where the syncer paused for the last one.
+func (s *Syncer) pauseAtProgress() string {
+	s.pauseAtMu.Lock()
+	defer s.pauseAtMu.Unlock()
+	p := s.pauseAtMu.target
+	if p == nil {
+		return s.pauseAtMu.note
+	}
+	progress := fmt.Sprintf("pausing at %s, applied binlog events up to %s",
+		p.time.Format(time.RFC3339), formatBinlogTS(s.lastTxnTS.Load()))
+	if !p.deadline.IsZero() {
+		progress += fmt.Sprintf(", will time out at %s", p.deadline.Format(time.RFC3339))
+	}
+	return progress
+}
+
+// checkPauseAt checks whether the syncer should stop before the event because it starts
+// a transaction later than the pause-at target. lastTxnEndLocation is where the syncer
+// will pause.
+func (s *Syncer) checkPauseAt(e *replication.BinlogEvent, lastTxnEndLocation binlog.Location) bool {
+	switch ev := e.Event.(type) {
+	case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+	case *replication.QueryEvent:
+		// COMMIT ends a transaction of non-transactional tables, whose timestamp may differ from its BEGIN.
+		if string(ev.Query) == "COMMIT" {
+			return false
+		}
+	default:
+		return false
+	}
+	ts := int64(e.Header.Timestamp)
+	if ts == 0 {
+		return false
+	}
+
+	s.pauseAtMu.Lock()
+	p := s.pauseAtMu.target
+	s.pauseAtMu.Unlock()
+	if p == nil || ts <= p.time.Unix() {
+		s.lastTxnTS.Store(ts)
+		return false
+	}
+	s.reachPauseAt(fmt.Sprintf("paused at %s, the next transaction is at %s later than %s",
+		lastTxnEndLocation, formatBinlogTS(ts), p.time.Format(time.RFC3339)))
+	return true
+}
+
+// watchPauseAt pauses the syncer when the deadline passes, or when the syncer has caught
+// up with the upstream after the target time, because all the binlog events written
+// later are later than the target.
+func (s *Syncer) watchPauseAt(ctx context.Context, p *pauseAtTarget) {
+	ticker := time.NewTicker(pauseAtCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		if !p.deadline.IsZero() && now.After(p.deadline) {
+			s.reachPauseAt(fmt.Sprintf("timed out pausing at %s, applied binlog events up to %s, paused at %s",
+				p.time.Format(time.RFC3339), formatBinlogTS(s.lastTxnTS.Load()), s.currentLocation()))
+			return
+		}
+		// tsOffset is DM's timestamp - MySQL's timestamp
+		if now.Unix()-s.tsOffset.Load() <= p.time.Unix() || !s.running.Load() {
+			continue
+		}
+		tctx, cancel := s.tctx.WithContext(ctx).WithTimeout(conn.DefaultDBTimeout)
+		pos, gtidSet, err := s.fromDB.GetMasterStatus(tctx, s.cfg.Flavor)
+		cancel()
+		if err != nil {
+			s.tctx.L().Warn("fail to get master status when waiting for the pause-at target", log.ShortError(err))
+			continue
+		}
+		if s.caughtUpWith(binlog.NewLocation(pos, gtidSet)) {
+			s.reachPauseAt(fmt.Sprintf("paused at %s, caught up with the upstream after %s",
+				s.currentLocation(), p.time.Format(time.RFC3339)))
+			return
+		}
+	}
+}
+
+// reachPauseAt records the note and calls the pause function of the pending pause-at target.
+func (s *Syncer) reachPauseAt(note string) {
+	s.pauseAtMu.Lock()
+	p := s.pauseAtMu.target
+	s.pauseAtMu.target = nil
+	if p != nil {
+		s.pauseAtMu.note = note
+	}
+	s.pauseAtMu.Unlock()
+	if p == nil {
+		// already reached or canceled
+		return
+	}
+
+	p.cancel()
+	s.tctx.L().Info("reach pause-at target", zap.Time("target", p.time), zap.String("note", note))
+	go p.pause()
+}
+
+func (s *Syncer) currentLocation() binlog.Location {
+	s.currentLocationMu.RLock()
+	defer s.currentLocationMu.RUnlock()
+	return s.currentLocationMu.currentLocation
+}
+
+// caughtUpWith returns whether the syncer has read all the binlog events before master.
+func (s *Syncer) caughtUpWith(master binlog.Location) bool {
+	current := s.currentLocation()
+	if s.cfg.EnableGTID {
+		if cmp, canCmp := binlog.CompareGTID(current.GetGTID(), master.GetGTID()); canCmp {
+			return cmp >= 0
+		}
+	}
+	realPos, err := binlog.RealMySQLPos(current.Position)
+	if err != nil {
+		s.tctx.L().Warn("fail to parse real mysql position", zap.Stringer("position", current.Position), log.ShortError(err))
+		return false
+	}
+	return realPos.Compare(master.Position) >= 0
+}
+
+func formatBinlogTS(ts int64) string {
+	if ts == 0 {
+		return "none"
+	}
+	return time.Unix(ts, 0).Format(time.RFC3339)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/stretchr/testify/require"
+)
+
+func newPauseAtTestEvent(ts int64, ev replication.Event) *replication.BinlogEvent {
+	return &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: uint32(ts)},
+		Event:  ev,
+	}
+}
+
+func TestPauseAt(t *testing.T) {
+	var (
+		syncer   = NewSyncer(genDefaultSubTaskConfig4Test(), nil, nil)
+		target   = time.Now().Add(time.Hour).Truncate(time.Second)
+		ts       = target.Unix()
+		location = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2345}, nil)
+		paused   = make(chan struct{}, 1)
+		pause    = func() { paused <- struct{}{} }
+	)
+
+	syncer.PauseAt(target, time.Time{}, pause)
+	require.Contains(t, syncer.pauseAtProgress(), "pausing at "+target.Format(time.RFC3339))
+
+	// the events not later than the target, or not starting a transaction, are applied
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts-1, &replication.GTIDEvent{}), location))
+	require.Equal(t, ts-1, syncer.lastTxnTS.Load())
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts, &replication.QueryEvent{Query: []byte("BEGIN")}), location))
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts+1, &replication.RowsEvent{}), location))
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts+1, &replication.QueryEvent{Query: []byte("COMMIT")}), location))
+	require.Equal(t, ts, syncer.lastTxnTS.Load())
+	require.Len(t, paused, 0)
+
+	// stop before the first transaction later than the target
+	require.True(t, syncer.checkPauseAt(newPauseAtTestEvent(ts+1, &replication.GTIDEvent{}), location))
+	<-paused
+	require.Contains(t, syncer.pauseAtProgress(), "paused at position: (mysql-bin.000001, 2345)")
+	// the target is reached only once
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts+2, &replication.GTIDEvent{}), location))
+
+	// resuming clears the note
+	syncer.CancelPauseAt()
+	require.Equal(t, "", syncer.pauseAtProgress())
+
+	// the syncer already past the target pauses at once
+	syncer.PauseAt(target.Add(-time.Second), time.Time{}, pause)
+	<-paused
+	require.Contains(t, syncer.pauseAtProgress(), "already applied binlog events at")
+	syncer.CancelPauseAt()
+
+	// a canceled target never pauses the syncer
+	syncer.PauseAt(target.Add(time.Hour), time.Time{}, pause)
+	syncer.CancelPauseAt()
+	require.False(t, syncer.checkPauseAt(newPauseAtTestEvent(ts+3600*2, &replication.GTIDEvent{}), location))
+	require.Len(t, paused, 0)
+}
+
+func TestPauseAtTimeout(t *testing.T) {
+	backup := pauseAtCheckInterval
+	pauseAtCheckInterval = 10 * time.Millisecond
+	defer func() {
+		pauseAtCheckInterval = backup
+	}()
+
+	var (
+		syncer = NewSyncer(genDefaultSubTaskConfig4Test(), nil, nil)
+		paused = make(chan struct{}, 1)
+	)
+	syncer.PauseAt(time.Now().Add(time.Hour), time.Now().Add(50*time.Millisecond), func() { paused <- struct{}{} })
+	select {
+	case <-paused:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "syncer is not paused after the deadline")
+	}
+	require.Contains(t, syncer.pauseAtProgress(), "timed out pausing at")
+}
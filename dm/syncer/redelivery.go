@@ -0,0 +1,129 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// redeliveryFilter drops the binlog events re-delivered after the binlog streamer
+// reconnects. The streamer is reset to the end of the last finished transaction,
+// so the events of the partially consumed transaction are delivered again, which
+// causes duplicate-key errors outside safe mode.
+//
+// Each reset of the streamer starts a new connection epoch. The end location of
+// the latest event executed in the downstream in the previous epoch is kept as
+// the watermark, and the events not after it are dropped until an event passes
+// it. The events queued but not executed yet are not dropped, they're skipped by
+// maybeSkipNRowsEvent in GTID mode, or executed again in safe mode.
+type redeliveryFilter struct {
+	enableGTID bool
+	counter    prometheus.Counter
+	logger     log.Logger
+
+	epoch int
+	// mu protects applied, which is marked by the DML and DDL workers.
+	mu sync.Mutex
+	// applied is the end location of the latest executed event in the current epoch.
+	applied *binlog.Location
+	// watermark is the applied location of the previous epoch, nil if there are
+	// no re-delivered events to drop.
+	watermark  *binlog.Location
+	suppressed int
+}
+
+func newRedeliveryFilter(enableGTID bool, counter prometheus.Counter, logger log.Logger) *redeliveryFilter {
+	return &redeliveryFilter{
+		enableGTID: enableGTID,
+		counter:    counter,
+		logger:     logger,
+	}
+}
+
+// markApplied records the end location of an event executed in the downstream.
+// The jobs of different DML workers are executed out of order, so only the
+// latest location is kept.
+func (f *redeliveryFilter) markApplied(location binlog.Location) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.applied != nil && f.notAfter(location, *f.applied) {
+		return
+	}
+	clone := location.Clone()
+	f.applied = &clone
+}
+
+// reconnect starts a new connection epoch, it's called after the streamer is reset.
+func (f *redeliveryFilter) reconnect() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.epoch++
+	if f.applied != nil {
+		f.watermark = f.applied
+		f.suppressed = 0
+	}
+	f.applied = nil
+	f.logger.Info("binlog streamer reconnected, will drop the re-delivered events",
+		zap.Int("epoch", f.epoch), zap.Stringer("watermark", f.watermark))
+}
+
+// suppress returns true if the event is re-delivered and should be dropped.
+// Only the events applied to the downstream are checked, i.e. the rows events and
+// the query events except the ones marking the boundaries of transactions.
+func (f *redeliveryFilter) suppress(e *replication.BinlogEvent, endLocation binlog.Location) bool {
+	if f.watermark == nil {
+		return false
+	}
+	switch ev := e.Event.(type) {
+	case *replication.RowsEvent:
+	case *replication.QueryEvent:
+		query := strings.TrimSpace(string(ev.Query))
+		if query == "BEGIN" || query == "COMMIT" {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if !f.notAfter(endLocation, *f.watermark) {
+		f.logger.Info("re-delivered events are dropped",
+			zap.Int("epoch", f.epoch), zap.Int("count", f.suppressed),
+			zap.Stringer("watermark", f.watermark), zap.Stringer("first location", endLocation))
+		f.watermark = nil
+		return false
+	}
+	f.suppressed++
+	f.counter.Inc()
+	return true
+}
+
+// notAfter returns whether the location is not after the other one.
+func (f *redeliveryFilter) notAfter(location, other binlog.Location) bool {
+	if f.enableGTID {
+		cmp, canCmp := binlog.CompareGTID(location.GetGTID(), other.GetGTID())
+		if canCmp && cmp != 0 {
+			return cmp < 0
+		}
+		// the events of the same transaction have the same GTID set, they're
+		// compared by position.
+	}
+	return binlog.ComparePosition(location.Position, other.Position) <= 0
+}
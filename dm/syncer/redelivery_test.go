@@ -0,0 +1,199 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func readRedeliveryCounter(t *testing.T, counter prometheus.Counter) float64 {
+	t.Helper()
+	metric := &dto.Metric{}
+	require.NoError(t, counter.Write(metric))
+	return metric.GetCounter().GetValue()
+}
+
+func TestRedeliveryFilterPosition(t *testing.T) {
+	var (
+		counter = prometheus.NewCounter(prometheus.CounterOpts{Name: "test_suppressed"})
+		filter  = newRedeliveryFilter(false, counter, log.L())
+		rows    = &replication.BinlogEvent{Event: &replication.RowsEvent{}}
+		ddl     = &replication.BinlogEvent{Event: &replication.QueryEvent{Query: []byte("CREATE TABLE t (c INT)")}}
+		begin   = &replication.BinlogEvent{Event: &replication.QueryEvent{Query: []byte("BEGIN")}}
+		xid     = &replication.BinlogEvent{Event: &replication.XIDEvent{}}
+		at      = func(pos uint32) binlog.Location {
+			return binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, nil)
+		}
+	)
+
+	// nothing is dropped before reconnecting
+	require.False(t, filter.suppress(rows, at(300)))
+	filter.markApplied(at(300))
+	require.False(t, filter.suppress(rows, at(400)))
+	filter.markApplied(at(400))
+
+	// the streamer is reset to the last finished transaction
+	filter.reconnect()
+	require.False(t, filter.suppress(begin, at(200)))
+	require.True(t, filter.suppress(rows, at(300)))
+	require.True(t, filter.suppress(rows, at(400)))
+	require.Equal(t, 2.0, readRedeliveryCounter(t, counter))
+
+	// the first event past the watermark stops dropping
+	require.False(t, filter.suppress(rows, at(500)))
+	filter.markApplied(at(500))
+	require.False(t, filter.suppress(xid, at(600)))
+	filter.markApplied(at(600))
+
+	// reconnecting without applying any events keeps the watermark
+	filter.reconnect()
+	filter.reconnect()
+	require.True(t, filter.suppress(ddl, at(600)))
+	require.False(t, filter.suppress(ddl, at(700)))
+	// the next binlog file is always after the watermark
+	require.False(t, filter.suppress(rows, binlog.NewLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 100}, nil)))
+	require.Equal(t, 3.0, readRedeliveryCounter(t, counter))
+}
+
+func TestRedeliveryFilterGTID(t *testing.T) {
+	var (
+		counter = prometheus.NewCounter(prometheus.CounterOpts{Name: "test_suppressed"})
+		filter  = newRedeliveryFilter(true, counter, log.L())
+		rows    = &replication.BinlogEvent{Event: &replication.RowsEvent{}}
+		at      = func(gtidStr string, pos uint32) binlog.Location {
+			gset, err := gtid.ParserGTID(mysql.MySQLFlavor, gtidStr)
+			require.NoError(t, err)
+			return binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, gset)
+		}
+		uuid = "3ccc475b-2343-11e7-be21-6c0b84d59f30:"
+	)
+
+	// the rows events of the transaction 1-15 have the GTID set of the last finished one
+	filter.markApplied(at(uuid+"1-14", 300))
+	filter.markApplied(at(uuid+"1-14", 400))
+	filter.reconnect()
+
+	// the same GTID set is compared by position
+	require.True(t, filter.suppress(rows, at(uuid+"1-14", 300)))
+	require.True(t, filter.suppress(rows, at(uuid+"1-14", 400)))
+	require.False(t, filter.suppress(rows, at(uuid+"1-14", 500)))
+	require.Equal(t, 2.0, readRedeliveryCounter(t, counter))
+
+	// an older GTID set is dropped even if the position is larger, e.g. the binlog file is switched by failover
+	filter.markApplied(at(uuid+"1-15", 300))
+	filter.reconnect()
+	require.True(t, filter.suppress(rows, at(uuid+"1-14", 900)))
+	require.False(t, filter.suppress(rows, at(uuid+"1-16", 100)))
+	require.Equal(t, 3.0, readRedeliveryCounter(t, counter))
+}
+
+// fakeRedeliveryStreamer delivers the events of transactions, and is reset to
+// the end of the last finished transaction after reconnecting like the binlog
+// streamer in GTID mode.
+type fakeRedeliveryStreamer struct {
+	events    []*replication.BinlogEvent
+	locations []binlog.Location
+	next      int
+	finished  int
+}
+
+func (s *fakeRedeliveryStreamer) getEvent() (*replication.BinlogEvent, binlog.Location, bool) {
+	if s.next >= len(s.events) {
+		return nil, binlog.Location{}, false
+	}
+	e, location := s.events[s.next], s.locations[s.next]
+	s.next++
+	if _, ok := e.Event.(*replication.XIDEvent); ok {
+		s.finished = s.next
+	}
+	return e, location, true
+}
+
+func (s *fakeRedeliveryStreamer) reset() {
+	s.next = s.finished
+}
+
+func TestRedeliveryFilterReconnectGTID(t *testing.T) {
+	var (
+		counter  = prometheus.NewCounter(prometheus.CounterOpts{Name: "test_suppressed"})
+		filter   = newRedeliveryFilter(true, counter, log.L())
+		streamer = &fakeRedeliveryStreamer{}
+		uuid     = "3ccc475b-2343-11e7-be21-6c0b84d59f30:"
+		pos      = uint32(0)
+	)
+	// the events of a transaction have the GTID set of the last finished one,
+	// and the XID event has the GTID set including the transaction itself.
+	for trx := 1; trx <= 3; trx++ {
+		prev, err := gtid.ParserGTID(mysql.MySQLFlavor, fmt.Sprintf("%s1-%d", uuid, trx+9))
+		require.NoError(t, err)
+		cur, err := gtid.ParserGTID(mysql.MySQLFlavor, fmt.Sprintf("%s1-%d", uuid, trx+10))
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			pos += 100
+			streamer.events = append(streamer.events, &replication.BinlogEvent{Event: &replication.RowsEvent{}})
+			streamer.locations = append(streamer.locations, binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, prev))
+		}
+		pos += 100
+		streamer.events = append(streamer.events, &replication.BinlogEvent{Event: &replication.XIDEvent{}})
+		streamer.locations = append(streamer.locations, binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: pos}, cur))
+	}
+
+	// the streamer disconnects in the middle of the transactions, the events are
+	// executed in the downstream before the streamer is reset.
+	var executed []uint32
+	for _, disconnectAt := range []int{2, 6, 6, 12} {
+		for streamer.next < disconnectAt {
+			e, location, ok := streamer.getEvent()
+			require.True(t, ok)
+			if filter.suppress(e, location) {
+				continue
+			}
+			if _, ok := e.Event.(*replication.RowsEvent); ok {
+				executed = append(executed, location.Position.Pos)
+				filter.markApplied(location)
+			}
+		}
+		streamer.reset()
+		filter.reconnect()
+	}
+	for {
+		e, location, ok := streamer.getEvent()
+		if !ok {
+			break
+		}
+		if filter.suppress(e, location) {
+			continue
+		}
+		if _, ok := e.Event.(*replication.RowsEvent); ok {
+			executed = append(executed, location.Position.Pos)
+			filter.markApplied(location)
+		}
+	}
+
+	// every rows event is executed exactly once.
+	require.Equal(t, []uint32{100, 200, 300, 500, 600, 700, 900, 1000, 1100}, executed)
+	// 2 events of the first transaction, and 2 events of the second one after
+	// each of the 2 reconnects in it.
+	require.Equal(t, 6.0, readRedeliveryCounter(t, counter))
+}
@@ -14,6 +14,7 @@
 package syncer
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pingcap/failpoint"
@@ -38,8 +39,19 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 		RecentRps:           s.rps.Load(),
 		SyncerBinlog:        syncerLocation.Position.String(),
 		SecondsBehindMaster: s.secondsBehindMaster.Load(),
+		FiredDDLHooks:       s.ddlHookGroup.FiredHooks(),
+		TableTunings:        s.tableTunings.Effective(),
+		UnexpectedTables:    s.unexpectedTables.Tables(),
 	}
 
+	if s.streamerController != nil {
+		if applied, total, ok := s.streamerController.AppliedEvents(syncerLocation); ok {
+			st.HandleErrorProgress = fmt.Sprintf("%d/%d statements applied at %s", applied, total, syncerLocation.Position)
+		}
+	}
+
+	st.PauseAtProgress = s.pauseAtProgress()
+
 	if syncerLocation.GetGTID() != nil {
 		st.SyncerBinlogGtid = syncerLocation.GetGTID().String()
 	}
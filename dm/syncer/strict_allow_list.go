@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	onlineddl "github.com/pingcap/tiflow/dm/syncer/online-ddl-tools"
+	"go.uber.org/zap"
+)
+
+// UnexpectedTables records the tables which are not matched by block-allow-list
+// but found in binlog when strict-allow-list is "warn", with where they are first
+// seen. Every table is warned only once.
+type UnexpectedTables struct {
+	mode   config.StrictAllowListMode
+	logger log.Logger
+
+	mu        sync.Mutex
+	firstSeen map[string]binlog.Location // quoted table name -> location
+}
+
+// NewUnexpectedTables creates a new UnexpectedTables.
+func NewUnexpectedTables(mode config.StrictAllowListMode, logger log.Logger) *UnexpectedTables {
+	return &UnexpectedTables{
+		mode:      mode,
+		logger:    logger,
+		firstSeen: make(map[string]binlog.Location),
+	}
+}
+
+// Check handles the binlog event of a table skipped by block-allow-list at
+// location. It returns an error to pause the task if strict-allow-list is
+// "error", and records the table if it's "warn".
+func (u *UnexpectedTables) Check(table *filter.Table, location binlog.Location) error {
+	if u == nil {
+		return nil
+	}
+	switch u.mode {
+	case config.StrictAllowListError:
+		return terror.ErrSyncerUnexpectedTable.Generate(table, location)
+	case config.StrictAllowListWarn:
+		name := table.String()
+		u.mu.Lock()
+		_, ok := u.firstSeen[name]
+		if !ok {
+			u.firstSeen[name] = location
+		}
+		u.mu.Unlock()
+		if !ok {
+			u.logger.Warn("binlog event of table not matched by block-allow-list is found, skip it",
+				zap.Stringer("table", table), zap.Stringer("location", location))
+		}
+	}
+	return nil
+}
+
+// Tables returns the recorded tables with where they are first seen, sorted by name.
+func (u *UnexpectedTables) Tables() []string {
+	if u == nil {
+		return nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if len(u.firstSeen) == 0 {
+		return nil
+	}
+	tables := make([]string, 0, len(u.firstSeen))
+	for name, location := range u.firstSeen {
+		tables = append(tables, fmt.Sprintf("%s first seen at %s", name, location))
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// checkUnexpectedTable checks the binlog event of the table by strict-allow-list
+// if it's skipped by block-allow-list. The system schemas and the ghost tables of
+// online DDL are expected to be skipped.
+func (s *Syncer) checkUnexpectedTable(table *filter.Table, location binlog.Location) error {
+	if s.unexpectedTables == nil || filter.IsSystemSchema(table.Schema) {
+		return nil
+	}
+	if s.onlineDDL != nil && table.Name != "" && s.onlineDDL.TableType(table.Name) != onlineddl.RealTable {
+		return nil
+	}
+	if !skipByTable(s.baList, table) {
+		return nil
+	}
+	return s.unexpectedTables.Check(table, location)
+}
+
+// initUnexpectedTables creates the recorder by strict-allow-list, the recorded
+// tables are kept if strict-allow-list isn't changed.
+func (s *Syncer) initUnexpectedTables() {
+	switch {
+	case s.cfg.StrictAllowList == config.StrictAllowListNone:
+		s.unexpectedTables = nil
+	case s.unexpectedTables == nil || s.unexpectedTables.mode != s.cfg.StrictAllowList:
+		s.unexpectedTables = NewUnexpectedTables(s.cfg.StrictAllowList, s.tctx.L())
+	}
+}
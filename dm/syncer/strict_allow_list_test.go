@@ -0,0 +1,76 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictAllowList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cfg       = genDefaultSubTaskConfig4Test()
+		location1 = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2345}, nil)
+		location2 = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 4567}, nil)
+		allowed   = &filter.Table{Schema: "db", Name: "tbl"}
+		unrouted  = &filter.Table{Schema: "other", Name: "tbl"}
+		system    = &filter.Table{Schema: "mysql", Name: "user"}
+	)
+	cfg.BAList = &filter.Rules{DoDBs: []string{"db"}}
+	syncer := NewSyncer(cfg, nil, nil)
+	var err error
+	syncer.baList, err = filter.New(cfg.CaseSensitive, cfg.BAList)
+	require.NoError(t, err)
+
+	// skipped silently by default.
+	syncer.initUnexpectedTables()
+	require.Nil(t, syncer.unexpectedTables)
+	require.NoError(t, syncer.checkUnexpectedTable(unrouted, location1))
+	require.Nil(t, syncer.unexpectedTables.Tables())
+
+	// every table is recorded once with where it's first seen.
+	syncer.cfg.StrictAllowList = config.StrictAllowListWarn
+	syncer.initUnexpectedTables()
+	require.NoError(t, syncer.checkUnexpectedTable(allowed, location1))
+	require.NoError(t, syncer.checkUnexpectedTable(system, location1))
+	require.NoError(t, syncer.checkUnexpectedTable(unrouted, location1))
+	require.NoError(t, syncer.checkUnexpectedTable(unrouted, location2))
+	require.NoError(t, syncer.checkUnexpectedTable(&filter.Table{Schema: "another"}, location2))
+	tables := syncer.unexpectedTables.Tables()
+	require.Len(t, tables, 2)
+	require.Contains(t, tables[0], "`another` first seen at position: (mysql-bin.000001, 4567)")
+	require.Contains(t, tables[1], "`other`.`tbl` first seen at position: (mysql-bin.000001, 2345)")
+	// kept if strict-allow-list isn't changed.
+	syncer.initUnexpectedTables()
+	require.Len(t, syncer.unexpectedTables.Tables(), 2)
+
+	// the task is paused at the first event.
+	syncer.cfg.StrictAllowList = config.StrictAllowListError
+	syncer.initUnexpectedTables()
+	require.NoError(t, syncer.checkUnexpectedTable(allowed, location1))
+	require.NoError(t, syncer.checkUnexpectedTable(system, location1))
+	err = syncer.checkUnexpectedTable(unrouted, location2)
+	require.True(t, terror.ErrSyncerUnexpectedTable.Equal(err))
+	require.Contains(t, err.Error(), "`other`.`tbl`")
+	require.Contains(t, err.Error(), "position: (mysql-bin.000001, 4567)")
+	require.Nil(t, syncer.unexpectedTables.Tables())
+}
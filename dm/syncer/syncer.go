@@ -164,7 +164,11 @@ type Syncer struct {
 	columnMapping   *cm.Mapping
 	baList          *filter.Filter
 	exprFilterGroup *ExprFilterGroup
-	sessCtx         sessionctx.Context
+	ddlHookGroup    *DDLHookGroup
+	tableTunings    *TableTunings
+	// unexpectedTables handles the tables not matched by block-allow-list, nil if strict-allow-list is not set
+	unexpectedTables *UnexpectedTables
+	sessCtx          sessionctx.Context
 
 	running atomic.Bool
 	closed  atomic.Bool
@@ -202,6 +206,9 @@ type Syncer struct {
 	runFatalChan chan *pb.ProcessError
 	// record whether error occurred when execute SQLs
 	execError atomic.Error
+	// redelivery drops the events of the partially consumed transaction which are
+	// delivered again after the binlog streamer reconnects, it's created in Run
+	redelivery *redeliveryFilter
 
 	readerHub              *streamer.ReaderHub
 	recordedActiveRelayLog bool
@@ -211,6 +218,14 @@ type Syncer struct {
 		currentLocation binlog.Location // use to calc remain binlog size
 	}
 
+	// the target of `pause-task --at-time`, see pause_at.go
+	pauseAtMu struct {
+		sync.Mutex
+		target *pauseAtTarget
+		note   string // where the syncer paused for the last target
+	}
+	lastTxnTS atomic.Int64 // binlog timestamp of the latest transaction read
+
 	errLocation struct {
 		sync.RWMutex
 		startLocation *binlog.Location
@@ -240,6 +255,12 @@ type Syncer struct {
 	idAndCollationMap          map[int]string
 
 	ddlWorker *DDLWorker
+
+	// "`db`.`tbl`.col" of the columns whose downstream default has been warned to differ from the tracked one
+	defaultDriftWarned map[string]struct{}
+	// "`db`.`tbl`" -> the trailing columns added by `ALTER TABLE ... ALGORITHM=INSTANT` of the source table,
+	// the rows events may miss them for the rows written before the columns are added
+	instantAddedColumns map[string]map[string]struct{}
 }
 
 // NewSyncer creates a new Syncer.
@@ -252,7 +273,7 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	if cfg.FrameworkLogger != nil {
 		logger = log.Logger{Logger: cfg.FrameworkLogger.With(logFields...)}
 	} else {
-		logger = log.With(logFields...)
+		logger = log.ForTask(cfg.Name, cfg.SourceID).WithFields(logFields...)
 	}
 
 	syncer := &Syncer{
@@ -408,6 +429,15 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.sessCtx = utils.NewSessionCtx(vars)
 	s.exprFilterGroup = NewExprFilterGroup(s.tctx, s.sessCtx, s.cfg.ExprFilter)
+	s.ddlHookGroup, err = NewDDLHookGroup(s.tctx, s.cfg.CaseSensitive, s.cfg.DDLHook)
+	if err != nil {
+		return err
+	}
+	s.tableTunings, err = NewTableTunings(s.cfg)
+	if err != nil {
+		return err
+	}
+	s.initUnexpectedTables()
 	// create an empty Tracker and will be initialized in `Run`
 	s.schemaTracker = schema.NewTracker()
 
@@ -1387,6 +1417,8 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 				s.tctx.L().Info("skip save global point", zap.String("failpoint", "SkipSaveGlobalPoint"))
 				panic("SkipSaveGlobalPoint")
 			})
+			// match DDL hooks before adding the SET statements
+			hookMatches := s.ddlHookGroup.Match(ddlJob.ddls)
 			// set timezone
 			if ddlJob.timezone != "" {
 				s.timezoneLastTime = ddlJob.timezone
@@ -1421,17 +1453,23 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 				}
 				row.Close()
 			}
-			affected, err = db.ExecuteSQLWithIgnore(s.syncCtx, s.metricsProxies, errorutil.IsIgnorableMySQLDDLError, ddlJob.ddls)
-			failpoint.Inject("TestHandleSpecialDDLError", func() {
-				err = mysql2.ErrInvalidConn
-				// simulate the value of affected along with the injected error due to the adding of SET SQL of timezone and timestamp
-				if affected == 0 {
-					affected++
+			err = s.ddlHookGroup.Execute(s.syncCtx, db, s.metricsProxies, hookMatches, ddlHookStageBefore)
+			if err == nil {
+				affected, err = db.ExecuteSQLWithIgnore(s.syncCtx, s.metricsProxies, errorutil.IsIgnorableMySQLDDLError, ddlJob.ddls)
+				failpoint.Inject("TestHandleSpecialDDLError", func() {
+					err = mysql2.ErrInvalidConn
+					// simulate the value of affected along with the injected error due to the adding of SET SQL of timezone and timestamp
+					if affected == 0 {
+						affected++
+					}
+				})
+				if err != nil {
+					err = s.handleSpecialDDLError(s.syncCtx, err, ddlJob.ddls, affected, db, ddlCreateTime)
+					err = terror.WithScope(err, terror.ScopeDownstream)
+				}
+				if err == nil {
+					err = s.ddlHookGroup.Execute(s.syncCtx, db, s.metricsProxies, hookMatches, ddlHookStageAfter)
 				}
-			})
-			if err != nil {
-				err = s.handleSpecialDDLError(s.syncCtx, err, ddlJob.ddls, affected, db, ddlCreateTime)
-				err = terror.WithScope(err, terror.ScopeDownstream)
 			}
 		}
 		failpoint.Label("bypass")
@@ -1496,6 +1534,9 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 			s.jobWg.Done()
 			continue
 		}
+		if s.redelivery != nil {
+			s.redelivery.markApplied(ddlJob.currentLocation)
+		}
 		s.jobWg.Done()
 		s.updateJobMetrics(true, queueBucket, ddlJob)
 	}
@@ -1520,6 +1561,9 @@ func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
 
 	for _, sqlJob := range jobs {
 		s.updateJobMetrics(true, queueBucket, sqlJob)
+		if sqlJob.tp == dml && s.redelivery != nil {
+			s.redelivery.markApplied(sqlJob.currentLocation)
+		}
 	}
 	s.updateReplicationJobTS(nil, dmlWorkerJobIdx(queueID))
 	s.metricsProxies.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "statements").Observe(float64(statementsCnt))
@@ -1704,10 +1748,21 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 	// some initialization that can't be put in Syncer.Init
 	if fresh && !skipLoadMeta {
-		// for fresh task, we try to load checkpoints from meta (file or config item)
-		err = s.checkpoint.LoadMeta(runCtx)
-		if err != nil {
-			return err
+		// for fresh task in all mode, we prefer the location which load unit has
+		// written into downstream, so it's consistent with the loaded data.
+		loaded := false
+		if s.cfg.Mode == config.ModeAll {
+			loaded, err = s.checkpoint.LoadSyncMarker(runCtx)
+			if err != nil {
+				return err
+			}
+		}
+		// otherwise we try to load checkpoints from meta (file or config item)
+		if !loaded {
+			err = s.checkpoint.LoadMeta(runCtx)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1731,6 +1786,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		cleanDumpFile = false
 	}
 
+	// the executed jobs are marked applied to redelivery, so it's created before the workers
+	s.redelivery = newRedeliveryFilter(s.cfg.EnableGTID, s.metricsProxies.Metrics.SuppressedRedeliveredEventTotal, s.tctx.L())
+
 	s.runWg.Add(1)
 	go s.syncDML()
 	s.runWg.Add(1)
@@ -2112,6 +2170,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			if err1 != nil {
 				return err1
 			}
+			s.redelivery.reconnect()
 			continue
 		case err == relay.ErrorMaybeDuplicateEvent:
 			s.tctx.L().Warn("read binlog met a truncated file, will skip events that has been consumed")
@@ -2140,6 +2199,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 				if err = maybeSkipNRowsEvent(eventIndex); err != nil {
 					return err
 				}
+				s.redelivery.reconnect()
 				continue
 			}
 
@@ -2179,6 +2239,17 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			lastEvent = e
 		}
 
+		if shardingReSync == nil && s.redelivery.suppress(e, endLocation) {
+			continue
+		}
+
+		// stop before the first transaction later than the pause-at target, and wait for
+		// the subtask to pause the syncer, the applied events are flushed when exiting.
+		if shardingReSync == nil && s.checkPauseAt(e, lastTxnEndLocation) {
+			<-s.runCtx.Ctx.Done()
+			return nil
+		}
+
 		switch op {
 		case pb.ErrorOp_Skip:
 			// try to handle pessimistic sharding?
@@ -2504,6 +2575,9 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		return nil, err
 	}
 	if needSkip {
+		if err = s.checkUnexpectedTable(sourceTable, ec.startLocation); err != nil {
+			return nil, err
+		}
 		s.metricsProxies.SkipBinlogDurationHistogram.WithLabelValues("rows", s.cfg.Name, s.cfg.SourceID).Observe(time.Since(ec.startTime).Seconds())
 		// for RowsEvent, we should record lastLocation rather than endLocation
 		return nil, s.recordSkipSQLsLocation(&ec)
@@ -2525,7 +2599,11 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 	if err != nil {
 		return nil, terror.WithScope(err, terror.ScopeDownstream)
 	}
-	originRows, err := s.mappingDML(sourceTable, tableInfo, ev.Rows)
+	rows, err := s.fillMissingColumns(ec.tctx, sourceTable, targetTable, tableInfo, ev.Rows)
+	if err != nil {
+		return nil, err
+	}
+	originRows, err := s.mappingDML(sourceTable, tableInfo, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -2543,6 +2621,7 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		sourceTableInfo: tableInfo,
 		sourceTable:     sourceTable,
 		extendData:      extRows,
+		unsignedMap:     ev.Table.UnsignedMap(),
 	}
 
 	switch ec.header.EventType {
@@ -3112,6 +3191,7 @@ func (s *Syncer) Close() {
 		return
 	}
 	s.stopSync()
+	s.CancelPauseAt()
 	s.closeDBs()
 	s.checkpoint.Close()
 	s.schemaTracker.Close()
@@ -3342,6 +3422,7 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	}
 	// update syncer config
 	s.cfg.SyncerConfig = cfg.SyncerConfig
+	s.initUnexpectedTables()
 
 	// updated fileds that changed in func `copyConfigFromSource`
 	s.cfg.From = cfg.From
@@ -3354,6 +3435,42 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	return nil
 }
 
+// UpdateUpstream rebuilds the upstream connections and the binlog syncer config with the new connection
+// config of upstream, the binlog streamer uses them when it's restarted from the checkpoint. It should be
+// called when the syncer is not processing, e.g. paused.
+func (s *Syncer) UpdateUpstream(ctx context.Context, from dbconfig.DBConfig) error {
+	s.Lock()
+	defer s.Unlock()
+
+	oldFrom := s.cfg.From
+	s.cfg.From = from
+	if s.isClosed() || s.streamerController == nil {
+		// the connections will be created with the new config if the syncer is initialized later.
+		return nil
+	}
+
+	syncCfg, err := subtaskCfg2BinlogSyncerCfg(s.cfg, s.timezone, s.baList)
+	if err != nil {
+		s.cfg.From = oldFrom
+		return err
+	}
+	dbCfg := from
+	dbCfg.RawDBCfg = dbconfig.DefaultRawDBConfig().SetReadTimeout(maxDMLConnectionTimeout)
+	fromDB, fromConns, err := dbconn.CreateConns(s.tctx.WithContext(ctx), s.cfg, conn.UpstreamDBConfig(&dbCfg), 1, s.cfg.DumpIOTotalBytes, s.cfg.DumpUUID)
+	if err != nil {
+		s.cfg.From = oldFrom
+		return err
+	}
+
+	dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
+	s.fromDB = &dbconn.UpStreamConn{BaseDB: fromDB}
+	s.fromConn = fromConns[0]
+	s.syncCfg = syncCfg
+	s.streamerController.UpdateSyncCfg(s.syncCfg, s.fromDB)
+	s.tctx.L().Info("upstream connections are rebuilt with the new config")
+	return nil
+}
+
 // checkpointID returns ID which used for checkpoint table.
 func (s *Syncer) checkpointID() string {
 	if len(s.cfg.SourceID) > 0 {
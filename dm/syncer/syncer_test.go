@@ -1956,6 +1956,72 @@ func TestSyncerGetTableInfo(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestFillMissingColumnsOfInstantAddedColumns(t *testing.T) {
+	cfg := genDefaultSubTaskConfig4Test()
+	cfg.WorkerCount = 0
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.sessCtx = utils.NewSessionCtx(nil)
+	syncer.exprFilterGroup = NewExprFilterGroup(tcontext.Background(), syncer.sessCtx, nil)
+	ctx := context.Background()
+	tctx := tcontext.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	dbConn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	syncer.downstreamTrackConn = dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+	syncer.schemaTracker, err = schema.NewTestTracker(ctx, cfg.Name, syncer.downstreamTrackConn, log.L())
+	require.NoError(t, err)
+	defer syncer.schemaTracker.Close()
+
+	table := &filter.Table{Schema: "test", Name: "tbl"}
+	require.NoError(t, syncer.schemaTracker.CreateSchemaIfNotExists(table.Schema))
+	execDDL := func(sql string) {
+		stmt, err2 := parseSQL(sql)
+		require.NoError(t, err2)
+		syncer.recordInstantAddedColumns(stmt, table.Schema)
+		require.NoError(t, syncer.schemaTracker.Exec(ctx, table.Schema, stmt))
+	}
+	genUpdateDMLs := func(rows [][]interface{}) ([]*sqlmodel.RowChange, error) {
+		ti, err2 := syncer.getTableInfo(tctx, table, table)
+		require.NoError(t, err2)
+		filled, err2 := syncer.fillMissingColumns(tctx, table, table, ti, rows)
+		require.NoError(t, err2)
+		return syncer.genAndFilterUpdateDMLs(tctx, &genDMLParam{
+			sourceTable:     table,
+			targetTable:     table,
+			originalData:    filled,
+			sourceTableInfo: ti,
+		}, nil, nil)
+	}
+
+	execDDL("create table tbl (id int primary key, name varchar(24))")
+	execDDL("alter table tbl add column c1 int default 5, algorithm=instant")
+
+	// update the row written before the column is added instantly.
+	mock.ExpectQuery("SHOW VARIABLES LIKE .*").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	mock.ExpectQuery("SHOW CREATE TABLE.*").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow(table.Name, "CREATE TABLE `tbl` (`id` int primary key, `name` varchar(24), `c1` int default 5)"))
+	dmls, err := genUpdateDMLs([][]interface{}{{int32(1), "a"}, {int32(1), "b"}})
+	require.NoError(t, err)
+	require.Len(t, dmls, 1)
+	require.Equal(t, []interface{}{int32(1), "a", int64(5)}, dmls[0].GetPreValues())
+	require.Equal(t, []interface{}{int32(1), "b", int64(5)}, dmls[0].GetPostValues())
+
+	// the columns added by copying the table are not filled.
+	execDDL("alter table tbl add column c2 int default 6")
+	_, err = genUpdateDMLs([][]interface{}{{int32(1), "a", int32(5)}, {int32(1), "b", int32(5)}})
+	require.True(t, terror.ErrSyncerUnitDMLColumnNotMatch.Equal(err))
+
+	// the instantly added column is not trailing after the column is moved.
+	execDDL("alter table tbl add column c3 int default 7, algorithm=instant")
+	execDDL("alter table tbl modify column c2 int default 6 first")
+	_, err = genUpdateDMLs([][]interface{}{{int32(6), int32(1), "a", int32(5)}, {int32(6), int32(1), "b", int32(5)}})
+	require.True(t, terror.ErrSyncerUnitDMLColumnNotMatch.Equal(err))
+}
+
 func TestCheckCanUpdateCfg(t *testing.T) {
 	cfg := genDefaultSubTaskConfig4Test()
 	syncer := NewSyncer(cfg, nil, nil)
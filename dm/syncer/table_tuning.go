@@ -0,0 +1,180 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	selector "github.com/pingcap/tidb/util/table-rule-selector"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+)
+
+// dmlTuning is the effective tuning of the DMLs of a table, zero values mean using the ones of the syncer.
+type dmlTuning struct {
+	batch       int
+	maxDMLSize  int64
+	workerCount int
+	// queueOffset is the first DML queue of the table when workerCount is set.
+	queueOffset int
+}
+
+// String implements fmt.Stringer.
+func (t *dmlTuning) String() string {
+	return fmt.Sprintf("batch=%d, max-dml-size=%d, worker-count=%d", t.batch, t.maxDMLSize, t.workerCount)
+}
+
+// TableTunings matches the upstream tables of DMLs to the per-table tuning overrides.
+type TableTunings struct {
+	tunings       []*config.TableTuning
+	selector      selector.Selector
+	caseSensitive bool
+	batch         int
+	workerCount   int
+	// hasMaxDMLSize is true if any override limits the size of DMLs.
+	hasMaxDMLSize bool
+
+	mu sync.RWMutex
+	// quoted upstream table -> effective tuning, nil if no override matches the table.
+	tables map[string]*dmlTuning
+}
+
+// NewTableTunings creates a TableTunings, returns nil if there is no override.
+func NewTableTunings(cfg *config.SubTaskConfig) (*TableTunings, error) {
+	if len(cfg.TableTunings) == 0 {
+		return nil, nil
+	}
+	t := &TableTunings{
+		tunings:       cfg.TableTunings,
+		selector:      selector.NewTrieSelector(),
+		caseSensitive: cfg.CaseSensitive,
+		batch:         cfg.Batch,
+		workerCount:   cfg.WorkerCount,
+		tables:        make(map[string]*dmlTuning),
+	}
+	for _, tuning := range cfg.TableTunings {
+		schema, table := tuning.SchemaPattern, tuning.TablePattern
+		if !t.caseSensitive {
+			schema, table = strings.ToLower(schema), strings.ToLower(table)
+		}
+		if err := t.selector.Insert(schema, table, tuning, selector.Append); err != nil {
+			return nil, terror.ErrConfigInvalidTableTuning.Generate(tuning, err.Error())
+		}
+		if tuning.MaxDMLSize != "" {
+			t.hasMaxDMLSize = true
+		}
+	}
+	return t, nil
+}
+
+// match returns the effective tuning of the upstream table, nil if no override matches it.
+// The result is cached, so match is cheap enough to be called for every DML.
+func (t *TableTunings) match(table *cdcmodel.TableName) *dmlTuning {
+	if t == nil || table == nil {
+		return nil
+	}
+	key := table.QuoteString()
+	t.mu.RLock()
+	tuning, ok := t.tables[key]
+	t.mu.RUnlock()
+	if ok {
+		return tuning
+	}
+
+	schema, name := table.Schema, table.Table
+	if !t.caseSensitive {
+		schema, name = strings.ToLower(schema), strings.ToLower(name)
+	}
+	if cfg := t.firstMatched(t.selector.Match(schema, name)); cfg != nil {
+		tuning = &dmlTuning{
+			batch:       cfg.Batch,
+			maxDMLSize:  cfg.MaxDMLSizeInBytes(),
+			workerCount: cfg.WorkerCount,
+		}
+		if tuning.workerCount > 0 && t.workerCount > 0 {
+			tuning.queueOffset = int(utils.GenHashKey(key)) % t.workerCount
+		}
+	}
+	t.mu.Lock()
+	t.tables[key] = tuning
+	t.mu.Unlock()
+	return tuning
+}
+
+// firstMatched returns the first override in the order of config among the matched rules.
+func (t *TableTunings) firstMatched(rules selector.RuleSet) *config.TableTuning {
+	if len(rules) == 0 {
+		return nil
+	}
+	for _, tuning := range t.tunings {
+		for _, rule := range rules {
+			if rule == tuning {
+				return tuning
+			}
+		}
+	}
+	return nil
+}
+
+// Effective returns the effective tuning of the tables having DMLs dispatched and matched by the overrides,
+// which is used by query-status.
+func (t *TableTunings) Effective() []string {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	res := make([]string, 0, len(t.tables))
+	for table, tuning := range t.tables {
+		if tuning == nil {
+			continue
+		}
+		effective := *tuning
+		if effective.batch == 0 {
+			effective.batch = t.batch
+		}
+		if effective.workerCount == 0 {
+			effective.workerCount = t.workerCount
+		}
+		res = append(res, table+": "+effective.String())
+	}
+	sort.Strings(res)
+	return res
+}
+
+// dmlSize returns the approximate size of the values of the DML.
+func dmlSize(dml *sqlmodel.RowChange) int64 {
+	var size int64
+	addValues := func(values []interface{}) {
+		for _, v := range values {
+			switch v := v.(type) {
+			case string:
+				size += int64(len(v))
+			case []byte:
+				size += int64(len(v))
+			default:
+				size += 8
+			}
+		}
+	}
+	addValues(dml.GetPreValues())
+	addValues(dml.GetPostValues())
+	return size
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"testing"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableTuningsMatch(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SubTaskConfig{}
+	cfg.Batch = 100
+	cfg.WorkerCount = 16
+	tunings, err := NewTableTunings(cfg)
+	require.NoError(t, err)
+	require.Nil(t, tunings)
+	require.Nil(t, tunings.match(&cdcmodel.TableName{Schema: "db", Table: "tbl"}))
+	require.Nil(t, tunings.Effective())
+
+	cfg.TableTunings = []*config.TableTuning{
+		{SchemaPattern: "db", TablePattern: "hot_*", WorkerCount: 2},
+		{SchemaPattern: "db", Batch: 1000, MaxDMLSize: "4MiB"},
+	}
+	tunings, err = NewTableTunings(cfg)
+	require.NoError(t, err)
+	require.True(t, tunings.hasMaxDMLSize)
+
+	// the first matched override in the order of config takes effect.
+	hot := tunings.match(&cdcmodel.TableName{Schema: "DB", Table: "hot_1"})
+	require.Equal(t, 2, hot.workerCount)
+	require.Equal(t, 0, hot.batch)
+	require.Less(t, hot.queueOffset, 16)
+	big := tunings.match(&cdcmodel.TableName{Schema: "db", Table: "big"})
+	require.Equal(t, &dmlTuning{batch: 1000, maxDMLSize: 4 << 20}, big)
+	require.Nil(t, tunings.match(&cdcmodel.TableName{Schema: "other", Table: "tbl"}))
+	// cached.
+	require.Same(t, hot, tunings.match(&cdcmodel.TableName{Schema: "DB", Table: "hot_1"}))
+
+	require.Equal(t, []string{
+		"`DB`.`hot_1`: batch=100, max-dml-size=0, worker-count=2",
+		"`db`.`big`: batch=1000, max-dml-size=4194304, worker-count=16",
+	}, tunings.Effective())
+}
+
+func TestDMLWorkerWithTableTunings(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SubTaskConfig{}
+	cfg.Batch = 100
+	cfg.WorkerCount = 16
+	cfg.TableTunings = []*config.TableTuning{
+		{SchemaPattern: "db", TablePattern: "hot", WorkerCount: 2, Batch: 10},
+		{SchemaPattern: "db", TablePattern: "big", Batch: 1000, MaxDMLSize: "1KiB"},
+	}
+	tunings, err := NewTableTunings(cfg)
+	require.NoError(t, err)
+	w := &DMLWorker{batch: cfg.Batch, workerCount: cfg.WorkerCount, tunings: tunings}
+
+	createSQL := "create table db.hot(id int primary key, name varchar(24))"
+	ti := mockTableInfo(t, createSQL)
+	newJob := func(table string, id int) *job {
+		source := &cdcmodel.TableName{Schema: "db", Table: table}
+		return &job{
+			tp:          dml,
+			dml:         sqlmodel.NewRowChange(source, nil, nil, []interface{}{id, "haha"}, ti, nil, nil),
+			dmlQueueKey: fmt.Sprintf("%s.%d", table, id),
+		}
+	}
+
+	// the jobs of the hot table are confined to 2 queues.
+	queues := make(map[int]struct{})
+	for i := 0; i < 1000; i++ {
+		queues[w.queueBucket(newJob("hot", i))] = struct{}{}
+	}
+	require.Len(t, queues, 2)
+	// the jobs of other tables are distributed to all queues.
+	queues = make(map[int]struct{})
+	for i := 0; i < 1000; i++ {
+		queues[w.queueBucket(newJob("other", i))] = struct{}{}
+	}
+	require.Len(t, queues, 16)
+
+	// the limits of a batch are the tightest ones of the tables in it.
+	hot, big, other := newJob("hot", 1), newJob("big", 1), newJob("other", 1)
+	for _, j := range []*job{hot, big, other} {
+		w.queueBucket(j)
+	}
+	batchLimit, sizeLimit := w.batchLimits(big, 0, 0)
+	require.Equal(t, 1000, batchLimit)
+	require.Equal(t, int64(1024), sizeLimit)
+	batchLimit, sizeLimit = w.batchLimits(other, batchLimit, sizeLimit)
+	require.Equal(t, 100, batchLimit)
+	require.Equal(t, int64(1024), sizeLimit)
+	batchLimit, sizeLimit = w.batchLimits(hot, batchLimit, sizeLimit)
+	require.Equal(t, 10, batchLimit)
+	require.Equal(t, int64(1024), sizeLimit)
+
+	require.Equal(t, int64(8+4), dmlSize(hot.dml))
+}
@@ -14,6 +14,7 @@
 package syncer
 
 import (
+	"context"
 	"crypto/tls"
 	"database/sql"
 	"fmt"
@@ -31,6 +32,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog/common"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
@@ -196,16 +198,22 @@ func subtaskCfg2BinlogSyncerCfg(cfg *config.SubTaskConfig, timezone *time.Locati
 		}
 	}
 
+	password, err := secret.Resolve(context.Background(), cfg.From.Password)
+	if err != nil {
+		return replication.BinlogSyncerConfig{}, err
+	}
+
 	syncCfg := replication.BinlogSyncerConfig{
 		ServerID:                cfg.ServerID,
 		Flavor:                  cfg.Flavor,
 		Host:                    cfg.From.Host,
 		Port:                    uint16(cfg.From.Port),
 		User:                    cfg.From.User,
-		Password:                cfg.From.Password,
+		Password:                password,
 		TimestampStringLocation: timezone,
 		TLSConfig:               tlsConfig,
 		RowsEventDecodeFunc:     rowsEventDecodeFunc,
+		Dialer:                  conn.UpstreamBinlogDialer(&cfg.From),
 	}
 	// when retry count > 1, go-mysql will retry sync from the previous GTID set in GTID mode,
 	// which may get duplicate binlog event after retry success. so just set retry count = 1, and task
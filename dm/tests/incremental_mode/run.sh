@@ -98,13 +98,13 @@ function run() {
 	sed -i "s/root/dm_incremental/g" $WORK_DIR/source1.yaml
 	sed -i "s/root/dm_incremental/g" $WORK_DIR/source2.yaml
 
-	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
+	run_dm_ctl_with_retry $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"operate-source update $WORK_DIR/source1.yaml" \
-		"Update worker config is not supported by dm-ha now" 1
-	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
+		"source is updated but there is no worker bound to apply it" 1
+	run_dm_ctl_with_retry $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"operate-source update $WORK_DIR/source2.yaml" \
-		"Update worker config is not supported by dm-ha now" 1
-	# update mysql config is not supported by dm-ha now, so we stop and start source again to update source config
+		"source is updated but there is no worker bound to apply it" 1
+	# the workers are offline, so the updated sources take effect after they're bound again
 
 	run_dm_ctl_with_retry $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"stop-relay -s $worker1bound worker1" \
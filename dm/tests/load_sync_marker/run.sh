@@ -0,0 +1,67 @@
+#!/bin/bash
+
+set -eu
+
+cur=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+source $cur/../_utils/test_prepare
+WORK_DIR=$TEST_DIR/$TEST_NAME
+
+function prepare_data() {
+	run_sql_source1 "DROP DATABASE if exists $TEST_NAME;"
+	run_sql_source1 "CREATE DATABASE $TEST_NAME;"
+	run_sql_source1 "CREATE TABLE $TEST_NAME.t1(id INT PRIMARY KEY, b VARCHAR(20));"
+	for i in $(seq 100); do
+		run_sql_source1 "INSERT INTO $TEST_NAME.t1 VALUES ($i, 'full');"
+	done
+}
+
+function run() {
+	prepare_data
+
+	# worker exits right after load unit writes the sync marker, before sync unit flushes its checkpoint
+	export GO_FAILPOINTS="github.com/pingcap/tiflow/dm/loader/LoadSyncMarkerWrittenExit=return()"
+
+	run_dm_master $WORK_DIR/master $MASTER_PORT $cur/conf/dm-master.toml
+	check_rpc_alive $cur/../bin/check_master_online 127.0.0.1:$MASTER_PORT
+	run_dm_worker $WORK_DIR/worker1 $WORKER1_PORT $cur/conf/dm-worker1.toml
+	check_rpc_alive $cur/../bin/check_worker_online 127.0.0.1:$WORKER1_PORT
+
+	cp $cur/conf/source1.yaml $WORK_DIR/source1.yaml
+	sed -i "/relay-binlog-name/i\relay-dir: $WORK_DIR/worker1/relay_log" $WORK_DIR/source1.yaml
+	dmctl_operate_source create $WORK_DIR/source1.yaml $SOURCE_ID1
+
+	# don't check result, because worker may meet failpoint `LoadSyncMarkerWrittenExit` before correct response.
+	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
+		"start-task $cur/conf/dm-task.yaml"
+
+	check_port_offline $WORKER1_PORT 20
+
+	run_sql_tidb "SELECT status FROM dm_meta.test_load_sync_marker WHERE source_name = '$SOURCE_ID1'"
+	check_contains "status: loaded"
+
+	# these rows are written after dump, they should be replicated by sync unit
+	for i in $(seq 101 120); do
+		run_sql_source1 "INSERT INTO $TEST_NAME.t1 VALUES ($i, 'incr');"
+	done
+
+	export GO_FAILPOINTS=''
+	run_dm_worker $WORK_DIR/worker1 $WORKER1_PORT $cur/conf/dm-worker1.toml
+	check_rpc_alive $cur/../bin/check_worker_online 127.0.0.1:$WORKER1_PORT
+
+	run_dm_ctl_with_retry $WORK_DIR "127.0.0.1:$MASTER_PORT" \
+		"query-status test" \
+		"\"unit\": \"Sync\"" 1
+
+	check_sync_diff $WORK_DIR $cur/conf/diff_config.toml
+
+	run_sql_tidb_with_retry "SELECT status FROM dm_meta.test_load_sync_marker WHERE source_name = '$SOURCE_ID1'" "status: installed"
+	check_log_contain_with_retry "loaded checkpoints from load sync marker" $WORK_DIR/worker1/log/dm-worker.log
+}
+
+cleanup_data $TEST_NAME
+# also cleanup dm processes in case of last run failed
+cleanup_process $*
+run $*
+cleanup_process $*
+
+echo "[$(date)] <<<<<< test case $TEST_NAME success! >>>>>>"
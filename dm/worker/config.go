@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tiflow/dm/config/security"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/secret"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -102,6 +103,10 @@ type Config struct {
 
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
 
+	// SecretProviders configures the providers of the secrets referenced by the passwords
+	// in source and task configs, such as `vault://path#key` and `aws-sm://name`.
+	SecretProviders secret.Config `toml:"secret-providers" json:"secret-providers"`
+
 	// tls config
 	security.Security
 
@@ -47,9 +47,14 @@ func (s *Server) JoinMaster(endpoints []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	cfgContent, _ := s.cfg.Toml()
+	version := ha.NewMemberVersion(cfgContent)
 	req := &pb.RegisterWorkerRequest{
-		Name:    s.cfg.Name,
-		Address: s.cfg.AdvertiseAddr,
+		Name:       s.cfg.Name,
+		Address:    s.cfg.AdvertiseAddr,
+		Version:    version.Version,
+		GitHash:    version.GitHash,
+		ConfigHash: version.ConfigHash,
 	}
 
 	var errorStr string
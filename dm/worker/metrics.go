@@ -15,6 +15,8 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/pprof"
@@ -79,6 +81,43 @@ func (h *statusHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// relayStatsHandler serves the statistics of relayed binlog events of the source
+// bound to the worker, by `GET /relay/stats?source=source-id`.
+type relayStatsHandler struct {
+	s *Server
+}
+
+func (h *relayStatsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	source := req.URL.Query().Get("source")
+	worker := h.s.getSourceWorker(true)
+	if worker == nil {
+		http.Error(w, "no source is bound to the worker", http.StatusNotFound)
+		return
+	}
+	sourceID, stats := worker.relayEventStats()
+	if source != "" && source != sourceID {
+		http.Error(w, fmt.Sprintf("source %s is not bound to the worker, the bound source is %s", source, sourceID), http.StatusNotFound)
+		return
+	}
+	if stats == nil {
+		http.Error(w, fmt.Sprintf("relay is not enabled for source %s", sourceID), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(&relay.EventStatsSnapshot{
+		Source:  sourceID,
+		Windows: stats.Snapshot(time.Now()),
+	})
+	if err != nil && !common.IsErrNetClosing(err) {
+		log.L().Error("fail to write relay stats response", log.ShortError(err))
+	}
+}
+
 // Note: handle error inside the function with returning it.
 func (s *Server) collectMetrics() {
 	// CPU usage metric
@@ -122,9 +161,10 @@ func RegistryMetrics() {
 }
 
 // InitStatus initializes the HTTP status server.
-func InitStatus(lis net.Listener) {
+func InitStatus(lis net.Listener, s *Server) {
 	mux := http.NewServeMux()
 	mux.Handle("/status", &statusHandler{})
+	mux.Handle("/relay/stats", &relayStatsHandler{s: s})
 	mux.Handle("/metrics", promhttp.Handler())
 
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
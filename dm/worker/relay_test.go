@@ -50,6 +50,10 @@ func (d *DummyRelay) IsActive(uuid, filename string) (bool, int64) {
 	return false, 0
 }
 
+func (d *DummyRelay) EventStats() *relay.EventStats {
+	return nil
+}
+
 func (d *DummyRelay) NewReader(logger log.Logger, cfg *relay.BinlogReaderConfig) *relay.BinlogReader {
 	return nil
 }
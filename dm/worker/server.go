@@ -240,7 +240,7 @@ func (s *Server) Start() error {
 		s.httpWg.Add(1)
 		go func() {
 			s.httpWg.Done()
-			InitStatus(httpL) // serve status
+			InitStatus(httpL, s) // serve status
 		}()
 
 		s.closed.Store(false) // the server started now.
@@ -803,6 +803,7 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusRequest) (*
 
 	var err error
 	resp.SubTaskStatus, sourceStatus.RelayStatus, err = w.QueryStatus(ctx, req.Name)
+	sourceStatus.UpstreamConnections = w.UpstreamConnections()
 
 	if err != nil {
 		resp.Msg = fmt.Sprintf("error when get master status: %v", err)
@@ -1010,6 +1011,39 @@ func (s *Server) CheckSubtasksCanUpdate(ctx context.Context, req *pb.CheckSubtas
 	return resp, nil
 }
 
+// UpdateSourceCfg applies the updated connection parameters of the bound source to the relay and subtasks.
+func (s *Server) UpdateSourceCfg(ctx context.Context, req *pb.UpdateSourceCfgRequest) (*pb.UpdateSourceCfgResponse, error) {
+	// don't log the payload, which contains the password of upstream.
+	log.L().Info("", zap.String("request", "UpdateSourceCfg"))
+	resp := &pb.UpdateSourceCfgResponse{}
+	defer func() {
+		log.L().Info("", zap.String("request", "UpdateSourceCfg"), zap.Stringer("resp", resp))
+	}()
+	w := s.getSourceWorker(true)
+	if w == nil {
+		msg := "fail to call UpdateSourceCfg, because no mysql source is being handled in the worker"
+		log.L().Warn(msg)
+		resp.Msg = msg
+		return resp, nil
+	}
+	// parse the config in the same way as the one read from etcd.
+	cfg := &config.SourceConfig{}
+	if err := cfg.Parse(req.SourceCfgTomlString); err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	units, err := w.UpdateSourceCfg(ctx, cfg)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	resp.Result = true
+	resp.Units = units
+	return resp, nil
+}
+
 func (s *Server) GetWorkerValidatorStatus(ctx context.Context, req *pb.GetValidationStatusRequest) (*pb.GetValidationStatusResponse, error) {
 	log.L().Info("", zap.String("request", "GetWorkerValidateStatus"), zap.Stringer("payload", req))
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -468,7 +469,7 @@ func (w *SourceWorker) EnableHandleSubtasks() error {
 		w.l.Info("start to create subtask in EnableHandleSubtasks", zap.String("sourceID", subTaskCfg.SourceID), zap.String("task", subTaskCfg.Name))
 		// "for range" of a map will use same value address, so we'd better not pass value address to other function
 		clone := subTaskCfg
-		if err2 := w.StartSubTask(&clone, expectStage.Expect, validatorStage, false); err2 != nil {
+		if err2 := w.startSubTaskWithStage(&clone, expectStage, validatorStage, false); err2 != nil {
 			w.subTaskHolder.closeAllSubTasks()
 			return err2
 		}
@@ -573,6 +574,38 @@ func (w *SourceWorker) StartSubTask(cfg *config.SubTaskConfig, expectStage, vali
 	return nil
 }
 
+// startSubTaskWithStage creates a subtask and run it in the expected stage. A subtask
+// pausing at a binlog time keeps running until its sync unit reaches the time.
+func (w *SourceWorker) startSubTaskWithStage(cfg *config.SubTaskConfig, stage ha.Stage, validatorStage pb.Stage, needLock bool) error {
+	if stage.Expect != pb.Stage_Paused || stage.PauseAtTime <= 0 {
+		return w.StartSubTask(cfg, stage.Expect, validatorStage, needLock)
+	}
+	if needLock {
+		w.Lock()
+		defer w.Unlock()
+	}
+
+	if err := w.StartSubTask(cfg, pb.Stage_Running, validatorStage, false); err != nil {
+		return err
+	}
+	st := w.subTaskHolder.findSubTask(cfg.Name)
+	if st == nil || st.Stage() != pb.Stage_Running {
+		// the subtask failed to start, the error is reported by its status.
+		return nil
+	}
+	target, deadline := pauseAtOfStage(stage)
+	w.l.Info("pause subtask at", zap.String("task", cfg.Name), zap.Time("target", target), zap.Time("deadline", deadline))
+	return st.PauseAt(target, deadline)
+}
+
+// pauseAtOfStage returns the target and deadline of the pause-at stage of a subtask.
+func pauseAtOfStage(stage ha.Stage) (target, deadline time.Time) {
+	if stage.PauseAtDeadline > 0 {
+		deadline = time.Unix(stage.PauseAtDeadline, 0)
+	}
+	return time.Unix(stage.PauseAtTime, 0), deadline
+}
+
 // caller should make sure w.Lock is locked before calling this method.
 func (w *SourceWorker) getRelayWithoutLock() relay.Process {
 	if w.relayHolder != nil {
@@ -581,6 +614,18 @@ func (w *SourceWorker) getRelayWithoutLock() relay.Process {
 	return nil
 }
 
+// relayEventStats returns the source ID and the statistics of relayed binlog events,
+// the statistics is nil if relay is not enabled.
+func (w *SourceWorker) relayEventStats() (string, *relay.EventStats) {
+	w.RLock()
+	defer w.RUnlock()
+	r := w.getRelayWithoutLock()
+	if r == nil {
+		return w.cfg.SourceID, nil
+	}
+	return w.cfg.SourceID, r.EventStats()
+}
+
 // UpdateSubTask update config for a sub task.
 func (w *SourceWorker) UpdateSubTask(ctx context.Context, cfg *config.SubTaskConfig, needLock bool) error {
 	if needLock {
@@ -598,7 +643,13 @@ func (w *SourceWorker) UpdateSubTask(ctx context.Context, cfg *config.SubTaskCon
 	}
 
 	w.l.Info("update sub task", zap.String("task", cfg.Name))
-	return st.Update(ctx, cfg)
+	if err := st.Update(ctx, cfg); err != nil {
+		return err
+	}
+	if w.taskStatusChecker != nil {
+		w.taskStatusChecker.ResetBreaker(cfg.Name)
+	}
+	return nil
 }
 
 // OperateSubTask stop/resume/pause sub task.
@@ -635,6 +686,10 @@ func (w *SourceWorker) OperateSubTask(name string, op pb.TaskOp) error {
 		}
 		failpoint.Label("bypassRefresh")
 		w.l.Info("resume subtask", zap.String("task", name))
+		st.CancelPauseAt()
+		if w.taskStatusChecker != nil {
+			w.taskStatusChecker.ResetBreaker(name)
+		}
 		err = st.Resume(w.getRelayWithoutLock())
 	case pb.TaskOp_AutoResume:
 		// TODO(ehco) change to auto_restart
@@ -647,6 +702,24 @@ func (w *SourceWorker) OperateSubTask(name string, op pb.TaskOp) error {
 	return err
 }
 
+// PauseSubTaskAt pauses the sub task after its sync unit applied all the binlog events not later than target.
+func (w *SourceWorker) PauseSubTaskAt(name string, target, deadline time.Time) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(name)
+	if st == nil {
+		return terror.ErrWorkerSubTaskNotFound.Generate(name)
+	}
+
+	w.l.Info("pause subtask at", zap.String("task", name), zap.Time("target", target), zap.Time("deadline", deadline))
+	return st.PauseAt(target, deadline)
+}
+
 // QueryStatus query worker's sub tasks' status. If relay enabled, also return source status.
 func (w *SourceWorker) QueryStatus(ctx context.Context, name string) ([]*pb.SubTaskStatus, *pb.RelayStatus, error) {
 	w.RLock()
@@ -679,6 +752,18 @@ func (w *SourceWorker) QueryStatus(ctx context.Context, name string) ([]*pb.SubT
 	return subtaskStatus, relayStatus, nil
 }
 
+// UpstreamConnections returns the used and max connections to the upstream in the format of
+// "used/max", it returns an empty string if max-connections is not set.
+func (w *SourceWorker) UpstreamConnections() string {
+	w.RLock()
+	defer w.RUnlock()
+	used, max, ok := conn.UpstreamConnectionUsage(&w.cfg.From)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", used, max)
+}
+
 func (w *SourceWorker) resetSubtaskStage() (int64, error) {
 	subTaskStages, _, subTaskCfgm, revSubTask, err := w.fetchSubTasksAndAdjust()
 	if err != nil {
@@ -815,7 +900,7 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 			if err != nil {
 				return opErrTypeBeforeOp, terror.Annotate(err, "fail to get validator stage from etcd")
 			}
-			return opErrTypeBeforeOp, w.StartSubTask(&subTaskCfg, stage.Expect, expectValidatorStage, true)
+			return opErrTypeBeforeOp, w.startSubTaskWithStage(&subTaskCfg, stage, expectValidatorStage, true)
 		default:
 			// not valid stage
 			return op.String(), w.OperateSubTask(stage.Task, op)
@@ -830,6 +915,9 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 	}
 	if stage.IsDeleted {
 		op = pb.TaskOp_Delete
+	} else if stage.Expect == pb.Stage_Paused && stage.PauseAtTime > 0 {
+		target, deadline := pauseAtOfStage(stage)
+		return op.String(), w.PauseSubTaskAt(stage.Task, target, deadline)
 	}
 	return op.String(), w.OperateSubTask(stage.Task, op)
 }
@@ -1331,6 +1419,58 @@ func (w *SourceWorker) CheckCfgCanUpdated(cfg *config.SubTaskConfig) error {
 	return subTask.CheckUnitCfgCanUpdate(cfg)
 }
 
+// UpdateSourceCfg applies the updated connection parameters of the source online. The relay and sync units
+// rebuild their upstream connections at a safe point and continue from where they stopped. It returns the
+// result of each unit.
+func (w *SourceWorker) UpdateSourceCfg(ctx context.Context, cfg *config.SourceConfig) ([]*pb.UpdateSourceCfgUnitResult, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return nil, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+	if cfg.SourceID != w.cfg.SourceID {
+		return nil, terror.ErrWorkerSourceNotMatch.Generate()
+	}
+	if err := config.CheckSourceCfgHotUpdate(w.cfg, cfg); err != nil {
+		return nil, err
+	}
+	// UUIDSuffix is not marshaled, it's only kept in the bound worker.
+	cfg.UUIDSuffix = w.cfg.UUIDSuffix
+	w.cfg = cfg
+	w.l.Info("update source config online", zap.Stringer("cfg", cfg))
+
+	// sourceDB will be connected with the new config when it's used next time.
+	w.sourceDBMu.Lock()
+	if w.sourceDB != nil {
+		w.sourceDB.Close()
+		w.sourceDB = nil
+	}
+	w.sourceDBMu.Unlock()
+
+	var results []*pb.UpdateSourceCfgUnitResult
+	if w.relayEnabled.Load() {
+		result := &pb.UpdateSourceCfgUnitResult{Unit: pb.UnitType_Relay, Result: true}
+		if err := w.relayHolder.Update(ctx, cfg); err != nil {
+			result.Result = false
+			result.Msg = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	from := cfg.DecryptPassword().From
+	subTasks := w.subTaskHolder.getAllSubTasks()
+	names := make([]string, 0, len(subTasks))
+	for name := range subTasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		results = append(results, subTasks[name].UpdateUpstream(ctx, from, w.getRelayWithoutLock())...)
+	}
+	return results, nil
+}
+
 func (w *SourceWorker) GetWorkerValidatorErr(taskName string, errState pb.ValidateErrorState) ([]*pb.ValidationError, error) {
 	st := w.subTaskHolder.findSubTask(taskName)
 	if st != nil {
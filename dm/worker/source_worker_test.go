@@ -853,3 +853,50 @@ func TestMasterBinlogOff(t *testing.T) {
 	require.Len(t, status, 1)
 	require.Equal(t, subtaskCfg.Name, status[0].Name)
 }
+
+// processNotifyUnit notifies when the unit starts to process.
+type processNotifyUnit struct {
+	*MockUnit
+	processCh chan struct{}
+}
+
+func (u *processNotifyUnit) Process(ctx context.Context, pr chan pb.ProcessResult) {
+	u.processCh <- struct{}{}
+	u.MockUnit.Process(ctx, pr)
+}
+
+func TestStartSubTaskPausingAt(t *testing.T) {
+	processCh := make(chan struct{}, 1)
+	createUnits = func(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, worker string, relay relay.Process) []unit.Unit {
+		return []unit.Unit{&processNotifyUnit{MockUnit: NewMockUnit(pb.UnitType_Sync), processCh: processCh}}
+	}
+	defer func() {
+		createUnits = createRealUnits
+	}()
+
+	cfg, err := config.ParseYamlAndVerify(config.SampleSourceConfig)
+	require.NoError(t, err)
+	cfg.From.Password = "no need to connect"
+	w, err := NewSourceWorker(cfg, nil, "", "")
+	require.NoError(t, err)
+	w.closed.Store(false)
+
+	// the subtask of a restarted worker pausing at a binlog time runs until
+	// its sync unit reaches the time, instead of being paused at once.
+	var subtaskCfg config.SubTaskConfig
+	require.NoError(t, subtaskCfg.Decode(config.SampleSubtaskConfig, true))
+	stage := ha.NewSubTaskPauseAtStage(cfg.SourceID, subtaskCfg.Name, time.Now(), time.Time{})
+	require.NoError(t, w.startSubTaskWithStage(&subtaskCfg, stage, pb.Stage_InvalidStage, true))
+	select {
+	case <-processCh:
+	case <-time.After(3 * time.Second):
+		require.FailNow(t, "the subtask is not started")
+	}
+	st := w.subTaskHolder.findSubTask(subtaskCfg.Name)
+	require.NotNil(t, st)
+	// the mock unit isn't a syncer, so the subtask is paused at once.
+	require.Eventually(t, func() bool {
+		return st.Stage() == pb.Stage_Paused
+	}, 3*time.Second, 100*time.Millisecond)
+	st.Close()
+}
@@ -106,6 +106,9 @@ func (w *SourceWorker) Status(stName string, sourceStatus *binlog.SourceStatus)
 					}
 				}
 			}
+			if w.taskStatusChecker != nil {
+				w.taskStatusChecker.FillAutoResumeStatus(&stStatus)
+			}
 		}
 		status = append(status, &stStatus)
 	}
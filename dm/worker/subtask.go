@@ -15,12 +15,15 @@ package worker
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/dumpling"
 	"github.com/pingcap/tiflow/dm/loader"
 	"github.com/pingcap/tiflow/dm/pb"
@@ -124,10 +127,11 @@ func NewRealSubTask(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, work
 // NewSubTaskWithStage creates a new SubTask with stage.
 func NewSubTaskWithStage(cfg *config.SubTaskConfig, stage pb.Stage, etcdClient *clientv3.Client, workerName string) *SubTask {
 	ctx, cancel := context.WithCancel(context.Background())
+	initSubTaskLogger(cfg)
 	st := SubTask{
 		cfg:        cfg,
 		stage:      stage,
-		l:          log.With(zap.String("subtask", cfg.Name)),
+		l:          log.ForTask(cfg.Name, cfg.SourceID).WithFields(zap.String("subtask", cfg.Name)),
 		ctx:        ctx,
 		cancel:     cancel,
 		etcdClient: etcdClient,
@@ -137,6 +141,29 @@ func NewSubTaskWithStage(cfg *config.SubTaskConfig, stage pb.Stage, etcdClient *
 	return &st
 }
 
+// initSubTaskLogger routes the logs of the subtask to a separate file if
+// `log-level` or `log-file` is configured, the units get the logger by
+// log.ForTask. The logs are written to the DM-worker log if it fails.
+func initSubTaskLogger(cfg *config.SubTaskConfig) {
+	if cfg.LogLevel == "" && cfg.LogFile == "" {
+		return
+	}
+	file := cfg.LogFile
+	if file == "" {
+		file = filepath.Join("logs", cfg.Name+".log")
+	}
+	ext := filepath.Ext(file)
+	logCfg := &log.Config{
+		Level:  cfg.LogLevel,
+		Format: cfg.LogFormat,
+		File:   strings.TrimSuffix(file, ext) + "-" + cfg.SourceID + ext,
+	}
+	if err := log.InitTaskLogger(cfg.Name, cfg.SourceID, logCfg); err != nil {
+		log.L().Warn("fail to init the logger of subtask, use the DM-worker logger instead",
+			zap.String("subtask", cfg.Name), zap.String("file", logCfg.File), log.ShortError(err))
+	}
+}
+
 // initUnits initializes the sub task processing units.
 func (st *SubTask) initUnits(relay relay.Process) error {
 	// NOTE: because lightning not support init tls with raw certs bytes, we write the certs data to a file.
@@ -566,6 +593,7 @@ func (st *SubTask) Close() {
 	// we can start/stop validator independent of task, so we don't set st.validator = nil inside
 	st.StopValidator()
 	st.validator = nil
+	log.CloseTaskLogger(st.cfg.Name, st.cfg.SourceID)
 }
 
 // Kill kill running unit and stop the sub task.
@@ -583,6 +611,7 @@ func (st *SubTask) Kill() {
 
 	st.StopValidator()
 	st.validator = nil
+	log.CloseTaskLogger(cfg.Name, cfg.SourceID)
 }
 
 // Pause pauses a running sub task or a sub task paused by error.
@@ -601,6 +630,31 @@ func (st *SubTask) Pause() error {
 	return nil
 }
 
+// PauseAt pauses a running sub task after its sync unit applied all the binlog
+// events not later than target, or at deadline if target can't be reached in time.
+// The sub task whose current unit is not the sync unit is paused at once.
+func (st *SubTask) PauseAt(target, deadline time.Time) error {
+	syncUnit, ok := st.CurrUnit().(*syncer.Syncer)
+	if !ok || st.Stage() != pb.Stage_Running {
+		st.l.Info("sync unit is not running, pause the subtask at once", zap.Time("target", target))
+		return st.Pause()
+	}
+
+	syncUnit.PauseAt(target, deadline, func() {
+		if err := st.Pause(); err != nil {
+			st.l.Warn("fail to pause subtask after reaching the pause-at target", zap.Time("target", target), log.ShortError(err))
+		}
+	})
+	return nil
+}
+
+// CancelPauseAt cancels the pending PauseAt of the sub task.
+func (st *SubTask) CancelPauseAt() {
+	if syncUnit, ok := st.CurrUnit().(*syncer.Syncer); ok {
+		syncUnit.CancelPauseAt()
+	}
+}
+
 // Resume resumes the paused sub task
 // TODO: similar to Run, refactor later.
 func (st *SubTask) Resume(relay relay.Process) error {
@@ -660,6 +714,56 @@ func (st *SubTask) Update(ctx context.Context, cfg *config.SubTaskConfig) error
 	return nil
 }
 
+// upstreamUpdater is implemented by the units which can rebuild their upstream connections online.
+type upstreamUpdater interface {
+	// UpdateUpstream rebuilds the upstream connections with the new connection config, it should be
+	// called when the unit is not processing.
+	UpdateUpstream(ctx context.Context, from dbconfig.DBConfig) error
+}
+
+// UpdateUpstream applies the updated connection config of upstream to the sub task. The units which can
+// rebuild their upstream connections online, e.g. the sync unit, are updated, and a running one is paused
+// to rebuild them between transactions and resumed from its checkpoint. Other units keep their connections
+// until the sub task is recreated. It returns the result of each updated unit.
+func (st *SubTask) UpdateUpstream(ctx context.Context, from dbconfig.DBConfig, relay relay.Process) []*pb.UpdateSourceCfgUnitResult {
+	cfg := *st.getCfg()
+	cfg.From = from
+	st.SetCfg(cfg)
+
+	var results []*pb.UpdateSourceCfgUnitResult
+	for _, u := range st.units {
+		updater, ok := u.(upstreamUpdater)
+		if !ok {
+			continue
+		}
+		result := &pb.UpdateSourceCfgUnitResult{Task: cfg.Name, Unit: u.Type(), Result: true}
+		if err := st.updateUnitUpstream(ctx, u, updater, from, relay); err != nil {
+			st.l.Warn("fail to update upstream connections", zap.Stringer("unit", u.Type()), log.ShortError(err))
+			result.Result = false
+			result.Msg = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (st *SubTask) updateUnitUpstream(ctx context.Context, u unit.Unit, updater upstreamUpdater, from dbconfig.DBConfig, relay relay.Process) error {
+	if st.CurrUnit() != u || st.Stage() != pb.Stage_Running {
+		return updater.UpdateUpstream(ctx, from)
+	}
+
+	// the running unit stops between transactions and saves its checkpoint when it's paused.
+	if err := st.Pause(); err != nil {
+		return err
+	}
+	err := updater.UpdateUpstream(ctx, from)
+	// resume it even if the update fails, it keeps using the old connections then.
+	if err2 := st.Resume(relay); err == nil {
+		err = err2
+	}
+	return err
+}
+
 // OperateSchema operates schema for an upstream table.
 func (st *SubTask) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaRequest) (schema string, err error) {
 	if st.Stage() != pb.Stage_Paused && req.Op != pb.SchemaOp_ListMigrateTargets {
@@ -817,9 +921,16 @@ func (st *SubTask) fail(err error) {
 // HandleError handle error for syncer unit.
 func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest, relay relay.Process) (string, error) {
 	// TODO: do we need lock here?
-	syncUnit, ok := st.currUnit.(*syncer.Syncer)
+	cu := st.CurrUnit()
+	syncUnit, ok := cu.(*syncer.Syncer)
 	if !ok {
-		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(st.currUnit.Type())
+		// operators for a specified binlog position can be set before the sync unit
+		// starts, they will take effect when the position is reached.
+		syncUnit = st.pendingSyncUnit()
+		if syncUnit == nil || len(req.BinlogPos) == 0 {
+			return "", terror.ErrWorkerOperSyncUnitOnly.Generate(cu.Type())
+		}
+		return syncUnit.HandleError(ctx, req)
 	}
 
 	msg, err := syncUnit.HandleError(ctx, req)
@@ -833,6 +944,18 @@ func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReq
 	return msg, err
 }
 
+// pendingSyncUnit returns the initialized sync unit which has not started yet.
+func (st *SubTask) pendingSyncUnit() *syncer.Syncer {
+	st.RLock()
+	defer st.RUnlock()
+	for _, u := range st.units {
+		if s, ok := u.(*syncer.Syncer); ok {
+			return s
+		}
+	}
+	return nil
+}
+
 func (st *SubTask) getCfg() *config.SubTaskConfig {
 	st.RLock()
 	defer st.RUnlock()
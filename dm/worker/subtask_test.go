@@ -23,6 +23,7 @@ import (
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/dumpling"
 	"github.com/pingcap/tiflow/dm/loader"
 	"github.com/pingcap/tiflow/dm/pb"
@@ -592,3 +593,44 @@ func TestSubtaskRace(t *testing.T) {
 	st.markResultCanceled()
 	// this test is to test data race, so don't need assert here
 }
+
+type mockUpstreamUpdaterUnit struct {
+	*MockUnit
+	from              dbconfig.DBConfig
+	errUpdateUpstream error
+}
+
+func (m *mockUpstreamUpdaterUnit) UpdateUpstream(_ context.Context, from dbconfig.DBConfig) error {
+	if m.errUpdateUpstream != nil {
+		return m.errUpdateUpstream
+	}
+	m.from = from
+	return nil
+}
+
+func TestSubTaskUpdateUpstream(t *testing.T) {
+	cfg := &config.SubTaskConfig{
+		Name: "test-update-upstream",
+		From: dbconfig.DBConfig{Host: "127.0.0.1", User: "root", Password: "old"},
+	}
+	st := NewSubTaskWithStage(cfg, pb.Stage_Paused, nil, "worker")
+	mockLoader := NewMockUnit(pb.UnitType_Load)
+	mockSyncer := &mockUpstreamUpdaterUnit{MockUnit: NewMockUnit(pb.UnitType_Sync)}
+	st.units = []unit.Unit{mockLoader, mockSyncer}
+
+	// only the units which can rebuild their upstream connections are updated
+	from := dbconfig.DBConfig{Host: "127.0.0.1", User: "root", Password: "new"}
+	results := st.UpdateUpstream(context.Background(), from, nil)
+	require.Len(t, results, 1)
+	require.Equal(t, &pb.UpdateSourceCfgUnitResult{Task: cfg.Name, Unit: pb.UnitType_Sync, Result: true}, results[0])
+	require.Equal(t, from, mockSyncer.from)
+	require.Equal(t, from, st.getCfg().From)
+
+	// the failure of a unit is reported in its result
+	mockSyncer.errUpdateUpstream = errors.New("update failed")
+	results = st.UpdateUpstream(context.Background(), dbconfig.DBConfig{Host: "127.0.0.1", User: "root"}, nil)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Result)
+	require.Equal(t, "update failed", results[0].Msg)
+	require.Equal(t, from, mockSyncer.from)
+}
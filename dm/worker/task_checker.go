@@ -16,6 +16,9 @@ package worker
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -61,6 +64,9 @@ type ResumeStrategy int
 //  1. update latestPausedTime
 //  2. dispatch auto resume task
 //  3. if step2 successes, update latestResumeTime, forward backoff
+//
+// ResumeBreak:
+//  1. update latestPausedTime
 const (
 	// When a task is not in paused state, or paused by manually, or we can't get enough information from worker
 	// to determine whether this task is paused because of some error, we will apply ResumeIgnore strategy, and
@@ -75,6 +81,9 @@ const (
 	ResumeNoSense
 	// ResumeDispatch means we will dispatch an auto resume operation in this check round for the paused task.
 	ResumeDispatch
+	// When a task is paused by the identical error for BreakerThreshold consecutive times, the circuit breaker
+	// opens and we will apply ResumeBreak strategy, and stop auto resume until the task is resumed manually.
+	ResumeBreak
 )
 
 var resumeStrategy2Str = map[ResumeStrategy]string{
@@ -82,8 +91,15 @@ var resumeStrategy2Str = map[ResumeStrategy]string{
 	ResumeSkip:     "skip task resume",
 	ResumeNoSense:  "resume task makes no sense",
 	ResumeDispatch: "dispatch auto resume",
+	ResumeBreak:    "auto resume breaker is open",
 }
 
+// states of the auto resume circuit breaker shown in the subtask status.
+const (
+	autoResumeBreakerClosed = "closed"
+	autoResumeBreakerOpen   = "open"
+)
+
 // String implements fmt.Stringer interface.
 func (bs ResumeStrategy) String() string {
 	if s, ok := resumeStrategy2Str[bs]; ok {
@@ -100,6 +116,12 @@ type TaskStatusChecker interface {
 	Start()
 	// Close closes the checker
 	Close()
+	// ResetBreaker closes the auto resume circuit breaker of a subtask, it's called
+	// when the subtask is resumed manually.
+	ResetBreaker(taskName string)
+	// FillAutoResumeStatus fills the auto resume status of a subtask found in the
+	// latest check round.
+	FillAutoResumeStatus(stStatus *pb.SubTaskStatus)
 }
 
 // NewTaskStatusChecker is a TaskStatusChecker initializer.
@@ -112,6 +134,61 @@ type AutoResumeInfo struct {
 	LatestPausedTime time.Time
 	LatestBlockTime  time.Time
 	LatestResumeTime time.Time
+
+	// BreakerThreshold is the number of consecutive auto resumes for the identical
+	// error after which the circuit breaker opens, 0 means it never opens.
+	BreakerThreshold int
+	// LatestErrorID identifies the error of the latest auto resume, SameErrorCount
+	// is the number of consecutive auto resumes for it.
+	LatestErrorID  string
+	SameErrorCount int
+	// BreakerOpen means auto resume is stopped until the subtask is resumed manually.
+	BreakerOpen bool
+}
+
+// ResetBreaker closes the circuit breaker and forgets the errors of the previous auto resumes.
+func (i *AutoResumeInfo) ResetBreaker() {
+	i.BreakerOpen = false
+	i.LatestErrorID = ""
+	i.SameErrorCount = 0
+}
+
+// NextResumeTime returns the time of the next auto resume, it's zero if the breaker is open.
+func (i *AutoResumeInfo) NextResumeTime() time.Time {
+	if i.BreakerOpen {
+		return time.Time{}
+	}
+	return i.LatestResumeTime.Add(i.Backoff.Current())
+}
+
+// countError counts the error the subtask is paused by, and opens the breaker if
+// the subtask has been paused by it for BreakerThreshold consecutive times.
+func (i *AutoResumeInfo) countError(errs []*pb.ProcessError) {
+	id := errorsID(errs)
+	if id != i.LatestErrorID {
+		i.LatestErrorID = id
+		i.SameErrorCount = 0
+	}
+	i.SameErrorCount++
+	if i.BreakerThreshold > 0 && i.SameErrorCount >= i.BreakerThreshold {
+		i.BreakerOpen = true
+	}
+}
+
+var digitsRe = regexp.MustCompile(`[0-9]+`)
+
+// errorsID identifies the errors by their codes and the hash of their messages.
+// The digits in the messages are normalized, because positions, GTIDs and
+// connection IDs in them change between the occurrences of the same error.
+func errorsID(errs []*pb.ProcessError) string {
+	ids := make([]string, 0, len(errs))
+	for _, err := range errs {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(digitsRe.ReplaceAllString(err.Message, "?")))
+		_, _ = h.Write([]byte(digitsRe.ReplaceAllString(err.RawCause, "?")))
+		ids = append(ids, fmt.Sprintf("%d-%x", err.ErrCode, h.Sum64()))
+	}
+	return strings.Join(ids, ",")
 }
 
 // realTaskStatusChecker is not thread-safe.
@@ -129,6 +206,14 @@ type realTaskStatusChecker struct {
 
 	subtaskAutoResume map[string]*AutoResumeInfo
 	relayAutoResume   *AutoResumeInfo
+
+	// mu protects the fields below, which are accessed by DM-worker. It's never
+	// held when the checker calls DM-worker, so that they won't wait for each other.
+	mu sync.Mutex
+	// resetBreakers are the subtasks resumed manually since the latest check round.
+	resetBreakers map[string]struct{}
+	// autoResumeStatus is the auto resume status of subtasks in the latest check round.
+	autoResumeStatus map[string]pb.SubTaskStatus
 }
 
 // NewRealTaskStatusChecker creates a new realTaskStatusChecker instance.
@@ -138,6 +223,8 @@ func NewRealTaskStatusChecker(cfg config.CheckerConfig, w *SourceWorker) TaskSta
 		l:                 log.With(zap.String("component", "task checker")),
 		w:                 w,
 		subtaskAutoResume: map[string]*AutoResumeInfo{},
+		resetBreakers:     map[string]struct{}{},
+		autoResumeStatus:  map[string]pb.SubTaskStatus{},
 	}
 	tsc.closed.Store(true)
 	return tsc
@@ -172,6 +259,26 @@ func (tsc *realTaskStatusChecker) Close() {
 	tsc.wg.Wait()
 }
 
+// ResetBreaker implements TaskStatusChecker.ResetBreaker.
+func (tsc *realTaskStatusChecker) ResetBreaker(taskName string) {
+	tsc.mu.Lock()
+	defer tsc.mu.Unlock()
+	tsc.resetBreakers[taskName] = struct{}{}
+}
+
+// FillAutoResumeStatus implements TaskStatusChecker.FillAutoResumeStatus.
+func (tsc *realTaskStatusChecker) FillAutoResumeStatus(stStatus *pb.SubTaskStatus) {
+	if stStatus.Stage != pb.Stage_Paused {
+		return
+	}
+	tsc.mu.Lock()
+	defer tsc.mu.Unlock()
+	if status, ok := tsc.autoResumeStatus[stStatus.Name]; ok {
+		stStatus.AutoResumeBreaker = status.AutoResumeBreaker
+		stStatus.NextAutoResumeTime = status.NextAutoResumeTime
+	}
+}
+
 func (tsc *realTaskStatusChecker) run() {
 	// keep running until canceled in `Close`.
 	tsc.ctx, tsc.cancel = context.WithCancel(context.Background())
@@ -228,11 +335,23 @@ func (i *AutoResumeInfo) CheckResumeSubtask(
 		}
 	}
 
+	if i.BreakerOpen {
+		// the subtask is paused by a different error now, e.g. after its config is changed.
+		if errorsID(stStatus.Result.Errors) == i.LatestErrorID {
+			return ResumeBreak
+		}
+		i.ResetBreaker()
+	}
+
 	// auto resume interval does not exceed backoff duration, skip this paused task
 	if time.Since(i.LatestResumeTime) < i.Backoff.Current() {
 		return ResumeSkip
 	}
 
+	i.countError(stStatus.Result.Errors)
+	if i.BreakerOpen {
+		return ResumeBreak
+	}
 	return ResumeDispatch
 }
 
@@ -267,6 +386,9 @@ func (i *AutoResumeInfo) update(strategy ResumeStrategy, backoffRollback time.Du
 	case ResumeIgnore:
 		if time.Since(i.LatestPausedTime) > backoffRollback {
 			i.Backoff.Rollback()
+			// the task keeps running for a while, the previous errors are not consecutive.
+			i.LatestErrorID = ""
+			i.SameErrorCount = 0
 			// after each rollback, reset this timer
 			i.LatestPausedTime = time.Now()
 		}
@@ -276,7 +398,7 @@ func (i *AutoResumeInfo) update(strategy ResumeStrategy, backoffRollback time.Du
 		if i.LatestBlockTime.IsZero() {
 			i.LatestBlockTime = time.Now()
 		}
-	case ResumeSkip, ResumeDispatch:
+	case ResumeSkip, ResumeDispatch, ResumeBreak:
 		i.LatestPausedTime = time.Now()
 	}
 }
@@ -320,7 +442,17 @@ func (tsc *realTaskStatusChecker) checkRelayStatus() {
 func (tsc *realTaskStatusChecker) checkTaskStatus() {
 	allSubTaskStatus := tsc.w.getAllSubTaskStatus()
 
+	tsc.mu.Lock()
+	resetBreakers := tsc.resetBreakers
+	tsc.resetBreakers = map[string]struct{}{}
+	tsc.mu.Unlock()
+	autoResumeStatus := make(map[string]pb.SubTaskStatus, len(allSubTaskStatus))
+
 	defer func() {
+		tsc.mu.Lock()
+		tsc.autoResumeStatus = autoResumeStatus
+		tsc.mu.Unlock()
+
 		// cleanup outdated tasks
 		for taskName := range tsc.subtaskAutoResume {
 			_, ok := allSubTaskStatus[taskName]
@@ -343,9 +475,14 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 				Backoff:          bf,
 				LatestPausedTime: time.Now(),
 				LatestResumeTime: time.Now(),
+				BreakerThreshold: tsc.cfg.BreakerThreshold,
 			}
 			tsc.subtaskAutoResume[taskName] = info
 		}
+		if _, ok := resetBreakers[taskName]; ok && info.BreakerOpen {
+			tsc.l.Info("close auto resume breaker of task resumed manually", zap.String("task", taskName))
+			info.ResetBreaker()
+		}
 		strategy := info.CheckResumeSubtask(stStatus, tsc.cfg.BackoffRollback.Duration)
 		switch strategy {
 		case ResumeNoSense:
@@ -366,8 +503,29 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 				info.LatestResumeTime = time.Now()
 				info.Backoff.BoundaryForward()
 			}
+		case ResumeBreak:
+			tsc.l.Warn("auto resume breaker is open, resume task manually",
+				zap.String("task", taskName),
+				zap.Int("sameErrorCount", info.SameErrorCount),
+				zap.String("errorID", info.LatestErrorID))
 		}
+		autoResumeStatus[taskName] = info.status(strategy)
+	}
+}
+
+// status returns the auto resume status of a subtask checked with strategy.
+func (i *AutoResumeInfo) status(strategy ResumeStrategy) pb.SubTaskStatus {
+	var status pb.SubTaskStatus
+	switch strategy {
+	case ResumeBreak:
+		status.AutoResumeBreaker = autoResumeBreakerOpen
+	case ResumeDispatch:
+		status.AutoResumeBreaker = autoResumeBreakerClosed
+	case ResumeSkip:
+		status.AutoResumeBreaker = autoResumeBreakerClosed
+		status.NextAutoResumeTime = i.NextResumeTime().Format(time.RFC3339)
 	}
+	return status
 }
 
 func (tsc *realTaskStatusChecker) check() {
@@ -288,3 +288,67 @@ func TestCheckTaskIndependent(t *testing.T) {
 	require.Len(t, rtsc.subtaskAutoResume, 1)
 	require.True(t, rtsc.subtaskAutoResume[task2].LatestBlockTime.IsZero())
 }
+
+func TestAutoResumeBreaker(t *testing.T) {
+	bf, err := backoff.NewBackoff(1, false, time.Millisecond, time.Millisecond)
+	require.NoError(t, err)
+	info := &AutoResumeInfo{
+		Backoff:          bf,
+		LatestPausedTime: time.Now(),
+		LatestResumeTime: time.Now().Add(-time.Second),
+		BreakerThreshold: 3,
+	}
+	pausedBy := func(msg string) *pb.SubTaskStatus {
+		return &pb.SubTaskStatus{
+			Name:   "test-task",
+			Stage:  pb.Stage_Paused,
+			Result: &pb.ProcessResult{Errors: []*pb.ProcessError{unit.NewProcessError(errors.New(msg))}},
+		}
+	}
+	check := func(status *pb.SubTaskStatus) ResumeStrategy {
+		info.LatestResumeTime = time.Now().Add(-time.Second)
+		return info.CheckResumeSubtask(status, time.Minute)
+	}
+
+	// the errors differ only in digits are identical.
+	require.Equal(t, ResumeDispatch, check(pausedBy("connection 10 is closed at pos 1234")))
+	require.Equal(t, ResumeDispatch, check(pausedBy("connection 11 is closed at pos 5678")))
+	require.Equal(t, 2, info.SameErrorCount)
+	require.Equal(t, ResumeBreak, check(pausedBy("connection 12 is closed at pos 9012")))
+	require.True(t, info.BreakerOpen)
+	require.True(t, info.NextResumeTime().IsZero())
+	require.Equal(t, autoResumeBreakerOpen, info.status(ResumeBreak).AutoResumeBreaker)
+	require.Equal(t, ResumeBreak, check(pausedBy("connection 13 is closed at pos 3456")))
+
+	// a different error closes the breaker.
+	require.Equal(t, ResumeDispatch, check(pausedBy("table not found")))
+	require.False(t, info.BreakerOpen)
+	require.Equal(t, 1, info.SameErrorCount)
+	require.Equal(t, ResumeDispatch, check(pausedBy("table not found")))
+	require.Equal(t, ResumeBreak, check(pausedBy("table not found")))
+
+	// a manual resume closes the breaker.
+	info.ResetBreaker()
+	require.Equal(t, ResumeDispatch, check(pausedBy("table not found")))
+	status := info.status(ResumeSkip)
+	require.Equal(t, autoResumeBreakerClosed, status.AutoResumeBreaker)
+	require.Equal(t, info.NextResumeTime().Format(time.RFC3339), status.NextAutoResumeTime)
+
+	// the breaker never opens if the threshold is 0.
+	info.BreakerThreshold = 0
+	for i := 0; i < 10; i++ {
+		require.Equal(t, ResumeDispatch, check(pausedBy("table not found")))
+	}
+
+	// the checker only fills the status of paused subtasks.
+	tsc := NewRealTaskStatusChecker(config.CheckerConfig{}, nil)
+	rtsc := tsc.(*realTaskStatusChecker)
+	rtsc.autoResumeStatus["test-task"] = info.status(ResumeBreak)
+	running := &pb.SubTaskStatus{Name: "test-task", Stage: pb.Stage_Running}
+	tsc.FillAutoResumeStatus(running)
+	require.Empty(t, running.AutoResumeBreaker)
+	paused := pausedBy("table not found")
+	tsc.FillAutoResumeStatus(paused)
+	require.Equal(t, autoResumeBreakerOpen, paused.AutoResumeBreaker)
+	require.Empty(t, paused.NextAutoResumeTime)
+}
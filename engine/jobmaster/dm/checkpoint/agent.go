@@ -23,6 +23,7 @@ import (
 	dmconfig "github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/engine/framework"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/engine/jobmaster/dm/bootstrap"
@@ -45,6 +46,7 @@ const (
 		binlog_name VARCHAR(128),
 		binlog_pos INT UNSIGNED,
 		binlog_gtid TEXT,
+		binlog_suffix INT UNSIGNED DEFAULT 0,
 		exit_safe_binlog_name VARCHAR(128) DEFAULT '',
 		exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
 		exit_safe_binlog_gtid TEXT,
@@ -54,6 +56,9 @@ const (
 		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 		UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
 	)`
+	// syncCheckpointAddSuffix adds the column which is added to the syncer checkpoint table after
+	// the table is created, for the tables created by the previous versions.
+	syncCheckpointAddSuffix = `ALTER TABLE %s ADD COLUMN binlog_suffix INT UNSIGNED DEFAULT 0 AFTER binlog_gtid`
 )
 
 // NewCheckpointAgent is a method to create a new checkpoint agent
@@ -168,7 +173,16 @@ func createLoadCheckpointTable(ctx context.Context, jobID string, cfg *config.Jo
 }
 
 func createSyncCheckpointTable(ctx context.Context, jobID string, cfg *config.JobCfg, db *conn.BaseDB) error {
-	_, err := db.DB.ExecContext(ctx, fmt.Sprintf(syncCheckpointTable, syncTableName(jobID, cfg)))
+	tableName := syncTableName(jobID, cfg)
+	if _, err := db.DB.ExecContext(ctx, fmt.Sprintf(syncCheckpointTable, tableName)); err != nil {
+		return err
+	}
+	// the table may be created by the previous versions, the syncer doesn't upgrade it
+	// because it's created here.
+	_, err := db.DB.ExecContext(ctx, fmt.Sprintf(syncCheckpointAddSuffix, tableName))
+	if err != nil && utils.IgnoreErrorCheckpoint(err) {
+		return nil
+	}
 	return err
 }
 
@@ -21,7 +21,9 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/errno"
 	dmconfig "github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
@@ -66,6 +68,7 @@ func TestCheckpoint(t *testing.T) {
 		binlog_name VARCHAR(128),
 		binlog_pos INT UNSIGNED,
 		binlog_gtid TEXT,
+		binlog_suffix INT UNSIGNED DEFAULT 0,
 		exit_safe_binlog_name VARCHAR(128) DEFAULT '',
 		exit_safe_binlog_pos INT UNSIGNED DEFAULT 0,
 		exit_safe_binlog_gtid TEXT,
@@ -75,8 +78,18 @@ func TestCheckpoint(t *testing.T) {
 		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 		UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
 	)`, "`meta`.`test_syncer_checkpoint`"))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `meta`.`test_syncer_checkpoint` ADD COLUMN binlog_suffix INT UNSIGNED DEFAULT 0 AFTER binlog_gtid")).
+		WillReturnError(&mysql.MySQLError{Number: errno.ErrDupFieldName, Message: "Duplicate column name 'binlog_suffix'"})
 	require.NoError(t, createSyncCheckpointTable(context.Background(), jobID, jobCfg, conn.NewBaseDBForTest(db)))
 
+	// the column is added to the table created by the previous versions.
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `meta`.`test_syncer_checkpoint`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `meta`.`test_syncer_checkpoint` ADD COLUMN binlog_suffix")).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, createSyncCheckpointTable(context.Background(), jobID, jobCfg, conn.NewBaseDBForTest(db)))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `meta`.`test_syncer_checkpoint`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `meta`.`test_syncer_checkpoint` ADD COLUMN binlog_suffix")).WillReturnError(errors.New("invalid connection"))
+	require.Error(t, createSyncCheckpointTable(context.Background(), jobID, jobCfg, conn.NewBaseDBForTest(db)))
+
 	mock.ExpectExec(regexp.QuoteMeta("DROP TABLE IF EXISTS `meta`.`test_lightning_checkpoint_list`")).WillReturnResult(sqlmock.NewResult(1, 1))
 	require.NoError(t, dropLoadCheckpointTable(context.Background(), jobID, jobCfg, conn.NewBaseDBForTest(db)))
 
@@ -129,6 +142,7 @@ func TestCheckpointLifeCycle(t *testing.T) {
 	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
 	require.NoError(t, checkpointAgent.Create(context.Background(), jobCfg2))
 	require.NoError(t, mock.ExpectationsWereMet())
 
@@ -147,6 +161,7 @@ func TestCheckpointLifeCycle(t *testing.T) {
 	require.NoError(t, err)
 	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(".*").WillReturnResult(sqlmock.NewResult(1, 1))
 	require.NoError(t, checkpointAgent.Create(context.Background(), jobCfg))
 	require.NoError(t, mock.ExpectationsWereMet())
 
@@ -60,6 +60,7 @@ type Request struct {
 	backoffBaseDelay time.Duration
 	backoffMaxDelay  time.Duration
 	maxRetries       uint64
+	isRetryableErr   func(error) bool
 
 	// output
 	err  error
@@ -203,6 +204,16 @@ func (r *Request) WithMaxRetries(maxRetries uint64) *Request {
 	return r
 }
 
+// WithIsRetryableErr specifies the errors to retry, by default all errors
+// except the context ones are retried.
+func (r *Request) WithIsRetryableErr(f func(error) bool) *Request {
+	if r.err != nil {
+		return r
+	}
+	r.isRetryableErr = f
+	return r
+}
+
 // WithBody makes http request use obj as its body.
 // only supports two types now:
 //  1. io.Reader
@@ -288,6 +299,10 @@ func (r *Request) Do(ctx context.Context) (res *Result) {
 	if maxRetries <= 0 {
 		maxRetries = defaultMaxRetries
 	}
+	isRetryableErr := r.isRetryableErr
+	if isRetryableErr == nil {
+		isRetryableErr = cerrors.IsRetryableError
+	}
 
 	fn := func() error {
 		req, err := r.newHTTPRequest(ctx)
@@ -330,7 +345,7 @@ func (r *Request) Do(ctx context.Context) (res *Result) {
 			retry.WithBackoffBaseDelay(baseDelay),
 			retry.WithBackoffMaxDelay(maxDelay),
 			retry.WithMaxTries(maxRetries),
-			retry.WithIsRetryableErr(cerrors.IsRetryableError),
+			retry.WithIsRetryableErr(isRetryableErr),
 		)
 	} else {
 		err = fn()
@@ -372,7 +387,7 @@ func (r *Request) checkResponse(resp *http.Response) *Result {
 			body:        body,
 			contentType: contentType,
 			statusCode:  resp.StatusCode,
-			err:         err,
+			err:         &statusError{code: resp.StatusCode, err: err},
 		}
 	}
 
@@ -383,6 +398,32 @@ func (r *Request) checkResponse(resp *http.Response) *Result {
 	}
 }
 
+// statusError is the error of a response with a non-2xx status code.
+type statusError struct {
+	code int
+	err  error
+}
+
+// Error implements the error interface.
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// StatusCode returns the status code of the response which err is returned
+// for, or 0 if err is not returned for a response, e.g. the connection fails.
+func StatusCode(err error) int {
+	var e *statusError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return 0
+}
+
 // Result contains the result of calling Request.Do().
 type Result struct {
 	body        []byte
@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -145,6 +146,47 @@ func TestRequestDoContextTimeout(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestRequestDoRetry(t *testing.T) {
+	var calls atomic.Int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	c, err := CDCRESTClientFromConfig(&Config{
+		Host:    testServer.URL,
+		APIPath: "/api",
+		Version: "v1",
+	})
+	require.Nil(t, err)
+	err = c.Get().
+		WithPrefix("/test").
+		WithMaxRetries(3).
+		WithBackoffBaseDelay(time.Millisecond).
+		Do(context.Background()).
+		Error()
+	require.Nil(t, err)
+	require.Equal(t, int32(3), calls.Load())
+
+	// the errors not retryable are returned immediately.
+	calls.Store(0)
+	err = c.Get().
+		WithPrefix("/test").
+		WithMaxRetries(3).
+		WithBackoffBaseDelay(time.Millisecond).
+		WithIsRetryableErr(func(error) bool { return false }).
+		Do(context.Background()).
+		Error()
+	require.NotNil(t, err)
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, http.StatusServiceUnavailable, StatusCode(err))
+	require.Equal(t, 0, StatusCode(errors.New("test-error")))
+}
+
 func TestResultIntoError(t *testing.T) {
 	result := Result{err: errors.New("test-error")}
 	err := result.Into(&testStruct{})
@@ -14,8 +14,13 @@
 package v2
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/pkg/api/internal/rest"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/security"
 )
 
@@ -26,6 +31,8 @@ type APIV2Interface interface {
 	ChangefeedsGetter
 	TsoGetter
 	UnsafeGetter
+	OwnerGetter
+	StatusGetter
 }
 
 // APIV2Client implements APIV1Interface and it is used to interact with cdc owner http api.
@@ -66,8 +73,60 @@ func (c *APIV2Client) Changefeeds() ChangefeedInterface {
 	return newChangefeeds(c)
 }
 
-// NewAPIClient creates a new APIV1Client.
-func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Client, error) {
+// Owner returns a OwnerInterface to communicate with cdc api
+func (c *APIV2Client) Owner() OwnerInterface {
+	if c == nil {
+		return nil
+	}
+	return newOwner(c)
+}
+
+// Status returns a StatusInterface to communicate with cdc api
+func (c *APIV2Client) Status() StatusInterface {
+	if c == nil {
+		return nil
+	}
+	return newStatus(c)
+}
+
+// ClientOption configures the APIV2Client created by NewAPIClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	timeout          time.Duration
+	maxRetries       uint64
+	backoffBaseDelay time.Duration
+	backoffMaxDelay  time.Duration
+}
+
+// WithTimeout specifies the timeout of every request, including its retries.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetry makes the client try a request at most `maxRetries` times with
+// an exponential backoff between `baseDelay` and `maxDelay`. The GET requests
+// are retried if the connection fails or the server responds with 5xx or 429,
+// and the others are only retried if they are refused before being handled by
+// the owner, e.g. the owner is being elected, since they are not idempotent.
+// Zero delays fall back to the default ones.
+func WithRetry(maxRetries uint64, baseDelay, maxDelay time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.maxRetries = maxRetries
+		o.backoffBaseDelay = baseDelay
+		o.backoffMaxDelay = maxDelay
+	}
+}
+
+// NewAPIClient creates a new APIV2Client. The TLS of the client is configured
+// by `credential`, and it's nil if TLS is not enabled. Note that the requests
+// to the changefeeds are forwarded to the owner by the server, so any server
+// of the cluster can be used.
+func NewAPIClient(
+	serverAddr string, credential *security.Credential, opts ...ClientOption,
+) (*APIV2Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v2"
@@ -78,5 +137,93 @@ func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Cli
 		return nil, errors.Trace(err)
 	}
 
-	return &APIV2Client{client}, nil
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.timeout == 0 && o.maxRetries <= 1 {
+		return &APIV2Client{client}, nil
+	}
+	return &APIV2Client{&retryingClient{CDCRESTClient: client, opts: o}}, nil
+}
+
+// retryingClient applies the clientOptions to every request.
+type retryingClient struct {
+	*rest.CDCRESTClient
+	opts *clientOptions
+}
+
+// Method implements rest.CDCRESTInterface.
+func (c *retryingClient) Method(method rest.HTTPMethod) *rest.Request {
+	req := c.CDCRESTClient.Method(method)
+	if c.opts.timeout > 0 {
+		req = req.WithTimeout(c.opts.timeout)
+	}
+	if c.opts.maxRetries <= 1 {
+		return req
+	}
+	req = req.WithMaxRetries(c.opts.maxRetries).
+		WithBackoffBaseDelay(c.opts.backoffBaseDelay).
+		WithBackoffMaxDelay(c.opts.backoffMaxDelay)
+	if method == rest.HTTPMethodGet {
+		req = req.WithIsRetryableErr(isUnavailableErr)
+	} else {
+		req = req.WithIsRetryableErr(isRefusedByOwnerErr)
+	}
+	return req
+}
+
+// Post implements rest.CDCRESTInterface.
+func (c *retryingClient) Post() *rest.Request {
+	return c.Method(rest.HTTPMethodPost)
+}
+
+// Put implements rest.CDCRESTInterface.
+func (c *retryingClient) Put() *rest.Request {
+	return c.Method(rest.HTTPMethodPut)
+}
+
+// Get implements rest.CDCRESTInterface.
+func (c *retryingClient) Get() *rest.Request {
+	return c.Method(rest.HTTPMethodGet)
+}
+
+// Delete implements rest.CDCRESTInterface.
+func (c *retryingClient) Delete() *rest.Request {
+	return c.Method(rest.HTTPMethodDelete)
+}
+
+// isUnavailableErr returns true if the connection fails or the server is
+// unavailable for now. The other 4xx responses never succeed by retrying.
+func isUnavailableErr(err error) bool {
+	if !cerrors.IsRetryableError(err) {
+		return false
+	}
+	code := rest.StatusCode(err)
+	return code == 0 || code == http.StatusTooManyRequests ||
+		code >= http.StatusInternalServerError
+}
+
+// refusedByOwnerErrs are the errors returned if a request can't be forwarded
+// to the owner, so that it's not handled yet and is safe to retry.
+var refusedByOwnerErrs = []*errors.Error{
+	cerrors.ErrOwnerNotFound,
+	cerrors.ErrNotOwner,
+	cerrors.ErrRequestForwardErr,
+	cerrors.ErrServerIsNotReady,
+}
+
+// isRefusedByOwnerErr returns true if the request is refused before it's
+// handled by the owner. The server only returns the messages of the errors,
+// so they are matched by the RFC codes.
+func isRefusedByOwnerErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, e := range refusedByOwnerErrs {
+		if strings.Contains(err.Error(), string(e.RFCCode())) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,140 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func writeJSON(rw http.ResponseWriter, code int, obj interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(code)
+	_ = json.NewEncoder(rw).Encode(obj)
+}
+
+func TestAPIClientOwnerAndStatus(t *testing.T) {
+	var resignQuery string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/owner/resign", func(rw http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		resignQuery = r.URL.RawQuery
+		rw.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/api/v2/status", func(rw http.ResponseWriter, r *http.Request) {
+		writeJSON(rw, http.StatusOK, &v2.ServerStatus{ID: "capture-1", IsOwner: true})
+	})
+	mux.HandleFunc("/api/v2/security/certificate", func(rw http.ResponseWriter, r *http.Request) {
+		writeJSON(rw, http.StatusOK, &v2.CertificateStatus{TLSEnabled: true})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := NewAPIClient(server.URL, nil)
+	require.Nil(t, err)
+
+	require.Nil(t, client.Owner().Resign(ctx, true, time.Minute))
+	require.Equal(t, "graceful=true&timeout=1m0s", resignQuery)
+	require.Nil(t, client.Owner().Resign(ctx, false, 0))
+	require.Equal(t, "graceful=false", resignQuery)
+
+	status, err := client.Status().Get(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "capture-1", status.ID)
+	require.True(t, status.IsOwner)
+
+	cert, err := client.Status().GetCertificate(ctx)
+	require.Nil(t, err)
+	require.True(t, cert.TLSEnabled)
+}
+
+func TestAPIClientRetry(t *testing.T) {
+	var (
+		calls   atomic.Int32
+		code    atomic.Int32
+		failure atomic.Value
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			writeJSON(rw, int(code.Load()),
+				model.NewHTTPError(failure.Load().(error)))
+			return
+		}
+		writeJSON(rw, http.StatusOK, &v2.ServerStatus{ID: "capture-1"})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := NewAPIClient(server.URL, nil,
+		WithRetry(3, time.Millisecond, 10*time.Millisecond), WithTimeout(10*time.Second))
+	require.Nil(t, err)
+
+	// the GET requests are retried on 5xx and 429.
+	code.Store(http.StatusInternalServerError)
+	failure.Store(cerrors.ErrInternalServerError.FastGenByArgs())
+	status, err := client.Status().Get(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "capture-1", status.ID)
+	require.Equal(t, int32(3), calls.Load())
+
+	calls.Store(0)
+	code.Store(http.StatusTooManyRequests)
+	_, err = client.Status().Get(ctx)
+	require.Nil(t, err)
+	require.Equal(t, int32(3), calls.Load())
+
+	// but not on the other 4xx.
+	calls.Store(0)
+	code.Store(http.StatusBadRequest)
+	_, err = client.Status().Get(ctx)
+	require.NotNil(t, err)
+	require.Equal(t, int32(1), calls.Load())
+
+	// and they are retried if the connection fails.
+	require.True(t, isUnavailableErr(errors.New("connection refused")))
+	require.False(t, isUnavailableErr(context.Canceled))
+
+	code.Store(http.StatusInternalServerError)
+
+	// the other requests are only retried if they are not handled by the owner.
+	calls.Store(0)
+	err = client.Owner().Resign(ctx, false, 0)
+	require.NotNil(t, err)
+	require.Equal(t, int32(1), calls.Load())
+
+	calls.Store(0)
+	failure.Store(cerrors.ErrOwnerNotFound.FastGenByArgs())
+	require.Nil(t, client.Owner().Resign(ctx, false, 0))
+	require.Equal(t, int32(3), calls.Load())
+
+	// the requests are not retried by default.
+	calls.Store(0)
+	client, err = NewAPIClient(server.URL, nil)
+	require.Nil(t, err)
+	_, err = client.Status().Get(ctx)
+	require.NotNil(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
@@ -16,6 +16,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/pkg/api/internal/rest"
@@ -40,6 +41,16 @@ type ChangefeedInterface interface {
 		name string) (*v2.ChangeFeedInfo, error)
 	// Resume resumes a changefeed with given config
 	Resume(ctx context.Context, cfg *v2.ResumeChangefeedConfig, name string) error
+	// GetDDLHistory gets the DDLs emitted by a changefeed since the given ts
+	GetDDLHistory(ctx context.Context, name string, sinceTs uint64) (*v2.ChangefeedDDLHistory, error)
+	// GetBarriers gets the barriers which a changefeed is blocked at
+	GetBarriers(ctx context.Context, name string) (*v2.ChangefeedBarriers, error)
+	// Clone creates a new changefeed from the checkpoint of a changefeed
+	Clone(ctx context.Context, cfg *v2.CloneChangefeedConfig, name string) (*v2.ChangeFeedInfo, error)
+	// Cutover makes a changefeed finish at the target ts and cuts over to a new changefeed
+	Cutover(ctx context.Context, cfg *v2.CutoverChangefeedConfig, name string) (*v2.ChangefeedCutover, error)
+	// GetCutover gets the progress of the cutover of a changefeed
+	GetCutover(ctx context.Context, name string) (*v2.ChangefeedCutover, error)
 }
 
 // changefeeds implements ChangefeedInterface
@@ -89,6 +100,74 @@ func (c *changefeeds) GetInfo(ctx context.Context,
 	return result, err
 }
 
+// GetDDLHistory gets the DDLs emitted by a changefeed since the given ts
+func (c *changefeeds) GetDDLHistory(ctx context.Context,
+	name string, sinceTs uint64,
+) (*v2.ChangefeedDDLHistory, error) {
+	result := &v2.ChangefeedDDLHistory{}
+	u := fmt.Sprintf("changefeeds/%s/ddl-history", name)
+	err := c.client.Get().
+		WithURI(u).
+		WithParam("since_ts", strconv.FormatUint(sinceTs, 10)).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// GetBarriers gets the barriers which a changefeed is blocked at
+func (c *changefeeds) GetBarriers(ctx context.Context,
+	name string,
+) (*v2.ChangefeedBarriers, error) {
+	result := &v2.ChangefeedBarriers{}
+	u := fmt.Sprintf("changefeeds/%s/barriers", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Clone creates a new changefeed from the checkpoint of a changefeed
+func (c *changefeeds) Clone(ctx context.Context,
+	cfg *v2.CloneChangefeedConfig, name string,
+) (*v2.ChangeFeedInfo, error) {
+	result := &v2.ChangeFeedInfo{}
+	u := fmt.Sprintf("changefeeds/%s/clone", name)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Cutover makes a changefeed finish at the target ts and cuts over to a new changefeed
+func (c *changefeeds) Cutover(ctx context.Context,
+	cfg *v2.CutoverChangefeedConfig, name string,
+) (*v2.ChangefeedCutover, error) {
+	result := &v2.ChangefeedCutover{}
+	u := fmt.Sprintf("changefeeds/%s/cutover", name)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// GetCutover gets the progress of the cutover of a changefeed
+func (c *changefeeds) GetCutover(ctx context.Context,
+	name string,
+) (*v2.ChangefeedCutover, error) {
+	result := &v2.ChangefeedCutover{}
+	u := fmt.Sprintf("changefeeds/%s/cutover", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
 func (c *changefeeds) Update(ctx context.Context,
 	cfg *v2.ChangefeedConfig, name string,
 ) (*v2.ChangeFeedInfo, error) {
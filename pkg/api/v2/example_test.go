@@ -0,0 +1,58 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/security"
+)
+
+func ExampleNewAPIClient() {
+	// the credential is nil if TLS is not enabled.
+	credential := &security.Credential{
+		CAPath:   "/path/to/ca.pem",
+		CertPath: "/path/to/client.pem",
+		KeyPath:  "/path/to/client-key.pem",
+	}
+	client, err := apiv2client.NewAPIClient("127.0.0.1:8300", credential,
+		apiv2client.WithTimeout(30*time.Second),
+		apiv2client.WithRetry(5, 500*time.Millisecond, 10*time.Second))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	ctx := context.Background()
+	info, err := client.Changefeeds().Create(ctx, &v2.ChangefeedConfig{
+		ID:      "my-changefeed",
+		SinkURI: "blackhole://",
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(info.ID, info.State)
+
+	history, err := client.Changefeeds().GetDDLHistory(ctx, info.ID, 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(len(history.Entries))
+}
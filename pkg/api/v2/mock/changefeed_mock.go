@@ -73,6 +73,21 @@ func (m *MockChangefeedInterface) EXPECT() *MockChangefeedInterfaceMockRecorder
 	return m.recorder
 }
 
+// Clone mocks base method.
+func (m *MockChangefeedInterface) Clone(ctx context.Context, cfg *v2.CloneChangefeedConfig, name string) (*v2.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, cfg, name)
+	ret0, _ := ret[0].(*v2.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockChangefeedInterfaceMockRecorder) Clone(ctx, cfg, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockChangefeedInterface)(nil).Clone), ctx, cfg, name)
+}
+
 // Create mocks base method.
 func (m *MockChangefeedInterface) Create(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +103,66 @@ func (mr *MockChangefeedInterfaceMockRecorder) Create(ctx, cfg interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChangefeedInterface)(nil).Create), ctx, cfg)
 }
 
+// Cutover mocks base method.
+func (m *MockChangefeedInterface) Cutover(ctx context.Context, cfg *v2.CutoverChangefeedConfig, name string) (*v2.ChangefeedCutover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cutover", ctx, cfg, name)
+	ret0, _ := ret[0].(*v2.ChangefeedCutover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cutover indicates an expected call of Cutover.
+func (mr *MockChangefeedInterfaceMockRecorder) Cutover(ctx, cfg, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cutover", reflect.TypeOf((*MockChangefeedInterface)(nil).Cutover), ctx, cfg, name)
+}
+
+// GetBarriers mocks base method.
+func (m *MockChangefeedInterface) GetBarriers(ctx context.Context, name string) (*v2.ChangefeedBarriers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBarriers", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedBarriers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBarriers indicates an expected call of GetBarriers.
+func (mr *MockChangefeedInterfaceMockRecorder) GetBarriers(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBarriers", reflect.TypeOf((*MockChangefeedInterface)(nil).GetBarriers), ctx, name)
+}
+
+// GetCutover mocks base method.
+func (m *MockChangefeedInterface) GetCutover(ctx context.Context, name string) (*v2.ChangefeedCutover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCutover", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedCutover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCutover indicates an expected call of GetCutover.
+func (mr *MockChangefeedInterfaceMockRecorder) GetCutover(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCutover", reflect.TypeOf((*MockChangefeedInterface)(nil).GetCutover), ctx, name)
+}
+
+// GetDDLHistory mocks base method.
+func (m *MockChangefeedInterface) GetDDLHistory(ctx context.Context, name string, sinceTs uint64) (*v2.ChangefeedDDLHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDDLHistory", ctx, name, sinceTs)
+	ret0, _ := ret[0].(*v2.ChangefeedDDLHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDDLHistory indicates an expected call of GetDDLHistory.
+func (mr *MockChangefeedInterfaceMockRecorder) GetDDLHistory(ctx, name, sinceTs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDDLHistory", reflect.TypeOf((*MockChangefeedInterface)(nil).GetDDLHistory), ctx, name, sinceTs)
+}
+
 // GetInfo mocks base method.
 func (m *MockChangefeedInterface) GetInfo(ctx context.Context, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,58 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/api/internal/rest"
+)
+
+// OwnerGetter has a method to return a OwnerInterface.
+type OwnerGetter interface {
+	Owner() OwnerInterface
+}
+
+// OwnerInterface has methods to work with the owner
+type OwnerInterface interface {
+	// Resign makes the current owner resign. If `graceful` is true, the owner
+	// waits for the in-flight DDLs and admin jobs at most `timeout` before
+	// resigning, zero `timeout` falls back to the default one of the server.
+	Resign(ctx context.Context, graceful bool, timeout time.Duration) error
+}
+
+// owner implements OwnerInterface
+type owner struct {
+	client rest.CDCRESTInterface
+}
+
+// newOwner returns owner
+func newOwner(c *APIV2Client) *owner {
+	return &owner{
+		client: c.RESTClient(),
+	}
+}
+
+// Resign makes the current owner resign
+func (c *owner) Resign(ctx context.Context, graceful bool, timeout time.Duration) error {
+	req := c.client.Post().
+		WithURI("owner/resign").
+		WithParam("graceful", strconv.FormatBool(graceful))
+	if timeout > 0 {
+		req = req.WithParam("timeout", timeout.String())
+	}
+	return req.Do(ctx).Error()
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/internal/rest"
+)
+
+// StatusGetter has a method to return a StatusInterface.
+type StatusGetter interface {
+	Status() StatusInterface
+}
+
+// StatusInterface has methods to get the status of the server
+type StatusInterface interface {
+	// Get gets the status of the server which the client connects to
+	Get(ctx context.Context) (*v2.ServerStatus, error)
+	// GetCertificate gets the status of the TLS certificate of the server
+	GetCertificate(ctx context.Context) (*v2.CertificateStatus, error)
+}
+
+// status implements StatusInterface
+type status struct {
+	client rest.CDCRESTInterface
+}
+
+// newStatus returns status
+func newStatus(c *APIV2Client) *status {
+	return &status{
+		client: c.RESTClient(),
+	}
+}
+
+// Get gets the status of the server
+func (c *status) Get(ctx context.Context) (*v2.ServerStatus, error) {
+	result := new(v2.ServerStatus)
+	err := c.client.Get().
+		WithURI("status").
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// GetCertificate gets the status of the TLS certificate of the server
+func (c *status) GetCertificate(ctx context.Context) (*v2.CertificateStatus, error) {
+	result := new(v2.CertificateStatus)
+	err := c.client.Get().
+		WithURI("security/certificate").
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// cloneChangefeedOptions defines flags for the `cli changefeed clone` command.
+type cloneChangefeedOptions struct {
+	apiClient   apiv1client.APIV1Interface
+	apiClientV2 apiv2client.APIV2Interface
+
+	changefeedID    string
+	newChangefeedID string
+	sinkURI         string
+	wait            bool
+	waitTimeout     time.Duration
+}
+
+// newCloneChangefeedOptions creates new options for the `cli changefeed clone` command.
+func newCloneChangefeedOptions() *cloneChangefeedOptions {
+	return &cloneChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *cloneChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID to clone")
+	cmd.PersistentFlags().StringVar(&o.newChangefeedID, "new-changefeed-id", "", "ID of the new replication task (changefeed)")
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "", "Sink URI of the new replication task (changefeed)")
+	cmd.PersistentFlags().BoolVar(&o.wait, "wait", false, "Wait until the new changefeed advances its checkpoint")
+	cmd.PersistentFlags().DurationVar(&o.waitTimeout, "wait-timeout", 10*time.Minute, "Timeout of waiting for the new changefeed")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("new-changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("sink-uri")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *cloneChangefeedOptions) complete(f factory.Factory) error {
+	clientV1, err := f.APIV1Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = clientV1
+	clientV2, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClientV2 = clientV2
+	return nil
+}
+
+// run the `cli changefeed clone` command.
+func (o *cloneChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.Background()
+	info, err := o.apiClientV2.Changefeeds().Clone(ctx, &v2.CloneChangefeedConfig{
+		ID:      o.newChangefeedID,
+		SinkURI: o.sinkURI,
+	}, o.changefeedID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Printf("Clone changefeed %s to %s from checkpoint %d successfully!\n",
+		o.changefeedID, info.ID, info.StartTs)
+	if o.wait {
+		if err := o.waitCheckpointAdvanced(ctx, cmd, info.StartTs); err != nil {
+			return err
+		}
+	}
+	return util.JSONPrint(cmd, info)
+}
+
+// waitCheckpointAdvanced waits until the checkpoint of the new changefeed
+// passes its start ts, which means it's replicating to the new sink.
+func (o *cloneChangefeedOptions) waitCheckpointAdvanced(
+	ctx context.Context, cmd *cobra.Command, startTs uint64,
+) error {
+	return waitUntil(ctx, o.waitTimeout, func(ctx context.Context) (bool, error) {
+		detail, err := o.apiClient.Changefeeds().Get(ctx, o.newChangefeedID)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if detail.FeedState == model.StateFailed {
+			return false, errors.Errorf("changefeed %s failed: %v",
+				o.newChangefeedID, detail.RunningError)
+		}
+		if detail.CheckpointTSO <= startTs {
+			return false, nil
+		}
+		cmd.Printf("Changefeed %s advances its checkpoint to %d\n",
+			o.newChangefeedID, detail.CheckpointTSO)
+		return true, nil
+	})
+}
+
+// newCmdCloneChangefeed creates the `cli changefeed clone` command.
+func newCmdCloneChangefeed(f factory.Factory) *cobra.Command {
+	o := newCloneChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "clone",
+		Short: "Clone a replication task (changefeed) from its checkpoint to another sink",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_v1 "github.com/pingcap/tiflow/pkg/api/v1/mock"
+	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedCloneCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfV1 := mock_v1.NewMockChangefeedInterface(ctrl)
+	cfV2 := mock_v2.NewMockChangefeedInterface(ctrl)
+
+	f := &mockFactory{changefeeds: cfV1, changefeedsv2: cfV2}
+
+	o := newCloneChangefeedOptions()
+	o.complete(f)
+	cmd := newCmdCloneChangefeed(f)
+
+	cfV2.EXPECT().Clone(gomock.Any(), &v2.CloneChangefeedConfig{
+		ID:      "green",
+		SinkURI: "kafka://127.0.0.1:9092/topic",
+	}, "blue").Return(&v2.ChangeFeedInfo{ID: "green", StartTs: 100}, nil)
+	gomock.InOrder(
+		cfV1.EXPECT().Get(gomock.Any(), "green").
+			Return(&model.ChangefeedDetail{CheckpointTSO: 100}, nil),
+		cfV1.EXPECT().Get(gomock.Any(), "green").
+			Return(&model.ChangefeedDetail{CheckpointTSO: 105}, nil),
+	)
+	waitInterval = 10 * time.Millisecond
+	o.changefeedID = "blue"
+	o.newChangefeedID = "green"
+	o.sinkURI = "kafka://127.0.0.1:9092/topic"
+	o.wait = true
+	o.waitTimeout = time.Minute
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.Nil(t, o.run(cmd))
+	out, err := io.ReadAll(b)
+	require.Nil(t, err)
+	require.Contains(t, string(out), "advances its checkpoint to 105")
+
+	cfV2.EXPECT().Clone(gomock.Any(), gomock.Any(), "blue").Return(nil, errors.New("test"))
+	require.NotNil(t, o.run(cmd))
+}
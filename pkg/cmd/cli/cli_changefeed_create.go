@@ -161,8 +161,10 @@ func (o *createChangefeedOptions) completeReplicaCfg(
 		if protocol != "" {
 			cfg.Sink.Protocol = protocol
 		}
+		// The encoders of these protocols omit the old values of the tables
+		// not in `old-value-tables`, so it's not forced on if they are set.
 		for _, fp := range config.ForceEnableOldValueProtocols {
-			if cfg.Sink.Protocol == fp {
+			if cfg.Sink.Protocol == fp && len(cfg.OldValueTables) == 0 {
 				log.Warn("Attempting to replicate without old value enabled. CDC will enable old value and continue.", zap.String("protocol", cfg.Sink.Protocol))
 				cfg.EnableOldValue = true
 				break
@@ -0,0 +1,135 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// cutoverChangefeedOptions defines flags for the `cli changefeed cutover` command.
+type cutoverChangefeedOptions struct {
+	apiClientV2 apiv2client.APIV2Interface
+
+	changefeedID    string
+	newChangefeedID string
+	targetTs        uint64
+	wait            bool
+	waitTimeout     time.Duration
+}
+
+// newCutoverChangefeedOptions creates new options for the `cli changefeed cutover` command.
+func newCutoverChangefeedOptions() *cutoverChangefeedOptions {
+	return &cutoverChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *cutoverChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID to finish")
+	cmd.PersistentFlags().StringVar(&o.newChangefeedID, "new-changefeed-id", "",
+		"ID of the replication task (changefeed) to cut over to, "+
+			"only query the cutover of the changefeed if it's not specified")
+	cmd.PersistentFlags().Uint64Var(&o.targetTs, "target-ts", 0, "The ts at which the changefeed is finished")
+	cmd.PersistentFlags().BoolVar(&o.wait, "wait", false, "Wait until the cutover is completed")
+	cmd.PersistentFlags().DurationVar(&o.waitTimeout, "wait-timeout", 10*time.Minute, "Timeout of waiting for the cutover")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *cutoverChangefeedOptions) complete(f factory.Factory) error {
+	clientV2, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClientV2 = clientV2
+	return nil
+}
+
+// validate checks that the provided cutover options are specified as expected.
+func (o *cutoverChangefeedOptions) validate() error {
+	if o.newChangefeedID != "" && o.targetTs == 0 {
+		return errors.New("target-ts is required when new-changefeed-id is specified")
+	}
+	if o.newChangefeedID == "" && o.targetTs != 0 {
+		return errors.New("new-changefeed-id is required when target-ts is specified")
+	}
+	return nil
+}
+
+// run the `cli changefeed cutover` command.
+func (o *cutoverChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.Background()
+	var (
+		cutover *v2.ChangefeedCutover
+		err     error
+	)
+	if o.newChangefeedID != "" {
+		cutover, err = o.apiClientV2.Changefeeds().Cutover(ctx, &v2.CutoverChangefeedConfig{
+			NewChangefeedID: o.newChangefeedID,
+			TargetTs:        o.targetTs,
+		}, o.changefeedID)
+	} else {
+		cutover, err = o.apiClientV2.Changefeeds().GetCutover(ctx, o.changefeedID)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if o.wait && cutover.State != model.CutoverStateCompleted {
+		err = waitUntil(ctx, o.waitTimeout, func(ctx context.Context) (bool, error) {
+			c, err := o.apiClientV2.Changefeeds().GetCutover(ctx, o.changefeedID)
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			cutover = c
+			if cutover.OldState == model.StateFailed {
+				return false, errors.Errorf("changefeed %s failed before the target ts %d",
+					o.changefeedID, cutover.TargetTs)
+			}
+			return cutover.State == model.CutoverStateCompleted, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return util.JSONPrint(cmd, cutover)
+}
+
+// newCmdCutoverChangefeed creates the `cli changefeed cutover` command.
+func newCmdCutoverChangefeed(f factory.Factory) *cobra.Command {
+	o := newCutoverChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "cutover",
+		Short: "Finish a replication task (changefeed) at the target ts and cut over to another one",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
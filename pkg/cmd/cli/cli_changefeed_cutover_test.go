@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedCutoverCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfV2 := mock_v2.NewMockChangefeedInterface(ctrl)
+
+	f := &mockFactory{changefeedsv2: cfV2}
+
+	o := newCutoverChangefeedOptions()
+	o.complete(f)
+	cmd := newCmdCutoverChangefeed(f)
+
+	o.changefeedID = "blue"
+	o.newChangefeedID = "green"
+	require.NotNil(t, o.validate())
+	o.targetTs = 200
+	require.Nil(t, o.validate())
+
+	pending := &v2.ChangefeedCutover{
+		ID: "blue", NewChangefeedID: "green", TargetTs: 200,
+		State: model.CutoverStatePending, OldState: model.StateNormal,
+	}
+	cfV2.EXPECT().Cutover(gomock.Any(), &v2.CutoverChangefeedConfig{
+		NewChangefeedID: "green",
+		TargetTs:        200,
+	}, "blue").Return(pending, nil)
+	gomock.InOrder(
+		cfV2.EXPECT().GetCutover(gomock.Any(), "blue").Return(pending, nil),
+		cfV2.EXPECT().GetCutover(gomock.Any(), "blue").Return(&v2.ChangefeedCutover{
+			ID: "blue", NewChangefeedID: "green", TargetTs: 200,
+			State: model.CutoverStateCompleted, NewCheckpointTs: 201,
+		}, nil),
+	)
+	waitInterval = 10 * time.Millisecond
+	o.wait = true
+	o.waitTimeout = time.Minute
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.Nil(t, o.run(cmd))
+	out, err := io.ReadAll(b)
+	require.Nil(t, err)
+	require.Contains(t, string(out), "\"state\": \"completed\"")
+
+	// query only
+	o.newChangefeedID = ""
+	o.targetTs = 0
+	o.wait = false
+	require.Nil(t, o.validate())
+	cfV2.EXPECT().GetCutover(gomock.Any(), "blue").Return(nil, errors.New("test"))
+	require.NotNil(t, o.run(cmd))
+}
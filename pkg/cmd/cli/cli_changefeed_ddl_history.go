@@ -0,0 +1,83 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// ddlHistoryChangefeedOptions defines flags for the `cli changefeed ddl-history` command.
+type ddlHistoryChangefeedOptions struct {
+	apiClientV2  apiv2client.APIV2Interface
+	changefeedID string
+	sinceTs      uint64
+}
+
+// newDDLHistoryChangefeedOptions creates new options for the `cli changefeed ddl-history` command.
+func newDDLHistoryChangefeedOptions() *ddlHistoryChangefeedOptions {
+	return &ddlHistoryChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *ddlHistoryChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Uint64Var(&o.sinceTs, "since-ts", 0, "Only output the DDLs whose commit ts is not less than since-ts")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *ddlHistoryChangefeedOptions) complete(f factory.Factory) error {
+	clientV2, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClientV2 = clientV2
+	return nil
+}
+
+// run the `cli changefeed ddl-history` command.
+func (o *ddlHistoryChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.Background()
+	history, err := o.apiClientV2.Changefeeds().GetDDLHistory(ctx, o.changefeedID, o.sinceTs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return util.JSONPrint(cmd, history)
+}
+
+// newCmdDDLHistoryChangefeed creates the `cli changefeed ddl-history` command.
+func newCmdDDLHistoryChangefeed(f factory.Factory) *cobra.Command {
+	o := newDDLHistoryChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "ddl-history",
+		Short: "Query the DDLs emitted by a replication task (changefeed)",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
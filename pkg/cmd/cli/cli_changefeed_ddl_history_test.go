@@ -0,0 +1,57 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedDDLHistoryCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfV2 := mock_v2.NewMockChangefeedInterface(ctrl)
+
+	f := &mockFactory{changefeedsv2: cfV2}
+
+	o := newDDLHistoryChangefeedOptions()
+	o.complete(f)
+	cmd := newCmdDDLHistoryChangefeed(f)
+
+	cfV2.EXPECT().GetDDLHistory(gomock.Any(), "abc", uint64(10)).Return(&v2.ChangefeedDDLHistory{
+		Namespace: "default",
+		ID:        "abc",
+		Entries: []v2.DDLHistoryEntry{
+			{Query: "CREATE TABLE t(id int)", CommitTs: 20, Target: "`test`.`t`"},
+		},
+	}, nil)
+	o.changefeedID = "abc"
+	o.sinceTs = 10
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.Nil(t, o.run(cmd))
+	out, err := io.ReadAll(b)
+	require.Nil(t, err)
+	require.Contains(t, string(out), "CREATE TABLE t(id int)")
+
+	cfV2.EXPECT().GetDDLHistory(gomock.Any(), "abc", uint64(10)).Return(nil, errors.New("test"))
+	require.NotNil(t, o.run(cmd))
+}
@@ -14,10 +14,12 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/pingcap/errors"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/tikv/client-go/v2/oracle"
@@ -89,3 +91,27 @@ func confirmIgnoreIneligibleTables(cmd *cobra.Command) (bool, error) {
 
 	return true, nil
 }
+
+// waitInterval is the interval of polling in waitUntil.
+var waitInterval = time.Second
+
+// waitUntil polls the check until it's done or fails, or the timeout is reached.
+func waitUntil(ctx context.Context, timeout time.Duration,
+	check func(ctx context.Context) (bool, error),
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Annotatef(ctx.Err(), "wait timeout after %s", timeout)
+		case <-ticker.C:
+		}
+	}
+}
@@ -15,6 +15,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pingcap/errors"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
@@ -29,23 +31,26 @@ import (
 
 // cfMeta holds changefeed info and changefeed status.
 type cfMeta struct {
-	UpstreamID     uint64                    `json:"upstream_id"`
-	Namespace      string                    `json:"namespace"`
-	ID             string                    `json:"id"`
-	SinkURI        string                    `json:"sink_uri"`
-	Config         *v2.ReplicaConfig         `json:"config"`
-	CreateTime     model.JSONTime            `json:"create_time"`
-	StartTs        uint64                    `json:"start_ts"`
-	ResolvedTs     uint64                    `json:"resolved_ts"`
-	TargetTs       uint64                    `json:"target_ts"`
-	CheckpointTSO  uint64                    `json:"checkpoint_tso"`
-	CheckpointTime model.JSONTime            `json:"checkpoint_time"`
-	Engine         model.SortEngine          `json:"sort_engine,omitempty"`
-	FeedState      model.FeedState           `json:"state"`
-	RunningError   *model.RunningError       `json:"error"`
-	ErrorHis       []int64                   `json:"error_history"`
-	CreatorVersion string                    `json:"creator_version"`
-	TaskStatus     []model.CaptureTaskStatus `json:"task_status,omitempty"`
+	UpstreamID     uint64                     `json:"upstream_id"`
+	Namespace      string                     `json:"namespace"`
+	ID             string                     `json:"id"`
+	SinkURI        string                     `json:"sink_uri"`
+	Config         *v2.ReplicaConfig          `json:"config"`
+	CreateTime     model.JSONTime             `json:"create_time"`
+	StartTs        uint64                     `json:"start_ts"`
+	ResolvedTs     uint64                     `json:"resolved_ts"`
+	TargetTs       uint64                     `json:"target_ts"`
+	CheckpointTSO  uint64                     `json:"checkpoint_tso"`
+	CheckpointTime model.JSONTime             `json:"checkpoint_time"`
+	Engine         model.SortEngine           `json:"sort_engine,omitempty"`
+	FeedState      model.FeedState            `json:"state"`
+	RunningError   *model.RunningError        `json:"error"`
+	ErrorHis       []int64                    `json:"error_history"`
+	CreatorVersion string                     `json:"creator_version"`
+	TaskStatus     []model.CaptureTaskStatus  `json:"task_status,omitempty"`
+	LagBreakdown   *v2.CheckpointLagBreakdown `json:"checkpoint_lag_breakdown,omitempty"`
+	// BarrierSummary is set if the changefeed is blocked at a barrier longer than the threshold.
+	BarrierSummary string `json:"barrier_summary,omitempty"`
 }
 
 // queryChangefeedOptions defines flags for the `cli changefeed query` command.
@@ -54,6 +59,8 @@ type queryChangefeedOptions struct {
 	apiClientV2  apiv2client.APIV2Interface
 	changefeedID string
 	simplified   bool
+	// barrierThreshold is the min waiting duration of the barriers to be summarized.
+	barrierThreshold time.Duration
 }
 
 // newQueryChangefeedOptions creates new options for the `cli changefeed query` command.
@@ -66,6 +73,8 @@ func newQueryChangefeedOptions() *queryChangefeedOptions {
 func (o *queryChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&o.simplified, "simple", "s", false, "Output simplified replication status")
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().DurationVar(&o.barrierThreshold, "barrier-threshold", time.Minute,
+		"Summarize the barrier which the changefeed is blocked at longer than the threshold")
 	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
 }
 
@@ -127,10 +136,57 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		ErrorHis:       detail.ErrorHis,
 		CreatorVersion: detail.CreatorVersion,
 		TaskStatus:     detail.TaskStatus,
+		LagBreakdown:   info.LagBreakdown,
+	}
+	// the barriers are only for diagnosis, e.g. they can't be got from an owner
+	// of an older version, so the error is ignored.
+	if barriers, err := o.apiClientV2.Changefeeds().GetBarriers(ctx, o.changefeedID); err == nil {
+		meta.BarrierSummary = summarizeBarriers(barriers.Barriers, o.barrierThreshold)
 	}
 	return util.JSONPrint(cmd, meta)
 }
 
+// summarizeBarriers returns a one-line summary of the barrier which the changefeed
+// is blocked at for the longest time, if it's longer than the threshold.
+func summarizeBarriers(barriers []v2.ChangefeedBarrier, threshold time.Duration) string {
+	var (
+		longest *v2.ChangefeedBarrier
+		count   int
+	)
+	for i := range barriers {
+		if time.Duration(barriers[i].WaitingMs)*time.Millisecond < threshold {
+			continue
+		}
+		count++
+		if longest == nil || barriers[i].WaitingMs > longest.WaitingMs {
+			longest = &barriers[i]
+		}
+	}
+	if longest == nil {
+		return ""
+	}
+
+	summary := fmt.Sprintf("blocked at %s barrier %d for %s", longest.Type, longest.BarrierTs,
+		time.Duration(longest.WaitingMs)*time.Millisecond)
+	if longest.DDL != "" {
+		summary += fmt.Sprintf(" by DDL %q", longest.DDL)
+	}
+	if len(longest.PendingTables) > 0 {
+		slowest := longest.PendingTables[0]
+		for _, t := range longest.PendingTables[1:] {
+			if t.CheckpointTs < slowest.CheckpointTs {
+				slowest = t
+			}
+		}
+		summary += fmt.Sprintf(", %d tables not reached, the slowest table %d on capture %s is at %d",
+			len(longest.PendingTables), slowest.TableID, slowest.CaptureID, slowest.CheckpointTs)
+	}
+	if count > 1 {
+		summary += fmt.Sprintf(", and %d more barriers", count-1)
+	}
+	return summary
+}
+
 // newCmdQueryChangefeed creates the `cli changefeed query` command.
 func newCmdQueryChangefeed(f factory.Factory) *cobra.Command {
 	o := newQueryChangefeedOptions()
@@ -18,6 +18,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/errors"
@@ -77,6 +78,11 @@ func TestChangefeedQueryCli(t *testing.T) {
 	cfV2.EXPECT().GetInfo(gomock.Any(), gomock.Any()).Return(&v2.ChangeFeedInfo{
 		Config: v2.GetDefaultReplicaConfig(),
 	}, nil)
+	cfV2.EXPECT().GetBarriers(gomock.Any(), "bcd").Return(&v2.ChangefeedBarriers{
+		Barriers: []v2.ChangefeedBarrier{{
+			Type: "ddl", BarrierTs: 20, DDL: "TRUNCATE TABLE t", WaitingMs: 90000,
+		}},
+	}, nil)
 
 	o.simplified = false
 	o.changefeedID = "bcd"
@@ -87,9 +93,31 @@ func TestChangefeedQueryCli(t *testing.T) {
 	require.Nil(t, err)
 	// make sure config is printed
 	require.Contains(t, string(out), "config")
+	require.Contains(t, string(out), "blocked at ddl barrier 20 for 1m30s")
 
 	// query failed
 	cfV1.EXPECT().Get(gomock.Any(), "bcd").Return(nil, errors.New("test"))
 	os.Args = []string{"query", "--simple=false", "--changefeed-id=bcd"}
 	require.NotNil(t, o.run(cmd))
 }
+
+func TestSummarizeBarriers(t *testing.T) {
+	barriers := []v2.ChangefeedBarrier{
+		{Type: "syncpoint", BarrierTs: 10, WaitingMs: 1000},
+		{
+			Type: "ddl", BarrierTs: 20, DDL: "ALTER TABLE t ADD COLUMN c int", WaitingMs: 120000,
+			PendingTables: []v2.BarrierPendingTable{
+				{TableID: 1, CaptureID: "capture-1", CheckpointTs: 15},
+				{TableID: 2, CaptureID: "capture-2", CheckpointTs: 12},
+			},
+		},
+	}
+	require.Empty(t, summarizeBarriers(nil, time.Minute))
+	require.Empty(t, summarizeBarriers(barriers[:1], time.Minute))
+	require.Equal(t, "blocked at ddl barrier 20 for 2m0s by DDL \"ALTER TABLE t ADD COLUMN c int\", "+
+		"2 tables not reached, the slowest table 2 on capture capture-2 is at 12",
+		summarizeBarriers(barriers, time.Minute))
+	require.Equal(t, "blocked at ddl barrier 20 for 2m0s by DDL \"ALTER TABLE t ADD COLUMN c int\", "+
+		"2 tables not reached, the slowest table 2 on capture capture-2 is at 12, and 1 more barriers",
+		summarizeBarriers(barriers, time.Second))
+}
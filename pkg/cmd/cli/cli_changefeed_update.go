@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/log"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/sink"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
@@ -101,6 +102,18 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
+	if newInfo.SinkURI != old.SinkURI && old.Config != nil && newInfo.Config != nil {
+		// the sink specific configurations are reset by the server.
+		warnings, err := sink.AdjustConfigForSinkTypeChange(old.SinkURI, newInfo.SinkURI,
+			old.Config.ToInternalReplicaConfig(), newInfo.Config.ToInternalReplicaConfig())
+		if err != nil {
+			return err
+		}
+		for _, warning := range warnings {
+			cmd.Printf("Warning: %s\n", warning)
+		}
+	}
+
 	changelog, err := diff.Diff(old, newInfo)
 	if err != nil {
 		return err
@@ -151,7 +151,12 @@ func (o *options) run(cmd *cobra.Command) error {
 	}
 	// Drain the server before shutdown.
 	shutdownNotify := func() <-chan struct{} { return server.Drain() }
-	util.InitSignalHandling(shutdownNotify, cancel)
+	if config.GetGlobalServerConfig().Security.IsTLSEnabled() {
+		// SIGHUP reloads the TLS certificates.
+		util.InitSignalHandlingExceptSIGHUP(shutdownNotify, cancel)
+	} else {
+		util.InitSignalHandling(shutdownNotify, cancel)
+	}
 
 	// Run TiCDC server.
 	err = server.Run(ctx)
@@ -190,6 +190,7 @@ func TestParseCfg(t *testing.T) {
 				WriterBufferSize:            8388608,
 				Compression:                 "snappy",
 				WriteL0PauseTrigger:         math.MaxInt32,
+				WriteL0SlowdownTrigger:      320,
 				CompactionL0Trigger:         160,
 				CompactionDeletionThreshold: 10485760,
 				CompactionPeriod:            1800,
@@ -207,11 +208,15 @@ func TestParseCfg(t *testing.T) {
 				ServerWorkerPoolSize:         4,
 			},
 			Scheduler: &config.SchedulerConfig{
-				HeartbeatTick:        2,
-				MaxTaskConcurrency:   10,
-				CheckBalanceInterval: 60000000000,
-				AddTableBatchSize:    50,
-				RegionPerSpan:        0,
+				HeartbeatTick:           2,
+				MaxTaskConcurrency:      10,
+				CheckBalanceInterval:    60000000000,
+				AddTableBatchSize:       50,
+				RegionPerSpan:           0,
+				FenceTimeout:            0,
+				MinTableSpanConcurrency: 16,
+				MaxTableSpanConcurrency: 4096,
+				OpenTableLimit:          0,
 			},
 			EnableNewSink: true,
 		},
@@ -349,6 +354,7 @@ check-balance-interval = "10s"
 				Compression:                 "none",
 				CompactionL0Trigger:         11,
 				WriteL0PauseTrigger:         13,
+				WriteL0SlowdownTrigger:      12,
 				IteratorMaxAliveDuration:    10000,
 				IteratorSlowReadDuration:    256,
 				CompactionDeletionThreshold: 15,
@@ -364,11 +370,15 @@ check-balance-interval = "10s"
 				ServerWorkerPoolSize:         16,
 			},
 			Scheduler: &config.SchedulerConfig{
-				HeartbeatTick:        3,
-				MaxTaskConcurrency:   11,
-				CheckBalanceInterval: config.TomlDuration(10 * time.Second),
-				AddTableBatchSize:    50,
-				RegionPerSpan:        0,
+				HeartbeatTick:           3,
+				MaxTaskConcurrency:      11,
+				CheckBalanceInterval:    config.TomlDuration(10 * time.Second),
+				AddTableBatchSize:       50,
+				RegionPerSpan:           0,
+				FenceTimeout:            0,
+				MinTableSpanConcurrency: 16,
+				MaxTableSpanConcurrency: 4096,
+				OpenTableLimit:          0,
 			},
 			EnableNewSink: true,
 		},
@@ -496,6 +506,7 @@ cert-allowed-cn = ["dd","ee"]
 				WriterBufferSize:            8388608,
 				Compression:                 "snappy",
 				WriteL0PauseTrigger:         math.MaxInt32,
+				WriteL0SlowdownTrigger:      320,
 				CompactionL0Trigger:         160,
 				CompactionDeletionThreshold: 10485760,
 				CompactionPeriod:            1800,
@@ -513,11 +524,15 @@ cert-allowed-cn = ["dd","ee"]
 				ServerWorkerPoolSize:         4,
 			},
 			Scheduler: &config.SchedulerConfig{
-				HeartbeatTick:        2,
-				MaxTaskConcurrency:   10,
-				CheckBalanceInterval: 60000000000,
-				AddTableBatchSize:    50,
-				RegionPerSpan:        0,
+				HeartbeatTick:           2,
+				MaxTaskConcurrency:      10,
+				CheckBalanceInterval:    60000000000,
+				AddTableBatchSize:       50,
+				RegionPerSpan:           0,
+				FenceTimeout:            0,
+				MinTableSpanConcurrency: 16,
+				MaxTableSpanConcurrency: 4096,
+				OpenTableLimit:          0,
 			},
 			EnableNewSink: true,
 		},
@@ -562,6 +577,7 @@ unknown3 = 3
 			WriterBufferSize:            8388608,
 			Compression:                 "snappy",
 			WriteL0PauseTrigger:         math.MaxInt32,
+			WriteL0SlowdownTrigger:      320,
 			CompactionL0Trigger:         160,
 			CompactionDeletionThreshold: 10485760,
 			CompactionPeriod:            1800,
@@ -579,11 +595,15 @@ unknown3 = 3
 			ServerWorkerPoolSize:         4,
 		},
 		Scheduler: &config.SchedulerConfig{
-			HeartbeatTick:        2,
-			MaxTaskConcurrency:   10,
-			CheckBalanceInterval: 60000000000,
-			AddTableBatchSize:    50,
-			RegionPerSpan:        0,
+			HeartbeatTick:           2,
+			MaxTaskConcurrency:      10,
+			CheckBalanceInterval:    60000000000,
+			AddTableBatchSize:       50,
+			RegionPerSpan:           0,
+			FenceTimeout:            0,
+			MinTableSpanConcurrency: 16,
+			MaxTableSpanConcurrency: 4096,
+			OpenTableLimit:          0,
 		},
 		EnableNewSink: true,
 	}, o.serverConfig.Debug)
@@ -67,16 +67,32 @@ type shutdownNotify func() <-chan struct{}
 // InitSignalHandling initializes signal handling.
 // It must be called after InitCmd.
 func InitSignalHandling(shutdown shutdownNotify, cancel context.CancelFunc) {
+	initSignalHandling(shutdown, cancel,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+}
+
+// InitSignalHandlingExceptSIGHUP is the same as InitSignalHandling, except
+// that SIGHUP doesn't shut down the process, because it's used to reload
+// the TLS certificates.
+func InitSignalHandlingExceptSIGHUP(shutdown shutdownNotify, cancel context.CancelFunc) {
+	initSignalHandling(shutdown, cancel,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+}
+
+func initSignalHandling(
+	shutdown shutdownNotify, cancel context.CancelFunc, signals ...os.Signal,
+) {
 	// systemd and k8s send signals twice. The first is for graceful shutdown,
 	// and the second is for force shutdown.
 	// We use 2 for channel length to ease testing.
 	signalChanLen := 2
 	sc := make(chan os.Signal, signalChanLen)
-	signal.Notify(sc,
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGTERM,
-		syscall.SIGQUIT)
+	signal.Notify(sc, signals...)
 
 	go func() {
 		sig := <-sc
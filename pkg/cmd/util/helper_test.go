@@ -186,6 +186,7 @@ func TestAndWriteExampleReplicaTOML(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, &config.SinkConfig{
 		EncoderConcurrency: 16,
+		SendConcurrency:    4,
 		DispatchRules: []*config.DispatchRule{
 			{PartitionRule: "ts", TopicRule: "hello_{schema}", Matcher: []string{"test1.*", "test2.*"}},
 			{PartitionRule: "rowid", TopicRule: "{schema}_world", Matcher: []string{"test3.*", "test4.*"}},
@@ -214,6 +215,7 @@ func TestAndWriteStorageSinkTOML(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, &config.SinkConfig{
 		EncoderConcurrency: 16,
+		SendConcurrency:    4,
 		Terminator:         "\r\n",
 		DateSeparator:      "day",
 		CSVConfig: &config.CSVConfig{
@@ -81,6 +81,7 @@ const (
   "capture-session-ttl": 10,
   "owner-flush-interval": 50000000,
   "processor-flush-interval": 50000000,
+  "cert-reload-interval": 0,
   "sorter": {
     "num-concurrent-worker": 4,
     "chunk-size-limit": 999,
@@ -100,7 +101,8 @@ const (
     "worker-concurrent": 8,
     "worker-pool-size": 0,
     "region-scan-limit": 40,
-    "region-retry-duration": 60000000000
+    "region-retry-duration": 60000000000,
+    "enable-follower-incremental-scan": false
   },
   "debug": {
     "table-actor": {
@@ -116,6 +118,7 @@ const (
       "writer-buffer-size": 8388608,
       "compression": "snappy",
       "write-l0-pause-trigger": 2147483647,
+      "write-l0-slowdown-trigger": 320,
       "compaction-l0-trigger": 160,
       "compaction-deletion-threshold": 10485760,
       "compaction-period": 1800,
@@ -137,9 +140,14 @@ const (
       "max-task-concurrency": 10,
       "check-balance-interval": 60000000000,
       "add-table-batch-size": 50,
-      "region-per-span": 0
+      "region-per-span": 0,
+      "fence-timeout": 0,
+      "min-table-span-concurrency": 16,
+      "max-table-span-concurrency": 4096,
+      "open-table-limit": 0
     },
-    "enable-new-sink": true
+    "enable-new-sink": true,
+    "enable-commit-ts-order-check": false
   },
   "cluster-id": "default"
 }`
@@ -166,6 +174,7 @@ const (
   },
   "sink": {
   	"encoder-concurrency": 16,
+    "send-concurrency": 4,
     "dispatchers": null,
     "protocol": "open-protocol",
     "column-selectors": [
@@ -196,6 +205,17 @@ const (
     "max-log-size": 64,
     "flush-interval": 2000,
     "storage": ""
+  },
+  "ddl-history": {
+    "max-count": 100,
+    "retention": 604800000000000
+  },
+  "resolved-ts-interval": 0,
+  "enable-follower-incremental-scan": false,
+  "ddl-pacing": {
+    "max-ddl-per-minute": 0,
+    "max-outstanding": 0,
+    "queue-warning-threshold": 1000
   }
 }`
 
@@ -220,6 +240,7 @@ const (
   },
   "sink": {
     "encoder-concurrency": 16,
+    "send-concurrency": 4,
     "dispatchers": null,
     "protocol": "open-protocol",
     "column-selectors": [
@@ -248,6 +269,17 @@ const (
     "max-log-size": 64,
     "flush-interval": 2000,
     "storage": ""
+  },
+  "ddl-history": {
+    "max-count": 100,
+    "retention": 604800000000000
+  },
+  "resolved-ts-interval": 0,
+  "enable-follower-incremental-scan": false,
+  "ddl-pacing": {
+    "max-ddl-per-minute": 0,
+    "max-outstanding": 0,
+    "queue-warning-threshold": 1000
   }
 }`
 )
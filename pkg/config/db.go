@@ -47,6 +47,11 @@ type DBConfig struct {
 	//
 	// The default value is 1<<31 - 1.
 	WriteL0PauseTrigger int `toml:"write-l0-pause-trigger" json:"write-l0-pause-trigger"`
+	// WriteL0SlowdownTrigger defines number of db sst file at level-0 that will
+	// throttle the events written into db by pullers.
+	//
+	// The default value is 320.
+	WriteL0SlowdownTrigger int `toml:"write-l0-slowdown-trigger" json:"write-l0-slowdown-trigger"`
 
 	// CompactionL0Trigger defines number of db sst file at level-0 that will
 	// trigger compaction.
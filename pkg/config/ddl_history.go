@@ -0,0 +1,42 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// DDLHistoryConfig represents the retention of the emitted DDL history of a changefeed.
+type DDLHistoryConfig struct {
+	// MaxCount is the max number of DDLs kept in the history, 0 disables recording.
+	MaxCount int `toml:"max-count" json:"max-count"`
+	// Retention is the max age of DDLs kept in the history, 0 means no limit.
+	Retention time.Duration `toml:"retention" json:"retention"`
+}
+
+// ValidateAndAdjust validates the ddl history config.
+func (c *DDLHistoryConfig) ValidateAndAdjust() error {
+	if c.MaxCount < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The DDLHistory.MaxCount:%d must not be negative", c.MaxCount))
+	}
+	if c.Retention < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The DDLHistory.Retention:%s must not be negative", c.Retention.String()))
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// DDLPacingConfig limits the rate of executing DDLs to the downstream of a changefeed.
+type DDLPacingConfig struct {
+	// MaxDDLPerMinute is the max number of DDLs executed per minute, 0 means no limit.
+	MaxDDLPerMinute int `toml:"max-ddl-per-minute" json:"max-ddl-per-minute"`
+	// MaxOutstanding is the max number of DDLs sent to the downstream but not
+	// finished yet, 0 means no limit.
+	MaxOutstanding int `toml:"max-outstanding" json:"max-outstanding"`
+	// QueueWarningThreshold is the number of DDLs waiting to be executed that
+	// triggers a warning, 0 disables the warning.
+	QueueWarningThreshold int `toml:"queue-warning-threshold" json:"queue-warning-threshold"`
+}
+
+// ValidateAndAdjust validates the ddl pacing config.
+func (c *DDLPacingConfig) ValidateAndAdjust() error {
+	if c.MaxDDLPerMinute < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The DDLPacing.MaxDDLPerMinute:%d must not be negative", c.MaxDDLPerMinute))
+	}
+	if c.MaxOutstanding < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The DDLPacing.MaxOutstanding:%d must not be negative", c.MaxOutstanding))
+	}
+	if c.QueueWarningThreshold < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The DDLPacing.QueueWarningThreshold:%d must not be negative", c.QueueWarningThreshold))
+	}
+	return nil
+}
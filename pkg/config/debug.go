@@ -49,6 +49,12 @@ type DebugConfig struct {
 	// EnableNewSink enables the new sink.
 	// The default value is true.
 	EnableNewSink bool `toml:"enable-new-sink" json:"enable-new-sink"`
+
+	// EnableCommitTsOrderCheck enables checking that the events appended to
+	// every table sink are ordered by commit ts, and counting the violations.
+	// It's a correctness tripwire and has per-event overhead.
+	// The default value is false.
+	EnableCommitTsOrderCheck bool `toml:"enable-commit-ts-order-check" json:"enable-commit-ts-order-check"`
 }
 
 // ValidateAndAdjust validates and adjusts the debug configuration
@@ -72,6 +78,14 @@ func (c *DebugConfig) ValidateAndAdjust() error {
 					"`debug.enable-pull-based-sink` to be false")
 		}
 	}
+	if c.Scheduler.FenceTimeout != 0 && !c.IsPullBasedSinkEnabled() {
+		// Only the pull-based sink can stop emitting events when a capture
+		// fences itself.
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"enabling fencing requires setting " +
+				"`debug.enable-pull-based-sink`, `debug.enable-db-sorter` and " +
+				"`debug.enable-new-sink` to be true")
+	}
 	if c.EnablePullBasedSink {
 		if !c.EnableDBSorter {
 			return cerrors.ErrInvalidPullBasedSinkConfig.GenWithStackByArgs(
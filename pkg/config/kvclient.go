@@ -25,6 +25,20 @@ type KVClientConfig struct {
 	RegionScanLimit int `toml:"region-scan-limit" json:"region-scan-limit"`
 	// the total retry duration of connecting a region
 	RegionRetryDuration TomlDuration `toml:"region-retry-duration" json:"region-retry-duration"`
+	// whether to send the incremental scan requests of a new event feed to followers,
+	// the region is handed over to its leader after the incremental scan is finished.
+	EnableFollowerIncrementalScan bool `toml:"enable-follower-incremental-scan" json:"enable-follower-incremental-scan"`
+}
+
+// WithFollowerIncrementalScan returns the config with the follower incremental
+// scan enabled if `enable` is true, the config itself is not changed.
+func (c *KVClientConfig) WithFollowerIncrementalScan(enable bool) *KVClientConfig {
+	if !enable || c.EnableFollowerIncrementalScan {
+		return c
+	}
+	cloned := *c
+	cloned.EnableFollowerIncrementalScan = true
+	return &cloned
 }
 
 // ValidateAndAdjust validates and adjusts the kv client configuration
@@ -21,9 +21,11 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/pkg/config/outdated"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -31,6 +33,8 @@ const (
 	minSyncPointInterval = time.Second * 30
 	// minSyncPointRetention is the minimum of SyncPointRetention can be set.
 	minSyncPointRetention = time.Hour * 1
+	// minResolvedTsInterval is the minimum of ResolvedTsInterval can be set.
+	minResolvedTsInterval = time.Millisecond * 50
 )
 
 var defaultReplicaConfig = &ReplicaConfig{
@@ -54,6 +58,7 @@ var defaultReplicaConfig = &ReplicaConfig{
 			NullString: NULL,
 		},
 		EncoderConcurrency:       16,
+		SendConcurrency:          4,
 		Terminator:               CRLF,
 		DateSeparator:            DateSeparatorNone.String(),
 		EnablePartitionSeparator: false,
@@ -65,6 +70,13 @@ var defaultReplicaConfig = &ReplicaConfig{
 		FlushIntervalInMs: 2000,
 		Storage:           "",
 	},
+	DDLHistory: &DDLHistoryConfig{
+		MaxCount:  100,
+		Retention: time.Hour * 24 * 7,
+	},
+	DDLPacing: &DDLPacingConfig{
+		QueueWarningThreshold: 1000,
+	},
 }
 
 // GetDefaultReplicaConfig returns the default replica config.
@@ -104,6 +116,19 @@ type replicaConfig struct {
 	Mounter            *MounterConfig    `toml:"mounter" json:"mounter"`
 	Sink               *SinkConfig       `toml:"sink" json:"sink"`
 	Consistent         *ConsistentConfig `toml:"consistent" json:"consistent"`
+	DDLHistory         *DDLHistoryConfig `toml:"ddl-history" json:"ddl-history"`
+	// ResolvedTsInterval is the interval of advancing resolved ts in puller and
+	// emitting resolved events to sink. Zero means using the built-in intervals.
+	ResolvedTsInterval time.Duration `toml:"resolved-ts-interval" json:"resolved-ts-interval"`
+	// EnableFollowerIncrementalScan sends the incremental scan requests of the
+	// changefeed to followers, it's also enabled by the kv client config.
+	EnableFollowerIncrementalScan bool `toml:"enable-follower-incremental-scan" json:"enable-follower-incremental-scan"`
+	// DDLPacing limits the rate of executing DDLs to the downstream.
+	DDLPacing *DDLPacingConfig `toml:"ddl-pacing" json:"ddl-pacing"`
+	// OldValueTables are the table filter rules of the tables whose old values
+	// are captured when EnableOldValue is off. The update and delete events of
+	// the other tables only carry the handle key columns as old values.
+	OldValueTables []string `toml:"old-value-tables" json:"old-value-tables,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ReplicationConfig
@@ -161,11 +186,20 @@ func (c *replicaConfig) fillFromV1(v1 *outdated.ReplicaConfigV1) {
 	}
 }
 
+// HasOldValue returns whether the old values of some tables are captured,
+// either by EnableOldValue or by OldValueTables.
+func (c *ReplicaConfig) HasOldValue() bool {
+	return c.EnableOldValue || len(c.OldValueTables) > 0
+}
+
 // ValidateAndAdjust verifies and adjusts the replica configuration.
 func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
+	if err := c.validateAndAdjustOldValueTables(); err != nil {
+		return err
+	}
 	// check sink uri
 	if c.Sink != nil {
-		err := c.Sink.validateAndAdjust(sinkURI, c.EnableOldValue)
+		err := c.Sink.validateAndAdjust(sinkURI, c.HasOldValue())
 		if err != nil {
 			return err
 		}
@@ -187,7 +221,60 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 						minSyncPointRetention.String()))
 		}
 	}
+	if c.ResolvedTsInterval != 0 && c.ResolvedTsInterval < minResolvedTsInterval {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(
+				fmt.Sprintf("The ResolvedTsInterval:%s must be larger than %s",
+					c.ResolvedTsInterval.String(),
+					minResolvedTsInterval.String()))
+	}
+	if c.DDLHistory != nil {
+		if err := c.DDLHistory.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	if c.DDLPacing != nil {
+		if err := c.DDLPacing.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// validateAndAdjustOldValueTables verifies OldValueTables, and adds the tables
+// whose old values are required by other features to it.
+func (c *ReplicaConfig) validateAndAdjustOldValueTables() error {
+	if c.EnableOldValue || len(c.OldValueTables) == 0 {
+		return nil
+	}
+	if _, err := filter.Parse(c.OldValueTables); err != nil {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("invalid old-value-tables %v: %s", c.OldValueTables, err.Error()))
+	}
+	// The split update events carry the old values of all tables.
+	if c.Sink != nil && (c.Sink.SplitUpdateToDeleteInsert == SplitUpdateAlways ||
+		c.Sink.SplitUpdateToDeleteInsert == SplitUpdatePKChangeOnly) {
+		log.Warn("old value is enabled for all tables, since update events are split",
+			zap.String("splitUpdateToDeleteInsert", c.Sink.SplitUpdateToDeleteInsert))
+		c.EnableOldValue = true
+		return nil
+	}
+	// The expression filters on old values need the old values of their tables.
+	if c.Filter != nil {
+		for _, rule := range c.Filter.EventFilters {
+			if rule.IgnoreUpdateOldValueExpr == "" && rule.IgnoreDeleteValueExpr == "" {
+				continue
+			}
+			for _, m := range rule.Matcher {
+				if !slices.Contains(c.OldValueTables, m) {
+					log.Info("old value is enabled for tables of the expression filter",
+						zap.String("matcher", m))
+					c.OldValueTables = append(c.OldValueTables, m)
+				}
+			}
+		}
+	}
 	return nil
 }
 
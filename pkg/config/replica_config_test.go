@@ -16,6 +16,7 @@ package config
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"testing"
 	"time"
 
@@ -95,6 +96,9 @@ func TestReplicaConfigOutDated(t *testing.T) {
 	conf.Sink.TxnAtomicity = unknownTxnAtomicity
 	conf.Sink.DateSeparator = ""
 	conf.Sink.CSVConfig = nil
+	conf.Sink.SendConcurrency = 0
+	conf.DDLHistory = nil
+	conf.DDLPacing = nil
 	require.Equal(t, conf, conf2)
 }
 
@@ -149,4 +153,87 @@ func TestValidateAndAdjust(t *testing.T) {
 
 	cfg.Sink.EncoderConcurrency = -1
 	require.Error(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Sink.EncoderConcurrency = 16
+	cfg.Sink.SendConcurrency = -1
+	require.Error(t, cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustDDLHistory(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLHistory.MaxCount = 0
+	cfg.DDLHistory.Retention = 0
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLHistory.MaxCount = -1
+	require.Regexp(t, ".*MaxCount.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLHistory.MaxCount = 10
+	cfg.DDLHistory.Retention = -time.Second
+	require.Regexp(t, ".*Retention.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustResolvedTsInterval(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	require.Equal(t, time.Duration(0), cfg.ResolvedTsInterval)
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.ResolvedTsInterval = minResolvedTsInterval
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.ResolvedTsInterval = 10 * time.Millisecond
+	require.Regexp(t, ".*ResolvedTsInterval.*must be larger than.*", cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustDDLPacing(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	require.Equal(t, &DDLPacingConfig{QueueWarningThreshold: 1000}, cfg.DDLPacing)
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLPacing.MaxDDLPerMinute = 60
+	cfg.DDLPacing.MaxOutstanding = 1
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLPacing.MaxDDLPerMinute = -1
+	require.Regexp(t, ".*MaxDDLPerMinute.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLPacing.MaxDDLPerMinute = 0
+	cfg.DDLPacing.MaxOutstanding = -1
+	require.Regexp(t, ".*MaxOutstanding.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.DDLPacing.MaxOutstanding = 0
+	cfg.DDLPacing.QueueWarningThreshold = -1
+	require.Regexp(t, ".*QueueWarningThreshold.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustOldValueTables(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.EnableOldValue = false
+	cfg.OldValueTables = []string{"test.t1"}
+	cfg.Filter.EventFilters = []*EventFilterRule{
+		{Matcher: []string{"test.t2"}, IgnoreUpdateOldValueExpr: "a > 1"},
+		{Matcher: []string{"test.t3"}, IgnoreInsertValueExpr: "a > 1"},
+	}
+	sinkURI, err := url.Parse("kafka://127.0.0.1:9092/test?protocol=canal-json")
+	require.NoError(t, err)
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURI))
+	require.False(t, cfg.EnableOldValue)
+	require.True(t, cfg.HasOldValue())
+	require.Equal(t, []string{"test.t1", "test.t2"}, cfg.OldValueTables)
+
+	// The split update events need the old values of all tables.
+	cfg.Sink.SplitUpdateToDeleteInsert = SplitUpdatePKChangeOnly
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURI))
+	require.True(t, cfg.EnableOldValue)
+
+	cfg = GetDefaultReplicaConfig()
+	cfg.EnableOldValue = false
+	cfg.OldValueTables = []string{"test.t1["}
+	require.Regexp(t, ".*invalid old-value-tables.*", cfg.ValidateAndAdjust(nil))
 }
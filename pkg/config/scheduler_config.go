@@ -38,6 +38,22 @@ type SchedulerConfig struct {
 	// RegionPerSpan the number of regions in a span, must be greater than 1000.
 	// Set 0 to disable span replication.
 	RegionPerSpan int `toml:"region-per-span" json:"region-per-span"`
+	// FenceTimeout is the lease of table spans on a capture. A capture stops
+	// emitting events if it doesn't receive heartbeats from the owner within
+	// the lease, and the owner only moves the table spans of a removed capture
+	// to other captures after the lease expires, so that a table span is never
+	// written by two captures at the same time, e.g., during network partitions.
+	// It requires the pull-based sink. Set 0 to disable fencing.
+	FenceTimeout TomlDuration `toml:"fence-timeout" json:"fence-timeout"`
+	// MinTableSpanConcurrency and MaxTableSpanConcurrency bound the processing
+	// concurrency of a table span adjusted at runtime, i.e. the number of its
+	// events mounted in parallel, which is 256 if it's not adjusted.
+	MinTableSpanConcurrency int `toml:"min-table-span-concurrency" json:"min-table-span-concurrency"`
+	MaxTableSpanConcurrency int `toml:"max-table-span-concurrency" json:"max-table-span-concurrency"`
+	// OpenTableLimit is the max number of table spans a capture opens for a
+	// changefeed, tables beyond the limit are added to other captures.
+	// Set 0 to disable the limit.
+	OpenTableLimit int `toml:"open-table-limit" json:"open-table-limit"`
 }
 
 // NewDefaultSchedulerConfig return the default scheduler configuration.
@@ -46,9 +62,13 @@ func NewDefaultSchedulerConfig() *SchedulerConfig {
 		HeartbeatTick:      2,
 		MaxTaskConcurrency: 10,
 		// TODO: no need to check balance each minute, relax the interval.
-		CheckBalanceInterval: TomlDuration(time.Minute),
-		AddTableBatchSize:    50,
-		RegionPerSpan:        0,
+		CheckBalanceInterval:    TomlDuration(time.Minute),
+		AddTableBatchSize:       50,
+		RegionPerSpan:           0,
+		FenceTimeout:            0,
+		MinTableSpanConcurrency: 16,
+		MaxTableSpanConcurrency: 4096,
+		OpenTableLimit:          0,
 	}
 }
 
@@ -74,6 +94,22 @@ func (c *SchedulerConfig) ValidateAndAdjust() error {
 		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
 			"region-per-span must be either 0 or greater than 1000")
 	}
+	if c.FenceTimeout != 0 && time.Duration(c.FenceTimeout) < time.Second {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"fence-timeout must be either 0 or not less than 1s")
+	}
+	if c.MinTableSpanConcurrency <= 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"min-table-span-concurrency must be larger than 0")
+	}
+	if c.MaxTableSpanConcurrency < c.MinTableSpanConcurrency {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"max-table-span-concurrency must not be less than min-table-span-concurrency")
+	}
+	if c.OpenTableLimit < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"open-table-limit must not be less than 0")
+	}
 
 	return nil
 }
@@ -141,6 +141,7 @@ var defaultServerConfig = &ServerConfig{
 			WriterBufferSize:            8388608,
 			Compression:                 "snappy",
 			WriteL0PauseTrigger:         math.MaxInt32,
+			WriteL0SlowdownTrigger:      320,
 			CompactionL0Trigger:         160,
 			CompactionDeletionThreshold: 10485760,
 			CompactionPeriod:            1800,
@@ -174,6 +175,9 @@ type ServerConfig struct {
 
 	OwnerFlushInterval     TomlDuration `toml:"owner-flush-interval" json:"owner-flush-interval"`
 	ProcessorFlushInterval TomlDuration `toml:"processor-flush-interval" json:"processor-flush-interval"`
+	// CertReloadInterval is the interval to reload the TLS certificates
+	// from disk, 0 means only reloading them on SIGHUP.
+	CertReloadInterval TomlDuration `toml:"cert-reload-interval" json:"cert-reload-interval"`
 
 	Sorter              *SorterConfig   `toml:"sorter" json:"sorter"`
 	Security            *SecurityConfig `toml:"security" json:"security"`
@@ -108,6 +108,17 @@ func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	require.Error(t, conf.ValidateAndAdjust())
 }
 
+func TestKVClientConfigWithFollowerIncrementalScan(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().KVClient
+
+	require.Same(t, conf, conf.WithFollowerIncrementalScan(false))
+	enabled := conf.WithFollowerIncrementalScan(true)
+	require.True(t, enabled.EnableFollowerIncrementalScan)
+	require.False(t, conf.EnableFollowerIncrementalScan)
+	require.Same(t, enabled, enabled.WithFollowerIncrementalScan(true))
+}
+
 func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
 	t.Parallel()
 	conf := GetDefaultServerConfig().Clone().Debug.Scheduler
@@ -140,6 +151,40 @@ func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
 	require.Nil(t, conf.ValidateAndAdjust())
 	conf.RegionPerSpan = 999
 	require.Error(t, conf.ValidateAndAdjust())
+
+	conf = GetDefaultServerConfig().Clone().Debug.Scheduler
+	conf.FenceTimeout = TomlDuration(10 * time.Second)
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.FenceTimeout = TomlDuration(100 * time.Millisecond)
+	require.Error(t, conf.ValidateAndAdjust())
+
+	conf = GetDefaultServerConfig().Clone().Debug.Scheduler
+	conf.MinTableSpanConcurrency = 0
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MinTableSpanConcurrency = 32
+	conf.MaxTableSpanConcurrency = 16
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxTableSpanConcurrency = 32
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	conf = GetDefaultServerConfig().Clone().Debug.Scheduler
+	conf.OpenTableLimit = 100
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.OpenTableLimit = -1
+	require.Error(t, conf.ValidateAndAdjust())
+}
+
+func TestDebugConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().Debug
+	conf.Scheduler.FenceTimeout = TomlDuration(10 * time.Second)
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	// Fencing requires the pull-based sink.
+	conf.EnablePullBasedSink = false
+	require.Regexp(t, ".*ErrInvalidServerOption.*", conf.ValidateAndAdjust())
+	conf.Scheduler.FenceTimeout = 0
+	require.Nil(t, conf.ValidateAndAdjust())
 }
 
 func TestIsValidClusterID(t *testing.T) {
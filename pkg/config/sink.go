@@ -28,6 +28,10 @@ import (
 // DefaultMaxMessageBytes sets the default value for max-message-bytes.
 const DefaultMaxMessageBytes = 10 * 1024 * 1024 // 10M
 
+// MinColumnValueSizeLimit is the min value of column-value-size-limit, which is
+// larger than any truncation marker, so a marker never exceeds the limit.
+const MinColumnValueSizeLimit = 1024
+
 // AtomicityLevel represents the atomicity level of a changefeed.
 type AtomicityLevel string
 
@@ -80,16 +84,55 @@ var ForceEnableOldValueProtocols = []string{
 
 // SinkConfig represents sink config for a changefeed
 type SinkConfig struct {
-	DispatchRules            []*DispatchRule   `toml:"dispatchers" json:"dispatchers"`
-	CSVConfig                *CSVConfig        `toml:"csv" json:"csv"`
-	Protocol                 string            `toml:"protocol" json:"protocol"`
-	ColumnSelectors          []*ColumnSelector `toml:"column-selectors" json:"column-selectors"`
-	SchemaRegistry           string            `toml:"schema-registry" json:"schema-registry"`
-	TxnAtomicity             AtomicityLevel    `toml:"transaction-atomicity" json:"transaction-atomicity"`
-	EncoderConcurrency       int               `toml:"encoder-concurrency" json:"encoder-concurrency"`
-	Terminator               string            `toml:"terminator" json:"terminator"`
-	DateSeparator            string            `toml:"date-separator" json:"date-separator"`
-	EnablePartitionSeparator bool              `toml:"enable-partition-separator" json:"enable-partition-separator"`
+	DispatchRules   []*DispatchRule   `toml:"dispatchers" json:"dispatchers"`
+	CSVConfig       *CSVConfig        `toml:"csv" json:"csv"`
+	Protocol        string            `toml:"protocol" json:"protocol"`
+	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors"`
+	SchemaRegistry  string            `toml:"schema-registry" json:"schema-registry"`
+	// SchemaRegistryConfig is the authentication and subject naming config of
+	// the schema registry, only used by the avro protocol.
+	SchemaRegistryConfig     *SchemaRegistryConfig `toml:"schema-registry-config" json:"schema-registry-config,omitempty"`
+	TxnAtomicity             AtomicityLevel        `toml:"transaction-atomicity" json:"transaction-atomicity"`
+	EncoderConcurrency       int                   `toml:"encoder-concurrency" json:"encoder-concurrency"`
+	Terminator               string                `toml:"terminator" json:"terminator"`
+	DateSeparator            string                `toml:"date-separator" json:"date-separator"`
+	EnablePartitionSeparator bool                  `toml:"enable-partition-separator" json:"enable-partition-separator"`
+	// SendConcurrency is the number of goroutines sending the encoded messages
+	// to the downstream, messages of a partition are sent by the same goroutine.
+	// Note: This field is only used in the MQ sink.
+	SendConcurrency int `toml:"send-concurrency" json:"send-concurrency"`
+	// SplitUpdateToDeleteInsert decides which update events are split into a
+	// delete event of the old row and an insert event of the new row, it can
+	// be `always`, `pk-change-only` or `never`, default `never`.
+	// The delete event is always delivered before the insert event. If the old
+	// and new rows are dispatched to different partitions, the two events are
+	// only ordered within their own partitions, so consumers may observe the
+	// insert before the delete.
+	// Note: This field is only used in the MQ sink and the storage sink.
+	SplitUpdateToDeleteInsert string `toml:"split-update-to-delete-insert" json:"split-update-to-delete-insert,omitempty"`
+	// ColumnValueSizeLimit is the max size in bytes of a string or binary
+	// column value, e.g. a BLOB or a JSON, 0 means no limit, default 0.
+	// A value larger than the limit is replaced with a marker object carrying
+	// its original length, its SHA-256 and a prefix of it, instead of failing
+	// the changefeed on the encoder or broker limits.
+	// The limit should be at least MinColumnValueSizeLimit, and the values of
+	// the handle key and unique key columns are never truncated.
+	// Note: This field is only used in the MQ sink and the storage sink, the
+	// MySQL sink rejects it since the truncated values corrupt the data.
+	ColumnValueSizeLimit int `toml:"column-value-size-limit" json:"column-value-size-limit,omitempty"`
+	// RedactLog decides how the literal values in the SQL statements and the
+	// errors logged by the sink are redacted, it can be `none`, `elide` or
+	// `hash`, default `none`. The statement shape is kept, e.g. `elide`
+	// replaces the values with `?`, and `hash` replaces them with their
+	// hashes, so that the logs of the same value can still be correlated.
+	// Note: This field is only used in the MySQL sink.
+	RedactLog string `toml:"redact-log" json:"redact-log,omitempty"`
+	// ErrorLogSampleIntervalInSec is the interval in seconds within which an
+	// identical sink error is logged only once, the number of the suppressed
+	// ones is logged with the next one, 0 means every error is logged,
+	// default 0.
+	// Note: This field is only used in the MySQL sink.
+	ErrorLogSampleIntervalInSec int `toml:"error-log-sample-interval-in-sec" json:"error-log-sample-interval-in-sec,omitempty"`
 	// TiDBSourceID is the source ID of the upstream TiDB,
 	// which is used to set the `tidb_cdc_write_source` session variable.
 	// Note: This field is only used internally and only used in the MySQL sink.
@@ -108,6 +151,94 @@ type CSVConfig struct {
 	IncludeCommitTs bool `toml:"include-commit-ts" json:"include-commit-ts"`
 }
 
+// The subject name strategies of the schema registry.
+const (
+	// SubjectNameStrategyTopic uses `<topic>-value` as the subject name.
+	SubjectNameStrategyTopic = "topic"
+	// SubjectNameStrategyRecord uses the fully-qualified record name as the subject name.
+	SubjectNameStrategyRecord = "record"
+	// SubjectNameStrategyTopicRecord uses `<topic>-<fully-qualified record name>`
+	// as the subject name.
+	SubjectNameStrategyTopicRecord = "topic-record"
+)
+
+// SchemaRegistryConfig defines the authentication and subject naming config
+// of the schema registry.
+type SchemaRegistryConfig struct {
+	// Username and Password are the credentials of the basic authentication.
+	Username string `toml:"username" json:"username"`
+	Password string `toml:"password" json:"password"`
+	// BearerToken is the token of the bearer authentication, it can't be used
+	// together with the basic authentication.
+	BearerToken string `toml:"bearer-token" json:"bearer-token"`
+	// CAPath, CertPath and KeyPath are used to connect to the schema registry
+	// over TLS.
+	CAPath   string `toml:"ca-path" json:"ca-path"`
+	CertPath string `toml:"cert-path" json:"cert-path"`
+	KeyPath  string `toml:"key-path" json:"key-path"`
+	// SubjectNameStrategy is the subject name strategy of the value schemas,
+	// can be `topic`, `record` or `topic-record`, default `topic`. The key
+	// schemas always use the `topic` strategy, because they share the record
+	// name with the value schemas.
+	SubjectNameStrategy string `toml:"subject-name-strategy" json:"subject-name-strategy"`
+}
+
+// MaskSensitiveData masks the credentials of the schema registry.
+func (c *SchemaRegistryConfig) MaskSensitiveData() {
+	if c == nil {
+		return
+	}
+	if c.Password != "" {
+		c.Password = "xxxxx"
+	}
+	if c.BearerToken != "" {
+		c.BearerToken = "xxxxx"
+	}
+}
+
+func (c *SchemaRegistryConfig) validateAndAdjust() error {
+	if c.BearerToken != "" && (c.Username != "" || c.Password != "") {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"schema registry can't use bearer token and basic authentication at the same time")
+	}
+	if c.Username == "" && c.Password != "" {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"schema registry requires username when password is set")
+	}
+	switch c.SubjectNameStrategy {
+	case "":
+		c.SubjectNameStrategy = SubjectNameStrategyTopic
+	case SubjectNameStrategyTopic, SubjectNameStrategyRecord, SubjectNameStrategyTopicRecord:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"subject-name-strategy of schema registry could only be %q, %q or %q, but got %q",
+			SubjectNameStrategyTopic, SubjectNameStrategyRecord,
+			SubjectNameStrategyTopicRecord, c.SubjectNameStrategy)
+	}
+	return nil
+}
+
+// The strategies of splitting update events into delete and insert events.
+const (
+	// SplitUpdateAlways splits all update events.
+	SplitUpdateAlways = "always"
+	// SplitUpdatePKChangeOnly only splits the update events which change the
+	// values of the handle key columns.
+	SplitUpdatePKChangeOnly = "pk-change-only"
+	// SplitUpdateNever never splits update events.
+	SplitUpdateNever = "never"
+)
+
+// The policies of redacting the values in the sink logs.
+const (
+	// RedactLogNone logs the values as they are.
+	RedactLogNone = "none"
+	// RedactLogElide replaces the values with `?`.
+	RedactLogElide = "elide"
+	// RedactLogHash replaces the values with their hashes.
+	RedactLogHash = "hash"
+)
+
 // DateSeparator specifies the date separator in storage destination path
 type DateSeparator int
 
@@ -204,6 +335,48 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 		return cerror.ErrSinkInvalidConfig.GenWithStack(
 			"encoder-concurrency should greater than 0, but got %d", s.EncoderConcurrency)
 	}
+	if s.SendConcurrency < 0 {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"send-concurrency should not be negative, but got %d", s.SendConcurrency)
+	}
+
+	switch s.SplitUpdateToDeleteInsert {
+	case "", SplitUpdateAlways, SplitUpdatePKChangeOnly, SplitUpdateNever:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"split-update-to-delete-insert could only be %q, %q or %q, but got %q",
+			SplitUpdateAlways, SplitUpdatePKChangeOnly, SplitUpdateNever,
+			s.SplitUpdateToDeleteInsert)
+	}
+
+	if s.ColumnValueSizeLimit < 0 {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"column-value-size-limit should not be negative, but got %d", s.ColumnValueSizeLimit)
+	}
+	if s.ColumnValueSizeLimit > 0 && s.ColumnValueSizeLimit < MinColumnValueSizeLimit {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"column-value-size-limit should be at least %d, but got %d",
+			MinColumnValueSizeLimit, s.ColumnValueSizeLimit)
+	}
+	if s.ColumnValueSizeLimit > 0 && sinkURI != nil &&
+		sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"column-value-size-limit is not supported by the %s sink, "+
+				"since the truncated values corrupt the data", sinkURI.Scheme)
+	}
+
+	switch s.RedactLog {
+	case "", RedactLogNone, RedactLogElide, RedactLogHash:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"redact-log could only be %q, %q or %q, but got %q",
+			RedactLogNone, RedactLogElide, RedactLogHash, s.RedactLog)
+	}
+	if s.ErrorLogSampleIntervalInSec < 0 {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"error-log-sample-interval-in-sec should not be negative, but got %d",
+			s.ErrorLogSampleIntervalInSec)
+	}
 
 	// validate terminator
 	if len(s.Terminator) == 0 {
@@ -218,6 +391,12 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 		}
 	}
 
+	if s.SchemaRegistryConfig != nil {
+		if err := s.SchemaRegistryConfig.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+
 	if s.CSVConfig != nil {
 		return s.validateAndAdjustCSVConfig()
 	}
@@ -263,3 +263,132 @@ func TestValidateAndAdjustCSVConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAndAdjustSchemaRegistryConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       *SchemaRegistryConfig
+		wantStrategy string
+		wantErr      string
+	}{
+		{
+			name:         "default strategy",
+			config:       &SchemaRegistryConfig{},
+			wantStrategy: SubjectNameStrategyTopic,
+		},
+		{
+			name: "basic authentication",
+			config: &SchemaRegistryConfig{
+				Username:            "user",
+				Password:            "pass",
+				SubjectNameStrategy: SubjectNameStrategyTopicRecord,
+			},
+			wantStrategy: SubjectNameStrategyTopicRecord,
+		},
+		{
+			name:    "password without username",
+			config:  &SchemaRegistryConfig{Password: "pass"},
+			wantErr: "schema registry requires username when password is set",
+		},
+		{
+			name: "both bearer token and basic authentication",
+			config: &SchemaRegistryConfig{
+				Username:    "user",
+				Password:    "pass",
+				BearerToken: "token",
+			},
+			wantErr: "can't use bearer token and basic authentication at the same time",
+		},
+		{
+			name:    "invalid strategy",
+			config:  &SchemaRegistryConfig{SubjectNameStrategy: "table"},
+			wantErr: "subject-name-strategy of schema registry could only be",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s := &SinkConfig{SchemaRegistryConfig: tc.config}
+			err := s.validateAndAdjust(nil, false)
+			if tc.wantErr == "" {
+				require.Nil(t, err)
+				require.Equal(t, tc.wantStrategy, tc.config.SubjectNameStrategy)
+			} else {
+				require.Regexp(t, tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSchemaRegistryConfigMaskSensitiveData(t *testing.T) {
+	var nilConfig *SchemaRegistryConfig
+	nilConfig.MaskSensitiveData()
+
+	c := &SchemaRegistryConfig{Username: "user", Password: "pass"}
+	c.MaskSensitiveData()
+	require.Equal(t, &SchemaRegistryConfig{Username: "user", Password: "xxxxx"}, c)
+
+	c = &SchemaRegistryConfig{BearerToken: "token"}
+	c.MaskSensitiveData()
+	require.Equal(t, &SchemaRegistryConfig{BearerToken: "xxxxx"}, c)
+}
+
+func TestValidateAndAdjustSplitUpdate(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range []string{
+		"", SplitUpdateAlways, SplitUpdatePKChangeOnly, SplitUpdateNever,
+	} {
+		s := &SinkConfig{SplitUpdateToDeleteInsert: strategy}
+		require.NoError(t, s.validateAndAdjust(nil, true))
+	}
+
+	s := &SinkConfig{SplitUpdateToDeleteInsert: "sometimes"}
+	require.ErrorContains(t, s.validateAndAdjust(nil, true),
+		"split-update-to-delete-insert could only be")
+}
+
+func TestValidateAndAdjustColumnValueSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, uri := range []string{
+		"kafka://127.0.0.1:9092/test?protocol=canal-json",
+		"s3://bucket/prefix?protocol=csv",
+	} {
+		sinkURI, err := url.Parse(uri)
+		require.NoError(t, err)
+		s := &SinkConfig{ColumnValueSizeLimit: 1024}
+		require.NoError(t, s.validateAndAdjust(sinkURI, true))
+	}
+
+	s := &SinkConfig{ColumnValueSizeLimit: -1}
+	require.ErrorContains(t, s.validateAndAdjust(nil, true),
+		"column-value-size-limit should not be negative")
+	s = &SinkConfig{ColumnValueSizeLimit: MinColumnValueSizeLimit - 1}
+	require.ErrorContains(t, s.validateAndAdjust(nil, true),
+		"column-value-size-limit should be at least")
+
+	sinkURI, err := url.Parse("mysql://root@127.0.0.1:3306/")
+	require.NoError(t, err)
+	s = &SinkConfig{ColumnValueSizeLimit: 1024}
+	require.ErrorContains(t, s.validateAndAdjust(sinkURI, true),
+		"column-value-size-limit is not supported by the mysql sink")
+	s = &SinkConfig{}
+	require.NoError(t, s.validateAndAdjust(sinkURI, true))
+}
+
+func TestValidateAndAdjustRedactLog(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{"", RedactLogNone, RedactLogElide, RedactLogHash} {
+		s := &SinkConfig{RedactLog: mode, ErrorLogSampleIntervalInSec: 60}
+		require.NoError(t, s.validateAndAdjust(nil, true))
+	}
+
+	s := &SinkConfig{RedactLog: "on"}
+	require.ErrorContains(t, s.validateAndAdjust(nil, true),
+		"redact-log could only be")
+	s = &SinkConfig{ErrorLogSampleIntervalInSec: -1}
+	require.ErrorContains(t, s.validateAndAdjust(nil, true),
+		"error-log-sample-interval-in-sec should not be negative")
+}
@@ -67,6 +67,13 @@ var (
 		Help:      "The total number of db delay",
 	}, []string{"id"})
 
+	dbCompactionDebt = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "compaction_debt_bytes",
+		Help:      "The estimated number of bytes need to be compacted by the db",
+	}, []string{"id"})
+
 	dbBlockCacheAccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "ticdc",
 		Subsystem: "db",
@@ -85,6 +92,7 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(dbWriteDelayDuration)
 	registry.MustRegister(dbWriteDelayCount)
 	registry.MustRegister(dbBlockCacheAccess)
+	registry.MustRegister(dbCompactionDebt)
 }
 
 /* There are some metrics shared with pipeline sorter and pull-based-sink sort engine. */
@@ -99,6 +107,16 @@ func WriteDelayCount() *prometheus.GaugeVec {
 	return dbWriteDelayCount
 }
 
+// WriteDelayDuration returns dbWriteDelayDuration.
+func WriteDelayDuration() *prometheus.GaugeVec {
+	return dbWriteDelayDuration
+}
+
+// CompactionDebt returns dbCompactionDebt.
+func CompactionDebt() *prometheus.GaugeVec {
+	return dbCompactionDebt
+}
+
 // LevelCount returns dbLevelCount.
 func LevelCount() *prometheus.GaugeVec {
 	return dbLevelCount
@@ -343,6 +343,10 @@ var (
 		"MySQL worker panic",
 		errors.RFCCodeText("CDC:ErrMySQLWorkerPanic"),
 	)
+	ErrMySQLSchemaDrift = errors.Normalize(
+		"downstream table %s drifts from the upstream schema, missing columns: %v, extra columns: %v",
+		errors.RFCCodeText("CDC:ErrMySQLSchemaDrift"),
+	)
 	ErrAvroToEnvelopeError = errors.Normalize(
 		"to envelope failed",
 		errors.RFCCodeText("CDC:ErrAvroToEnvelopeError"),
@@ -367,6 +371,15 @@ var (
 		"schema manager API error",
 		errors.RFCCodeText("CDC:ErrAvroSchemaAPIError"),
 	)
+	ErrAvroSchemaIncompatible = errors.Normalize(
+		"schema is incompatible with the registered schemas of subject %s, "+
+			"incompatible fields: %v, registry message: %s",
+		errors.RFCCodeText("CDC:ErrAvroSchemaIncompatible"),
+	)
+	ErrAvroSchemaRegistryUnauthorized = errors.Normalize(
+		"schema registry rejected the credentials, status: %d, message: %s",
+		errors.RFCCodeText("CDC:ErrAvroSchemaRegistryUnauthorized"),
+	)
 	ErrMaxwellEncodeFailed = errors.Normalize(
 		"maxwell encode failed",
 		errors.RFCCodeText("CDC:ErrMaxwellEncodeFailed"),
@@ -620,6 +633,18 @@ var (
 		"table not found in processor cache",
 		errors.RFCCodeText("CDC:ErrProcessorTableNotFound"),
 	)
+	ErrTooManyOpenTables = errors.Normalize(
+		"too many open tables in processor, count: %d, limit: %d",
+		errors.RFCCodeText("CDC:ErrTooManyOpenTables"),
+	)
+	ErrReplayTableSpanRefused = errors.Normalize(
+		"can't replay table span from %d, reason: %s",
+		errors.RFCCodeText("CDC:ErrReplayTableSpanRefused"),
+	)
+	ErrProcessorFenceNotSupported = errors.Normalize(
+		"fencing is only supported by the pull-based sink",
+		errors.RFCCodeText("CDC:ErrProcessorFenceNotSupported"),
+	)
 	ErrProcessorEtcdWatch = errors.Normalize(
 		"etcd watch returns error",
 		errors.RFCCodeText("CDC:ErrProcessorEtcdWatch"),
@@ -700,6 +725,14 @@ var (
 		"changefeed update error: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateRefused"),
 	)
+	ErrChangefeedCutoverRefused = errors.Normalize(
+		"changefeed cutover refused: %s",
+		errors.RFCCodeText("CDC:ErrChangefeedCutoverRefused"),
+	)
+	ErrChangefeedCutoverNotFound = errors.Normalize(
+		"cutover of changefeed %s not found",
+		errors.RFCCodeText("CDC:ErrChangefeedCutoverNotFound"),
+	)
 	ErrChangefeedUpdateFailedTransaction = errors.Normalize(
 		"changefeed update failed due to unexpected etcd transaction failure: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateFailed"),
@@ -734,6 +767,11 @@ var (
 			"is earlier than or equal to GC safepoint at %d",
 		errors.RFCCodeText("CDC:ErrStartTsBeforeGC"),
 	)
+	ErrChangefeedMixedKeyspaces = errors.Normalize(
+		"fail to create changefeed because its tables belong to "+
+			"multiple keyspaces %v, a changefeed can only replicate tables of one keyspace",
+		errors.RFCCodeText("CDC:ErrChangefeedMixedKeyspaces"),
+	)
 	ErrTargetTsBeforeStartTs = errors.Normalize(
 		"fail to create changefeed because target-ts %d is earlier than start-ts %d",
 		errors.RFCCodeText("CDC:ErrTargetTsBeforeStartTs"),
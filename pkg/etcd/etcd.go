@@ -128,6 +128,41 @@ type CDCEtcdClient interface {
 		namespace string,
 	) (*model.UpstreamInfo, error)
 
+	GetChangefeedDDLHistory(ctx context.Context,
+		id model.ChangeFeedID,
+	) (*model.DDLHistory, error)
+
+	GetChangefeedOwnerHandoff(ctx context.Context,
+		id model.ChangeFeedID,
+	) (*model.OwnerHandoff, error)
+
+	SaveChangefeedOwnerHandoff(ctx context.Context,
+		id model.ChangeFeedID,
+		handoff *model.OwnerHandoff,
+	) error
+
+	DeleteChangefeedOwnerHandoff(ctx context.Context,
+		id model.ChangeFeedID,
+	) error
+
+	GetChangefeedCutover(ctx context.Context,
+		id model.ChangeFeedID,
+	) (*model.Cutover, error)
+
+	GetChangefeedCutovers(ctx context.Context,
+		namespace string,
+	) (map[model.ChangeFeedID]*model.Cutover, error)
+
+	SaveChangefeedCutover(ctx context.Context,
+		id model.ChangeFeedID,
+		cutover *model.Cutover,
+	) error
+
+	DeleteChangefeedCutover(ctx context.Context,
+		id model.ChangeFeedID,
+		modRevision int64,
+	) error
+
 	GetGCServiceID() string
 
 	GetEnsureGCServiceID(tag string) string
@@ -636,6 +671,178 @@ func (c *CDCEtcdClientImpl) GetUpstreamInfo(ctx context.Context,
 	return info, errors.Trace(err)
 }
 
+// GetChangefeedDDLHistory queries the emitted DDL history of a given changefeed,
+// an empty history is returned if nothing has been recorded.
+func (c *CDCEtcdClientImpl) GetChangefeedDDLHistory(ctx context.Context,
+	id model.ChangeFeedID,
+) (*model.DDLHistory, error) {
+	key := c.changefeedDDLHistoryKey(id)
+	resp, err := c.Client.Get(ctx, key)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	history := &model.DDLHistory{}
+	if resp.Count == 0 {
+		return history, nil
+	}
+	err = history.Unmarshal(resp.Kvs[0].Value)
+	return history, errors.Trace(err)
+}
+
+func (c *CDCEtcdClientImpl) changefeedDDLHistoryKey(id model.ChangeFeedID) string {
+	key := CDCKey{
+		Tp:           CDCKeyTypeChangefeedDDLHistory,
+		ClusterID:    c.ClusterID,
+		ChangefeedID: id,
+	}
+	return key.String()
+}
+
+// GetChangefeedOwnerHandoff queries the owner handoff marker of a given changefeed,
+// nil is returned if the marker doesn't exist.
+func (c *CDCEtcdClientImpl) GetChangefeedOwnerHandoff(ctx context.Context,
+	id model.ChangeFeedID,
+) (*model.OwnerHandoff, error) {
+	resp, err := c.Client.Get(ctx, c.changefeedOwnerHandoffKey(id))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Count == 0 {
+		return nil, nil
+	}
+	handoff := &model.OwnerHandoff{}
+	err = handoff.Unmarshal(resp.Kvs[0].Value)
+	return handoff, errors.Trace(err)
+}
+
+// SaveChangefeedOwnerHandoff stores the owner handoff marker of a changefeed into etcd
+func (c *CDCEtcdClientImpl) SaveChangefeedOwnerHandoff(ctx context.Context,
+	id model.ChangeFeedID,
+	handoff *model.OwnerHandoff,
+) error {
+	value, err := handoff.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = c.Client.Put(ctx, c.changefeedOwnerHandoffKey(id), string(value))
+	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+}
+
+// DeleteChangefeedOwnerHandoff deletes the owner handoff marker of a changefeed from etcd
+func (c *CDCEtcdClientImpl) DeleteChangefeedOwnerHandoff(ctx context.Context,
+	id model.ChangeFeedID,
+) error {
+	_, err := c.Client.Delete(ctx, c.changefeedOwnerHandoffKey(id))
+	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+}
+
+func (c *CDCEtcdClientImpl) changefeedOwnerHandoffKey(id model.ChangeFeedID) string {
+	key := CDCKey{
+		Tp:           CDCKeyTypeChangefeedOwnerHandoff,
+		ClusterID:    c.ClusterID,
+		ChangefeedID: id,
+	}
+	return key.String()
+}
+
+// GetChangefeedCutover queries the cutover from a given changefeed,
+// nil is returned if there is no cutover from the changefeed.
+func (c *CDCEtcdClientImpl) GetChangefeedCutover(ctx context.Context,
+	id model.ChangeFeedID,
+) (*model.Cutover, error) {
+	resp, err := c.Client.Get(ctx, c.changefeedCutoverKey(id))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Count == 0 {
+		return nil, nil
+	}
+	cutover := &model.Cutover{}
+	if err := cutover.Unmarshal(resp.Kvs[0].Value); err != nil {
+		return nil, errors.Trace(err)
+	}
+	cutover.ModRevision = resp.Kvs[0].ModRevision
+	return cutover, nil
+}
+
+// GetChangefeedCutovers queries all the cutovers from the changefeeds in the namespace
+func (c *CDCEtcdClientImpl) GetChangefeedCutovers(ctx context.Context,
+	namespace string,
+) (map[model.ChangeFeedID]*model.Cutover, error) {
+	prefix := NamespacedPrefix(c.ClusterID, namespace) + ChangefeedCutoverKey + "/"
+	resp, err := c.Client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	cutovers := make(map[model.ChangeFeedID]*model.Cutover, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		cutover := &model.Cutover{}
+		if err := cutover.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		cutover.ModRevision = kv.ModRevision
+		id := model.ChangeFeedID{Namespace: namespace, ID: string(kv.Key[len(prefix):])}
+		cutovers[id] = cutover
+	}
+	return cutovers, nil
+}
+
+// SaveChangefeedCutover stores the cutover from a changefeed into etcd if it
+// isn't changed since cutover.ModRevision, which is updated after it's saved.
+func (c *CDCEtcdClientImpl) SaveChangefeedCutover(ctx context.Context,
+	id model.ChangeFeedID,
+	cutover *model.Cutover,
+) error {
+	value, err := cutover.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	key := c.changefeedCutoverKey(id)
+	cmps := []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(key), "=", cutover.ModRevision),
+	}
+	opsThen := []clientv3.Op{clientv3.OpPut(key, string(value))}
+	resp, err := c.Client.Txn(ctx, cmps, opsThen, TxnEmptyOpsElse)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if !resp.Succeeded {
+		return cerror.ErrChangefeedUpdateFailedTransaction.GenWithStackByArgs(id)
+	}
+	cutover.ModRevision = resp.Header.Revision
+	return nil
+}
+
+// DeleteChangefeedCutover deletes the cutover from a changefeed from etcd if
+// it isn't changed since modRevision.
+func (c *CDCEtcdClientImpl) DeleteChangefeedCutover(ctx context.Context,
+	id model.ChangeFeedID,
+	modRevision int64,
+) error {
+	key := c.changefeedCutoverKey(id)
+	cmps := []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(key), "=", modRevision),
+	}
+	opsThen := []clientv3.Op{clientv3.OpDelete(key)}
+	resp, err := c.Client.Txn(ctx, cmps, opsThen, TxnEmptyOpsElse)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if !resp.Succeeded {
+		return cerror.ErrChangefeedUpdateFailedTransaction.GenWithStackByArgs(id)
+	}
+	return nil
+}
+
+func (c *CDCEtcdClientImpl) changefeedCutoverKey(id model.ChangeFeedID) string {
+	key := CDCKey{
+		Tp:           CDCKeyTypeChangefeedCutover,
+		ClusterID:    c.ClusterID,
+		ChangefeedID: id,
+	}
+	return key.String()
+}
+
 // GcServiceIDForTest returns the gc service ID for tests
 func GcServiceIDForTest() string {
 	return fmt.Sprintf("ticdc-%s-%d", "default", 0)
@@ -222,6 +222,113 @@ func TestCreateChangefeed(t *testing.T) {
 	require.True(t, cerror.ErrChangeFeedAlreadyExists.Equal(err))
 }
 
+func TestOpChangefeedDDLHistory(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("test-ddl-history")
+	history, err := s.client.GetChangefeedDDLHistory(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 0)
+
+	history.Entries = append(history.Entries, model.DDLHistoryEntry{
+		Query:    "CREATE TABLE t(id int)",
+		CommitTs: 100,
+		Target:   "`test`.`t`",
+	})
+	// the history is written by the owner with the patches of the changefeed state.
+	value, err := history.Marshal()
+	require.NoError(t, err)
+	_, err = s.client.Client.Put(ctx, s.client.changefeedDDLHistoryKey(id), string(value))
+	require.NoError(t, err)
+	history, err = s.client.GetChangefeedDDLHistory(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 1)
+	require.Equal(t, uint64(100), history.Entries[0].CommitTs)
+}
+
+func TestOpChangefeedOwnerHandoff(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("test-owner-handoff")
+	handoff, err := s.client.GetChangefeedOwnerHandoff(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, handoff)
+
+	require.NoError(t, s.client.SaveChangefeedOwnerHandoff(ctx, id, &model.OwnerHandoff{
+		CaptureID:    "capture-1",
+		CheckpointTs: 100,
+	}))
+	handoff, err = s.client.GetChangefeedOwnerHandoff(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "capture-1", handoff.CaptureID)
+	require.Equal(t, uint64(100), handoff.CheckpointTs)
+
+	require.NoError(t, s.client.DeleteChangefeedOwnerHandoff(ctx, id))
+	handoff, err = s.client.GetChangefeedOwnerHandoff(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, handoff)
+}
+
+func TestOpChangefeedCutover(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("test-cutover-blue")
+	cutover, err := s.client.GetChangefeedCutover(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, cutover)
+
+	saved := &model.Cutover{
+		From:     id,
+		To:       model.DefaultChangeFeedID("test-cutover-green"),
+		TargetTs: 100,
+		State:    model.CutoverStatePending,
+	}
+	require.NoError(t, s.client.SaveChangefeedCutover(ctx, id, saved))
+	require.NotZero(t, saved.ModRevision)
+	cutover, err = s.client.GetChangefeedCutover(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "test-cutover-green", cutover.To.ID)
+	require.Equal(t, uint64(100), cutover.TargetTs)
+	require.Equal(t, model.CutoverStatePending, cutover.State)
+	require.Equal(t, saved.ModRevision, cutover.ModRevision)
+
+	// a new cutover is not saved if there is one.
+	err = s.client.SaveChangefeedCutover(ctx, id, &model.Cutover{From: id, TargetTs: 200})
+	require.True(t, cerror.ErrChangefeedUpdateFailedTransaction.Equal(err))
+
+	cutovers, err := s.client.GetChangefeedCutovers(ctx, model.DefaultNamespace)
+	require.NoError(t, err)
+	require.Len(t, cutovers, 1)
+	require.Equal(t, uint64(100), cutovers[id].TargetTs)
+	require.Equal(t, saved.ModRevision, cutovers[id].ModRevision)
+
+	// the cutover changed since it's read is neither saved nor deleted.
+	cutover.State = model.CutoverStateCompleted
+	require.NoError(t, s.client.SaveChangefeedCutover(ctx, id, cutover))
+	saved.TargetTs = 300
+	err = s.client.SaveChangefeedCutover(ctx, id, saved)
+	require.True(t, cerror.ErrChangefeedUpdateFailedTransaction.Equal(err))
+	err = s.client.DeleteChangefeedCutover(ctx, id, saved.ModRevision)
+	require.True(t, cerror.ErrChangefeedUpdateFailedTransaction.Equal(err))
+	cutover, err = s.client.GetChangefeedCutover(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, model.CutoverStateCompleted, cutover.State)
+
+	require.NoError(t, s.client.DeleteChangefeedCutover(ctx, id, cutover.ModRevision))
+	cutover, err = s.client.GetChangefeedCutover(ctx, id)
+	require.NoError(t, err)
+	require.Nil(t, cutover)
+}
+
 func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	s := &Tester{}
 	s.SetUpTest(t)
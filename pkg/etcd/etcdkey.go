@@ -34,6 +34,14 @@ const (
 	ChangefeedInfoKey = "/changefeed/info"
 	// ChangefeedStatusKey is the key path for changefeed status
 	ChangefeedStatusKey = "/changefeed/status"
+	// ChangefeedDDLHistoryKey is the key path for the emitted DDL history of changefeed
+	ChangefeedDDLHistoryKey = "/changefeed/ddl-history"
+	// ChangefeedOwnerHandoffKey is the key path for the handoff marker of changefeed
+	// persisted by an owner which resigns gracefully
+	ChangefeedOwnerHandoffKey = "/changefeed/owner-handoff"
+	// ChangefeedCutoverKey is the key path for the cutover from changefeed
+	// to another one
+	ChangefeedCutoverKey = "/changefeed/cutover"
 	// metaVersionKey is the key path for metadata version
 	metaVersionKey = "/meta/meta-version"
 	upstreamKey    = "/upstream"
@@ -63,6 +71,9 @@ const (
 	CDCKeyTypeTaskPosition
 	CDCKeyTypeMetaVersion
 	CDCKeyTypeUpStream
+	CDCKeyTypeChangefeedDDLHistory
+	CDCKeyTypeChangefeedOwnerHandoff
+	CDCKeyTypeChangefeedCutover
 )
 
 // CDCKey represents an etcd key which is defined by TiCDC
@@ -167,6 +178,30 @@ func (k *CDCKey) Parse(clusterID, key string) error {
 				ID:        key[len(ChangefeedStatusKey)+1:],
 			}
 			k.OwnerLeaseID = ""
+		case strings.HasPrefix(key, ChangefeedDDLHistoryKey):
+			k.Tp = CDCKeyTypeChangefeedDDLHistory
+			k.CaptureID = ""
+			k.ChangefeedID = model.ChangeFeedID{
+				Namespace: namespace,
+				ID:        key[len(ChangefeedDDLHistoryKey)+1:],
+			}
+			k.OwnerLeaseID = ""
+		case strings.HasPrefix(key, ChangefeedOwnerHandoffKey):
+			k.Tp = CDCKeyTypeChangefeedOwnerHandoff
+			k.CaptureID = ""
+			k.ChangefeedID = model.ChangeFeedID{
+				Namespace: namespace,
+				ID:        key[len(ChangefeedOwnerHandoffKey)+1:],
+			}
+			k.OwnerLeaseID = ""
+		case strings.HasPrefix(key, ChangefeedCutoverKey):
+			k.Tp = CDCKeyTypeChangefeedCutover
+			k.CaptureID = ""
+			k.ChangefeedID = model.ChangeFeedID{
+				Namespace: namespace,
+				ID:        key[len(ChangefeedCutoverKey)+1:],
+			}
+			k.OwnerLeaseID = ""
 		case strings.HasPrefix(key, taskPositionKey):
 			splitKey := strings.SplitN(key[len(taskPositionKey)+1:], "/", 2)
 			if len(splitKey) != 2 {
@@ -201,6 +236,15 @@ func (k *CDCKey) String() string {
 	case CDCKeyTypeChangeFeedStatus:
 		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedStatusKey +
 			"/" + k.ChangefeedID.ID
+	case CDCKeyTypeChangefeedDDLHistory:
+		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedDDLHistoryKey +
+			"/" + k.ChangefeedID.ID
+	case CDCKeyTypeChangefeedOwnerHandoff:
+		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedOwnerHandoffKey +
+			"/" + k.ChangefeedID.ID
+	case CDCKeyTypeChangefeedCutover:
+		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedCutoverKey +
+			"/" + k.ChangefeedID.ID
 	case CDCKeyTypeTaskPosition:
 		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + taskPositionKey +
 			"/" + k.CaptureID + "/" + k.ChangefeedID.ID
@@ -105,6 +105,30 @@ func TestEtcdKey(t *testing.T) {
 			Namespace:  model.DefaultNamespace,
 			UpstreamID: 12345,
 		},
+	}, {
+		key: DefaultClusterAndNamespacePrefix + "/changefeed/ddl-history/test-changefeed",
+		expected: &CDCKey{
+			Tp:           CDCKeyTypeChangefeedDDLHistory,
+			ChangefeedID: model.DefaultChangeFeedID("test-changefeed"),
+			ClusterID:    DefaultCDCClusterID,
+			Namespace:    model.DefaultNamespace,
+		},
+	}, {
+		key: DefaultClusterAndNamespacePrefix + "/changefeed/owner-handoff/test-changefeed",
+		expected: &CDCKey{
+			Tp:           CDCKeyTypeChangefeedOwnerHandoff,
+			ChangefeedID: model.DefaultChangeFeedID("test-changefeed"),
+			ClusterID:    DefaultCDCClusterID,
+			Namespace:    model.DefaultNamespace,
+		},
+	}, {
+		key: DefaultClusterAndNamespacePrefix + "/changefeed/cutover/test-changefeed",
+		expected: &CDCKey{
+			Tp:           CDCKeyTypeChangefeedCutover,
+			ChangefeedID: model.DefaultChangeFeedID("test-changefeed"),
+			ClusterID:    DefaultCDCClusterID,
+			Namespace:    model.DefaultNamespace,
+		},
 	}, {
 		key: fmt.Sprintf("%s%s", DefaultClusterAndMetaPrefix, metaVersionKey),
 		expected: &CDCKey{
@@ -160,7 +184,7 @@ func TestEtcdKeyParseError(t *testing.T) {
 		}
 	}
 	k := new(CDCKey)
-	k.Tp = CDCKeyTypeUpStream + 1
+	k.Tp = CDCKeyTypeChangefeedOwnerHandoff + 1
 	require.Panics(t, func() {
 		_ = k.String()
 	})
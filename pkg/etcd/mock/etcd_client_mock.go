@@ -94,6 +94,34 @@ func (mr *MockCDCEtcdClientMockRecorder) DeleteCaptureInfo(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCaptureInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteCaptureInfo), arg0, arg1)
 }
 
+// DeleteChangefeedCutover mocks base method.
+func (m *MockCDCEtcdClient) DeleteChangefeedCutover(ctx context.Context, id model.ChangeFeedID, modRevision int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangefeedCutover", ctx, id, modRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangefeedCutover indicates an expected call of DeleteChangefeedCutover.
+func (mr *MockCDCEtcdClientMockRecorder) DeleteChangefeedCutover(ctx, id, modRevision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangefeedCutover", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteChangefeedCutover), ctx, id, modRevision)
+}
+
+// DeleteChangefeedOwnerHandoff mocks base method.
+func (m *MockCDCEtcdClient) DeleteChangefeedOwnerHandoff(ctx context.Context, id model.ChangeFeedID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangefeedOwnerHandoff", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangefeedOwnerHandoff indicates an expected call of DeleteChangefeedOwnerHandoff.
+func (mr *MockCDCEtcdClientMockRecorder) DeleteChangefeedOwnerHandoff(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangefeedOwnerHandoff", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteChangefeedOwnerHandoff), ctx, id)
+}
+
 // GetAllCDCInfo mocks base method.
 func (m *MockCDCEtcdClient) GetAllCDCInfo(ctx context.Context) ([]*mvccpb.KeyValue, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +199,66 @@ func (mr *MockCDCEtcdClientMockRecorder) GetChangeFeedStatus(ctx, id interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedStatus", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangeFeedStatus), ctx, id)
 }
 
+// GetChangefeedCutover mocks base method.
+func (m *MockCDCEtcdClient) GetChangefeedCutover(ctx context.Context, id model.ChangeFeedID) (*model.Cutover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedCutover", ctx, id)
+	ret0, _ := ret[0].(*model.Cutover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedCutover indicates an expected call of GetChangefeedCutover.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangefeedCutover(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedCutover", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangefeedCutover), ctx, id)
+}
+
+// GetChangefeedCutovers mocks base method.
+func (m *MockCDCEtcdClient) GetChangefeedCutovers(ctx context.Context, namespace string) (map[model.ChangeFeedID]*model.Cutover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedCutovers", ctx, namespace)
+	ret0, _ := ret[0].(map[model.ChangeFeedID]*model.Cutover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedCutovers indicates an expected call of GetChangefeedCutovers.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangefeedCutovers(ctx, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedCutovers", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangefeedCutovers), ctx, namespace)
+}
+
+// GetChangefeedDDLHistory mocks base method.
+func (m *MockCDCEtcdClient) GetChangefeedDDLHistory(ctx context.Context, id model.ChangeFeedID) (*model.DDLHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedDDLHistory", ctx, id)
+	ret0, _ := ret[0].(*model.DDLHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedDDLHistory indicates an expected call of GetChangefeedDDLHistory.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangefeedDDLHistory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedDDLHistory", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangefeedDDLHistory), ctx, id)
+}
+
+// GetChangefeedOwnerHandoff mocks base method.
+func (m *MockCDCEtcdClient) GetChangefeedOwnerHandoff(ctx context.Context, id model.ChangeFeedID) (*model.OwnerHandoff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedOwnerHandoff", ctx, id)
+	ret0, _ := ret[0].(*model.OwnerHandoff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedOwnerHandoff indicates an expected call of GetChangefeedOwnerHandoff.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangefeedOwnerHandoff(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedOwnerHandoff", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangefeedOwnerHandoff), ctx, id)
+}
+
 // GetClusterID mocks base method.
 func (m *MockCDCEtcdClient) GetClusterID() string {
 	m.ctrl.T.Helper()
@@ -300,6 +388,34 @@ func (mr *MockCDCEtcdClientMockRecorder) SaveChangeFeedInfo(ctx, info, changeFee
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangeFeedInfo), ctx, info, changeFeedID)
 }
 
+// SaveChangefeedCutover mocks base method.
+func (m *MockCDCEtcdClient) SaveChangefeedCutover(ctx context.Context, id model.ChangeFeedID, cutover *model.Cutover) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveChangefeedCutover", ctx, id, cutover)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveChangefeedCutover indicates an expected call of SaveChangefeedCutover.
+func (mr *MockCDCEtcdClientMockRecorder) SaveChangefeedCutover(ctx, id, cutover interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangefeedCutover", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangefeedCutover), ctx, id, cutover)
+}
+
+// SaveChangefeedOwnerHandoff mocks base method.
+func (m *MockCDCEtcdClient) SaveChangefeedOwnerHandoff(ctx context.Context, id model.ChangeFeedID, handoff *model.OwnerHandoff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveChangefeedOwnerHandoff", ctx, id, handoff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveChangefeedOwnerHandoff indicates an expected call of SaveChangefeedOwnerHandoff.
+func (mr *MockCDCEtcdClientMockRecorder) SaveChangefeedOwnerHandoff(ctx, id, handoff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangefeedOwnerHandoff", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangefeedOwnerHandoff), ctx, id, handoff)
+}
+
 // UpdateChangefeedAndUpstream mocks base method.
 func (m *MockCDCEtcdClient) UpdateChangefeedAndUpstream(ctx context.Context, upstreamInfo *model.UpstreamInfo, changeFeedInfo *model.ChangeFeedInfo, changeFeedID model.ChangeFeedID) error {
 	m.ctrl.T.Helper()
@@ -150,6 +150,22 @@ func (r *dmlExprFilterRule) resetExpr(tableName string) {
 	delete(r.deleteExprs, tableName)
 }
 
+// updateTableInfo caches the tableInfo of the table, the expressions of the
+// table are reset if its tableInfo was updated.
+// The caller must hold r.mu.Lock() before calling this function.
+func (r *dmlExprFilterRule) updateTableInfo(tableName string, ti *model.TableInfo) {
+	if oldTi, ok := r.tables[tableName]; ok {
+		// If one table's tableInfo was updated, we need to reset this rule
+		// and update the tableInfo in the cache.
+		if ti.Version != oldTi.Version {
+			r.tables[tableName] = ti.Clone()
+			r.resetExpr(tableName)
+		}
+	} else {
+		r.tables[tableName] = ti.Clone()
+	}
+}
+
 // getInsertExprs returns the expression filter to filter INSERT events.
 // This function will lazy calculate expressions if not initialized.
 func (r *dmlExprFilterRule) getInsertExpr(ti *model.TableInfo) (
@@ -254,16 +270,7 @@ func (r *dmlExprFilterRule) shouldSkipDML(
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if oldTi, ok := r.tables[tableName]; ok {
-		// If one table's tableInfo was updated, we need to reset this rule
-		// and update the tableInfo in the cache.
-		if ti.Version != oldTi.Version {
-			r.tables[tableName] = ti.Clone()
-			r.resetExpr(ti.TableName.String())
-		}
-	} else {
-		r.tables[tableName] = ti.Clone()
-	}
+	r.updateTableInfo(tableName, ti)
 
 	switch {
 	case row.IsInsert():
@@ -314,6 +321,44 @@ func (r *dmlExprFilterRule) shouldSkipDML(
 	}
 }
 
+// getTableRules returns the expressions of the rule resolved with the
+// tableInfo. An expression which can't be resolved is returned as it is.
+func (r *dmlExprFilterRule) getTableRules(ti *model.TableInfo) []Rule {
+	tableName := ti.TableName.String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.updateTableInfo(tableName, ti)
+
+	exprs := []struct {
+		ruleType string
+		raw      string
+		get      func(*model.TableInfo) (expression.Expression, error)
+	}{
+		{RuleTypeIgnoreInsertValueExpr, r.config.IgnoreInsertValueExpr, r.getInsertExpr},
+		{RuleTypeIgnoreUpdateNewValueExpr, r.config.IgnoreUpdateNewValueExpr, r.getUpdateNewExpr},
+		{RuleTypeIgnoreUpdateOldValueExpr, r.config.IgnoreUpdateOldValueExpr, r.getUpdateOldExpr},
+		{RuleTypeIgnoreDeleteValueExpr, r.config.IgnoreDeleteValueExpr, r.getDeleteExpr},
+	}
+	res := make([]Rule, 0)
+	for _, e := range exprs {
+		if e.raw == "" {
+			continue
+		}
+		condition := e.raw
+		if expr, err := e.get(ti); err == nil && expr != nil {
+			condition = expr.String()
+		}
+		res = append(res, Rule{
+			Type:      e.ruleType,
+			Matcher:   r.config.Matcher,
+			Condition: condition,
+		})
+	}
+	return res
+}
+
 func (r *dmlExprFilterRule) skipDMLByExpression(
 	rowData []types.Datum,
 	expr expression.Expression,
@@ -426,3 +471,13 @@ func (f *dmlExprFilter) shouldSkipDML(
 	}
 	return false, nil
 }
+
+// getTableRules returns the expressions of the rules which match the table,
+// resolved with the tableInfo.
+func (f *dmlExprFilter) getTableRules(ti *model.TableInfo) []Rule {
+	res := make([]Rule, 0)
+	for _, rule := range f.getRules(ti.TableName.Schema, ti.TableName.Table) {
+		res = append(res, rule.getTableRules(ti)...)
+	}
+	return res
+}
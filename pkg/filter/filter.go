@@ -14,6 +14,9 @@
 package filter
 
 import (
+	"strconv"
+	"strings"
+
 	timodel "github.com/pingcap/tidb/parser/model"
 	tfilter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -74,6 +77,32 @@ type Filter interface {
 	// Verify should only be called by create changefeed OpenAPI.
 	// Its purpose is to verify the expression filter config.
 	Verify(tableInfos []*model.TableInfo) error
+	// GetTableDMLRules returns the rules which are applied to the DML events
+	// of the table, resolved with the tableInfo of the table.
+	GetTableDMLRules(ti *model.TableInfo) []Rule
+}
+
+// The types of the filter rules, which are the names of their config items.
+const (
+	RuleTypeIgnoreTxnStartTs         = "ignore-txn-start-ts"
+	RuleTypeIgnoreEvent              = "ignore-event"
+	RuleTypeIgnoreInsertValueExpr    = "ignore-insert-value-expr"
+	RuleTypeIgnoreUpdateNewValueExpr = "ignore-update-new-value-expr"
+	RuleTypeIgnoreUpdateOldValueExpr = "ignore-update-old-value-expr"
+	RuleTypeIgnoreDeleteValueExpr    = "ignore-delete-value-expr"
+)
+
+// Rule is a filter rule resolved for a table.
+type Rule struct {
+	// Type is the type of the rule, see the RuleType constants.
+	Type string
+	// Matcher is the matcher of the event filter which the rule comes from,
+	// it's empty if the rule is applied to all tables.
+	Matcher []string
+	// Condition is the condition of the rule, i.e. the ignored start ts, the
+	// ignored event types, or the expression resolved with the columns of
+	// the table.
+	Condition string
 }
 
 // filter implements Filter.
@@ -210,6 +239,24 @@ func (f *filter) Verify(tableInfos []*model.TableInfo) error {
 	return f.dmlExprFilter.verify(tableInfos)
 }
 
+// GetTableDMLRules implements Filter interface. The rules are returned in
+// the order they are applied by ShouldIgnoreDMLEvent.
+func (f *filter) GetTableDMLRules(ti *model.TableInfo) []Rule {
+	rules := make([]Rule, 0)
+	if len(f.ignoreTxnStartTs) > 0 {
+		startTs := make([]string, 0, len(f.ignoreTxnStartTs))
+		for _, ts := range f.ignoreTxnStartTs {
+			startTs = append(startTs, strconv.FormatUint(ts, 10))
+		}
+		rules = append(rules, Rule{
+			Type:      RuleTypeIgnoreTxnStartTs,
+			Condition: strings.Join(startTs, ", "),
+		})
+	}
+	rules = append(rules, f.sqlEventFilter.getTableDMLRules(ti.TableName.Schema, ti.TableName.Table)...)
+	return append(rules, f.dmlExprFilter.getTableRules(ti)...)
+}
+
 func (f *filter) shouldIgnoreStartTs(ts uint64) bool {
 	for _, ignoreTs := range f.ignoreTxnStartTs {
 		if ignoreTs == ts {
@@ -327,3 +327,51 @@ func TestShouldIgnoreDDL(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTableDMLRules(t *testing.T) {
+	helper := newTestHelper(t)
+	defer helper.close()
+	helper.getTk().MustExec("use test;")
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Filter = &config.FilterConfig{
+		Rules:            []string{"test.*"},
+		IgnoreTxnStartTs: []uint64{100, 200},
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher:     []string{"test.worker"},
+				IgnoreEvent: []bf.EventType{bf.AllDML, bf.CreateTable},
+				IgnoreSQL:   []string{"^drop"},
+			},
+			{
+				Matcher:               []string{"test.worker"},
+				IgnoreEvent:           []bf.EventType{bf.DropTable},
+				IgnoreInsertValueExpr: "age >= 20",
+			},
+			{
+				Matcher:     []string{"test.other"},
+				IgnoreEvent: []bf.EventType{bf.DeleteEvent},
+			},
+		},
+	}
+	f, err := NewFilter(cfg, "")
+	require.Nil(t, err)
+
+	ti := helper.execDDL("create table test.worker(id int primary key, age int)")
+	rules := f.GetTableDMLRules(ti)
+	require.Len(t, rules, 3)
+	require.Equal(t, Rule{Type: RuleTypeIgnoreTxnStartTs, Condition: "100, 200"}, rules[0])
+	require.Equal(t, Rule{
+		Type:      RuleTypeIgnoreEvent,
+		Matcher:   []string{"test.worker"},
+		Condition: "insert, update, delete",
+	}, rules[1])
+	require.Equal(t, RuleTypeIgnoreInsertValueExpr, rules[2].Type)
+	require.Equal(t, []string{"test.worker"}, rules[2].Matcher)
+	// the expression is resolved with the columns of the table.
+	require.NotEqual(t, "age >= 20", rules[2].Condition)
+	require.Contains(t, rules[2].Condition, "20")
+
+	ti = helper.execDDL("create table test.t1(id int primary key)")
+	require.Equal(t, []Rule{{Type: RuleTypeIgnoreTxnStartTs, Condition: "100, 200"}}, f.GetTableDMLRules(ti))
+}
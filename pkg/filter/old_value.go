@@ -0,0 +1,64 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// OldValueMatcher decides whether the old values of a table are captured.
+// It's safe for concurrent use.
+type OldValueMatcher struct {
+	// all indicates the old values of all tables are captured.
+	all bool
+	// tables matches the tables whose old values are captured if all is false,
+	// nil means none.
+	tables tfilter.Filter
+}
+
+// NewOldValueMatcher creates an OldValueMatcher by `enable-old-value` and
+// `old-value-tables` of the replica config.
+func NewOldValueMatcher(cfg *config.ReplicaConfig) (*OldValueMatcher, error) {
+	if cfg.EnableOldValue || len(cfg.OldValueTables) == 0 {
+		return &OldValueMatcher{all: cfg.EnableOldValue}, nil
+	}
+	f, err := tfilter.Parse(cfg.OldValueTables)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.OldValueTables)
+	}
+	if !cfg.CaseSensitive {
+		f = tfilter.CaseInsensitive(f)
+	}
+	return &OldValueMatcher{tables: f}, nil
+}
+
+// NewOldValueMatcherForAll creates an OldValueMatcher which captures the old
+// values of all tables or none.
+func NewOldValueMatcherForAll(enableOldValue bool) *OldValueMatcher {
+	return &OldValueMatcher{all: enableOldValue}
+}
+
+// Enabled returns whether the old values of the table are captured.
+func (m *OldValueMatcher) Enabled(schema, table string) bool {
+	return m.all || (m.tables != nil && m.tables.MatchTable(schema, table))
+}
+
+// IsMixed returns whether only the old values of some tables are captured.
+// In this case, the update events of the other tables are still delivered as
+// updates, but without the old values of the non-handle-key columns.
+func (m *OldValueMatcher) IsMixed() bool {
+	return !m.all && m.tables != nil
+}
@@ -14,6 +14,8 @@
 package filter
 
 import (
+	"strings"
+
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -47,6 +49,11 @@ type sqlEventRule struct {
 	// which means not match `test.t1`.
 	tf tfilter.Filter
 	bf *bf.BinlogEvent
+	// ignoreDMLTypes are the ignored DML event types of the rule, with
+	// `all dml` expanded.
+	ignoreDMLTypes []bf.EventType
+	// config is the config of the rule.
+	config *config.EventFilterRule
 }
 
 func newSQLEventFilterRule(cfg *config.EventFilterRule) (*sqlEventRule, error) {
@@ -56,12 +63,14 @@ func newSQLEventFilterRule(cfg *config.EventFilterRule) (*sqlEventRule, error) {
 	}
 
 	res := &sqlEventRule{
-		tf: tf,
+		tf:     tf,
+		config: cfg,
 	}
 
 	if err := verifyIgnoreEvents(cfg.IgnoreEvent); err != nil {
 		return nil, err
 	}
+	res.ignoreDMLTypes = expandIgnoreDMLTypes(cfg.IgnoreEvent)
 
 	bfRule := &bf.BinlogEventRule{
 		SchemaPattern: binlogFilterSchemaPlaceholder,
@@ -92,6 +101,21 @@ func verifyIgnoreEvents(types []bf.EventType) error {
 	return nil
 }
 
+// expandIgnoreDMLTypes returns the DML event types in types, `all dml` is
+// expanded to the DML event types it contains.
+func expandIgnoreDMLTypes(types []bf.EventType) []bf.EventType {
+	res := make([]bf.EventType, 0)
+	for _, et := range []bf.EventType{bf.InsertEvent, bf.UpdateEvent, bf.DeleteEvent} {
+		for _, t := range types {
+			if t == et || t == bf.AllDML {
+				res = append(res, et)
+				break
+			}
+		}
+	}
+	return res
+}
+
 // sqlEventFilter is a filter that filters DDL/DML event by its type or query.
 type sqlEventFilter struct {
 	p     *parser.Parser
@@ -214,3 +238,25 @@ var supportedEventTypes = []bf.EventType{
 	bf.DropTablePartition,
 	bf.TruncateTablePartition,
 }
+
+// getTableDMLRules returns the rules which ignore the DML events of the table
+// by their types. The rules of the queries are not returned since they never
+// match DML events.
+func (f *sqlEventFilter) getTableDMLRules(schema, table string) []Rule {
+	res := make([]Rule, 0)
+	for _, rule := range f.getRules(schema, table) {
+		if len(rule.ignoreDMLTypes) == 0 {
+			continue
+		}
+		types := make([]string, 0, len(rule.ignoreDMLTypes))
+		for _, et := range rule.ignoreDMLTypes {
+			types = append(types, string(et))
+		}
+		res = append(res, Rule{
+			Type:      RuleTypeIgnoreEvent,
+			Matcher:   rule.config.Matcher,
+			Condition: strings.Join(types, ", "),
+		})
+	}
+	return res
+}
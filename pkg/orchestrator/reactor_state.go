@@ -123,7 +123,8 @@ func (s *GlobalReactorState) Update(key util.EtcdKey, value []byte, _ bool) erro
 			zap.Uint64("upstream", k.UpstreamID),
 			zap.Any("info", newUpstreamInfo))
 		s.Upstreams[k.UpstreamID] = &newUpstreamInfo
-	case etcd.CDCKeyTypeMetaVersion:
+	case etcd.CDCKeyTypeMetaVersion, etcd.CDCKeyTypeChangefeedDDLHistory,
+		etcd.CDCKeyTypeChangefeedOwnerHandoff, etcd.CDCKeyTypeChangefeedCutover:
 	default:
 		log.Warn("receive an unexpected etcd event", zap.String("key", key.String()), zap.ByteString("value", value))
 	}
@@ -354,10 +355,28 @@ func (s *ChangefeedReactorState) PatchTaskPosition(captureID model.CaptureID, fn
 	})
 }
 
+// PatchDDLHistory appends a DataPatch which can modify the emitted DDL history.
+// The history is not kept in the state, since it's only written by the owner.
+func (s *ChangefeedReactorState) PatchDDLHistory(fn func(*model.DDLHistory) (*model.DDLHistory, bool, error)) {
+	key := &etcd.CDCKey{
+		ClusterID:    s.ClusterID,
+		Tp:           etcd.CDCKeyTypeChangefeedDDLHistory,
+		ChangefeedID: s.ID,
+	}
+	s.patchAny(key.String(), ddlHistoryTPI, func(e interface{}) (interface{}, bool, error) {
+		// e == nil means that the key is not exist before this patch
+		if e == nil {
+			return fn(nil)
+		}
+		return fn(e.(*model.DDLHistory))
+	})
+}
+
 var (
 	taskPositionTPI     *model.TaskPosition
 	changefeedStatusTPI *model.ChangeFeedStatus
 	changefeedInfoTPI   *model.ChangeFeedInfo
+	ddlHistoryTPI       *model.DDLHistory
 )
 
 func (s *ChangefeedReactorState) patchAny(key string, tpi interface{}, fn func(interface{}) (interface{}, bool, error)) {
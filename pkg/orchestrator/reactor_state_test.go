@@ -125,6 +125,8 @@ func TestChangefeedStateUpdate(t *testing.T) {
 						Mounter:          &config.MounterConfig{WorkerNum: 16},
 						Sink:             &config.SinkConfig{Protocol: "open-protocol"},
 						Consistent:       &config.ConsistentConfig{Level: "normal", Storage: "local"},
+						DDLHistory:       &config.DDLHistoryConfig{MaxCount: 100, Retention: 7 * 24 * time.Hour},
+						DDLPacing:        &config.DDLPacingConfig{QueueWarningThreshold: 1000},
 					},
 				},
 				Status: &model.ChangeFeedStatus{CheckpointTs: 421980719742451713, ResolvedTs: 421980720003809281},
@@ -173,6 +175,8 @@ func TestChangefeedStateUpdate(t *testing.T) {
 						Mounter:          &config.MounterConfig{WorkerNum: 16},
 						Sink:             &config.SinkConfig{Protocol: "open-protocol"},
 						Consistent:       &config.ConsistentConfig{Level: "normal", Storage: "local"},
+						DDLHistory:       &config.DDLHistoryConfig{MaxCount: 100, Retention: 7 * 24 * time.Hour},
+						DDLPacing:        &config.DDLPacingConfig{QueueWarningThreshold: 1000},
 					},
 				},
 				Status: &model.ChangeFeedStatus{CheckpointTs: 421980719742451713, ResolvedTs: 421980720003809281},
@@ -226,6 +230,8 @@ func TestChangefeedStateUpdate(t *testing.T) {
 						Mounter:          &config.MounterConfig{WorkerNum: 16},
 						Sink:             &config.SinkConfig{Protocol: "open-protocol"},
 						Consistent:       &config.ConsistentConfig{Level: "normal", Storage: "local"},
+						DDLHistory:       &config.DDLHistoryConfig{MaxCount: 100, Retention: 7 * 24 * time.Hour},
+						DDLPacing:        &config.DDLPacingConfig{QueueWarningThreshold: 1000},
 					},
 				},
 				Status: &model.ChangeFeedStatus{CheckpointTs: 421980719742451713, ResolvedTs: 421980720003809281},
@@ -362,6 +368,38 @@ func TestPatchStatus(t *testing.T) {
 	require.Nil(t, state.Status)
 }
 
+func TestPatchDDLHistory(t *testing.T) {
+	id := model.DefaultChangeFeedID("test1")
+	state := NewChangefeedReactorState(etcd.DefaultCDCClusterID, id)
+	stateTester := NewReactorStateTester(t, state, nil)
+	key := (&etcd.CDCKey{
+		ClusterID:    etcd.DefaultCDCClusterID,
+		Tp:           etcd.CDCKeyTypeChangefeedDDLHistory,
+		ChangefeedID: id,
+	}).String()
+	state.PatchDDLHistory(func(history *model.DDLHistory) (*model.DDLHistory, bool, error) {
+		require.Nil(t, history)
+		return &model.DDLHistory{Entries: []model.DDLHistoryEntry{{Query: "q1", CommitTs: 1}}}, true, nil
+	})
+	stateTester.MustApplyPatches()
+	state.PatchDDLHistory(func(history *model.DDLHistory) (*model.DDLHistory, bool, error) {
+		require.Len(t, history.Entries, 1)
+		history.Entries = append(history.Entries, model.DDLHistoryEntry{Query: "q2", CommitTs: 2})
+		return history, true, nil
+	})
+	stateTester.MustApplyPatches()
+	history := &model.DDLHistory{}
+	require.NoError(t, history.Unmarshal([]byte(stateTester.KVEntries()[key])))
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, "q2", history.Entries[1].Query)
+
+	state.PatchDDLHistory(func(history *model.DDLHistory) (*model.DDLHistory, bool, error) {
+		return nil, history != nil, nil
+	})
+	stateTester.MustApplyPatches()
+	require.NotContains(t, stateTester.KVEntries(), key)
+}
+
 func TestPatchTaskPosition(t *testing.T) {
 	state := NewChangefeedReactorState(etcd.DefaultCDCClusterID,
 		model.DefaultChangeFeedID("test1"))
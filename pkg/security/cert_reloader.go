@@ -0,0 +1,177 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// CertReloader holds the CA and key pair loaded from the paths of a
+// Credential, and reloads them from disk on demand. The tls.Config built by
+// a CertReloader uses the latest loaded certificates for new connections,
+// the established connections are not affected by reloading.
+type CertReloader struct {
+	caPath   string
+	certPath string
+	keyPath  string
+
+	mu       sync.RWMutex
+	certPool *x509.CertPool
+	cert     *tls.Certificate
+	notAfter time.Time
+	loadedAt time.Time
+}
+
+// NewCertReloader creates a CertReloader and loads the certificates.
+func NewCertReloader(caPath, certPath, keyPath string) (*CertReloader, error) {
+	r := &CertReloader{
+		caPath:   caPath,
+		certPath: certPath,
+		keyPath:  keyPath,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return r, nil
+}
+
+// Reload loads the certificates from disk. The previously loaded certificates
+// are kept if any of them fails to be loaded.
+func (r *CertReloader) Reload() error {
+	ca, err := os.ReadFile(r.caPath)
+	if err != nil {
+		return errors.WrapError(errors.ErrToTLSConfigFailed,
+			errors.Annotate(err, "could not read ca certificate"))
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(ca) {
+		return errors.ErrToTLSConfigFailed.GenWithStack("failed to append ca certs")
+	}
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return errors.WrapError(errors.ErrToTLSConfigFailed,
+			errors.Annotate(err, "could not load key pair"))
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return errors.WrapError(errors.ErrToTLSConfigFailed, err)
+	}
+	cert.Leaf = leaf
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.certPool = certPool
+	r.cert = &cert
+	r.notAfter = leaf.NotAfter
+	r.loadedAt = time.Now()
+	return nil
+}
+
+// Run reloads the certificates every `interval` and whenever SIGHUP is
+// received, until ctx is canceled. Non-positive `interval` disables the
+// periodic reloading.
+func (r *CertReloader) Run(ctx context.Context, interval time.Duration) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	var tickCh <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tickCh = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-tickCh:
+		case <-sigCh:
+		}
+		if err := r.Reload(); err != nil {
+			log.Warn("reload certificates failed, keep using the loaded ones",
+				zap.String("certPath", r.certPath), zap.Error(err))
+			continue
+		}
+		log.Info("certificates reloaded",
+			zap.String("certPath", r.certPath), zap.Time("notAfter", r.NotAfter()))
+	}
+}
+
+// NotAfter returns the expiration time of the loaded certificate.
+func (r *CertReloader) NotAfter() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.notAfter
+}
+
+// LoadedAt returns the time when the certificates are loaded.
+func (r *CertReloader) LoadedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.loadedAt
+}
+
+func (r *CertReloader) getCertificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+func (r *CertReloader) getCertPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.certPool
+}
+
+// tlsConfig builds a tls.Config which uses the latest loaded certificates.
+// The CA pool of clients is fixed when the config is built, so clients should
+// build a new config for each new connection.
+func (r *CertReloader) tlsConfig(verifyCN []string) *tls.Config {
+	certPool := r.getCertPool()
+	tlsCfg := &tls.Config{
+		RootCAs:    certPool,
+		ClientCAs:  certPool,
+		NextProtos: []string{"h2", "http/1.1"}, // specify `h2` to let Go use HTTP/2.
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.getCertificate(), nil
+		},
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.getCertificate(), nil
+		},
+	}
+	addVerifyPeerCertificate(tlsCfg, verifyCN)
+	// Servers verify the certificates of clients with the latest CA pool.
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := tlsCfg.Clone()
+		certPool := r.getCertPool()
+		cfg.RootCAs = certPool
+		cfg.ClientCAs = certPool
+		cfg.GetConfigForClient = nil
+		return cfg, nil
+	}
+	return tlsCfg
+}
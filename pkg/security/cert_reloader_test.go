@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	copyFile := func(src, dst string) {
+		data, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(dst, data, 0o600))
+	}
+	certDir := "../../tests/integration_tests/_certificates"
+	cred := &Credential{
+		CAPath:   filepath.Join(dir, "ca.pem"),
+		CertPath: filepath.Join(dir, "server.pem"),
+		KeyPath:  filepath.Join(dir, "server-key.pem"),
+	}
+	copyFile(filepath.Join(certDir, "ca.pem"), cred.CAPath)
+	copyFile(filepath.Join(certDir, "server.pem"), cred.CertPath)
+	copyFile(filepath.Join(certDir, "server-key.pem"), cred.KeyPath)
+
+	reloader, err := cred.EnableCertReload()
+	require.NoError(t, err)
+	require.Equal(t, reloader, cred.CertReloader())
+	require.Equal(t, "tidb-server", reloader.getCertificate().Leaf.Subject.CommonName)
+	notAfter := reloader.NotAfter()
+	tlsCfg, err := cred.ToTLSConfig()
+	require.NoError(t, err)
+
+	// Rotate the certificates.
+	ca, err := NewCA()
+	require.NoError(t, err)
+	certPEM, keyPEM, err := ca.GenerateCerts("rotated")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cred.CAPath, ca.CAPEM, 0o600))
+	require.NoError(t, os.WriteFile(cred.CertPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(cred.KeyPath, keyPEM, 0o600))
+	require.NoError(t, reloader.Reload())
+	require.NotEqual(t, notAfter, reloader.NotAfter())
+	notAfter = reloader.NotAfter()
+
+	// The built config uses the reloaded certificates.
+	cert, err := tlsCfg.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, "rotated", cert.Leaf.Subject.CommonName)
+	serverCfg, err := tlsCfg.GetConfigForClient(nil)
+	require.NoError(t, err)
+	require.True(t, serverCfg.ClientCAs.Equal(reloader.getCertPool()))
+
+	// The loaded certificates are kept if the reloading fails.
+	require.NoError(t, os.WriteFile(cred.CertPath, []byte("invalid"), 0o600))
+	require.Error(t, reloader.Reload())
+	require.Equal(t, notAfter, reloader.NotAfter())
+	cert, err = tlsCfg.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, "rotated", cert.Leaf.Subject.CommonName)
+}
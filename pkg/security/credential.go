@@ -32,6 +32,9 @@ type Credential struct {
 	CertPath      string   `toml:"cert-path" json:"cert-path"`
 	KeyPath       string   `toml:"key-path" json:"key-path"`
 	CertAllowedCN []string `toml:"cert-allowed-cn" json:"cert-allowed-cn"`
+
+	// reloader is set by EnableCertReload, it isn't a part of the config.
+	reloader *CertReloader
 }
 
 // IsTLSEnabled checks whether TLS is enabled or not.
@@ -62,8 +65,29 @@ func (s *Credential) ToGRPCDialOption() (grpc.DialOption, error) {
 	return grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)), nil
 }
 
+// EnableCertReload makes the tls.Config generated from the Credential use
+// the certificates held by a CertReloader, so that the certificates can be
+// rotated without restarting. It returns the CertReloader.
+func (s *Credential) EnableCertReload() (*CertReloader, error) {
+	reloader, err := NewCertReloader(s.CAPath, s.CertPath, s.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	s.reloader = reloader
+	return reloader, nil
+}
+
+// CertReloader returns the CertReloader enabled by EnableCertReload,
+// or nil if it's not enabled.
+func (s *Credential) CertReloader() *CertReloader {
+	return s.reloader
+}
+
 // ToTLSConfig generates tls's config from *Security
 func (s *Credential) ToTLSConfig() (*tls.Config, error) {
+	if s.reloader != nil {
+		return s.reloader.tlsConfig(nil), nil
+	}
 	cfg, err := ToTLSConfigWithVerify(s.CAPath, s.CertPath, s.KeyPath, nil)
 	return cfg, errors.WrapError(errors.ErrToTLSConfigFailed, err)
 }
@@ -71,6 +95,9 @@ func (s *Credential) ToTLSConfig() (*tls.Config, error) {
 // ToTLSConfigWithVerify generates tls's config from *Security and requires
 // the remote common name to be verified.
 func (s *Credential) ToTLSConfigWithVerify() (*tls.Config, error) {
+	if s.reloader != nil {
+		return s.reloader.tlsConfig(s.CertAllowedCN), nil
+	}
 	cfg, err := ToTLSConfigWithVerify(s.CAPath, s.CertPath, s.KeyPath, s.CertAllowedCN)
 	return cfg, errors.WrapError(errors.ErrToTLSConfigFailed, err)
 }
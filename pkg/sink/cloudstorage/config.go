@@ -54,6 +54,18 @@ type Config struct {
 	FileSize                 int
 	DateSeparator            string
 	EnablePartitionSeparator bool
+	// EnableManifest enables writing manifests which list the finalized
+	// data files, see Manifest for details.
+	EnableManifest bool
+	// FlushConcurrency is the max number of data files written concurrently
+	// by all the workers, 0 means it's only limited by WorkerCount.
+	FlushConcurrency int
+	// FlushMinSize and FlushMinAge coalesce the flushes of small tables: a
+	// table whose pending data is less than FlushMinSize isn't flushed at the
+	// flush interval until its oldest pending data is older than FlushMinAge.
+	// Tables exceeding FileSize are flushed as soon as possible as usual.
+	FlushMinSize int
+	FlushMinAge  time.Duration
 }
 
 // NewConfig returns the default cloud storage sink config.
@@ -91,6 +103,22 @@ func (c *Config) Apply(
 	if err != nil {
 		return err
 	}
+	err = getEnableManifest(query, &c.EnableManifest)
+	if err != nil {
+		return err
+	}
+	err = getFlushConcurrency(query, &c.FlushConcurrency)
+	if err != nil {
+		return err
+	}
+	err = getFlushMinSize(query, &c.FlushMinSize)
+	if err != nil {
+		return err
+	}
+	err = getFlushMinAge(query, &c.FlushMinAge)
+	if err != nil {
+		return err
+	}
 
 	c.DateSeparator = replicaConfig.Sink.DateSeparator
 	c.EnablePartitionSeparator = replicaConfig.Sink.EnablePartitionSeparator
@@ -171,3 +199,83 @@ func getFileSize(values url.Values, fileSize *int) error {
 	*fileSize = sz
 	return nil
 }
+
+func getEnableManifest(values url.Values, enableManifest *bool) error {
+	s := values.Get("enable-manifest")
+	if len(s) == 0 {
+		return nil
+	}
+
+	enabled, err := strconv.ParseBool(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig, err)
+	}
+	*enableManifest = enabled
+	return nil
+}
+
+func getFlushConcurrency(values url.Values, flushConcurrency *int) error {
+	s := values.Get("flush-concurrency")
+	if len(s) == 0 {
+		return nil
+	}
+
+	c, err := strconv.Atoi(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig, err)
+	}
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig,
+			fmt.Errorf("invalid flush-concurrency %d, it must be greater than 0", c))
+	}
+	*flushConcurrency = c
+	return nil
+}
+
+func getFlushMinSize(values url.Values, flushMinSize *int) error {
+	s := values.Get("flush-min-size")
+	if len(s) == 0 {
+		return nil
+	}
+
+	sz, err := strconv.Atoi(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig, err)
+	}
+	if sz < 0 {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig,
+			fmt.Errorf("invalid flush-min-size %d, it must not be negative", sz))
+	}
+	if sz > maxFileSize {
+		log.Warn("flush-min-size is too large",
+			zap.Int("original", sz), zap.Int("override", maxFileSize))
+		sz = maxFileSize
+	}
+	*flushMinSize = sz
+	return nil
+}
+
+func getFlushMinAge(values url.Values, flushMinAge *time.Duration) error {
+	s := values.Get("flush-min-age")
+	if len(s) == 0 {
+		return nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig, err)
+	}
+	if d < 0 {
+		return cerror.WrapError(cerror.ErrCloudStorageInvalidConfig,
+			fmt.Errorf("invalid flush-min-age %s, it must not be negative", d))
+	}
+	// the data of small tables is delayed by at most flush-min-age, which
+	// holds back the checkpoint of the tables.
+	if d > maxFlushInterval {
+		log.Warn("flush-min-age is too large", zap.Duration("original", d),
+			zap.Duration("override", maxFlushInterval))
+		d = maxFlushInterval
+	}
+	*flushMinAge = d
+	return nil
+}
@@ -29,7 +29,11 @@ func TestConfigApply(t *testing.T) {
 	expected.FlushInterval = 10 * time.Second
 	expected.FileSize = 16 * 1024 * 1024
 	expected.DateSeparator = config.DateSeparatorNone.String()
-	uri := "s3://bucket/prefix?worker-count=32&flush-interval=10s&file-size=16777216"
+	expected.FlushConcurrency = 8
+	expected.FlushMinSize = 1024 * 1024
+	expected.FlushMinAge = time.Minute
+	uri := "s3://bucket/prefix?worker-count=32&flush-interval=10s&file-size=16777216" +
+		"&flush-concurrency=8&flush-min-size=1048576&flush-min-age=1m"
 	sinkURI, err := url.Parse(uri)
 	require.Nil(t, err)
 	cfg := NewConfig()
@@ -104,6 +108,41 @@ func TestVerifySinkURIParams(t *testing.T) {
 			uri:         "s3://bucket/prefix?file-size=1073741824",
 			expectedErr: "",
 		},
+		{
+			name:        "valid sink uri with enable-manifest",
+			uri:         "s3://bucket/prefix?enable-manifest=true",
+			expectedErr: "",
+		},
+		{
+			name:        "invalid sink uri with enable-manifest",
+			uri:         "s3://bucket/prefix?enable-manifest=yes",
+			expectedErr: "invalid syntax",
+		},
+		{
+			name:        "valid sink uri with flush-concurrency, flush-min-size and flush-min-age",
+			uri:         "s3://bucket/prefix?flush-concurrency=4&flush-min-size=1048576&flush-min-age=1m",
+			expectedErr: "",
+		},
+		{
+			name:        "invalid sink uri with flush-concurrency less than lower limit",
+			uri:         "s3://bucket/prefix?flush-concurrency=0",
+			expectedErr: "invalid flush-concurrency 0, it must be greater than 0",
+		},
+		{
+			name:        "invalid sink uri with negative flush-min-size",
+			uri:         "s3://bucket/prefix?flush-min-size=-1",
+			expectedErr: "invalid flush-min-size -1, it must not be negative",
+		},
+		{
+			name:        "invalid sink uri with negative flush-min-age",
+			uri:         "s3://bucket/prefix?flush-min-age=-1s",
+			expectedErr: "invalid flush-min-age -1s, it must not be negative",
+		},
+		{
+			name:        "invalid sink uri with flush-min-age greater than upper limit",
+			uri:         "s3://bucket/prefix?flush-min-age=1h",
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -116,6 +155,7 @@ func TestVerifySinkURIParams(t *testing.T) {
 			require.LessOrEqual(t, cfg.WorkerCount, maxWorkerCount)
 			require.LessOrEqual(t, cfg.FlushInterval, maxFlushInterval)
 			require.LessOrEqual(t, cfg.FileSize, maxFileSize)
+			require.LessOrEqual(t, cfg.FlushMinAge, maxFlushInterval)
 		} else {
 			require.Regexp(t, tc.expectedErr, err)
 		}
@@ -0,0 +1,172 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+)
+
+const (
+	// ManifestDir is the directory where the manifests are written.
+	ManifestDir = "manifest"
+
+	manifestFilePrefix = "CDC_MANIFEST"
+	manifestFileSuffix = ".json"
+)
+
+// ManifestFile describes a data file which has been completely written to
+// the storage.
+type ManifestFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// Checksum is the hex encoded SHA-256 of the file content.
+	Checksum string `json:"checksum"`
+	// MaxCommitTs is the max commit ts of the events in the file.
+	MaxCommitTs uint64 `json:"max-commit-ts"`
+}
+
+// NewManifestFile creates a ManifestFile from the file content.
+func NewManifestFile(path string, content []byte, maxCommitTs uint64) ManifestFile {
+	sum := sha256.Sum256(content)
+	return ManifestFile{
+		Path:        path,
+		Size:        int64(len(content)),
+		Checksum:    hex.EncodeToString(sum[:]),
+		MaxCommitTs: maxCommitTs,
+	}
+}
+
+// Verify checks whether the content matches the size and checksum of the file.
+func (f *ManifestFile) Verify(content []byte) error {
+	if int64(len(content)) != f.Size {
+		return errors.Errorf("size mismatch for %s, expected %d, got %d", f.Path, f.Size, len(content))
+	}
+	sum := sha256.Sum256(content)
+	if checksum := hex.EncodeToString(sum[:]); checksum != f.Checksum {
+		return errors.Errorf("checksum mismatch for %s, expected %s, got %s", f.Path, f.Checksum, checksum)
+	}
+	return nil
+}
+
+// Manifest lists the data files finalized since the previous manifest.
+// Manifests are written after all the files listed in them, with increasing
+// sequence numbers starting from one. So following the manifests in order,
+// all files finalized at or before a manifest are known once it's read.
+type Manifest struct {
+	Seq uint64 `json:"seq"`
+	// MaxCommitTs is the max commit ts of all files listed in the manifest.
+	MaxCommitTs uint64         `json:"max-commit-ts"`
+	Files       []ManifestFile `json:"files"`
+}
+
+// ManifestPath returns the path of the manifest with the given sequence number.
+func ManifestPath(seq uint64) string {
+	return fmt.Sprintf("%s/%s%020d%s", ManifestDir, manifestFilePrefix, seq, manifestFileSuffix)
+}
+
+// ParseManifestPath parses the sequence number from the path of a manifest.
+func ParseManifestPath(path string) (uint64, error) {
+	name := strings.TrimPrefix(path, ManifestDir+"/")
+	if name == path || !strings.HasPrefix(name, manifestFilePrefix) ||
+		!strings.HasSuffix(name, manifestFileSuffix) {
+		return 0, errors.Errorf("cannot match manifest path pattern for %s", path)
+	}
+	seq, err := strconv.ParseUint(
+		strings.TrimSuffix(strings.TrimPrefix(name, manifestFilePrefix), manifestFileSuffix), 10, 64)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return seq, nil
+}
+
+// IsManifestPath returns whether the path is in the manifest directory.
+func IsManifestPath(path string) bool {
+	return strings.HasPrefix(path, ManifestDir+"/")
+}
+
+// ListManifestSeqs returns the sequence numbers of all manifests in the
+// storage in ascending order.
+func ListManifestSeqs(ctx context.Context, s storage.ExternalStorage) ([]uint64, error) {
+	var seqs []uint64
+	err := s.WalkDir(ctx, &storage.WalkOption{SubDir: ManifestDir}, func(path string, _ int64) error {
+		seq, err := ParseManifestPath(path)
+		if err != nil {
+			// skip unknown files
+			return nil
+		}
+		seqs = append(seqs, seq)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+// ManifestReader follows the manifests written by the cloud storage sink.
+type ManifestReader struct {
+	storage storage.ExternalStorage
+	nextSeq uint64
+}
+
+// NewManifestReader creates a ManifestReader which starts from the manifest
+// after lastSeq, use zero to read from the first manifest.
+func NewManifestReader(s storage.ExternalStorage, lastSeq uint64) *ManifestReader {
+	return &ManifestReader{
+		storage: s,
+		nextSeq: lastSeq + 1,
+	}
+}
+
+// Next returns the manifests written since the last call in order of their
+// sequence numbers. It stops at the first missing sequence number, so the
+// returned manifests are always contiguous.
+func (r *ManifestReader) Next(ctx context.Context) ([]*Manifest, error) {
+	seqs, err := ListManifestSeqs(ctx, r.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []*Manifest
+	for _, seq := range seqs {
+		if seq < r.nextSeq {
+			continue
+		}
+		if seq > r.nextSeq {
+			break
+		}
+		content, err := r.storage.ReadFile(ctx, ManifestPath(seq))
+		if err != nil {
+			return manifests, errors.Trace(err)
+		}
+		manifest := &Manifest{}
+		if err := json.Unmarshal(content, manifest); err != nil {
+			return manifests, errors.Trace(err)
+		}
+		manifests = append(manifests, manifest)
+		r.nextSeq++
+	}
+	return manifests, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestPath(t *testing.T) {
+	path := ManifestPath(12)
+	require.Equal(t, "manifest/CDC_MANIFEST00000000000000000012.json", path)
+	require.True(t, IsManifestPath(path))
+	seq, err := ParseManifestPath(path)
+	require.Nil(t, err)
+	require.Equal(t, uint64(12), seq)
+
+	require.False(t, IsManifestPath("test/table1/5/CDC000001.json"))
+	_, err = ParseManifestPath("test/table1/5/CDC000001.json")
+	require.Regexp(t, "cannot match manifest path pattern", err)
+	_, err = ParseManifestPath("manifest/CDC_MANIFESTxx.json")
+	require.NotNil(t, err)
+}
+
+func TestManifestFileVerify(t *testing.T) {
+	content := []byte("hello world")
+	f := NewManifestFile("test/table1/5/CDC000001.json", content, 100)
+	require.Equal(t, int64(len(content)), f.Size)
+	require.Nil(t, f.Verify(content))
+	require.Regexp(t, "size mismatch", f.Verify([]byte("hello")))
+	require.Regexp(t, "checksum mismatch", f.Verify([]byte("hello World")))
+}
+
+func TestManifestReader(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.Nil(t, err)
+
+	writeManifest := func(seq uint64) {
+		m := &Manifest{
+			Seq:         seq,
+			MaxCommitTs: seq * 100,
+			Files:       []ManifestFile{NewManifestFile("test/table1/5/CDC000001.json", []byte("a"), seq*100)},
+		}
+		content, err := json.Marshal(m)
+		require.Nil(t, err)
+		require.Nil(t, s.WriteFile(ctx, ManifestPath(seq), content))
+	}
+
+	r := NewManifestReader(s, 0)
+	manifests, err := r.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 0)
+
+	writeManifest(1)
+	writeManifest(2)
+	// manifest 3 is not visible yet
+	writeManifest(4)
+	manifests, err = r.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 2)
+	require.Equal(t, uint64(1), manifests[0].Seq)
+	require.Equal(t, uint64(200), manifests[1].MaxCommitTs)
+
+	writeManifest(3)
+	manifests, err = r.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 2)
+	require.Equal(t, uint64(3), manifests[0].Seq)
+	require.Equal(t, uint64(4), manifests[1].Seq)
+
+	// start from a given manifest
+	r = NewManifestReader(s, 3)
+	manifests, err = r.Next(ctx)
+	require.Nil(t, err)
+	require.Len(t, manifests, 1)
+	require.Equal(t, uint64(4), manifests[0].Seq)
+}
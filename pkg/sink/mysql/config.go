@@ -62,7 +62,20 @@ const (
 	// BackoffMaxDelay indicates the max delay time for retrying.
 	BackoffMaxDelay = 60 * time.Second
 
-	defaultBatchDMLEnable = true
+	defaultBatchDMLEnable  = true
+	defaultMultiStmtEnable = false
+
+	// SchemaDriftPolicyNone disables the downstream schema check.
+	SchemaDriftPolicyNone = "none"
+	// SchemaDriftPolicyFail fails the sink if some columns are missing in
+	// the downstream table.
+	SchemaDriftPolicyFail = "fail"
+	// SchemaDriftPolicyAdapt omits the columns missing in the downstream
+	// table from the DMLs.
+	SchemaDriftPolicyAdapt = "adapt"
+
+	defaultSchemaDriftPolicy   = SchemaDriftPolicyNone
+	defaultSchemaCheckInterval = 10 * time.Minute
 )
 
 // Config is the configs for MySQL backend.
@@ -84,6 +97,20 @@ type Config struct {
 	IsTiDB         bool // IsTiDB is true if the downstream is TiDB
 	SourceID       uint64
 	BatchDMLEnable bool
+	// MultiStmtEnable indicates whether to execute the DMLs of a txn as one
+	// multi-statement. A failed multi-statement is re-executed statement by
+	// statement to report the real error.
+	MultiStmtEnable bool
+	// SchemaDriftPolicy decides how to handle a downstream table whose
+	// columns drift from the upstream schema. The downstream columns are
+	// checked after each DDL of the table and every SchemaCheckInterval.
+	SchemaDriftPolicy   string
+	SchemaCheckInterval time.Duration
+	// RedactLog decides how the values in the logged DMLs and errors are
+	// redacted, and the identical errors are logged once per
+	// ErrorLogSampleInterval, see config.SinkConfig.
+	RedactLog              string
+	ErrorLogSampleInterval time.Duration
 }
 
 // NewConfig returns the default mysql backend config.
@@ -99,6 +126,10 @@ func NewConfig() *Config {
 		DialTimeout:         defaultDialTimeout,
 		SafeMode:            defaultSafeMode,
 		BatchDMLEnable:      defaultBatchDMLEnable,
+		MultiStmtEnable:     defaultMultiStmtEnable,
+		SchemaDriftPolicy:   defaultSchemaDriftPolicy,
+		SchemaCheckInterval: defaultSchemaCheckInterval,
+		RedactLog:           config.RedactLogNone,
 	}
 }
 
@@ -151,9 +182,19 @@ func (c *Config) Apply(
 	if err = getBatchDMLEnable(query, &c.BatchDMLEnable); err != nil {
 		return err
 	}
+	if err = getMultiStmtEnable(query, &c.MultiStmtEnable); err != nil {
+		return err
+	}
+	if err = getSchemaDriftPolicy(query, &c.SchemaDriftPolicy, &c.SchemaCheckInterval); err != nil {
+		return err
+	}
 	c.EnableOldValue = replicaConfig.EnableOldValue
 	c.ForceReplicate = replicaConfig.ForceReplicate
 	c.SourceID = replicaConfig.Sink.TiDBSourceID
+	if replicaConfig.Sink.RedactLog != "" {
+		c.RedactLog = replicaConfig.Sink.RedactLog
+	}
+	c.ErrorLogSampleInterval = time.Duration(replicaConfig.Sink.ErrorLogSampleIntervalInSec) * time.Second
 
 	return nil
 }
@@ -332,3 +373,44 @@ func getBatchDMLEnable(values url.Values, batchDMLEnable *bool) error {
 	}
 	return nil
 }
+
+func getMultiStmtEnable(values url.Values, multiStmtEnable *bool) error {
+	s := values.Get("multi-stmt-enable")
+	if len(s) > 0 {
+		enable, err := strconv.ParseBool(s)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLInvalidConfig, err)
+		}
+		*multiStmtEnable = enable
+	}
+	return nil
+}
+
+func getSchemaDriftPolicy(values url.Values, policy *string, interval *time.Duration) error {
+	s := values.Get("schema-drift-policy")
+	if len(s) > 0 {
+		switch s {
+		case SchemaDriftPolicyNone, SchemaDriftPolicyFail, SchemaDriftPolicyAdapt:
+			*policy = s
+		default:
+			return cerror.WrapError(cerror.ErrMySQLInvalidConfig,
+				fmt.Errorf("invalid schema-drift-policy %s, which must be one of %s, %s and %s",
+					s, SchemaDriftPolicyNone, SchemaDriftPolicyFail, SchemaDriftPolicyAdapt))
+		}
+	}
+
+	s = values.Get("schema-check-interval")
+	if len(s) == 0 {
+		return nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLInvalidConfig, err)
+	}
+	if d <= 0 {
+		return cerror.WrapError(cerror.ErrMySQLInvalidConfig,
+			fmt.Errorf("invalid schema-check-interval %s, which must be greater than 0", s))
+	}
+	*interval = d
+	return nil
+}
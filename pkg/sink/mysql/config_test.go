@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	dmysql "github.com/go-sql-driver/mysql"
@@ -187,9 +188,13 @@ func TestApplySinkURIParamsToConfig(t *testing.T) {
 	expected.Timezone = `"UTC"`
 	expected.tidbTxnMode = "pessimistic"
 	expected.EnableOldValue = true
+	expected.MultiStmtEnable = true
+	expected.SchemaDriftPolicy = SchemaDriftPolicyAdapt
+	expected.SchemaCheckInterval = time.Minute
 	uriStr := "mysql://127.0.0.1:3306/?worker-count=64&max-txn-row=20" +
 		"&batch-replace-enable=true&batch-replace-size=50&safe-mode=false" +
-		"&tidb-txn-mode=pessimistic"
+		"&tidb-txn-mode=pessimistic&multi-stmt-enable=true" +
+		"&schema-drift-policy=adapt&schema-check-interval=1m"
 	uri, err := url.Parse(uriStr)
 	require.Nil(t, err)
 	cfg := NewConfig()
@@ -285,6 +290,10 @@ func TestParseSinkURIBadQueryString(t *testing.T) {
 		"mysql://127.0.0.1:3306/?write-timeout=badduration",
 		"mysql://127.0.0.1:3306/?read-timeout=badduration",
 		"mysql://127.0.0.1:3306/?timeout=badduration",
+		"mysql://127.0.0.1:3306/?multi-stmt-enable=not-bool",
+		"mysql://127.0.0.1:3306/?schema-drift-policy=ignore",
+		"mysql://127.0.0.1:3306/?schema-check-interval=badduration",
+		"mysql://127.0.0.1:3306/?schema-check-interval=0s",
 	}
 	ctx := context.TODO()
 	var uri *url.URL
@@ -0,0 +1,202 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxLogRedactionLift is the max duration the log redaction of a
+	// changefeed can be lifted for.
+	MaxLogRedactionLift = time.Hour
+	// maxLogRedactionLiftRecords is the max number of the audit records of
+	// the lifts kept in memory.
+	maxLogRedactionLiftRecords = 64
+)
+
+// LogRedactionLift is the audit record of lifting the log redaction of a
+// changefeed temporarily.
+type LogRedactionLift struct {
+	Namespace    string    `json:"namespace"`
+	ChangefeedID string    `json:"changefeed_id"`
+	Operator     string    `json:"operator"`
+	Reason       string    `json:"reason"`
+	LiftedAt     time.Time `json:"lifted_at"`
+	Until        time.Time `json:"until"`
+}
+
+// logRedactionLifts records the changefeeds whose log redaction is lifted in
+// this process, and the audit records of the lifts.
+var logRedactionLifts = struct {
+	sync.Mutex
+	until   map[model.ChangeFeedID]time.Time
+	records []LogRedactionLift
+}{
+	until: make(map[model.ChangeFeedID]time.Time),
+}
+
+// LiftLogRedaction lifts the log redaction of the changefeed until `duration`
+// later, so that the values in the sink logs can be inspected for debugging.
+// The lift only takes effect in this process, and it's audited in the log and
+// the records returned by LogRedactionLifts. The caller should make sure the
+// duration is positive and not greater than MaxLogRedactionLift.
+func LiftLogRedaction(
+	changefeedID model.ChangeFeedID, duration time.Duration, operator, reason string,
+) LogRedactionLift {
+	now := time.Now()
+	lift := LogRedactionLift{
+		Namespace:    changefeedID.Namespace,
+		ChangefeedID: changefeedID.ID,
+		Operator:     operator,
+		Reason:       reason,
+		LiftedAt:     now,
+		Until:        now.Add(duration),
+	}
+
+	logRedactionLifts.Lock()
+	defer logRedactionLifts.Unlock()
+	logRedactionLifts.until[changefeedID] = lift.Until
+	logRedactionLifts.records = append(logRedactionLifts.records, lift)
+	if len(logRedactionLifts.records) > maxLogRedactionLiftRecords {
+		logRedactionLifts.records = logRedactionLifts.records[1:]
+	}
+	log.Warn("log redaction of the sink is lifted",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("operator", operator),
+		zap.String("reason", reason),
+		zap.Time("until", lift.Until))
+	return lift
+}
+
+// LogRedactionLifted returns true if the log redaction of the changefeed is
+// lifted at `now`.
+func LogRedactionLifted(changefeedID model.ChangeFeedID, now time.Time) bool {
+	logRedactionLifts.Lock()
+	defer logRedactionLifts.Unlock()
+	until, ok := logRedactionLifts.until[changefeedID]
+	if !ok {
+		return false
+	}
+	if !now.Before(until) {
+		delete(logRedactionLifts.until, changefeedID)
+		return false
+	}
+	return true
+}
+
+// LogRedactionLifts returns the audit records of the recent lifts in this
+// process, the oldest first.
+func LogRedactionLifts() []LogRedactionLift {
+	logRedactionLifts.Lock()
+	defer logRedactionLifts.Unlock()
+	records := make([]LogRedactionLift, len(logRedactionLifts.records))
+	copy(records, logRedactionLifts.records)
+	return records
+}
+
+// RedactSQL redacts the string and numeric literals in the SQL statement by
+// the policy, the identifiers and the placeholders are kept.
+func RedactSQL(policy string, sql string) string {
+	return redactLiterals(policy, sql, true)
+}
+
+// RedactErrorMessage redacts the quoted values in the error message by the
+// policy, e.g. the duplicate entry of a duplicate key error. The numbers are
+// kept, since they're usually the error codes.
+func RedactErrorMessage(policy string, msg string) string {
+	return redactLiterals(policy, msg, false)
+}
+
+func redactLiterals(policy string, s string, numbers bool) string {
+	if policy != config.RedactLogElide && policy != config.RedactLogHash {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := quotedEnd(s, i)
+			b.WriteString(redactValue(policy, s[i:end]))
+			i = end
+		case c == '`':
+			// the quoted identifiers are kept.
+			end := quotedEnd(s, i)
+			b.WriteString(s[i:end])
+			i = end
+		case numbers && isDigit(c) && (i == 0 || !isIdentChar(s[i-1])):
+			end := i + 1
+			for end < len(s) && (isIdentChar(s[end]) || s[end] == '.') {
+				end++
+			}
+			b.WriteString(redactValue(policy, s[i:end]))
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// quotedEnd returns the index after the closing quote of the quoted string
+// starting at `start`, or the length of s if it's not closed.
+func quotedEnd(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			// a doubled quote is an escaped quote.
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+func redactValue(policy string, value string) string {
+	if policy == config.RedactLogElide {
+		return "?"
+	}
+	sum := sha256.Sum256([]byte(value))
+	return "#" + hex.EncodeToString(sum[:8])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentChar(c byte) bool {
+	return isDigit(c) || c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSQL(t *testing.T) {
+	t.Parallel()
+
+	sql := "UPDATE `db`.`t1` SET `name` = 'it''s \\'x\\'', `age` = 18 WHERE `id` = ? AND c2 = -1.5e3"
+	require.Equal(t, sql, RedactSQL(config.RedactLogNone, sql))
+	require.Equal(t, sql, RedactSQL("", sql))
+	require.Equal(t,
+		"UPDATE `db`.`t1` SET `name` = ?, `age` = ? WHERE `id` = ? AND c2 = -?",
+		RedactSQL(config.RedactLogElide, sql))
+
+	hashed := RedactSQL(config.RedactLogHash, "INSERT INTO t VALUES ('a'),('a'),('b')")
+	require.Regexp(t, `^INSERT INTO t VALUES \((#[0-9a-f]{16})\),\((#[0-9a-f]{16})\),\((#[0-9a-f]{16})\)$`, hashed)
+	require.Equal(t, hashed[22:39], hashed[42:59])
+	require.NotEqual(t, hashed[22:39], hashed[62:79])
+
+	// an unclosed string is redacted to the end.
+	require.Equal(t, "SELECT ?", RedactSQL(config.RedactLogElide, "SELECT 'abc"))
+
+	msg := "Error 1062: Duplicate entry '1-alice' for key 'uk'"
+	require.Equal(t, "Error 1062: Duplicate entry ? for key ?",
+		RedactErrorMessage(config.RedactLogElide, msg))
+}
+
+func TestLiftLogRedaction(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := model.DefaultChangeFeedID("test-lift-log-redaction")
+	require.False(t, LogRedactionLifted(changefeedID, time.Now()))
+
+	lift := LiftLogRedaction(changefeedID, time.Minute, "127.0.0.1:1234", "investigate duplicate entry")
+	require.Equal(t, changefeedID.ID, lift.ChangefeedID)
+	require.Equal(t, time.Minute, lift.Until.Sub(lift.LiftedAt))
+	require.True(t, LogRedactionLifted(changefeedID, time.Now()))
+	require.False(t, LogRedactionLifted(model.DefaultChangeFeedID("another"), time.Now()))
+	require.Contains(t, LogRedactionLifts(), lift)
+
+	// the lift expires.
+	require.False(t, LogRedactionLifted(changefeedID, lift.Until))
+	require.False(t, LogRedactionLifted(changefeedID, time.Now()))
+	// the audit record is kept.
+	require.Contains(t, LogRedactionLifts(), lift)
+}
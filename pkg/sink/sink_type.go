@@ -81,3 +81,31 @@ func IsStorageScheme(scheme string) bool {
 	return scheme == FileScheme || scheme == S3Scheme || scheme == GCSScheme ||
 		scheme == GSScheme || scheme == AzblobScheme || scheme == AzureScheme || scheme == CloudStorageNoopScheme
 }
+
+// Capabilities are the capabilities of a sink.
+type Capabilities struct {
+	// Transactional is true if the sink writes the rows of an upstream
+	// transaction atomically.
+	Transactional bool
+	// ExactlyOnce is true if the rows replayed after a restart don't
+	// introduce duplicates in the downstream.
+	ExactlyOnce bool
+	// Syncpoint is true if the sink can record syncpoints in the downstream.
+	Syncpoint bool
+}
+
+// GetCapabilities returns the capabilities of the sink with the given scheme.
+// `splitTxn` indicates whether the upstream transactions are split by the sink.
+// All capabilities are false for unknown schemes.
+func GetCapabilities(scheme string, splitTxn bool) Capabilities {
+	if IsMySQLCompatibleScheme(scheme) {
+		// replayed rows are written in safe mode, which is idempotent.
+		return Capabilities{
+			Transactional: !splitTxn,
+			ExactlyOnce:   true,
+			Syncpoint:     true,
+		}
+	}
+	// MQ and storage sinks deliver the rows at least once.
+	return Capabilities{}
+}
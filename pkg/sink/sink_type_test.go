@@ -0,0 +1,33 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, Capabilities{Transactional: true, ExactlyOnce: true, Syncpoint: true},
+		GetCapabilities(TiDBScheme, false))
+	require.Equal(t, Capabilities{ExactlyOnce: true, Syncpoint: true},
+		GetCapabilities(MySQLSSLScheme, true))
+	require.Equal(t, Capabilities{}, GetCapabilities(KafkaScheme, false))
+	require.Equal(t, Capabilities{}, GetCapabilities(S3Scheme, false))
+	require.Equal(t, Capabilities{}, GetCapabilities(BlackHoleScheme, false))
+	require.Equal(t, Capabilities{}, GetCapabilities("unknown", false))
+}
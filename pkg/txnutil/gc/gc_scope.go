@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/log"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+)
+
+// ServiceSafePointV2Client is implemented by the PD clients which support the
+// keyspace-level service GC safepoint, a.k.a. GC safepoint v2.
+type ServiceSafePointV2Client interface {
+	// UpdateServiceSafePointV2 updates the service safepoint of the keyspace
+	// and returns the min service safepoint of the keyspace.
+	UpdateServiceSafePointV2(
+		ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64,
+	) (uint64, error)
+}
+
+// Scope is the scope of a service GC safepoint, the safepoint of the global
+// scope blocks the GC of all keyspaces.
+type Scope struct {
+	// Keyspaced is true if the safepoint only blocks the GC of KeyspaceID.
+	Keyspaced  bool
+	KeyspaceID uint32
+}
+
+// GlobalScope is the scope of the global service GC safepoint.
+var GlobalScope = Scope{}
+
+// KeyspaceScope returns the scope of the service GC safepoint of the keyspace.
+func KeyspaceScope(keyspaceID uint32) Scope {
+	return Scope{Keyspaced: true, KeyspaceID: keyspaceID}
+}
+
+// String implements fmt.Stringer.
+func (s Scope) String() string {
+	if !s.Keyspaced {
+		return "global"
+	}
+	return fmt.Sprintf("keyspace-%d", s.KeyspaceID)
+}
+
+// ResolveScope returns the scope of the service GC safepoint of a changefeed
+// by the keyspaces its tables belong to. The tables of a non-keyspace cluster
+// belong to no keyspace, then the global scope is returned. A changefeed whose
+// tables belong to multiple keyspaces is refused.
+func ResolveScope(keyspaceIDs []uint32) (Scope, error) {
+	if len(keyspaceIDs) == 0 {
+		return GlobalScope, nil
+	}
+	ids := append([]uint32(nil), keyspaceIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	uniq := ids[:1]
+	for _, id := range ids[1:] {
+		if id != uniq[len(uniq)-1] {
+			uniq = append(uniq, id)
+		}
+	}
+	if len(uniq) > 1 {
+		return GlobalScope, cerrors.ErrChangefeedMixedKeyspaces.GenWithStackByArgs(uniq)
+	}
+	return KeyspaceScope(ids[0]), nil
+}
+
+// SetServiceGCSafepointWithScope sets a service safepoint of the scope to PD.
+// It falls back to the global scope if PD client doesn't support the
+// keyspace-level service safepoint, and returns the scope actually set.
+func SetServiceGCSafepointWithScope(
+	ctx context.Context, pdCli pd.Client, scope Scope,
+	serviceID string, TTL int64, safePoint uint64,
+) (minServiceGCTs uint64, actual Scope, err error) {
+	if !scope.Keyspaced {
+		minServiceGCTs, err = SetServiceGCSafepoint(ctx, pdCli, serviceID, TTL, safePoint)
+		return minServiceGCTs, GlobalScope, err
+	}
+	v2Cli, ok := pdCli.(ServiceSafePointV2Client)
+	if !ok {
+		log.Warn("PD client doesn't support keyspace-level service GC safepoint, "+
+			"fallback to the global one",
+			zap.String("serviceID", serviceID),
+			zap.Uint32("keyspaceID", scope.KeyspaceID))
+		minServiceGCTs, err = SetServiceGCSafepoint(ctx, pdCli, serviceID, TTL, safePoint)
+		return minServiceGCTs, GlobalScope, err
+	}
+	err = retry.Do(ctx,
+		func() error {
+			var err1 error
+			minServiceGCTs, err1 = v2Cli.UpdateServiceSafePointV2(
+				ctx, scope.KeyspaceID, serviceID, TTL, safePoint)
+			if err1 != nil {
+				log.Warn("Set keyspace GC safepoint failed, retry later",
+					zap.Uint32("keyspaceID", scope.KeyspaceID), zap.Error(err1))
+			}
+			return err1
+		},
+		retry.WithBackoffBaseDelay(gcServiceBackoffDelay),
+		retry.WithMaxTries(gcServiceMaxRetries),
+		retry.WithIsRetryableErr(cerrors.IsRetryableError))
+	return minServiceGCTs, scope, err
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveScope(t *testing.T) {
+	t.Parallel()
+
+	scope, err := ResolveScope(nil)
+	require.Nil(t, err)
+	require.Equal(t, GlobalScope, scope)
+	require.Equal(t, "global", scope.String())
+
+	scope, err = ResolveScope([]uint32{2, 2, 2})
+	require.Nil(t, err)
+	require.Equal(t, KeyspaceScope(2), scope)
+	require.Equal(t, "keyspace-2", scope.String())
+
+	_, err = ResolveScope([]uint32{3, 1, 3, 2})
+	require.True(t, cerrors.ErrChangefeedMixedKeyspaces.Equal(err))
+	require.Contains(t, err.Error(), "multiple keyspaces [1 2 3]")
+}
+
+func TestSetServiceGCSafepointWithScope(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var global, keyspaced []uint64
+	pdCli := &MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			global = append(global, safePoint)
+			return safePoint, nil
+		},
+		UpdateServiceSafePointV2Func: func(
+			ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			require.Equal(t, uint32(1), keyspaceID)
+			keyspaced = append(keyspaced, safePoint)
+			return safePoint - 1, nil
+		},
+	}
+
+	minTs, scope, err := SetServiceGCSafepointWithScope(ctx, pdCli, GlobalScope, "ticdc", 10, 100)
+	require.Nil(t, err)
+	require.Equal(t, uint64(100), minTs)
+	require.Equal(t, GlobalScope, scope)
+
+	minTs, scope, err = SetServiceGCSafepointWithScope(ctx, pdCli, KeyspaceScope(1), "ticdc", 10, 200)
+	require.Nil(t, err)
+	require.Equal(t, uint64(199), minTs)
+	require.Equal(t, KeyspaceScope(1), scope)
+	require.Equal(t, []uint64{100}, global)
+	require.Equal(t, []uint64{200}, keyspaced)
+
+	// fallback to the global scope if the keyspace-level safepoint isn't supported.
+	v1Cli := &mockPdClientForServiceGCSafePoint{serviceSafePoint: make(map[string]uint64)}
+	_, scope, err = SetServiceGCSafepointWithScope(ctx, v1Cli, KeyspaceScope(1), "ticdc", 10, 300)
+	require.Nil(t, err)
+	require.Equal(t, GlobalScope, scope)
+	require.Equal(t, map[string]uint64{"ticdc": 300}, v1Cli.serviceSafePoint)
+}
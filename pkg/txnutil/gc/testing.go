@@ -29,6 +29,7 @@ type MockPDClient struct {
 	GetAllStoresFunc func(ctx context.Context, opts ...pd.GetStoreOption) ([]*metapb.Store, error)
 
 	UpdateServiceGCSafePointFunc func(ctx context.Context, serviceID string, ttl int64, safePoint uint64) (uint64, error)
+	UpdateServiceSafePointV2Func func(ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64) (uint64, error)
 }
 
 // UpdateServiceGCSafePoint implements pd.Client.UpdateServiceGCSafePoint.
@@ -36,6 +37,11 @@ func (m *MockPDClient) UpdateServiceGCSafePoint(ctx context.Context, serviceID s
 	return m.UpdateServiceGCSafePointFunc(ctx, serviceID, ttl, safePoint)
 }
 
+// UpdateServiceSafePointV2 implements ServiceSafePointV2Client.UpdateServiceSafePointV2.
+func (m *MockPDClient) UpdateServiceSafePointV2(ctx context.Context, keyspaceID uint32, serviceID string, ttl int64, safePoint uint64) (uint64, error) {
+	return m.UpdateServiceSafePointV2Func(ctx, keyspaceID, serviceID, ttl, safePoint)
+}
+
 // GetTS implements pd.Client.GetTS.
 func (m *MockPDClient) GetTS(ctx context.Context) (int64, int64, error) {
 	return oracle.GetPhysical(time.Now()), 0, nil
@@ -45,6 +45,9 @@ var (
 	// maxTiKVVersion is the version of the maximum compatible TiKV.
 	// Compatible versions are in [MinTiKVVersion, maxTiKVVersion)
 	maxTiKVVersion = semver.New("8.0.0")
+	// minTiKVVersionForFollowerIncrementalScan is the version of the minimal
+	// TiKV which serves the incremental scan requests on followers.
+	minTiKVVersionForFollowerIncrementalScan = semver.New("7.1.0-alpha")
 
 	// CaptureInfo.Version is added since v4.0.11,
 	// we use the minimal release version as default.
@@ -221,6 +224,29 @@ func CheckStoreVersion(ctx context.Context, client pd.Client, storeID uint64) er
 	return nil
 }
 
+// CheckFollowerIncrementalScan returns true if all the TiKV stores serve the
+// incremental scan requests on followers.
+func CheckFollowerIncrementalScan(ctx context.Context, client pd.Client) (bool, error) {
+	stores, err := client.GetAllStores(ctx, pd.WithExcludeTombstone())
+	if err != nil {
+		return false, cerror.WrapError(cerror.ErrGetAllStoresFailed, err)
+	}
+	for _, s := range stores {
+		if engine.IsTiFlash(s) {
+			continue
+		}
+		ver, err := semver.NewVersion(SanitizeVersion(s.Version))
+		if err != nil {
+			err = errors.Annotate(err, "invalid TiKV version")
+			return false, cerror.WrapError(cerror.ErrNewSemVersion, err)
+		}
+		if ver.LessThan(*minTiKVVersionForFollowerIncrementalScan) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // TiCDCClusterVersion is the version of TiCDC cluster
 type TiCDCClusterVersion struct {
 	*semver.Version
@@ -213,6 +213,40 @@ func TestCheckClusterVersion(t *testing.T) {
 	}
 }
 
+func TestCheckFollowerIncrementalScan(t *testing.T) {
+	t.Parallel()
+	mock := mockPDClient{}
+	ctx := context.Background()
+
+	mock.getAllStores = func() []*metapb.Store {
+		return []*metapb.Store{
+			{Version: minTiKVVersionForFollowerIncrementalScan.String()},
+			{Version: "v7.5.0"},
+			// TiFlash is skipped.
+			{
+				Version: MinTiKVVersion.String(),
+				Labels:  []*metapb.StoreLabel{{Key: "engine", Value: "tiflash"}},
+			},
+		}
+	}
+	ok, err := CheckFollowerIncrementalScan(ctx, &mock)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	mock.getAllStores = func() []*metapb.Store {
+		return []*metapb.Store{{Version: "v7.5.0"}, {Version: "v7.0.0"}}
+	}
+	ok, err = CheckFollowerIncrementalScan(ctx, &mock)
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	mock.getAllStores = func() []*metapb.Store {
+		return []*metapb.Store{{Version: "invalid"}}
+	}
+	_, err = CheckFollowerIncrementalScan(ctx, &mock)
+	require.Regexp(t, ".*invalid TiKV version.*", err)
+}
+
 func TestCompareVersion(t *testing.T) {
 	// build on master branch, `vx.y.z-master`
 	masterVersion := semver.New(SanitizeVersion("v6.3.0-master"))